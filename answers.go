@@ -0,0 +1,197 @@
+package installer
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gopkg.in/yaml.v2"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+//Answers are the choices made by the user during an installation.
+//They can be saved to a JSON or YAML answer file, and replayed
+//later to install with no UI.
+//
+//Answer files hold form values as is, passwords included.
+type Answers struct {
+	AcceptConditions bool `json:"acceptConditions" yaml:"acceptConditions"`
+	//Components selected, default ones are selected if nil
+	Components []string `json:"components" yaml:"components"`
+	//InstallDir chosen, the default one is used if empty
+	InstallDir string            `json:"installDir,omitempty" yaml:"installDir,omitempty"`
+	Values     map[string]string `json:"values,omitempty" yaml:"values,omitempty"`
+}
+
+//Answers returns the choices made so far.
+func (i *installer) Answers() *Answers {
+	a := &Answers{
+		AcceptConditions: true,
+		InstallDir:       i.InstallDir(),
+	}
+	if len(i.components) > 0 {
+		a.Components = []string{}
+	}
+	for _, c := range i.components {
+		if c.Selected {
+			a.Components = append(a.Components, c.ID)
+		}
+	}
+	if len(i.values) > 0 {
+		a.Values = i.vars()
+		delete(a.Values, installDirVarName)
+	}
+	return a
+}
+
+//SaveAnswers saves the choices made to an answer file,
+//in YAML if its extension is .yaml or .yml, in JSON otherwise.
+//As it may hold passwords, only the user can read it.
+func (i *installer) SaveAnswers(path string) error {
+	content, err := marshalAnswers(path, i.Answers())
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, content, 0600)
+}
+
+//LoadAnswers reads an answer file saved with SaveAnswers.
+func LoadAnswers(path string) (*Answers, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	a := &Answers{}
+	if isYAML(path) {
+		err = yaml.UnmarshalStrict(content, a)
+	} else {
+		dec := json.NewDecoder(bytes.NewReader(content))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(a)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid answer file %s: %w", path, err)
+	}
+	return a, nil
+}
+
+//ApplyAnswers replays choices before installation. It fails
+//if a required choice is missing, conditions not being accepted
+//or a required form value not being set for instance.
+func (i *installer) ApplyAnswers(a *Answers) error {
+	return i.applyAnswers(ioutil.Discard, a)
+}
+
+//applyAnswers replays choices, printing them to w.
+//Passwords are not printed.
+func (i *installer) applyAnswers(w io.Writer, a *Answers) error {
+	if len(i.conditions) > 0 && !a.AcceptConditions {
+		return errors.New("conditions must be accepted with --accept-conditions or in the answer file")
+	}
+	for _, c := range i.conditions {
+		fmt.Fprintf(w, "condition accepted: %s\n", c.Title)
+	}
+	if a.Components != nil {
+		if err := i.SelectComponents(a.Components...); err != nil {
+			return err
+		}
+	}
+	for _, c := range i.components {
+		if c.Selected {
+			fmt.Fprintf(w, "component selected: %s\n", c.Title)
+		}
+	}
+	if a.InstallDir != "" || i.installDir != nil {
+		dir := a.InstallDir
+		if dir == "" {
+			dir = i.installDir.Default
+		}
+		if err := i.SelectInstallDir(dir); err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "install directory: %s\n", i.InstallDir())
+	}
+	if err := i.applyValues(a.Values); err != nil {
+		return err
+	}
+	for _, form := range i.forms {
+		for _, f := range form.Fields {
+			fmt.Fprintf(w, "%s: %s\n", f.Label, displayValue(f, i.values[f.Name]))
+		}
+	}
+	i.describeSteps()
+	return nil
+}
+
+//applyValues sets form values before checking every
+//required one has been set.
+func (i *installer) applyValues(values map[string]string) error {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := i.SetValue(name, values[name]); err != nil {
+			return err
+		}
+	}
+	for _, form := range i.forms {
+		for _, f := range form.Fields {
+			if f.Required && i.values[f.Name] == "" {
+				return fmt.Errorf("missing required value %s, set it with --set %s=value or in the answer file", f.Name, f.Name)
+			}
+		}
+	}
+	return i.validateForms()
+}
+
+func marshalAnswers(path string, a *Answers) ([]byte, error) {
+	if isYAML(path) {
+		return yaml.Marshal(a)
+	}
+	return json.MarshalIndent(a, "", "  ")
+}
+
+func isYAML(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	return ext == ".yaml" || ext == ".yml"
+}
+
+//recordAnswers saves the choices made to path, if any.
+func (i *installer) recordAnswers(path string) error {
+	if path == "" {
+		return nil
+	}
+	return i.SaveAnswers(path)
+}
+
+//answers returns choices read from the answer file
+//if any, overridden by those set with flags.
+func (o runOptions) answers() (*Answers, error) {
+	a := &Answers{}
+	if o.answersFile != "" {
+		var err error
+		if a, err = LoadAnswers(o.answersFile); err != nil {
+			return nil, err
+		}
+	}
+	a.AcceptConditions = a.AcceptConditions || o.acceptConditions
+	if o.components != "" {
+		a.Components = parseComponents(o.components)
+	}
+	if o.installDir != "" {
+		a.InstallDir = o.installDir
+	}
+	if len(o.values) > 0 && a.Values == nil {
+		a.Values = map[string]string{}
+	}
+	for name, value := range o.values {
+		a.Values[name] = value
+	}
+	return a, nil
+}
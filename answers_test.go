@@ -0,0 +1,103 @@
+package installer
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+//newAnswersInstaller returns an installer asking for every
+//choice an answer file holds.
+func newAnswersInstaller(t *testing.T) *installer {
+	i := New("test")
+	i.AddCondition("license", "terms")
+	i.AddComponent("cli", "CLI", "command line", true)
+	i.AddComponent("docs", "Docs", "documentation", false)
+	i.SetInstallDir(filepath.Join(t.TempDir(), "app"), 0)
+	i.AddForm("server",
+		Field{Name: "url", Label: "URL", Required: true},
+		Field{Name: "env", Label: "Environment", Default: "prod"},
+	)
+	return i
+}
+
+func TestAnswersRoundTrip(t *testing.T) {
+	for _, name := range []string{"answers.json", "answers.yaml"} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), name)
+			dir := filepath.Join(t.TempDir(), "chosen")
+			recorded := newAnswersInstaller(t)
+			args := []string{"--accept-conditions", "--components=docs", "--install-dir=" + dir, "--set", "url=https://example.com", "--record-answers=" + path}
+			if err := recorded.Run("test", args); err != nil {
+				t.Fatal(err)
+			}
+			replayed := newAnswersInstaller(t)
+			if err := replayed.Run("test", []string{"--answers=" + path}); err != nil {
+				t.Fatal(err)
+			}
+			want := &Answers{
+				AcceptConditions: true,
+				Components:       []string{"docs"},
+				InstallDir:       dir,
+				Values:           map[string]string{"url": "https://example.com", "env": "prod"},
+			}
+			if got := replayed.Answers(); !reflect.DeepEqual(got, want) {
+				t.Fatalf("got %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestLoadAnswersUnknownField(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "answers.json", content: `{"acceptConditions": true, "instalDir": "/opt/app"}`},
+		{name: "answers.yaml", content: "acceptConditions: true\ninstalDir: /opt/app\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.name)
+			if err := ioutil.WriteFile(path, []byte(tt.content), 0600); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := LoadAnswers(path); err == nil || !strings.Contains(err.Error(), "instalDir") {
+				t.Fatalf("got %v, want the unknown field reported", err)
+			}
+		})
+	}
+}
+
+func TestAnswersFlagsOverride(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "answers.json")
+	content := `{"acceptConditions": true, "components": ["cli"], "installDir": "` + filepath.ToSlash(filepath.Join(t.TempDir(), "file")) + `", "values": {"url": "https://file.example.com", "env": "dev"}}`
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	dir := filepath.Join(t.TempDir(), "flag")
+	i := newAnswersInstaller(t)
+	args := []string{"--answers=" + path, "--components=docs", "--install-dir=" + dir, "--set", "url=https://flag.example.com"}
+	if err := i.Run("test", args); err != nil {
+		t.Fatal(err)
+	}
+	want := &Answers{
+		AcceptConditions: true,
+		Components:       []string{"docs"},
+		InstallDir:       dir,
+		Values:           map[string]string{"url": "https://flag.example.com", "env": "dev"},
+	}
+	if got := i.Answers(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestAnswersMissingRequiredValue(t *testing.T) {
+	i := newAnswersInstaller(t)
+	err := i.Run("test", []string{"--accept-conditions"})
+	if err == nil || !strings.Contains(err.Error(), "missing required value url") {
+		t.Fatalf("got %v, want url reported missing", err)
+	}
+}
@@ -0,0 +1,326 @@
+package installer
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ulikunitz/xz"
+)
+
+//ArchiveFormat is the format of an archive to extract.
+type ArchiveFormat int
+
+const (
+	ArchiveZip ArchiveFormat = iota
+	ArchiveTar
+	ArchiveTarGz
+	ArchiveTarXz
+)
+
+//zipCreatorUnix is the creator of zip entries holding unix modes,
+//see zip.FileHeader.CreatorVersion.
+const zipCreatorUnix = 3
+
+//AddStepExtractArchive adds a step that extracts the archive
+//found at src into dst.
+//
+//Entries leading outside of dst, either by their path or by
+//a symlink target, make the step fail. File modes and symlinks
+//are preserved, other special files are ignored. Files without
+//a unix mode, such as those of zip archives made on windows, get
+//the default file mode, see SetDefaultFileMode.
+//Files are written atomically, replaced files are kept aside
+//until installation completes.
+//On rollback, extracted files and created directories are removed
+//and replaced files restored.
+func (i *installer) AddStepExtractArchive(dst, src string, format ArchiveFormat) {
+	var entries []ManifestEntry
+	process := func(ctx context.Context, progress Progress) error {
+		var err error
+		entries, err = extractArchive(ctx, i.expand(dst), i.expand(src), format, i.fileMode, progress)
+		if err != nil {
+			return withRollbackErr(err, revertEntries(entries))
+		}
+		i.manifest.add(entries...)
+		return nil
+	}
+	i.addStep(step{
+		process:     process,
+		undo:        func() error { return revertEntries(entries) },
+		commit:      func() error { return discardBackups(entries) },
+		Description: i.getExtractArchiveText(src, dst),
+	})
+}
+
+//extractArchive returns manifest entries of directories,
+//files and symlinks created until an error occurs.
+//Files without a mode get fileMode. It stops once ctx is done.
+func extractArchive(ctx context.Context, dst, src string, format ArchiveFormat, fileMode os.FileMode, progress Progress) ([]ManifestEntry, error) {
+	e := &extractor{dst: filepath.Clean(dst), fileMode: fileMode, progress: progress}
+	if err := e.mkDir(e.dst); err != nil {
+		return e.entries, err
+	}
+	if format == ArchiveZip {
+		return e.entries, e.extractZip(ctx, src)
+	}
+	f, err := os.Open(src)
+	if err != nil {
+		return e.entries, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return e.entries, err
+	}
+	cr := &contextReader{ctx: ctx, r: f}
+	pr := &progressReader{r: cr, size: info.Size(), status: filepath.Base(src), progress: progress}
+	r, err := newTarReader(pr, format)
+	if err != nil {
+		return e.entries, err
+	}
+	return e.entries, e.extractTar(r)
+}
+
+func newTarReader(r io.Reader, format ArchiveFormat) (*tar.Reader, error) {
+	switch format {
+	case ArchiveTar:
+		return tar.NewReader(r), nil
+	case ArchiveTarGz:
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return tar.NewReader(gz), nil
+	case ArchiveTarXz:
+		xzr, err := xz.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return tar.NewReader(xzr), nil
+	}
+	return nil, fmt.Errorf("unknown archive format %d", format)
+}
+
+//extractor writes archive entries inside dst
+//and records what it creates.
+type extractor struct {
+	dst     string
+	entries []ManifestEntry
+	//fileMode is the mode of files without one
+	fileMode os.FileMode
+	progress Progress
+}
+
+func (e *extractor) extractZip(ctx context.Context, src string) error {
+	z, err := zip.OpenReader(src)
+	if err != nil {
+		return err
+	}
+	defer z.Close()
+	for index, f := range z.File {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		reportItem(e.progress, index, len(z.File), f.Name)
+		if err := e.extractZipFile(ctx, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *extractor) extractZipFile(ctx context.Context, f *zip.File) error {
+	mode := f.Mode()
+	if mode.IsDir() {
+		return e.extractDir(f.Name)
+	}
+	r, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	if mode&os.ModeSymlink != 0 {
+		linkname, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		return e.extractSymlink(f.Name, string(linkname))
+	}
+	if !mode.IsRegular() {
+		return nil
+	}
+	perm := mode.Perm()
+	if f.CreatorVersion>>8 != zipCreatorUnix {
+		//other creators only tell if a file is read only
+		perm = 0
+	}
+	return e.extractFile(f.Name, &contextReader{ctx: ctx, r: r}, perm)
+}
+
+func (e *extractor) extractTar(r *tar.Reader) error {
+	for {
+		h, err := r.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := e.extractTarEntry(h, r); err != nil {
+			return err
+		}
+	}
+}
+
+func (e *extractor) extractTarEntry(h *tar.Header, r io.Reader) error {
+	switch h.Typeflag {
+	case tar.TypeDir:
+		return e.extractDir(h.Name)
+	case tar.TypeReg:
+		return e.extractFile(h.Name, r, h.FileInfo().Mode().Perm())
+	case tar.TypeSymlink:
+		return e.extractSymlink(h.Name, h.Linkname)
+	}
+	return nil
+}
+
+func (e *extractor) extractDir(name string) error {
+	target, err := e.targetPath(name)
+	if err != nil {
+		return err
+	}
+	return e.mkDir(target)
+}
+
+func (e *extractor) extractFile(name string, r io.Reader, mode os.FileMode) error {
+	target, err := e.targetPath(name)
+	if err != nil {
+		return err
+	}
+	if err := e.mkDir(filepath.Dir(target)); err != nil {
+		return err
+	}
+	if mode == 0 {
+		mode = e.fileMode
+	}
+	entry := newFileEntry(target)
+	entry.Checksum, entry.backup, err = writeFile(target, r, mode)
+	if err != nil {
+		return err
+	}
+	e.entries = append(e.entries, entry)
+	return nil
+}
+
+func (e *extractor) extractSymlink(name, linkname string) error {
+	target, err := e.targetPath(name)
+	if err != nil {
+		return err
+	}
+	if err := e.checkSymlink(target, linkname); err != nil {
+		return err
+	}
+	if err := e.mkDir(filepath.Dir(target)); err != nil {
+		return err
+	}
+	entry := ManifestEntry{Kind: KindSymlink, Path: target, Existed: pathExists(target)}
+	entry.backup, err = backupFile(target)
+	if err != nil {
+		return err
+	}
+	if err := os.Symlink(linkname, target); err != nil {
+		return withRollbackErr(err, revertEntry(entry))
+	}
+	e.entries = append(e.entries, entry)
+	return nil
+}
+
+//mkDir creates dirPath and its missing parents,
+//recording each one of them it created.
+func (e *extractor) mkDir(dirPath string) error {
+	var missing []string
+	for p := dirPath; !pathExists(p); p = filepath.Dir(p) {
+		missing = append([]string{p}, missing...)
+		if p == filepath.Dir(p) {
+			break
+		}
+	}
+	for _, p := range missing {
+		err := os.Mkdir(p, os.ModePerm)
+		if os.IsExist(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		e.entries = append(e.entries, ManifestEntry{Kind: KindDir, Path: p})
+	}
+	return nil
+}
+
+//targetPath returns where an archive entry is extracted,
+//refusing any path leading outside of dst, either by its name
+//or through a symlink already extracted in its parents.
+func (e *extractor) targetPath(name string) (string, error) {
+	target := filepath.Join(e.dst, filepath.FromSlash(name))
+	if !isInDir(e.dst, target) {
+		return "", fmt.Errorf("illegal path in archive: %s", name)
+	}
+	symlink, err := e.hasSymlinkParent(target)
+	if err != nil {
+		return "", err
+	}
+	if symlink {
+		return "", fmt.Errorf("illegal path through a symlink in archive: %s", name)
+	}
+	return target, nil
+}
+
+//hasSymlinkParent tells if a parent of target inside dst
+//is a symlink. Parents are checked down from dst until
+//one does not exist yet.
+func (e *extractor) hasSymlinkParent(target string) (bool, error) {
+	rel, err := filepath.Rel(e.dst, filepath.Dir(target))
+	if err != nil || rel == "." {
+		return false, err
+	}
+	parent := e.dst
+	for _, part := range strings.Split(rel, string(filepath.Separator)) {
+		parent = filepath.Join(parent, part)
+		info, err := os.Lstat(parent)
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+//checkSymlink refuses symlinks pointing outside of dst.
+func (e *extractor) checkSymlink(target, linkname string) error {
+	resolved := filepath.Join(filepath.Dir(target), filepath.FromSlash(linkname))
+	if filepath.IsAbs(linkname) || !isInDir(e.dst, resolved) {
+		return fmt.Errorf("illegal symlink in archive: %s -> %s", target, linkname)
+	}
+	return nil
+}
+
+func isInDir(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
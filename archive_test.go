@@ -0,0 +1,240 @@
+package installer
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+//archiveEntry is a file, directory or symlink
+//of an archive built by a test.
+type archiveEntry struct {
+	name     string
+	content  string
+	linkname string
+	dir      bool
+}
+
+func writeTestTar(t *testing.T, entries []archiveEntry) string {
+	t.Helper()
+	var buf bytes.Buffer
+	w := tar.NewWriter(&buf)
+	for _, e := range entries {
+		h := &tar.Header{Name: e.name, Mode: 0644, Typeflag: tar.TypeReg, Size: int64(len(e.content))}
+		switch {
+		case e.dir:
+			h.Typeflag, h.Mode, h.Size = tar.TypeDir, 0755, 0
+		case e.linkname != "":
+			h.Typeflag, h.Linkname, h.Size = tar.TypeSymlink, e.linkname, 0
+		}
+		if err := w.WriteHeader(h); err != nil {
+			t.Fatal(err)
+		}
+		if h.Typeflag == tar.TypeReg {
+			if _, err := w.Write([]byte(e.content)); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	src := filepath.Join(t.TempDir(), "archive.tar")
+	if err := ioutil.WriteFile(src, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return src
+}
+
+func writeTestZip(t *testing.T, entries []archiveEntry) string {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, e := range entries {
+		h := &zip.FileHeader{Name: e.name}
+		content := e.content
+		switch {
+		case e.dir:
+			h.SetMode(os.ModeDir | 0755)
+		case e.linkname != "":
+			h.SetMode(os.ModeSymlink | 0777)
+			content = e.linkname
+		default:
+			h.SetMode(0644)
+		}
+		f, err := w.CreateHeader(h)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := f.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	src := filepath.Join(t.TempDir(), "archive.zip")
+	if err := ioutil.WriteFile(src, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return src
+}
+
+func TestExtractArchive(t *testing.T) {
+	entries := []archiveEntry{
+		{name: "bin", dir: true},
+		{name: "bin/app", content: "app"},
+		{name: "lib/libapp.so", content: "lib"},
+		{name: "current", linkname: "bin/app"},
+	}
+	for _, format := range []ArchiveFormat{ArchiveTar, ArchiveZip} {
+		src := writeTestTar(t, entries)
+		if format == ArchiveZip {
+			src = writeTestZip(t, entries)
+		}
+		dst := filepath.Join(t.TempDir(), "dst")
+		if _, err := extractArchive(context.Background(), dst, src, format, defaultFileMode, noProgress); err != nil {
+			t.Fatalf("format %d: %v", format, err)
+		}
+		for name, want := range map[string]string{"bin/app": "app", "lib/libapp.so": "lib", "current": "app"} {
+			got, err := ioutil.ReadFile(filepath.Join(dst, name))
+			if err != nil || string(got) != want {
+				t.Errorf("format %d: %s is %q, %v, want %q", format, name, got, err, want)
+			}
+		}
+	}
+}
+
+func TestExtractArchiveOutsideOfDst(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []archiveEntry
+	}{
+		{name: "parent name", entries: []archiveEntry{{name: "../evil", content: "evil"}}},
+		{name: "nested parent name", entries: []archiveEntry{{name: "a/../../evil", content: "evil"}}},
+		{name: "absolute symlink", entries: []archiveEntry{{name: "link", linkname: "/etc"}}},
+		{name: "parent symlink", entries: []archiveEntry{{name: "link", linkname: "../"}}},
+		{name: "chained symlinks", entries: []archiveEntry{
+			{name: "x", linkname: "."},
+			{name: "x/y", linkname: ".."},
+			{name: "x/y/evil", content: "evil"},
+		}},
+		{name: "file through symlink", entries: []archiveEntry{
+			{name: "a", dir: true},
+			{name: "x", linkname: "a"},
+			{name: "x/evil", content: "evil"},
+		}},
+	}
+	for _, tt := range tests {
+		for _, format := range []ArchiveFormat{ArchiveTar, ArchiveZip} {
+			src := writeTestTar(t, tt.entries)
+			if format == ArchiveZip {
+				src = writeTestZip(t, tt.entries)
+			}
+			root := t.TempDir()
+			dst := filepath.Join(root, "dst")
+			entries, err := extractArchive(context.Background(), dst, src, format, defaultFileMode, noProgress)
+			if err == nil {
+				t.Errorf("%s, format %d: extracted without error", tt.name, format)
+			}
+			if err := revertEntries(entries); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := os.Lstat(filepath.Join(root, "evil")); !os.IsNotExist(err) {
+				t.Errorf("%s, format %d: file written outside of dst", tt.name, format)
+			}
+		}
+	}
+}
+
+func TestExtractArchiveFileMode(t *testing.T) {
+	unix := writeTestZip(t, []archiveEntry{{name: "app", content: "app"}})
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	//made on windows, without any unix mode
+	f, err := w.CreateHeader(&zip.FileHeader{Name: "app", CreatorVersion: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Write([]byte("app")); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	windows := filepath.Join(t.TempDir(), "archive.zip")
+	if err := ioutil.WriteFile(windows, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		src  string
+		want os.FileMode
+	}{
+		{name: "unix zip", src: unix, want: 0644},
+		{name: "windows zip", src: windows, want: 0600},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dst := t.TempDir()
+			if _, err := extractArchive(context.Background(), dst, tt.src, ArchiveZip, 0600, noProgress); err != nil {
+				t.Fatal(err)
+			}
+			info, err := os.Stat(filepath.Join(dst, "app"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if info.Mode().Perm() != tt.want {
+				t.Fatalf("got mode %v, want %v", info.Mode().Perm(), tt.want)
+			}
+		})
+	}
+}
+
+func TestExtractArchiveInvalid(t *testing.T) {
+	root := t.TempDir()
+	src := filepath.Join(root, "archive.tar.gz")
+	if err := ioutil.WriteFile(src, []byte("not gzip"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		src  string
+	}{
+		{name: "missing archive", src: filepath.Join(root, "missing.tar.gz")},
+		{name: "invalid archive", src: src},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dst := filepath.Join(t.TempDir(), "a", "dst")
+			entries, err := extractArchive(context.Background(), dst, tt.src, ArchiveTarGz, defaultFileMode, noProgress)
+			if err == nil {
+				t.Fatal("got no error")
+			}
+			if err := revertEntries(entries); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := os.Stat(filepath.Dir(dst)); !os.IsNotExist(err) {
+				t.Fatalf("created directories left behind: %v", err)
+			}
+		})
+	}
+}
+
+func TestExtractArchiveExistingDst(t *testing.T) {
+	dst := t.TempDir()
+	entries, err := extractArchive(context.Background(), dst, writeTestTar(t, []archiveEntry{{name: "app", content: "app"}}), ArchiveTar, defaultFileMode, noProgress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range entries {
+		if e.Kind == KindDir {
+			t.Fatalf("existing directory %s recorded as created", e.Path)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package installer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+//writeFile atomically streams r into file : content is written
+//to a sibling temporary file, synced to disk and then renamed
+//over file, so an interrupted write never leaves a truncated file.
+//
+//If file already exists, a backup of it is kept and its path
+//is returned, so it can be restored on rollback. file itself is
+//never moved away, a crash leaving either version in place.
+//The SHA-256 checksum of what has been written is returned as well.
+func writeFile(file string, r io.Reader, mode os.FileMode) (sum, backup string, err error) {
+	return writeVerifiedFile(file, r, mode, "")
+}
+
+//writeVerifiedFile is writeFile failing with a *ChecksumError before
+//file is replaced if what has been written does not match expected,
+//the hex encoded SHA-256 checksum of its content. It is not checked
+//if expected is empty.
+func writeVerifiedFile(file string, r io.Reader, mode os.FileMode, expected string) (sum, backup string, err error) {
+	tmp, sum, err := writeTempFile(file, r, mode)
+	if err != nil {
+		return "", "", err
+	}
+	if expected != "" && !strings.EqualFold(sum, expected) {
+		os.Remove(tmp)
+		return "", "", &ChecksumError{File: file, Expected: expected, Actual: sum}
+	}
+	backup, err = replaceFile(tmp, file)
+	if err != nil {
+		os.Remove(tmp)
+		return "", "", err
+	}
+	return sum, backup, nil
+}
+
+//replaceFile atomically renames tmp over file, keeping a backup of
+//file whose path is returned, empty if file did not exist. The parent
+//directory is synced so the rename survives a crash.
+func replaceFile(tmp, file string) (string, error) {
+	backup, err := keepBackup(file)
+	if err != nil {
+		return "", err
+	}
+	if err := os.Rename(tmp, file); err != nil {
+		discardBackup(backup)
+		return "", err
+	}
+	if err := syncDir(filepath.Dir(file)); err != nil {
+		if backup == "" {
+			return "", withRollbackErr(err, rmvFile(file))
+		}
+		return "", withRollbackErr(err, os.Rename(backup, file))
+	}
+	return backup, nil
+}
+
+//keepBackup keeps file under a new path without moving it away,
+//as a hard link or else a copy, and returns that path.
+//It returns an empty path if file does not exist.
+func keepBackup(file string) (string, error) {
+	info, err := os.Lstat(file)
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	backup := backupPath(file)
+	if err := os.Link(file, backup); err == nil {
+		return backup, nil
+	}
+	if !info.Mode().IsRegular() {
+		return backupFile(file)
+	}
+	src, err := os.Open(file)
+	if err != nil {
+		return "", err
+	}
+	defer src.Close()
+	dst, err := os.OpenFile(backup, os.O_CREATE|os.O_EXCL|os.O_WRONLY, info.Mode().Perm())
+	if err != nil {
+		return "", err
+	}
+	if _, err := writeSynced(dst, src, info.Mode().Perm()); err != nil {
+		dst.Close()
+		os.Remove(backup)
+		return "", err
+	}
+	return backup, dst.Close()
+}
+
+//writeTempFile writes r to a temporary file next to file
+//and returns its path along with the checksum of its content.
+func writeTempFile(file string, r io.Reader, mode os.FileMode) (string, string, error) {
+	dir, base := filepath.Split(file)
+	f, err := os.CreateTemp(dir, "."+base+".tmp-*")
+	if err != nil {
+		return "", "", err
+	}
+	sum, err := writeSynced(f, r, mode)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return "", "", err
+	}
+	return f.Name(), sum, nil
+}
+
+func writeSynced(f *os.File, r io.Reader, mode os.FileMode) (string, error) {
+	h := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(f, h), r); err != nil {
+		return "", err
+	}
+	if err := f.Chmod(mode); err != nil {
+		return "", err
+	}
+	if err := f.Sync(); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+//backupFile moves file aside and returns its new path.
+//It returns an empty path if file does not exist.
+func backupFile(file string) (string, error) {
+	if _, err := os.Lstat(file); os.IsNotExist(err) {
+		return "", nil
+	}
+	backup := backupPath(file)
+	return backup, os.Rename(file, backup)
+}
+
+//backupPath returns a new path next to file
+//to keep its previous version.
+func backupPath(file string) string {
+	dir, base := filepath.Split(file)
+	return filepath.Join(dir, fmt.Sprintf(".%s.bak-%d", base, time.Now().UnixNano()))
+}
+
+func discardBackup(backup string) error {
+	if backup == "" {
+		return nil
+	}
+	return rmvFile(backup)
+}
+
+//discardBackups removes files replaced by recorded entries.
+func discardBackups(entries []ManifestEntry) error {
+	for _, e := range entries {
+		if err := discardBackup(e.backup); err != nil {
+			return err
+		}
+	}
+	return nil
+}
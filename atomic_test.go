@@ -0,0 +1,60 @@
+package installer
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWriteFileKeepsOriginalInPlace(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "file")
+	if err := ioutil.WriteFile(file, []byte("old"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	original, err := os.Stat(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, backup, err := writeFile(file, strings.NewReader("new"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if content, _ := ioutil.ReadFile(file); string(content) != "new" {
+		t.Fatalf("got %q, want new", content)
+	}
+	kept, err := os.Stat(backup)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !os.SameFile(original, kept) {
+		t.Fatal("backup is not the original file")
+	}
+	if err := revertEntry(ManifestEntry{Kind: KindFile, Path: file, backup: backup}); err != nil {
+		t.Fatal(err)
+	}
+	if content, _ := ioutil.ReadFile(file); string(content) != "old" {
+		t.Fatalf("got %q after revert, want old", content)
+	}
+}
+
+func TestWriteVerifiedFileMismatch(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "file")
+	if err := ioutil.WriteFile(file, []byte("old"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	_, _, err := writeVerifiedFile(file, strings.NewReader("new"), 0644, strings.Repeat("0", 64))
+	var checksumErr *ChecksumError
+	if !errors.As(err, &checksumErr) {
+		t.Fatalf("got %v, want a checksum error", err)
+	}
+	if content, _ := ioutil.ReadFile(file); string(content) != "old" {
+		t.Fatalf("got %q, want old", content)
+	}
+	if files, _ := ioutil.ReadDir(dir); len(files) != 1 {
+		t.Fatalf("got %d files, want temporary files removed", len(files))
+	}
+}
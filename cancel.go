@@ -0,0 +1,46 @@
+package installer
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+//ErrCancelled is returned when the user cancels the installation.
+var ErrCancelled = errors.New("installation cancelled")
+
+//AddStepWithContext adds a new step whose process receives a context,
+//done when the user cancels the installation, along with a progress
+//reporter. Long processes should stop as soon as ctx is done.
+func (i *installer) AddStepWithContext(process func(ctx context.Context, progress Progress) error, desc string, opts ...StepOption) {
+	i.addStep(step{
+		process:     process,
+		Description: desc,
+	}, opts...)
+}
+
+//processStep processes s unless ctx is done.
+//Any failure happening once ctx is done is reported as ErrCancelled.
+func processStep(ctx context.Context, s step, progress Progress) error {
+	if ctx.Err() != nil {
+		return ErrCancelled
+	}
+	err := s.attempt(ctx, progress)
+	if err != nil && ctx.Err() != nil {
+		return ErrCancelled
+	}
+	return err
+}
+
+//contextReader stops reading from r once ctx is done.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (c *contextReader) Read(b []byte) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return c.r.Read(b)
+}
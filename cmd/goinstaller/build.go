@@ -0,0 +1,328 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/audrenbdb/installer"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const (
+	installerModule = "github.com/audrenbdb/installer"
+	definitionFile  = "definition.yml"
+	payloadDir      = "payload"
+)
+
+var mainTemplate = template.Must(template.New("main.go").Parse(`// Code generated by goinstaller. DO NOT EDIT.
+
+package main
+
+import (
+	"bytes"
+	"embed"
+	"io/fs"
+	"log"
+	"os"
+
+	"github.com/audrenbdb/installer"
+)
+
+//go:embed {{.Definition}}
+var definition []byte
+
+//go:embed {{.Payload}}
+var payload embed.FS
+
+//modes of payload files, embed.FS not holding them
+var modes = map[string]fs.FileMode{
+{{- range .Files}}
+	{{printf "%q" .Name}}: {{printf "%#o" .Mode}},
+{{- end}}
+}
+
+//checksums of payload files, verified once copied
+var checksums = map[string]string{
+{{- range .Files}}
+	{{printf "%q" .Name}}: {{printf "%q" .SHA256}},
+{{- end}}
+}
+
+func main() {
+	sub, err := fs.Sub(payload, {{printf "%q" .Payload}})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fsys := installer.FSWithChecksums(installer.FSWithModes(sub, modes), checksums)
+	i, err := installer.LoadDefinition(bytes.NewReader(definition), installer.WithPayload(fsys))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := i.Run({{printf "%q" .WindowTitle}}, os.Args[1:]); err != nil {
+		log.Fatal(err)
+	}
+}
+`))
+
+type builder struct {
+	definition   string
+	payload      string
+	output       string
+	windowTitle  string
+	installerSrc string
+	keep         bool
+	//generic states if a generic installer reading
+	//an appended payload is built
+	generic bool
+	//base is a generic installer to append the payload to
+	base string
+	//publicKey is a key file generic installers are built
+	//with, refusing payloads not signed with its private key
+	publicKey string
+	//sign is a private key file appended payloads are signed with
+	sign string
+}
+
+//payloadFile describes a file of the payload.
+type payloadFile struct {
+	Name   string
+	Mode   fs.FileMode
+	SHA256 string
+}
+
+//build validates the definition before generating
+//and building the installer main package.
+func (b *builder) build() error {
+	if b.generic {
+		return b.buildGeneric()
+	}
+	if b.base != "" {
+		return b.pack()
+	}
+	if err := b.validate(); err != nil {
+		return err
+	}
+	if err := checkEmbeddable(b.payload); err != nil {
+		return err
+	}
+	dir, err := b.tempDir()
+	if err != nil {
+		return err
+	}
+	if !b.keep {
+		defer os.RemoveAll(dir)
+	}
+	if err := b.generate(dir); err != nil {
+		return err
+	}
+	return b.compile(dir)
+}
+
+//tempDir creates a directory to build in.
+func (b *builder) tempDir() (string, error) {
+	dir, err := os.MkdirTemp("", "goinstaller-*")
+	if err == nil && b.keep {
+		log.Printf("build directory: %s", dir)
+	}
+	return dir, err
+}
+
+//compile builds the installer main package found in dir.
+func (b *builder) compile(dir string) error {
+	if err := runGo(dir, "mod", "tidy"); err != nil {
+		return err
+	}
+	output, err := filepath.Abs(b.output)
+	if err != nil {
+		return err
+	}
+	args := []string{"build", "-o", output}
+	if targetOS() == "windows" {
+		args = append(args, "-ldflags=-H=windowsgui")
+	}
+	return runGo(dir, args...)
+}
+
+//validate loads the definition with the payload, as the installer
+//will on the target system.
+func (b *builder) validate() error {
+	f, err := os.Open(b.definition)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = installer.LoadDefinition(f, installer.WithPayload(os.DirFS(b.payload)), installer.WithTargetOS(targetOS()))
+	return err
+}
+
+//checkEmbeddable checks every file of the payload can be embedded.
+func checkEmbeddable(payload string) error {
+	return fs.WalkDir(os.DirFS(payload), ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		base := d.Name()
+		if name != "." && (strings.HasPrefix(base, ".") || strings.HasPrefix(base, "_")) {
+			return fmt.Errorf("payload: %s can not be embedded, its name starts with . or _", name)
+		}
+		if !d.IsDir() && !d.Type().IsRegular() {
+			return fmt.Errorf("payload: %s is not a regular file", name)
+		}
+		return nil
+	})
+}
+
+//generate writes the installer main package into dir,
+//along with the definition and a copy of the payload.
+func (b *builder) generate(dir string) error {
+	if _, err := copyFile(b.definition, filepath.Join(dir, definitionFile), 0644); err != nil {
+		return err
+	}
+	files, err := copyPayload(b.payload, filepath.Join(dir, payloadDir))
+	if err != nil {
+		return err
+	}
+	return b.writeMain(dir, mainTemplate, map[string]interface{}{
+		"Definition":  definitionFile,
+		"Payload":     payloadDir,
+		"Files":       files,
+		"WindowTitle": b.windowTitle,
+	})
+}
+
+//writeMain writes the go.mod and main.go
+//of the installer main package into dir.
+func (b *builder) writeMain(dir string, main *template.Template, data interface{}) error {
+	goMod, err := b.goMod()
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte(goMod), 0644); err != nil {
+		return err
+	}
+	f, err := os.Create(filepath.Join(dir, "main.go"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return main.Execute(f, data)
+}
+
+//goMod returns the go.mod of the installer main package,
+//requiring the installer package goinstaller was built with
+//unless a local copy is given.
+func (b *builder) goMod() (string, error) {
+	mod := "module installer\n\ngo 1.16\n\n"
+	if b.installerSrc != "" {
+		src, err := filepath.Abs(b.installerSrc)
+		if err != nil {
+			return "", err
+		}
+		mod += fmt.Sprintf("require %s v0.0.0\n\nreplace %s => %s\n", installerModule, installerModule, src)
+		return mod, nil
+	}
+	version := installerVersion()
+	if version == "" {
+		return "", errors.New("installer package version unknown, set -installer-src")
+	}
+	return mod + fmt.Sprintf("require %s %s\n", installerModule, version), nil
+}
+
+//installerVersion returns the version of the installer
+//package goinstaller was built with, if it is a released one.
+func installerVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+	modules := append([]*debug.Module{&info.Main}, info.Deps...)
+	for _, m := range modules {
+		if m.Path == installerModule && m.Version != "(devel)" {
+			return m.Version
+		}
+	}
+	return ""
+}
+
+//copyPayload copies the payload directory to dst and
+//describes each file, sorted by name.
+func copyPayload(src, dst string) ([]payloadFile, error) {
+	var files []payloadFile
+	err := filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if d.IsDir() {
+			return os.MkdirAll(target, 0755)
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		sum, err := copyFile(path, target, info.Mode().Perm())
+		files = append(files, payloadFile{Name: filepath.ToSlash(rel), Mode: info.Mode().Perm(), SHA256: sum})
+		return err
+	})
+	sort.Slice(files, func(a, b int) bool { return files[a].Name < files[b].Name })
+	return files, err
+}
+
+//copyFile copies src to dst and returns the hex
+//encoded SHA-256 checksum of its content.
+func copyFile(src, dst string, mode os.FileMode) (string, error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return "", err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(out, h), in); err != nil {
+		out.Close()
+		return "", err
+	}
+	if err := out.Close(); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), os.Chmod(dst, mode)
+}
+
+//runGo runs the go command in dir, its output
+//being forwarded to goinstaller's one.
+func runGo(dir string, args ...string) error {
+	cmd := exec.Command("go", args...)
+	cmd.Dir = dir
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("go %s: %w", args[0], err)
+	}
+	return nil
+}
+
+//targetOS returns the platform the installer is built for.
+func targetOS() string {
+	if goos := os.Getenv("GOOS"); goos != "" {
+		return goos
+	}
+	return runtime.GOOS
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+//writeTestPayload writes files, names mapped to their content,
+//into a new payload directory, files under bin being executable.
+func writeTestPayload(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		file := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			t.Fatal(err)
+		}
+		mode := os.FileMode(0644)
+		if strings.HasPrefix(name, "bin/") {
+			mode = 0755
+		}
+		if err := ioutil.WriteFile(file, []byte(content), mode); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func sha256Hex(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+//vetMain runs go vet on the installer main package generated
+//into dir, against the installer package of this repository.
+//It is skipped if the installer package does not build here,
+//the go toolchain or its cgo dependencies being missing.
+func vetMain(t *testing.T, dir string) {
+	t.Helper()
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go toolchain not found")
+	}
+	sum, err := ioutil.ReadFile(filepath.Join("..", "..", "go.sum"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "go.sum"), sum, 0644); err != nil {
+		t.Fatal(err)
+	}
+	vet := func(pkg string) ([]byte, error) {
+		cmd := exec.Command("go", "vet", "-mod=mod", pkg)
+		cmd.Dir = dir
+		return cmd.CombinedOutput()
+	}
+	if output, err := vet(installerModule); err != nil {
+		t.Skipf("installer package does not build here: %s", output)
+	}
+	if output, err := vet("."); err != nil {
+		t.Fatalf("go vet: %v\n%s", err, output)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	definition := filepath.Join(t.TempDir(), "installer.yml")
+	if err := ioutil.WriteFile(definition, []byte("title: test\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	b := &builder{
+		definition:   definition,
+		payload:      writeTestPayload(t, map[string]string{"bin/app": "app", "README.md": "readme"}),
+		windowTitle:  `"quoted" title`,
+		installerSrc: filepath.Join("..", ".."),
+	}
+	dir := t.TempDir()
+	if err := b.generate(dir); err != nil {
+		t.Fatal(err)
+	}
+	main, err := ioutil.ReadFile(filepath.Join(dir, "main.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`"README.md": 0644,`,
+		`"bin/app": 0755,`,
+		`"bin/app": "` + sha256Hex("app") + `",`,
+		`i.Run("\"quoted\" title", os.Args[1:])`,
+	} {
+		if runtime.GOOS == "windows" && strings.HasSuffix(want, "0755,") {
+			continue
+		}
+		if !strings.Contains(string(main), want) {
+			t.Errorf("main.go does not contain %s", want)
+		}
+	}
+	vetMain(t, dir)
+}
+
+func TestCheckEmbeddable(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		wantErr string
+	}{
+		{name: "embeddable", files: map[string]string{"bin/app": "app", "docs/README.md": "readme"}},
+		{name: "hidden file", files: map[string]string{"bin/.env": "env"}, wantErr: "bin/.env"},
+		{name: "hidden directory", files: map[string]string{".git/config": "config"}, wantErr: ".git"},
+		{name: "underscore", files: map[string]string{"_build/app": "app"}, wantErr: "_build"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkEmbeddable(writeTestPayload(t, tt.files))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("got %v, want an error about %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckEmbeddableSymlink(t *testing.T) {
+	payload := writeTestPayload(t, map[string]string{"bin/app": "app"})
+	if err := os.Symlink("app", filepath.Join(payload, "bin", "link")); err != nil {
+		t.Skip(err)
+	}
+	if err := checkEmbeddable(payload); err == nil || !strings.Contains(err.Error(), "not a regular file") {
+		t.Fatalf("got %v, want a not regular file error", err)
+	}
+}
+
+func TestCopyPayload(t *testing.T) {
+	files := map[string]string{"bin/app": "app", "README.md": "readme", "share/doc/guide.txt": "guide"}
+	dst := filepath.Join(t.TempDir(), payloadDir)
+	got, err := copyPayload(writeTestPayload(t, files), dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []payloadFile{
+		{Name: "README.md", Mode: 0644, SHA256: sha256Hex("readme")},
+		{Name: "bin/app", Mode: 0755, SHA256: sha256Hex("app")},
+		{Name: "share/doc/guide.txt", Mode: 0644, SHA256: sha256Hex("guide")},
+	}
+	if runtime.GOOS == "windows" {
+		for i := 0; i < len(got) && i < len(want); i++ {
+			got[i].Mode = want[i].Mode
+		}
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+	for _, f := range want {
+		file := filepath.Join(dst, filepath.FromSlash(f.Name))
+		copied, err := ioutil.ReadFile(file)
+		if err != nil || string(copied) != files[f.Name] {
+			t.Fatalf("%s: got %q, %v", f.Name, copied, err)
+		}
+		info, err := os.Stat(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if runtime.GOOS != "windows" && info.Mode().Perm() != f.Mode {
+			t.Errorf("%s: got mode %v, want %v", f.Name, info.Mode().Perm(), f.Mode)
+		}
+	}
+}
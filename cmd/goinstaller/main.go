@@ -0,0 +1,59 @@
+//Command goinstaller builds a self-contained installer executable
+//from an installer definition and a payload directory :
+//
+//	goinstaller -definition installer.yml -payload dist -o setup
+//
+//The definition is validated against the payload before anything
+//is built, see installer.LoadDefinition. The payload is embedded
+//into the executable, copy steps reading from it.
+//
+//The executable is built with the go toolchain for the current
+//platform, GOOS and GOARCH being honoured.
+//
+//A generic installer can also be built once, and repackaged with
+//new definitions and payloads without the go toolchain, the payload
+//being appended to the executable :
+//
+//	goinstaller -generic -o generic-setup
+//	goinstaller -base generic-setup -definition installer.yml -payload dist -o setup
+//
+//Appended payloads may be signed, the generic installer being built
+//with the public key and refusing payloads it did not sign :
+//
+//	goinstaller -keygen release
+//	goinstaller -generic -public-key release.pub -o generic-setup
+//	goinstaller -base generic-setup -sign release.key -definition installer.yml -payload dist -o setup
+package main
+
+import (
+	"flag"
+	"log"
+)
+
+func main() {
+	log.SetFlags(0)
+	var b builder
+	flag.StringVar(&b.definition, "definition", "installer.yml", "installer definition, YAML or JSON")
+	flag.StringVar(&b.payload, "payload", "payload", "payload directory embedded into the installer")
+	flag.StringVar(&b.output, "o", "installer", "installer executable to build")
+	flag.StringVar(&b.windowTitle, "window-title", "Installer", "title of the installer window")
+	flag.StringVar(&b.installerSrc, "installer-src", "", "local copy of the installer package to build with, instead of the version goinstaller was built with")
+	flag.BoolVar(&b.keep, "keep", false, "keep the generated build directory")
+	flag.BoolVar(&b.generic, "generic", false, "build a generic installer, reading its definition and payload from a payload appended with -base")
+	flag.StringVar(&b.base, "base", "", "generic installer to append the definition and payload to, instead of building one")
+	flag.StringVar(&b.publicKey, "public-key", "", "public key file a generic installer is built with, refusing payloads not signed with its private key")
+	flag.StringVar(&b.sign, "sign", "", "private key file the payload appended with -base is signed with")
+	keyPrefix := flag.String("keygen", "", "write a new key pair to `prefix`.pub and prefix.key, instead of building")
+	flag.Parse()
+	if *keyPrefix != "" {
+		if err := keygen(*keyPrefix); err != nil {
+			log.Fatalf("goinstaller: %v", err)
+		}
+		log.Printf("keys written: %s.pub, %s.key", *keyPrefix, *keyPrefix)
+		return
+	}
+	if err := b.build(); err != nil {
+		log.Fatalf("goinstaller: %v", err)
+	}
+	log.Printf("installer built: %s", b.output)
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"crypto/ed25519"
+	"github.com/audrenbdb/installer"
+	"os"
+	"path/filepath"
+	"text/template"
+)
+
+var genericTemplate = template.Must(template.New("main.go").Parse(`// Code generated by goinstaller. DO NOT EDIT.
+
+package main
+
+import (
+	"bytes"
+	"io/fs"
+	"log"
+	"os"
+
+	"github.com/audrenbdb/installer"
+)
+
+func main() {
+	p, err := installer.OpenPayload()
+	if err != nil {
+		log.Fatal(err)
+	}
+	definition, err := p.ReadFile({{printf "%q" .Definition}})
+	if err != nil {
+		log.Fatal(err)
+	}
+	sub, err := fs.Sub(p, {{printf "%q" .Payload}})
+	if err != nil {
+		log.Fatal(err)
+	}
+	i, err := installer.LoadDefinition(bytes.NewReader(definition), installer.WithPayload(sub))
+	if err != nil {
+		log.Fatal(err)
+	}
+{{- if .PublicKey}}
+	publicKey, err := installer.ParsePublicKey({{printf "%q" .PublicKey}})
+	if err != nil {
+		log.Fatal(err)
+	}
+	i.RequireSignedPayload(p, publicKey)
+{{- end}}
+	if err := i.Run({{printf "%q" .WindowTitle}}, os.Args[1:]); err != nil {
+		log.Fatal(err)
+	}
+}
+`))
+
+//buildGeneric builds an installer reading its definition and
+//payload from a payload appended to it, see pack.
+//Built with a public key, it refuses payloads not signed
+//with the matching private key.
+func (b *builder) buildGeneric() error {
+	var publicKey string
+	if b.publicKey != "" {
+		var err error
+		if publicKey, err = readPublicKey(b.publicKey); err != nil {
+			return err
+		}
+	}
+	dir, err := b.tempDir()
+	if err != nil {
+		return err
+	}
+	if !b.keep {
+		defer os.RemoveAll(dir)
+	}
+	err = b.writeMain(dir, genericTemplate, map[string]interface{}{
+		"Definition":  definitionFile,
+		"Payload":     payloadDir,
+		"WindowTitle": b.windowTitle,
+		"PublicKey":   publicKey,
+	})
+	if err != nil {
+		return err
+	}
+	return b.compile(dir)
+}
+
+//pack copies the generic installer base to the output
+//and appends the definition and the payload to it,
+//signing it if a private key is given.
+//No go toolchain is needed.
+func (b *builder) pack() error {
+	if err := b.validate(); err != nil {
+		return err
+	}
+	var key ed25519.PrivateKey
+	if b.sign != "" {
+		var err error
+		if key, err = readPrivateKey(b.sign); err != nil {
+			return err
+		}
+	}
+	dir, err := b.tempDir()
+	if err != nil {
+		return err
+	}
+	if !b.keep {
+		defer os.RemoveAll(dir)
+	}
+	if _, err := copyFile(b.definition, filepath.Join(dir, definitionFile), 0644); err != nil {
+		return err
+	}
+	if _, err := copyPayload(b.payload, filepath.Join(dir, payloadDir)); err != nil {
+		return err
+	}
+	if _, err := copyFile(b.base, b.output, 0755); err != nil {
+		return err
+	}
+	if err := installer.AppendPayload(b.output, os.DirFS(dir)); err != nil {
+		return err
+	}
+	if key == nil {
+		return nil
+	}
+	return installer.SignPayload(b.output, key)
+}
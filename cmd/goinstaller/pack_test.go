@@ -0,0 +1,48 @@
+package main
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWriteGenericMain(t *testing.T) {
+	publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name      string
+		publicKey string
+	}{
+		{name: "unsigned"},
+		{name: "signed", publicKey: base64.StdEncoding.EncodeToString(publicKey)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &builder{windowTitle: "test", installerSrc: filepath.Join("..", "..")}
+			dir := t.TempDir()
+			err := b.writeMain(dir, genericTemplate, map[string]interface{}{
+				"Definition":  definitionFile,
+				"Payload":     payloadDir,
+				"WindowTitle": b.windowTitle,
+				"PublicKey":   tt.publicKey,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			main, err := ioutil.ReadFile(filepath.Join(dir, "main.go"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if signed := strings.Contains(string(main), "i.RequireSignedPayload(p, publicKey)"); signed != (tt.publicKey != "") {
+				t.Fatalf("payload signature required %v", signed)
+			}
+			vetMain(t, dir)
+		})
+	}
+}
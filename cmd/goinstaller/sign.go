@@ -0,0 +1,52 @@
+package main
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"github.com/audrenbdb/installer"
+	"io/ioutil"
+	"strings"
+)
+
+//keygen writes a new ed25519 key pair as base64 to
+//prefix.pub and prefix.key, the latter to be kept secret.
+func keygen(prefix string) error {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(prefix+".key", []byte(base64.StdEncoding.EncodeToString(privateKey)+"\n"), 0600)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(prefix+".pub", []byte(base64.StdEncoding.EncodeToString(publicKey)+"\n"), 0644)
+}
+
+//readPublicKey reads a public key written by keygen,
+//returned base64 encoded to be compiled into the installer.
+func readPublicKey(file string) (string, error) {
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+	key, err := installer.ParsePublicKey(string(content))
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+//readPrivateKey reads a private key written by keygen.
+func readPrivateKey(file string) (ed25519.PrivateKey, error) {
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(content)))
+	if err != nil || len(key) != ed25519.PrivateKeySize {
+		return nil, errors.New("invalid ed25519 private key " + file)
+	}
+	return ed25519.PrivateKey(key), nil
+}
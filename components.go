@@ -0,0 +1,109 @@
+package installer
+
+import (
+	"fmt"
+	"strings"
+)
+
+//component is an optional part of the product
+//the user may choose to install or not.
+type component struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Selected    bool   `json:"selected"`
+}
+
+//AddComponent adds an optional part of the product, displayed
+//as a checkbox page between the conditions and the installation.
+//
+//Steps added while calling addSteps belong to the component,
+//they only run if the component is selected once installation starts :
+//
+//	i.AddComponent("cli", "CLI tools", "Command-line tools", true, func() {
+//		i.AddStepCopyFiles(binDir, tools)
+//	})
+//
+//defaultOn states if the component is selected by default.
+func (i *installer) AddComponent(id, title, description string, defaultOn bool, addSteps ...func()) {
+	i.components = append(i.components, &component{
+		ID:          id,
+		Title:       title,
+		Description: description,
+		Selected:    defaultOn,
+	})
+	previous := i.currentComponent
+	i.currentComponent = id
+	for _, add := range addSteps {
+		add()
+	}
+	i.currentComponent = previous
+}
+
+//ComponentSelected reports if component id is selected.
+//It may be called by steps to adapt what they do.
+func (i *installer) ComponentSelected(id string) bool {
+	for _, c := range i.components {
+		if c.ID == id {
+			return c.Selected
+		}
+	}
+	return false
+}
+
+//SelectComponents selects listed components only,
+//replacing the default selection.
+func (i *installer) SelectComponents(ids ...string) error {
+	for _, id := range ids {
+		if !i.hasComponent(id) {
+			return fmt.Errorf("unknown component %s", id)
+		}
+	}
+	for _, c := range i.components {
+		c.Selected = containsString(ids, c.ID)
+	}
+	return nil
+}
+
+func (i *installer) hasComponent(id string) bool {
+	for _, c := range i.components {
+		if c.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+//inComponent makes s run only if the component being
+//built is selected.
+func (i *installer) inComponent(s step) step {
+	id := i.currentComponent
+	if id == "" {
+		return s
+	}
+	runIf := s.runIf
+	s.runIf = func() bool {
+		return i.ComponentSelected(id) && (runIf == nil || runIf())
+	}
+	return s
+}
+
+//parseComponents parses a comma separated list of component ids.
+func parseComponents(list string) []string {
+	var ids []string
+	for _, id := range strings.Split(list, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func containsString(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+package installer
+
+import "context"
+
+//RunIf makes a step run only if pred returns true
+//at install time, when the step is reached.
+//Otherwise the step is reported as skipped.
+func RunIf(pred func() bool) StepOption {
+	return func(s *step) {
+		s.runIf = pred
+	}
+}
+
+//Optional makes the failure of a step a warning displayed in
+//the completed steps list rather than a failure of the whole
+//installation.
+func Optional() StepOption {
+	return func(s *step) {
+		s.optional = true
+	}
+}
+
+type stepStatus string
+
+const (
+	statusDone    stepStatus = "done"
+	statusSkipped stepStatus = "skipped"
+	statusWarning stepStatus = "warning"
+)
+
+//stepResult is how a step ended, unless it failed.
+type stepResult struct {
+	Status stepStatus `json:"status"`
+	//Message explains a warning
+	Message string `json:"message,omitempty"`
+}
+
+func (s step) shouldRun() bool {
+	return s.runIf == nil || s.runIf()
+}
+
+//runStep processes s according to its options.
+//A step whose predicate is false is skipped, an optional
+//step failing ends with a warning.
+func runStep(ctx context.Context, s step, progress Progress) (stepResult, error) {
+	if !s.shouldRun() {
+		return stepResult{Status: statusSkipped}, nil
+	}
+	return processOptionalStep(ctx, s, progress)
+}
+
+func processOptionalStep(ctx context.Context, s step, progress Progress) (stepResult, error) {
+	err := processStep(ctx, s, progress)
+	if err != nil && s.optional && err != ErrCancelled {
+		return stepResult{Status: statusWarning, Message: err.Error()}, nil
+	}
+	if err != nil {
+		return stepResult{}, err
+	}
+	return stepResult{Status: statusDone}, nil
+}
@@ -0,0 +1,81 @@
+package installer
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestRunStep(t *testing.T) {
+	failing := func(context.Context, Progress) error { return errors.New("failed") }
+	tests := []struct {
+		name    string
+		process func(context.Context, Progress) error
+		opts    []StepOption
+		want    stepResult
+		wantErr bool
+	}{
+		{name: "done", opts: []StepOption{RunIf(func() bool { return true })}, want: stepResult{Status: statusDone}},
+		{name: "predicate false", process: failing, opts: []StepOption{RunIf(func() bool { return false })}, want: stepResult{Status: statusSkipped}},
+		{name: "optional failing", process: failing, opts: []StepOption{Optional()}, want: stepResult{Status: statusWarning, Message: "failed"}},
+		{name: "optional done", opts: []StepOption{Optional()}, want: stepResult{Status: statusDone}},
+		{name: "failing", process: failing, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := step{process: tt.process}
+			if s.process == nil {
+				s.process = func(context.Context, Progress) error { return nil }
+			}
+			for _, opt := range tt.opts {
+				opt(&s)
+			}
+			got, err := runStep(context.Background(), s, noProgress)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOptionalCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := step{process: func(context.Context, Progress) error {
+		cancel()
+		return errors.New("interrupted")
+	}}
+	Optional()(&s)
+	if _, err := runStep(ctx, s, noProgress); err != ErrCancelled {
+		t.Fatalf("got %v, want %v", err, ErrCancelled)
+	}
+}
+
+func TestSkippedStepsNotRolledBack(t *testing.T) {
+	var calls []string
+	i := New("test")
+	add := func(name string, err error, opts ...StepOption) {
+		i.AddStepWithRollback(func() error {
+			calls = append(calls, "install "+name)
+			return err
+		}, func() error {
+			calls = append(calls, "rollback "+name)
+			return nil
+		}, name, opts...)
+	}
+	add("done", nil)
+	add("predicate false", nil, RunIf(func() bool { return false }))
+	add("optional", errors.New("failed"), Optional())
+	add("failing", errors.New("failed"))
+	var stepErr *StepError
+	if err := i.Run("test", []string{"--silent"}); !errors.As(err, &stepErr) || stepErr.Index != 3 {
+		t.Fatalf("got %v, want step 3 failing", err)
+	}
+	want := []string{"install done", "install optional", "install failing", "rollback done"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Fatalf("got %v, want %v", calls, want)
+	}
+}
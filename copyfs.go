@@ -0,0 +1,143 @@
+package installer
+
+import (
+	"context"
+	"embed"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+//AddStepCopyFS adds a step that copies the tree found at root
+//in fsys into dst, an embed.FS for instance.
+//Subdirectories are created, file contents are streamed
+//and file modes declared in fsys are preserved. Files without
+//a mode get the default file mode, see SetDefaultFileMode.
+//So do files of an embed.FS, declaring every file read only,
+//unless FSWithModes lists them.
+//If fsys is a ChecksumFS, such as a Payload, files are hashed
+//while being written and a mismatch fails the step.
+//Files are written atomically, replaced files are kept
+//aside until installation completes.
+//On rollback, copied files and created directories are removed
+//and replaced files restored.
+func (i *installer) AddStepCopyFS(dst string, fsys fs.FS, root string) {
+	var entries []ManifestEntry
+	process := func(ctx context.Context, progress Progress) error {
+		var err error
+		entries, err = copyFS(ctx, i.expand(dst), fsys, root, i.fileMode, progress)
+		if err != nil {
+			return withRollbackErr(err, revertEntries(entries))
+		}
+		i.manifest.add(entries...)
+		return nil
+	}
+	i.addStep(step{
+		process:     process,
+		undo:        func() error { return revertEntries(entries) },
+		commit:      func() error { return discardBackups(entries) },
+		Description: i.getCopyFilesText(dst),
+	})
+}
+
+//copyFS returns manifest entries of directories created
+//and files copied until an error occurs. Files without a mode
+//and files of an embed.FS get fileMode. It stops once ctx is done.
+func copyFS(ctx context.Context, dst string, fsys fs.FS, root string, fileMode os.FileMode, progress Progress) ([]ManifestEntry, error) {
+	sub, err := fs.Sub(fsys, root)
+	if err != nil {
+		return nil, err
+	}
+	total, err := countFiles(sub)
+	if err != nil {
+		return nil, err
+	}
+	checksum := checksumLookup(fsys, root)
+	modes := fileModes{declared: hasModes(fsys), fallback: fileMode}
+	var entries []ManifestEntry
+	err = fs.WalkDir(sub, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		target := filepath.Join(dst, filepath.FromSlash(name))
+		if d.IsDir() {
+			entry := newDirEntry(target)
+			if err := mkDirAll(target); err != nil {
+				return err
+			}
+			entries = append(entries, entry)
+			return nil
+		}
+		reportItem(progress, len(entries), total, name)
+		entry, err := copyFSFile(ctx, sub, name, target, modes, checksum(name))
+		if err != nil {
+			return err
+		}
+		entries = append(entries, entry)
+		return nil
+	})
+	return entries, err
+}
+
+//hasModes reports if fsys declares modes of its files,
+//which an embed.FS does not.
+func hasModes(fsys fs.FS) bool {
+	switch f := fsys.(type) {
+	case embed.FS, *embed.FS:
+		return false
+	case *checksumFS:
+		return hasModes(f.FS)
+	case *modeFS:
+		return hasModes(f.FS)
+	}
+	return true
+}
+
+//fileModes picks modes of files copied from an fs.FS.
+type fileModes struct {
+	//declared is false if the fs.FS does not declare modes,
+	//only those listed by FSWithModes being kept
+	declared bool
+	//fallback is the mode of files without one
+	fallback os.FileMode
+}
+
+func (m fileModes) of(f fs.File, info fs.FileInfo) os.FileMode {
+	_, listed := f.(*modeFile)
+	mode := info.Mode().Perm()
+	if mode == 0 || !m.declared && !listed {
+		return m.fallback
+	}
+	return mode
+}
+
+//countFiles returns how many files and directories fsys holds.
+func countFiles(fsys fs.FS) (int, error) {
+	count := 0
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		count++
+		return err
+	})
+	return count, err
+}
+
+//copyFSFile copies file name of fsys to target, failing if
+//its content does not match checksum unless it is empty.
+func copyFSFile(ctx context.Context, fsys fs.FS, name, target string, modes fileModes, checksum string) (ManifestEntry, error) {
+	entry := newFileEntry(target)
+	src, err := fsys.Open(name)
+	if err != nil {
+		return entry, err
+	}
+	defer src.Close()
+	info, err := src.Stat()
+	if err != nil {
+		return entry, err
+	}
+	r := &contextReader{ctx: ctx, r: src}
+	entry.Checksum, entry.backup, err = writeVerifiedFile(target, r, modes.of(src, info), checksum)
+	return entry, err
+}
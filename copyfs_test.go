@@ -0,0 +1,101 @@
+package installer
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"embed"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+)
+
+//go:embed testdata/copyfs
+var testEmbedFS embed.FS
+
+func TestCopyFSModes(t *testing.T) {
+	tests := []struct {
+		name string
+		fsys fs.FS
+		want map[string]os.FileMode
+	}{
+		{
+			name: "modes declared",
+			fsys: fstest.MapFS{
+				"testdata/copyfs/bin":  {Data: []byte("bin"), Mode: 0755},
+				"testdata/copyfs/data": {Data: []byte("data"), Mode: 0644},
+			},
+			want: map[string]os.FileMode{"bin": 0755, "data": 0644},
+		},
+		{
+			name: "embed",
+			fsys: testEmbedFS,
+			want: map[string]os.FileMode{"bin": 0640, "data": 0640},
+		},
+		{
+			name: "embed with modes",
+			fsys: FSWithModes(testEmbedFS, map[string]fs.FileMode{"testdata/copyfs/bin": 0755}),
+			want: map[string]os.FileMode{"bin": 0755, "data": 0640},
+		},
+		{
+			name: "embed with checksums",
+			fsys: FSWithChecksums(testEmbedFS, map[string]string{}),
+			want: map[string]os.FileMode{"bin": 0640, "data": 0640},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dst := t.TempDir()
+			if _, err := copyFS(context.Background(), dst, tt.fsys, "testdata/copyfs", 0640, noProgress); err != nil {
+				t.Fatal(err)
+			}
+			for name, want := range tt.want {
+				info, err := os.Stat(filepath.Join(dst, name))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if info.Mode().Perm() != want {
+					t.Errorf("%s has mode %v, want %v", name, info.Mode().Perm(), want)
+				}
+			}
+		})
+	}
+}
+
+func TestSetDefaultFileMode(t *testing.T) {
+	var buf bytes.Buffer
+	w := tar.NewWriter(&buf)
+	if err := w.WriteHeader(&tar.Header{Name: "extracted", Typeflag: tar.TypeReg, Size: 4}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write([]byte("data")); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	src := filepath.Join(t.TempDir(), "archive.tar")
+	if err := ioutil.WriteFile(src, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	dst := t.TempDir()
+	i := New("test")
+	i.SetDefaultFileMode(0600)
+	i.AddStepCopyFS(dst, fstest.MapFS{"app/copied": {Data: []byte("data")}}, "app")
+	i.AddStepExtractArchive(dst, src, ArchiveTar)
+	if err := i.Run("test", []string{"--silent"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"copied", "extracted"} {
+		info, err := os.Stat(filepath.Join(dst, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Mode().Perm() != 0600 {
+			t.Errorf("%s has mode %v, want 0600", name, info.Mode().Perm())
+		}
+	}
+}
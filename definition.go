@@ -0,0 +1,457 @@
+package installer
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gopkg.in/yaml.v2"
+	"io"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//DefinitionOption configures how a definition is loaded.
+type DefinitionOption func(o *definitionOptions)
+
+type definitionOptions struct {
+	payload fs.FS
+	//goos is the system steps are checked against
+	goos string
+}
+
+//WithPayload sets the file system copy steps of a definition
+//read from. It is os.DirFS("."), the working directory of the
+//running installer, by default, which is rarely where files
+//to install are once the installer ships.
+func WithPayload(fsys fs.FS) DefinitionOption {
+	return func(o *definitionOptions) {
+		o.payload = fsys
+	}
+}
+
+//WithTargetOS checks steps of a definition are supported on goos,
+//such as windows or linux, rather than on the running system.
+//Steps of goos the running system does not support are not added,
+//such an installer only being meant to validate the definition
+//before cross compiling it.
+func WithTargetOS(goos string) DefinitionOption {
+	return func(o *definitionOptions) {
+		o.goos = goos
+	}
+}
+
+//platformSteps lists systems supporting step types
+//not supported everywhere.
+var platformSteps = map[string][]string{
+	"createScheme":   {"linux", "windows"},
+	"createShortcut": {"windows"},
+}
+
+//definition is an installer described in a YAML or JSON file.
+type definition struct {
+	Title                 string                `yaml:"title"`
+	Width                 int                   `yaml:"width"`
+	Height                int                   `yaml:"height"`
+	MustReadAllConditions *bool                 `yaml:"mustReadAllConditions"`
+	Pacing                *pacingDefinition     `yaml:"pacing"`
+	FileMode              string                `yaml:"fileMode"`
+	ManifestPath          string                `yaml:"manifestPath"`
+	Version               string                `yaml:"version"`
+	InstallDir            *installDirDefinition `yaml:"installDir"`
+	Conditions            []condition           `yaml:"conditions"`
+	Components            []component           `yaml:"components"`
+	Forms                 []formDefinition      `yaml:"forms"`
+	Steps                 []stepDefinition      `yaml:"steps"`
+	//Texts overrides default texts by their json name, e.g. acceptButton
+	Texts map[string]string `yaml:"texts"`
+}
+
+type pacingDefinition struct {
+	//Mode is none, fixed or minDuration
+	Mode  string        `yaml:"mode"`
+	Delay time.Duration `yaml:"delay"`
+}
+
+type installDirDefinition struct {
+	Default       string `yaml:"default"`
+	RequiredSpace uint64 `yaml:"requiredSpace"`
+}
+
+type formDefinition struct {
+	Title  string            `yaml:"title"`
+	Fields []fieldDefinition `yaml:"fields"`
+}
+
+type fieldDefinition struct {
+	Name     string    `yaml:"name"`
+	Label    string    `yaml:"label"`
+	Type     FieldType `yaml:"type"`
+	Default  string    `yaml:"default"`
+	Options  []string  `yaml:"options"`
+	Required bool      `yaml:"required"`
+	//Pattern is a regular expression values must match
+	Pattern string `yaml:"pattern"`
+	//Message is displayed when a value does not match Pattern
+	Message string `yaml:"message"`
+}
+
+//stepDefinition is a built-in step along with its parameters.
+//Parameters used depend on the step type.
+type stepDefinition struct {
+	//Type is rmkDir, rmvDir, copy, extractArchive, download,
+	//writeTemplate, createScheme, createShortcut or verify
+	Type string `yaml:"type"`
+	//Component the step belongs to, if any
+	Component string `yaml:"component"`
+	//Modes the step runs in, all if empty
+	Modes []InstallMode `yaml:"modes"`
+	Path  string        `yaml:"path"`
+	Src   string        `yaml:"src"`
+	Dst   string        `yaml:"dst"`
+	URL   string        `yaml:"url"`
+	//SHA256 is the checksum of a downloaded file
+	SHA256 string `yaml:"sha256"`
+	//Format is zip, tar, tar.gz or tar.xz
+	Format   string        `yaml:"format"`
+	Template string        `yaml:"template"`
+	Mode     string        `yaml:"mode"`
+	Scheme   string        `yaml:"scheme"`
+	Name     string        `yaml:"name"`
+	Command  string        `yaml:"command"`
+	Timeout  time.Duration `yaml:"timeout"`
+	Retries  int           `yaml:"retries"`
+	Backoff  time.Duration `yaml:"backoff"`
+	Optional bool          `yaml:"optional"`
+}
+
+//LoadDefinition builds an installer from a declarative YAML or JSON
+//definition, so installer content can be maintained without Go code :
+//
+//	title: <h1>Installation of MyApp</h1>
+//	installDir:
+//	  default: /opt/myapp
+//	conditions:
+//	  - title: License
+//	    body: ...
+//	steps:
+//	  - type: copy
+//	    src: bin
+//	    dst: ${INSTALL_DIR}/bin
+//
+//Components are declared with their id, title, description and
+//selected state, steps belonging to them name their component.
+//Sources of copy steps are read from the payload, the working
+//directory unless set, see WithPayload.
+//Unknown keys, step types and missing parameters are reported
+//as errors, so a definition can be validated before it ships.
+//The installer returned may be completed with Go code.
+func LoadDefinition(r io.Reader, opts ...DefinitionOption) (*installer, error) {
+	o := definitionOptions{payload: os.DirFS("."), goos: runtime.GOOS}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	content, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var d definition
+	if err := yaml.UnmarshalStrict(content, &d); err != nil {
+		return nil, fmt.Errorf("invalid definition: %w", err)
+	}
+	i := New(d.Title)
+	if err := i.applyDefinition(d, o); err != nil {
+		return nil, fmt.Errorf("invalid definition: %w", err)
+	}
+	return i, nil
+}
+
+func (i *installer) applyDefinition(d definition, o definitionOptions) error {
+	if d.Width > 0 && d.Height > 0 {
+		i.SetDimensions(d.Width, d.Height)
+	}
+	if d.MustReadAllConditions != nil {
+		i.SetMustReadAllConditions(*d.MustReadAllConditions)
+	}
+	if d.Pacing != nil {
+		pacing, err := d.Pacing.pacing()
+		if err != nil {
+			return err
+		}
+		i.SetStepPacing(pacing)
+	}
+	if d.FileMode != "" {
+		mode, err := parseMode(d.FileMode)
+		if err != nil {
+			return err
+		}
+		i.SetDefaultFileMode(mode)
+	}
+	if d.ManifestPath != "" {
+		i.SetManifestPath(d.ManifestPath)
+	}
+	if d.Version != "" {
+		i.SetVersion(d.Version)
+	}
+	if d.InstallDir != nil {
+		i.SetInstallDir(d.InstallDir.Default, d.InstallDir.RequiredSpace)
+	}
+	for _, c := range d.Conditions {
+		i.AddCondition(c.Title, c.Body)
+	}
+	for _, c := range d.Components {
+		if c.ID == "" || i.hasComponent(c.ID) {
+			return fmt.Errorf("component id %q is empty or duplicated", c.ID)
+		}
+		i.AddComponent(c.ID, c.Title, c.Description, c.Selected)
+	}
+	for _, f := range d.Forms {
+		if err := i.addFormDefinition(f); err != nil {
+			return err
+		}
+	}
+	for index, s := range d.Steps {
+		if err := i.addStepDefinition(s, o); err != nil {
+			return fmt.Errorf("step %d (%s): %w", index, s.Type, err)
+		}
+	}
+	return i.overrideTexts(d.Texts)
+}
+
+func (p pacingDefinition) pacing() (StepPacing, error) {
+	switch p.Mode {
+	case "none":
+		return NoPacing(), nil
+	case "fixed":
+		return FixedPacing(p.Delay), nil
+	case "minDuration":
+		return MinDurationPacing(p.Delay), nil
+	}
+	return StepPacing{}, fmt.Errorf("unknown pacing mode %q", p.Mode)
+}
+
+func (i *installer) addFormDefinition(f formDefinition) error {
+	fields := make([]Field, 0, len(f.Fields))
+	for _, fd := range f.Fields {
+		field, err := fd.field()
+		if err != nil {
+			return err
+		}
+		fields = append(fields, field)
+	}
+	i.AddForm(f.Title, fields...)
+	return nil
+}
+
+func (fd fieldDefinition) field() (Field, error) {
+	if fd.Name == "" {
+		return Field{}, errors.New("field name is required")
+	}
+	switch fd.Type {
+	case FieldText, FieldPassword, FieldCheckbox, FieldNumber:
+	case FieldSelect:
+		if len(fd.Options) == 0 {
+			return Field{}, fmt.Errorf("field %s: options are required", fd.Name)
+		}
+	default:
+		return Field{}, fmt.Errorf("field %s: unknown type %q", fd.Name, fd.Type)
+	}
+	f := Field{
+		Name:     fd.Name,
+		Label:    fd.Label,
+		Type:     fd.Type,
+		Default:  fd.Default,
+		Options:  fd.Options,
+		Required: fd.Required,
+	}
+	if fd.Pattern == "" {
+		return f, nil
+	}
+	pattern, err := regexp.Compile(fd.Pattern)
+	if err != nil {
+		return Field{}, fmt.Errorf("field %s: %w", fd.Name, err)
+	}
+	message := fd.Message
+	if message == "" {
+		message = fmt.Sprintf("%s must match %s", fd.Label, fd.Pattern)
+	}
+	f.Validate = func(value string) error {
+		if !pattern.MatchString(value) {
+			return errors.New(message)
+		}
+		return nil
+	}
+	return f, nil
+}
+
+//addStepDefinition adds the built-in step s describes,
+//along with its options.
+func (i *installer) addStepDefinition(s stepDefinition, o definitionOptions) error {
+	if s.Component != "" && !i.hasComponent(s.Component) {
+		return fmt.Errorf("unknown component %s", s.Component)
+	}
+	for _, mode := range s.Modes {
+		if !containsMode([]InstallMode{ModeFresh, ModeUpgrade, ModeRepair}, mode) {
+			return fmt.Errorf("unknown install mode %s", mode)
+		}
+	}
+	if systems, ok := platformSteps[s.Type]; ok && !containsString(systems, o.goos) {
+		return fmt.Errorf("not supported on %s", o.goos)
+	}
+	//steps of another system are only checked
+	native := o.goos == runtime.GOOS
+	i.currentComponent, i.currentModes = s.Component, s.Modes
+	defer func() { i.currentComponent, i.currentModes = "", nil }()
+	added := len(i.steps)
+	var err error
+	switch s.Type {
+	case "rmkDir":
+		err = requireParams(map[string]string{"path": s.Path})
+		if err == nil {
+			i.AddStepRmkDir(s.Path)
+		}
+	case "rmvDir":
+		err = requireParams(map[string]string{"path": s.Path})
+		if err == nil {
+			i.AddStepRmvDir(s.Path)
+		}
+	case "copy":
+		err = requireParams(map[string]string{"src": s.Src, "dst": s.Dst})
+		if err == nil {
+			err = checkPayloadDir(o.payload, s.Src)
+		}
+		if err == nil {
+			i.AddStepCopyFS(s.Dst, o.payload, s.Src)
+		}
+	case "extractArchive":
+		err = requireParams(map[string]string{"src": s.Src, "dst": s.Dst, "format": s.Format})
+		var format ArchiveFormat
+		if err == nil {
+			format, err = parseArchiveFormat(s.Format)
+		}
+		if err == nil {
+			i.AddStepExtractArchive(s.Dst, s.Src, format)
+		}
+	case "download":
+		err = requireParams(map[string]string{"url": s.URL, "dst": s.Dst})
+		if err == nil {
+			i.AddStepDownload(s.URL, s.Dst, s.SHA256)
+		}
+	case "writeTemplate":
+		err = requireParams(map[string]string{"dst": s.Dst, "template": s.Template})
+		var mode os.FileMode
+		if err == nil && s.Mode != "" {
+			mode, err = parseMode(s.Mode)
+		}
+		t, parseErr := parseTemplate(s.Dst, s.Template)
+		if err == nil {
+			err = parseErr
+		}
+		if err == nil {
+			i.addStepWriteTemplate(s.Dst, t, mode)
+		}
+	case "createScheme":
+		err = requireParams(map[string]string{"scheme": s.Scheme, "command": s.Command})
+		if err == nil && native {
+			i.addStepCreateScheme(s.Scheme, s.Name, s.Command)
+		}
+	case "verify":
+		i.AddStepVerifyInstallation()
+	case "createShortcut":
+		err = requireParams(map[string]string{"src": s.Src, "dst": s.Dst})
+		if err == nil && native {
+			err = i.addStepCreateShortcut(s.Src, s.Dst)
+		}
+	default:
+		err = errors.New("unknown step type")
+	}
+	if err != nil {
+		return err
+	}
+	if len(i.steps) > added {
+		i.applyStepDefinitionOptions(s)
+	}
+	return nil
+}
+
+//applyStepDefinitionOptions sets options of s
+//to the step just added.
+func (i *installer) applyStepDefinitionOptions(s stepDefinition) {
+	last := &i.steps[len(i.steps)-1]
+	if s.Timeout > 0 {
+		WithTimeout(s.Timeout)(last)
+	}
+	if s.Retries > 0 {
+		WithRetry(s.Retries, s.Backoff)(last)
+	}
+	if s.Optional {
+		Optional()(last)
+	}
+}
+
+//requireParams fails naming the first empty parameter.
+func requireParams(params map[string]string) error {
+	for _, name := range []string{"path", "src", "dst", "url", "format", "template", "scheme", "command"} {
+		if value, ok := params[name]; ok && value == "" {
+			return fmt.Errorf("%s is required", name)
+		}
+	}
+	return nil
+}
+
+func checkPayloadDir(payload fs.FS, dir string) error {
+	info, err := fs.Stat(payload, dir)
+	if err != nil {
+		return fmt.Errorf("payload: %w", err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("payload: %s is not a directory", dir)
+	}
+	return nil
+}
+
+func parseArchiveFormat(s string) (ArchiveFormat, error) {
+	switch strings.ToLower(s) {
+	case "zip":
+		return ArchiveZip, nil
+	case "tar":
+		return ArchiveTar, nil
+	case "tar.gz", "tgz":
+		return ArchiveTarGz, nil
+	case "tar.xz", "txz":
+		return ArchiveTarXz, nil
+	}
+	return 0, fmt.Errorf("unknown archive format %s", s)
+}
+
+//parseMode parses an octal file mode such as 0644.
+func parseMode(s string) (os.FileMode, error) {
+	mode, err := strconv.ParseUint(s, 8, 32)
+	if err != nil || mode > 0777 {
+		return 0, fmt.Errorf("invalid file mode %s", s)
+	}
+	return os.FileMode(mode), nil
+}
+
+//overrideTexts replaces texts by their json name.
+func (i *installer) overrideTexts(overrides map[string]string) error {
+	if len(overrides) == 0 {
+		return nil
+	}
+	b, err := json.Marshal(overrides)
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(i.texts); err != nil {
+		return fmt.Errorf("texts: %w", err)
+	}
+	return nil
+}
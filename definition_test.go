@@ -0,0 +1,139 @@
+package installer
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoadDefinitionTargetOS(t *testing.T) {
+	shortcut := `
+title: test
+steps:
+  - type: createShortcut
+    src: app.exe
+    dst: app.lnk
+    retries: 2
+`
+	scheme := `
+title: test
+steps:
+  - type: createScheme
+    scheme: app
+    command: app
+`
+	tests := []struct {
+		name       string
+		definition string
+		goos       string
+		wantErr    bool
+	}{
+		{name: "shortcut on windows", definition: shortcut, goos: "windows"},
+		{name: "shortcut on linux", definition: shortcut, goos: "linux", wantErr: true},
+		{name: "scheme on linux", definition: scheme, goos: "linux"},
+		{name: "scheme on windows", definition: scheme, goos: "windows"},
+		{name: "scheme on darwin", definition: scheme, goos: "darwin", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i, err := LoadDefinition(strings.NewReader(tt.definition), WithTargetOS(tt.goos))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got %v", err)
+			}
+			if err != nil {
+				return
+			}
+			if added := len(i.steps) == 1; added != (tt.goos == runtime.GOOS) {
+				t.Fatalf("step added %v on %s", added, runtime.GOOS)
+			}
+		})
+	}
+}
+
+func TestLoadDefinitionErrors(t *testing.T) {
+	payload := fstest.MapFS{"bin/app": {Data: []byte("app")}}
+	tests := []struct {
+		name       string
+		definition string
+		wantErr    string
+	}{
+		{name: "valid", definition: "title: test\nsteps:\n  - type: copy\n    src: bin\n    dst: /opt/app\n"},
+		{name: "unknown key", definition: "title: test\ntitel: test\n", wantErr: "titel"},
+		{name: "unknown step key", definition: "steps:\n  - type: rmkDir\n    path: /opt/app\n    pth: /opt/app\n", wantErr: "pth"},
+		{name: "unknown step type", definition: "steps:\n  - type: format\n", wantErr: "unknown step type"},
+		{name: "missing parameter", definition: "steps:\n  - type: copy\n    src: bin\n", wantErr: "dst is required"},
+		{name: "missing payload dir", definition: "steps:\n  - type: copy\n    src: lib\n    dst: /opt/app\n", wantErr: "payload"},
+		{name: "unknown archive format", definition: "steps:\n  - type: extractArchive\n    src: a.rar\n    dst: /opt/app\n    format: rar\n", wantErr: "rar"},
+		{name: "unknown component", definition: "steps:\n  - type: rmkDir\n    path: /opt/app\n    component: docs\n", wantErr: "unknown component docs"},
+		{name: "unknown text", definition: "texts:\n  sucess: done\n", wantErr: "sucess"},
+		{name: "unknown field type", definition: "forms:\n  - fields:\n      - name: url\n        type: url\n", wantErr: "unknown type"},
+		{name: "invalid pattern", definition: "forms:\n  - fields:\n      - name: port\n        type: text\n        pattern: \"[\"\n", wantErr: "port"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadDefinition(strings.NewReader(tt.definition), WithPayload(payload))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("got %v, want an error about %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadDefinitionTexts(t *testing.T) {
+	definition := "texts:\n  success: MyApp is installed!\n  acceptButton: I agree\n"
+	i, err := LoadDefinition(strings.NewReader(definition))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if i.texts.Success != "MyApp is installed!" || i.texts.AcceptButton != "I agree" {
+		t.Fatalf("got texts %+v", i.texts)
+	}
+	if i.texts.Fail != i.getInstallationFailText() {
+		t.Fatalf("got fail text %q, want the default one", i.texts.Fail)
+	}
+}
+
+func TestLoadDefinitionPattern(t *testing.T) {
+	definition := `
+forms:
+  - title: server
+    fields:
+      - name: port
+        label: Port
+        type: text
+        pattern: ^[0-9]+$
+        message: port must be a number
+      - name: host
+        label: Host
+        type: text
+        pattern: ^[a-z.]+$
+`
+	i, err := LoadDefinition(strings.NewReader(definition))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		field   int
+		value   string
+		wantErr string
+	}{
+		{field: 0, value: "8080"},
+		{field: 0, value: "http", wantErr: "port must be a number"},
+		{field: 1, value: "example.com"},
+		{field: 1, value: "Example.com", wantErr: "Host must match ^[a-z.]+$"},
+	}
+	for _, tt := range tests {
+		f := i.forms[0].Fields[tt.field]
+		err := i.validateField(f, tt.value)
+		if tt.wantErr == "" && err != nil || tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr) {
+			t.Errorf("%s %q: got %v, want %q", f.Name, tt.value, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package installer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+//errRangeNotSatisfiable is returned when a partial file
+//is already as large as the content to download, or larger.
+var errRangeNotSatisfiable = errors.New("range not satisfiable")
+
+//downloadClient honours proxy environment variables
+//such as HTTPS_PROXY and NO_PROXY.
+var downloadClient = &http.Client{Transport: downloadTransport(http.ProxyFromEnvironment)}
+
+//downloadTransport returns the default transport
+//sending requests through the proxy returned by proxy.
+func downloadTransport(proxy func(*http.Request) (*url.URL, error)) http.RoundTripper {
+	t, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return http.DefaultTransport
+	}
+	t = t.Clone()
+	t.Proxy = proxy
+	return t
+}
+
+//AddStepDownload adds a step downloading url to dst, for content
+//too large to be embedded into the installer. The step fails if
+//the SHA-256 checksum of what was downloaded does not match sha256,
+//hex encoded. It is not checked if sha256 is empty.
+//
+//Content is streamed to a partial file next to dst. If the step
+//is retried, see WithRetry, or the installer run again, the download
+//resumes where it stopped when the server supports HTTP range requests
+//and tells the content did not change since, with an ETag or a
+//Last-Modified header. If what was resumed does not match sha256,
+//everything is downloaded again once.
+//Proxy environment variables are honoured.
+//dst is replaced once the download is verified, the replaced file
+//being kept aside until installation completes.
+//On rollback, dst is removed and the replaced file restored.
+func (i *installer) AddStepDownload(url, dst, sha256 string, opts ...StepOption) {
+	var entries []ManifestEntry
+	process := func(ctx context.Context, progress Progress) error {
+		entry, err := download(ctx, i.expand(url), i.expand(dst), sha256, i.fileMode, progress)
+		if err != nil {
+			return err
+		}
+		entries = []ManifestEntry{entry}
+		i.manifest.add(entry)
+		return nil
+	}
+	i.addStep(step{
+		process:     process,
+		undo:        func() error { return revertEntries(entries) },
+		commit:      func() error { return discardBackups(entries) },
+		Description: i.getDownloadText(url, dst),
+	}, opts...)
+}
+
+//partialFile is where the content of file is downloaded to.
+func partialFile(file string) string {
+	dir, base := filepath.Split(file)
+	return filepath.Join(dir, "."+base+".part")
+}
+
+//validatorFile is where the ETag or Last-Modified header
+//of the content downloaded to partial is kept.
+func validatorFile(partial string) string {
+	return partial + ".validator"
+}
+
+//removePartial removes partial along with its validator.
+func removePartial(partial string) {
+	os.Remove(partial)
+	os.Remove(validatorFile(partial))
+}
+
+//download downloads url to file and returns its manifest entry.
+//A partial file left by a previous attempt is resumed. If the content
+//does not match expected, it is downloaded again from scratch when
+//it was resumed, and the partial file is removed otherwise.
+func download(ctx context.Context, url, file, expected string, mode os.FileMode, progress Progress) (ManifestEntry, error) {
+	partial := partialFile(file)
+	sum, resumed, err := fetchPartial(ctx, url, partial, expected, progress)
+	if err == nil && resumed && !matchesChecksum(sum, expected) {
+		//partial held stale content
+		removePartial(partial)
+		sum, _, err = fetchPartial(ctx, url, partial, expected, progress)
+	}
+	if err != nil {
+		return ManifestEntry{}, err
+	}
+	if !matchesChecksum(sum, expected) {
+		removePartial(partial)
+		return ManifestEntry{}, &ChecksumError{File: url, Expected: expected, Actual: sum}
+	}
+	if err := os.Chmod(partial, mode); err != nil {
+		return ManifestEntry{}, err
+	}
+	entry := newFileEntry(file)
+	backup, err := replaceFile(partial, file)
+	if err != nil {
+		return ManifestEntry{}, err
+	}
+	os.Remove(validatorFile(partial))
+	entry.Checksum, entry.backup = sum, backup
+	return entry, nil
+}
+
+func matchesChecksum(sum, expected string) bool {
+	return expected == "" || strings.EqualFold(sum, expected)
+}
+
+//fetchPartial downloads url to partial and returns the checksum of
+//its content. resumed tells if partial already held some of it.
+func fetchPartial(ctx context.Context, url, partial, expected string, progress Progress) (sum string, resumed bool, err error) {
+	resumed, err = fetch(ctx, url, partial, progress)
+	if err == errRangeNotSatisfiable {
+		//partial is either complete or stale, which only
+		//a checksum tells, else download everything again
+		err = nil
+		if expected == "" {
+			removePartial(partial)
+			resumed, err = fetch(ctx, url, partial, progress)
+		}
+	}
+	if err != nil {
+		return "", false, err
+	}
+	sum, err = checksumFile(partial)
+	return sum, resumed, err
+}
+
+//fetch appends the content of url to partial, requesting
+//only what is missing if partial already holds some of it
+//and the content did not change since, and tells if it did.
+//It returns errRangeNotSatisfiable if nothing is missing
+//or partial does not match the content anymore.
+func fetch(ctx context.Context, url, partial string, progress Progress) (bool, error) {
+	f, err := os.OpenFile(partial, os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+	offset, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return false, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return false, err
+	}
+	if validator, err := ioutil.ReadFile(validatorFile(partial)); err == nil && offset > 0 {
+		//the content is sent in full if it changed
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+		req.Header.Set("If-Range", string(validator))
+	}
+	resp, err := downloadClient.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+	resumed := false
+	switch {
+	case resp.StatusCode == http.StatusPartialContent && resumes(resp, offset):
+		resumed = true
+	case resp.StatusCode == http.StatusOK:
+		//range not supported or content changed, download everything again
+		if err := f.Truncate(0); err != nil {
+			return false, err
+		}
+		if offset, err = f.Seek(0, io.SeekStart); err != nil {
+			return false, err
+		}
+		if err := keepValidator(partial, resp); err != nil {
+			return false, err
+		}
+	case resp.StatusCode == http.StatusRequestedRangeNotSatisfiable && req.Header.Get("Range") != "":
+		return true, errRangeNotSatisfiable
+	default:
+		if resp.StatusCode == http.StatusPartialContent {
+			f.Truncate(0)
+		}
+		return false, fmt.Errorf("download %s: %s", url, resp.Status)
+	}
+	size := int64(-1)
+	if resp.ContentLength >= 0 {
+		size = offset + resp.ContentLength
+	}
+	r := &downloadReader{r: &contextReader{ctx: ctx, r: resp.Body}, read: offset, size: size, progress: progress}
+	if _, err := io.Copy(f, r); err != nil {
+		return resumed, err
+	}
+	return resumed, f.Sync()
+}
+
+//keepValidator keeps what tells if the content of resp changed,
+//so the download to partial is only resumed if it did not.
+//Weak ETags can not tell it.
+func keepValidator(partial string, resp *http.Response) error {
+	validator := resp.Header.Get("ETag")
+	if validator == "" || strings.HasPrefix(validator, "W/") {
+		validator = resp.Header.Get("Last-Modified")
+	}
+	if validator == "" {
+		return rmvFile(validatorFile(partial))
+	}
+	return ioutil.WriteFile(validatorFile(partial), []byte(validator), 0600)
+}
+
+//resumes tells if the partial content of resp
+//starts at offset.
+func resumes(resp *http.Response, offset int64) bool {
+	return strings.HasPrefix(resp.Header.Get("Content-Range"), fmt.Sprintf("bytes %d-", offset))
+}
+
+//downloadReader reports bytes downloaded so far,
+//size being -1 if unknown.
+type downloadReader struct {
+	r        io.Reader
+	read     int64
+	size     int64
+	progress Progress
+}
+
+func (d *downloadReader) Read(b []byte) (int, error) {
+	n, err := d.r.Read(b)
+	d.read += int64(n)
+	if d.size > 0 {
+		d.progress(float64(d.read)/float64(d.size), formatBytes(uint64(d.read))+" / "+formatBytes(uint64(d.size)))
+	} else {
+		d.progress(0, formatBytes(uint64(d.read)))
+	}
+	return n, err
+}
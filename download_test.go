@@ -0,0 +1,132 @@
+package installer
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const downloadContent = "content downloaded from the server"
+
+func checksum(content string) string {
+	h := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(h[:])
+}
+
+func TestDownload(t *testing.T) {
+	serve := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"v1"`)
+		http.ServeContent(w, r, "", time.Time{}, strings.NewReader(downloadContent))
+	}
+	ignoreRange := func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(downloadContent))
+	}
+	tests := []struct {
+		name      string
+		handler   http.HandlerFunc
+		partial   string
+		validator string
+		sha256    string
+		wantRange bool
+		wantErr   bool
+	}{
+		{name: "fresh", handler: serve, sha256: checksum(downloadContent)},
+		{name: "resumed", handler: serve, partial: downloadContent[:10], validator: `"v1"`, sha256: checksum(downloadContent), wantRange: true},
+		{name: "range ignored", handler: ignoreRange, partial: downloadContent[:10], validator: `"v1"`, sha256: checksum(downloadContent), wantRange: true},
+		{name: "already complete", handler: serve, partial: downloadContent, validator: `"v1"`, sha256: checksum(downloadContent), wantRange: true},
+		{name: "content changed", handler: serve, partial: "old content", validator: `"v0"`, wantRange: true},
+		{name: "unknown validator", handler: serve, partial: "old content"},
+		{name: "stale partial without checksum", handler: serve, partial: downloadContent + " which changed", validator: `"v1"`, wantRange: true},
+		{name: "stale partial", handler: serve, partial: downloadContent + " which changed", validator: `"v1"`, sha256: checksum(downloadContent), wantRange: true},
+		{name: "stale resumed partial", handler: serve, partial: "old", validator: `"v1"`, sha256: checksum(downloadContent), wantRange: true},
+		{name: "checksum mismatch", handler: serve, sha256: checksum("other content"), wantErr: true},
+		{name: "resumed checksum mismatch", handler: serve, partial: downloadContent[:10], validator: `"v1"`, sha256: checksum("other content"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ranged := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				ranged = ranged || r.Header.Get("Range") != ""
+				tt.handler(w, r)
+			}))
+			defer server.Close()
+			file := filepath.Join(t.TempDir(), "file")
+			if tt.partial != "" {
+				if err := ioutil.WriteFile(partialFile(file), []byte(tt.partial), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tt.validator != "" {
+				if err := ioutil.WriteFile(validatorFile(partialFile(file)), []byte(tt.validator), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+			_, err := download(context.Background(), server.URL, file, tt.sha256, 0644, func(float64, string) {})
+			if tt.wantErr {
+				var checksumErr *ChecksumError
+				if !errors.As(err, &checksumErr) {
+					t.Fatalf("got %v, want a checksum error", err)
+				}
+				if _, err := os.Stat(partialFile(file)); !os.IsNotExist(err) {
+					t.Fatal("partial file not removed")
+				}
+				if _, err := os.Stat(file); !os.IsNotExist(err) {
+					t.Fatal("file installed despite its checksum")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ranged != tt.wantRange {
+				t.Fatalf("got range requested %v, want %v", ranged, tt.wantRange)
+			}
+			if content, _ := ioutil.ReadFile(file); !bytes.Equal(content, []byte(downloadContent)) {
+				t.Fatalf("got %q, want %q", content, downloadContent)
+			}
+			if _, err := os.Stat(validatorFile(partialFile(file))); !os.IsNotExist(err) {
+				t.Fatal("validator not removed")
+			}
+		})
+	}
+}
+
+func TestDownloadThroughProxy(t *testing.T) {
+	proxied := make(chan string, 1)
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied <- r.URL.String()
+		w.Write([]byte(downloadContent))
+	}))
+	defer proxy.Close()
+	proxyURL, err := url.Parse(proxy.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := downloadClient
+	downloadClient = &http.Client{Transport: downloadTransport(http.ProxyURL(proxyURL))}
+	defer func() { downloadClient = client }()
+	fileURL := "http://download.test/file"
+	file := filepath.Join(t.TempDir(), "file")
+	if _, err := download(context.Background(), fileURL, file, checksum(downloadContent), 0644, func(float64, string) {}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case got := <-proxied:
+		if got != fileURL {
+			t.Fatalf("got %s proxied, want %s", got, fileURL)
+		}
+	default:
+		t.Fatal("proxy not used")
+	}
+}
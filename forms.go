@@ -0,0 +1,204 @@
+package installer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+//FieldType is the kind of input of a form field.
+type FieldType string
+
+const (
+	FieldText     FieldType = "text"
+	FieldPassword FieldType = "password"
+	//FieldCheckbox values are "true" or "false"
+	FieldCheckbox FieldType = "checkbox"
+	//FieldSelect values are one of the field options
+	FieldSelect FieldType = "select"
+	FieldNumber FieldType = "number"
+)
+
+//Field is an input of a form page.
+type Field struct {
+	//Name identifies the value entered by the user, see Value.
+	//It may be used as a path variable, e.g. ${server_url}.
+	Name     string    `json:"name"`
+	Label    string    `json:"label"`
+	Type     FieldType `json:"type"`
+	Default  string    `json:"default"`
+	Options  []string  `json:"options"`
+	Required bool      `json:"required"`
+	//Validate checks the value entered by the user once its
+	//type is checked. It may be nil.
+	Validate func(value string) error `json:"-"`
+}
+
+//form is a page of fields displayed before installation.
+type form struct {
+	Title  string  `json:"title"`
+	Fields []Field `json:"fields"`
+}
+
+//AddForm adds a page of fields the user fills before installation.
+//Forms are displayed in the same order they were added, after
+//components and install directory.
+//
+//Values entered are available to steps with Value, as path
+//variables and in templates, see AddStepWriteTemplate.
+//In unattended mode, they are set with --set name=value.
+func (i *installer) AddForm(title string, fields ...Field) {
+	if i.values == nil {
+		i.values = map[string]string{}
+	}
+	for _, f := range fields {
+		i.values[f.Name] = f.Default
+	}
+	i.forms = append(i.forms, &form{Title: title, Fields: fields})
+}
+
+//Value returns the value of field name, its default
+//one until the user entered another.
+func (i *installer) Value(name string) string {
+	return i.values[name]
+}
+
+//SetValue validates value and sets it to field name.
+//It must be called before the first step is installed.
+func (i *installer) SetValue(name, value string) error {
+	f, ok := i.field(name)
+	if !ok {
+		return fmt.Errorf("unknown field %s", name)
+	}
+	if err := i.validateField(f, value); err != nil {
+		return err
+	}
+	i.values[name] = value
+	return nil
+}
+
+//validateForms checks every value, required ones
+//having been left empty in particular.
+func (i *installer) validateForms() error {
+	for _, form := range i.forms {
+		for _, f := range form.Fields {
+			if err := i.validateField(f, i.values[f.Name]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (i *installer) field(name string) (Field, bool) {
+	for _, form := range i.forms {
+		for _, f := range form.Fields {
+			if f.Name == name {
+				return f, true
+			}
+		}
+	}
+	return Field{}, false
+}
+
+//validateField checks value matches the type of f
+//before calling its own validator.
+func (i *installer) validateField(f Field, value string) error {
+	if value == "" {
+		if f.Required {
+			return errors.New(i.getRequiredFieldText(f.Label))
+		}
+		return nil
+	}
+	switch f.Type {
+	case FieldNumber:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return errors.New(i.getInvalidNumberText(f.Label))
+		}
+	case FieldCheckbox:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return errors.New(i.getInvalidChoiceText(f.Label, "true, false"))
+		}
+	case FieldSelect:
+		if !containsString(f.Options, value) {
+			return errors.New(i.getInvalidChoiceText(f.Label, strings.Join(f.Options, ", ")))
+		}
+	}
+	if f.Validate != nil {
+		return f.Validate(value)
+	}
+	return nil
+}
+
+//displayValue returns value as it may be displayed,
+//passwords being masked.
+func displayValue(f Field, value string) string {
+	if f.Type == FieldPassword && value != "" {
+		return "********"
+	}
+	return value
+}
+
+//vars returns form values by field name along with
+//INSTALL_DIR if an install directory was set.
+func (i *installer) vars() map[string]string {
+	vars := make(map[string]string, len(i.values)+1)
+	for name, value := range i.values {
+		vars[name] = value
+	}
+	if i.installDir != nil {
+		vars[installDirVarName] = i.installDir.Path
+	}
+	return vars
+}
+
+//AddStepWriteTemplate adds a step that renders tmpl, a text/template,
+//to file. Template data holds form values by field name along with
+//INSTALL_DIR :
+//
+//	i.AddStepWriteTemplate(conf, "url: {{.server_url}}\n", 0600)
+//
+//The default file mode is used if mode is 0.
+//The template is parsed when the step is added, so a malformed
+//one panics early. Path variables may be used in file.
+//On rollback, the file is removed or its previous version restored.
+func (i *installer) AddStepWriteTemplate(file, tmpl string, mode os.FileMode) {
+	i.addStepWriteTemplate(file, template.Must(parseTemplate(file, tmpl)), mode)
+}
+
+func parseTemplate(name, tmpl string) (*template.Template, error) {
+	return template.New(name).Option("missingkey=error").Parse(tmpl)
+}
+
+func (i *installer) addStepWriteTemplate(file string, t *template.Template, mode os.FileMode) {
+	var entries []ManifestEntry
+	process := func() error {
+		var content bytes.Buffer
+		if err := t.Execute(&content, i.vars()); err != nil {
+			return err
+		}
+		if mode == 0 {
+			mode = i.fileMode
+		}
+		entry := newFileEntry(i.expand(file))
+		sum, backup, err := writeFile(entry.Path, &content, mode)
+		if err != nil {
+			return err
+		}
+		entry.Checksum, entry.backup = sum, backup
+		entries = []ManifestEntry{entry}
+		i.manifest.add(entries...)
+		return nil
+	}
+	i.addStep(step{
+		process:     func(context.Context, Progress) error { return process() },
+		undo:        func() error { return revertEntries(entries) },
+		commit:      func() error { return discardBackups(entries) },
+		Description: i.getWriteTemplateText(file),
+	})
+}
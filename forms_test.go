@@ -0,0 +1,108 @@
+package installer
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidateField(t *testing.T) {
+	errPort := errors.New("invalid port")
+	port := func(value string) error {
+		if value == "0" {
+			return errPort
+		}
+		return nil
+	}
+	tests := []struct {
+		name    string
+		field   Field
+		value   string
+		wantErr bool
+	}{
+		{name: "text", field: Field{Type: FieldText}, value: "text"},
+		{name: "optional empty", field: Field{Type: FieldNumber}},
+		{name: "required empty", field: Field{Type: FieldText, Required: true}, wantErr: true},
+		{name: "required set", field: Field{Type: FieldText, Required: true}, value: "text"},
+		{name: "number", field: Field{Type: FieldNumber}, value: "1.5"},
+		{name: "not a number", field: Field{Type: FieldNumber}, value: "one", wantErr: true},
+		{name: "checked", field: Field{Type: FieldCheckbox}, value: "true"},
+		{name: "unchecked", field: Field{Type: FieldCheckbox}, value: "false"},
+		{name: "not a checkbox value", field: Field{Type: FieldCheckbox}, value: "yes please", wantErr: true},
+		{name: "option", field: Field{Type: FieldSelect, Options: []string{"dev", "prod"}}, value: "prod"},
+		{name: "not an option", field: Field{Type: FieldSelect, Options: []string{"dev", "prod"}}, value: "test", wantErr: true},
+		{name: "validated", field: Field{Type: FieldNumber, Validate: port}, value: "8080"},
+		{name: "not validated", field: Field{Type: FieldNumber, Validate: port}, value: "0", wantErr: true},
+		{name: "type checked first", field: Field{Type: FieldNumber, Validate: port}, value: "port", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := New("test")
+			tt.field.Name, tt.field.Label = "field", "Field"
+			if err := i.validateField(tt.field, tt.value); (err != nil) != tt.wantErr {
+				t.Fatalf("got %v", err)
+			}
+		})
+	}
+}
+
+func TestAddStepWriteTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		tmpl     string
+		mode     os.FileMode
+		want     string
+		wantMode os.FileMode
+		wantErr  bool
+	}{
+		{name: "values", tmpl: "url: {{.url}}\ndir: {{.INSTALL_DIR}}\n", mode: 0600, want: "url: https://example.com\ndir: ${dir}\n", wantMode: 0600},
+		{name: "default mode", tmpl: "{{.url}}", want: "https://example.com", wantMode: 0644},
+		{name: "missing key", tmpl: "{{.unknown}}", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			i := New("test")
+			i.SetInstallDir(dir, 0)
+			i.AddForm("server", Field{Name: "url", Label: "URL"})
+			i.AddStepWriteTemplate(filepath.Join(InstallDirVar, "conf"), tt.tmpl, tt.mode)
+			err := i.Run("test", []string{"--silent", "--set", "url=https://example.com"})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got %v", err)
+			}
+			file := filepath.Join(dir, "conf")
+			if tt.wantErr {
+				if _, err := os.Stat(file); !os.IsNotExist(err) {
+					t.Fatal("file written despite a missing key")
+				}
+				return
+			}
+			content, err := ioutil.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := strings.Replace(tt.want, "${dir}", dir, 1); string(content) != want {
+				t.Fatalf("got %q, want %q", content, want)
+			}
+			info, err := os.Stat(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if info.Mode().Perm() != tt.wantMode {
+				t.Fatalf("got mode %v, want %v", info.Mode().Perm(), tt.wantMode)
+			}
+		})
+	}
+}
+
+func TestAddStepWriteTemplateMalformed(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("malformed template added")
+		}
+	}()
+	New("test").AddStepWriteTemplate("conf", "{{.url", 0)
+}
@@ -4,6 +4,8 @@ go 1.16
 
 require (
 	github.com/audrenbdb/locale v0.0.0-20210809100034-8dd420b2b811
+	github.com/ulikunitz/xz v0.5.12
 	github.com/wailsapp/wails v1.16.6
 	golang.org/x/sys v0.0.0-20210831042530-f4d43177bf5e
+	gopkg.in/yaml.v2 v2.4.0
 )
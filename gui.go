@@ -1,9 +1,12 @@
 package installer
 
 import (
+	"context"
 	_ "embed"
 	"errors"
 	"github.com/wailsapp/wails"
+	"sync"
+	"time"
 )
 
 //go:embed main.js
@@ -12,16 +15,63 @@ var js string
 //go:embed styles.css
 var css string
 
+//pages.js and pages.css add pages the bundled frontend lacks
+//
+//go:embed pages.js
+var pagesJS string
+
+//go:embed pages.css
+var pagesCSS string
+
 type wailsBind struct {
 	Title      string      `json:"title"`
 	Conditions []condition `json:"conditions"`
 	Steps      []step      `json:"steps"`
 	Texts      *texts      `json:"texts"`
 	MustReadAllConditions bool `json:"mustReadAllConditions"`
+	//Components are displayed as a checkbox page
+	//between conditions and steps
+	Components []*component `json:"components"`
+	//InstallDir is displayed as a directory chooser page
+	//before steps, it is nil unless SetInstallDir was called
+	InstallDir *installDir `json:"installDir"`
+	//Forms are displayed after the install directory page,
+	//Values holds what was entered by field name
+	Forms  []*form           `json:"forms"`
+	Values map[string]string `json:"values"`
+	//PromptOnFailure makes the window ask the user to retry,
+	//skip or abort when a step fails
+	PromptOnFailure bool `json:"promptOnFailure"`
 
+	//installer validates choices made by the user
+	installer *installer
+	//checkErr is the error of checks run before any step,
+	//no step is processed if it is set
+	checkErr error
+
+	//pacing of steps processed with InstallStep
+	pacing StepPacing
 	//completed is set to true when all steps have
 	//been processed successfully
 	completed bool
+	//runtime is provided by wails once the window is ready
+	//and is used to push events to the frontend
+	runtime *wails.Runtime
+
+	//ctx is done once the user cancels the installation
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	//mu guards the state of the installation below
+	mu sync.Mutex
+	//running is true while a step is being processed
+	running bool
+	//done is the number of steps processed successfully or skipped
+	done int
+	//skipped holds indexes of failed steps the user chose to skip
+	skipped map[int]bool
+	//rolledBack is set once completed steps have been reverted
+	rolledBack bool
 }
 
 //OpenWindow open the GUI installer windows.
@@ -29,6 +79,9 @@ type wailsBind struct {
 //to opening the window.
 func (i *installer) OpenWindow(windowTitle string) error {
 	err := i.newWailsApp(windowTitle)
+	if err == nil {
+		err = i.saveManifest()
+	}
 	if err != nil {
 		return err
 	}
@@ -45,6 +98,17 @@ func (i *installer) newWailsApp(title string) error {
 	if err := app.Run(); err != nil {
 		return err
 	}
+	//window may be closed while a step failed,
+	//completed steps must not be left behind
+	if err := bind.rollback(); err != nil {
+		return err
+	}
+	if bind.checkErr != nil {
+		return bind.checkErr
+	}
+	if !bind.completed && bind.ctx.Err() != nil {
+		return ErrCancelled
+	}
 	if !bind.completed {
 		return errors.New("all steps not completed")
 	}
@@ -52,12 +116,26 @@ func (i *installer) newWailsApp(title string) error {
 }
 
 func (i *installer) newWailsBind() *wailsBind {
+	ctx, cancel := context.WithCancel(context.Background())
+	i.describeSteps()
+	checkErr := i.runChecks()
 	return &wailsBind{
-		Title:      i.title,
+		Title:      i.headline(),
 		Conditions: i.conditions,
 		Steps:      i.steps,
 		Texts:      i.texts,
 		MustReadAllConditions: i.mustReadAllConditions,
+		Components:            i.components,
+		InstallDir:            i.installDir,
+		Forms:                 i.forms,
+		PromptOnFailure:       i.promptOnFailure,
+		Values:                i.values,
+		installer:             i,
+		pacing:                i.pacing,
+		ctx:                   ctx,
+		cancel:                cancel,
+		skipped:               map[int]bool{},
+		checkErr:              checkErr,
 	}
 }
 
@@ -67,8 +145,8 @@ func (i *installer) newWailsAppConfig(title string) *wails.AppConfig {
 		Width:     i.width,
 		Height:    i.height,
 		Title:     title,
-		JS:        js,
-		CSS:       css,
+		JS:        pagesJS + js,
+		CSS:       css + pagesCSS,
 		Colour:    "#131313",
 	}
 }
@@ -77,14 +155,199 @@ func (g *wailsBind) Self() *wailsBind {
 	return g
 }
 
-func (g *wailsBind) InstallStep(i int) error {
-	lastIndex := len(g.Steps) - 1
-	err := g.Steps[i].process()
+//WailsInit is called by wails once the frontend is ready.
+func (g *wailsBind) WailsInit(runtime *wails.Runtime) error {
+	g.runtime = runtime
+	return nil
+}
+
+//InstallStep processes step i and returns how it ended :
+//done, skipped because of its RunIf predicate, or ended
+//with a warning if it is optional and failed.
+//
+//The install directory and form values are validated before
+//the first step, none is processed if they are not valid.
+//
+//If the installation has been cancelled, every step completed
+//is rolled back before ErrCancelled is returned to the frontend,
+//no step being processed anymore.
+//
+//If step i fails, a stepFailed event is emitted with its index and
+//error message, and every step completed is rolled back before the
+//error is returned. With SetPromptOnFailure, nothing is rolled back
+//yet : the user may retry by calling InstallStep again, skip the step
+//with SkipStep, or abort the installation with Cancel.
+func (g *wailsBind) InstallStep(i int) (stepResult, error) {
+	if g.ctx.Err() != nil {
+		return stepResult{}, withRollbackErr(ErrCancelled, g.rollback())
+	}
+	err := g.checkErr
+	if i == 0 && err == nil {
+		//the frontend may not have submitted every choice
+		err = g.installer.validateChoices()
+	}
 	if err != nil {
+		g.emit("stepFailed", i, err.Error())
+		return stepResult{}, err
+	}
+	if !g.Steps[i].shouldRun() {
+		result := stepResult{Status: statusSkipped}
+		g.stepDone(i, result, nil)
+		return result, nil
+	}
+	g.setRunning(true)
+	progress := g.progress(i)
+	var result stepResult
+	err = g.pacing.run(g.ctx, func() error {
+		var err error
+		result, err = processOptionalStep(g.ctx, g.Steps[i], progress)
 		return err
+	})
+	g.stepDone(i, result, err)
+	if err == nil && g.ctx.Err() != nil {
+		err = ErrCancelled
 	}
-	if i == lastIndex {
-		g.completed = true
+	if err == ErrCancelled {
+		return result, withRollbackErr(err, g.rollback())
+	}
+	if err != nil {
+		g.emit("stepFailed", i, err.Error())
+		if !g.installer.promptOnFailure {
+			return result, withRollbackErr(err, g.rollback())
+		}
+	}
+	return result, err
+}
+
+//SelectComponents sets which components are installed.
+//It must be called before the first step is installed.
+func (g *wailsBind) SelectComponents(ids []string) {
+	for _, c := range g.Components {
+		c.Selected = containsString(ids, c.ID)
+	}
+}
+
+//BrowseInstallDir opens a dialog to pick a directory
+//and returns it, or an empty string if none was picked.
+func (g *wailsBind) BrowseInstallDir() string {
+	if g.runtime == nil {
+		return ""
+	}
+	return g.runtime.Dialog.SelectDirectory()
+}
+
+//SelectInstallDir validates dir and makes it the install directory.
+//Steps descriptions are updated with it.
+//It must be called before the first step is installed.
+func (g *wailsBind) SelectInstallDir(dir string) error {
+	if err := g.installer.SelectInstallDir(dir); err != nil {
+		return err
 	}
+	//the install record may be in the chosen install dir
+	g.checkErr = g.installer.runChecks()
 	return nil
 }
+
+//SubmitForm validates values entered in form index
+//and sets them. The first invalid value is reported,
+//nothing being set in that case.
+func (g *wailsBind) SubmitForm(index int, values map[string]string) error {
+	for _, f := range g.Forms[index].Fields {
+		if err := g.installer.validateField(f, values[f.Name]); err != nil {
+			return err
+		}
+	}
+	for _, f := range g.Forms[index].Fields {
+		g.installer.values[f.Name] = values[f.Name]
+	}
+	g.installer.describeSteps()
+	return nil
+}
+
+//SkipStep marks failed step i as skipped, installation
+//goes on with the next one. A skipped step is not rolled back.
+func (g *wailsBind) SkipStep(i int) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.skipped[i] = true
+	g.settle(i)
+}
+
+//Cancel stops the installation. The running step is asked to stop,
+//remaining steps are skipped and completed ones are rolled back.
+//It also aborts the installation once a step failed.
+func (g *wailsBind) Cancel() error {
+	g.cancel()
+	g.mu.Lock()
+	idle := !g.running && !g.completed
+	g.mu.Unlock()
+	if !idle {
+		//InstallStep rolls back once the running step returns
+		return nil
+	}
+	return g.rollback()
+}
+
+func (g *wailsBind) setRunning(running bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.running = running
+}
+
+//stepDone updates installation state once step i returned.
+//A step not done has nothing to roll back.
+func (g *wailsBind) stepDone(i int, result stepResult, err error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.running = false
+	if err != nil {
+		return
+	}
+	if result.Status != statusDone {
+		g.skipped[i] = true
+	}
+	g.settle(i)
+}
+
+//settle moves installation past step i, completing
+//it if i is the last one. mu must be held.
+func (g *wailsBind) settle(i int) {
+	g.done = i + 1
+	if g.done == len(g.Steps) && g.ctx.Err() == nil {
+		commitSteps(g.Steps)
+		g.completed = true
+	}
+}
+
+//rollback reverts completed steps, last one first.
+//It only happens once, a rollbackStep event is emitted
+//with the index of each step being reverted.
+func (g *wailsBind) rollback() error {
+	g.mu.Lock()
+	if g.rolledBack || g.completed {
+		g.mu.Unlock()
+		return nil
+	}
+	g.rolledBack = true
+	last := g.done - 1
+	steps := withoutUndo(g.Steps, g.skipped)
+	g.mu.Unlock()
+	return rollbackSteps(steps, last, func(index int) {
+		g.emit("rollbackStep", index)
+	})
+}
+
+//progress returns a Progress emitting a stepProgress event
+//with step index, fraction done and status.
+func (g *wailsBind) progress(index int) Progress {
+	return throttle(func(fraction float64, status string) {
+		g.emit("stepProgress", index, fraction, status)
+	}, 100*time.Millisecond)
+}
+
+func (g *wailsBind) emit(event string, data ...interface{}) {
+	if g.runtime == nil {
+		return
+	}
+	g.runtime.Events.Emit(event, data...)
+}
@@ -0,0 +1,115 @@
+package installer
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestInstallStepCancelled(t *testing.T) {
+	i := New("test")
+	i.SetStepPacing(NoPacing())
+	var calls []string
+	for _, name := range []string{"first", "second"} {
+		name := name
+		i.AddStepWithRollback(func() error {
+			calls = append(calls, "install "+name)
+			return nil
+		}, func() error {
+			calls = append(calls, "rollback "+name)
+			return nil
+		}, name)
+	}
+	g := i.newWailsBind()
+	if _, err := g.InstallStep(0); err != nil {
+		t.Fatal(err)
+	}
+	if err := g.Cancel(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.InstallStep(1); !errors.Is(err, ErrCancelled) {
+		t.Fatalf("got %v, want %v", err, ErrCancelled)
+	}
+	if want := []string{"install first", "rollback first"}; !reflect.DeepEqual(calls, want) {
+		t.Fatalf("got %v, want %v", calls, want)
+	}
+}
+
+func TestInstallStepPromptOnFailure(t *testing.T) {
+	tests := []struct {
+		name      string
+		choose    func(g *wailsBind) error
+		completed bool
+		want      []string
+	}{
+		{
+			name: "retry",
+			choose: func(g *wailsBind) error {
+				_, err := g.InstallStep(1)
+				return err
+			},
+			completed: true,
+			want:      []string{"install first", "install second", "install second"},
+		},
+		{
+			name: "skip",
+			choose: func(g *wailsBind) error {
+				g.SkipStep(1)
+				return nil
+			},
+			completed: true,
+			want:      []string{"install first", "install second"},
+		},
+		{
+			name:   "abort",
+			choose: func(g *wailsBind) error { return g.Cancel() },
+			want:   []string{"install first", "install second", "rollback first"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := New("test")
+			i.SetStepPacing(NoPacing())
+			i.SetPromptOnFailure(true)
+			var calls []string
+			fail := true
+			i.AddStepWithRollback(func() error {
+				calls = append(calls, "install first")
+				return nil
+			}, func() error {
+				calls = append(calls, "rollback first")
+				return nil
+			}, "first")
+			i.AddStepWithRollback(func() error {
+				calls = append(calls, "install second")
+				if fail {
+					fail = false
+					return errors.New("failed")
+				}
+				return nil
+			}, func() error {
+				calls = append(calls, "rollback second")
+				return nil
+			}, "second")
+			g := i.newWailsBind()
+			if !g.PromptOnFailure {
+				t.Fatal("prompt on failure not bound")
+			}
+			if _, err := g.InstallStep(0); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := g.InstallStep(1); err == nil {
+				t.Fatal("got no error")
+			}
+			if err := tt.choose(g); err != nil {
+				t.Fatal(err)
+			}
+			if g.completed != tt.completed {
+				t.Errorf("got completed %v", g.completed)
+			}
+			if !reflect.DeepEqual(calls, tt.want) {
+				t.Errorf("got %v, want %v", calls, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package installer
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+)
+
+//StepError is returned when a step fails during
+//an unattended install.
+type StepError struct {
+	//Index of the failing step, starting at 0
+	Index int
+	Err   error
+}
+
+func (e *StepError) Error() string {
+	return fmt.Sprintf("step %d failed: %v", e.Index, e.Err)
+}
+
+func (e *StepError) Unwrap() error {
+	return e.Err
+}
+
+//Run starts the installer according to command-line args,
+//usually os.Args[1:].
+//
+//Without flags, it opens the installer window just like OpenWindow.
+//When no display is available, or with --tui, conditions and steps
+//are displayed in the terminal instead.
+//
+//With --silent or --accept-conditions, conditions and steps are
+//processed without any GUI. Step descriptions and results are
+//printed to stdout. If a step fails, completed steps are rolled back
+//and a *StepError holding the failing step index is returned, so the
+//caller can exit with a non-zero code.
+//Installers having conditions require --accept-conditions to
+//run unattended. Components to install are listed with
+//--components=id1,id2, default ones are installed otherwise.
+//The install directory is set with --install-dir, the default
+//one is used otherwise. Form values are set with --set name=value,
+//repeated for each field.
+//
+//Choices can be replayed from a JSON or YAML answer file with
+//--answers=file, which implies --silent. Flags override what the
+//file holds. Choices made are saved to an answer file once
+//installation succeeds with --record-answers=file.
+//
+//With --verify, files recorded by a previous installation in the
+//manifest, see SetManifestPath, are checked instead of installing.
+//
+//Outside of the installer window, an interrupt signal (Ctrl+C)
+//cancels the installation.
+func (i *installer) Run(windowTitle string, args []string) error {
+	opts, err := parseRunFlags(args)
+	if err != nil {
+		return err
+	}
+	if opts.verify {
+		return i.verify(os.Stdout)
+	}
+	if !opts.unattended() && !opts.tui && hasDisplay() {
+		if err := i.OpenWindow(windowTitle); err != nil {
+			return err
+		}
+		return i.recordAnswers(opts.recordAnswers)
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	if opts.unattended() {
+		err = i.runHeadless(ctx, os.Stdout, opts)
+	} else {
+		err = i.runTUI(ctx, os.Stdin, os.Stdout)
+	}
+	if err == nil {
+		err = i.saveManifest()
+	}
+	if err == nil {
+		err = i.recordAnswers(opts.recordAnswers)
+	}
+	if i.onClose != nil {
+		i.onClose()
+	}
+	return err
+}
+
+type runOptions struct {
+	silent           bool
+	acceptConditions bool
+	tui              bool
+	components       string
+	installDir       string
+	values           valueFlags
+	answersFile      string
+	recordAnswers    string
+	verify           bool
+}
+
+//unattended reports if the installation runs without any UI.
+func (o runOptions) unattended() bool {
+	return o.silent || o.acceptConditions || o.answersFile != ""
+}
+
+//valueFlags collects form values set with --set name=value.
+type valueFlags map[string]string
+
+func (v valueFlags) String() string {
+	return ""
+}
+
+func (v valueFlags) Set(arg string) error {
+	nameValue := strings.SplitN(arg, "=", 2)
+	if len(nameValue) != 2 {
+		return fmt.Errorf("%s is not in the form name=value", arg)
+	}
+	v[nameValue[0]] = nameValue[1]
+	return nil
+}
+
+func parseRunFlags(args []string) (runOptions, error) {
+	opts := runOptions{values: valueFlags{}}
+	flags := flag.NewFlagSet("installer", flag.ContinueOnError)
+	flags.BoolVar(&opts.silent, "silent", false, "install without GUI")
+	flags.BoolVar(&opts.acceptConditions, "accept-conditions", false, "accept all install conditions")
+	flags.BoolVar(&opts.tui, "tui", false, "display installer in the terminal")
+	flags.StringVar(&opts.components, "components", "", "comma separated ids of components to install")
+	flags.StringVar(&opts.installDir, "install-dir", "", "directory to install into")
+	flags.Var(opts.values, "set", "form value in the form name=value, may be repeated")
+	flags.StringVar(&opts.answersFile, "answers", "", "answer file to install from, without GUI")
+	flags.StringVar(&opts.recordAnswers, "record-answers", "", "answer file to save choices to")
+	flags.BoolVar(&opts.verify, "verify", false, "verify a previous installation instead of installing")
+	return opts, flags.Parse(args)
+}
+
+func (i *installer) runHeadless(ctx context.Context, w io.Writer, opts runOptions) error {
+	answers, err := opts.answers()
+	if err != nil {
+		return err
+	}
+	if err := i.applyAnswers(w, answers); err != nil {
+		return err
+	}
+	if err := i.validateChoices(); err != nil {
+		return err
+	}
+	//the install record may be in the chosen install dir
+	if err := i.runChecks(); err != nil {
+		return err
+	}
+	return i.runStepsHeadless(ctx, w)
+}
+
+//verify checks files recorded in the manifest
+//of a previous installation.
+func (i *installer) verify(w io.Writer) error {
+	if i.manifestPath == "" {
+		return errors.New("no manifest to verify, see SetManifestPath")
+	}
+	if err := VerifyInstallation(i.expand(i.manifestPath)); err != nil {
+		return err
+	}
+	fmt.Fprintln(w, "installation verified")
+	return nil
+}
+
+func (i *installer) runStepsHeadless(ctx context.Context, w io.Writer) error {
+	skipped := map[int]bool{}
+	for index, s := range i.steps {
+		fmt.Fprintf(w, "[%d/%d] %s\n", index+1, len(i.steps), s.Description)
+		result, err := runStep(ctx, s, noProgress)
+		if err != nil {
+			fmt.Fprintf(w, "step %d failed: %v\n", index, err)
+			steps := withoutUndo(i.steps, skipped)
+			rollbackErr := rollbackSteps(steps, index-1, func(index int) {
+				fmt.Fprintf(w, "rolling back step %d\n", index)
+			})
+			return &StepError{Index: index, Err: withRollbackErr(err, rollbackErr)}
+		}
+		switch result.Status {
+		case statusSkipped:
+			skipped[index] = true
+			fmt.Fprintln(w, "skipped")
+		case statusWarning:
+			skipped[index] = true
+			fmt.Fprintf(w, "warning: %s\n", result.Message)
+		default:
+			fmt.Fprintln(w, "ok")
+		}
+	}
+	commitSteps(i.steps)
+	return nil
+}
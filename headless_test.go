@@ -0,0 +1,79 @@
+package installer
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestRunSilentStepFailing(t *testing.T) {
+	for failing := 0; failing < 3; failing++ {
+		t.Run(strconv.Itoa(failing), func(t *testing.T) {
+			errFailed := errors.New("failed")
+			var calls []string
+			i := New("test")
+			for index := 0; index < 3; index++ {
+				name := strconv.Itoa(index)
+				var err error
+				if index == failing {
+					err = errFailed
+				}
+				i.AddStepWithRollback(func() error {
+					calls = append(calls, "install "+name)
+					return err
+				}, func() error {
+					calls = append(calls, "rollback "+name)
+					return nil
+				}, name)
+			}
+			err := i.Run("test", []string{"--silent"})
+			var stepErr *StepError
+			if !errors.As(err, &stepErr) {
+				t.Fatalf("got %v, want a step error", err)
+			}
+			if stepErr.Index != failing || !errors.Is(err, errFailed) {
+				t.Fatalf("got %v, want step %d failing", err, failing)
+			}
+			var want []string
+			for index := 0; index <= failing; index++ {
+				want = append(want, "install "+strconv.Itoa(index))
+			}
+			for index := failing - 1; index >= 0; index-- {
+				want = append(want, "rollback "+strconv.Itoa(index))
+			}
+			if !reflect.DeepEqual(calls, want) {
+				t.Fatalf("got %v, want %v", calls, want)
+			}
+		})
+	}
+}
+
+func TestRunSilentConditions(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr bool
+	}{
+		{name: "not accepted", args: []string{"--silent"}, wantErr: true},
+		{name: "accepted", args: []string{"--silent", "--accept-conditions"}},
+		{name: "accepted alone", args: []string{"--accept-conditions"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := New("test")
+			i.AddCondition("license", "terms")
+			installed := false
+			i.AddStep(func() error {
+				installed = true
+				return nil
+			}, "step")
+			if err := i.Run("test", tt.args); (err != nil) != tt.wantErr {
+				t.Fatalf("got %v", err)
+			}
+			if installed == tt.wantErr {
+				t.Fatalf("got installed %v", installed)
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package installer
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+//InstallDirVar may be used in paths given to built-in steps,
+//just like form values, e.g. ${server_url}.
+//It is replaced by the directory chosen by the user when
+//the step is processed, see SetInstallDir :
+//
+//	i.AddStepCopyFiles(filepath.Join(installer.InstallDirVar, "bin"), files)
+const InstallDirVar = "${INSTALL_DIR}"
+
+const installDirVarName = "INSTALL_DIR"
+
+var pathVarRegexp = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+//installDir is the directory the product is installed into.
+type installDir struct {
+	Default string `json:"default"`
+	Path    string `json:"path"`
+	//RequiredSpace in bytes on the disk holding Path
+	RequiredSpace uint64 `json:"requiredSpace"`
+}
+
+//SetInstallDir adds a page where the user picks the directory
+//the product is installed into, defaultDir being proposed first.
+//
+//The chosen directory must be writable and its disk must have at least
+//requiredSpace bytes available, 0 skipping that check. As the user may
+//pick any directory, an existing one must be empty unless it holds
+//the installation being upgraded or repaired, see SetVersion.
+//It replaces InstallDirVar in paths of built-in steps and may be read
+//by other steps with InstallDir.
+func (i *installer) SetInstallDir(defaultDir string, requiredSpace uint64) {
+	i.installDir = &installDir{
+		Default:       defaultDir,
+		Path:          defaultDir,
+		RequiredSpace: requiredSpace,
+	}
+}
+
+//InstallDir returns the directory chosen by the user,
+//or an empty string if SetInstallDir was not called.
+func (i *installer) InstallDir() string {
+	if i.installDir == nil {
+		return ""
+	}
+	return i.installDir.Path
+}
+
+//SelectInstallDir validates dir and makes it the install directory.
+//It must be called before the first step is installed.
+func (i *installer) SelectInstallDir(dir string) error {
+	if i.installDir == nil {
+		return errors.New("no install directory to select, see SetInstallDir")
+	}
+	dir = filepath.Clean(dir)
+	if err := i.validateInstallDir(dir); err != nil {
+		return err
+	}
+	i.installDir.Path = dir
+	i.describeSteps()
+	return nil
+}
+
+//validateChoices checks the install directory and form values
+//once the user made every choice, before any step runs.
+func (i *installer) validateChoices() error {
+	if i.installDir != nil {
+		if err := i.validateInstallDir(i.installDir.Path); err != nil {
+			return err
+		}
+	}
+	return i.validateForms()
+}
+
+//validateInstallDir checks dir is an absolute path that can be
+//created or written to, on a disk having enough space available.
+func (i *installer) validateInstallDir(dir string) error {
+	if !filepath.IsAbs(dir) {
+		return errors.New(i.getInstallDirNotAbsText(dir))
+	}
+	existing, err := nearestExistingDir(dir)
+	if err != nil {
+		return errors.New(i.getInstallDirNotWritableText(dir))
+	}
+	if !isWritableDir(existing) {
+		return errors.New(i.getInstallDirNotWritableText(dir))
+	}
+	if existing == dir {
+		empty, err := isEmptyDir(dir)
+		if err != nil {
+			return err
+		}
+		if !empty && !i.isInstalledIn(dir) {
+			return errors.New(i.getInstallDirNotEmptyText(dir))
+		}
+	}
+	required := i.installDir.RequiredSpace
+	if required == 0 {
+		return nil
+	}
+	available, err := freeSpace(existing)
+	if err != nil {
+		return err
+	}
+	if available < required {
+		return errors.New(i.getNotEnoughSpaceText(formatBytes(required), formatBytes(available)))
+	}
+	return nil
+}
+
+//isInstalledIn tells if a previous installation recorded
+//files in dir, its manifest path possibly depending on dir.
+func (i *installer) isInstalledIn(dir string) bool {
+	chosen := i.installDir.Path
+	i.installDir.Path = dir
+	m := i.installedManifest()
+	i.installDir.Path = chosen
+	if m == nil {
+		return false
+	}
+	for _, e := range m.Entries {
+		if isInDir(dir, e.Path) {
+			return true
+		}
+	}
+	return false
+}
+
+//nearestExistingDir returns dir or its closest parent
+//that exists. It fails if that path is not a directory.
+func nearestExistingDir(dir string) (string, error) {
+	for {
+		info, err := os.Stat(dir)
+		if err == nil {
+			if !info.IsDir() {
+				return "", fmt.Errorf("%s is not a directory", dir)
+			}
+			return dir, nil
+		}
+		parent := filepath.Dir(dir)
+		if !os.IsNotExist(err) || parent == dir {
+			return "", err
+		}
+		dir = parent
+	}
+}
+
+//isWritableDir reports if a file can be created in dir.
+func isWritableDir(dir string) bool {
+	f, err := os.CreateTemp(dir, ".installer-check-*")
+	if err != nil {
+		return false
+	}
+	f.Close()
+	return os.Remove(f.Name()) == nil
+}
+
+//formatBytes formats n bytes in a human readable way, e.g. 1.5 GB.
+func formatBytes(n uint64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := uint64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+//expand replaces path variables such as InstallDirVar found in s.
+//Unknown variables are left as is.
+func (i *installer) expand(s string) string {
+	return pathVarRegexp.ReplaceAllStringFunc(s, func(v string) string {
+		name := pathVarRegexp.FindStringSubmatch(v)[1]
+		if value, ok := i.lookupVar(name); ok {
+			return value
+		}
+		return v
+	})
+}
+
+func (i *installer) lookupVar(name string) (string, bool) {
+	value, ok := i.vars()[name]
+	return value, ok
+}
+
+//describeSteps expands path variables in step descriptions
+//so the user sees actual paths.
+func (i *installer) describeSteps() {
+	for index := range i.steps {
+		i.steps[index].Description = i.expand(i.steps[index].desc)
+	}
+}
@@ -0,0 +1,136 @@
+package installer
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestValidateInstallDir(t *testing.T) {
+	root := t.TempDir()
+	path := func(name string) string { return filepath.Join(root, name) }
+	for _, dir := range []string{"empty", "not-empty", "installed", "read-only"} {
+		if err := os.Mkdir(path(dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, file := range []string{"file", "not-empty/data", "installed/bin"} {
+		if err := ioutil.WriteFile(path(file), []byte(file), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	m := &Manifest{}
+	m.add(ManifestEntry{Kind: KindFile, Path: path("installed/bin"), Checksum: checksum("installed/bin")})
+	if err := m.save(path("installed/manifest.json")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chmod(path("read-only"), 0555); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chmod(path("read-only"), 0755)
+	tests := []struct {
+		name          string
+		dir           string
+		requiredSpace uint64
+		wantErr       bool
+	}{
+		{name: "relative", dir: "app", wantErr: true},
+		{name: "missing", dir: path("missing/app")},
+		{name: "empty", dir: path("empty")},
+		{name: "not empty", dir: path("not-empty"), wantErr: true},
+		{name: "upgraded", dir: path("installed")},
+		{name: "file", dir: path("file"), wantErr: true},
+		{name: "under a file", dir: path("file/app"), wantErr: true},
+		{name: "not writable", dir: path("read-only/app"), wantErr: true},
+		{name: "enough space", dir: path("missing/app"), requiredSpace: 1},
+		{name: "not enough space", dir: path("missing/app"), requiredSpace: 1 << 62, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.name == "not writable" && isWritableDir(path("read-only")) {
+				t.Skip("permissions not enforced for this user")
+			}
+			i := New("test")
+			i.SetInstallDir(path("empty"), tt.requiredSpace)
+			i.SetManifestPath(filepath.Join(InstallDirVar, "manifest.json"))
+			if err := i.validateInstallDir(tt.dir); (err != nil) != tt.wantErr {
+				t.Fatalf("got %v", err)
+			}
+		})
+	}
+}
+
+func TestNearestExistingDir(t *testing.T) {
+	root := t.TempDir()
+	file := filepath.Join(root, "file")
+	if err := ioutil.WriteFile(file, []byte("file"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		dir     string
+		want    string
+		wantErr bool
+	}{
+		{name: "existing", dir: root, want: root},
+		{name: "missing", dir: filepath.Join(root, "a", "b"), want: root},
+		{name: "file", dir: file, wantErr: true},
+		{name: "under a file", dir: filepath.Join(file, "a"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := nearestExistingDir(tt.dir)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		n    uint64
+		want string
+	}{
+		{n: 0, want: "0 B"},
+		{n: 1023, want: "1023 B"},
+		{n: 1024, want: "1.0 KB"},
+		{n: 1536, want: "1.5 KB"},
+		{n: 5 << 20, want: "5.0 MB"},
+		{n: 3 << 29, want: "1.5 GB"},
+	}
+	for _, tt := range tests {
+		if got := formatBytes(tt.n); got != tt.want {
+			t.Errorf("formatBytes(%d) = %s, want %s", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestExpand(t *testing.T) {
+	i := New("test")
+	i.SetInstallDir("/opt/app", 0)
+	i.AddForm("server", Field{Name: "server_url", Label: "URL"})
+	if err := i.SetValue("server_url", "https://example.com"); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{s: InstallDirVar + "/bin", want: "/opt/app/bin"},
+		{s: "${server_url}/api", want: "https://example.com/api"},
+		{s: "${unknown}", want: "${unknown}"},
+		{s: "$INSTALL_DIR", want: "$INSTALL_DIR"},
+		{s: "${1x}", want: "${1x}"},
+		{s: "no variable", want: "no variable"},
+	}
+	for _, tt := range tests {
+		if got := i.expand(tt.s); got != tt.want {
+			t.Errorf("expand(%s) = %s, want %s", tt.s, got, tt.want)
+		}
+	}
+}
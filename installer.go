@@ -1,13 +1,19 @@
 package installer
 
 import (
+	"bytes"
+	"context"
 	"github.com/audrenbdb/locale"
-	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
 	"time"
 )
 
+//defaultFileMode is the mode of copied files unless
+//specified otherwise.
+const defaultFileMode os.FileMode = 0644
+
 //New creates an installer.
 //Title provided is going to be installer
 //headline inside window GUI. It accepts HTML tags.
@@ -27,6 +33,9 @@ func New(title string) *installer {
 		height: 540,
 		width:  640,
 		mustReadAllConditions: true,
+		pacing:                FixedPacing(2 * time.Second),
+		manifest:              &Manifest{},
+		fileMode:              defaultFileMode,
 	}
 	i.setDefaultTexts()
 	return i
@@ -39,6 +48,20 @@ func (i *installer) SetMustReadAllConditions(r bool) {
 	i.mustReadAllConditions = r
 }
 
+//SetDefaultFileMode sets the mode of files copied by AddStepCopyFiles,
+//and of files without a mode copied by AddStepCopyFileSpecs,
+//AddStepCopyFS or extracted by AddStepExtractArchive.
+//It is 0644 by default.
+func (i *installer) SetDefaultFileMode(mode os.FileMode) {
+	i.fileMode = mode
+}
+
+//SetStepPacing replaces the default pacing of steps,
+//which is a fixed delay of 2 seconds before each of them.
+func (i *installer) SetStepPacing(p StepPacing) {
+	i.pacing = p
+}
+
 //SetOnCloseFunc binds a function to be called once installer
 //closes.
 //
@@ -48,6 +71,13 @@ func (i *installer) SetOnCloseFunc(onClose func()) {
 	i.onClose = onClose
 }
 
+//SetPromptOnFailure makes a failed step wait in the installer window
+//for the user to retry it, skip it or abort the installation, instead
+//of rolling back completed steps right away.
+func (i *installer) SetPromptOnFailure(prompt bool) {
+	i.promptOnFailure = prompt
+}
+
 //SetDimensions replaces default dimensions with custom ones
 func (i *installer) SetDimensions(width, height int) {
 	i.width = width
@@ -70,29 +100,74 @@ func (i *installer) AddCondition(title, body string) {
 //accepts conditions displayed to him.
 //If multiple steps are added, they will be executed in the
 //same order they were added.
-//In order to give end-user sense of progression, an artificial
-//delay of 2 seconds is added.
-func (i *installer) AddStep(process func() error, desc string) {
-	i.steps = append(i.steps, step{
-		process: func() error {
-			time.Sleep(2 * time.Second)
-			return process()
-		},
+//In order to give end-user sense of progression, steps are
+//paced in the installer window, see SetStepPacing.
+//
+//Options may set a retry policy, see WithRetry. A timeout has
+//no effect as process receives no context, see AddStepWithContext.
+func (i *installer) AddStep(process func() error, desc string, opts ...StepOption) {
+	i.AddStepWithRollback(process, nil, desc, opts...)
+}
+
+//AddStepWithRollback adds a new step along with an undo function.
+//
+//If a later step fails, undo functions of every step
+//already completed are called in reverse order so the
+//installer leaves the system as it found it.
+//A nil undo means the step has nothing to revert.
+func (i *installer) AddStepWithRollback(process, undo func() error, desc string, opts ...StepOption) {
+	i.addStep(step{
+		process:     func(context.Context, Progress) error { return process() },
+		undo:        undo,
 		Description: desc,
-	})
+	}, opts...)
+}
+
+func (i *installer) addStep(s step, opts ...StepOption) {
+	for _, opt := range opts {
+		opt(&s)
+	}
+	s.desc = s.Description
+	i.steps = append(i.steps, i.inModes(i.inComponent(s)))
 }
 
 //AddStepRmkDir adds a step that deletes a dir and its child
 //before remaking it.
+//The previous dir is moved aside until installation completes.
+//On rollback, the remade directory is removed and the previous
+//one restored.
 func (i *installer) AddStepRmkDir(dirPath string) {
-	process := func() error { return rmkDir(dirPath) }
-	desc := i.getRmkDirText(dirPath)
-	i.AddStep(process, desc)
+	var backup string
+	process := func(context.Context, Progress) error {
+		entry := newDirEntry(i.expand(dirPath))
+		var err error
+		backup, err = rmkDir(i.expand(dirPath), backup)
+		if err != nil {
+			return err
+		}
+		i.manifest.add(entry)
+		return nil
+	}
+	undo := func() error {
+		if err := rmvDir(i.expand(dirPath)); err != nil {
+			return err
+		}
+		if backup == "" {
+			return nil
+		}
+		return os.Rename(backup, i.expand(dirPath))
+	}
+	i.addStep(step{
+		process:     process,
+		undo:        undo,
+		commit:      func() error { return rmvDir(backup) },
+		Description: i.getRmkDirText(dirPath),
+	})
 }
 
 //AddStepRmvDir adds a step that removes a directory and its child
 func (i *installer) AddStepRmvDir(dirPath string) {
-	process := func() error { return rmvDir(dirPath) }
+	process := func() error { return rmvDir(i.expand(dirPath)) }
 	desc := i.getRmvDirText(dirPath)
 	i.AddStep(process, desc)
 }
@@ -100,47 +175,125 @@ func (i *installer) AddStepRmvDir(dirPath string) {
 //AddStepCopyFiles copy listed files in a given dir
 //Files are in a form of map with key being file name
 //and value being its content in form of byte array
+//Files are written with the default file mode, see SetDefaultFileMode.
+//On rollback, copied files are removed.
 func (i *installer) AddStepCopyFiles(dirPath string, files map[string][]byte) {
-	process := func() error { return copyFiles(dirPath, files) }
-	desc := i.getCopyFilesText(dirPath)
-	i.AddStep(process, desc)
+	specs := make([]FileSpec, 0, len(files))
+	for fileName, content := range files {
+		specs = append(specs, FileSpec{Name: fileName, Content: content})
+	}
+	sort.Slice(specs, func(a, b int) bool { return specs[a].Name < specs[b].Name })
+	i.AddStepCopyFileSpecs(dirPath, specs)
+}
+
+//FileSpec describes a file to copy.
+type FileSpec struct {
+	Name    string
+	Content []byte
+	//Mode of the file once copied, e.g. 0755 for binaries
+	//or 0600 for secrets. The default file mode is used if empty.
+	Mode os.FileMode
+	//SHA256 is the hex encoded checksum Content must match
+	//once written. It is not checked if empty.
+	SHA256 string
+}
+
+//AddStepCopyFileSpecs copy listed files in a given dir,
+//each with its own mode.
+//Files are written atomically, replaced files are kept
+//aside until installation completes.
+//On rollback, copied files are removed and replaced ones restored.
+func (i *installer) AddStepCopyFileSpecs(dirPath string, files []FileSpec) {
+	var entries []ManifestEntry
+	process := func(ctx context.Context, progress Progress) error {
+		var err error
+		entries, err = copyFiles(ctx, i.expand(dirPath), files, i.fileMode, progress)
+		if err != nil {
+			return withRollbackErr(err, revertEntries(entries))
+		}
+		i.manifest.add(entries...)
+		return nil
+	}
+	i.addStep(step{
+		process:     process,
+		undo:        func() error { return revertEntries(entries) },
+		commit:      func() error { return discardBackups(entries) },
+		Description: i.getCopyFilesText(dirPath),
+	})
 }
 
 func mkDirAll(dirPath string) error {
 	return os.MkdirAll(dirPath, os.ModePerm)
 }
 
-func rmkDir(dirPath string) error {
-	if err := rmvDir(dirPath); err != nil {
-		return err
+//rmkDir moves dirPath aside before remaking it and returns
+//where it has been moved. If a previous attempt already moved it
+//to backup, what that attempt created is removed instead.
+func rmkDir(dirPath, backup string) (string, error) {
+	var err error
+	if backup == "" {
+		backup, err = backupFile(dirPath)
+	} else {
+		err = rmvDir(dirPath)
 	}
-	return mkDirAll(dirPath)
+	if err != nil {
+		return backup, err
+	}
+	return backup, mkDirAll(dirPath)
 }
 
 func rmvDir(dirPath string) error {
 	return os.RemoveAll(dirPath)
 }
 
-func copyFiles(dirPath string, files map[string][]byte) error {
-	for fileName, content := range files {
-		file := filepath.Join(dirPath, fileName)
-		if err := copyFile(file, content); err != nil {
-			return err
+//copyFiles returns manifest entries of files copied
+//until an error occurs. Files without mode are written
+//with defaultMode. It stops once ctx is done.
+func copyFiles(ctx context.Context, dirPath string, files []FileSpec, defaultMode os.FileMode, progress Progress) ([]ManifestEntry, error) {
+	var entries []ManifestEntry
+	for index, spec := range files {
+		if err := ctx.Err(); err != nil {
+			return entries, err
+		}
+		reportItem(progress, index, len(files), spec.Name)
+		mode := spec.Mode
+		if mode == 0 {
+			mode = defaultMode
+		}
+		file := filepath.Join(dirPath, spec.Name)
+		entry := newFileEntry(file)
+		sum, backup, err := writeVerifiedFile(file, bytes.NewReader(spec.Content), mode, spec.SHA256)
+		if err != nil {
+			return entries, err
 		}
+		entry.Checksum, entry.backup = sum, backup
+		entries = append(entries, entry)
 	}
-	return nil
-}
-
-func copyFile(file string, content []byte) error {
-	return ioutil.WriteFile(file, content, os.ModePerm)
+	return entries, nil
 }
 
+//copyFile writes content to file, discarding
+//the previous version of file if any.
 func rmvPath(dirPath string) error {
 	return os.RemoveAll(dirPath)
 }
 
 type step struct {
-	process     func() error
+	process func(ctx context.Context, progress Progress) error
+	//undo reverts what process did. It may be nil.
+	undo func() error
+	//commit is called once every step completed,
+	//to discard what was kept for undo. It may be nil.
+	commit func() error
+	//timeout, retries and backoff are set with step options
+	timeout time.Duration
+	retries int
+	backoff time.Duration
+	//runIf and optional are set with step options
+	runIf       func() bool
+	optional    bool
+	//desc is the description before path variables are expanded
+	desc        string
 	Description string `json:"description"`
 }
 
@@ -163,4 +316,39 @@ type installer struct {
 	//mustReadAllConditions states if a user should scroll to
 	//bottom of conditions list
 	mustReadAllConditions bool
+	//pacing of steps in the installer window
+	pacing StepPacing
+	//manifest records what built-in steps created.
+	//It is saved to manifestPath once installation succeeds.
+	manifest     *Manifest
+	manifestPath string
+	//fileMode is the default mode of copied files
+	fileMode os.FileMode
+	//components the user may choose to install
+	components []*component
+	//currentComponent is the id of the component
+	//steps are being added to
+	currentComponent string
+	//installDir chosen by the user, nil unless SetInstallDir was called
+	installDir *installDir
+	//forms the user fills before installation
+	//and values entered by field name
+	forms  []*form
+	values map[string]string
+	//checks must pass before any step runs,
+	//such as RequireSignedPayload
+	checks []func() error
+	//version of the product being installed, and mode
+	//detected from the version already installed
+	version string
+	mode    InstallMode
+	//previous is the manifest of the installation
+	//being upgraded or repaired, if any
+	previous *Manifest
+	//currentModes are the modes steps
+	//are being added for, nil for all
+	currentModes []InstallMode
+	//promptOnFailure states if a failed step waits for the
+	//user to retry, skip or abort in the installer window
+	promptOnFailure bool
 }
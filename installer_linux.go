@@ -1,9 +1,14 @@
 package installer
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"syscall"
 )
 
 const (
@@ -11,17 +16,60 @@ const (
 	xdgMime          = "xdg-mime"
 	xdgSchemeHandler = "x-scheme-handler"
 	desktopExt       = ".desktop"
+	schemeDesktopFmt = `[Desktop Entry]
+Type=Application
+Name=%s
+Exec=%s %%u
+NoDisplay=true
+MimeType=x-scheme-handler/%s;
+`
 )
 
 //AddStepCreateScheme registers a new scheme by
 //copying a customprotocol.desktop file in application dir
 //located in ~/.local/shared/applications.
 //A desktop file contains a link or a bash cmd that will
-//be triggered when scheme is called
-func (i *installer) AddStepCreateScheme(protoc string, content []byte) {
-	process := func() error { return createScheme(protoc, content) }
-	description := i.getRegisterSchemeText(protoc)
-	i.AddStep(process, description)
+//be triggered when scheme is called.
+//As xdg-mime may hang or fail transiently, options may set
+//a timeout or a retry policy, see WithTimeout and WithRetry.
+//A .desktop file already there is kept until installation completes.
+//On rollback, it is restored, or the .desktop file is deleted.
+func (i *installer) AddStepCreateScheme(protoc string, content []byte, opts ...StepOption) {
+	var backup string
+	process := func(ctx context.Context, _ Progress) error {
+		var err error
+		backup, err = createScheme(ctx, protoc, []byte(i.expand(string(content))))
+		if err != nil {
+			return err
+		}
+		i.manifest.add(ManifestEntry{Kind: KindScheme, Path: protoc, Existed: backup != ""})
+		return nil
+	}
+	undo := func() error {
+		path, err := getDotDesktopFilePath(getSchemeDesktopFileName(protoc))
+		if err != nil {
+			return err
+		}
+		return restoreDesktopFile(path, backup)
+	}
+	i.addStep(step{
+		process:     process,
+		undo:        undo,
+		commit:      func() error { return discardBackup(backup) },
+		Description: i.getRegisterSchemeText(protoc),
+	}, opts...)
+}
+
+//addStepCreateScheme registers scheme with a .desktop file
+//named name running command.
+func (i *installer) addStepCreateScheme(scheme, name, command string) {
+	content := fmt.Sprintf(schemeDesktopFmt, name, command, scheme)
+	i.AddStepCreateScheme(scheme, []byte(content))
+}
+
+//addStepCreateShortcut fails as shortcuts are windows ones.
+func (i *installer) addStepCreateShortcut(src, dst string) error {
+	return errors.New("shortcuts are not supported on linux")
 }
 
 func (i *installer) AddStepDeleteScheme(scheme string) {
@@ -30,6 +78,37 @@ func (i *installer) AddStepDeleteScheme(scheme string) {
 	i.AddStep(process, description)
 }
 
+//hasDisplay reports if a graphical session is available
+//to open the installer window.
+func hasDisplay() bool {
+	return os.Getenv("DISPLAY") != "" || os.Getenv("WAYLAND_DISPLAY") != ""
+}
+
+//syncDir flushes dir entries to disk,
+//such as a file just renamed in it.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
+
+//freeSpace returns the number of bytes available
+//to the user on the disk holding dir.
+func freeSpace(dir string) (uint64, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(dir, &stat); err != nil {
+		return 0, err
+	}
+	return stat.Bavail * uint64(stat.Bsize), nil
+}
+
+//addStepDeleteUninstallOpt does nothing as uninstall options
+//are windows registry keys.
+func (i *installer) addStepDeleteUninstallOpt(prog string) {}
+
 func deleteScheme(scheme string) error {
 	return deleteDesktopFile(getSchemeDesktopFileName(scheme))
 }
@@ -62,16 +141,35 @@ func getSchemeDesktopFileName(scheme string) string {
 	return scheme + desktopExt
 }
 
-func createScheme(protoc string, content []byte) error {
-	desktopFile := protoc + desktopExt
+//createScheme writes the .desktop file of protoc and binds protoc
+//to it. A backup of the .desktop file replaced is kept and its path
+//returned, empty if there was none.
+func createScheme(ctx context.Context, protoc string, content []byte) (string, error) {
+	desktopFile := getSchemeDesktopFileName(protoc)
 	if err := mkAllShareAppDirPath(); err != nil {
-		return err
+		return "", err
 	}
-	err := copyDesktopFile(desktopFile, content)
+	path, err := getDotDesktopFilePath(desktopFile)
 	if err != nil {
-		return err
+		return "", err
 	}
-	return runXDGMime(desktopFile, protoc)
+	_, backup, err := writeFile(path, bytes.NewReader(content), defaultFileMode)
+	if err != nil {
+		return "", err
+	}
+	if err := runXDGMime(ctx, desktopFile, protoc); err != nil {
+		return "", withRollbackErr(err, restoreDesktopFile(path, backup))
+	}
+	return backup, nil
+}
+
+//restoreDesktopFile puts back the .desktop file replaced
+//by the one at path, or removes it if there was none.
+func restoreDesktopFile(path, backup string) error {
+	if backup == "" {
+		return rmvFile(path)
+	}
+	return os.Rename(backup, path)
 }
 
 func mkAllShareAppDirPath() error {
@@ -82,18 +180,10 @@ func mkAllShareAppDirPath() error {
 	return mkDirAll(path)
 }
 
-//runXDGMime calls xdg mime app to bind a scheme to a .desktop file
-func runXDGMime(desktopFile, scheme string) error {
+//runXDGMime calls xdg mime app to bind a scheme to a .desktop file.
+//It is killed once ctx is done.
+func runXDGMime(ctx context.Context, desktopFile, scheme string) error {
 	handler := filepath.Join(xdgSchemeHandler, scheme)
-	cmd := exec.Command(xdgMime, "default", desktopFile, handler)
+	cmd := exec.CommandContext(ctx, xdgMime, "default", desktopFile, handler)
 	return cmd.Run()
 }
-
-func copyDesktopFile(file string, content []byte) error {
-	home, err := os.UserHomeDir()
-	if err != nil {
-		return err
-	}
-	filePath := filepath.Join(home, shareAppPath, file)
-	return copyFile(filePath, content)
-}
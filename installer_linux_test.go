@@ -0,0 +1,82 @@
+package installer
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+//setenv sets key to value until t ends.
+func setenv(t *testing.T, key, value string) {
+	previous, set := os.LookupEnv(key)
+	os.Setenv(key, value)
+	t.Cleanup(func() {
+		if set {
+			os.Setenv(key, previous)
+		} else {
+			os.Unsetenv(key)
+		}
+	})
+}
+
+func TestCreateSchemeRollback(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing bool
+		fail     bool
+		want     string
+	}{
+		{name: "new scheme rolled back", fail: true},
+		{name: "existing scheme rolled back", existing: true, fail: true, want: "previous"},
+		{name: "new scheme", want: "content"},
+		{name: "existing scheme", existing: true, want: "content"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			home := t.TempDir()
+			bin := t.TempDir()
+			if err := ioutil.WriteFile(filepath.Join(bin, xdgMime), []byte("#!/bin/sh\n"), 0755); err != nil {
+				t.Fatal(err)
+			}
+			setenv(t, "HOME", home)
+			setenv(t, "PATH", bin)
+			apps := filepath.Join(home, shareAppPath)
+			file := filepath.Join(apps, getSchemeDesktopFileName("app"))
+			if tt.existing {
+				if err := os.MkdirAll(apps, 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := ioutil.WriteFile(file, []byte("previous"), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			i := New("test")
+			i.AddStepCreateScheme("app", []byte("content"))
+			i.AddStep(func() error {
+				if tt.fail {
+					return errors.New("failed")
+				}
+				return nil
+			}, "step")
+			if err := i.Run("test", []string{"--silent"}); (err != nil) != tt.fail {
+				t.Fatalf("got %v", err)
+			}
+			content, err := ioutil.ReadFile(file)
+			if tt.want == "" {
+				if !os.IsNotExist(err) {
+					t.Fatalf("got %q, %v, want no desktop file", content, err)
+				}
+			} else if string(content) != tt.want {
+				t.Fatalf("got %q, %v, want %q", content, err, tt.want)
+			}
+			if files, _ := ioutil.ReadDir(apps); len(files) > 1 {
+				t.Errorf("got %d files, want backups removed", len(files))
+			}
+			if !tt.fail && i.manifest.Entries[0].Existed != tt.existing {
+				t.Errorf("got existed %v, want %v", i.manifest.Entries[0].Existed, tt.existing)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package installer
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRmkDirRollback(t *testing.T) {
+	tests := []struct {
+		name string
+		fail bool
+	}{
+		{name: "rolled back", fail: true},
+		{name: "completed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			dir := filepath.Join(root, "app")
+			if err := os.Mkdir(dir, 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := ioutil.WriteFile(filepath.Join(dir, "data"), []byte("data"), 0644); err != nil {
+				t.Fatal(err)
+			}
+			i := New("test")
+			i.AddStepRmkDir(dir)
+			i.AddStep(func() error {
+				if tt.fail {
+					return errors.New("failed")
+				}
+				return nil
+			}, "step")
+			if err := i.Run("test", []string{"--silent"}); (err != nil) != tt.fail {
+				t.Fatalf("got %v", err)
+			}
+			_, err := os.Stat(filepath.Join(dir, "data"))
+			if restored := err == nil; restored != tt.fail {
+				t.Errorf("data restored %v, want %v", restored, tt.fail)
+			}
+			entries, err := ioutil.ReadDir(root)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(entries) != 1 {
+				t.Errorf("%d entries left next to %s, want 1", len(entries), dir)
+			}
+		})
+	}
+}
+
+func TestRmkDirFailureNotRecorded(t *testing.T) {
+	root := t.TempDir()
+	file := filepath.Join(root, "file")
+	if err := ioutil.WriteFile(file, []byte("file"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	i := New("test")
+	i.AddStepRmkDir(filepath.Join(file, "app"))
+	if err := i.steps[0].process(context.Background(), func(float64, string) {}); err == nil {
+		t.Fatal("got no error")
+	}
+	if len(i.manifest.Entries) != 0 {
+		t.Fatalf("got %v recorded", i.manifest.Entries)
+	}
+}
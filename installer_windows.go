@@ -21,10 +21,22 @@ const (
 	`
 )
 
+//AddStepCreateShortcut adds a step that creates a shortcut
+//of src at dst.
+//On rollback, the shortcut is deleted.
 func (i *installer) AddStepCreateShortcut(src, dst string) {
-	process := func() error { return createShortcut(src, dst) }
+	process := func() error {
+		dst := i.expand(dst)
+		entry := ManifestEntry{Kind: KindShortcut, Path: dst, Existed: pathExists(dst)}
+		if err := createShortcut(i.expand(src), dst); err != nil {
+			return err
+		}
+		i.manifest.add(entry)
+		return nil
+	}
+	undo := func() error { return rmvPath(i.expand(dst)) }
 	description := i.getShortcutCreatingText(src, dst)
-	i.AddStep(process, description)
+	i.AddStepWithRollback(process, undo, description)
 }
 
 //AddStepRmvFolderAfterInstall sets an onClose function to delete
@@ -35,7 +47,7 @@ func (i *installer) AddStepRmvFolderAfterInstall(path string) {
 	process := func() error { return nil }
 	description := i.getRemoveFolderAfterInstallText(path)
 	i.onClose = func() {
-		err := rmvFolderAfterDelay(path)
+		err := rmvFolderAfterDelay(i.expand(path))
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -43,6 +55,30 @@ func (i *installer) AddStepRmvFolderAfterInstall(path string) {
 	i.AddStep(process, description)
 }
 
+//hasDisplay reports if a graphical session is available
+//to open the installer window. It always is on windows.
+func hasDisplay() bool {
+	return true
+}
+
+//syncDir does nothing as directories can not be
+//synced on windows, renames being journaled by NTFS.
+func syncDir(dir string) error {
+	return nil
+}
+
+//freeSpace returns the number of bytes available
+//to the user on the disk holding dir.
+func freeSpace(dir string) (uint64, error) {
+	path, err := windows.UTF16PtrFromString(dir)
+	if err != nil {
+		return 0, err
+	}
+	var available uint64
+	err = windows.GetDiskFreeSpaceEx(path, &available, nil, nil)
+	return available, err
+}
+
 func rmvFolderAfterDelay(path string) error {
 	cmd := fmt.Sprintf("Start-Sleep -s 5; rm -r %s", path)
 	return startHiddenPowerShellCmd(cmd)
@@ -52,12 +88,20 @@ func rmvFolderAfterDelay(path string) error {
 //shellCmd will be executed when scheme is called.
 //Please note that registry keys are user related and not global.
 //FriendlyTypeName is the name displayed to the user when he attempts to open that scheme.
-func (i *installer) AddStepCreateScheme(scheme string, friendlyTypeName string, shellCmd string) {
+//Options may set a retry policy, see WithRetry. A timeout has
+//no effect as registry keys are created without a context.
+//On rollback, scheme registry keys are deleted.
+func (i *installer) AddStepCreateScheme(scheme string, friendlyTypeName string, shellCmd string, opts ...StepOption) {
 	process := func() error {
-		return createScheme(scheme, friendlyTypeName, shellCmd)
+		if err := createScheme(scheme, friendlyTypeName, i.expand(shellCmd)); err != nil {
+			return err
+		}
+		i.manifest.add(ManifestEntry{Kind: KindScheme, Path: scheme})
+		return nil
 	}
+	undo := func() error { return deleteSchemeKey(scheme) }
 	description := i.getRegisterSchemeText(scheme)
-	i.AddStep(process, description)
+	i.AddStepWithRollback(process, undo, description, opts...)
 }
 
 //UninstallOptions is used to create a registry key with optional options provided
@@ -74,12 +118,23 @@ type UninstallOptions struct {
 	KeyName string
 }
 
+//AddStepCreateUninstallOpt adds a step that creates uninstall
+//registry keys for a program.
+//On rollback, those keys are deleted.
 func (i *installer) AddStepCreateUninstallOpt(opts UninstallOptions) {
 	process := func() error {
-		return createUninstallOpt(opts)
+		opts := opts
+		opts.UninstallString = i.expand(opts.UninstallString)
+		opts.DisplayIcon = i.expand(opts.DisplayIcon)
+		if err := createUninstallOpt(opts); err != nil {
+			return err
+		}
+		i.manifest.add(ManifestEntry{Kind: KindUninstallOpt, Path: opts.KeyName})
+		return nil
 	}
+	undo := func() error { return deleteUninstallKey(opts.KeyName) }
 	description := i.getUninstallOptText()
-	i.AddStep(process, description)
+	i.AddStepWithRollback(process, undo, description)
 }
 
 //AddStepDeleteScheme adds a step that deletes scheme association registry keys
@@ -97,6 +152,19 @@ func (i *installer) AddStepDeleteUninstallOpt(prog string) {
 	i.AddStep(process, description)
 }
 
+func (i *installer) addStepDeleteUninstallOpt(prog string) {
+	i.AddStepDeleteUninstallOpt(prog)
+}
+
+func (i *installer) addStepCreateScheme(scheme, name, command string) {
+	i.AddStepCreateScheme(scheme, name, command)
+}
+
+func (i *installer) addStepCreateShortcut(src, dst string) error {
+	i.AddStepCreateShortcut(src, dst)
+	return nil
+}
+
 func createShortcut(src, dst string) error {
 	shellCmd := fmt.Sprintf(createShortcutShellCmdFmt, src, dst)
 	if err := startHiddenPowerShellCmd(shellCmd); err != nil {
@@ -16,6 +16,19 @@ type texts struct {
 	Success        string `json:"success"`
 	CompletedSteps string `json:"completedSteps"`
 	ReadAllConditionsTooltip string `json:"readAllConditionsTooltip"`
+	Rollback                 string `json:"rollback"`
+	Cancel                   string `json:"cancel"`
+	Cancelled                string `json:"cancelled"`
+	Retry                    string `json:"retry"`
+	Skip                     string `json:"skip"`
+	Abort                    string `json:"abort"`
+	StepFailed               string `json:"stepFailed"`
+	Skipped                  string `json:"skipped"`
+	Warning                  string `json:"warning"`
+	InstallDir               string `json:"installDir"`
+	Browse                   string `json:"browse"`
+	Next                     string `json:"next"`
+	Components               string `json:"components"`
 }
 
 func (i *installer) setDefaultTexts() {
@@ -25,6 +38,19 @@ func (i *installer) setDefaultTexts() {
 		Success:        i.getInstallationSuccessText(),
 		CompletedSteps: i.getCompletedStepsText(),
 		ReadAllConditionsTooltip: i.getReadAllConditionsToolTip(),
+		Rollback:                 i.getRollbackText(),
+		Cancel:                   i.getCancelButtonText(),
+		Cancelled:                i.getCancelledText(),
+		Retry:                    i.getRetryButtonText(),
+		Skip:                     i.getSkipButtonText(),
+		Abort:                    i.getAbortButtonText(),
+		StepFailed:               i.getStepFailedText(),
+		Skipped:                  i.getSkippedStepText(),
+		Warning:                  i.getWarningStepText(),
+		InstallDir:               i.getInstallDirText(),
+		Browse:                   i.getBrowseButtonText(),
+		Next:                     i.getNextButtonText(),
+		Components:               i.getComponentsText(),
 	}
 }
 
@@ -219,4 +245,384 @@ func (i *installer) getReadAllConditionsToolTip() string {
 	default:
 		return "You must have scrolled through all of the conditions to continue"
 	}
-}
\ No newline at end of file
+}
+
+func (i *installer) getRollbackText() string {
+	switch i.lang {
+	case fr:
+		return "Annulation des étapes déjà réalisées."
+	case vi:
+		return "Đang hoàn tác các bước đã hoàn thành."
+	default:
+		return "Reverting completed steps."
+	}
+}
+
+func (i *installer) getUninstallerTitleText() string {
+	switch i.lang {
+	case fr:
+		return "Désinstallation"
+	case vi:
+		return "Gỡ cài đặt"
+	default:
+		return "Uninstallation"
+	}
+}
+
+func (i *installer) getDeleteFileText(file string) string {
+	var msg string
+	switch i.lang {
+	case fr:
+		msg = "Suppression du fichier %s."
+	case vi:
+		msg = "Xóa tệp %s."
+	default:
+		msg = "Deleting file %s."
+	}
+	return fmt.Sprintf(msg, file)
+}
+
+func (i *installer) getRemoveManifestText() string {
+	switch i.lang {
+	case fr:
+		return "Suppression du registre d'installation."
+	case vi:
+		return "Xóa bản ghi cài đặt."
+	default:
+		return "Removing install record."
+	}
+}
+
+func (i *installer) getExtractArchiveText(src, dst string) string {
+	var msg string
+	switch i.lang {
+	case fr:
+		msg = "L'archive %s va être extraite ici : %s."
+	case vi:
+		msg = "Tệp nén %s sẽ được giải nén tại đây: %s."
+	default:
+		msg = "Archive %s is going to be extracted here : %s."
+	}
+	return fmt.Sprintf(msg, src, dst)
+}
+
+func (i *installer) getDownloadText(url, dst string) string {
+	var msg string
+	switch i.lang {
+	case fr:
+		msg = "%s va être téléchargé ici : %s."
+	case vi:
+		msg = "%s sẽ được tải xuống tại đây: %s."
+	default:
+		msg = "%s is going to be downloaded here : %s."
+	}
+	return fmt.Sprintf(msg, url, dst)
+}
+
+func (i *installer) getCancelButtonText() string {
+	switch i.lang {
+	case fr:
+		return "Annuler"
+	case vi:
+		return "Hủy"
+	default:
+		return "Cancel"
+	}
+}
+
+func (i *installer) getCancelledText() string {
+	switch i.lang {
+	case fr:
+		return "L'installation a été annulée, les étapes réalisées ont été annulées."
+	case vi:
+		return "Quá trình cài đặt đã bị hủy, các bước đã hoàn thành đã được hoàn tác."
+	default:
+		return "Installation was cancelled, completed steps have been reverted."
+	}
+}
+
+func (i *installer) getRetryButtonText() string {
+	switch i.lang {
+	case fr:
+		return "Réessayer"
+	case vi:
+		return "Thử lại"
+	default:
+		return "Retry"
+	}
+}
+
+func (i *installer) getSkipButtonText() string {
+	switch i.lang {
+	case fr:
+		return "Ignorer"
+	case vi:
+		return "Bỏ qua"
+	default:
+		return "Skip"
+	}
+}
+
+func (i *installer) getAbortButtonText() string {
+	switch i.lang {
+	case fr:
+		return "Abandonner"
+	case vi:
+		return "Hủy bỏ"
+	default:
+		return "Abort"
+	}
+}
+
+func (i *installer) getStepFailedText() string {
+	switch i.lang {
+	case fr:
+		return "Cette étape a échoué :"
+	case vi:
+		return "Bước này đã thất bại:"
+	default:
+		return "This step failed:"
+	}
+}
+
+func (i *installer) getSkippedStepText() string {
+	switch i.lang {
+	case fr:
+		return "Étape ignorée"
+	case vi:
+		return "Bước đã bỏ qua"
+	default:
+		return "Step skipped"
+	}
+}
+
+func (i *installer) getWarningStepText() string {
+	switch i.lang {
+	case fr:
+		return "Étape facultative échouée"
+	case vi:
+		return "Bước tùy chọn không thành công"
+	default:
+		return "Optional step failed"
+	}
+}
+
+func (i *installer) getInstallDirText() string {
+	switch i.lang {
+	case fr:
+		return "Dossier d'installation"
+	case vi:
+		return "Thư mục cài đặt"
+	default:
+		return "Installation directory"
+	}
+}
+
+func (i *installer) getBrowseButtonText() string {
+	switch i.lang {
+	case fr:
+		return "Parcourir..."
+	case vi:
+		return "Duyệt..."
+	default:
+		return "Browse..."
+	}
+}
+
+func (i *installer) getNextButtonText() string {
+	switch i.lang {
+	case fr:
+		return "Suivant"
+	case vi:
+		return "Tiếp theo"
+	default:
+		return "Next"
+	}
+}
+
+func (i *installer) getComponentsText() string {
+	switch i.lang {
+	case fr:
+		return "Composants à installer"
+	case vi:
+		return "Các thành phần cần cài đặt"
+	default:
+		return "Components to install"
+	}
+}
+
+func (i *installer) getInstallDirNotAbsText(dir string) string {
+	var msg string
+	switch i.lang {
+	case fr:
+		msg = "Le dossier %s doit être un chemin absolu."
+	case vi:
+		msg = "Thư mục %s phải là đường dẫn tuyệt đối."
+	default:
+		msg = "Directory %s must be an absolute path."
+	}
+	return fmt.Sprintf(msg, dir)
+}
+
+func (i *installer) getInstallDirNotEmptyText(dir string) string {
+	var msg string
+	switch i.lang {
+	case fr:
+		msg = "Le dossier %s n'est pas vide."
+	case vi:
+		msg = "Thư mục %s không trống."
+	default:
+		msg = "Directory %s is not empty."
+	}
+	return fmt.Sprintf(msg, dir)
+}
+
+func (i *installer) getInstallDirNotWritableText(dir string) string {
+	var msg string
+	switch i.lang {
+	case fr:
+		msg = "Impossible d'écrire dans le dossier %s."
+	case vi:
+		msg = "Không thể ghi vào thư mục %s."
+	default:
+		msg = "Directory %s is not writable."
+	}
+	return fmt.Sprintf(msg, dir)
+}
+
+func (i *installer) getNotEnoughSpaceText(required, available string) string {
+	var msg string
+	switch i.lang {
+	case fr:
+		msg = "Espace disque insuffisant : %s requis, %s disponible."
+	case vi:
+		msg = "Không đủ dung lượng đĩa: cần %s, còn trống %s."
+	default:
+		msg = "Not enough disk space: %s required, %s available."
+	}
+	return fmt.Sprintf(msg, required, available)
+}
+
+func (i *installer) getRequiredFieldText(label string) string {
+	var msg string
+	switch i.lang {
+	case fr:
+		msg = "Le champ %s est obligatoire."
+	case vi:
+		msg = "Trường %s là bắt buộc."
+	default:
+		msg = "Field %s is required."
+	}
+	return fmt.Sprintf(msg, label)
+}
+
+func (i *installer) getInvalidNumberText(label string) string {
+	var msg string
+	switch i.lang {
+	case fr:
+		msg = "Le champ %s doit être un nombre."
+	case vi:
+		msg = "Trường %s phải là một số."
+	default:
+		msg = "Field %s must be a number."
+	}
+	return fmt.Sprintf(msg, label)
+}
+
+func (i *installer) getInvalidChoiceText(label, choices string) string {
+	var msg string
+	switch i.lang {
+	case fr:
+		msg = "Le champ %s doit valoir : %s."
+	case vi:
+		msg = "Trường %s phải là một trong: %s."
+	default:
+		msg = "Field %s must be one of : %s."
+	}
+	return fmt.Sprintf(msg, label, choices)
+}
+
+func (i *installer) getWriteTemplateText(file string) string {
+	var msg string
+	switch i.lang {
+	case fr:
+		msg = "Écriture du fichier de configuration %s."
+	case vi:
+		msg = "Đang ghi tệp cấu hình %s."
+	default:
+		msg = "Writing configuration file %s."
+	}
+	return fmt.Sprintf(msg, file)
+}
+
+func (i *installer) getVerifyInstallationText() string {
+	switch i.lang {
+	case fr:
+		return "Vérification des fichiers installés."
+	case vi:
+		return "Đang kiểm tra các tệp đã cài đặt."
+	default:
+		return "Verifying installed files."
+	}
+}
+
+func (i *installer) getUpgradeText(installed, version string) string {
+	var msg string
+	switch i.lang {
+	case fr:
+		msg = "La version %s installée va être mise à jour vers la version %s."
+	case vi:
+		msg = "Phiên bản %s đã cài đặt sẽ được nâng cấp lên phiên bản %s."
+	default:
+		msg = "Installed version %s is going to be upgraded to version %s."
+	}
+	return fmt.Sprintf(msg, installed, version)
+}
+
+func (i *installer) getRepairText(version string) string {
+	var msg string
+	switch i.lang {
+	case fr:
+		msg = "La version %s est déjà installée et va être réparée."
+	case vi:
+		msg = "Phiên bản %s đã được cài đặt và sẽ được sửa chữa."
+	default:
+		msg = "Version %s is already installed and is going to be repaired."
+	}
+	return fmt.Sprintf(msg, version)
+}
+
+func (i *installer) getDowngradeText(installed, version string) string {
+	var msg string
+	switch i.lang {
+	case fr:
+		msg = "La version %s installée est plus récente que la version %s, l'installation est refusée."
+	case vi:
+		msg = "Phiên bản %s đã cài đặt mới hơn phiên bản %s, quá trình cài đặt bị từ chối."
+	default:
+		msg = "Installed version %s is newer than version %s, installation refused."
+	}
+	return fmt.Sprintf(msg, installed, version)
+}
+
+func (i *installer) getPayloadNotSignedText() string {
+	switch i.lang {
+	case fr:
+		return "Le contenu de l'installateur n'est pas signé, l'installation est refusée."
+	case vi:
+		return "Nội dung của trình cài đặt chưa được ký, quá trình cài đặt bị từ chối."
+	default:
+		return "The installer content is not signed, installation refused."
+	}
+}
+
+func (i *installer) getInvalidSignatureText() string {
+	switch i.lang {
+	case fr:
+		return "La signature du contenu de l'installateur est invalide, l'installation est refusée."
+	case vi:
+		return "Chữ ký nội dung của trình cài đặt không hợp lệ, quá trình cài đặt bị từ chối."
+	default:
+		return "The installer content signature is invalid, installation refused."
+	}
+}
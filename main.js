@@ -1 +1 @@
-!function(t){var e={};function n(r){if(e[r])return e[r].exports;var o=e[r]={i:r,l:!1,exports:{}};return t[r].call(o.exports,o,o.exports,n),o.l=!0,o.exports}n.m=t,n.c=e,n.d=function(t,e,r){n.o(t,e)||Object.defineProperty(t,e,{enumerable:!0,get:r})},n.r=function(t){"undefined"!=typeof Symbol&&Symbol.toStringTag&&Object.defineProperty(t,Symbol.toStringTag,{value:"Module"}),Object.defineProperty(t,"__esModule",{value:!0})},n.t=function(t,e){if(1&e&&(t=n(t)),8&e)return t;if(4&e&&"object"==typeof t&&t&&t.__esModule)return t;var r=Object.create(null);if(n.r(r),Object.defineProperty(r,"default",{enumerable:!0,value:t}),2&e&&"string"!=typeof t)for(var o in t)n.d(r,o,(function(e){return t[e]}).bind(null,o));return r},n.n=function(t){var e=t&&t.__esModule?function(){return t.default}:function(){return t};return n.d(e,"a",e),e},n.o=function(t,e){return Object.prototype.hasOwnProperty.call(t,e)},n.p="",n(n.s=0)}({"+2oP":function(t,e,n){"use strict";var r=n("I+eb"),o=n("hh1v"),i=n("6LWA"),a=n("I8vh"),s=n("UMSQ"),u=n("/GqU"),c=n("hBjN"),l=n("tiKp"),f=n("Hd5f")("slice"),p=l("species"),h=[].slice,d=Math.max;r({target:"Array",proto:!0,forced:!f},{slice:function(t,e){var n,r,l,f=u(this),y=s(f.length),v=a(t,y),g=a(void 0===e?y:e,y);if(i(f)&&("function"!=typeof(n=f.constructor)||n!==Array&&!i(n.prototype)?o(n)&&null===(n=n[p])&&(n=void 0):n=void 0,n===Array||void 0===n))return h.call(f,v,g);for(r=new(void 0===n?Array:n)(d(g-v,0)),l=0;v<g;v++,l++)v in f&&c(r,l,f[v]);return r.length=l,r}})},"+M1K":function(t,e,n){var r=n("ppGB");t.exports=function(t){var e=r(t);if(e<0)throw RangeError("The argument can't be less than 0");return e}},"+MnM":function(t,e,n){var r=n("I+eb"),o=n("2oRo"),i=n("1E5z");r({global:!0},{Reflect:{}}),i(o.Reflect,"Reflect",!0)},"+ywr":function(t,e,n){n("dOgj")("Uint32",function(t){return function(e,n,r){return t(this,e,n,r)}})},"/5zm":function(t,e,n){var r=n("I+eb"),o=n("jrUv"),i=Math.cosh,a=Math.abs,s=Math.E;r({target:"Math",stat:!0,forced:!i||i(710)===1/0},{cosh:function(t){var e=o(a(t)-1)+1;return(e+1/(e*s*s))*(s/2)}})},"/GqU":function(t,e,n){var r=n("RK3t"),o=n("HYAF");t.exports=function(t){return r(o(t))}},"/OPJ":function(t,e,n){var r=n("0Dky"),o=n("2oRo").RegExp;t.exports=r(function(){var t=o(".","s");return!(t.dotAll&&t.exec("\n")&&"s"===t.flags)})},"/Yfv":function(t,e,n){n("dOgj")("Int8",function(t){return function(e,n,r){return t(this,e,n,r)}})},"/b8u":function(t,e,n){var r=n("STAE");t.exports=r&&!Symbol.sham&&"symbol"==typeof Symbol.iterator},"/byt":function(t,e){t.exports={CSSRuleList:0,CSSStyleDeclaration:0,CSSValueList:0,ClientRectList:0,DOMRectList:0,DOMStringList:0,DOMTokenList:1,DataTransferItemList:0,FileList:0,HTMLAllCollection:0,HTMLCollection:0,HTMLFormElement:0,HTMLSelectElement:0,MediaList:0,MimeTypeArray:0,NamedNodeMap:0,NodeList:1,PaintRequestList:0,Plugin:0,PluginArray:0,SVGLengthList:0,SVGNumberList:0,SVGPathSegList:0,SVGPointList:0,SVGStringList:0,SVGTransformList:0,SourceBufferList:0,StyleSheetList:0,TextTrackCueList:0,TextTrackList:0,TouchList:0}},"/qmn":function(t,e,n){var r=n("2oRo");t.exports=r.Promise},0:function(t,e,n){t.exports=n("zUnb")},"07d7":function(t,e,n){var r=n("AO7/"),o=n("busE"),i=n("sEFX");r||o(Object.prototype,"toString",i,{unsafe:!0})},"0BK2":function(t,e){t.exports={}},"0Dky":function(t,e){t.exports=function(t){try{return!!t()}catch(e){return!0}}},"0GbY":function(t,e,n){var r=n("2oRo");t.exports=function(t,e){return arguments.length<2?"function"==typeof(n=r[t])?n:void 0:r[t]&&r[t][e];var n}},"0TWp":function(t,e,n){!function(){"use strict";!function(t){var e=t.performance;function n(t){e&&e.mark&&e.mark(t)}function r(t,n){e&&e.measure&&e.measure(t,n)}n("Zone");var o=!0===t.__zone_symbol__forceDuplicateZoneCheck;if(t.Zone){if(o||"function"!=typeof t.Zone.__symbol__)throw new Error("Zone already loaded.");return t.Zone}var i,a=function(){function e(t,e){this._parent=t,this._name=e?e.name||"unnamed":"<root>",this._properties=e&&e.properties||{},this._zoneDelegate=new u(this,this._parent&&this._parent._zoneDelegate,e)}return e.assertZonePatched=function(){if(t.Promise!==A.ZoneAwarePromise)throw new Error("Zone.js has detected that ZoneAwarePromise `(window|global).Promise` has been overwritten.\nMost likely cause is that a Promise polyfill has been loaded after Zone.js (Polyfilling Promise api is not necessary when zone.js is loaded. If you must load one, do so before loading zone.js.)")},Object.defineProperty(e,"root",{get:function(){for(var t=e.current;t.parent;)t=t.parent;return t},enumerable:!0,configurable:!0}),Object.defineProperty(e,"current",{get:function(){return C.zone},enumerable:!0,configurable:!0}),Object.defineProperty(e,"currentTask",{get:function(){return O},enumerable:!0,configurable:!0}),e.__load_patch=function(i,a){if(A.hasOwnProperty(i)){if(o)throw Error("Already loaded patch: "+i)}else if(!t["__Zone_disable_"+i]){var s="Zone:"+i;n(s),A[i]=a(t,e,I),r(s,s)}},Object.defineProperty(e.prototype,"parent",{get:function(){return this._parent},enumerable:!0,configurable:!0}),Object.defineProperty(e.prototype,"name",{get:function(){return this._name},enumerable:!0,configurable:!0}),e.prototype.get=function(t){var e=this.getZoneWith(t);if(e)return e._properties[t]},e.prototype.getZoneWith=function(t){for(var e=this;e;){if(e._properties.hasOwnProperty(t))return e;e=e._parent}return null},e.prototype.fork=function(t){if(!t)throw new Error("ZoneSpec required!");return this._zoneDelegate.fork(this,t)},e.prototype.wrap=function(t,e){if("function"!=typeof t)throw new Error("Expecting function got: "+t);var n=this._zoneDelegate.intercept(this,t,e),r=this;return function(){return r.runGuarded(n,this,arguments,e)}},e.prototype.run=function(t,e,n,r){C={parent:C,zone:this};try{return this._zoneDelegate.invoke(this,t,e,n,r)}finally{C=C.parent}},e.prototype.runGuarded=function(t,e,n,r){void 0===e&&(e=null),C={parent:C,zone:this};try{try{return this._zoneDelegate.invoke(this,t,e,n,r)}catch(o){if(this._zoneDelegate.handleError(this,o))throw o}}finally{C=C.parent}},e.prototype.runTask=function(t,e,n){if(t.zone!=this)throw new Error("A task can only be run in the zone of creation! (Creation: "+(t.zone||g).name+"; Execution: "+this.name+")");if(t.state!==m||t.type!==k&&t.type!==T){var r=t.state!=w;r&&t._transitionTo(w,_),t.runCount++;var o=O;O=t,C={parent:C,zone:this};try{t.type==T&&t.data&&!t.data.isPeriodic&&(t.cancelFn=void 0);try{return this._zoneDelegate.invokeTask(this,t,e,n)}catch(i){if(this._zoneDelegate.handleError(this,i))throw i}}finally{t.state!==m&&t.state!==S&&(t.type==k||t.data&&t.data.isPeriodic?r&&t._transitionTo(_,w):(t.runCount=0,this._updateTaskCount(t,-1),r&&t._transitionTo(m,w,m))),C=C.parent,O=o}}},e.prototype.scheduleTask=function(t){if(t.zone&&t.zone!==this)for(var e=this;e;){if(e===t.zone)throw Error("can not reschedule task to "+this.name+" which is descendants of the original zone "+t.zone.name);e=e.parent}t._transitionTo(b,m);var n=[];t._zoneDelegates=n,t._zone=this;try{t=this._zoneDelegate.scheduleTask(this,t)}catch(r){throw t._transitionTo(S,b,m),this._zoneDelegate.handleError(this,r),r}return t._zoneDelegates===n&&this._updateTaskCount(t,1),t.state==b&&t._transitionTo(_,b),t},e.prototype.scheduleMicroTask=function(t,e,n,r){return this.scheduleTask(new c(x,t,e,n,r,void 0))},e.prototype.scheduleMacroTask=function(t,e,n,r,o){return this.scheduleTask(new c(T,t,e,n,r,o))},e.prototype.scheduleEventTask=function(t,e,n,r,o){return this.scheduleTask(new c(k,t,e,n,r,o))},e.prototype.cancelTask=function(t){if(t.zone!=this)throw new Error("A task can only be cancelled in the zone of creation! (Creation: "+(t.zone||g).name+"; Execution: "+this.name+")");t._transitionTo(E,_,w);try{this._zoneDelegate.cancelTask(this,t)}catch(e){throw t._transitionTo(S,E),this._zoneDelegate.handleError(this,e),e}return this._updateTaskCount(t,-1),t._transitionTo(m,E),t.runCount=0,t},e.prototype._updateTaskCount=function(t,e){var n=t._zoneDelegates;-1==e&&(t._zoneDelegates=null);for(var r=0;r<n.length;r++)n[r]._updateTaskCount(t.type,e)},e.__symbol__=D,e}(),s={name:"",onHasTask:function(t,e,n,r){return t.hasTask(n,r)},onScheduleTask:function(t,e,n,r){return t.scheduleTask(n,r)},onInvokeTask:function(t,e,n,r,o,i){return t.invokeTask(n,r,o,i)},onCancelTask:function(t,e,n,r){return t.cancelTask(n,r)}},u=function(){function t(t,e,n){this._taskCounts={microTask:0,macroTask:0,eventTask:0},this.zone=t,this._parentDelegate=e,this._forkZS=n&&(n&&n.onFork?n:e._forkZS),this._forkDlgt=n&&(n.onFork?e:e._forkDlgt),this._forkCurrZone=n&&(n.onFork?this.zone:e.zone),this._interceptZS=n&&(n.onIntercept?n:e._interceptZS),this._interceptDlgt=n&&(n.onIntercept?e:e._interceptDlgt),this._interceptCurrZone=n&&(n.onIntercept?this.zone:e.zone),this._invokeZS=n&&(n.onInvoke?n:e._invokeZS),this._invokeDlgt=n&&(n.onInvoke?e:e._invokeDlgt),this._invokeCurrZone=n&&(n.onInvoke?this.zone:e.zone),this._handleErrorZS=n&&(n.onHandleError?n:e._handleErrorZS),this._handleErrorDlgt=n&&(n.onHandleError?e:e._handleErrorDlgt),this._handleErrorCurrZone=n&&(n.onHandleError?this.zone:e.zone),this._scheduleTaskZS=n&&(n.onScheduleTask?n:e._scheduleTaskZS),this._scheduleTaskDlgt=n&&(n.onScheduleTask?e:e._scheduleTaskDlgt),this._scheduleTaskCurrZone=n&&(n.onScheduleTask?this.zone:e.zone),this._invokeTaskZS=n&&(n.onInvokeTask?n:e._invokeTaskZS),this._invokeTaskDlgt=n&&(n.onInvokeTask?e:e._invokeTaskDlgt),this._invokeTaskCurrZone=n&&(n.onInvokeTask?this.zone:e.zone),this._cancelTaskZS=n&&(n.onCancelTask?n:e._cancelTaskZS),this._cancelTaskDlgt=n&&(n.onCancelTask?e:e._cancelTaskDlgt),this._cancelTaskCurrZone=n&&(n.onCancelTask?this.zone:e.zone),this._hasTaskZS=null,this._hasTaskDlgt=null,this._hasTaskDlgtOwner=null,this._hasTaskCurrZone=null;var r=n&&n.onHasTask;(r||e&&e._hasTaskZS)&&(this._hasTaskZS=r?n:s,this._hasTaskDlgt=e,this._hasTaskDlgtOwner=this,this._hasTaskCurrZone=t,n.onScheduleTask||(this._scheduleTaskZS=s,this._scheduleTaskDlgt=e,this._scheduleTaskCurrZone=this.zone),n.onInvokeTask||(this._invokeTaskZS=s,this._invokeTaskDlgt=e,this._invokeTaskCurrZone=this.zone),n.onCancelTask||(this._cancelTaskZS=s,this._cancelTaskDlgt=e,this._cancelTaskCurrZone=this.zone))}return t.prototype.fork=function(t,e){return this._forkZS?this._forkZS.onFork(this._forkDlgt,this.zone,t,e):new a(t,e)},t.prototype.intercept=function(t,e,n){return this._interceptZS?this._interceptZS.onIntercept(this._interceptDlgt,this._interceptCurrZone,t,e,n):e},t.prototype.invoke=function(t,e,n,r,o){return this._invokeZS?this._invokeZS.onInvoke(this._invokeDlgt,this._invokeCurrZone,t,e,n,r,o):e.apply(n,r)},t.prototype.handleError=function(t,e){return!this._handleErrorZS||this._handleErrorZS.onHandleError(this._handleErrorDlgt,this._handleErrorCurrZone,t,e)},t.prototype.scheduleTask=function(t,e){var n=e;if(this._scheduleTaskZS)this._hasTaskZS&&n._zoneDelegates.push(this._hasTaskDlgtOwner),(n=this._scheduleTaskZS.onScheduleTask(this._scheduleTaskDlgt,this._scheduleTaskCurrZone,t,e))||(n=e);else if(e.scheduleFn)e.scheduleFn(e);else{if(e.type!=x)throw new Error("Task is missing scheduleFn.");y(e)}return n},t.prototype.invokeTask=function(t,e,n,r){return this._invokeTaskZS?this._invokeTaskZS.onInvokeTask(this._invokeTaskDlgt,this._invokeTaskCurrZone,t,e,n,r):e.callback.apply(n,r)},t.prototype.cancelTask=function(t,e){var n;if(this._cancelTaskZS)n=this._cancelTaskZS.onCancelTask(this._cancelTaskDlgt,this._cancelTaskCurrZone,t,e);else{if(!e.cancelFn)throw Error("Task is not cancelable");n=e.cancelFn(e)}return n},t.prototype.hasTask=function(t,e){try{this._hasTaskZS&&this._hasTaskZS.onHasTask(this._hasTaskDlgt,this._hasTaskCurrZone,t,e)}catch(n){this.handleError(t,n)}},t.prototype._updateTaskCount=function(t,e){var n=this._taskCounts,r=n[t],o=n[t]=r+e;if(o<0)throw new Error("More tasks executed then were scheduled.");0!=r&&0!=o||this.hasTask(this.zone,{microTask:n.microTask>0,macroTask:n.macroTask>0,eventTask:n.eventTask>0,change:t})},t}(),c=function(){function e(n,r,o,i,a,s){this._zone=null,this.runCount=0,this._zoneDelegates=null,this._state="notScheduled",this.type=n,this.source=r,this.data=i,this.scheduleFn=a,this.cancelFn=s,this.callback=o;var u=this;this.invoke=n===k&&i&&i.useG?e.invokeTask:function(){return e.invokeTask.call(t,u,this,arguments)}}return e.invokeTask=function(t,e,n){t||(t=this),P++;try{return t.runCount++,t.zone.runTask(t,e,n)}finally{1==P&&v(),P--}},Object.defineProperty(e.prototype,"zone",{get:function(){return this._zone},enumerable:!0,configurable:!0}),Object.defineProperty(e.prototype,"state",{get:function(){return this._state},enumerable:!0,configurable:!0}),e.prototype.cancelScheduleRequest=function(){this._transitionTo(m,b)},e.prototype._transitionTo=function(t,e,n){if(this._state!==e&&this._state!==n)throw new Error(this.type+" '"+this.source+"': can not transition to '"+t+"', expecting state '"+e+"'"+(n?" or '"+n+"'":"")+", was '"+this._state+"'.");this._state=t,t==m&&(this._zoneDelegates=null)},e.prototype.toString=function(){return this.data&&void 0!==this.data.handleId?this.data.handleId.toString():Object.prototype.toString.call(this)},e.prototype.toJSON=function(){return{type:this.type,state:this.state,source:this.source,zone:this.zone.name,runCount:this.runCount}},e}(),l=D("setTimeout"),f=D("Promise"),p=D("then"),h=[],d=!1;function y(e){if(0===P&&0===h.length)if(i||t[f]&&(i=t[f].resolve(0)),i){var n=i[p];n||(n=i.then),n.call(i,v)}else t[l](v,0);e&&h.push(e)}function v(){if(!d){for(d=!0;h.length;){var t=h;h=[];for(var e=0;e<t.length;e++){var n=t[e];try{n.zone.runTask(n,null,null)}catch(r){I.onUnhandledError(r)}}}I.microtaskDrainDone(),d=!1}}var g={name:"NO ZONE"},m="notScheduled",b="scheduling",_="scheduled",w="running",E="canceling",S="unknown",x="microTask",T="macroTask",k="eventTask",A={},I={symbol:D,currentZoneFrame:function(){return C},onUnhandledError:R,microtaskDrainDone:R,scheduleMicroTask:y,showUncaughtError:function(){return!a[D("ignoreConsoleErrorUncaughtError")]},patchEventTarget:function(){return[]},patchOnProperties:R,patchMethod:function(){return R},bindArguments:function(){return[]},patchThen:function(){return R},patchMacroTask:function(){return R},setNativePromise:function(t){t&&"function"==typeof t.resolve&&(i=t.resolve(0))},patchEventPrototype:function(){return R},isIEOrEdge:function(){return!1},getGlobalObjects:function(){},ObjectDefineProperty:function(){return R},ObjectGetOwnPropertyDescriptor:function(){},ObjectCreate:function(){},ArraySlice:function(){return[]},patchClass:function(){return R},wrapWithCurrentZone:function(){return R},filterProperties:function(){return[]},attachOriginToPatched:function(){return R},_redefineProperty:function(){return R},patchCallbacks:function(){return R}},C={parent:null,zone:new a(null,null)},O=null,P=0;function R(){}function D(t){return"__zone_symbol__"+t}r("Zone","Zone"),t.Zone=a}("undefined"!=typeof window&&window||"undefined"!=typeof self&&self||global);var t=function(t){var e="function"==typeof Symbol&&t[Symbol.iterator],n=0;return e?e.call(t):{next:function(){return t&&n>=t.length&&(t=void 0),{value:t&&t[n++],done:!t}}}};Zone.__load_patch("ZoneAwarePromise",function(e,n,r){var o=Object.getOwnPropertyDescriptor,i=Object.defineProperty,a=r.symbol,s=[],u=a("Promise"),c=a("then"),l="__creationTrace__";r.onUnhandledError=function(t){if(r.showUncaughtError()){var e=t&&t.rejection;e?console.error("Unhandled Promise rejection:",e instanceof Error?e.message:e,"; Zone:",t.zone.name,"; Task:",t.task&&t.task.source,"; Value:",e,e instanceof Error?e.stack:void 0):console.error(t)}},r.microtaskDrainDone=function(){for(;s.length;)for(var t=function(){var t=s.shift();try{t.zone.runGuarded(function(){throw t})}catch(e){p(e)}};s.length;)t()};var f=a("unhandledPromiseRejectionHandler");function p(t){r.onUnhandledError(t);try{var e=n[f];e&&"function"==typeof e&&e.call(this,t)}catch(o){}}function h(t){return t&&t.then}function d(t){return t}function y(t){return N.reject(t)}var v=a("state"),g=a("value"),m=a("finally"),b=a("parentPromiseValue"),_=a("parentPromiseState"),w="Promise.then",E=null,S=!0,x=!1,T=0;function k(t,e){return function(n){try{O(t,e,n)}catch(r){O(t,!1,r)}}}var A=function(){var t=!1;return function(e){return function(){t||(t=!0,e.apply(null,arguments))}}},I="Promise resolved with itself",C=a("currentTaskTrace");function O(t,e,o){var a,u=A();if(t===o)throw new TypeError(I);if(t[v]===E){var c=null;try{"object"!=typeof o&&"function"!=typeof o||(c=o&&o.then)}catch(y){return u(function(){O(t,!1,y)})(),t}if(e!==x&&o instanceof N&&o.hasOwnProperty(v)&&o.hasOwnProperty(g)&&o[v]!==E)R(o),O(t,o[v],o[g]);else if(e!==x&&"function"==typeof c)try{c.call(o,u(k(t,e)),u(k(t,!1)))}catch(y){u(function(){O(t,!1,y)})()}else{t[v]=e;var f=t[g];if(t[g]=o,t[m]===m&&e===S&&(t[v]=t[_],t[g]=t[b]),e===x&&o instanceof Error){var p=n.currentTask&&n.currentTask.data&&n.currentTask.data[l];p&&i(o,C,{configurable:!0,enumerable:!1,writable:!0,value:p})}for(var h=0;h<f.length;)D(t,f[h++],f[h++],f[h++],f[h++]);if(0==f.length&&e==x){t[v]=T;try{throw new Error("Uncaught (in promise): "+((a=o)&&a.toString===Object.prototype.toString?(a.constructor&&a.constructor.name||"")+": "+JSON.stringify(a):a?a.toString():Object.prototype.toString.call(a))+(o&&o.stack?"\n"+o.stack:""))}catch(y){var d=y;d.rejection=o,d.promise=t,d.zone=n.current,d.task=n.currentTask,s.push(d),r.scheduleMicroTask()}}}}return t}var P=a("rejectionHandledHandler");function R(t){if(t[v]===T){try{var e=n[P];e&&"function"==typeof e&&e.call(this,{rejection:t[g],promise:t})}catch(o){}t[v]=x;for(var r=0;r<s.length;r++)t===s[r].promise&&s.splice(r,1)}}function D(t,e,n,r,o){R(t);var i=t[v],a=i?"function"==typeof r?r:d:"function"==typeof o?o:y;e.scheduleMicroTask(w,function(){try{var r=t[g],o=n&&m===n[m];o&&(n[b]=r,n[_]=i);var s=e.run(a,void 0,o&&a!==y&&a!==d?[]:[r]);O(n,!0,s)}catch(u){O(n,!1,u)}},n)}var N=function(){function e(t){if(!(this instanceof e))throw new Error("Must be an instanceof Promise.");this[v]=E,this[g]=[];try{t&&t(k(this,S),k(this,x))}catch(n){O(this,!1,n)}}return e.toString=function(){return"function ZoneAwarePromise() { [native code] }"},e.resolve=function(t){return O(new this(null),S,t)},e.reject=function(t){return O(new this(null),x,t)},e.race=function(e){var n,r,o,i,a=new this(function(t,e){o=t,i=e});function s(t){o(t)}function u(t){i(t)}try{for(var c=t(e),l=c.next();!l.done;l=c.next()){var f=l.value;h(f)||(f=this.resolve(f)),f.then(s,u)}}catch(p){n={error:p}}finally{try{l&&!l.done&&(r=c.return)&&r.call(c)}finally{if(n)throw n.error}}return a},e.all=function(e){var n,r,o,i,a=new this(function(t,e){o=t,i=e}),s=2,u=0,c=[],l=function(t){h(t)||(t=f.resolve(t));var e=u;t.then(function(t){c[e]=t,0==--s&&o(c)},i),s++,u++},f=this;try{for(var p=t(e),d=p.next();!d.done;d=p.next())l(d.value)}catch(y){n={error:y}}finally{try{d&&!d.done&&(r=p.return)&&r.call(p)}finally{if(n)throw n.error}}return 0==(s-=2)&&o(c),a},Object.defineProperty(e.prototype,Symbol.toStringTag,{get:function(){return"Promise"},enumerable:!0,configurable:!0}),e.prototype.then=function(t,e){var r=new this.constructor(null),o=n.current;return this[v]==E?this[g].push(o,r,t,e):D(this,o,r,t,e),r},e.prototype.catch=function(t){return this.then(null,t)},e.prototype.finally=function(t){var e=new this.constructor(null);e[m]=m;var r=n.current;return this[v]==E?this[g].push(r,e,t,t):D(this,r,e,t,t),e},e}();N.resolve=N.resolve,N.reject=N.reject,N.race=N.race,N.all=N.all;var M=e[u]=e.Promise,j=n.__symbol__("ZoneAwarePromise"),F=o(e,"Promise");F&&!F.configurable||(F&&delete F.writable,F&&delete F.value,F||(F={configurable:!0,enumerable:!0}),F.get=function(){return e[j]?e[j]:e[u]},F.set=function(t){t===N?e[j]=t:(e[u]=t,t.prototype[c]||V(t),r.setNativePromise(t))},i(e,"Promise",F)),e.Promise=N;var L,B=a("thenPatched");function V(t){var e=t.prototype,n=o(e,"then");if(!n||!1!==n.writable&&n.configurable){var r=e.then;e[c]=r,t.prototype.then=function(t,e){var n=this;return new N(function(t,e){r.call(n,t,e)}).then(t,e)},t[B]=!0}}if(r.patchThen=V,M){V(M);var H=e.fetch;"function"==typeof H&&(e[r.symbol("fetch")]=H,e.fetch=(L=H,function(){var t=L.apply(this,arguments);if(t instanceof N)return t;var e=t.constructor;return e[B]||V(e),t}))}return Promise[n.__symbol__("uncaughtPromiseErrors")]=s,N});var e=Object.getOwnPropertyDescriptor,n=Object.defineProperty,r=Object.getPrototypeOf,o=Object.create,i=Array.prototype.slice,a="addEventListener",s="removeEventListener",u=Zone.__symbol__(a),c=Zone.__symbol__(s),l="true",f="false",p="__zone_symbol__";function h(t,e){return Zone.current.wrap(t,e)}function d(t,e,n,r,o){return Zone.current.scheduleMacroTask(t,e,n,r,o)}var y=Zone.__symbol__,v="undefined"!=typeof window,g=v?window:void 0,m=v&&g||"object"==typeof self&&self||global,b="removeAttribute",_=[null];function w(t,e){for(var n=t.length-1;n>=0;n--)"function"==typeof t[n]&&(t[n]=h(t[n],e+"_"+n));return t}function E(t){return!t||!1!==t.writable&&!("function"==typeof t.get&&void 0===t.set)}var S="undefined"!=typeof WorkerGlobalScope&&self instanceof WorkerGlobalScope,x=!("nw"in m)&&void 0!==m.process&&"[object process]"==={}.toString.call(m.process),T=!x&&!S&&!(!v||!g.HTMLElement),k=void 0!==m.process&&"[object process]"==={}.toString.call(m.process)&&!S&&!(!v||!g.HTMLElement),A={},I=function(t){if(t=t||m.event){var e=A[t.type];e||(e=A[t.type]=y("ON_PROPERTY"+t.type));var n,r=this||t.target||m,o=r[e];return T&&r===g&&"error"===t.type?!0===(n=o&&o.call(this,t.message,t.filename,t.lineno,t.colno,t.error))&&t.preventDefault():null==(n=o&&o.apply(this,arguments))||n||t.preventDefault(),n}};function C(t,r,o){var i=e(t,r);if(!i&&o&&e(o,r)&&(i={enumerable:!0,configurable:!0}),i&&i.configurable){var a=y("on"+r+"patched");if(!t.hasOwnProperty(a)||!t[a]){delete i.writable,delete i.value;var s=i.get,u=i.set,c=r.substr(2),l=A[c];l||(l=A[c]=y("ON_PROPERTY"+c)),i.set=function(e){var n=this;n||t!==m||(n=m),n&&(n[l]&&n.removeEventListener(c,I),u&&u.apply(n,_),"function"==typeof e?(n[l]=e,n.addEventListener(c,I,!1)):n[l]=null)},i.get=function(){var e=this;if(e||t!==m||(e=m),!e)return null;var n=e[l];if(n)return n;if(s){var o=s&&s.call(this);if(o)return i.set.call(this,o),"function"==typeof e[b]&&e.removeAttribute(r),o}return null},n(t,r,i),t[a]=!0}}}function O(t,e,n){if(e)for(var r=0;r<e.length;r++)C(t,"on"+e[r],n);else{var o=[];for(var i in t)"on"==i.substr(0,2)&&o.push(i);for(var a=0;a<o.length;a++)C(t,o[a],n)}}var P=y("originalInstance");function R(t){var e=m[t];if(e){m[y(t)]=e,m[t]=function(){var n=w(arguments,t);switch(n.length){case 0:this[P]=new e;break;case 1:this[P]=new e(n[0]);break;case 2:this[P]=new e(n[0],n[1]);break;case 3:this[P]=new e(n[0],n[1],n[2]);break;case 4:this[P]=new e(n[0],n[1],n[2],n[3]);break;default:throw new Error("Arg list too long.")}},j(m[t],e);var r,o=new e(function(){});for(r in o)"XMLHttpRequest"===t&&"responseBlob"===r||function(e){"function"==typeof o[e]?m[t].prototype[e]=function(){return this[P][e].apply(this[P],arguments)}:n(m[t].prototype,e,{set:function(n){"function"==typeof n?(this[P][e]=h(n,t+"."+e),j(this[P][e],n)):this[P][e]=n},get:function(){return this[P][e]}})}(r);for(r in e)"prototype"!==r&&e.hasOwnProperty(r)&&(m[t][r]=e[r])}}var D=!1;function N(t,n,o){for(var i=t;i&&!i.hasOwnProperty(n);)i=r(i);!i&&t[n]&&(i=t);var a,s,u=y(n),c=null;if(i&&!(c=i[u])&&(c=i[u]=i[n],E(i&&e(i,n)))){var l=o(c,u,n);i[n]=function(){return l(this,arguments)},j(i[n],c),D&&(a=c,s=i[n],"function"==typeof Object.getOwnPropertySymbols&&Object.getOwnPropertySymbols(a).forEach(function(t){var e=Object.getOwnPropertyDescriptor(a,t);Object.defineProperty(s,t,{get:function(){return a[t]},set:function(n){(!e||e.writable&&"function"==typeof e.set)&&(a[t]=n)},enumerable:!e||e.enumerable,configurable:!e||e.configurable})}))}return c}function M(t,e,n){var r=null;function o(t){var e=t.data;return e.args[e.cbIdx]=function(){t.invoke.apply(this,arguments)},r.apply(e.target,e.args),t}r=N(t,e,function(t){return function(e,r){var i=n(e,r);return i.cbIdx>=0&&"function"==typeof r[i.cbIdx]?d(i.name,r[i.cbIdx],i,o):t.apply(e,r)}})}function j(t,e){t[y("OriginalDelegate")]=e}var F=!1,L=!1;function B(){try{var t=g.navigator.userAgent;if(-1!==t.indexOf("MSIE ")||-1!==t.indexOf("Trident/"))return!0}catch(e){}return!1}function V(){if(F)return L;F=!0;try{var t=g.navigator.userAgent;-1===t.indexOf("MSIE ")&&-1===t.indexOf("Trident/")&&-1===t.indexOf("Edge/")||(L=!0)}catch(e){}return L}Zone.__load_patch("toString",function(t){var e=Function.prototype.toString,n=y("OriginalDelegate"),r=y("Promise"),o=y("Error"),i=function(){if("function"==typeof this){var i=this[n];if(i)return"function"==typeof i?e.call(i):Object.prototype.toString.call(i);if(this===Promise){var a=t[r];if(a)return e.call(a)}if(this===Error){var s=t[o];if(s)return e.call(s)}}return e.call(this)};i[n]=e,Function.prototype.toString=i;var a=Object.prototype.toString;Object.prototype.toString=function(){return this instanceof Promise?"[object Promise]":a.call(this)}});var H=!1;if("undefined"!=typeof window)try{var z=Object.defineProperty({},"passive",{get:function(){H=!0}});window.addEventListener("test",z,z),window.removeEventListener("test",z,z)}catch(Tt){H=!1}var U={useG:!0},W={},Z={},q=/^__zone_symbol__(\w+)(true|false)$/,G="__zone_symbol__propagationStopped";function K(t,e,n){var o=n&&n.add||a,i=n&&n.rm||s,u=n&&n.listeners||"eventListeners",c=n&&n.rmAll||"removeAllListeners",h=y(o),d="."+o+":",v="prependListener",g="."+v+":",m=function(t,e,n){if(!t.isRemoved){var r=t.callback;"object"==typeof r&&r.handleEvent&&(t.callback=function(t){return r.handleEvent(t)},t.originalDelegate=r),t.invoke(t,e,[n]);var o=t.options;o&&"object"==typeof o&&o.once&&e[i].call(e,n.type,t.originalDelegate?t.originalDelegate:t.callback,o)}},b=function(e){if(e=e||t.event){var n=this||e.target||t,r=n[W[e.type][f]];if(r)if(1===r.length)m(r[0],n,e);else for(var o=r.slice(),i=0;i<o.length&&(!e||!0!==e[G]);i++)m(o[i],n,e)}},_=function(e){if(e=e||t.event){var n=this||e.target||t,r=n[W[e.type][l]];if(r)if(1===r.length)m(r[0],n,e);else for(var o=r.slice(),i=0;i<o.length&&(!e||!0!==e[G]);i++)m(o[i],n,e)}};function w(e,n){if(!e)return!1;var a=!0;n&&void 0!==n.useG&&(a=n.useG);var s=n&&n.vh,m=!0;n&&void 0!==n.chkDup&&(m=n.chkDup);var w=!1;n&&void 0!==n.rt&&(w=n.rt);for(var E=e;E&&!E.hasOwnProperty(o);)E=r(E);if(!E&&e[o]&&(E=e),!E)return!1;if(E[h])return!1;var S,T=n&&n.eventNameToString,k={},A=E[h]=E[o],I=E[y(i)]=E[i],C=E[y(u)]=E[u],O=E[y(c)]=E[c];function P(t){H||"boolean"==typeof k.options||null==k.options||(t.options=!!k.options.capture,k.options=t.options)}n&&n.prepend&&(S=E[y(n.prepend)]=E[n.prepend]);var R=a?function(t){if(!k.isExisting)return P(t),A.call(k.target,k.eventName,k.capture?_:b,k.options)}:function(t){return P(t),A.call(k.target,k.eventName,t.invoke,k.options)},D=a?function(t){if(!t.isRemoved){var e=W[t.eventName],n=void 0;e&&(n=e[t.capture?l:f]);var r=n&&t.target[n];if(r)for(var o=0;o<r.length;o++)if(r[o]===t){r.splice(o,1),t.isRemoved=!0,0===r.length&&(t.allRemoved=!0,t.target[n]=null);break}}if(t.allRemoved)return I.call(t.target,t.eventName,t.capture?_:b,t.options)}:function(t){return I.call(t.target,t.eventName,t.invoke,t.options)},N=n&&n.diff?n.diff:function(t,e){var n=typeof e;return"function"===n&&t.callback===e||"object"===n&&t.originalDelegate===e},M=Zone[Zone.__symbol__("BLACK_LISTED_EVENTS")],F=function(e,n,r,o,i,u){return void 0===i&&(i=!1),void 0===u&&(u=!1),function(){var c=this||t,h=arguments[0],d=arguments[1];if(!d)return e.apply(this,arguments);if(x&&"uncaughtException"===h)return e.apply(this,arguments);var y=!1;if("function"!=typeof d){if(!d.handleEvent)return e.apply(this,arguments);y=!0}if(!s||s(e,d,c,arguments)){var v,g=arguments[2];if(M)for(var b=0;b<M.length;b++)if(h===M[b])return e.apply(this,arguments);var _=!1;void 0===g?v=!1:!0===g?v=!0:!1===g?v=!1:(v=!!g&&!!g.capture,_=!!g&&!!g.once);var w,E=Zone.current,S=W[h];if(S)w=S[v?l:f];else{var A=(T?T(h):h)+f,I=(T?T(h):h)+l,C=p+A,O=p+I;W[h]={},W[h][f]=C,W[h][l]=O,w=v?O:C}var P,R=c[w],D=!1;if(R){if(D=!0,m)for(b=0;b<R.length;b++)if(N(R[b],d))return}else R=c[w]=[];var j=c.constructor.name,F=Z[j];F&&(P=F[h]),P||(P=j+n+(T?T(h):h)),k.options=g,_&&(k.options.once=!1),k.target=c,k.capture=v,k.eventName=h,k.isExisting=D;var L=a?U:void 0;L&&(L.taskData=k);var B=E.scheduleEventTask(P,d,L,r,o);return k.target=null,L&&(L.taskData=null),_&&(g.once=!0),(H||"boolean"!=typeof B.options)&&(B.options=g),B.target=c,B.capture=v,B.eventName=h,y&&(B.originalDelegate=d),u?R.unshift(B):R.push(B),i?c:void 0}}};return E[o]=F(A,d,R,D,w),S&&(E[v]=F(S,g,function(t){return S.call(k.target,k.eventName,t.invoke,k.options)},D,w,!0)),E[i]=function(){var e,n=this||t,r=arguments[0],o=arguments[2];e=void 0!==o&&(!0===o||!1!==o&&!!o&&!!o.capture);var i=arguments[1];if(!i)return I.apply(this,arguments);if(!s||s(I,i,n,arguments)){var a,u=W[r];u&&(a=u[e?l:f]);var c=a&&n[a];if(c)for(var p=0;p<c.length;p++){var h=c[p];if(N(h,i))return c.splice(p,1),h.isRemoved=!0,0===c.length&&(h.allRemoved=!0,n[a]=null),h.zone.cancelTask(h),w?n:void 0}return I.apply(this,arguments)}},E[u]=function(){for(var e=arguments[0],n=[],r=Q(this||t,T?T(e):e),o=0;o<r.length;o++){var i=r[o];n.push(i.originalDelegate?i.originalDelegate:i.callback)}return n},E[c]=function(){var e=this||t,n=arguments[0];if(n){var r=W[n];if(r){var o=e[r[f]],a=e[r[l]];if(o){var s=o.slice();for(h=0;h<s.length;h++)this[i].call(this,n,(u=s[h]).originalDelegate?u.originalDelegate:u.callback,u.options)}if(a)for(s=a.slice(),h=0;h<s.length;h++){var u;this[i].call(this,n,(u=s[h]).originalDelegate?u.originalDelegate:u.callback,u.options)}}}else{for(var p=Object.keys(e),h=0;h<p.length;h++){var d=q.exec(p[h]),y=d&&d[1];y&&"removeListener"!==y&&this[c].call(this,y)}this[c].call(this,"removeListener")}if(w)return this},j(E[o],A),j(E[i],I),O&&j(E[c],O),C&&j(E[u],C),!0}for(var E=[],S=0;S<e.length;S++)E[S]=w(e[S],n);return E}function Q(t,e){var n=[];for(var r in t){var o=q.exec(r),i=o&&o[1];if(i&&(!e||i===e)){var a=t[r];if(a)for(var s=0;s<a.length;s++)n.push(a[s])}}return n}function Y(t,e){var n=t.Event;n&&n.prototype&&e.patchMethod(n.prototype,"stopImmediatePropagation",function(t){return function(e,n){e[G]=!0,t&&t.apply(e,n)}})}function X(t,e,n,r,o){var i=Zone.__symbol__(r);if(!e[i]){var a=e[i]=e[r];e[r]=function(i,s,u){return s&&s.prototype&&o.forEach(function(e){var o=n+"."+r+"::"+e,i=s.prototype;if(i.hasOwnProperty(e)){var a=t.ObjectGetOwnPropertyDescriptor(i,e);a&&a.value?(a.value=t.wrapWithCurrentZone(a.value,o),t._redefineProperty(s.prototype,e,a)):i[e]&&(i[e]=t.wrapWithCurrentZone(i[e],o))}else i[e]&&(i[e]=t.wrapWithCurrentZone(i[e],o))}),a.call(e,i,s,u)},t.attachOriginToPatched(e[r],a)}}var J=Zone.__symbol__,$=Object[J("defineProperty")]=Object.defineProperty,tt=Object[J("getOwnPropertyDescriptor")]=Object.getOwnPropertyDescriptor,et=Object.create,nt=J("unconfigurables");function rt(t,e,n){var r=n.configurable;return at(t,e,n=it(t,e,n),r)}function ot(t,e){return t&&t[nt]&&t[nt][e]}function it(t,e,n){return Object.isFrozen(n)||(n.configurable=!0),n.configurable||(t[nt]||Object.isFrozen(t)||$(t,nt,{writable:!0,value:{}}),t[nt]&&(t[nt][e]=!0)),n}function at(t,e,n,r){try{return $(t,e,n)}catch(i){if(!n.configurable)throw i;void 0===r?delete n.configurable:n.configurable=r;try{return $(t,e,n)}catch(i){var o=null;try{o=JSON.stringify(n)}catch(i){o=n.toString()}console.log("Attempting to configure '"+e+"' with descriptor '"+o+"' on object '"+t+"' and got error, giving up: "+i)}}}var st=["absolutedeviceorientation","afterinput","afterprint","appinstalled","beforeinstallprompt","beforeprint","beforeunload","devicelight","devicemotion","deviceorientation","deviceorientationabsolute","deviceproximity","hashchange","languagechange","message","mozbeforepaint","offline","online","paint","pageshow","pagehide","popstate","rejectionhandled","storage","unhandledrejection","unload","userproximity","vrdisplyconnected","vrdisplaydisconnected","vrdisplaypresentchange"],ut=["encrypted","waitingforkey","msneedkey","mozinterruptbegin","mozinterruptend"],ct=["load"],lt=["blur","error","focus","load","resize","scroll","messageerror"],ft=["bounce","finish","start"],pt=["loadstart","progress","abort","error","load","progress","timeout","loadend","readystatechange"],ht=["upgradeneeded","complete","abort","success","error","blocked","versionchange","close"],dt=["close","error","open","message"],yt=["error","message"],vt=["abort","animationcancel","animationend","animationiteration","auxclick","beforeinput","blur","cancel","canplay","canplaythrough","change","compositionstart","compositionupdate","compositionend","cuechange","click","close","contextmenu","curechange","dblclick","drag","dragend","dragenter","dragexit","dragleave","dragover","drop","durationchange","emptied","ended","error","focus","focusin","focusout","gotpointercapture","input","invalid","keydown","keypress","keyup","load","loadstart","loadeddata","loadedmetadata","lostpointercapture","mousedown","mouseenter","mouseleave","mousemove","mouseout","mouseover","mouseup","mousewheel","orientationchange","pause","play","playing","pointercancel","pointerdown","pointerenter","pointerleave","pointerlockchange","mozpointerlockchange","webkitpointerlockerchange","pointerlockerror","mozpointerlockerror","webkitpointerlockerror","pointermove","pointout","pointerover","pointerup","progress","ratechange","reset","resize","scroll","seeked","seeking","select","selectionchange","selectstart","show","sort","stalled","submit","suspend","timeupdate","volumechange","touchcancel","touchmove","touchstart","touchend","transitioncancel","transitionend","waiting","wheel"].concat(["webglcontextrestored","webglcontextlost","webglcontextcreationerror"],["autocomplete","autocompleteerror"],["toggle"],["afterscriptexecute","beforescriptexecute","DOMContentLoaded","freeze","fullscreenchange","mozfullscreenchange","webkitfullscreenchange","msfullscreenchange","fullscreenerror","mozfullscreenerror","webkitfullscreenerror","msfullscreenerror","readystatechange","visibilitychange","resume"],st,["beforecopy","beforecut","beforepaste","copy","cut","paste","dragstart","loadend","animationstart","search","transitionrun","transitionstart","webkitanimationend","webkitanimationiteration","webkitanimationstart","webkittransitionend"],["activate","afterupdate","ariarequest","beforeactivate","beforedeactivate","beforeeditfocus","beforeupdate","cellchange","controlselect","dataavailable","datasetchanged","datasetcomplete","errorupdate","filterchange","layoutcomplete","losecapture","move","moveend","movestart","propertychange","resizeend","resizestart","rowenter","rowexit","rowsdelete","rowsinserted","command","compassneedscalibration","deactivate","help","mscontentzoom","msmanipulationstatechanged","msgesturechange","msgesturedoubletap","msgestureend","msgesturehold","msgesturestart","msgesturetap","msgotpointercapture","msinertiastart","mslostpointercapture","mspointercancel","mspointerdown","mspointerenter","mspointerhover","mspointerleave","mspointermove","mspointerout","mspointerover","mspointerup","pointerout","mssitemodejumplistitemremoved","msthumbnailclick","stop","storagecommit"]);function gt(t,e,n){if(!n||0===n.length)return e;var r=n.filter(function(e){return e.target===t});if(!r||0===r.length)return e;var o=r[0].ignoreProperties;return e.filter(function(t){return-1===o.indexOf(t)})}function mt(t,e,n,r){t&&O(t,gt(t,e,n),r)}function bt(t,e){if((!x||k)&&!Zone[t.symbol("patchEvents")]){var n="undefined"!=typeof WebSocket,o=e.__Zone_ignore_on_properties;if(T){var i=window,a=B?[{target:i,ignoreProperties:["error"]}]:[];mt(i,vt.concat(["messageerror"]),o?o.concat(a):o,r(i)),mt(Document.prototype,vt,o),void 0!==i.SVGElement&&mt(i.SVGElement.prototype,vt,o),mt(Element.prototype,vt,o),mt(HTMLElement.prototype,vt,o),mt(HTMLMediaElement.prototype,ut,o),mt(HTMLFrameSetElement.prototype,st.concat(lt),o),mt(HTMLBodyElement.prototype,st.concat(lt),o),mt(HTMLFrameElement.prototype,ct,o),mt(HTMLIFrameElement.prototype,ct,o);var s=i.HTMLMarqueeElement;s&&mt(s.prototype,ft,o);var u=i.Worker;u&&mt(u.prototype,yt,o)}var c=e.XMLHttpRequest;c&&mt(c.prototype,pt,o);var l=e.XMLHttpRequestEventTarget;l&&mt(l&&l.prototype,pt,o),"undefined"!=typeof IDBIndex&&(mt(IDBIndex.prototype,ht,o),mt(IDBRequest.prototype,ht,o),mt(IDBOpenDBRequest.prototype,ht,o),mt(IDBDatabase.prototype,ht,o),mt(IDBTransaction.prototype,ht,o),mt(IDBCursor.prototype,ht,o)),n&&mt(WebSocket.prototype,dt,o)}}function _t(t,e){var n=e.getGlobalObjects(),r=n.eventNames,o=n.globalSources,i=n.zoneSymbolEventNames,a=n.TRUE_STR,s=n.FALSE_STR,u=n.ZONE_SYMBOL_PREFIX,c="Anchor,Area,Audio,BR,Base,BaseFont,Body,Button,Canvas,Content,DList,Directory,Div,Embed,FieldSet,Font,Form,Frame,FrameSet,HR,Head,Heading,Html,IFrame,Image,Input,Keygen,LI,Label,Legend,Link,Map,Marquee,Media,Menu,Meta,Meter,Mod,OList,Object,OptGroup,Option,Output,Paragraph,Pre,Progress,Quote,Script,Select,Source,Span,Style,TableCaption,TableCell,TableCol,Table,TableRow,TableSection,TextArea,Title,Track,UList,Unknown,Video",l="ApplicationCache,EventSource,FileReader,InputMethodContext,MediaController,MessagePort,Node,Performance,SVGElementInstance,SharedWorker,TextTrack,TextTrackCue,TextTrackList,WebKitNamedFlow,Window,Worker,WorkerGlobalScope,XMLHttpRequest,XMLHttpRequestEventTarget,XMLHttpRequestUpload,IDBRequest,IDBOpenDBRequest,IDBDatabase,IDBTransaction,IDBCursor,DBIndex,WebSocket".split(","),f=[],p=t.wtf,h=c.split(",");p?f=h.map(function(t){return"HTML"+t+"Element"}).concat(l):t.EventTarget?f.push("EventTarget"):f=l;for(var d=t.__Zone_disable_IE_check||!1,y=t.__Zone_enable_cross_context_check||!1,v=e.isIEOrEdge(),g="function __BROWSERTOOLS_CONSOLE_SAFEFUNC() { [native code] }",m=0;m<r.length;m++){var b=u+((x=r[m])+s),_=u+(x+a);i[x]={},i[x][s]=b,i[x][a]=_}for(m=0;m<c.length;m++)for(var w=h[m],E=o[w]={},S=0;S<r.length;S++){var x;E[x=r[S]]=w+".addEventListener:"+x}var T=[];for(m=0;m<f.length;m++){var k=t[f[m]];T.push(k&&k.prototype)}return e.patchEventTarget(t,T,{vh:function(t,e,n,r){if(!d&&v){if(y)try{var o;if("[object FunctionWrapper]"===(o=e.toString())||o==g)return t.apply(n,r),!1}catch(i){return t.apply(n,r),!1}else if("[object FunctionWrapper]"===(o=e.toString())||o==g)return t.apply(n,r),!1}else if(y)try{e.toString()}catch(i){return t.apply(n,r),!1}return!0}}),Zone[e.symbol("patchEventTarget")]=!!t.EventTarget,!0}function wt(t,e){var n=t.getGlobalObjects();if((!n.isNode||n.isMix)&&!function(t,e){var n=t.getGlobalObjects();if((n.isBrowser||n.isMix)&&!t.ObjectGetOwnPropertyDescriptor(HTMLElement.prototype,"onclick")&&"undefined"!=typeof Element){var r=t.ObjectGetOwnPropertyDescriptor(Element.prototype,"onclick");if(r&&!r.configurable)return!1;if(r){t.ObjectDefineProperty(Element.prototype,"onclick",{enumerable:!0,configurable:!0,get:function(){return!0}});var o=!!document.createElement("div").onclick;return t.ObjectDefineProperty(Element.prototype,"onclick",r),o}}var i=e.XMLHttpRequest;if(!i)return!1;var a=i.prototype,s=t.ObjectGetOwnPropertyDescriptor(a,"onreadystatechange");if(s)return t.ObjectDefineProperty(a,"onreadystatechange",{enumerable:!0,configurable:!0,get:function(){return!0}}),o=!!(c=new i).onreadystatechange,t.ObjectDefineProperty(a,"onreadystatechange",s||{}),o;var u=t.symbol("fake");t.ObjectDefineProperty(a,"onreadystatechange",{enumerable:!0,configurable:!0,get:function(){return this[u]},set:function(t){this[u]=t}});var c,l=function(){};return(c=new i).onreadystatechange=l,o=c[u]===l,c.onreadystatechange=null,o}(t,e)){var r="undefined"!=typeof WebSocket;!function(t){for(var e=t.getGlobalObjects().eventNames,n=t.symbol("unbound"),r=function(r){var o=e[r],i="on"+o;self.addEventListener(o,function(e){var r,o,a=e.target;for(o=a?a.constructor.name+"."+i:"unknown."+i;a;)a[i]&&!a[i][n]&&((r=t.wrapWithCurrentZone(a[i],o))[n]=a[i],a[i]=r),a=a.parentElement},!0)},o=0;o<e.length;o++)r(o)}(t),t.patchClass("XMLHttpRequest"),r&&function(t,e){var n=t.getGlobalObjects(),r=n.ADD_EVENT_LISTENER_STR,o=n.REMOVE_EVENT_LISTENER_STR,i=e.WebSocket;e.EventTarget||t.patchEventTarget(e,[i.prototype]),e.WebSocket=function(e,n){var a,s,u=arguments.length>1?new i(e,n):new i(e),c=t.ObjectGetOwnPropertyDescriptor(u,"onmessage");return c&&!1===c.configurable?(a=t.ObjectCreate(u),s=u,[r,o,"send","close"].forEach(function(e){a[e]=function(){var n=t.ArraySlice.call(arguments);if(e===r||e===o){var i=n.length>0?n[0]:void 0;if(i){var s=Zone.__symbol__("ON_PROPERTY"+i);u[s]=a[s]}}return u[e].apply(u,n)}})):a=u,t.patchOnProperties(a,["close","error","message","open"],s),a};var a=e.WebSocket;for(var s in i)a[s]=i[s]}(t,e),Zone[t.symbol("patchEvents")]=!0}}Zone.__load_patch("util",function(t,r,u){u.patchOnProperties=O,u.patchMethod=N,u.bindArguments=w,u.patchMacroTask=M;var c=r.__symbol__("BLACK_LISTED_EVENTS"),d=r.__symbol__("UNPATCHED_EVENTS");t[d]&&(t[c]=t[d]),t[c]&&(r[c]=r[d]=t[c]),u.patchEventPrototype=Y,u.patchEventTarget=K,u.isIEOrEdge=V,u.ObjectDefineProperty=n,u.ObjectGetOwnPropertyDescriptor=e,u.ObjectCreate=o,u.ArraySlice=i,u.patchClass=R,u.wrapWithCurrentZone=h,u.filterProperties=gt,u.attachOriginToPatched=j,u._redefineProperty=rt,u.patchCallbacks=X,u.getGlobalObjects=function(){return{globalSources:Z,zoneSymbolEventNames:W,eventNames:vt,isBrowser:T,isMix:k,isNode:x,TRUE_STR:l,FALSE_STR:f,ZONE_SYMBOL_PREFIX:p,ADD_EVENT_LISTENER_STR:a,REMOVE_EVENT_LISTENER_STR:s}}}),function(t){t.__zone_symbol__legacyPatch=function(){var e=t.Zone;e.__load_patch("registerElement",function(t,e,n){!function(t,e){var n=e.getGlobalObjects();(n.isBrowser||n.isMix)&&"registerElement"in t.document&&e.patchCallbacks(e,document,"Document","registerElement",["createdCallback","attachedCallback","detachedCallback","attributeChangedCallback"])}(t,n)}),e.__load_patch("EventTargetLegacy",function(t,e,n){_t(t,n),wt(n,t)})}}("undefined"!=typeof window&&window||"undefined"!=typeof self&&self||global);var Et=y("zoneTask");function St(t,e,n,r){var o=null,i=null;n+=r;var a={};function s(e){var n=e.data;return n.args[0]=function(){try{e.invoke.apply(this,arguments)}finally{e.data&&e.data.isPeriodic||("number"==typeof n.handleId?delete a[n.handleId]:n.handleId&&(n.handleId[Et]=null))}},n.handleId=o.apply(t,n.args),e}function u(t){return i(t.data.handleId)}o=N(t,e+=r,function(n){return function(o,i){if("function"==typeof i[0]){var c=d(e,i[0],{isPeriodic:"Interval"===r,delay:"Timeout"===r||"Interval"===r?i[1]||0:void 0,args:i},s,u);if(!c)return c;var l=c.data.handleId;return"number"==typeof l?a[l]=c:l&&(l[Et]=c),l&&l.ref&&l.unref&&"function"==typeof l.ref&&"function"==typeof l.unref&&(c.ref=l.ref.bind(l),c.unref=l.unref.bind(l)),"number"==typeof l||l?l:c}return n.apply(t,i)}}),i=N(t,n,function(e){return function(n,r){var o,i=r[0];"number"==typeof i?o=a[i]:(o=i&&i[Et])||(o=i),o&&"string"==typeof o.type?"notScheduled"!==o.state&&(o.cancelFn&&o.data.isPeriodic||0===o.runCount)&&("number"==typeof i?delete a[i]:i&&(i[Et]=null),o.zone.cancelTask(o)):e.apply(t,r)}})}function xt(t,e){if(!Zone[e.symbol("patchEventTarget")]){for(var n=e.getGlobalObjects(),r=n.eventNames,o=n.zoneSymbolEventNames,i=n.TRUE_STR,a=n.FALSE_STR,s=n.ZONE_SYMBOL_PREFIX,u=0;u<r.length;u++){var c=r[u],l=s+(c+a),f=s+(c+i);o[c]={},o[c][a]=l,o[c][i]=f}var p=t.EventTarget;if(p&&p.prototype)return e.patchEventTarget(t,[p&&p.prototype]),!0}}Zone.__load_patch("legacy",function(t){var e=t[Zone.__symbol__("legacyPatch")];e&&e()}),Zone.__load_patch("timers",function(t){St(t,"set","clear","Timeout"),St(t,"set","clear","Interval"),St(t,"set","clear","Immediate")}),Zone.__load_patch("requestAnimationFrame",function(t){St(t,"request","cancel","AnimationFrame"),St(t,"mozRequest","mozCancel","AnimationFrame"),St(t,"webkitRequest","webkitCancel","AnimationFrame")}),Zone.__load_patch("blocking",function(t,e){for(var n=["alert","prompt","confirm"],r=0;r<n.length;r++)N(t,n[r],function(n,r,o){return function(r,i){return e.current.run(n,t,i,o)}})}),Zone.__load_patch("EventTarget",function(t,e,n){!function(t,e){e.patchEventPrototype(t,e)}(t,n),xt(t,n);var r=t.XMLHttpRequestEventTarget;r&&r.prototype&&n.patchEventTarget(t,[r.prototype]),R("MutationObserver"),R("WebKitMutationObserver"),R("IntersectionObserver"),R("FileReader")}),Zone.__load_patch("on_property",function(t,e,n){bt(n,t),Object.defineProperty=function(t,e,n){if(ot(t,e))throw new TypeError("Cannot assign to read only property '"+e+"' of "+t);var r=n.configurable;return"prototype"!==e&&(n=it(t,e,n)),at(t,e,n,r)},Object.defineProperties=function(t,e){return Object.keys(e).forEach(function(n){Object.defineProperty(t,n,e[n])}),t},Object.create=function(t,e){return"object"!=typeof e||Object.isFrozen(e)||Object.keys(e).forEach(function(n){e[n]=it(t,n,e[n])}),et(t,e)},Object.getOwnPropertyDescriptor=function(t,e){var n=tt(t,e);return n&&ot(t,e)&&(n.configurable=!1),n}}),Zone.__load_patch("customElements",function(t,e,n){!function(t,e){var n=e.getGlobalObjects();(n.isBrowser||n.isMix)&&t.customElements&&"customElements"in t&&e.patchCallbacks(e,t.customElements,"customElements","define",["connectedCallback","disconnectedCallback","adoptedCallback","attributeChangedCallback"])}(t,n)}),Zone.__load_patch("XHR",function(t,e){!function(l){var f=t.XMLHttpRequest;if(f){var p=f.prototype,h=p[u],v=p[c];if(!h){var g=t.XMLHttpRequestEventTarget;if(g){var m=g.prototype;h=m[u],v=m[c]}}var b="readystatechange",_="scheduled",w=N(p,"open",function(){return function(t,e){return t[r]=0==e[2],t[a]=e[1],w.apply(t,e)}}),E=y("fetchTaskAborting"),S=y("fetchTaskScheduling"),x=N(p,"send",function(){return function(t,n){if(!0===e.current[S])return x.apply(t,n);if(t[r])return x.apply(t,n);var o={target:t,url:t[a],isPeriodic:!1,args:n,aborted:!1},i=d("XMLHttpRequest.send",A,o,k,I);t&&!0===t[s]&&!o.aborted&&i.state===_&&i.invoke()}}),T=N(p,"abort",function(){return function(t,r){var o=t[n];if(o&&"string"==typeof o.type){if(null==o.cancelFn||o.data&&o.data.aborted)return;o.zone.cancelTask(o)}else if(!0===e.current[E])return T.apply(t,r)}})}function k(t){var e=t.data,r=e.target;r[i]=!1,r[s]=!1;var a=r[o];h||(h=r[u],v=r[c]),a&&v.call(r,b,a);var l=r[o]=function(){if(r.readyState===r.DONE)if(!e.aborted&&r[i]&&t.state===_){var n=r.__zone_symbol__loadfalse;if(n&&n.length>0){var o=t.invoke;t.invoke=function(){for(var n=r.__zone_symbol__loadfalse,i=0;i<n.length;i++)n[i]===t&&n.splice(i,1);e.aborted||t.state!==_||o.call(t)},n.push(t)}else t.invoke()}else e.aborted||!1!==r[i]||(r[s]=!0)};return h.call(r,b,l),r[n]||(r[n]=t),x.apply(r,e.args),r[i]=!0,t}function A(){}function I(t){var e=t.data;return e.aborted=!0,T.apply(e.target,e.args)}}();var n=y("xhrTask"),r=y("xhrSync"),o=y("xhrListener"),i=y("xhrScheduled"),a=y("xhrURL"),s=y("xhrErrorBeforeScheduled")}),Zone.__load_patch("geolocation",function(t){t.navigator&&t.navigator.geolocation&&function(t,n){for(var r=t.constructor.name,o=function(o){var i=n[o],a=t[i];if(a){if(!E(e(t,i)))return"continue";t[i]=function(t){var e=function(){return t.apply(this,w(arguments,r+"."+i))};return j(e,t),e}(a)}},i=0;i<n.length;i++)o(i)}(t.navigator.geolocation,["getCurrentPosition","watchPosition"])}),Zone.__load_patch("PromiseRejectionEvent",function(t,e){function n(e){return function(n){Q(t,e).forEach(function(r){var o=t.PromiseRejectionEvent;if(o){var i=new o(e,{promise:n.promise,reason:n.rejection});r.invoke(i)}})}}t.PromiseRejectionEvent&&(e[y("unhandledPromiseRejectionHandler")]=n("unhandledrejection"),e[y("rejectionHandledHandler")]=n("rejectionhandled"))})}()},"0TkE":function(t,e,n){"use strict";var r=n("67WC"),o=n("tycR").find,i=r.aTypedArray;(0,r.exportTypedArrayMethod)("find",function(t){return o(i(this),t,arguments.length>1?arguments[1]:void 0)})},"0boY":function(t,e,n){n("I+eb")({target:"Date",proto:!0},{toGMTString:Date.prototype.toUTCString})},"0eef":function(t,e,n){"use strict";var r={}.propertyIsEnumerable,o=Object.getOwnPropertyDescriptor,i=o&&!r.call({1:2},1);e.f=i?function(t){var e=o(this,t);return!!e&&e.enumerable}:r},"0oug":function(t,e,n){n("dG/n")("iterator")},"0rvr":function(t,e,n){var r=n("glrk"),o=n("O741");t.exports=Object.setPrototypeOf||("__proto__"in{}?function(){var t,e=!1,n={};try{(t=Object.getOwnPropertyDescriptor(Object.prototype,"__proto__").set).call(n,[]),e=n instanceof Array}catch(i){}return function(n,i){return r(n),o(i),e?t.call(n,i):n.__proto__=i,n}}():void 0)},"14Sl":function(t,e,n){"use strict";n("rB9j");var r=n("busE"),o=n("kmMV"),i=n("0Dky"),a=n("tiKp"),s=n("kRJp"),u=a("species"),c=RegExp.prototype;t.exports=function(t,e,n,l){var f=a(t),p=!i(function(){var e={};return e[f]=function(){return 7},7!=""[t](e)}),h=p&&!i(function(){var e=!1,n=/a/;return"split"===t&&((n={}).constructor={},n.constructor[u]=function(){return n},n.flags="",n[f]=/./[f]),n.exec=function(){return e=!0,null},n[f](""),!e});if(!p||!h||n){var d=/./[f],y=e(f,""[t],function(t,e,n,r,i){var a=e.exec;return a===o||a===c.exec?p&&!i?{done:!0,value:d.call(e,n,r)}:{done:!0,value:t.call(n,e,r)}:{done:!1}});r(String.prototype,t,y[0]),r(c,f,y[1])}l&&s(c[f],"sham",!0)}},"1E5z":function(t,e,n){var r=n("m/L8").f,o=n("UTVS"),i=n("tiKp")("toStringTag");t.exports=function(t,e,n){t&&!o(t=n?t:t.prototype,i)&&r(t,i,{configurable:!0,value:e})}},"1MNl":function(t,e,n){var r=n("NC/Y"),o=n("2oRo");t.exports=/ipad|iphone|ipod/i.test(r)&&void 0!==o.Pebble},"1Y/n":function(t,e,n){var r=n("HAuM"),o=n("ewvW"),i=n("RK3t"),a=n("UMSQ"),s=function(t){return function(e,n,s,u){r(n);var c=o(e),l=i(c),f=a(c.length),p=t?f-1:0,h=t?-1:1;if(s<2)for(;;){if(p in l){u=l[p],p+=h;break}if(p+=h,t?p<0:f<=p)throw TypeError("Reduce of empty array with no initial value")}for(;t?p>=0:f>p;p+=h)p in l&&(u=n(u,l[p],p,c));return u}};t.exports={left:s(!1),right:s(!0)}},"1dYe":function(t,e,n){"use strict";var r=n("67WC"),o=n("tycR").forEach,i=r.aTypedArray;(0,r.exportTypedArrayMethod)("forEach",function(t){o(i(this),t,arguments.length>1?arguments[1]:void 0)})},"1t3B":function(t,e,n){var r=n("I+eb"),o=n("0GbY"),i=n("glrk");r({target:"Reflect",stat:!0,sham:!n("uy83")},{preventExtensions:function(t){i(t);try{var e=o("Object","preventExtensions");return e&&e(t),!0}catch(n){return!1}}})},"2/pz":function(t,e,n){"use strict";var r=n("I+eb"),o=n("HAuM"),i=n("0GbY"),a=n("8GlL"),s=n("5mdu"),u=n("ImZN");r({target:"Promise",stat:!0},{any:function(t){var e=this,n=a.f(e),r=n.resolve,c=n.reject,l=s(function(){var n=o(e.resolve),a=[],s=0,l=1,f=!1;u(t,function(t){var o=s++,u=!1;a.push(void 0),l++,n.call(e,t).then(function(t){u||f||(f=!0,r(t))},function(t){u||f||(u=!0,a[o]=t,--l||c(new(i("AggregateError"))(a,"No one promise resolved")))})}),--l||c(new(i("AggregateError"))(a,"No one promise resolved"))});return l.error&&c(l.value),n.promise}})},"25bX":function(t,e,n){var r=n("I+eb"),o=n("glrk"),i=Object.isExtensible;r({target:"Reflect",stat:!0},{isExtensible:function(t){return o(t),!i||i(t)}})},"27RR":function(t,e,n){var r=n("I+eb"),o=n("g6v/"),i=n("Vu81"),a=n("/GqU"),s=n("Bs8V"),u=n("hBjN");r({target:"Object",stat:!0,sham:!o},{getOwnPropertyDescriptors:function(t){for(var e,n,r=a(t),o=s.f,c=i(r),l={},f=0;c.length>f;)void 0!==(n=o(r,e=c[f++]))&&u(l,e,n);return l}})},"2A+d":function(t,e,n){var r=n("I+eb"),o=n("/GqU"),i=n("UMSQ"),a=n("V37c");r({target:"String",stat:!0},{raw:function(t){for(var e=o(t.raw),n=i(e.length),r=arguments.length,s=[],u=0;n>u;)s.push(a(e[u++])),u<r&&s.push(a(arguments[u]));return s.join("")}})},"2B1R":function(t,e,n){"use strict";var r=n("I+eb"),o=n("tycR").map;r({target:"Array",proto:!0,forced:!n("Hd5f")("map")},{map:function(t){return o(this,t,arguments.length>1?arguments[1]:void 0)}})},"2Zix":function(t,e,n){var r=n("NC/Y");t.exports=/MSIE|Trident/.test(r)},"2bX/":function(t,e,n){var r=n("0GbY"),o=n("/b8u");t.exports=o?function(t){return"symbol"==typeof t}:function(t){var e=r("Symbol");return"function"==typeof e&&Object(t)instanceof e}},"2oRo":function(t,e){var n=function(t){return t&&t.Math==Math&&t};t.exports=n("object"==typeof globalThis&&globalThis)||n("object"==typeof window&&window)||n("object"==typeof self&&self)||n("object"==typeof global&&global)||function(){return this}()||Function("return this")()},"33Wh":function(t,e,n){var r=n("yoRg"),o=n("eDl+");t.exports=Object.keys||function(t){return r(t,o)}},"37lR":function(t,e){t.exports=function(t,e){for(var n=0,r=e.length,o=new t(r);r>n;)o[n]=e[n++];return o}},"3I1R":function(t,e,n){n("dG/n")("hasInstance")},"3KgV":function(t,e,n){var r=n("I+eb"),o=n("uy83"),i=n("0Dky"),a=n("hh1v"),s=n("8YOa").onFreeze,u=Object.freeze;r({target:"Object",stat:!0,forced:i(function(){u(1)}),sham:!o},{freeze:function(t){return u&&a(t)?u(s(t)):t}})},"3bBZ":function(t,e,n){var r=n("2oRo"),o=n("/byt"),i=n("4mDm"),a=n("kRJp"),s=n("tiKp"),u=s("iterator"),c=s("toStringTag"),l=i.values;for(var f in o){var p=r[f],h=p&&p.prototype;if(h){if(h[u]!==l)try{a(h,u,l)}catch(y){h[u]=l}if(h[c]||a(h,c,f),o[f])for(var d in i)if(h[d]!==i[d])try{a(h,d,i[d])}catch(y){h[d]=i[d]}}}},"4Brf":function(t,e,n){"use strict";var r=n("I+eb"),o=n("g6v/"),i=n("2oRo"),a=n("UTVS"),s=n("hh1v"),u=n("m/L8").f,c=n("6JNq"),l=i.Symbol;if(o&&"function"==typeof l&&(!("description"in l.prototype)||void 0!==l().description)){var f={},p=function(){var t=arguments.length<1||void 0===arguments[0]?void 0:String(arguments[0]),e=this instanceof p?new l(t):void 0===t?l():l(t);return""===t&&(f[e]=!0),e};c(p,l);var h=p.prototype=l.prototype;h.constructor=p;var d=h.toString,y="Symbol(test)"==String(l("test")),v=/^Symbol\((.*)\)[^)]+$/;u(h,"description",{configurable:!0,get:function(){var t=s(this)?this.valueOf():this,e=d.call(t);if(a(f,t))return"";var n=y?e.slice(7,-1):e.replace(v,"$1");return""===n?void 0:n}}),r({global:!0,forced:!0},{Symbol:p})}},"4WOD":function(t,e,n){var r=n("UTVS"),o=n("ewvW"),i=n("93I0"),a=n("4Xet"),s=i("IE_PROTO"),u=Object.prototype;t.exports=a?Object.getPrototypeOf:function(t){return t=o(t),r(t,s)?t[s]:"function"==typeof t.constructor&&t instanceof t.constructor?t.constructor.prototype:t instanceof Object?u:null}},"4Xet":function(t,e,n){var r=n("0Dky");t.exports=!r(function(){function t(){}return t.prototype.constructor=null,Object.getPrototypeOf(new t)!==t.prototype})},"4h0Y":function(t,e,n){var r=n("I+eb"),o=n("0Dky"),i=n("hh1v"),a=Object.isFrozen;r({target:"Object",stat:!0,forced:o(function(){a(1)})},{isFrozen:function(t){return!i(t)||!!a&&a(t)}})},"4l63":function(t,e,n){var r=n("I+eb"),o=n("wg0c");r({global:!0,forced:parseInt!=o},{parseInt:o})},"4mDm":function(t,e,n){"use strict";var r=n("/GqU"),o=n("RNIs"),i=n("P4y1"),a=n("afO8"),s=n("fdAy"),u=a.set,c=a.getterFor("Array Iterator");t.exports=s(Array,"Array",function(t,e){u(this,{type:"Array Iterator",target:r(t),index:0,kind:e})},function(){var t=c(this),e=t.target,n=t.kind,r=t.index++;return!e||r>=e.length?(t.target=void 0,{value:void 0,done:!0}):"keys"==n?{value:r,done:!1}:"values"==n?{value:e[r],done:!1}:{value:[r,e[r]],done:!1}},"values"),i.Arguments=i.Array,o("keys"),o("values"),o("entries")},"4oU/":function(t,e,n){var r=n("2oRo").isFinite;t.exports=Number.isFinite||function(t){return"number"==typeof t&&r(t)}},"4syw":function(t,e,n){var r=n("busE");t.exports=function(t,e,n){for(var o in e)r(t,o,e[o],n);return t}},"4wMS":function(t,e){t.exports={Debug:function(t){window.wails.Log.Debug(t)},Info:function(t){window.wails.Log.Info(t)},Warning:function(t){window.wails.Log.Warning(t)},Error:function(t){window.wails.Log.Error(t)},Fatal:function(t){window.wails.Log.Fatal(t)}}},"4yNf":function(t,e,n){"use strict";var r=n("I+eb"),o=n("HYAF"),i=n("ppGB"),a=n("V37c"),s="".slice,u=Math.max,c=Math.min;r({target:"String",proto:!0},{substr:function(t,e){var n,r,l=a(o(this)),f=l.length,p=i(t);return p===1/0&&(p=0),p<0&&(p=u(f+p,0)),(n=void 0===e?f:i(e))<=0||n===1/0?"":p>=(r=c(p+n,f))?"":s.call(l,p,r)}})},"5D5o":function(t,e,n){var r=n("I+eb"),o=n("0Dky"),i=n("hh1v"),a=Object.isSealed;r({target:"Object",stat:!0,forced:o(function(){a(1)})},{isSealed:function(t){return!i(t)||!!a&&a(t)}})},"5DmW":function(t,e,n){var r=n("I+eb"),o=n("0Dky"),i=n("/GqU"),a=n("Bs8V").f,s=n("g6v/"),u=o(function(){a(1)});r({target:"Object",stat:!0,forced:!s||u,sham:!s},{getOwnPropertyDescriptor:function(t,e){return a(i(t),e)}})},"5Tg+":function(t,e,n){var r=n("tiKp");e.f=r},"5Yz+":function(t,e,n){"use strict";var r=n("/GqU"),o=n("ppGB"),i=n("UMSQ"),a=n("pkCn"),s=Math.min,u=[].lastIndexOf,c=!!u&&1/[1].lastIndexOf(1,-0)<0,l=a("lastIndexOf");t.exports=c||!l?function(t){if(c)return u.apply(this,arguments)||0;var e=r(this),n=i(e.length),a=n-1;for(arguments.length>1&&(a=s(a,o(arguments[1]))),a<0&&(a=n+a);a>=0;a--)if(a in e&&e[a]===t)return a||0;return-1}:u},"5mdu":function(t,e){t.exports=function(t){try{return{error:!1,value:t()}}catch(e){return{error:!0,value:e}}}},"5s+n":function(t,e,n){"use strict";var r,o,i,a,s=n("I+eb"),u=n("xDBR"),c=n("2oRo"),l=n("0GbY"),f=n("/qmn"),p=n("busE"),h=n("4syw"),d=n("0rvr"),y=n("1E5z"),v=n("JiZb"),g=n("hh1v"),m=n("HAuM"),b=n("GarU"),_=n("iSVu"),w=n("ImZN"),E=n("HH4o"),S=n("SEBh"),x=n("LPSS").set,T=n("tXUg"),k=n("zfnd"),A=n("RN6c"),I=n("8GlL"),C=n("5mdu"),O=n("afO8"),P=n("lMq5"),R=n("tiKp"),D=n("YGnB"),N=n("YF1G"),M=n("LQDL"),j=R("species"),F="Promise",L=O.get,B=O.set,V=O.getterFor(F),H=f&&f.prototype,z=f,U=H,W=c.TypeError,Z=c.document,q=c.process,G=I.f,K=G,Q=!!(Z&&Z.createEvent&&c.dispatchEvent),Y="function"==typeof PromiseRejectionEvent,X=!1,J=P(F,function(){var t=_(z),e=t!==String(z);if(!e&&66===M)return!0;if(u&&!U.finally)return!0;if(M>=51&&/native code/.test(t))return!1;var n=new z(function(t){t(1)}),r=function(t){t(function(){},function(){})};return(n.constructor={})[j]=r,!(X=n.then(function(){})instanceof r)||!e&&D&&!Y}),$=J||!E(function(t){z.all(t).catch(function(){})}),tt=function(t){var e;return!(!g(t)||"function"!=typeof(e=t.then))&&e},et=function(t,e){if(!t.notified){t.notified=!0;var n=t.reactions;T(function(){for(var r=t.value,o=1==t.state,i=0;n.length>i;){var a,s,u,c=n[i++],l=o?c.ok:c.fail,f=c.resolve,p=c.reject,h=c.domain;try{l?(o||(2===t.rejection&&it(t),t.rejection=1),!0===l?a=r:(h&&h.enter(),a=l(r),h&&(h.exit(),u=!0)),a===c.promise?p(W("Promise-chain cycle")):(s=tt(a))?s.call(a,f,p):f(a)):p(r)}catch(d){h&&!u&&h.exit(),p(d)}}t.reactions=[],t.notified=!1,e&&!t.rejection&&rt(t)})}},nt=function(t,e,n){var r,o;Q?((r=Z.createEvent("Event")).promise=e,r.reason=n,r.initEvent(t,!1,!0),c.dispatchEvent(r)):r={promise:e,reason:n},!Y&&(o=c["on"+t])?o(r):"unhandledrejection"===t&&A("Unhandled promise rejection",n)},rt=function(t){x.call(c,function(){var e,n=t.facade,r=t.value;if(ot(t)&&(e=C(function(){N?q.emit("unhandledRejection",r,n):nt("unhandledrejection",n,r)}),t.rejection=N||ot(t)?2:1,e.error))throw e.value})},ot=function(t){return 1!==t.rejection&&!t.parent},it=function(t){x.call(c,function(){var e=t.facade;N?q.emit("rejectionHandled",e):nt("rejectionhandled",e,t.value)})},at=function(t,e,n){return function(r){t(e,r,n)}},st=function(t,e,n){t.done||(t.done=!0,n&&(t=n),t.value=e,t.state=2,et(t,!0))},ut=function(t,e,n){if(!t.done){t.done=!0,n&&(t=n);try{if(t.facade===e)throw W("Promise can't be resolved itself");var r=tt(e);r?T(function(){var n={done:!1};try{r.call(e,at(ut,n,t),at(st,n,t))}catch(o){st(n,o,t)}}):(t.value=e,t.state=1,et(t,!1))}catch(o){st({done:!1},o,t)}}};if(J&&(z=function(t){b(this,z,F),m(t),r.call(this);var e=L(this);try{t(at(ut,e),at(st,e))}catch(n){st(e,n)}},(r=function(t){B(this,{type:F,done:!1,notified:!1,parent:!1,reactions:[],rejection:!1,state:0,value:void 0})}).prototype=h(U=z.prototype,{then:function(t,e){var n=V(this),r=G(S(this,z));return r.ok="function"!=typeof t||t,r.fail="function"==typeof e&&e,r.domain=N?q.domain:void 0,n.parent=!0,n.reactions.push(r),0!=n.state&&et(n,!1),r.promise},catch:function(t){return this.then(void 0,t)}}),o=function(){var t=new r,e=L(t);this.promise=t,this.resolve=at(ut,e),this.reject=at(st,e)},I.f=G=function(t){return t===z||t===i?new o(t):K(t)},!u&&"function"==typeof f&&H!==Object.prototype)){a=H.then,X||(p(H,"then",function(t,e){var n=this;return new z(function(t,e){a.call(n,t,e)}).then(t,e)},{unsafe:!0}),p(H,"catch",U.catch,{unsafe:!0}));try{delete H.constructor}catch(ct){}d&&d(H,U)}s({global:!0,wrap:!0,forced:J},{Promise:z}),y(z,F,!1,!0),v(F),i=l(F),s({target:F,stat:!0,forced:J},{reject:function(t){var e=G(this);return e.reject.call(void 0,t),e.promise}}),s({target:F,stat:!0,forced:u||J},{resolve:function(t){return k(u&&this===i?z:this,t)}}),s({target:F,stat:!0,forced:$},{all:function(t){var e=this,n=G(e),r=n.resolve,o=n.reject,i=C(function(){var n=m(e.resolve),i=[],a=0,s=1;w(t,function(t){var u=a++,c=!1;i.push(void 0),s++,n.call(e,t).then(function(t){c||(c=!0,i[u]=t,--s||r(i))},o)}),--s||r(i)});return i.error&&o(i.value),n.promise},race:function(t){var e=this,n=G(e),r=n.reject,o=C(function(){var o=m(e.resolve);w(t,function(t){o.call(e,t).then(n.resolve,r)})});return o.error&&r(o.value),n.promise}})},"5uH8":function(t,e,n){n("I+eb")({target:"Number",stat:!0},{MIN_SAFE_INTEGER:-9007199254740991})},"5xtp":function(t,e,n){"use strict";var r=n("I+eb"),o=n("g6v/"),i=n("6x0u"),a=n("ewvW"),s=n("HAuM"),u=n("m/L8");o&&r({target:"Object",proto:!0,forced:i},{__defineSetter__:function(t,e){u.f(a(this),t,{set:s(e),enumerable:!0,configurable:!0})}})},"67WC":function(t,e,n){"use strict";var r,o,i,a=n("qYE9"),s=n("g6v/"),u=n("2oRo"),c=n("hh1v"),l=n("UTVS"),f=n("9d/t"),p=n("kRJp"),h=n("busE"),d=n("m/L8").f,y=n("4WOD"),v=n("0rvr"),g=n("tiKp"),m=n("kOOl"),b=u.Int8Array,_=b&&b.prototype,w=u.Uint8ClampedArray,E=w&&w.prototype,S=b&&y(b),x=_&&y(_),T=Object.prototype,k=T.isPrototypeOf,A=g("toStringTag"),I=m("TYPED_ARRAY_TAG"),C=m("TYPED_ARRAY_CONSTRUCTOR"),O=a&&!!v&&"Opera"!==f(u.opera),P=!1,R={Int8Array:1,Uint8Array:1,Uint8ClampedArray:1,Int16Array:2,Uint16Array:2,Int32Array:4,Uint32Array:4,Float32Array:4,Float64Array:8},D={BigInt64Array:8,BigUint64Array:8},N=function(t){if(!c(t))return!1;var e=f(t);return l(R,e)||l(D,e)};for(r in R)(i=(o=u[r])&&o.prototype)?p(i,C,o):O=!1;for(r in D)(i=(o=u[r])&&o.prototype)&&p(i,C,o);if((!O||"function"!=typeof S||S===Function.prototype)&&(S=function(){throw TypeError("Incorrect invocation")},O))for(r in R)u[r]&&v(u[r],S);if((!O||!x||x===T)&&(x=S.prototype,O))for(r in R)u[r]&&v(u[r].prototype,x);if(O&&y(E)!==x&&v(E,x),s&&!l(x,A))for(r in P=!0,d(x,A,{get:function(){return c(this)?this[I]:void 0}}),R)u[r]&&p(u[r],I,r);t.exports={NATIVE_ARRAY_BUFFER_VIEWS:O,TYPED_ARRAY_CONSTRUCTOR:C,TYPED_ARRAY_TAG:P&&I,aTypedArray:function(t){if(N(t))return t;throw TypeError("Target is not a typed array")},aTypedArrayConstructor:function(t){if(v&&!k.call(S,t))throw TypeError("Target is not a typed array constructor");return t},exportTypedArrayMethod:function(t,e,n){if(s){if(n)for(var r in R){var o=u[r];if(o&&l(o.prototype,t))try{delete o.prototype[t]}catch(i){}}x[t]&&!n||h(x,t,n?e:O&&_[t]||e)}},exportTypedArrayStaticMethod:function(t,e,n){var r,o;if(s){if(v){if(n)for(r in R)if((o=u[r])&&l(o,t))try{delete o[t]}catch(i){}if(S[t]&&!n)return;try{return h(S,t,n?e:O&&S[t]||e)}catch(i){}}for(r in R)!(o=u[r])||o[t]&&!n||h(o,t,e)}},isView:function(t){if(!c(t))return!1;var e=f(t);return"DataView"===e||l(R,e)||l(D,e)},isTypedArray:N,TypedArray:S,TypedArrayPrototype:x}},"6JNq":function(t,e,n){var r=n("UTVS"),o=n("Vu81"),i=n("Bs8V"),a=n("m/L8");t.exports=function(t,e){for(var n=o(e),s=a.f,u=i.f,c=0;c<n.length;c++){var l=n[c];r(t,l)||s(t,l,u(e,l))}}},"6LWA":function(t,e,n){var r=n("xrYK");t.exports=Array.isArray||function(t){return"Array"==r(t)}},"6R/c":function(t,e,n){"use strict";var r=n("67WC"),o=n("TWQb").indexOf,i=r.aTypedArray;(0,r.exportTypedArrayMethod)("indexOf",function(t){return o(i(this),t,arguments.length>1?arguments[1]:void 0)})},"6VoE":function(t,e,n){var r=n("tiKp"),o=n("P4y1"),i=r("iterator"),a=Array.prototype;t.exports=function(t){return void 0!==t&&(o.Array===t||a[i]===t)}},"6cQw":function(t,e,n){var r=n("I+eb"),o=n("0GbY"),i=n("0Dky"),a=o("JSON","stringify"),s=/[\uD800-\uDFFF]/g,u=/^[\uD800-\uDBFF]$/,c=/^[\uDC00-\uDFFF]$/,l=function(t,e,n){var r=n.charAt(e-1),o=n.charAt(e+1);return u.test(t)&&!c.test(o)||c.test(t)&&!u.test(r)?"\\u"+t.charCodeAt(0).toString(16):t},f=i(function(){return'"\\udf06\\ud834"'!==a("\udf06\ud834")||'"\\udead"'!==a("\udead")});a&&r({target:"JSON",stat:!0,forced:f},{stringify:function(t,e,n){var r=a.apply(null,arguments);return"string"==typeof r?r.replace(s,l):r}})},"6x0u":function(t,e,n){"use strict";var r=n("xDBR"),o=n("2oRo"),i=n("0Dky"),a=n("USzg");t.exports=r||!i(function(){if(!(a&&a<535)){var t=Math.random();__defineSetter__.call(null,t,function(){}),delete o[t]}})},"7+kd":function(t,e,n){n("dG/n")("isConcatSpreadable")},"7+zs":function(t,e,n){var r=n("kRJp"),o=n("UesL"),i=n("tiKp")("toPrimitive"),a=Date.prototype;i in a||r(a,i,o)},"7JcK":function(t,e,n){"use strict";var r=n("67WC"),o=n("iqeF"),i=r.aTypedArrayConstructor;(0,r.exportTypedArrayStaticMethod)("of",function(){for(var t=0,e=arguments.length,n=new(i(this))(e);e>t;)n[t]=arguments[t++];return n},o)},"7ueG":function(t,e,n){"use strict";var r=n("I+eb"),o=n("WKiH").start,i=n("yNLB")("trimStart"),a=i?function(){return o(this)}:"".trimStart;r({target:"String",proto:!0,forced:i},{trimStart:a,trimLeft:a})},"8AyJ":function(t,e,n){n("I+eb")({target:"Number",stat:!0},{isFinite:n("4oU/")})},"8GlL":function(t,e,n){"use strict";var r=n("HAuM"),o=function(t){var e,n;this.promise=new t(function(t,r){if(void 0!==e||void 0!==n)throw TypeError("Bad Promise constructor");e=t,n=r}),this.resolve=r(e),this.reject=r(n)};t.exports.f=function(t){return new o(t)}},"8YOa":function(t,e,n){var r=n("I+eb"),o=n("0BK2"),i=n("hh1v"),a=n("UTVS"),s=n("m/L8").f,u=n("JBy8"),c=n("BX/b"),l=n("kOOl"),f=n("uy83"),p=!1,h=l("meta"),d=0,y=Object.isExtensible||function(){return!0},v=function(t){s(t,h,{value:{objectID:"O"+d++,weakData:{}}})},g=t.exports={enable:function(){g.enable=function(){},p=!0;var t=u.f,e=[].splice,n={};n[h]=1,t(n).length&&(u.f=function(n){for(var r=t(n),o=0,i=r.length;o<i;o++)if(r[o]===h){e.call(r,o,1);break}return r},r({target:"Object",stat:!0,forced:!0},{getOwnPropertyNames:c.f}))},fastKey:function(t,e){if(!i(t))return"symbol"==typeof t?t:("string"==typeof t?"S":"P")+t;if(!a(t,h)){if(!y(t))return"F";if(!e)return"E";v(t)}return t[h].objectID},getWeakData:function(t,e){if(!a(t,h)){if(!y(t))return!0;if(!e)return!1;v(t)}return t[h].weakData},onFreeze:function(t){return f&&p&&y(t)&&!a(t,h)&&v(t),t}};o[h]=!0},"90hW":function(t,e){t.exports=Math.sign||function(t){return 0==(t=+t)||t!=t?t:t<0?-1:1}},"93I0":function(t,e,n){var r=n("VpIT"),o=n("kOOl"),i=r("keys");t.exports=function(t){return i[t]||(i[t]=o(t))}},"94Xl":function(t,e,n){n("JiZb")("Array")},"9LPj":function(t,e,n){"use strict";var r=n("I+eb"),o=n("0Dky"),i=n("ewvW"),a=n("wE6v");r({target:"Date",proto:!0,forced:o(function(){return null!==new Date(NaN).toJSON()||1!==Date.prototype.toJSON.call({toISOString:function(){return 1}})})},{toJSON:function(t){var e=i(this),n=a(e,"number");return"number"!=typeof n||isFinite(n)?e.toISOString():null}})},"9N29":function(t,e,n){"use strict";var r=n("I+eb"),o=n("1Y/n").right,i=n("pkCn"),a=n("LQDL"),s=n("YF1G");r({target:"Array",proto:!0,forced:!i("reduceRight")||!s&&a>79&&a<83},{reduceRight:function(t){return o(this,t,arguments.length,arguments.length>1?arguments[1]:void 0)}})},"9bJ7":function(t,e,n){"use strict";var r=n("I+eb"),o=n("ZUd8").codeAt;r({target:"String",proto:!0},{codePointAt:function(t){return o(this,t)}})},"9d/t":function(t,e,n){var r=n("AO7/"),o=n("xrYK"),i=n("tiKp")("toStringTag"),a="Arguments"==o(function(){return arguments}());t.exports=r?o:function(t){var e,n,r;return void 0===t?"Undefined":null===t?"Null":"string"==typeof(n=function(t,e){try{return t[e]}catch(n){}}(e=Object(t),i))?n:a?o(e):"Object"==(r=o(e))&&"function"==typeof e.callee?"Arguments":r}},"9mRW":function(t,e,n){n("I+eb")({target:"Math",stat:!0},{fround:n("vo4V")})},"9tb/":function(t,e,n){var r=n("I+eb"),o=n("I8vh"),i=String.fromCharCode,a=String.fromCodePoint;r({target:"String",stat:!0,forced:!!a&&1!=a.length},{fromCodePoint:function(t){for(var e,n=[],r=arguments.length,a=0;r>a;){if(e=+arguments[a++],o(e,1114111)!==e)throw RangeError(e+" is not a valid code point");n.push(e<65536?i(e):i(55296+((e-=65536)>>10),e%1024+56320))}return n.join("")}})},A2ZE:function(t,e,n){var r=n("HAuM");t.exports=function(t,e,n){if(r(t),void 0===e)return t;switch(n){case 0:return function(){return t.call(e)};case 1:return function(n){return t.call(e,n)};case 2:return function(n,r){return t.call(e,n,r)};case 3:return function(n,r,o){return t.call(e,n,r,o)}}return function(){return t.apply(e,arguments)}}},ALS0:function(t,e,n){"use strict";n("rB9j");var r,o,i=n("I+eb"),a=n("hh1v"),s=(r=!1,(o=/[ac]/).exec=function(){return r=!0,/./.exec.apply(this,arguments)},!0===o.test("abc")&&r),u=/./.test;i({target:"RegExp",proto:!0,forced:!s},{test:function(t){if("function"!=typeof this.exec)return u.call(this,t);var e=this.exec(t);if(null!==e&&!a(e))throw new Error("RegExp exec method returned something other than an Object or null");return!!e}})},"AO7/":function(t,e,n){var r={};r[n("tiKp")("toStringTag")]="z",t.exports="[object z]"===String(r)},AmFO:function(t,e,n){var r=n("I+eb"),o=n("0Dky"),i=n("jrUv"),a=Math.abs,s=Math.exp,u=Math.E;r({target:"Math",stat:!0,forced:o(function(){return-2e-17!=Math.sinh(-2e-17)})},{sinh:function(t){return a(t=+t)<1?(i(t)-i(-t))/2:(s(t-1)-s(-t-1))*(u/2)}})},B6y2:function(t,e,n){var r=n("I+eb"),o=n("b1O7").values;r({target:"Object",stat:!0},{values:function(t){return o(t)}})},BIHw:function(t,e,n){"use strict";var r=n("I+eb"),o=n("or9q"),i=n("ewvW"),a=n("UMSQ"),s=n("ppGB"),u=n("ZfDv");r({target:"Array",proto:!0},{flat:function(){var t=arguments.length?arguments[0]:void 0,e=i(this),n=a(e.length),r=u(e,0);return r.length=o(r,e,e,n,0,void 0===t?1:s(t)),r}})},BNF5:function(t,e,n){var r=n("NC/Y").match(/firefox\/(\d+)/i);t.exports=!!r&&+r[1]},BNMt:function(t,e,n){"use strict";var r=n("I+eb"),o=n("hXpO");r({target:"String",proto:!0,forced:n("rwPt")("blink")},{blink:function(){return o(this,"blink","","")}})},BTho:function(t,e,n){"use strict";var r=n("HAuM"),o=n("hh1v"),i=[].slice,a={};t.exports=Function.bind||function(t){var e=r(this),n=i.call(arguments,1),s=function(){var r=n.concat(i.call(arguments));return this instanceof s?function(t,e,n){if(!(e in a)){for(var r=[],o=0;o<e;o++)r[o]="a["+o+"]";a[e]=Function("C,a","return new C("+r.join(",")+")")}return a[e](t,n)}(e,r.length,r):e.apply(t,r)};return o(e.prototype)&&(s.prototype=e.prototype),s}},"BX/b":function(t,e,n){var r=n("/GqU"),o=n("JBy8").f,i={}.toString,a="object"==typeof window&&window&&Object.getOwnPropertyNames?Object.getOwnPropertyNames(window):[];t.exports.f=function(t){return a&&"[object Window]"==i.call(t)?function(t){try{return o(t)}catch(e){return a.slice()}}(t):o(r(t))}},Bs8V:function(t,e,n){var r=n("g6v/"),o=n("0eef"),i=n("XGwC"),a=n("/GqU"),s=n("oEtG"),u=n("UTVS"),c=n("DPsx"),l=Object.getOwnPropertyDescriptor;e.f=r?l:function(t,e){if(t=a(t),e=s(e),c)try{return l(t,e)}catch(n){}if(u(t,e))return i(!o.f.call(t,e),t[e])}},C0Ia:function(t,e,n){var r=n("hh1v"),o=n("6LWA"),i=n("tiKp")("species");t.exports=function(t){var e;return o(t)&&("function"!=typeof(e=t.constructor)||e!==Array&&!o(e.prototype)?r(e)&&null===(e=e[i])&&(e=void 0):e=void 0),void 0===e?Array:e}},CpAL:function(t,e,n){"use strict";var r=n("I+eb"),o=n("0Dky")(function(){return 120!==new Date(16e11).getYear()}),i=Date.prototype.getFullYear;r({target:"Date",proto:!0,forced:o},{getYear:function(){return i.call(this)-1900}})},CsgD:function(t,e,n){var r=n("I+eb"),o=n("jrUv");r({target:"Math",stat:!0,forced:o!=Math.expm1},{expm1:o})},Cwz8:function(t,e){t.exports={OpenURL:function(t){return window.wails.Browser.OpenURL(t)},OpenFile:function(t){return window.wails.Browser.OpenFile(t)}}},CyXQ:function(t,e,n){var r=n("ppGB"),o=n("UMSQ");t.exports=function(t){if(void 0===t)return 0;var e=r(t),n=o(e);if(e!==n)throw RangeError("Wrong length or index");return n}},DEfu:function(t,e,n){var r=n("2oRo");n("1E5z")(r.JSON,"JSON",!0)},DLK6:function(t,e,n){var r=n("ewvW"),o=Math.floor,i="".replace,a=/\$([$&'`]|\d{1,2}|<[^>]*>)/g,s=/\$([$&'`]|\d{1,2})/g;t.exports=function(t,e,n,u,c,l){var f=n+t.length,p=u.length,h=s;return void 0!==c&&(c=r(c),h=a),i.call(l,h,function(r,i){var a;switch(i.charAt(0)){case"$":return"$";case"&":return t;case"`":return e.slice(0,n);case"'":return e.slice(f);case"<":a=c[i.slice(1,-1)];break;default:var s=+i;if(0===s)return r;if(s>p){var l=o(s/10);return 0===l?r:l<=p?void 0===u[l-1]?i.charAt(1):u[l-1]+i.charAt(1):r}a=u[s-1]}return void 0===a?"":a})}},DMt2:function(t,e,n){var r=n("UMSQ"),o=n("V37c"),i=n("EUja"),a=n("HYAF"),s=Math.ceil,u=function(t){return function(e,n,u){var c,l,f=o(a(e)),p=f.length,h=void 0===u?" ":o(u),d=r(n);return d<=p||""==h?f:((l=i.call(h,s((c=d-p)/h.length))).length>c&&(l=l.slice(0,c)),t?f+l:l+f)}};t.exports={start:u(!1),end:u(!0)}},DPsx:function(t,e,n){var r=n("g6v/"),o=n("0Dky"),i=n("zBJ4");t.exports=!r&&!o(function(){return 7!=Object.defineProperty(i("div"),"a",{get:function(){return 7}}).a})},DQNa:function(t,e,n){var r=n("busE"),o=Date.prototype,i=o.toString,a=o.getTime;"Invalid Date"!=String(new Date(NaN))&&r(o,"toString",function(){var t=a.call(this);return t==t?i.call(this):"Invalid Date"})},DSw1:function(t,e){t.exports={New:function(t,e){return window.wails.Store.New(t,e)}}},DTth:function(t,e,n){var r=n("0Dky"),o=n("tiKp"),i=n("xDBR"),a=o("iterator");t.exports=!r(function(){var t=new URL("b?a=1&b=2&c=3","http://a"),e=t.searchParams,n="";return t.pathname="c%20d",e.forEach(function(t,r){e.delete("b"),n+=r+t}),i&&!t.toJSON||!e.sort||"http://a/c%20d?a=1&c=3"!==t.href||"3"!==e.get("c")||"a=1"!==String(new URLSearchParams("?a=1"))||!e[a]||"a"!==new URL("https://a@b").username||"b"!==new URLSearchParams(new URLSearchParams("a=b")).get("a")||"xn--e1aybc"!==new URL("http://\u0442\u0435\u0441\u0442").host||"#%D0%B1"!==new URL("http://a#\u0431").hash||"a1c3"!==n||"x"!==new URL("http://x",void 0).host})},E5NM:function(t,e,n){"use strict";var r=n("I+eb"),o=n("hXpO");r({target:"String",proto:!0,forced:n("rwPt")("big")},{big:function(){return o(this,"big","","")}})},E9XD:function(t,e,n){"use strict";var r=n("I+eb"),o=n("1Y/n").left,i=n("pkCn"),a=n("LQDL"),s=n("YF1G");r({target:"Array",proto:!0,forced:!i("reduce")||!s&&a>79&&a<83},{reduce:function(t){return o(this,t,arguments.length,arguments.length>1?arguments[1]:void 0)}})},EHx7:function(t,e,n){var r=n("0Dky"),o=n("2oRo").RegExp;t.exports=r(function(){var t=o("(?<a>b)","g");return"b"!==t.exec("b").groups.a||"bc"!=="b".replace(t,"$<a>c")})},ENF9:function(t,e,n){"use strict";var r,o=n("2oRo"),i=n("4syw"),a=n("8YOa"),s=n("bWFh"),u=n("rKzb"),c=n("hh1v"),l=n("afO8").enforce,f=n("f5p1"),p=!o.ActiveXObject&&"ActiveXObject"in o,h=Object.isExtensible,d=function(t){return function(){return t(this,arguments.length?arguments[0]:void 0)}},y=t.exports=s("WeakMap",d,u);if(f&&p){r=u.getConstructor(d,"WeakMap",!0),a.enable();var v=y.prototype,g=v.delete,m=v.has,b=v.get,_=v.set;i(v,{delete:function(t){if(c(t)&&!h(t)){var e=l(this);return e.frozen||(e.frozen=new r),g.call(this,t)||e.frozen.delete(t)}return g.call(this,t)},has:function(t){if(c(t)&&!h(t)){var e=l(this);return e.frozen||(e.frozen=new r),m.call(this,t)||e.frozen.has(t)}return m.call(this,t)},get:function(t){if(c(t)&&!h(t)){var e=l(this);return e.frozen||(e.frozen=new r),m.call(this,t)?b.call(this,t):e.frozen.get(t)}return b.call(this,t)},set:function(t,e){if(c(t)&&!h(t)){var n=l(this);n.frozen||(n.frozen=new r),m.call(this,t)?_.call(this,t,e):n.frozen.set(t,e)}else _.call(this,t,e);return this}})}},EUja:function(t,e,n){"use strict";var r=n("ppGB"),o=n("V37c"),i=n("HYAF");t.exports=function(t){var e=o(i(this)),n="",a=r(t);if(a<0||a==1/0)throw RangeError("Wrong number of repetitions");for(;a>0;(a>>>=1)&&(e+=e))1&a&&(n+=e);return n}},EnZy:function(t,e,n){"use strict";var r=n("14Sl"),o=n("ROdP"),i=n("glrk"),a=n("HYAF"),s=n("SEBh"),u=n("iqWW"),c=n("UMSQ"),l=n("V37c"),f=n("FMNM"),p=n("kmMV"),h=n("n3/R"),d=n("0Dky"),y=h.UNSUPPORTED_Y,v=[].push,g=Math.min;r("split",function(t,e,n){var r;return r="c"=="abbc".split(/(b)*/)[1]||4!="test".split(/(?:)/,-1).length||2!="ab".split(/(?:ab)*/).length||4!=".".split(/(.?)(.?)/).length||".".split(/()()/).length>1||"".split(/.?/).length?function(t,n){var r=l(a(this)),i=void 0===n?4294967295:n>>>0;if(0===i)return[];if(void 0===t)return[r];if(!o(t))return e.call(r,t,i);for(var s,u,c,f=[],h=0,d=new RegExp(t.source,(t.ignoreCase?"i":"")+(t.multiline?"m":"")+(t.unicode?"u":"")+(t.sticky?"y":"")+"g");(s=p.call(d,r))&&!((u=d.lastIndex)>h&&(f.push(r.slice(h,s.index)),s.length>1&&s.index<r.length&&v.apply(f,s.slice(1)),c=s[0].length,h=u,f.length>=i));)d.lastIndex===s.index&&d.lastIndex++;return h===r.length?!c&&d.test("")||f.push(""):f.push(r.slice(h)),f.length>i?f.slice(0,i):f}:"0".split(void 0,0).length?function(t,n){return void 0===t&&0===n?[]:e.call(this,t,n)}:e,[function(e,n){var o=a(this),i=null==e?void 0:e[t];return void 0!==i?i.call(e,o,n):r.call(l(o),e,n)},function(t,o){var a=i(this),p=l(t),h=n(r,a,p,o,r!==e);if(h.done)return h.value;var d=s(a,RegExp),v=a.unicode,m=new d(y?"^(?:"+a.source+")":a,(a.ignoreCase?"i":"")+(a.multiline?"m":"")+(a.unicode?"u":"")+(y?"g":"y")),b=void 0===o?4294967295:o>>>0;if(0===b)return[];if(0===p.length)return null===f(m,p)?[p]:[];for(var _=0,w=0,E=[];w<p.length;){m.lastIndex=y?0:w;var S,x=f(m,y?p.slice(w):p);if(null===x||(S=g(c(m.lastIndex+(y?w:0)),p.length))===_)w=u(p,w,v);else{if(E.push(p.slice(_,w)),E.length===b)return E;for(var T=1;T<=x.length-1;T++)if(E.push(x[T]),E.length===b)return E;w=_=S}}return E.push(p.slice(_)),E}]},!!d(function(){var t=/(?:)/,e=t.exec;t.exec=function(){return e.apply(this,arguments)};var n="ab".split(t);return 2!==n.length||"a"!==n[0]||"b"!==n[1]}),y)},Ep9I:function(t,e){t.exports=Object.is||function(t,e){return t===e?0!==t||1/t==1/e:t!=t&&e!=e}},Eqjn:function(t,e,n){"use strict";var r=n("I+eb"),o=n("g6v/"),i=n("6x0u"),a=n("ewvW"),s=n("HAuM"),u=n("m/L8");o&&r({target:"Object",proto:!0,forced:i},{__defineGetter__:function(t,e){u.f(a(this),t,{get:s(e),enumerable:!0,configurable:!0})}})},"Ew+T":function(t,e,n){var r=n("I+eb"),o=n("2oRo"),i=n("LPSS");r({global:!0,bind:!0,enumerable:!0,forced:!o.setImmediate||!o.clearImmediate},{setImmediate:i.set,clearImmediate:i.clear})},ExoC:function(t,e,n){n("I+eb")({target:"Object",stat:!0},{setPrototypeOf:n("0rvr")})},F8JR:function(t,e,n){"use strict";var r=n("tycR").forEach,o=n("pkCn")("forEach");t.exports=o?[].forEach:function(t){return r(this,t,arguments.length>1?arguments[1]:void 0)}},FDzp:function(t,e,n){n("dOgj")("Int32",function(t){return function(e,n,r){return t(this,e,n,r)}})},FEiF:function(t,e,n){var r=n("37lR"),o=n("trfz");t.exports=function(t,e){return r(o(t),e)}},FF6l:function(t,e,n){"use strict";var r=n("ewvW"),o=n("I8vh"),i=n("UMSQ"),a=Math.min;t.exports=[].copyWithin||function(t,e){var n=r(this),s=i(n.length),u=o(t,s),c=o(e,s),l=arguments.length>2?arguments[2]:void 0,f=a((void 0===l?s:o(l,s))-c,s-u),p=1;for(c<u&&u<c+f&&(p=-1,c+=f-1,u+=f-1);f-- >0;)c in n?n[u]=n[c]:delete n[u],u+=p,c+=p;return n}},FMNM:function(t,e,n){var r=n("xrYK"),o=n("kmMV");t.exports=function(t,e){var n=t.exec;if("function"==typeof n){var i=n.call(t,e);if("object"!=typeof i)throw TypeError("RegExp exec method returned something other than an Object or null");return i}if("RegExp"!==r(t))throw TypeError("RegExp#exec called on incompatible receiver");return o.call(t,e)}},FZtP:function(t,e,n){var r=n("2oRo"),o=n("/byt"),i=n("F8JR"),a=n("kRJp");for(var s in o){var u=r[s],c=u&&u.prototype;if(c&&c.forEach!==i)try{a(c,"forEach",i)}catch(l){c.forEach=i}}},"Fwt+":function(t,e,n){"use strict";var r=n("67WC"),o=n("UMSQ"),i=n("I8vh"),a=n("trfz"),s=r.aTypedArray;(0,r.exportTypedArrayMethod)("subarray",function(t,e){var n=s(this),r=n.length,u=i(t,r);return new(a(n))(n.buffer,n.byteOffset+u*n.BYTES_PER_ELEMENT,o((void 0===e?r:i(e,r))-u))})},"G+Rx":function(t,e,n){var r=n("0GbY");t.exports=r("document","documentElement")},"G/JM":function(t,e,n){n("I+eb")({target:"Reflect",stat:!0},{ownKeys:n("Vu81")})},GC2F:function(t,e,n){var r=n("+M1K");t.exports=function(t,e){var n=r(t);if(n%e)throw RangeError("Wrong offset");return n}},GKVU:function(t,e,n){"use strict";var r=n("I+eb"),o=n("hXpO");r({target:"String",proto:!0,forced:n("rwPt")("anchor")},{anchor:function(t){return o(this,"a","name",t)}})},GRPF:function(t,e,n){"use strict";var r=n("I+eb"),o=n("hXpO");r({target:"String",proto:!0,forced:n("rwPt")("fontsize")},{fontsize:function(t){return o(this,"font","size",t)}})},GXvd:function(t,e,n){n("dG/n")("species")},GarU:function(t,e){t.exports=function(t,e,n){if(!(t instanceof e))throw TypeError("Incorrect "+(n?n+" ":"")+"invocation");return t}},"H+LF":function(t,e,n){"use strict";n("bWFh")("WeakSet",function(t){return function(){return t(this,arguments.length?arguments[0]:void 0)}},n("rKzb"))},HAuM:function(t,e){t.exports=function(t){if("function"!=typeof t)throw TypeError(String(t)+" is not a function");return t}},HH4o:function(t,e,n){var r=n("tiKp")("iterator"),o=!1;try{var i=0,a={next:function(){return{done:!!i++}},return:function(){o=!0}};a[r]=function(){return this},Array.from(a,function(){throw 2})}catch(s){}t.exports=function(t,e){if(!e&&!o)return!1;var n=!1;try{var i={};i[r]=function(){return{next:function(){return{done:n=!0}}}},t(i)}catch(s){}return n}},HL5w:function(t,e){t.exports=function(t){window.wails._.Init(t)}},HNyW:function(t,e,n){var r=n("NC/Y");t.exports=/(?:ipad|iphone|ipod).*applewebkit/i.test(r)},HRxU:function(t,e,n){var r=n("I+eb"),o=n("g6v/");r({target:"Object",stat:!0,forced:!o,sham:!o},{defineProperties:n("N+g0")})},HYAF:function(t,e){t.exports=function(t){if(null==t)throw TypeError("Can't call method on "+t);return t}},Hd5f:function(t,e,n){var r=n("0Dky"),o=n("tiKp"),i=n("LQDL"),a=o("species");t.exports=function(t){return i>=51||!r(function(){var e=[];return(e.constructor={})[a]=function(){return{foo:1}},1!==e[t](Boolean).foo})}},HiXI:function(t,e,n){"use strict";var r=n("I+eb"),o=n("WKiH").end,i=n("yNLB")("trimEnd"),a=i?function(){return o(this)}:"".trimEnd;r({target:"String",proto:!0,forced:i},{trimEnd:a,trimRight:a})},HsHA:function(t,e){var n=Math.log;t.exports=Math.log1p||function(t){return(t=+t)>-1e-8&&t<1e-8?t-t*t/2:n(1+t)}},"I+eb":function(t,e,n){var r=n("2oRo"),o=n("Bs8V").f,i=n("kRJp"),a=n("busE"),s=n("zk60"),u=n("6JNq"),c=n("lMq5");t.exports=function(t,e){var n,l,f,p,h,d=t.target,y=t.global,v=t.stat;if(n=y?r:v?r[d]||s(d,{}):(r[d]||{}).prototype)for(l in e){if(p=e[l],f=t.noTargetGet?(h=o(n,l))&&h.value:n[l],!c(y?l:d+(v?".":"#")+l,t.forced)&&void 0!==f){if(typeof p==typeof f)continue;u(p,f)}(t.sham||f&&f.sham)&&i(p,"sham",!0),a(n,l,p,t)}}},I1Gw:function(t,e,n){n("dG/n")("split")},I8vh:function(t,e,n){var r=n("ppGB"),o=Math.max,i=Math.min;t.exports=function(t,e){var n=r(t);return n<0?o(n+e,0):i(n,e)}},I9xj:function(t,e,n){n("1E5z")(Math,"Math",!0)},"IL/d":function(t,e,n){"use strict";var r=n("iqeF");(0,n("67WC").exportTypedArrayStaticMethod)("from",n("oHi+"),r)},IZzc:function(t,e,n){"use strict";var r=n("67WC"),o=n("2oRo"),i=n("0Dky"),a=n("HAuM"),s=n("UMSQ"),u=n("rdv8"),c=n("BNF5"),l=n("2Zix"),f=n("LQDL"),p=n("USzg"),h=r.aTypedArray,d=r.exportTypedArrayMethod,y=o.Uint16Array,v=y&&y.prototype.sort,g=!!v&&!i(function(){var t=new y(2);t.sort(null),t.sort({})}),m=!!v&&!i(function(){if(f)return f<74;if(c)return c<67;if(l)return!0;if(p)return p<602;var t,e,n=new y(516),r=Array(516);for(t=0;t<516;t++)e=t%4,n[t]=515-t,r[t]=t-2*e+3;for(n.sort(function(t,e){return(t/4|0)-(e/4|0)}),t=0;t<516;t++)if(n[t]!==r[t])return!0});d("sort",function(t){if(void 0!==t&&a(t),m)return v.call(this,t);h(this);var e,n=s(this.length),r=Array(n);for(e=0;e<n;e++)r[e]=this[e];for(r=u(this,function(t){return function(e,n){return void 0!==t?+t(e,n)||0:n!=n?-1:e!=e?1:0===e&&0===n?1/e>0&&1/n<0?1:-1:e>n}}(t)),e=0;e<n;e++)this[e]=r[e];return this},!m||g)},ImZN:function(t,e,n){var r=n("glrk"),o=n("6VoE"),i=n("UMSQ"),a=n("A2ZE"),s=n("NaFW"),u=n("KmKo"),c=function(t,e){this.stopped=t,this.result=e};t.exports=function(t,e,n){var l,f,p,h,d,y,v,g=!(!n||!n.AS_ENTRIES),m=!(!n||!n.IS_ITERATOR),b=!(!n||!n.INTERRUPTED),_=a(e,n&&n.that,1+g+b),w=function(t){return l&&u(l),new c(!0,t)},E=function(t){return g?(r(t),b?_(t[0],t[1],w):_(t[0],t[1])):b?_(t,w):_(t)};if(m)l=t;else{if("function"!=typeof(f=s(t)))throw TypeError("Target is not iterable");if(o(f)){for(p=0,h=i(t.length);h>p;p++)if((d=E(t[p]))&&d instanceof c)return d;return new c(!1)}l=f.call(t)}for(y=l.next;!(v=y.call(l)).done;){try{d=E(v.value)}catch(S){throw u(l),S}if("object"==typeof d&&d&&d instanceof c)return d}return new c(!1)}},IxXR:function(t,e,n){"use strict";var r=n("I+eb"),o=n("hXpO");r({target:"String",proto:!0,forced:n("rwPt")("strike")},{strike:function(){return o(this,"strike","","")}})},J30X:function(t,e,n){n("I+eb")({target:"Array",stat:!0},{isArray:n("6LWA")})},JBy8:function(t,e,n){var r=n("yoRg"),o=n("eDl+").concat("length","prototype");e.f=Object.getOwnPropertyNames||function(t){return r(t,o)}},JTJg:function(t,e,n){"use strict";var r=n("I+eb"),o=n("WjRb"),i=n("HYAF"),a=n("V37c");r({target:"String",proto:!0,forced:!n("qxPZ")("includes")},{includes:function(t){return!!~a(i(this)).indexOf(a(o(t)),arguments.length>1?arguments[1]:void 0)}})},JaFt:function(t,e,n){"use strict";var r=n("67WC"),o=n("1Y/n").right,i=r.aTypedArray;(0,r.exportTypedArrayMethod)("reduceRight",function(t){return o(i(this),t,arguments.length,arguments.length>1?arguments[1]:void 0)})},JevA:function(t,e,n){var r=n("I+eb"),o=n("wg0c");r({target:"Number",stat:!0,forced:Number.parseInt!=o},{parseInt:o})},JfAA:function(t,e,n){"use strict";var r=n("busE"),o=n("glrk"),i=n("V37c"),a=n("0Dky"),s=n("rW0t"),u=RegExp.prototype,c=u.toString;(a(function(){return"/a/b"!=c.call({source:"a",flags:"b"})})||"toString"!=c.name)&&r(RegExp.prototype,"toString",function(){var t=o(this),e=i(t.source),n=t.flags;return"/"+e+"/"+i(void 0===n&&t instanceof RegExp&&!("flags"in u)?s.call(t):n)},{unsafe:!0})},JiZb:function(t,e,n){"use strict";var r=n("0GbY"),o=n("m/L8"),i=n("tiKp"),a=n("g6v/"),s=i("species");t.exports=function(t){var e=r(t);a&&e&&!e[s]&&(0,o.f)(e,s,{configurable:!0,get:function(){return this}})}},Junv:function(t,e,n){"use strict";var r=n("I+eb"),o=n("6LWA"),i=[].reverse,a=[1,2];r({target:"Array",proto:!0,forced:String(a)===String(a.reverse())},{reverse:function(){return o(this)&&(this.length=this.length),i.call(this)}})},KVSy:function(t,e,n){"use strict";var r=n("67WC"),o=n("trfz"),i=n("0Dky"),a=r.aTypedArray,s=[].slice;(0,r.exportTypedArrayMethod)("slice",function(t,e){for(var n=s.call(a(this),t,e),r=o(this),i=0,u=n.length,c=new r(u);u>i;)c[i]=n[i++];return c},i(function(){new Int8Array(1).slice()}))},KhsS:function(t,e,n){n("dG/n")("match")},KmKo:function(t,e,n){var r=n("glrk");t.exports=function(t){var e=t.return;if(void 0!==e)return r(e.call(t)).value}},KvGi:function(t,e,n){n("I+eb")({target:"Math",stat:!0},{sign:n("90hW")})},Kxld:function(t,e,n){n("I+eb")({target:"Object",stat:!0},{is:n("Ep9I")})},Kz25:function(t,e,n){"use strict";n("PKPk");var r,o=n("I+eb"),i=n("g6v/"),a=n("DTth"),s=n("2oRo"),u=n("N+g0"),c=n("busE"),l=n("GarU"),f=n("UTVS"),p=n("YNrV"),h=n("TfTi"),d=n("ZUd8").codeAt,y=n("X7LM"),v=n("V37c"),g=n("1E5z"),m=n("mGGf"),b=n("afO8"),_=s.URL,w=m.URLSearchParams,E=m.getState,S=b.set,x=b.getterFor("URL"),T=Math.floor,k=Math.pow,A=/[A-Za-z]/,I=/[\d+-.A-Za-z]/,C=/\d/,O=/^0x/i,P=/^[0-7]+$/,R=/^\d+$/,D=/^[\dA-Fa-f]+$/,N=/[\0\t\n\r #%/:<>?@[\\\]^|]/,M=/[\0\t\n\r #/:<>?@[\\\]^|]/,j=/^[\u0000-\u0020]+|[\u0000-\u0020]+$/g,F=/[\t\n\r]/g,L=function(t,e){var n,r,o;if("["==e.charAt(0)){if("]"!=e.charAt(e.length-1))return"Invalid host";if(!(n=V(e.slice(1,-1))))return"Invalid host";t.host=n}else if(K(t)){if(e=y(e),N.test(e))return"Invalid host";if(null===(n=B(e)))return"Invalid host";t.host=n}else{if(M.test(e))return"Invalid host";for(n="",r=h(e),o=0;o<r.length;o++)n+=q(r[o],z);t.host=n}},B=function(t){var e,n,r,o,i,a,s,u=t.split(".");if(u.length&&""==u[u.length-1]&&u.pop(),(e=u.length)>4)return t;for(n=[],r=0;r<e;r++){if(""==(o=u[r]))return t;if(i=10,o.length>1&&"0"==o.charAt(0)&&(i=O.test(o)?16:8,o=o.slice(8==i?1:2)),""===o)a=0;else{if(!(10==i?R:8==i?P:D).test(o))return t;a=parseInt(o,i)}n.push(a)}for(r=0;r<e;r++)if(a=n[r],r==e-1){if(a>=k(256,5-e))return null}else if(a>255)return null;for(s=n.pop(),r=0;r<n.length;r++)s+=n[r]*k(256,3-r);return s},V=function(t){var e,n,r,o,i,a,s,u=[0,0,0,0,0,0,0,0],c=0,l=null,f=0,p=function(){return t.charAt(f)};if(":"==p()){if(":"!=t.charAt(1))return;f+=2,l=++c}for(;p();){if(8==c)return;if(":"!=p()){for(e=n=0;n<4&&D.test(p());)e=16*e+parseInt(p(),16),f++,n++;if("."==p()){if(0==n)return;if(f-=n,c>6)return;for(r=0;p();){if(o=null,r>0){if(!("."==p()&&r<4))return;f++}if(!C.test(p()))return;for(;C.test(p());){if(i=parseInt(p(),10),null===o)o=i;else{if(0==o)return;o=10*o+i}if(o>255)return;f++}u[c]=256*u[c]+o,2!=++r&&4!=r||c++}if(4!=r)return;break}if(":"==p()){if(f++,!p())return}else if(p())return;u[c++]=e}else{if(null!==l)return;f++,l=++c}}if(null!==l)for(a=c-l,c=7;0!=c&&a>0;)s=u[c],u[c--]=u[l+a-1],u[l+--a]=s;else if(8!=c)return;return u},H=function(t){var e,n,r,o;if("number"==typeof t){for(e=[],n=0;n<4;n++)e.unshift(t%256),t=T(t/256);return e.join(".")}if("object"==typeof t){for(e="",r=function(t){for(var e=null,n=1,r=null,o=0,i=0;i<8;i++)0!==t[i]?(o>n&&(e=r,n=o),r=null,o=0):(null===r&&(r=i),++o);return o>n&&(e=r,n=o),e}(t),n=0;n<8;n++)o&&0===t[n]||(o&&(o=!1),r===n?(e+=n?":":"::",o=!0):(e+=t[n].toString(16),n<7&&(e+=":")));return"["+e+"]"}return t},z={},U=p({},z,{" ":1,'"':1,"<":1,">":1,"`":1}),W=p({},U,{"#":1,"?":1,"{":1,"}":1}),Z=p({},W,{"/":1,":":1,";":1,"=":1,"@":1,"[":1,"\\":1,"]":1,"^":1,"|":1}),q=function(t,e){var n=d(t,0);return n>32&&n<127&&!f(e,t)?t:encodeURIComponent(t)},G={ftp:21,file:null,http:80,https:443,ws:80,wss:443},K=function(t){return f(G,t.scheme)},Q=function(t){return""!=t.username||""!=t.password},Y=function(t){return!t.host||t.cannotBeABaseURL||"file"==t.scheme},X=function(t,e){var n;return 2==t.length&&A.test(t.charAt(0))&&(":"==(n=t.charAt(1))||!e&&"|"==n)},J=function(t){var e;return t.length>1&&X(t.slice(0,2))&&(2==t.length||"/"===(e=t.charAt(2))||"\\"===e||"?"===e||"#"===e)},$=function(t){var e=t.path,n=e.length;!n||"file"==t.scheme&&1==n&&X(e[0],!0)||e.pop()},tt=function(t){return"."===t||"%2e"===t.toLowerCase()},et={},nt={},rt={},ot={},it={},at={},st={},ut={},ct={},lt={},ft={},pt={},ht={},dt={},yt={},vt={},gt={},mt={},bt={},_t={},wt={},Et=function(t,e,n,o){var i,a,s,u,c,l=n||et,p=0,d="",y=!1,v=!1,g=!1;for(n||(t.scheme="",t.username="",t.password="",t.host=null,t.port=null,t.path=[],t.query=null,t.fragment=null,t.cannotBeABaseURL=!1,e=e.replace(j,"")),e=e.replace(F,""),i=h(e);p<=i.length;){switch(a=i[p],l){case et:if(!a||!A.test(a)){if(n)return"Invalid scheme";l=rt;continue}d+=a.toLowerCase(),l=nt;break;case nt:if(a&&(I.test(a)||"+"==a||"-"==a||"."==a))d+=a.toLowerCase();else{if(":"!=a){if(n)return"Invalid scheme";d="",l=rt,p=0;continue}if(n&&(K(t)!=f(G,d)||"file"==d&&(Q(t)||null!==t.port)||"file"==t.scheme&&!t.host))return;if(t.scheme=d,n)return void(K(t)&&G[t.scheme]==t.port&&(t.port=null));d="","file"==t.scheme?l=dt:K(t)&&o&&o.scheme==t.scheme?l=ot:K(t)?l=ut:"/"==i[p+1]?(l=it,p++):(t.cannotBeABaseURL=!0,t.path.push(""),l=bt)}break;case rt:if(!o||o.cannotBeABaseURL&&"#"!=a)return"Invalid scheme";if(o.cannotBeABaseURL&&"#"==a){t.scheme=o.scheme,t.path=o.path.slice(),t.query=o.query,t.fragment="",t.cannotBeABaseURL=!0,l=wt;break}l="file"==o.scheme?dt:at;continue;case ot:if("/"!=a||"/"!=i[p+1]){l=at;continue}l=ct,p++;break;case it:if("/"==a){l=lt;break}l=mt;continue;case at:if(t.scheme=o.scheme,a==r)t.username=o.username,t.password=o.password,t.host=o.host,t.port=o.port,t.path=o.path.slice(),t.query=o.query;else if("/"==a||"\\"==a&&K(t))l=st;else if("?"==a)t.username=o.username,t.password=o.password,t.host=o.host,t.port=o.port,t.path=o.path.slice(),t.query="",l=_t;else{if("#"!=a){t.username=o.username,t.password=o.password,t.host=o.host,t.port=o.port,t.path=o.path.slice(),t.path.pop(),l=mt;continue}t.username=o.username,t.password=o.password,t.host=o.host,t.port=o.port,t.path=o.path.slice(),t.query=o.query,t.fragment="",l=wt}break;case st:if(!K(t)||"/"!=a&&"\\"!=a){if("/"!=a){t.username=o.username,t.password=o.password,t.host=o.host,t.port=o.port,l=mt;continue}l=lt}else l=ct;break;case ut:if(l=ct,"/"!=a||"/"!=d.charAt(p+1))continue;p++;break;case ct:if("/"!=a&&"\\"!=a){l=lt;continue}break;case lt:if("@"==a){y&&(d="%40"+d),y=!0,s=h(d);for(var m=0;m<s.length;m++){var b=s[m];if(":"!=b||g){var _=q(b,Z);g?t.password+=_:t.username+=_}else g=!0}d=""}else if(a==r||"/"==a||"?"==a||"#"==a||"\\"==a&&K(t)){if(y&&""==d)return"Invalid authority";p-=h(d).length+1,d="",l=ft}else d+=a;break;case ft:case pt:if(n&&"file"==t.scheme){l=vt;continue}if(":"!=a||v){if(a==r||"/"==a||"?"==a||"#"==a||"\\"==a&&K(t)){if(K(t)&&""==d)return"Invalid host";if(n&&""==d&&(Q(t)||null!==t.port))return;if(u=L(t,d))return u;if(d="",l=gt,n)return;continue}"["==a?v=!0:"]"==a&&(v=!1),d+=a}else{if(""==d)return"Invalid host";if(u=L(t,d))return u;if(d="",l=ht,n==pt)return}break;case ht:if(!C.test(a)){if(a==r||"/"==a||"?"==a||"#"==a||"\\"==a&&K(t)||n){if(""!=d){var w=parseInt(d,10);if(w>65535)return"Invalid port";t.port=K(t)&&w===G[t.scheme]?null:w,d=""}if(n)return;l=gt;continue}return"Invalid port"}d+=a;break;case dt:if(t.scheme="file","/"==a||"\\"==a)l=yt;else{if(!o||"file"!=o.scheme){l=mt;continue}if(a==r)t.host=o.host,t.path=o.path.slice(),t.query=o.query;else if("?"==a)t.host=o.host,t.path=o.path.slice(),t.query="",l=_t;else{if("#"!=a){J(i.slice(p).join(""))||(t.host=o.host,t.path=o.path.slice(),$(t)),l=mt;continue}t.host=o.host,t.path=o.path.slice(),t.query=o.query,t.fragment="",l=wt}}break;case yt:if("/"==a||"\\"==a){l=vt;break}o&&"file"==o.scheme&&!J(i.slice(p).join(""))&&(X(o.path[0],!0)?t.path.push(o.path[0]):t.host=o.host),l=mt;continue;case vt:if(a==r||"/"==a||"\\"==a||"?"==a||"#"==a){if(!n&&X(d))l=mt;else if(""==d){if(t.host="",n)return;l=gt}else{if(u=L(t,d))return u;if("localhost"==t.host&&(t.host=""),n)return;d="",l=gt}continue}d+=a;break;case gt:if(K(t)){if(l=mt,"/"!=a&&"\\"!=a)continue}else if(n||"?"!=a)if(n||"#"!=a){if(a!=r&&(l=mt,"/"!=a))continue}else t.fragment="",l=wt;else t.query="",l=_t;break;case mt:if(a==r||"/"==a||"\\"==a&&K(t)||!n&&("?"==a||"#"==a)){if(".."===(c=(c=d).toLowerCase())||"%2e."===c||".%2e"===c||"%2e%2e"===c?($(t),"/"==a||"\\"==a&&K(t)||t.path.push("")):tt(d)?"/"==a||"\\"==a&&K(t)||t.path.push(""):("file"==t.scheme&&!t.path.length&&X(d)&&(t.host&&(t.host=""),d=d.charAt(0)+":"),t.path.push(d)),d="","file"==t.scheme&&(a==r||"?"==a||"#"==a))for(;t.path.length>1&&""===t.path[0];)t.path.shift();"?"==a?(t.query="",l=_t):"#"==a&&(t.fragment="",l=wt)}else d+=q(a,W);break;case bt:"?"==a?(t.query="",l=_t):"#"==a?(t.fragment="",l=wt):a!=r&&(t.path[0]+=q(a,z));break;case _t:n||"#"!=a?a!=r&&("'"==a&&K(t)?t.query+="%27":t.query+="#"==a?"%23":q(a,z)):(t.fragment="",l=wt);break;case wt:a!=r&&(t.fragment+=q(a,U))}p++}},St=function(t){var e,n,r=l(this,St,"URL"),o=arguments.length>1?arguments[1]:void 0,a=v(t),s=S(r,{type:"URL"});if(void 0!==o)if(o instanceof St)e=x(o);else if(n=Et(e={},v(o)))throw TypeError(n);if(n=Et(s,a,null,e))throw TypeError(n);var u=s.searchParams=new w,c=E(u);c.updateSearchParams(s.query),c.updateURL=function(){s.query=String(u)||null},i||(r.href=Tt.call(r),r.origin=kt.call(r),r.protocol=At.call(r),r.username=It.call(r),r.password=Ct.call(r),r.host=Ot.call(r),r.hostname=Pt.call(r),r.port=Rt.call(r),r.pathname=Dt.call(r),r.search=Nt.call(r),r.searchParams=Mt.call(r),r.hash=jt.call(r))},xt=St.prototype,Tt=function(){var t=x(this),e=t.scheme,n=t.username,r=t.password,o=t.host,i=t.port,a=t.path,s=t.query,u=t.fragment,c=e+":";return null!==o?(c+="//",Q(t)&&(c+=n+(r?":"+r:"")+"@"),c+=H(o),null!==i&&(c+=":"+i)):"file"==e&&(c+="//"),c+=t.cannotBeABaseURL?a[0]:a.length?"/"+a.join("/"):"",null!==s&&(c+="?"+s),null!==u&&(c+="#"+u),c},kt=function(){var t=x(this),e=t.scheme,n=t.port;if("blob"==e)try{return new St(e.path[0]).origin}catch(r){return"null"}return"file"!=e&&K(t)?e+"://"+H(t.host)+(null!==n?":"+n:""):"null"},At=function(){return x(this).scheme+":"},It=function(){return x(this).username},Ct=function(){return x(this).password},Ot=function(){var t=x(this),e=t.host,n=t.port;return null===e?"":null===n?H(e):H(e)+":"+n},Pt=function(){var t=x(this).host;return null===t?"":H(t)},Rt=function(){var t=x(this).port;return null===t?"":String(t)},Dt=function(){var t=x(this),e=t.path;return t.cannotBeABaseURL?e[0]:e.length?"/"+e.join("/"):""},Nt=function(){var t=x(this).query;return t?"?"+t:""},Mt=function(){return x(this).searchParams},jt=function(){var t=x(this).fragment;return t?"#"+t:""},Ft=function(t,e){return{get:t,set:e,configurable:!0,enumerable:!0}};if(i&&u(xt,{href:Ft(Tt,function(t){var e=x(this),n=v(t),r=Et(e,n);if(r)throw TypeError(r);E(e.searchParams).updateSearchParams(e.query)}),origin:Ft(kt),protocol:Ft(At,function(t){var e=x(this);Et(e,v(t)+":",et)}),username:Ft(It,function(t){var e=x(this),n=h(v(t));if(!Y(e)){e.username="";for(var r=0;r<n.length;r++)e.username+=q(n[r],Z)}}),password:Ft(Ct,function(t){var e=x(this),n=h(v(t));if(!Y(e)){e.password="";for(var r=0;r<n.length;r++)e.password+=q(n[r],Z)}}),host:Ft(Ot,function(t){var e=x(this);e.cannotBeABaseURL||Et(e,v(t),ft)}),hostname:Ft(Pt,function(t){var e=x(this);e.cannotBeABaseURL||Et(e,v(t),pt)}),port:Ft(Rt,function(t){var e=x(this);Y(e)||(""==(t=v(t))?e.port=null:Et(e,t,ht))}),pathname:Ft(Dt,function(t){var e=x(this);e.cannotBeABaseURL||(e.path=[],Et(e,v(t),gt))}),search:Ft(Nt,function(t){var e=x(this);""==(t=v(t))?e.query=null:("?"==t.charAt(0)&&(t=t.slice(1)),e.query="",Et(e,t,_t)),E(e.searchParams).updateSearchParams(e.query)}),searchParams:Ft(Mt),hash:Ft(jt,function(t){var e=x(this);""!=(t=v(t))?("#"==t.charAt(0)&&(t=t.slice(1)),e.fragment="",Et(e,t,wt)):e.fragment=null})}),c(xt,"toJSON",function(){return Tt.call(this)},{enumerable:!0}),c(xt,"toString",function(){return Tt.call(this)},{enumerable:!0}),_){var Lt=_.createObjectURL,Bt=_.revokeObjectURL;Lt&&c(St,"createObjectURL",function(t){return Lt.apply(_,arguments)}),Bt&&c(St,"revokeObjectURL",function(t){return Bt.apply(_,arguments)})}g(St,"URL"),o({global:!0,forced:!a,sham:!i},{URL:St})},LD7m:function(t,e,n){var r=n("g6v/"),o=n("n3/R").UNSUPPORTED_Y,i=n("m/L8").f,a=n("afO8").get,s=RegExp.prototype;r&&o&&i(s,"sticky",{configurable:!0,get:function(){if(this!==s){if(this instanceof RegExp)return!!a(this).sticky;throw TypeError("Incompatible receiver, RegExp required")}}})},LKBx:function(t,e,n){"use strict";var r,o=n("I+eb"),i=n("Bs8V").f,a=n("UMSQ"),s=n("V37c"),u=n("WjRb"),c=n("HYAF"),l=n("qxPZ"),f=n("xDBR"),p="".startsWith,h=Math.min,d=l("startsWith");o({target:"String",proto:!0,forced:!(!f&&!d&&(r=i(String.prototype,"startsWith"),r&&!r.writable)||d)},{startsWith:function(t){var e=s(c(this));u(t);var n=a(h(arguments.length>1?arguments[1]:void 0,e.length)),r=s(t);return p?p.call(e,r,n):e.slice(n,n+r.length)===r}})},LPSS:function(t,e,n){var r,o,i,a,s=n("2oRo"),u=n("0Dky"),c=n("A2ZE"),l=n("G+Rx"),f=n("zBJ4"),p=n("HNyW"),h=n("YF1G"),d=s.setImmediate,y=s.clearImmediate,v=s.process,g=s.MessageChannel,m=s.Dispatch,b=0,_={};try{r=s.location}catch(T){}var w=function(t){if(_.hasOwnProperty(t)){var e=_[t];delete _[t],e()}},E=function(t){return function(){w(t)}},S=function(t){w(t.data)},x=function(t){s.postMessage(String(t),r.protocol+"//"+r.host)};d&&y||(d=function(t){for(var e=[],n=arguments.length,r=1;n>r;)e.push(arguments[r++]);return _[++b]=function(){("function"==typeof t?t:Function(t)).apply(void 0,e)},o(b),b},y=function(t){delete _[t]},h?o=function(t){v.nextTick(E(t))}:m&&m.now?o=function(t){m.now(E(t))}:g&&!p?(a=(i=new g).port2,i.port1.onmessage=S,o=c(a.postMessage,a,1)):s.addEventListener&&"function"==typeof postMessage&&!s.importScripts&&r&&"file:"!==r.protocol&&!u(x)?(o=x,s.addEventListener("message",S,!1)):o="onreadystatechange"in f("script")?function(t){l.appendChild(f("script")).onreadystatechange=function(){l.removeChild(this),w(t)}}:function(t){setTimeout(E(t),0)}),t.exports={set:d,clear:y}},LQDL:function(t,e,n){var r,o,i=n("2oRo"),a=n("NC/Y"),s=i.process,u=i.Deno,c=s&&s.versions||u&&u.version,l=c&&c.v8;l?o=(r=l.split("."))[0]<4?1:r[0]+r[1]:a&&(!(r=a.match(/Edge\/(\d+)/))||r[1]>=74)&&(r=a.match(/Chrome\/(\d+)/))&&(o=r[1]),t.exports=o&&+o},LSZE:function(t,e,n){n("pNMO"),n("4Brf"),n("tjZM"),n("3I1R"),n("7+kd"),n("0oug"),n("KhsS"),n("jt2F"),n("gOCb"),n("a57n"),n("GXvd"),n("I1Gw"),n("gXIK"),n("lEou"),n("gbiT"),n("lnpS"),n("ma9I"),n("qHT+"),n("piMb"),n("yyme"),n("TeQF"),n("fbCW"),n("x0AG"),n("BIHw"),n("XbcX"),n("QWBl"),n("pjDv"),n("yq1k"),n("yXV3"),n("J30X"),n("4mDm"),n("oVuX"),n("uqXc"),n("2B1R"),n("Xe3L"),n("E9XD"),n("9N29"),n("Junv"),n("+2oP"),n("Rfxz"),n("ToJy"),n("94Xl"),n("pDQq"),n("QGkA"),n("c9m3"),n("wZ/5"),n("gtqK"),n("rOQg"),n("tCCV"),n("CpAL"),n("brp2"),n("kyGr"),n("0boY"),n("rMz7"),n("9LPj"),n("7+zs"),n("DQNa"),n("TRJa"),n("wLYn"),n("tW5y"),n("sMBO"),n("bFeb"),n("6cQw"),n("DEfu"),n("Tskq"),n("Uydy"),n("eajv"),n("n/mU"),n("PqOI"),n("QNnp"),n("/5zm"),n("CsgD"),n("9mRW"),n("QFcT"),n("vAFs"),n("a5NK"),n("yiG3"),n("kNcU"),n("KvGi"),n("AmFO"),n("eJiR"),n("I9xj"),n("tl/u"),n("qePV"),n("NbN+"),n("8AyJ"),n("i6QF"),n("kSko"),n("WDsR"),n("r/Vq"),n("5uH8"),n("w1rZ"),n("JevA"),n("toAj"),n("VC3L"),n("zKZe"),n("uL8W"),n("Eqjn"),n("HRxU"),n("eoL8"),n("5xtp"),n("T63A"),n("3KgV"),n("wfmh"),n("5DmW"),n("27RR"),n("cDke"),n("NBAS"),n("Kxld"),n("yQYn"),n("4h0Y"),n("5D5o"),n("tkto"),n("v5b1"),n("W/eh"),n("zuhW"),n("r5Og"),n("ExoC"),n("07d7"),n("B6y2"),n("rNhl"),n("4l63"),n("5s+n"),n("gg6r"),n("2/pz"),n("p532"),n("pv2x"),n("SuFq"),n("PzqY"),n("rBZX"),n("XUE8"),n("nkod"),n("f3jH"),n("x2An"),n("25bX"),n("G/JM"),n("1t3B"),n("ftMj"),n("i5pp"),n("+MnM"),n("TWNs"),n("xgco"),n("rB9j"),n("U3f4"),n("LD7m"),n("ALS0"),n("JfAA"),n("YGK4"),n("9bJ7"),n("inlA"),n("9tb/"),n("JTJg"),n("PKPk"),n("Rm1S"),n("ofBz"),n("hDyC"),n("TZCg"),n("2A+d"),n("OM9Z"),n("UxlC"),n("W4Ht"),n("hByQ"),n("EnZy"),n("LKBx"),n("4yNf"),n("SYor"),n("HiXI"),n("7ueG"),n("GKVU"),n("E5NM"),n("BNMt"),n("zHFu"),n("x83w"),n("l2dK"),n("GRPF"),n("xdBZ"),n("mRH6"),n("yWo2"),n("IxXR"),n("TFPT"),n("Zk8X"),n("z8NH"),n("SpvK"),n("/Yfv"),n("iwkZ"),n("FDzp"),n("XMab"),n("ilnZ"),n("hMMk"),n("+ywr"),n("moxL"),n("qXVe"),n("c162"),n("waxf"),n("0TkE"),n("Onu3"),n("1dYe"),n("IL/d"),n("gvgV"),n("6R/c"),n("YL0P"),n("X5Zq"),n("MoCz"),n("P8wP"),n("7JcK"),n("ypFw"),n("JaFt"),n("zSZm"),n("PF2M"),n("KVSy"),n("ZJ55"),n("IZzc"),n("Fwt+"),n("s5qe"),n("cvf0"),n("klfK"),n("ENF9"),n("H+LF"),n("FZtP"),n("3bBZ"),n("Ew+T"),n("n5b4"),n("R5XZ"),n("Kz25"),n("vxnP"),n("mGGf"),t.exports=n("Qo9l")},MoCz:function(t,e,n){"use strict";var r=n("67WC"),o=n("5Yz+"),i=r.aTypedArray;(0,r.exportTypedArrayMethod)("lastIndexOf",function(t){return o.apply(i(this),arguments)})},"N+g0":function(t,e,n){var r=n("g6v/"),o=n("m/L8"),i=n("glrk"),a=n("33Wh");t.exports=r?Object.defineProperties:function(t,e){i(t);for(var n,r=a(e),s=r.length,u=0;s>u;)o.f(t,n=r[u++],e[n]);return t}},NBAS:function(t,e,n){var r=n("I+eb"),o=n("0Dky"),i=n("ewvW"),a=n("4WOD"),s=n("4Xet");r({target:"Object",stat:!0,forced:o(function(){a(1)}),sham:!s},{getPrototypeOf:function(t){return a(i(t))}})},"NC/Y":function(t,e,n){var r=n("0GbY");t.exports=r("navigator","userAgent")||""},NaFW:function(t,e,n){var r=n("9d/t"),o=n("P4y1"),i=n("tiKp")("iterator");t.exports=function(t){if(null!=t)return t[i]||t["@@iterator"]||o[r(t)]}},"NbN+":function(t,e,n){n("I+eb")({target:"Number",stat:!0},{EPSILON:Math.pow(2,-52)})},O741:function(t,e,n){var r=n("hh1v");t.exports=function(t){if(!r(t)&&null!==t)throw TypeError("Can't set "+String(t)+" as a prototype");return t}},OM9Z:function(t,e,n){n("I+eb")({target:"String",proto:!0},{repeat:n("EUja")})},Onu3:function(t,e,n){"use strict";var r=n("67WC"),o=n("tycR").findIndex,i=r.aTypedArray;(0,r.exportTypedArrayMethod)("findIndex",function(t){return o(i(this),t,arguments.length>1?arguments[1]:void 0)})},P4y1:function(t,e){t.exports={}},P8wP:function(t,e,n){"use strict";var r=n("67WC"),o=n("tycR").map,i=n("trfz"),a=r.aTypedArray;(0,r.exportTypedArrayMethod)("map",function(t){return o(a(this),t,arguments.length>1?arguments[1]:void 0,function(t,e){return new(i(t))(e)})})},PF2M:function(t,e,n){"use strict";var r=n("67WC"),o=n("UMSQ"),i=n("GC2F"),a=n("ewvW"),s=n("0Dky"),u=r.aTypedArray;(0,r.exportTypedArrayMethod)("set",function(t){u(this);var e=i(arguments.length>1?arguments[1]:void 0,1),n=this.length,r=a(t),s=o(r.length),c=0;if(s+e>n)throw RangeError("Wrong length");for(;c<s;)this[e+c]=r[c++]},s(function(){new Int8Array(1).set({})}))},PKPk:function(t,e,n){"use strict";var r=n("ZUd8").charAt,o=n("V37c"),i=n("afO8"),a=n("fdAy"),s=i.set,u=i.getterFor("String Iterator");a(String,"String",function(t){s(this,{type:"String Iterator",string:o(t),index:0})},function(){var t,e=u(this),n=e.string,o=e.index;return o>=n.length?{value:void 0,done:!0}:(t=r(n,o),e.index+=t.length,{value:t,done:!1})})},PqOI:function(t,e,n){var r=n("I+eb"),o=n("90hW"),i=Math.abs,a=Math.pow;r({target:"Math",stat:!0},{cbrt:function(t){return o(t=+t)*a(i(t),1/3)}})},PzqY:function(t,e,n){var r=n("I+eb"),o=n("g6v/"),i=n("glrk"),a=n("oEtG"),s=n("m/L8");r({target:"Reflect",stat:!0,forced:n("0Dky")(function(){Reflect.defineProperty(s.f({},1,{value:1}),1,{value:2})}),sham:!o},{defineProperty:function(t,e,n){i(t);var r=a(e);i(n);try{return s.f(t,r,n),!0}catch(o){return!1}}})},QFcT:function(t,e,n){var r=n("I+eb"),o=Math.hypot,i=Math.abs,a=Math.sqrt;r({target:"Math",stat:!0,forced:!!o&&o(1/0,NaN)!==1/0},{hypot:function(t,e){for(var n,r,o=0,s=0,u=arguments.length,c=0;s<u;)c<(n=i(arguments[s++]))?(o=o*(r=c/n)*r+1,c=n):o+=n>0?(r=n/c)*r:n;return c===1/0?1/0:c*a(o)}})},QGkA:function(t,e,n){n("RNIs")("flat")},QIpd:function(t,e,n){var r=n("xrYK");t.exports=function(t){if("number"!=typeof t&&"Number"!=r(t))throw TypeError("Incorrect invocation");return+t}},QNnp:function(t,e,n){var r=n("I+eb"),o=Math.floor,i=Math.log,a=Math.LOG2E;r({target:"Math",stat:!0},{clz32:function(t){return(t>>>=0)?31-o(i(t+.5)*a):32}})},QWBl:function(t,e,n){"use strict";var r=n("I+eb"),o=n("F8JR");r({target:"Array",proto:!0,forced:[].forEach!=o},{forEach:o})},Qo9l:function(t,e,n){var r=n("2oRo");t.exports=r},R5XZ:function(t,e,n){var r=n("I+eb"),o=n("2oRo"),i=n("NC/Y"),a=[].slice,s=function(t){return function(e,n){var r=arguments.length>2,o=r?a.call(arguments,2):void 0;return t(r?function(){("function"==typeof e?e:Function(e)).apply(this,o)}:e,n)}};r({global:!0,bind:!0,forced:/MSIE .\./.test(i)},{setTimeout:s(o.setTimeout),setInterval:s(o.setInterval)})},RK3t:function(t,e,n){var r=n("0Dky"),o=n("xrYK"),i="".split;t.exports=r(function(){return!Object("z").propertyIsEnumerable(0)})?function(t){return"String"==o(t)?i.call(t,""):Object(t)}:Object},RN6c:function(t,e,n){var r=n("2oRo");t.exports=function(t,e){var n=r.console;n&&n.error&&(1===arguments.length?n.error(t):n.error(t,e))}},RNIs:function(t,e,n){var r=n("tiKp"),o=n("fHMY"),i=n("m/L8"),a=r("unscopables"),s=Array.prototype;null==s[a]&&i.f(s,a,{configurable:!0,value:o(null)}),t.exports=function(t){s[a][t]=!0}},ROdP:function(t,e,n){var r=n("hh1v"),o=n("xrYK"),i=n("tiKp")("match");t.exports=function(t){var e;return r(t)&&(void 0!==(e=t[i])?!!e:"RegExp"==o(t))}},Rfxz:function(t,e,n){"use strict";var r=n("I+eb"),o=n("tycR").some;r({target:"Array",proto:!0,forced:!n("pkCn")("some")},{some:function(t){return o(this,t,arguments.length>1?arguments[1]:void 0)}})},Rm1S:function(t,e,n){"use strict";var r=n("14Sl"),o=n("glrk"),i=n("UMSQ"),a=n("V37c"),s=n("HYAF"),u=n("iqWW"),c=n("FMNM");r("match",function(t,e,n){return[function(e){var n=s(this),r=null==e?void 0:e[t];return void 0!==r?r.call(e,n):new RegExp(e)[t](a(n))},function(t){var r=o(this),s=a(t),l=n(e,r,s);if(l.done)return l.value;if(!r.global)return c(r,s);var f=r.unicode;r.lastIndex=0;for(var p,h=[],d=0;null!==(p=c(r,s));){var y=a(p[0]);h[d]=y,""===y&&(r.lastIndex=u(s,i(r.lastIndex),f)),d++}return 0===d?null:h}]})},SEBh:function(t,e,n){var r=n("glrk"),o=n("HAuM"),i=n("tiKp")("species");t.exports=function(t,e){var n,a=r(t).constructor;return void 0===a||null==(n=r(a)[i])?e:o(n)}},SFrS:function(t,e,n){var r=n("hh1v");t.exports=function(t,e){var n,o;if("string"===e&&"function"==typeof(n=t.toString)&&!r(o=n.call(t)))return o;if("function"==typeof(n=t.valueOf)&&!r(o=n.call(t)))return o;if("string"!==e&&"function"==typeof(n=t.toString)&&!r(o=n.call(t)))return o;throw TypeError("Can't convert object to primitive value")}},STAE:function(t,e,n){var r=n("LQDL"),o=n("0Dky");t.exports=!!Object.getOwnPropertySymbols&&!o(function(){var t=Symbol();return!String(t)||!(Object(t)instanceof Symbol)||!Symbol.sham&&r&&r<41})},SYor:function(t,e,n){"use strict";var r=n("I+eb"),o=n("WKiH").trim;r({target:"String",proto:!0,forced:n("yNLB")("trim")},{trim:function(){return o(this)}})},SpvK:function(t,e,n){n("dOgj")("Float64",function(t){return function(e,n,r){return t(this,e,n,r)}})},SuFq:function(t,e,n){var r=n("I+eb"),o=n("0GbY"),i=n("HAuM"),a=n("glrk"),s=n("hh1v"),u=n("fHMY"),c=n("BTho"),l=n("0Dky"),f=o("Reflect","construct"),p=l(function(){function t(){}return!(f(function(){},[],t)instanceof t)}),h=!l(function(){f(function(){})}),d=p||h;r({target:"Reflect",stat:!0,forced:d,sham:d},{construct:function(t,e){i(t),a(e);var n=arguments.length<3?t:i(arguments[2]);if(h&&!p)return f(t,e,n);if(t==n){switch(e.length){case 0:return new t;case 1:return new t(e[0]);case 2:return new t(e[0],e[1]);case 3:return new t(e[0],e[1],e[2]);case 4:return new t(e[0],e[1],e[2],e[3])}var r=[null];return r.push.apply(r,e),new(c.apply(t,r))}var o=n.prototype,l=u(s(o)?o:Object.prototype),d=Function.apply.call(t,l,e);return s(d)?d:l}})},T63A:function(t,e,n){var r=n("I+eb"),o=n("b1O7").entries;r({target:"Object",stat:!0},{entries:function(t){return o(t)}})},TFPT:function(t,e,n){"use strict";var r=n("I+eb"),o=n("hXpO");r({target:"String",proto:!0,forced:n("rwPt")("sub")},{sub:function(){return o(this,"sub","","")}})},TRJa:function(t,e,n){"use strict";var r=n("I+eb"),o=n("V37c"),i=/[\w*+\-./@]/,a=function(t,e){for(var n=t.toString(16);n.length<e;)n="0"+n;return n};r({global:!0},{escape:function(t){for(var e,n,r=o(t),s="",u=r.length,c=0;c<u;)e=r.charAt(c++),i.test(e)?s+=e:s+=(n=e.charCodeAt(0))<256?"%"+a(n,2):"%u"+a(n,4).toUpperCase();return s}})},TWNs:function(t,e,n){var r=n("g6v/"),o=n("2oRo"),i=n("lMq5"),a=n("cVYH"),s=n("kRJp"),u=n("m/L8").f,c=n("JBy8").f,l=n("ROdP"),f=n("V37c"),p=n("rW0t"),h=n("n3/R"),d=n("busE"),y=n("0Dky"),v=n("UTVS"),g=n("afO8").enforce,m=n("JiZb"),b=n("tiKp"),_=n("/OPJ"),w=n("EHx7"),E=b("match"),S=o.RegExp,x=S.prototype,T=/^\?<[^\s\d!#%&*+<=>@^][^\s!#%&*+<=>@^]*>/,k=/a/g,A=/a/g,I=new S(k)!==k,C=h.UNSUPPORTED_Y;if(i("RegExp",r&&(!I||C||_||w||y(function(){return A[E]=!1,S(k)!=k||S(A)==A||"/a/i"!=S(k,"i")})))){for(var O=function(t,e){var n,r,o,i,u,c,h=this instanceof O,d=l(t),y=void 0===e,m=[],b=t;if(!h&&d&&y&&t.constructor===O)return t;if((d||t instanceof O)&&(t=t.source,y&&(e="flags"in b?b.flags:p.call(b))),t=void 0===t?"":f(t),e=void 0===e?"":f(e),b=t,_&&"dotAll"in k&&(r=!!e&&e.indexOf("s")>-1)&&(e=e.replace(/s/g,"")),n=e,C&&"sticky"in k&&(o=!!e&&e.indexOf("y")>-1)&&(e=e.replace(/y/g,"")),w&&(t=(i=function(t){for(var e,n=t.length,r=0,o="",i=[],a={},s=!1,u=!1,c=0,l="";r<=n;r++){if("\\"===(e=t.charAt(r)))e+=t.charAt(++r);else if("]"===e)s=!1;else if(!s)switch(!0){case"["===e:s=!0;break;case"("===e:T.test(t.slice(r+1))&&(r+=2,u=!0),o+=e,c++;continue;case">"===e&&u:if(""===l||v(a,l))throw new SyntaxError("Invalid capture group name");a[l]=!0,i.push([l,c]),u=!1,l="";continue}u?l+=e:o+=e}return[o,i]}(t))[0],m=i[1]),u=a(S(t,e),h?this:x,O),(r||o||m.length)&&(c=g(u),r&&(c.dotAll=!0,c.raw=O(function(t){for(var e,n=t.length,r=0,o="",i=!1;r<=n;r++)"\\"!==(e=t.charAt(r))?i||"."!==e?("["===e?i=!0:"]"===e&&(i=!1),o+=e):o+="[\\s\\S]":o+=e+t.charAt(++r);return o}(t),n)),o&&(c.sticky=!0),m.length&&(c.groups=m)),t!==b)try{s(u,"source",""===b?"(?:)":b)}catch(E){}return u},P=function(t){t in O||u(O,t,{configurable:!0,get:function(){return S[t]},set:function(e){S[t]=e}})},R=c(S),D=0;R.length>D;)P(R[D++]);x.constructor=O,O.prototype=x,d(o,"RegExp",O)}m("RegExp")},TWQb:function(t,e,n){var r=n("/GqU"),o=n("UMSQ"),i=n("I8vh"),a=function(t){return function(e,n,a){var s,u=r(e),c=o(u.length),l=i(a,c);if(t&&n!=n){for(;c>l;)if((s=u[l++])!=s)return!0}else for(;c>l;l++)if((t||l in u)&&u[l]===n)return t||l||0;return!t&&-1}};t.exports={includes:a(!0),indexOf:a(!1)}},TZCg:function(t,e,n){"use strict";var r=n("I+eb"),o=n("DMt2").start;r({target:"String",proto:!0,forced:n("mgyK")},{padStart:function(t){return o(this,t,arguments.length>1?arguments[1]:void 0)}})},TeQF:function(t,e,n){"use strict";var r=n("I+eb"),o=n("tycR").filter;r({target:"Array",proto:!0,forced:!n("Hd5f")("filter")},{filter:function(t){return o(this,t,arguments.length>1?arguments[1]:void 0)}})},TfTi:function(t,e,n){"use strict";var r=n("A2ZE"),o=n("ewvW"),i=n("m92n"),a=n("6VoE"),s=n("UMSQ"),u=n("hBjN"),c=n("NaFW");t.exports=function(t){var e,n,l,f,p,h,d=o(t),y="function"==typeof this?this:Array,v=arguments.length,g=v>1?arguments[1]:void 0,m=void 0!==g,b=c(d),_=0;if(m&&(g=r(g,v>2?arguments[2]:void 0,2)),null==b||y==Array&&a(b))for(n=new y(e=s(d.length));e>_;_++)h=m?g(d[_],_):d[_],u(n,_,h);else for(p=(f=b.call(d)).next,n=new y;!(l=p.call(f)).done;_++)h=m?i(f,g,[l.value,_],!0):l.value,u(n,_,h);return n.length=_,n}},ToJy:function(t,e,n){"use strict";var r=n("I+eb"),o=n("HAuM"),i=n("ewvW"),a=n("UMSQ"),s=n("V37c"),u=n("0Dky"),c=n("rdv8"),l=n("pkCn"),f=n("BNF5"),p=n("2Zix"),h=n("LQDL"),d=n("USzg"),y=[],v=y.sort,g=u(function(){y.sort(void 0)}),m=u(function(){y.sort(null)}),b=l("sort"),_=!u(function(){if(h)return h<70;if(!(f&&f>3)){if(p)return!0;if(d)return d<603;var t,e,n,r,o="";for(t=65;t<76;t++){switch(e=String.fromCharCode(t),t){case 66:case 69:case 70:case 72:n=3;break;case 68:case 71:n=4;break;default:n=2}for(r=0;r<47;r++)y.push({k:e+r,v:n})}for(y.sort(function(t,e){return e.v-t.v}),r=0;r<y.length;r++)e=y[r].k.charAt(0),o.charAt(o.length-1)!==e&&(o+=e);return"DGBEFHACIJK"!==o}});r({target:"Array",proto:!0,forced:g||!m||!b||!_},{sort:function(t){void 0!==t&&o(t);var e=i(this);if(_)return void 0===t?v.call(e):v.call(e,t);var n,r,u=[],l=a(e.length);for(r=0;r<l;r++)r in e&&u.push(e[r]);for(n=(u=c(u,function(t){return function(e,n){return void 0===n?-1:void 0===e?1:void 0!==t?+t(e,n)||0:s(e)>s(n)?1:-1}}(t))).length,r=0;r<n;)e[r]=u[r++];for(;r<l;)delete e[r++];return e}})},Tskq:function(t,e,n){"use strict";var r=n("bWFh"),o=n("ZWaQ");t.exports=r("Map",function(t){return function(){return t(this,arguments.length?arguments[0]:void 0)}},o)},U3f4:function(t,e,n){var r=n("g6v/"),o=n("m/L8"),i=n("rW0t"),a=n("0Dky");r&&a(function(){return"sy"!==Object.getOwnPropertyDescriptor(RegExp.prototype,"flags").get.call({dotAll:!0,sticky:!0})})&&o.f(RegExp.prototype,"flags",{configurable:!0,get:i})},UMSQ:function(t,e,n){var r=n("ppGB"),o=Math.min;t.exports=function(t){return t>0?o(r(t),9007199254740991):0}},USzg:function(t,e,n){var r=n("NC/Y").match(/AppleWebKit\/(\d+)\./);t.exports=!!r&&+r[1]},UTVS:function(t,e,n){var r=n("ewvW"),o={}.hasOwnProperty;t.exports=Object.hasOwn||function(t,e){return o.call(r(t),e)}},UesL:function(t,e,n){"use strict";var r=n("glrk"),o=n("SFrS");t.exports=function(t){if(r(this),"string"===t||"default"===t)t="string";else if("number"!==t)throw TypeError("Incorrect hint");return o(this,t)}},UxlC:function(t,e,n){"use strict";var r=n("14Sl"),o=n("0Dky"),i=n("glrk"),a=n("ppGB"),s=n("UMSQ"),u=n("V37c"),c=n("HYAF"),l=n("iqWW"),f=n("DLK6"),p=n("FMNM"),h=n("tiKp")("replace"),d=Math.max,y=Math.min,v="$0"==="a".replace(/./,"$0"),g=!!/./[h]&&""===/./[h]("a","$0");r("replace",function(t,e,n){var r=g?"$":"$0";return[function(t,n){var r=c(this),o=null==t?void 0:t[h];return void 0!==o?o.call(t,r,n):e.call(u(r),t,n)},function(t,o){var c=i(this),h=u(t);if("string"==typeof o&&-1===o.indexOf(r)&&-1===o.indexOf("$<")){var v=n(e,c,h,o);if(v.done)return v.value}var g="function"==typeof o;g||(o=u(o));var m=c.global;if(m){var b=c.unicode;c.lastIndex=0}for(var _=[];;){var w=p(c,h);if(null===w)break;if(_.push(w),!m)break;""===u(w[0])&&(c.lastIndex=l(h,s(c.lastIndex),b))}for(var E,S="",x=0,T=0;T<_.length;T++){for(var k=u((w=_[T])[0]),A=d(y(a(w.index),h.length),0),I=[],C=1;C<w.length;C++)I.push(void 0===(E=w[C])?E:String(E));var O=w.groups;if(g){var P=[k].concat(I,A,h);void 0!==O&&P.push(O);var R=u(o.apply(void 0,P))}else R=f(k,h,A,I,O,o);A>=x&&(S+=h.slice(x,A)+R,x=A+k.length)}return S+h.slice(x)}]},!!o(function(){var t=/./;return t.exec=function(){var t=[];return t.groups={a:"7"},t},"7"!=="".replace(t,"$<a>")})||!v||g)},Uydy:function(t,e,n){var r=n("I+eb"),o=n("HsHA"),i=Math.acosh,a=Math.log,s=Math.sqrt,u=Math.LN2;r({target:"Math",stat:!0,forced:!i||710!=Math.floor(i(Number.MAX_VALUE))||i(1/0)!=1/0},{acosh:function(t){return(t=+t)<1?NaN:t>94906265.62425156?a(t)+u:o(t-1+s(t-1)*s(t+1))}})},V37c:function(t,e,n){var r=n("2bX/");t.exports=function(t){if(r(t))throw TypeError("Cannot convert a Symbol value to a string");return String(t)}},VC3L:function(t,e,n){"use strict";var r=n("I+eb"),o=n("0Dky"),i=n("QIpd"),a=1..toPrecision;r({target:"Number",proto:!0,forced:o(function(){return"1"!==a.call(1,void 0)})||!o(function(){a.call({})})},{toPrecision:function(t){return void 0===t?a.call(i(this)):a.call(i(this),t)}})},VpIT:function(t,e,n){var r=n("xDBR"),o=n("xs3f");(t.exports=function(t,e){return o[t]||(o[t]=void 0!==e?e:{})})("versions",[]).push({version:"3.16.3",mode:r?"pure":"global",copyright:"\xa9 2021 Denis Pushkarev (zloirock.ru)"})},Vu81:function(t,e,n){var r=n("0GbY"),o=n("JBy8"),i=n("dBg+"),a=n("glrk");t.exports=r("Reflect","ownKeys")||function(t){var e=o.f(a(t)),n=i.f;return n?e.concat(n(t)):e}},"W/eh":function(t,e,n){"use strict";var r=n("I+eb"),o=n("g6v/"),i=n("6x0u"),a=n("ewvW"),s=n("oEtG"),u=n("4WOD"),c=n("Bs8V").f;o&&r({target:"Object",proto:!0,forced:i},{__lookupSetter__:function(t){var e,n=a(this),r=s(t);do{if(e=c(n,r))return e.set}while(n=u(n))}})},W4Ht:function(t,e,n){"use strict";var r=n("I+eb"),o=n("HYAF"),i=n("ROdP"),a=n("V37c"),s=n("rW0t"),u=n("DLK6"),c=n("tiKp"),l=n("xDBR"),f=c("replace"),p=RegExp.prototype,h=Math.max,d=function(t,e,n){return n>t.length?-1:""===e?n:t.indexOf(e,n)};r({target:"String",proto:!0},{replaceAll:function(t,e){var n,r,c,y,v,g,m,b,_=o(this),w=0,E=0,S="";if(null!=t){if((n=i(t))&&!~a(o("flags"in p?t.flags:s.call(t))).indexOf("g"))throw TypeError("`.replaceAll` does not allow non-global regexes");if(void 0!==(r=t[f]))return r.call(t,_,e);if(l&&n)return a(_).replace(t,e)}for(c=a(_),y=a(t),(v="function"==typeof e)||(e=a(e)),m=h(1,g=y.length),w=d(c,y,0);-1!==w;)b=v?a(e(y,w,c)):u(y,c,w,[],void 0,e),S+=c.slice(E,w)+b,E=w+g,w=d(c,y,w+m);return E<c.length&&(S+=c.slice(E)),S}})},WDsR:function(t,e,n){var r=n("I+eb"),o=n("Xol8"),i=Math.abs;r({target:"Number",stat:!0},{isSafeInteger:function(t){return o(t)&&i(t)<=9007199254740991}})},WJkJ:function(t,e){t.exports="\t\n\v\f\r \xa0\u1680\u2000\u2001\u2002\u2003\u2004\u2005\u2006\u2007\u2008\u2009\u200a\u202f\u205f\u3000\u2028\u2029\ufeff"},WKiH:function(t,e,n){var r=n("HYAF"),o=n("V37c"),i="["+n("WJkJ")+"]",a=RegExp("^"+i+i+"*"),s=RegExp(i+i+"*$"),u=function(t){return function(e){var n=o(r(e));return 1&t&&(n=n.replace(a,"")),2&t&&(n=n.replace(s,"")),n}};t.exports={start:u(1),end:u(2),trim:u(3)}},WjRb:function(t,e,n){var r=n("ROdP");t.exports=function(t){if(r(t))throw TypeError("The method doesn't accept regular expressions");return t}},X5Zq:function(t,e,n){"use strict";var r=n("67WC"),o=r.aTypedArray,i=[].join;(0,r.exportTypedArrayMethod)("join",function(t){return i.apply(o(this),arguments)})},X7LM:function(t,e,n){"use strict";var r=/[^\0-\u007E]/,o=/[.\u3002\uFF0E\uFF61]/g,i="Overflow: input needs wider integers to process",a=Math.floor,s=String.fromCharCode,u=function(t){return t+22+75*(t<26)},c=function(t,e,n){var r=0;for(t=n?a(t/700):t>>1,t+=a(t/e);t>455;r+=36)t=a(t/35);return a(r+36*t/(t+38))},l=function(t){var e,n,r=[],o=(t=function(t){for(var e=[],n=0,r=t.length;n<r;){var o=t.charCodeAt(n++);if(o>=55296&&o<=56319&&n<r){var i=t.charCodeAt(n++);56320==(64512&i)?e.push(((1023&o)<<10)+(1023&i)+65536):(e.push(o),n--)}else e.push(o)}return e}(t)).length,l=128,f=0,p=72;for(e=0;e<t.length;e++)(n=t[e])<128&&r.push(s(n));var h=r.length,d=h;for(h&&r.push("-");d<o;){var y=2147483647;for(e=0;e<t.length;e++)(n=t[e])>=l&&n<y&&(y=n);var v=d+1;if(y-l>a((2147483647-f)/v))throw RangeError(i);for(f+=(y-l)*v,l=y,e=0;e<t.length;e++){if((n=t[e])<l&&++f>2147483647)throw RangeError(i);if(n==l){for(var g=f,m=36;;m+=36){var b=m<=p?1:m>=p+26?26:m-p;if(g<b)break;var _=g-b,w=36-b;r.push(s(u(b+_%w))),g=a(_/w)}r.push(s(u(g))),p=c(f,v,d==h),f=0,++d}}++f,++l}return r.join("")};t.exports=function(t){var e,n,i=[],a=t.toLowerCase().replace(o,".").split(".");for(e=0;e<a.length;e++)i.push(r.test(n=a[e])?"xn--"+l(n):n);return i.join(".")}},XGwC:function(t,e){t.exports=function(t,e){return{enumerable:!(1&t),configurable:!(2&t),writable:!(4&t),value:e}}},XMab:function(t,e,n){n("dOgj")("Uint8",function(t){return function(e,n,r){return t(this,e,n,r)}})},XUE8:function(t,e,n){var r=n("I+eb"),o=n("hh1v"),i=n("glrk"),a=n("xg1e"),s=n("Bs8V"),u=n("4WOD");r({target:"Reflect",stat:!0},{get:function t(e,n){var r,c,l=arguments.length<3?e:arguments[2];return i(e)===l?e[n]:(r=s.f(e,n))?a(r)?r.value:void 0===r.get?void 0:r.get.call(l):o(c=u(e))?t(c,n,l):void 0}})},XbcX:function(t,e,n){"use strict";var r=n("I+eb"),o=n("or9q"),i=n("ewvW"),a=n("UMSQ"),s=n("HAuM"),u=n("ZfDv");r({target:"Array",proto:!0},{flatMap:function(t){var e,n=i(this),r=a(n.length);return s(t),(e=u(n,0)).length=o(e,n,n,r,0,1,t,arguments.length>1?arguments[1]:void 0),e}})},Xe3L:function(t,e,n){"use strict";var r=n("I+eb"),o=n("0Dky"),i=n("hBjN");r({target:"Array",stat:!0,forced:o(function(){function t(){}return!(Array.of.call(t)instanceof t)})},{of:function(){for(var t=0,e=arguments.length,n=new("function"==typeof this?this:Array)(e);e>t;)i(n,t,arguments[t++]);return n.length=e,n}})},Xol8:function(t,e,n){var r=n("hh1v"),o=Math.floor;t.exports=function(t){return!r(t)&&isFinite(t)&&o(t)===t}},YF1G:function(t,e,n){var r=n("xrYK"),o=n("2oRo");t.exports="process"==r(o.process)},YGK4:function(t,e,n){"use strict";var r=n("bWFh"),o=n("ZWaQ");t.exports=r("Set",function(t){return function(){return t(this,arguments.length?arguments[0]:void 0)}},o)},YGnB:function(t,e){t.exports="object"==typeof window},YL0P:function(t,e,n){"use strict";var r=n("2oRo"),o=n("67WC"),i=n("4mDm"),a=n("tiKp")("iterator"),s=r.Uint8Array,u=i.values,c=i.keys,l=i.entries,f=o.aTypedArray,p=o.exportTypedArrayMethod,h=s&&s.prototype[a],d=!!h&&("values"==h.name||null==h.name),y=function(){return u.call(f(this))};p("entries",function(){return l.call(f(this))}),p("keys",function(){return c.call(f(this))}),p("values",y,!d),p(a,y,!d)},YNrV:function(t,e,n){"use strict";var r=n("g6v/"),o=n("0Dky"),i=n("33Wh"),a=n("dBg+"),s=n("0eef"),u=n("ewvW"),c=n("RK3t"),l=Object.assign,f=Object.defineProperty;t.exports=!l||o(function(){if(r&&1!==l({b:1},l(f({},"a",{enumerable:!0,get:function(){f(this,"b",{value:3,enumerable:!1})}}),{b:2})).b)return!0;var t={},e={},n=Symbol();return t[n]=7,"abcdefghijklmnopqrst".split("").forEach(function(t){e[t]=t}),7!=l({},t)[n]||"abcdefghijklmnopqrst"!=i(l({},e)).join("")})?function(t,e){for(var n=u(t),o=arguments.length,l=1,f=a.f,p=s.f;o>l;)for(var h,d=c(arguments[l++]),y=f?i(d).concat(f(d)):i(d),v=y.length,g=0;v>g;)h=y[g++],r&&!p.call(d,h)||(n[h]=d[h]);return n}:l},Yhre:function(t,e,n){"use strict";var r=n("2oRo"),o=n("g6v/"),i=n("qYE9"),a=n("kRJp"),s=n("4syw"),u=n("0Dky"),c=n("GarU"),l=n("ppGB"),f=n("UMSQ"),p=n("CyXQ"),h=n("d6cI"),d=n("4WOD"),y=n("0rvr"),v=n("JBy8").f,g=n("m/L8").f,m=n("gdVl"),b=n("1E5z"),_=n("afO8"),w=_.get,E=_.set,S=r.ArrayBuffer,x=S,T=r.DataView,k=T&&T.prototype,A=Object.prototype,I=r.RangeError,C=h.pack,O=h.unpack,P=function(t){return[255&t]},R=function(t){return[255&t,t>>8&255]},D=function(t){return[255&t,t>>8&255,t>>16&255,t>>24&255]},N=function(t){return t[3]<<24|t[2]<<16|t[1]<<8|t[0]},M=function(t){return C(t,23,4)},j=function(t){return C(t,52,8)},F=function(t,e){g(t.prototype,e,{get:function(){return w(this)[e]}})},L=function(t,e,n,r){var o=p(n),i=w(t);if(o+e>i.byteLength)throw I("Wrong index");var a=w(i.buffer).bytes,s=o+i.byteOffset,u=a.slice(s,s+e);return r?u:u.reverse()},B=function(t,e,n,r,o,i){var a=p(n),s=w(t);if(a+e>s.byteLength)throw I("Wrong index");for(var u=w(s.buffer).bytes,c=a+s.byteOffset,l=r(+o),f=0;f<e;f++)u[c+f]=l[i?f:e-f-1]};if(i){if(!u(function(){S(1)})||!u(function(){new S(-1)})||u(function(){return new S,new S(1.5),new S(NaN),"ArrayBuffer"!=S.name})){for(var V,H=(x=function(t){return c(this,x),new S(p(t))}).prototype=S.prototype,z=v(S),U=0;z.length>U;)(V=z[U++])in x||a(x,V,S[V]);H.constructor=x}y&&d(k)!==A&&y(k,A);var W=new T(new x(2)),Z=k.setInt8;W.setInt8(0,2147483648),W.setInt8(1,2147483649),!W.getInt8(0)&&W.getInt8(1)||s(k,{setInt8:function(t,e){Z.call(this,t,e<<24>>24)},setUint8:function(t,e){Z.call(this,t,e<<24>>24)}},{unsafe:!0})}else x=function(t){c(this,x,"ArrayBuffer");var e=p(t);E(this,{bytes:m.call(new Array(e),0),byteLength:e}),o||(this.byteLength=e)},T=function(t,e,n){c(this,T,"DataView"),c(t,x,"DataView");var r=w(t).byteLength,i=l(e);if(i<0||i>r)throw I("Wrong offset");if(i+(n=void 0===n?r-i:f(n))>r)throw I("Wrong length");E(this,{buffer:t,byteLength:n,byteOffset:i}),o||(this.buffer=t,this.byteLength=n,this.byteOffset=i)},o&&(F(x,"byteLength"),F(T,"buffer"),F(T,"byteLength"),F(T,"byteOffset")),s(T.prototype,{getInt8:function(t){return L(this,1,t)[0]<<24>>24},getUint8:function(t){return L(this,1,t)[0]},getInt16:function(t){var e=L(this,2,t,arguments.length>1?arguments[1]:void 0);return(e[1]<<8|e[0])<<16>>16},getUint16:function(t){var e=L(this,2,t,arguments.length>1?arguments[1]:void 0);return e[1]<<8|e[0]},getInt32:function(t){return N(L(this,4,t,arguments.length>1?arguments[1]:void 0))},getUint32:function(t){return N(L(this,4,t,arguments.length>1?arguments[1]:void 0))>>>0},getFloat32:function(t){return O(L(this,4,t,arguments.length>1?arguments[1]:void 0),23)},getFloat64:function(t){return O(L(this,8,t,arguments.length>1?arguments[1]:void 0),52)},setInt8:function(t,e){B(this,1,t,P,e)},setUint8:function(t,e){B(this,1,t,P,e)},setInt16:function(t,e){B(this,2,t,R,e,arguments.length>2?arguments[2]:void 0)},setUint16:function(t,e){B(this,2,t,R,e,arguments.length>2?arguments[2]:void 0)},setInt32:function(t,e){B(this,4,t,D,e,arguments.length>2?arguments[2]:void 0)},setUint32:function(t,e){B(this,4,t,D,e,arguments.length>2?arguments[2]:void 0)},setFloat32:function(t,e){B(this,4,t,M,e,arguments.length>2?arguments[2]:void 0)},setFloat64:function(t,e){B(this,8,t,j,e,arguments.length>2?arguments[2]:void 0)}});b(x,"ArrayBuffer"),b(T,"DataView"),t.exports={ArrayBuffer:x,DataView:T}},ZJ55:function(t,e,n){"use strict";var r=n("67WC"),o=n("tycR").some,i=r.aTypedArray;(0,r.exportTypedArrayMethod)("some",function(t){return o(i(this),t,arguments.length>1?arguments[1]:void 0)})},ZOXb:function(t,e,n){"use strict";var r=n("0Dky"),o=n("DMt2").start,i=Math.abs,a=Date.prototype,s=a.getTime,u=a.toISOString;t.exports=r(function(){return"0385-07-25T07:06:39.999Z"!=u.call(new Date(-5e13-1))})||!r(function(){u.call(new Date(NaN))})?function(){if(!isFinite(s.call(this)))throw RangeError("Invalid time value");var t=this.getUTCFullYear(),e=this.getUTCMilliseconds(),n=t<0?"-":t>9999?"+":"";return n+o(i(t),n?6:4,0)+"-"+o(this.getUTCMonth()+1,2,0)+"-"+o(this.getUTCDate(),2,0)+"T"+o(this.getUTCHours(),2,0)+":"+o(this.getUTCMinutes(),2,0)+":"+o(this.getUTCSeconds(),2,0)+"."+o(e,3,0)+"Z"}:u},ZUd8:function(t,e,n){var r=n("ppGB"),o=n("V37c"),i=n("HYAF"),a=function(t){return function(e,n){var a,s,u=o(i(e)),c=r(n),l=u.length;return c<0||c>=l?t?"":void 0:(a=u.charCodeAt(c))<55296||a>56319||c+1===l||(s=u.charCodeAt(c+1))<56320||s>57343?t?u.charAt(c):a:t?u.slice(c,c+2):s-56320+(a-55296<<10)+65536}};t.exports={codeAt:a(!1),charAt:a(!0)}},ZWaQ:function(t,e,n){"use strict";var r=n("m/L8").f,o=n("fHMY"),i=n("4syw"),a=n("A2ZE"),s=n("GarU"),u=n("ImZN"),c=n("fdAy"),l=n("JiZb"),f=n("g6v/"),p=n("8YOa").fastKey,h=n("afO8"),d=h.set,y=h.getterFor;t.exports={getConstructor:function(t,e,n,c){var l=t(function(t,r){s(t,l,e),d(t,{type:e,index:o(null),first:void 0,last:void 0,size:0}),f||(t.size=0),null!=r&&u(r,t[c],{that:t,AS_ENTRIES:n})}),h=y(e),v=function(t,e,n){var r,o,i=h(t),a=g(t,e);return a?a.value=n:(i.last=a={index:o=p(e,!0),key:e,value:n,previous:r=i.last,next:void 0,removed:!1},i.first||(i.first=a),r&&(r.next=a),f?i.size++:t.size++,"F"!==o&&(i.index[o]=a)),t},g=function(t,e){var n,r=h(t),o=p(e);if("F"!==o)return r.index[o];for(n=r.first;n;n=n.next)if(n.key==e)return n};return i(l.prototype,{clear:function(){for(var t=h(this),e=t.index,n=t.first;n;)n.removed=!0,n.previous&&(n.previous=n.previous.next=void 0),delete e[n.index],n=n.next;t.first=t.last=void 0,f?t.size=0:this.size=0},delete:function(t){var e=h(this),n=g(this,t);if(n){var r=n.next,o=n.previous;delete e.index[n.index],n.removed=!0,o&&(o.next=r),r&&(r.previous=o),e.first==n&&(e.first=r),e.last==n&&(e.last=o),f?e.size--:this.size--}return!!n},forEach:function(t){for(var e,n=h(this),r=a(t,arguments.length>1?arguments[1]:void 0,3);e=e?e.next:n.first;)for(r(e.value,e.key,this);e&&e.removed;)e=e.previous},has:function(t){return!!g(this,t)}}),i(l.prototype,n?{get:function(t){var e=g(this,t);return e&&e.value},set:function(t,e){return v(this,0===t?0:t,e)}}:{add:function(t){return v(this,t=0===t?0:t,t)}}),f&&r(l.prototype,"size",{get:function(){return h(this).size}}),l},setStrong:function(t,e,n){var r=e+" Iterator",o=y(e),i=y(r);c(t,e,function(t,e){d(this,{type:r,target:t,state:o(t),kind:e,last:void 0})},function(){for(var t=i(this),e=t.kind,n=t.last;n&&n.removed;)n=n.previous;return t.target&&(t.last=n=n?n.next:t.state.first)?"keys"==e?{value:n.key,done:!1}:"values"==e?{value:n.value,done:!1}:{value:[n.key,n.value],done:!1}:(t.target=void 0,{value:void 0,done:!0})},n?"entries":"values",!n,!0),l(e)}}},ZfDv:function(t,e,n){var r=n("C0Ia");t.exports=function(t,e){return new(r(t))(0===e?0:e)}},Zk8X:function(t,e,n){"use strict";var r=n("I+eb"),o=n("hXpO");r({target:"String",proto:!0,forced:n("rwPt")("sup")},{sup:function(){return o(this,"sup","","")}})},a57n:function(t,e,n){n("dG/n")("search")},a5NK:function(t,e,n){var r=n("I+eb"),o=Math.log,i=Math.LOG10E;r({target:"Math",stat:!0},{log10:function(t){return o(t)*i}})},afO8:function(t,e,n){var r,o,i,a=n("f5p1"),s=n("2oRo"),u=n("hh1v"),c=n("kRJp"),l=n("UTVS"),f=n("xs3f"),p=n("93I0"),h=n("0BK2");if(a||f.state){var d=f.state||(f.state=new(0,s.WeakMap)),y=d.get,v=d.has,g=d.set;r=function(t,e){if(v.call(d,t))throw new TypeError("Object already initialized");return e.facade=t,g.call(d,t,e),e},o=function(t){return y.call(d,t)||{}},i=function(t){return v.call(d,t)}}else{var m=p("state");h[m]=!0,r=function(t,e){if(l(t,m))throw new TypeError("Object already initialized");return e.facade=t,c(t,m,e),e},o=function(t){return l(t,m)?t[m]:{}},i=function(t){return l(t,m)}}t.exports={set:r,get:o,has:i,enforce:function(t){return i(t)?o(t):r(t,{})},getterFor:function(t){return function(e){var n;if(!u(e)||(n=o(e)).type!==t)throw TypeError("Incompatible receiver, "+t+" required");return n}}}},b1O7:function(t,e,n){var r=n("g6v/"),o=n("33Wh"),i=n("/GqU"),a=n("0eef").f,s=function(t){return function(e){for(var n,s=i(e),u=o(s),c=u.length,l=0,f=[];c>l;)n=u[l++],r&&!a.call(s,n)||f.push(t?[n,s[n]]:s[n]);return f}};t.exports={entries:s(!0),values:s(!1)}},bFeb:function(t,e,n){n("I+eb")({global:!0},{globalThis:n("2oRo")})},bWFh:function(t,e,n){"use strict";var r=n("I+eb"),o=n("2oRo"),i=n("lMq5"),a=n("busE"),s=n("8YOa"),u=n("ImZN"),c=n("GarU"),l=n("hh1v"),f=n("0Dky"),p=n("HH4o"),h=n("1E5z"),d=n("cVYH");t.exports=function(t,e,n){var y=-1!==t.indexOf("Map"),v=-1!==t.indexOf("Weak"),g=y?"set":"add",m=o[t],b=m&&m.prototype,_=m,w={},E=function(t){var e=b[t];a(b,t,"add"==t?function(t){return e.call(this,0===t?0:t),this}:"delete"==t?function(t){return!(v&&!l(t))&&e.call(this,0===t?0:t)}:"get"==t?function(t){return v&&!l(t)?void 0:e.call(this,0===t?0:t)}:"has"==t?function(t){return!(v&&!l(t))&&e.call(this,0===t?0:t)}:function(t,n){return e.call(this,0===t?0:t,n),this})};if(i(t,"function"!=typeof m||!(v||b.forEach&&!f(function(){(new m).entries().next()}))))_=n.getConstructor(e,t,y,g),s.enable();else if(i(t,!0)){var S=new _,x=S[g](v?{}:-0,1)!=S,T=f(function(){S.has(1)}),k=p(function(t){new m(t)}),A=!v&&f(function(){for(var t=new m,e=5;e--;)t[g](e,e);return!t.has(-0)});k||((_=e(function(e,n){c(e,_,t);var r=d(new m,e,_);return null!=n&&u(n,r[g],{that:r,AS_ENTRIES:y}),r})).prototype=b,b.constructor=_),(T||A)&&(E("delete"),E("has"),y&&E("get")),(A||x)&&E(g),v&&b.clear&&delete b.clear}return w[t]=_,r({global:!0,forced:_!=m},w),h(_,t),v||n.setStrong(_,t,y),_}},brp2:function(t,e,n){n("I+eb")({target:"Date",stat:!0},{now:function(){return(new Date).getTime()}})},busE:function(t,e,n){var r=n("2oRo"),o=n("kRJp"),i=n("UTVS"),a=n("zk60"),s=n("iSVu"),u=n("afO8"),c=u.get,l=u.enforce,f=String(String).split("String");(t.exports=function(t,e,n,s){var u,c=!!s&&!!s.unsafe,p=!!s&&!!s.enumerable,h=!!s&&!!s.noTargetGet;"function"==typeof n&&("string"!=typeof e||i(n,"name")||o(n,"name",e),(u=l(n)).source||(u.source=f.join("string"==typeof e?e:""))),t!==r?(c?!h&&t[e]&&(p=!0):delete t[e],p?t[e]=n:o(t,e,n)):p?t[e]=n:a(e,n)})(Function.prototype,"toString",function(){return"function"==typeof this&&c(this).source||s(this)})},c162:function(t,e,n){"use strict";var r=n("67WC"),o=n("gdVl"),i=r.aTypedArray;(0,r.exportTypedArrayMethod)("fill",function(t){return o.apply(i(this),arguments)})},c9m3:function(t,e,n){n("RNIs")("flatMap")},cDke:function(t,e,n){var r=n("I+eb"),o=n("0Dky"),i=n("BX/b").f;r({target:"Object",stat:!0,forced:o(function(){return!Object.getOwnPropertyNames(1)})},{getOwnPropertyNames:i})},cVYH:function(t,e,n){var r=n("hh1v"),o=n("0rvr");t.exports=function(t,e,n){var i,a;return o&&"function"==typeof(i=e.constructor)&&i!==n&&r(a=i.prototype)&&a!==n.prototype&&o(t,a),t}},cvf0:function(t,e,n){"use strict";var r=n("67WC").exportTypedArrayMethod,o=n("0Dky"),i=n("2oRo").Uint8Array,a=i&&i.prototype||{},s=[].toString,u=[].join;o(function(){s.call({})})&&(s=function(){return u.call(this)}),r("toString",s,a.toString!=s)},d6cI:function(t,e){var n=Math.abs,r=Math.pow,o=Math.floor,i=Math.log,a=Math.LN2;t.exports={pack:function(t,e,s){var u,c,l,f=new Array(s),p=8*s-e-1,h=(1<<p)-1,d=h>>1,y=23===e?r(2,-24)-r(2,-77):0,v=t<0||0===t&&1/t<0?1:0,g=0;for((t=n(t))!=t||t===1/0?(c=t!=t?1:0,u=h):(u=o(i(t)/a),t*(l=r(2,-u))<1&&(u--,l*=2),(t+=u+d>=1?y/l:y*r(2,1-d))*l>=2&&(u++,l/=2),u+d>=h?(c=0,u=h):u+d>=1?(c=(t*l-1)*r(2,e),u+=d):(c=t*r(2,d-1)*r(2,e),u=0));e>=8;f[g++]=255&c,c/=256,e-=8);for(u=u<<e|c,p+=e;p>0;f[g++]=255&u,u/=256,p-=8);return f[--g]|=128*v,f},unpack:function(t,e){var n,o=t.length,i=8*o-e-1,a=(1<<i)-1,s=a>>1,u=i-7,c=o-1,l=t[c--],f=127&l;for(l>>=7;u>0;f=256*f+t[c],c--,u-=8);for(n=f&(1<<-u)-1,f>>=-u,u+=e;u>0;n=256*n+t[c],c--,u-=8);if(0===f)f=1-s;else{if(f===a)return n?NaN:l?-1/0:1/0;n+=r(2,e),f-=s}return(l?-1:1)*n*r(2,f-e)}}},"dBg+":function(t,e){e.f=Object.getOwnPropertySymbols},"dG/n":function(t,e,n){var r=n("Qo9l"),o=n("UTVS"),i=n("5Tg+"),a=n("m/L8").f;t.exports=function(t){var e=r.Symbol||(r.Symbol={});o(e,t)||a(e,t,{value:i.f(t)})}},dOgj:function(t,e,n){"use strict";var r=n("I+eb"),o=n("2oRo"),i=n("g6v/"),a=n("iqeF"),s=n("67WC"),u=n("Yhre"),c=n("GarU"),l=n("XGwC"),f=n("kRJp"),p=n("Xol8"),h=n("UMSQ"),d=n("CyXQ"),y=n("GC2F"),v=n("oEtG"),g=n("UTVS"),m=n("9d/t"),b=n("hh1v"),_=n("2bX/"),w=n("fHMY"),E=n("0rvr"),S=n("JBy8").f,x=n("oHi+"),T=n("tycR").forEach,k=n("JiZb"),A=n("m/L8"),I=n("Bs8V"),C=n("afO8"),O=n("cVYH"),P=C.get,R=C.set,D=A.f,N=I.f,M=Math.round,j=o.RangeError,F=u.ArrayBuffer,L=u.DataView,B=s.NATIVE_ARRAY_BUFFER_VIEWS,V=s.TYPED_ARRAY_CONSTRUCTOR,H=s.TYPED_ARRAY_TAG,z=s.TypedArray,U=s.TypedArrayPrototype,W=s.aTypedArrayConstructor,Z=s.isTypedArray,q=function(t,e){for(var n=0,r=e.length,o=new(W(t))(r);r>n;)o[n]=e[n++];return o},G=function(t,e){D(t,e,{get:function(){return P(this)[e]}})},K=function(t){var e;return t instanceof F||"ArrayBuffer"==(e=m(t))||"SharedArrayBuffer"==e},Q=function(t,e){return Z(t)&&!_(e)&&e in t&&p(+e)&&e>=0},Y=function(t,e){return e=v(e),Q(t,e)?l(2,t[e]):N(t,e)},X=function(t,e,n){return e=v(e),!(Q(t,e)&&b(n)&&g(n,"value"))||g(n,"get")||g(n,"set")||n.configurable||g(n,"writable")&&!n.writable||g(n,"enumerable")&&!n.enumerable?D(t,e,n):(t[e]=n.value,t)};i?(B||(I.f=Y,A.f=X,G(U,"buffer"),G(U,"byteOffset"),G(U,"byteLength"),G(U,"length")),r({target:"Object",stat:!0,forced:!B},{getOwnPropertyDescriptor:Y,defineProperty:X}),t.exports=function(t,e,n){var i=t.match(/\d+$/)[0]/8,s=t+(n?"Clamped":"")+"Array",u="get"+t,l="set"+t,p=o[s],v=p,g=v&&v.prototype,m={},_=function(t,e){D(t,e,{get:function(){return function(t,e){var n=P(t);return n.view[u](e*i+n.byteOffset,!0)}(this,e)},set:function(t){return function(t,e,r){var o=P(t);n&&(r=(r=M(r))<0?0:r>255?255:255&r),o.view[l](e*i+o.byteOffset,r,!0)}(this,e,t)},enumerable:!0})};B?a&&(v=e(function(t,e,n,r){return c(t,v,s),O(b(e)?K(e)?void 0!==r?new p(e,y(n,i),r):void 0!==n?new p(e,y(n,i)):new p(e):Z(e)?q(v,e):x.call(v,e):new p(d(e)),t,v)}),E&&E(v,z),T(S(p),function(t){t in v||f(v,t,p[t])}),v.prototype=g):(v=e(function(t,e,n,r){c(t,v,s);var o,a,u,l=0,f=0;if(b(e)){if(!K(e))return Z(e)?q(v,e):x.call(v,e);o=e,f=y(n,i);var p=e.byteLength;if(void 0===r){if(p%i)throw j("Wrong length");if((a=p-f)<0)throw j("Wrong length")}else if((a=h(r)*i)+f>p)throw j("Wrong length");u=a/i}else u=d(e),o=new F(a=u*i);for(R(t,{buffer:o,byteOffset:f,byteLength:a,length:u,view:new L(o)});l<u;)_(t,l++)}),E&&E(v,z),g=v.prototype=w(U)),g.constructor!==v&&f(g,"constructor",v),f(g,V,v),H&&f(g,H,s),m[s]=v,r({global:!0,forced:v!=p,sham:!B},m),"BYTES_PER_ELEMENT"in v||f(v,"BYTES_PER_ELEMENT",i),"BYTES_PER_ELEMENT"in g||f(g,"BYTES_PER_ELEMENT",i),k(s)}):t.exports=function(){}},"eDl+":function(t,e){t.exports=["constructor","hasOwnProperty","isPrototypeOf","propertyIsEnumerable","toLocaleString","toString","valueOf"]},eJiR:function(t,e,n){var r=n("I+eb"),o=n("jrUv"),i=Math.exp;r({target:"Math",stat:!0},{tanh:function(t){var e=o(t=+t),n=o(-t);return e==1/0?1:n==1/0?-1:(e-n)/(i(t)+i(-t))}})},eajv:function(t,e,n){var r=n("I+eb"),o=Math.asinh,i=Math.log,a=Math.sqrt;r({target:"Math",stat:!0,forced:!(o&&1/o(0)>0)},{asinh:function t(e){return isFinite(e=+e)&&0!=e?e<0?-t(-e):i(e+a(e*e+1)):e}})},eoL8:function(t,e,n){var r=n("I+eb"),o=n("g6v/");r({target:"Object",stat:!0,forced:!o,sham:!o},{defineProperty:n("m/L8").f})},ewvW:function(t,e,n){var r=n("HYAF");t.exports=function(t){return Object(r(t))}},f3jH:function(t,e,n){var r=n("I+eb"),o=n("glrk"),i=n("4WOD");r({target:"Reflect",stat:!0,sham:!n("4Xet")},{getPrototypeOf:function(t){return i(o(t))}})},f5p1:function(t,e,n){var r=n("2oRo"),o=n("iSVu"),i=r.WeakMap;t.exports="function"==typeof i&&/native code/.test(o(i))},fHMY:function(t,e,n){var r,o=n("glrk"),i=n("N+g0"),a=n("eDl+"),s=n("0BK2"),u=n("G+Rx"),c=n("zBJ4"),l=n("93I0")("IE_PROTO"),f=function(){},p=function(t){return"<script>"+t+"<\/script>"},h=function(t){t.write(p("")),t.close();var e=t.parentWindow.Object;return t=null,e},d=function(){try{r=new ActiveXObject("htmlfile")}catch(o){}var t,e;d="undefined"!=typeof document?document.domain&&r?h(r):((e=c("iframe")).style.display="none",u.appendChild(e),e.src=String("javascript:"),(t=e.contentWindow.document).open(),t.write(p("document.F=Object")),t.close(),t.F):h(r);for(var n=a.length;n--;)delete d.prototype[a[n]];return d()};s[l]=!0,t.exports=Object.create||function(t,e){var n;return null!==t?(f.prototype=o(t),n=new f,f.prototype=null,n[l]=t):n=d(),void 0===e?n:i(n,e)}},fbCW:function(t,e,n){"use strict";var r=n("I+eb"),o=n("tycR").find,i=n("RNIs"),a=!0;"find"in[]&&Array(1).find(function(){a=!1}),r({target:"Array",proto:!0,forced:a},{find:function(t){return o(this,t,arguments.length>1?arguments[1]:void 0)}}),i("find")},fdAy:function(t,e,n){"use strict";var r=n("I+eb"),o=n("ntOU"),i=n("4WOD"),a=n("0rvr"),s=n("1E5z"),u=n("kRJp"),c=n("busE"),l=n("tiKp"),f=n("xDBR"),p=n("P4y1"),h=n("rpNk"),d=h.IteratorPrototype,y=h.BUGGY_SAFARI_ITERATORS,v=l("iterator"),g=function(){return this};t.exports=function(t,e,n,l,h,m,b){o(n,e,l);var _,w,E,S=function(t){if(t===h&&I)return I;if(!y&&t in k)return k[t];switch(t){case"keys":case"values":case"entries":return function(){return new n(this,t)}}return function(){return new n(this)}},x=e+" Iterator",T=!1,k=t.prototype,A=k[v]||k["@@iterator"]||h&&k[h],I=!y&&A||S(h),C="Array"==e&&k.entries||A;if(C&&(_=i(C.call(new t)),d!==Object.prototype&&_.next&&(f||i(_)===d||(a?a(_,d):"function"!=typeof _[v]&&u(_,v,g)),s(_,x,!0,!0),f&&(p[x]=g))),"values"==h&&A&&"values"!==A.name&&(T=!0,I=function(){return A.call(this)}),f&&!b||k[v]===I||u(k,v,I),p[e]=I,h)if(w={values:S("values"),keys:m?I:S("keys"),entries:S("entries")},b)for(E in w)!y&&!T&&E in k||c(k,E,w[E]);else r({target:e,proto:!0,forced:y||T},w);return w}},fhKU:function(t,e,n){var r=n("2oRo"),o=n("V37c"),i=n("WKiH").trim,a=n("WJkJ"),s=r.parseFloat,u=1/s(a+"-0")!=-1/0;t.exports=u?function(t){var e=i(o(t)),n=s(e);return 0===n&&"-"==e.charAt(0)?-0:n}:s},ftMj:function(t,e,n){var r=n("I+eb"),o=n("glrk"),i=n("hh1v"),a=n("xg1e"),s=n("0Dky"),u=n("m/L8"),c=n("Bs8V"),l=n("4WOD"),f=n("XGwC");r({target:"Reflect",stat:!0,forced:s(function(){var t=function(){},e=u.f(new t,"a",{configurable:!0});return!1!==Reflect.set(t.prototype,"a",1,e)})},{set:function t(e,n,r){var s,p,h,d=arguments.length<4?e:arguments[3],y=c.f(o(e),n);if(!y){if(i(p=l(e)))return t(p,n,r,d);y=f(0)}if(a(y)){if(!1===y.writable||!i(d))return!1;if(s=c.f(d,n)){if(s.get||s.set||!1===s.writable)return!1;s.value=r,u.f(d,n,s)}else u.f(d,n,f(0,r))}else{if(void 0===(h=y.set))return!1;h.call(d,r)}return!0}})},"g6v/":function(t,e,n){var r=n("0Dky");t.exports=!r(function(){return 7!=Object.defineProperty({},1,{get:function(){return 7}})[1]})},gOCb:function(t,e,n){n("dG/n")("replace")},gXIK:function(t,e,n){n("dG/n")("toPrimitive")},gbiT:function(t,e,n){n("dG/n")("unscopables")},gdVl:function(t,e,n){"use strict";var r=n("ewvW"),o=n("I8vh"),i=n("UMSQ");t.exports=function(t){for(var e=r(this),n=i(e.length),a=arguments.length,s=o(a>1?arguments[1]:void 0,n),u=a>2?arguments[2]:void 0,c=void 0===u?n:o(u,n);c>s;)e[s++]=t;return e}},gg6r:function(t,e,n){"use strict";var r=n("I+eb"),o=n("HAuM"),i=n("8GlL"),a=n("5mdu"),s=n("ImZN");r({target:"Promise",stat:!0},{allSettled:function(t){var e=this,n=i.f(e),r=n.resolve,u=n.reject,c=a(function(){var n=o(e.resolve),i=[],a=0,u=1;s(t,function(t){var o=a++,s=!1;i.push(void 0),u++,n.call(e,t).then(function(t){s||(s=!0,i[o]={status:"fulfilled",value:t},--u||r(i))},function(t){s||(s=!0,i[o]={status:"rejected",reason:t},--u||r(i))})}),--u||r(i)});return c.error&&u(c.value),n.promise}})},glrk:function(t,e,n){var r=n("hh1v");t.exports=function(t){if(!r(t))throw TypeError(String(t)+" is not an object");return t}},gtqK:function(t,e,n){var r=n("I+eb"),o=n("67WC");r({target:"ArrayBuffer",stat:!0,forced:!o.NATIVE_ARRAY_BUFFER_VIEWS},{isView:o.isView})},gvgV:function(t,e,n){"use strict";var r=n("67WC"),o=n("TWQb").includes,i=r.aTypedArray;(0,r.exportTypedArrayMethod)("includes",function(t){return o(i(this),t,arguments.length>1?arguments[1]:void 0)})},hBjN:function(t,e,n){"use strict";var r=n("oEtG"),o=n("m/L8"),i=n("XGwC");t.exports=function(t,e,n){var a=r(e);a in t?o.f(t,a,i(0,n)):t[a]=n}},hByQ:function(t,e,n){"use strict";var r=n("14Sl"),o=n("glrk"),i=n("HYAF"),a=n("Ep9I"),s=n("V37c"),u=n("FMNM");r("search",function(t,e,n){return[function(e){var n=i(this),r=null==e?void 0:e[t];return void 0!==r?r.call(e,n):new RegExp(e)[t](s(n))},function(t){var r=o(this),i=s(t),c=n(e,r,i);if(c.done)return c.value;var l=r.lastIndex;a(l,0)||(r.lastIndex=0);var f=u(r,i);return a(r.lastIndex,l)||(r.lastIndex=l),null===f?-1:f.index}]})},hDyC:function(t,e,n){"use strict";var r=n("I+eb"),o=n("DMt2").end;r({target:"String",proto:!0,forced:n("mgyK")},{padEnd:function(t){return o(this,t,arguments.length>1?arguments[1]:void 0)}})},hMMk:function(t,e,n){n("dOgj")("Uint16",function(t){return function(e,n,r){return t(this,e,n,r)}})},hXpO:function(t,e,n){var r=n("HYAF"),o=n("V37c"),i=/"/g;t.exports=function(t,e,n,a){var s=o(r(t)),u="<"+e;return""!==n&&(u+=" "+n+'="'+o(a).replace(i,"&quot;")+'"'),u+">"+s+"</"+e+">"}},hgfv:function(t,e,n){const r=n("4wMS"),o=n("Cwz8"),i=n("jyPT"),a=n("HL5w"),s=n("DSw1");t.exports={Log:r,Browser:o,Events:i,Init:a,Store:s}},hh1v:function(t,e){t.exports=function(t){return"object"==typeof t?null!==t:"function"==typeof t}},i5pp:function(t,e,n){var r=n("I+eb"),o=n("glrk"),i=n("O741"),a=n("0rvr");a&&r({target:"Reflect",stat:!0},{setPrototypeOf:function(t,e){o(t),i(e);try{return a(t,e),!0}catch(n){return!1}}})},i6QF:function(t,e,n){n("I+eb")({target:"Number",stat:!0},{isInteger:n("Xol8")})},iSVu:function(t,e,n){var r=n("xs3f"),o=Function.toString;"function"!=typeof r.inspectSource&&(r.inspectSource=function(t){return o.call(t)}),t.exports=r.inspectSource},ilnZ:function(t,e,n){n("dOgj")("Uint8",function(t){return function(e,n,r){return t(this,e,n,r)}},!0)},inlA:function(t,e,n){"use strict";var r,o=n("I+eb"),i=n("Bs8V").f,a=n("UMSQ"),s=n("V37c"),u=n("WjRb"),c=n("HYAF"),l=n("qxPZ"),f=n("xDBR"),p="".endsWith,h=Math.min,d=l("endsWith");o({target:"String",proto:!0,forced:!(!f&&!d&&(r=i(String.prototype,"endsWith"),r&&!r.writable)||d)},{endsWith:function(t){var e=s(c(this));u(t);var n=arguments.length>1?arguments[1]:void 0,r=a(e.length),o=void 0===n?r:h(a(n),r),i=s(t);return p?p.call(e,i,o):e.slice(o-i.length,o)===i}})},iqWW:function(t,e,n){"use strict";var r=n("ZUd8").charAt;t.exports=function(t,e,n){return e+(n?r(t,e).length:1)}},iqeF:function(t,e,n){var r=n("2oRo"),o=n("0Dky"),i=n("HH4o"),a=n("67WC").NATIVE_ARRAY_BUFFER_VIEWS,s=r.ArrayBuffer,u=r.Int8Array;t.exports=!a||!o(function(){u(1)})||!o(function(){new u(-1)})||!i(function(t){new u,new u(null),new u(1.5),new u(t)},!0)||o(function(){return 1!==new u(new s(2),1,void 0).length})},iwkZ:function(t,e,n){n("dOgj")("Int16",function(t){return function(e,n,r){return t(this,e,n,r)}})},jrUv:function(t,e){var n=Math.expm1,r=Math.exp;t.exports=!n||n(10)>22025.465794806718||n(10)<22025.465794806718||-2e-17!=n(-2e-17)?function(t){return 0==(t=+t)?t:t>-1e-6&&t<1e-6?t+t*t/2:r(t)-1}:n},jt2F:function(t,e,n){n("dG/n")("matchAll")},jyPT:function(t,e){function n(t,e,n){window.wails.Events.OnMultiple(t,e,n)}t.exports={OnMultiple:n,On:function(t,e){n(t,e)},Once:function(t,e){n(t,e,1)},Emit:function(t){var e=[t].slice.call(arguments);return window.wails.Events.Emit.apply(null,e)},Heartbeat:function(t,e,n){window.wails.Events.Heartbeat(t,e,n)},Acknowledge:function(t){return window.wails.Events.Acknowledge(t)}}},kNcU:function(t,e,n){var r=n("I+eb"),o=Math.log,i=Math.LN2;r({target:"Math",stat:!0},{log2:function(t){return o(t)/i}})},kOOl:function(t,e){var n=0,r=Math.random();t.exports=function(t){return"Symbol("+String(void 0===t?"":t)+")_"+(++n+r).toString(36)}},kRJp:function(t,e,n){var r=n("g6v/"),o=n("m/L8"),i=n("XGwC");t.exports=r?function(t,e,n){return o.f(t,e,i(1,n))}:function(t,e,n){return t[e]=n,t}},kSko:function(t,e,n){n("I+eb")({target:"Number",stat:!0},{isNaN:function(t){return t!=t}})},klfK:function(t,e,n){"use strict";var r=n("I+eb"),o=n("V37c"),i=String.fromCharCode,a=/^[\da-f]{2}$/i,s=/^[\da-f]{4}$/i;r({global:!0},{unescape:function(t){for(var e,n,r=o(t),u="",c=r.length,l=0;l<c;){if("%"===(e=r.charAt(l++)))if("u"===r.charAt(l)){if(n=r.slice(l+1,l+5),s.test(n)){u+=i(parseInt(n,16)),l+=5;continue}}else if(n=r.slice(l,l+2),a.test(n)){u+=i(parseInt(n,16)),l+=2;continue}u+=e}return u}})},kmMV:function(t,e,n){"use strict";var r,o,i=n("V37c"),a=n("rW0t"),s=n("n3/R"),u=n("VpIT"),c=n("fHMY"),l=n("afO8").get,f=n("/OPJ"),p=n("EHx7"),h=RegExp.prototype.exec,d=u("native-string-replace",String.prototype.replace),y=h,v=(o=/b*/g,h.call(r=/a/,"a"),h.call(o,"a"),0!==r.lastIndex||0!==o.lastIndex),g=s.UNSUPPORTED_Y||s.BROKEN_CARET,m=void 0!==/()??/.exec("")[1];(v||m||g||f||p)&&(y=function(t){var e,n,r,o,s,u,f,p=this,b=l(p),_=i(t),w=b.raw;if(w)return w.lastIndex=p.lastIndex,e=y.call(w,_),p.lastIndex=w.lastIndex,e;var E=b.groups,S=g&&p.sticky,x=a.call(p),T=p.source,k=0,A=_;if(S&&(-1===(x=x.replace("y","")).indexOf("g")&&(x+="g"),A=_.slice(p.lastIndex),p.lastIndex>0&&(!p.multiline||p.multiline&&"\n"!==_.charAt(p.lastIndex-1))&&(T="(?: "+T+")",A=" "+A,k++),n=new RegExp("^(?:"+T+")",x)),m&&(n=new RegExp("^"+T+"$(?!\\s)",x)),v&&(r=p.lastIndex),o=h.call(S?n:p,A),S?o?(o.input=o.input.slice(k),o[0]=o[0].slice(k),o.index=p.lastIndex,p.lastIndex+=o[0].length):p.lastIndex=0:v&&o&&(p.lastIndex=p.global?o.index+o[0].length:r),m&&o&&o.length>1&&d.call(o[0],n,function(){for(s=1;s<arguments.length-2;s++)void 0===arguments[s]&&(o[s]=void 0)}),o&&E)for(o.groups=u=c(null),s=0;s<E.length;s++)u[(f=E[s])[0]]=o[f[1]];return o}),t.exports=y},kyGr:function(t,e,n){"use strict";var r=n("I+eb"),o=n("ppGB"),i=Date.prototype.getTime,a=Date.prototype.setFullYear;r({target:"Date",proto:!0},{setYear:function(t){i.call(this);var e=o(t);return a.call(this,0<=e&&e<=99?e+1900:e)}})},l2dK:function(t,e,n){"use strict";var r=n("I+eb"),o=n("hXpO");r({target:"String",proto:!0,forced:n("rwPt")("fontcolor")},{fontcolor:function(t){return o(this,"font","color",t)}})},lEou:function(t,e,n){n("dG/n")("toStringTag")},lMq5:function(t,e,n){var r=n("0Dky"),o=/#|\.prototype\./,i=function(t,e){var n=s[a(t)];return n==c||n!=u&&("function"==typeof e?r(e):!!e)},a=i.normalize=function(t){return String(t).replace(o,".").toLowerCase()},s=i.data={},u=i.NATIVE="N",c=i.POLYFILL="P";t.exports=i},lnpS:function(t,e,n){"use strict";var r=n("I+eb"),o=n("4WOD"),i=n("0rvr"),a=n("fHMY"),s=n("kRJp"),u=n("XGwC"),c=n("ImZN"),l=n("V37c"),f=function(t,e){var n=this;if(!(n instanceof f))return new f(t,e);i&&(n=i(new Error(void 0),o(n))),void 0!==e&&s(n,"message",l(e));var r=[];return c(t,r.push,{that:r}),s(n,"errors",r),n};f.prototype=a(Error.prototype,{constructor:u(5,f),message:u(5,""),name:u(5,"AggregateError")}),r({global:!0},{AggregateError:f})},"m/L8":function(t,e,n){var r=n("g6v/"),o=n("DPsx"),i=n("glrk"),a=n("oEtG"),s=Object.defineProperty;e.f=r?s:function(t,e,n){if(i(t),e=a(e),i(n),o)try{return s(t,e,n)}catch(r){}if("get"in n||"set"in n)throw TypeError("Accessors not supported");return"value"in n&&(t[e]=n.value),t}},m92n:function(t,e,n){var r=n("glrk"),o=n("KmKo");t.exports=function(t,e,n,i){try{return i?e(r(n)[0],n[1]):e(n)}catch(a){throw o(t),a}}},mGGf:function(t,e,n){"use strict";n("4mDm");var r=n("I+eb"),o=n("0GbY"),i=n("DTth"),a=n("busE"),s=n("4syw"),u=n("1E5z"),c=n("ntOU"),l=n("afO8"),f=n("GarU"),p=n("UTVS"),h=n("A2ZE"),d=n("9d/t"),y=n("glrk"),v=n("hh1v"),g=n("V37c"),m=n("fHMY"),b=n("XGwC"),_=n("mh/w"),w=n("NaFW"),E=n("tiKp"),S=o("fetch"),x=o("Request"),T=x&&x.prototype,k=o("Headers"),A=E("iterator"),I=l.set,C=l.getterFor("URLSearchParams"),O=l.getterFor("URLSearchParamsIterator"),P=/\+/g,R=Array(4),D=function(t){return R[t-1]||(R[t-1]=RegExp("((?:%[\\da-f]{2}){"+t+"})","gi"))},N=function(t){try{return decodeURIComponent(t)}catch(e){return t}},M=function(t){var e=t.replace(P," "),n=4;try{return decodeURIComponent(e)}catch(r){for(;n;)e=e.replace(D(n--),N);return e}},j=/[!'()~]|%20/g,F={"!":"%21","'":"%27","(":"%28",")":"%29","~":"%7E","%20":"+"},L=function(t){return F[t]},B=function(t){return encodeURIComponent(t).replace(j,L)},V=function(t,e){if(e)for(var n,r,o=e.split("&"),i=0;i<o.length;)(n=o[i++]).length&&(r=n.split("="),t.push({key:M(r.shift()),value:M(r.join("="))}))},H=function(t){this.entries.length=0,V(this.entries,t)},z=function(t,e){if(t<e)throw TypeError("Not enough arguments")},U=c(function(t,e){I(this,{type:"URLSearchParamsIterator",iterator:_(C(t).entries),kind:e})},"Iterator",function(){var t=O(this),e=t.kind,n=t.iterator.next(),r=n.value;return n.done||(n.value="keys"===e?r.key:"values"===e?r.value:[r.key,r.value]),n}),W=function(){f(this,W,"URLSearchParams");var t,e,n,r,o,i,a,s,u,c=arguments.length>0?arguments[0]:void 0,l=[];if(I(this,{type:"URLSearchParams",entries:l,updateURL:function(){},updateSearchParams:H}),void 0!==c)if(v(c))if("function"==typeof(t=w(c)))for(n=(e=t.call(c)).next;!(r=n.call(e)).done;){if((a=(i=(o=_(y(r.value))).next).call(o)).done||(s=i.call(o)).done||!i.call(o).done)throw TypeError("Expected sequence with length 2");l.push({key:g(a.value),value:g(s.value)})}else for(u in c)p(c,u)&&l.push({key:u,value:g(c[u])});else V(l,"string"==typeof c?"?"===c.charAt(0)?c.slice(1):c:g(c))},Z=W.prototype;if(s(Z,{append:function(t,e){z(arguments.length,2);var n=C(this);n.entries.push({key:g(t),value:g(e)}),n.updateURL()},delete:function(t){z(arguments.length,1);for(var e=C(this),n=e.entries,r=g(t),o=0;o<n.length;)n[o].key===r?n.splice(o,1):o++;e.updateURL()},get:function(t){z(arguments.length,1);for(var e=C(this).entries,n=g(t),r=0;r<e.length;r++)if(e[r].key===n)return e[r].value;return null},getAll:function(t){z(arguments.length,1);for(var e=C(this).entries,n=g(t),r=[],o=0;o<e.length;o++)e[o].key===n&&r.push(e[o].value);return r},has:function(t){z(arguments.length,1);for(var e=C(this).entries,n=g(t),r=0;r<e.length;)if(e[r++].key===n)return!0;return!1},set:function(t,e){z(arguments.length,1);for(var n,r=C(this),o=r.entries,i=!1,a=g(t),s=g(e),u=0;u<o.length;u++)(n=o[u]).key===a&&(i?o.splice(u--,1):(i=!0,n.value=s));i||o.push({key:a,value:s}),r.updateURL()},sort:function(){var t,e,n,r=C(this),o=r.entries,i=o.slice();for(o.length=0,n=0;n<i.length;n++){for(t=i[n],e=0;e<n;e++)if(o[e].key>t.key){o.splice(e,0,t);break}e===n&&o.push(t)}r.updateURL()},forEach:function(t){for(var e,n=C(this).entries,r=h(t,arguments.length>1?arguments[1]:void 0,3),o=0;o<n.length;)r((e=n[o++]).value,e.key,this)},keys:function(){return new U(this,"keys")},values:function(){return new U(this,"values")},entries:function(){return new U(this,"entries")}},{enumerable:!0}),a(Z,A,Z.entries),a(Z,"toString",function(){for(var t,e=C(this).entries,n=[],r=0;r<e.length;)t=e[r++],n.push(B(t.key)+"="+B(t.value));return n.join("&")},{enumerable:!0}),u(W,"URLSearchParams"),r({global:!0,forced:!i},{URLSearchParams:W}),!i&&"function"==typeof k){var q=function(t){if(v(t)){var e,n=t.body;if("URLSearchParams"===d(n))return(e=t.headers?new k(t.headers):new k).has("content-type")||e.set("content-type","application/x-www-form-urlencoded;charset=UTF-8"),m(t,{body:b(0,String(n)),headers:b(0,e)})}return t};if("function"==typeof S&&r({global:!0,enumerable:!0,forced:!0},{fetch:function(t){return S(t,arguments.length>1?q(arguments[1]):{})}}),"function"==typeof x){var G=function(t){return f(this,G,"Request"),new x(t,arguments.length>1?q(arguments[1]):{})};T.constructor=G,G.prototype=T,r({global:!0,forced:!0},{Request:G})}}t.exports={URLSearchParams:W,getState:C}},mRH6:function(t,e,n){"use strict";var r=n("I+eb"),o=n("hXpO");r({target:"String",proto:!0,forced:n("rwPt")("link")},{link:function(t){return o(this,"a","href",t)}})},ma9I:function(t,e,n){"use strict";var r=n("I+eb"),o=n("0Dky"),i=n("6LWA"),a=n("hh1v"),s=n("ewvW"),u=n("UMSQ"),c=n("hBjN"),l=n("ZfDv"),f=n("Hd5f"),p=n("tiKp"),h=n("LQDL"),d=p("isConcatSpreadable"),y=h>=51||!o(function(){var t=[];return t[d]=!1,t.concat()[0]!==t}),v=f("concat"),g=function(t){if(!a(t))return!1;var e=t[d];return void 0!==e?!!e:i(t)};r({target:"Array",proto:!0,forced:!y||!v},{concat:function(t){var e,n,r,o,i,a=s(this),f=l(a,0),p=0;for(e=-1,r=arguments.length;e<r;e++)if(g(i=-1===e?a:arguments[e])){if(p+(o=u(i.length))>9007199254740991)throw TypeError("Maximum allowed index exceeded");for(n=0;n<o;n++,p++)n in i&&c(f,p,i[n])}else{if(p>=9007199254740991)throw TypeError("Maximum allowed index exceeded");c(f,p++,i)}return f.length=p,f}})},mgyK:function(t,e,n){var r=n("NC/Y");t.exports=/Version\/10(?:\.\d+){1,2}(?: [\w./]+)?(?: Mobile\/\w+)? Safari\//.test(r)},"mh/w":function(t,e,n){var r=n("glrk"),o=n("NaFW");t.exports=function(t){var e=o(t);if("function"!=typeof e)throw TypeError(String(t)+" is not iterable");return r(e.call(t))}},moxL:function(t,e,n){"use strict";var r=n("67WC"),o=n("FF6l"),i=r.aTypedArray;(0,r.exportTypedArrayMethod)("copyWithin",function(t,e){return o.call(i(this),t,e,arguments.length>2?arguments[2]:void 0)})},"n/mU":function(t,e,n){var r=n("I+eb"),o=Math.atanh,i=Math.log;r({target:"Math",stat:!0,forced:!(o&&1/o(-0)<0)},{atanh:function(t){return 0==(t=+t)?t:i((1+t)/(1-t))/2}})},"n3/R":function(t,e,n){var r=n("0Dky"),o=n("2oRo").RegExp;e.UNSUPPORTED_Y=r(function(){var t=o("a","y");return t.lastIndex=2,null!=t.exec("abcd")}),e.BROKEN_CARET=r(function(){var t=o("^r","gy");return t.lastIndex=2,null!=t.exec("str")})},n5b4:function(t,e,n){var r=n("I+eb"),o=n("2oRo"),i=n("tXUg"),a=n("YF1G"),s=o.process;r({global:!0,enumerable:!0,noTargetGet:!0},{queueMicrotask:function(t){var e=a&&s.domain;i(e?e.bind(t):t)}})},nkod:function(t,e,n){var r=n("I+eb"),o=n("g6v/"),i=n("glrk"),a=n("Bs8V");r({target:"Reflect",stat:!0,sham:!o},{getOwnPropertyDescriptor:function(t,e){return a.f(i(t),e)}})},ntOU:function(t,e,n){"use strict";var r=n("rpNk").IteratorPrototype,o=n("fHMY"),i=n("XGwC"),a=n("1E5z"),s=n("P4y1"),u=function(){return this};t.exports=function(t,e,n){var c=e+" Iterator";return t.prototype=o(r,{next:i(1,n)}),a(t,c,!1,!0),s[c]=u,t}},oEtG:function(t,e,n){var r=n("wE6v"),o=n("2bX/");t.exports=function(t){var e=r(t,"string");return o(e)?e:String(e)}},"oHi+":function(t,e,n){var r=n("ewvW"),o=n("UMSQ"),i=n("NaFW"),a=n("6VoE"),s=n("A2ZE"),u=n("67WC").aTypedArrayConstructor;t.exports=function(t){var e,n,c,l,f,p,h=r(t),d=arguments.length,y=d>1?arguments[1]:void 0,v=void 0!==y,g=i(h);if(null!=g&&!a(g))for(p=(f=g.call(h)).next,h=[];!(l=p.call(f)).done;)h.push(l.value);for(v&&d>2&&(y=s(y,arguments[2],2)),n=o(h.length),c=new(u(this))(n),e=0;n>e;e++)c[e]=v?y(h[e],e):h[e];return c}},oVuX:function(t,e,n){"use strict";var r=n("I+eb"),o=n("RK3t"),i=n("/GqU"),a=n("pkCn"),s=[].join,u=o!=Object,c=a("join",",");r({target:"Array",proto:!0,forced:u||!c},{join:function(t){return s.call(i(this),void 0===t?",":t)}})},ofBz:function(t,e,n){"use strict";var r=n("I+eb"),o=n("ntOU"),i=n("HYAF"),a=n("UMSQ"),s=n("V37c"),u=n("HAuM"),c=n("glrk"),l=n("xrYK"),f=n("ROdP"),p=n("rW0t"),h=n("kRJp"),d=n("0Dky"),y=n("tiKp"),v=n("SEBh"),g=n("iqWW"),m=n("afO8"),b=n("xDBR"),_=y("matchAll"),w=m.set,E=m.getterFor("RegExp String Iterator"),S=RegExp.prototype,x=S.exec,T="".matchAll,k=!!T&&!d(function(){"a".matchAll(/./)}),A=o(function(t,e,n,r){w(this,{type:"RegExp String Iterator",regexp:t,string:e,global:n,unicode:r,done:!1})},"RegExp String",function(){var t=E(this);if(t.done)return{value:void 0,done:!0};var e=t.regexp,n=t.string,r=function(t,e){var n,r=t.exec;if("function"==typeof r){if("object"!=typeof(n=r.call(t,e)))throw TypeError("Incorrect exec result");return n}return x.call(t,e)}(e,n);return null===r?{value:void 0,done:t.done=!0}:t.global?(""===s(r[0])&&(e.lastIndex=g(n,a(e.lastIndex),t.unicode)),{value:r,done:!1}):(t.done=!0,{value:r,done:!1})}),I=function(t){var e,n,r,o,i,u,l=c(this),f=s(t);return e=v(l,RegExp),void 0===(n=l.flags)&&l instanceof RegExp&&!("flags"in S)&&(n=p.call(l)),r=void 0===n?"":s(n),o=new e(e===RegExp?l.source:l,r),i=!!~r.indexOf("g"),u=!!~r.indexOf("u"),o.lastIndex=a(l.lastIndex),new A(o,f,i,u)};r({target:"String",proto:!0,forced:k},{matchAll:function(t){var e,n,r,o=i(this);if(null!=t){if(f(t)&&!~s(i("flags"in S?t.flags:p.call(t))).indexOf("g"))throw TypeError("`.matchAll` does not allow non-global regexes");if(k)return T.apply(o,arguments);if(void 0===(n=t[_])&&b&&"RegExp"==l(t)&&(n=I),null!=n)return u(n).call(t,o)}else if(k)return T.apply(o,arguments);return e=s(o),r=new RegExp(t,"g"),b?I.call(r,e):r[_](e)}}),b||_ in S||h(S,_,I)},or9q:function(t,e,n){"use strict";var r=n("6LWA"),o=n("UMSQ"),i=n("A2ZE"),a=function(t,e,n,s,u,c,l,f){for(var p,h=u,d=0,y=!!l&&i(l,f,3);d<s;){if(d in n){if(p=y?y(n[d],d,e):n[d],c>0&&r(p))h=a(t,e,p,o(p.length),h,c-1)-1;else{if(h>=9007199254740991)throw TypeError("Exceed the acceptable array length");t[h]=p}h++}d++}return h};t.exports=a},p532:function(t,e,n){"use strict";var r=n("I+eb"),o=n("xDBR"),i=n("/qmn"),a=n("0Dky"),s=n("0GbY"),u=n("SEBh"),c=n("zfnd"),l=n("busE");if(r({target:"Promise",proto:!0,real:!0,forced:!!i&&a(function(){i.prototype.finally.call({then:function(){}},function(){})})},{finally:function(t){var e=u(this,s("Promise")),n="function"==typeof t;return this.then(n?function(n){return c(e,t()).then(function(){return n})}:t,n?function(n){return c(e,t()).then(function(){throw n})}:t)}}),!o&&"function"==typeof i){var f=s("Promise").prototype.finally;i.prototype.finally!==f&&l(i.prototype,"finally",f,{unsafe:!0})}},pDQq:function(t,e,n){"use strict";var r=n("I+eb"),o=n("I8vh"),i=n("ppGB"),a=n("UMSQ"),s=n("ewvW"),u=n("ZfDv"),c=n("hBjN"),l=n("Hd5f")("splice"),f=Math.max,p=Math.min;r({target:"Array",proto:!0,forced:!l},{splice:function(t,e){var n,r,l,h,d,y,v=s(this),g=a(v.length),m=o(t,g),b=arguments.length;if(0===b?n=r=0:1===b?(n=0,r=g-m):(n=b-2,r=p(f(i(e),0),g-m)),g+n-r>9007199254740991)throw TypeError("Maximum allowed length exceeded");for(l=u(v,r),h=0;h<r;h++)(d=m+h)in v&&c(l,h,v[d]);if(l.length=r,n<r){for(h=m;h<g-r;h++)y=h+n,(d=h+r)in v?v[y]=v[d]:delete v[y];for(h=g;h>g-r+n;h--)delete v[h-1]}else if(n>r)for(h=g-r;h>m;h--)y=h+n-1,(d=h+r-1)in v?v[y]=v[d]:delete v[y];for(h=0;h<n;h++)v[h+m]=arguments[h+2];return v.length=g-r+n,l}})},pLQz:function(t,e,n){var r=n("NC/Y");t.exports=/web0s(?!.*chrome)/i.test(r)},pNMO:function(t,e,n){"use strict";var r=n("I+eb"),o=n("2oRo"),i=n("0GbY"),a=n("xDBR"),s=n("g6v/"),u=n("STAE"),c=n("0Dky"),l=n("UTVS"),f=n("6LWA"),p=n("hh1v"),h=n("2bX/"),d=n("glrk"),y=n("ewvW"),v=n("/GqU"),g=n("oEtG"),m=n("V37c"),b=n("XGwC"),_=n("fHMY"),w=n("33Wh"),E=n("JBy8"),S=n("BX/b"),x=n("dBg+"),T=n("Bs8V"),k=n("m/L8"),A=n("0eef"),I=n("kRJp"),C=n("busE"),O=n("VpIT"),P=n("93I0"),R=n("0BK2"),D=n("kOOl"),N=n("tiKp"),M=n("5Tg+"),j=n("dG/n"),F=n("1E5z"),L=n("afO8"),B=n("tycR").forEach,V=P("hidden"),H=N("toPrimitive"),z=L.set,U=L.getterFor("Symbol"),W=Object.prototype,Z=o.Symbol,q=i("JSON","stringify"),G=T.f,K=k.f,Q=S.f,Y=A.f,X=O("symbols"),J=O("op-symbols"),$=O("string-to-symbol-registry"),tt=O("symbol-to-string-registry"),et=O("wks"),nt=o.QObject,rt=!nt||!nt.prototype||!nt.prototype.findChild,ot=s&&c(function(){return 7!=_(K({},"a",{get:function(){return K(this,"a",{value:7}).a}})).a})?function(t,e,n){var r=G(W,e);r&&delete W[e],K(t,e,n),r&&t!==W&&K(W,e,r)}:K,it=function(t,e){var n=X[t]=_(Z.prototype);return z(n,{type:"Symbol",tag:t,description:e}),s||(n.description=e),n},at=function(t,e,n){t===W&&at(J,e,n),d(t);var r=g(e);return d(n),l(X,r)?(n.enumerable?(l(t,V)&&t[V][r]&&(t[V][r]=!1),n=_(n,{enumerable:b(0,!1)})):(l(t,V)||K(t,V,b(1,{})),t[V][r]=!0),ot(t,r,n)):K(t,r,n)},st=function(t,e){d(t);var n=v(e),r=w(n).concat(ft(n));return B(r,function(e){s&&!ut.call(n,e)||at(t,e,n[e])}),t},ut=function(t){var e=g(t),n=Y.call(this,e);return!(this===W&&l(X,e)&&!l(J,e))&&(!(n||!l(this,e)||!l(X,e)||l(this,V)&&this[V][e])||n)},ct=function(t,e){var n=v(t),r=g(e);if(n!==W||!l(X,r)||l(J,r)){var o=G(n,r);return!o||!l(X,r)||l(n,V)&&n[V][r]||(o.enumerable=!0),o}},lt=function(t){var e=Q(v(t)),n=[];return B(e,function(t){l(X,t)||l(R,t)||n.push(t)}),n},ft=function(t){var e=t===W,n=Q(e?J:v(t)),r=[];return B(n,function(t){!l(X,t)||e&&!l(W,t)||r.push(X[t])}),r};u||(C((Z=function(){if(this instanceof Z)throw TypeError("Symbol is not a constructor");var t=arguments.length&&void 0!==arguments[0]?m(arguments[0]):void 0,e=D(t),n=function(t){this===W&&n.call(J,t),l(this,V)&&l(this[V],e)&&(this[V][e]=!1),ot(this,e,b(1,t))};return s&&rt&&ot(W,e,{configurable:!0,set:n}),it(e,t)}).prototype,"toString",function(){return U(this).tag}),C(Z,"withoutSetter",function(t){return it(D(t),t)}),A.f=ut,k.f=at,T.f=ct,E.f=S.f=lt,x.f=ft,M.f=function(t){return it(N(t),t)},s&&(K(Z.prototype,"description",{configurable:!0,get:function(){return U(this).description}}),a||C(W,"propertyIsEnumerable",ut,{unsafe:!0}))),r({global:!0,wrap:!0,forced:!u,sham:!u},{Symbol:Z}),B(w(et),function(t){j(t)}),r({target:"Symbol",stat:!0,forced:!u},{for:function(t){var e=m(t);if(l($,e))return $[e];var n=Z(e);return $[e]=n,tt[n]=e,n},keyFor:function(t){if(!h(t))throw TypeError(t+" is not a symbol");if(l(tt,t))return tt[t]},useSetter:function(){rt=!0},useSimple:function(){rt=!1}}),r({target:"Object",stat:!0,forced:!u,sham:!s},{create:function(t,e){return void 0===e?_(t):st(_(t),e)},defineProperty:at,defineProperties:st,getOwnPropertyDescriptor:ct}),r({target:"Object",stat:!0,forced:!u},{getOwnPropertyNames:lt,getOwnPropertySymbols:ft}),r({target:"Object",stat:!0,forced:c(function(){x.f(1)})},{getOwnPropertySymbols:function(t){return x.f(y(t))}}),q&&r({target:"JSON",stat:!0,forced:!u||c(function(){var t=Z();return"[null]"!=q([t])||"{}"!=q({a:t})||"{}"!=q(Object(t))})},{stringify:function(t,e,n){for(var r,o=[t],i=1;arguments.length>i;)o.push(arguments[i++]);if(r=e,(p(e)||void 0!==t)&&!h(t))return f(e)||(e=function(t,e){if("function"==typeof r&&(e=r.call(this,t,e)),!h(e))return e}),o[1]=e,q.apply(null,o)}}),Z.prototype[H]||I(Z.prototype,H,Z.prototype.valueOf),F(Z,"Symbol"),R[V]=!0},piMb:function(t,e,n){"use strict";var r=n("I+eb"),o=n("tycR").every;r({target:"Array",proto:!0,forced:!n("pkCn")("every")},{every:function(t){return o(this,t,arguments.length>1?arguments[1]:void 0)}})},pjDv:function(t,e,n){var r=n("I+eb"),o=n("TfTi");r({target:"Array",stat:!0,forced:!n("HH4o")(function(t){Array.from(t)})},{from:o})},pkCn:function(t,e,n){"use strict";var r=n("0Dky");t.exports=function(t,e){var n=[][t];return!!n&&r(function(){n.call(null,e||function(){throw 1},1)})}},ppGB:function(t,e){var n=Math.ceil,r=Math.floor;t.exports=function(t){return isNaN(t=+t)?0:(t>0?r:n)(t)}},pv2x:function(t,e,n){var r=n("I+eb"),o=n("0GbY"),i=n("HAuM"),a=n("glrk"),s=n("0Dky"),u=o("Reflect","apply"),c=Function.apply;r({target:"Reflect",stat:!0,forced:!s(function(){u(function(){})})},{apply:function(t,e,n){return i(t),a(n),u?u(t,e,n):c.call(t,e,n)}})},"qHT+":function(t,e,n){var r=n("I+eb"),o=n("FF6l"),i=n("RNIs");r({target:"Array",proto:!0},{copyWithin:o}),i("copyWithin")},qXVe:function(t,e,n){"use strict";var r=n("67WC"),o=n("tycR").every,i=r.aTypedArray;(0,r.exportTypedArrayMethod)("every",function(t){return o(i(this),t,arguments.length>1?arguments[1]:void 0)})},qYE9:function(t,e){t.exports="undefined"!=typeof ArrayBuffer&&"undefined"!=typeof DataView},qePV:function(t,e,n){"use strict";var r=n("g6v/"),o=n("2oRo"),i=n("lMq5"),a=n("busE"),s=n("UTVS"),u=n("xrYK"),c=n("cVYH"),l=n("2bX/"),f=n("wE6v"),p=n("0Dky"),h=n("fHMY"),d=n("JBy8").f,y=n("Bs8V").f,v=n("m/L8").f,g=n("WKiH").trim,m=o.Number,b=m.prototype,_="Number"==u(h(b)),w=function(t){if(l(t))throw TypeError("Cannot convert a Symbol value to a number");var e,n,r,o,i,a,s,u,c=f(t,"number");if("string"==typeof c&&c.length>2)if(43===(e=(c=g(c)).charCodeAt(0))||45===e){if(88===(n=c.charCodeAt(2))||120===n)return NaN}else if(48===e){switch(c.charCodeAt(1)){case 66:case 98:r=2,o=49;break;case 79:case 111:r=8,o=55;break;default:return+c}for(a=(i=c.slice(2)).length,s=0;s<a;s++)if((u=i.charCodeAt(s))<48||u>o)return NaN;return parseInt(i,r)}return+c};if(i("Number",!m(" 0o1")||!m("0b1")||m("+0x1"))){for(var E,S=function(t){var e=arguments.length<1?0:t,n=this;return n instanceof S&&(_?p(function(){b.valueOf.call(n)}):"Number"!=u(n))?c(new m(w(e)),n,S):w(e)},x=r?d(m):"MAX_VALUE,MIN_VALUE,NaN,NEGATIVE_INFINITY,POSITIVE_INFINITY,EPSILON,isFinite,isInteger,isNaN,isSafeInteger,MAX_SAFE_INTEGER,MIN_SAFE_INTEGER,parseFloat,parseInt,isInteger,fromString,range".split(","),T=0;x.length>T;T++)s(m,E=x[T])&&!s(S,E)&&v(S,E,y(m,E));S.prototype=b,b.constructor=S,a(o,"Number",S)}},qxPZ:function(t,e,n){var r=n("tiKp")("match");t.exports=function(t){var e=/./;try{"/./"[t](e)}catch(n){try{return e[r]=!1,"/./"[t](e)}catch(o){}}return!1}},"r/Vq":function(t,e,n){n("I+eb")({target:"Number",stat:!0},{MAX_SAFE_INTEGER:9007199254740991})},r5Og:function(t,e,n){var r=n("I+eb"),o=n("hh1v"),i=n("8YOa").onFreeze,a=n("uy83"),s=n("0Dky"),u=Object.seal;r({target:"Object",stat:!0,forced:s(function(){u(1)}),sham:!a},{seal:function(t){return u&&o(t)?u(i(t)):t}})},rB9j:function(t,e,n){"use strict";var r=n("I+eb"),o=n("kmMV");r({target:"RegExp",proto:!0,forced:/./.exec!==o},{exec:o})},rBZX:function(t,e,n){var r=n("I+eb"),o=n("glrk"),i=n("Bs8V").f;r({target:"Reflect",stat:!0},{deleteProperty:function(t,e){var n=i(o(t),e);return!(n&&!n.configurable)&&delete t[e]}})},rKzb:function(t,e,n){"use strict";var r=n("4syw"),o=n("8YOa").getWeakData,i=n("glrk"),a=n("hh1v"),s=n("GarU"),u=n("ImZN"),c=n("tycR"),l=n("UTVS"),f=n("afO8"),p=f.set,h=f.getterFor,d=c.find,y=c.findIndex,v=0,g=function(t){return t.frozen||(t.frozen=new m)},m=function(){this.entries=[]},b=function(t,e){return d(t.entries,function(t){return t[0]===e})};m.prototype={get:function(t){var e=b(this,t);if(e)return e[1]},has:function(t){return!!b(this,t)},set:function(t,e){var n=b(this,t);n?n[1]=e:this.entries.push([t,e])},delete:function(t){var e=y(this.entries,function(e){return e[0]===t});return~e&&this.entries.splice(e,1),!!~e}},t.exports={getConstructor:function(t,e,n,c){var f=t(function(t,r){s(t,f,e),p(t,{type:e,id:v++,frozen:void 0}),null!=r&&u(r,t[c],{that:t,AS_ENTRIES:n})}),d=h(e),y=function(t,e,n){var r=d(t),a=o(i(e),!0);return!0===a?g(r).set(e,n):a[r.id]=n,t};return r(f.prototype,{delete:function(t){var e=d(this);if(!a(t))return!1;var n=o(t);return!0===n?g(e).delete(t):n&&l(n,e.id)&&delete n[e.id]},has:function(t){var e=d(this);if(!a(t))return!1;var n=o(t);return!0===n?g(e).has(t):n&&l(n,e.id)}}),r(f.prototype,n?{get:function(t){var e=d(this);if(a(t)){var n=o(t);return!0===n?g(e).get(t):n?n[e.id]:void 0}},set:function(t,e){return y(this,t,e)}}:{add:function(t){return y(this,t,!0)}}),f}}},rMz7:function(t,e,n){var r=n("I+eb"),o=n("ZOXb");r({target:"Date",proto:!0,forced:Date.prototype.toISOString!==o},{toISOString:o})},rNhl:function(t,e,n){var r=n("I+eb"),o=n("fhKU");r({global:!0,forced:parseFloat!=o},{parseFloat:o})},rOQg:function(t,e,n){"use strict";var r=n("I+eb"),o=n("0Dky"),i=n("Yhre"),a=n("glrk"),s=n("I8vh"),u=n("UMSQ"),c=n("SEBh"),l=i.ArrayBuffer,f=i.DataView,p=l.prototype.slice;r({target:"ArrayBuffer",proto:!0,unsafe:!0,forced:o(function(){return!new l(2).slice(1,void 0).byteLength})},{slice:function(t,e){if(void 0!==p&&void 0===e)return p.call(a(this),t);for(var n=a(this).byteLength,r=s(t,n),o=s(void 0===e?n:e,n),i=new(c(this,l))(u(o-r)),h=new f(this),d=new f(i),y=0;r<o;)d.setUint8(y++,h.getUint8(r++));return i}})},rW0t:function(t,e,n){"use strict";var r=n("glrk");t.exports=function(){var t=r(this),e="";return t.global&&(e+="g"),t.ignoreCase&&(e+="i"),t.multiline&&(e+="m"),t.dotAll&&(e+="s"),t.unicode&&(e+="u"),t.sticky&&(e+="y"),e}},rdv8:function(t,e){var n=Math.floor,r=function(t,e){var a=t.length,s=n(a/2);return a<8?o(t,e):i(r(t.slice(0,s),e),r(t.slice(s),e),e)},o=function(t,e){for(var n,r,o=t.length,i=1;i<o;){for(r=i,n=t[i];r&&e(t[r-1],n)>0;)t[r]=t[--r];r!==i++&&(t[r]=n)}return t},i=function(t,e,n){for(var r=t.length,o=e.length,i=0,a=0,s=[];i<r||a<o;)s.push(i<r&&a<o?n(t[i],e[a])<=0?t[i++]:e[a++]:i<r?t[i++]:e[a++]);return s};t.exports=r},rpNk:function(t,e,n){"use strict";var r,o,i,a=n("0Dky"),s=n("4WOD"),u=n("kRJp"),c=n("UTVS"),l=n("tiKp"),f=n("xDBR"),p=l("iterator"),h=!1;[].keys&&("next"in(i=[].keys())?(o=s(s(i)))!==Object.prototype&&(r=o):h=!0);var d=null==r||a(function(){var t={};return r[p].call(t)!==t});d&&(r={}),f&&!d||c(r,p)||u(r,p,function(){return this}),t.exports={IteratorPrototype:r,BUGGY_SAFARI_ITERATORS:h}},rwPt:function(t,e,n){var r=n("0Dky");t.exports=function(t){return r(function(){var e=""[t]('"');return e!==e.toLowerCase()||e.split('"').length>3})}},s5qe:function(t,e,n){"use strict";var r=n("2oRo"),o=n("67WC"),i=n("0Dky"),a=r.Int8Array,s=o.aTypedArray,u=o.exportTypedArrayMethod,c=[].toLocaleString,l=[].slice,f=!!a&&i(function(){c.call(new a(1))});u("toLocaleString",function(){return c.apply(f?l.call(s(this)):s(this),arguments)},i(function(){return[1,2].toLocaleString()!=new a([1,2]).toLocaleString()})||!i(function(){a.prototype.toLocaleString.call([1,2])}))},sEFX:function(t,e,n){"use strict";var r=n("AO7/"),o=n("9d/t");t.exports=r?{}.toString:function(){return"[object "+o(this)+"]"}},sMBO:function(t,e,n){var r=n("g6v/"),o=n("m/L8").f,i=Function.prototype,a=i.toString,s=/^\s*function ([^ (]*)/;!r||"name"in i||o(i,"name",{configurable:!0,get:function(){try{return a.call(this).match(s)[1]}catch(t){return""}}})},tCCV:function(t,e,n){var r=n("I+eb"),o=n("Yhre");r({global:!0,forced:!n("qYE9")},{DataView:o.DataView})},tW5y:function(t,e,n){"use strict";var r=n("hh1v"),o=n("m/L8"),i=n("4WOD"),a=n("tiKp")("hasInstance"),s=Function.prototype;a in s||o.f(s,a,{value:function(t){if("function"!=typeof this||!r(t))return!1;if(!r(this.prototype))return t instanceof this;for(;t=i(t);)if(this.prototype===t)return!0;return!1}})},tXUg:function(t,e,n){var r,o,i,a,s,u,c,l,f=n("2oRo"),p=n("Bs8V").f,h=n("LPSS").set,d=n("HNyW"),y=n("1MNl"),v=n("pLQz"),g=n("YF1G"),m=f.MutationObserver||f.WebKitMutationObserver,b=f.document,_=f.process,w=f.Promise,E=p(f,"queueMicrotask"),S=E&&E.value;S||(r=function(){var t,e;for(g&&(t=_.domain)&&t.exit();o;){e=o.fn,o=o.next;try{e()}catch(n){throw o?a():i=void 0,n}}i=void 0,t&&t.enter()},d||g||v||!m||!b?!y&&w&&w.resolve?((c=w.resolve(void 0)).constructor=w,l=c.then,a=function(){l.call(c,r)}):a=g?function(){_.nextTick(r)}:function(){h.call(f,r)}:(s=!0,u=b.createTextNode(""),new m(r).observe(u,{characterData:!0}),a=function(){u.data=s=!s})),t.exports=S||function(t){var e={fn:t,next:void 0};i&&(i.next=e),o||(o=e,a()),i=e}},tiKp:function(t,e,n){var r=n("2oRo"),o=n("VpIT"),i=n("UTVS"),a=n("kOOl"),s=n("STAE"),u=n("/b8u"),c=o("wks"),l=r.Symbol,f=u?l:l&&l.withoutSetter||a;t.exports=function(t){return i(c,t)&&(s||"string"==typeof c[t])||(c[t]=s&&i(l,t)?l[t]:f("Symbol."+t)),c[t]}},tjZM:function(t,e,n){n("dG/n")("asyncIterator")},tkto:function(t,e,n){var r=n("I+eb"),o=n("ewvW"),i=n("33Wh");r({target:"Object",stat:!0,forced:n("0Dky")(function(){i(1)})},{keys:function(t){return i(o(t))}})},"tl/u":function(t,e,n){var r=n("I+eb"),o=Math.ceil,i=Math.floor;r({target:"Math",stat:!0},{trunc:function(t){return(t>0?i:o)(t)}})},toAj:function(t,e,n){"use strict";var r=n("I+eb"),o=n("ppGB"),i=n("QIpd"),a=n("EUja"),s=n("0Dky"),u=1..toFixed,c=Math.floor,l=function(t,e,n){return 0===e?n:e%2==1?l(t,e-1,n*t):l(t*t,e/2,n)},f=function(t,e,n){for(var r=-1,o=n;++r<6;)t[r]=(o+=e*t[r])%1e7,o=c(o/1e7)},p=function(t,e){for(var n=6,r=0;--n>=0;)t[n]=c((r+=t[n])/e),r=r%e*1e7},h=function(t){for(var e=6,n="";--e>=0;)if(""!==n||0===e||0!==t[e]){var r=String(t[e]);n=""===n?r:n+a.call("0",7-r.length)+r}return n};r({target:"Number",proto:!0,forced:u&&("0.000"!==8e-5.toFixed(3)||"1"!==.9.toFixed(0)||"1.25"!==1.255.toFixed(2)||"1000000000000000128"!==(0xde0b6b3a7640080).toFixed(0))||!s(function(){u.call({})})},{toFixed:function(t){var e,n,r,s,u=i(this),c=o(t),d=[0,0,0,0,0,0],y="",v="0";if(c<0||c>20)throw RangeError("Incorrect fraction digits");if(u!=u)return"NaN";if(u<=-1e21||u>=1e21)return String(u);if(u<0&&(y="-",u=-u),u>1e-21)if(n=(e=function(t){for(var e=0,n=u*l(2,69,1);n>=4096;)e+=12,n/=4096;for(;n>=2;)e+=1,n/=2;return e}()-69)<0?u*l(2,-e,1):u/l(2,e,1),n*=4503599627370496,(e=52-e)>0){for(f(d,0,n),r=c;r>=7;)f(d,1e7,0),r-=7;for(f(d,l(10,r,1),0),r=e-1;r>=23;)p(d,1<<23),r-=23;p(d,1<<r),f(d,1,1),p(d,2),v=h(d)}else f(d,0,n),f(d,1<<-e,0),v=h(d)+a.call("0",c);return c>0?y+((s=v.length)<=c?"0."+a.call("0",c-s)+v:v.slice(0,s-c)+"."+v.slice(s-c)):y+v}})},trfz:function(t,e,n){var r=n("67WC"),o=n("SEBh"),i=r.TYPED_ARRAY_CONSTRUCTOR,a=r.aTypedArrayConstructor;t.exports=function(t){return a(o(t,t[i]))}},tycR:function(t,e,n){var r=n("A2ZE"),o=n("RK3t"),i=n("ewvW"),a=n("UMSQ"),s=n("ZfDv"),u=[].push,c=function(t){var e=1==t,n=2==t,c=3==t,l=4==t,f=6==t,p=7==t,h=5==t||f;return function(d,y,v,g){for(var m,b,_=i(d),w=o(_),E=r(y,v,3),S=a(w.length),x=0,T=g||s,k=e?T(d,S):n||p?T(d,0):void 0;S>x;x++)if((h||x in w)&&(b=E(m=w[x],x,_),t))if(e)k[x]=b;else if(b)switch(t){case 3:return!0;case 5:return m;case 6:return x;case 2:u.call(k,m)}else switch(t){case 4:return!1;case 7:u.call(k,m)}return f?-1:c||l?l:k}};t.exports={forEach:c(0),map:c(1),filter:c(2),some:c(3),every:c(4),find:c(5),findIndex:c(6),filterReject:c(7)}},uL8W:function(t,e,n){n("I+eb")({target:"Object",stat:!0,sham:!n("g6v/")},{create:n("fHMY")})},uqXc:function(t,e,n){var r=n("I+eb"),o=n("5Yz+");r({target:"Array",proto:!0,forced:o!==[].lastIndexOf},{lastIndexOf:o})},uy83:function(t,e,n){var r=n("0Dky");t.exports=!r(function(){return Object.isExtensible(Object.preventExtensions({}))})},v5b1:function(t,e,n){"use strict";var r=n("I+eb"),o=n("g6v/"),i=n("6x0u"),a=n("ewvW"),s=n("oEtG"),u=n("4WOD"),c=n("Bs8V").f;o&&r({target:"Object",proto:!0,forced:i},{__lookupGetter__:function(t){var e,n=a(this),r=s(t);do{if(e=c(n,r))return e.get}while(n=u(n))}})},vAFs:function(t,e,n){var r=n("I+eb"),o=n("0Dky"),i=Math.imul;r({target:"Math",stat:!0,forced:o(function(){return-5!=i(4294967295,5)||2!=i.length})},{imul:function(t,e){var n=+t,r=+e,o=65535&n,i=65535&r;return 0|o*i+((65535&n>>>16)*i+o*(65535&r>>>16)<<16>>>0)}})},vo4V:function(t,e,n){var r=n("90hW"),o=Math.abs,i=Math.pow,a=i(2,-52),s=i(2,-23),u=i(2,127)*(2-s),c=i(2,-126);t.exports=Math.fround||function(t){var e,n,i=o(t),l=r(t);return i<c?l*(i/c/s+1/a-1/a)*c*s:(n=(e=(1+s/a)*i)-(e-i))>u||n!=n?l*(1/0):l*n}},vxnP:function(t,e,n){"use strict";n("I+eb")({target:"URL",proto:!0,enumerable:!0},{toJSON:function(){return URL.prototype.toString.call(this)}})},w1rZ:function(t,e,n){var r=n("I+eb"),o=n("fhKU");r({target:"Number",stat:!0,forced:Number.parseFloat!=o},{parseFloat:o})},wE6v:function(t,e,n){var r=n("hh1v"),o=n("2bX/"),i=n("SFrS"),a=n("tiKp")("toPrimitive");t.exports=function(t,e){if(!r(t)||o(t))return t;var n,s=t[a];if(void 0!==s){if(void 0===e&&(e="default"),n=s.call(t,e),!r(n)||o(n))return n;throw TypeError("Can't convert object to primitive value")}return void 0===e&&(e="number"),i(t,e)}},wLYn:function(t,e,n){n("I+eb")({target:"Function",proto:!0},{bind:n("BTho")})},"wZ/5":function(t,e,n){"use strict";var r=n("I+eb"),o=n("2oRo"),i=n("Yhre"),a=n("JiZb"),s=i.ArrayBuffer;r({global:!0,forced:o.ArrayBuffer!==s},{ArrayBuffer:s}),a("ArrayBuffer")},waxf:function(t,e,n){"use strict";var r=n("67WC"),o=n("tycR").filter,i=n("FEiF"),a=r.aTypedArray;(0,r.exportTypedArrayMethod)("filter",function(t){var e=o(a(this),t,arguments.length>1?arguments[1]:void 0);return i(this,e)})},wfmh:function(t,e,n){var r=n("I+eb"),o=n("ImZN"),i=n("hBjN");r({target:"Object",stat:!0},{fromEntries:function(t){var e={};return o(t,function(t,n){i(e,t,n)},{AS_ENTRIES:!0}),e}})},wg0c:function(t,e,n){var r=n("2oRo"),o=n("V37c"),i=n("WKiH").trim,a=n("WJkJ"),s=r.parseInt,u=/^[+-]?0[Xx]/,c=8!==s(a+"08")||22!==s(a+"0x16");t.exports=c?function(t,e){var n=i(o(t));return s(n,e>>>0||(u.test(n)?16:10))}:s},x0AG:function(t,e,n){"use strict";var r=n("I+eb"),o=n("tycR").findIndex,i=n("RNIs"),a=!0;"findIndex"in[]&&Array(1).findIndex(function(){a=!1}),r({target:"Array",proto:!0,forced:a},{findIndex:function(t){return o(this,t,arguments.length>1?arguments[1]:void 0)}}),i("findIndex")},x2An:function(t,e,n){n("I+eb")({target:"Reflect",stat:!0},{has:function(t,e){return e in t}})},x83w:function(t,e,n){"use strict";var r=n("I+eb"),o=n("hXpO");r({target:"String",proto:!0,forced:n("rwPt")("fixed")},{fixed:function(){return o(this,"tt","","")}})},xDBR:function(t,e){t.exports=!1},xdBZ:function(t,e,n){"use strict";var r=n("I+eb"),o=n("hXpO");r({target:"String",proto:!0,forced:n("rwPt")("italics")},{italics:function(){return o(this,"i","","")}})},xg1e:function(t,e,n){var r=n("UTVS");t.exports=function(t){return void 0!==t&&(r(t,"value")||r(t,"writable"))}},xgco:function(t,e,n){var r=n("g6v/"),o=n("/OPJ"),i=n("m/L8").f,a=n("afO8").get,s=RegExp.prototype;r&&o&&i(s,"dotAll",{configurable:!0,get:function(){if(this!==s){if(this instanceof RegExp)return!!a(this).dotAll;throw TypeError("Incompatible receiver, RegExp required")}}})},xrYK:function(t,e){var n={}.toString;t.exports=function(t){return n.call(t).slice(8,-1)}},xs3f:function(t,e,n){var r=n("2oRo"),o=n("zk60"),i=r["__core-js_shared__"]||o("__core-js_shared__",{});t.exports=i},yNLB:function(t,e,n){var r=n("0Dky"),o=n("WJkJ");t.exports=function(t){return r(function(){return!!o[t]()||"\u200b\x85\u180e"!="\u200b\x85\u180e"[t]()||o[t].name!==t})}},yQYn:function(t,e,n){var r=n("I+eb"),o=n("0Dky"),i=n("hh1v"),a=Object.isExtensible;r({target:"Object",stat:!0,forced:o(function(){a(1)})},{isExtensible:function(t){return!!i(t)&&(!a||a(t))}})},yWo2:function(t,e,n){"use strict";var r=n("I+eb"),o=n("hXpO");r({target:"String",proto:!0,forced:n("rwPt")("small")},{small:function(){return o(this,"small","","")}})},yXV3:function(t,e,n){"use strict";var r=n("I+eb"),o=n("TWQb").indexOf,i=n("pkCn"),a=[].indexOf,s=!!a&&1/[1].indexOf(1,-0)<0,u=i("indexOf");r({target:"Array",proto:!0,forced:s||!u},{indexOf:function(t){return s?a.apply(this,arguments)||0:o(this,t,arguments.length>1?arguments[1]:void 0)}})},yiG3:function(t,e,n){n("I+eb")({target:"Math",stat:!0},{log1p:n("HsHA")})},yoRg:function(t,e,n){var r=n("UTVS"),o=n("/GqU"),i=n("TWQb").indexOf,a=n("0BK2");t.exports=function(t,e){var n,s=o(t),u=0,c=[];for(n in s)!r(a,n)&&r(s,n)&&c.push(n);for(;e.length>u;)r(s,n=e[u++])&&(~i(c,n)||c.push(n));return c}},ypFw:function(t,e,n){"use strict";var r=n("67WC"),o=n("1Y/n").left,i=r.aTypedArray;(0,r.exportTypedArrayMethod)("reduce",function(t){return o(i(this),t,arguments.length,arguments.length>1?arguments[1]:void 0)})},yq1k:function(t,e,n){"use strict";var r=n("I+eb"),o=n("TWQb").includes,i=n("RNIs");r({target:"Array",proto:!0},{includes:function(t){return o(this,t,arguments.length>1?arguments[1]:void 0)}}),i("includes")},yyme:function(t,e,n){var r=n("I+eb"),o=n("gdVl"),i=n("RNIs");r({target:"Array",proto:!0},{fill:o}),i("fill")},z8NH:function(t,e,n){n("dOgj")("Float32",function(t){return function(e,n,r){return t(this,e,n,r)}})},zBJ4:function(t,e,n){var r=n("2oRo"),o=n("hh1v"),i=r.document,a=o(i)&&o(i.createElement);t.exports=function(t){return a?i.createElement(t):{}}},zHFu:function(t,e,n){"use strict";var r=n("I+eb"),o=n("hXpO");r({target:"String",proto:!0,forced:n("rwPt")("bold")},{bold:function(){return o(this,"b","","")}})},zKZe:function(t,e,n){var r=n("I+eb"),o=n("YNrV");r({target:"Object",stat:!0,forced:Object.assign!==o},{assign:o})},zSZm:function(t,e,n){"use strict";var r=n("67WC"),o=r.aTypedArray,i=Math.floor;(0,r.exportTypedArrayMethod)("reverse",function(){for(var t,e=o(this).length,n=i(e/2),r=0;r<n;)t=this[r],this[r++]=this[--e],this[e]=t;return this})},zUnb:function(t,e,n){"use strict";n.r(e),n("LSZE");var r=function(t,e){return(r=Object.setPrototypeOf||{__proto__:[]}instanceof Array&&function(t,e){t.__proto__=e}||function(t,e){for(var n in e)e.hasOwnProperty(n)&&(t[n]=e[n])})(t,e)};function o(t,e){function n(){this.constructor=t}r(t,e),t.prototype=null===e?Object.create(e):(n.prototype=e.prototype,new n)}var i=function(){return(i=Object.assign||function(t){for(var e,n=1,r=arguments.length;n<r;n++)for(var o in e=arguments[n])Object.prototype.hasOwnProperty.call(e,o)&&(t[o]=e[o]);return t}).apply(this,arguments)};function a(t,e,n,r){var o,i=arguments.length,a=i<3?e:null===r?r=Object.getOwnPropertyDescriptor(e,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)a=Reflect.decorate(t,e,n,r);else for(var s=t.length-1;s>=0;s--)(o=t[s])&&(a=(i<3?o(a):i>3?o(e,n,a):o(e,n))||a);return i>3&&a&&Object.defineProperty(e,n,a),a}function s(t,e){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(t,e)}function u(t,e,n,r){return new(n||(n=Promise))(function(o,i){function a(t){try{u(r.next(t))}catch(e){i(e)}}function s(t){try{u(r.throw(t))}catch(e){i(e)}}function u(t){var e;t.done?o(t.value):(e=t.value,e instanceof n?e:new n(function(t){t(e)})).then(a,s)}u((r=r.apply(t,e||[])).next())})}function c(t,e){var n,r,o,i,a={label:0,sent:function(){if(1&o[0])throw o[1];return o[1]},trys:[],ops:[]};return i={next:s(0),throw:s(1),return:s(2)},"function"==typeof Symbol&&(i[Symbol.iterator]=function(){return this}),i;function s(i){return function(s){return function(i){if(n)throw new TypeError("Generator is already executing.");for(;a;)try{if(n=1,r&&(o=2&i[0]?r.return:i[0]?r.throw||((o=r.return)&&o.call(r),0):r.next)&&!(o=o.call(r,i[1])).done)return o;switch(r=0,o&&(i=[2&i[0],o.value]),i[0]){case 0:case 1:o=i;break;case 4:return a.label++,{value:i[1],done:!1};case 5:a.label++,r=i[1],i=[0];continue;case 7:i=a.ops.pop(),a.trys.pop();continue;default:if(!(o=(o=a.trys).length>0&&o[o.length-1])&&(6===i[0]||2===i[0])){a=0;continue}if(3===i[0]&&(!o||i[1]>o[0]&&i[1]<o[3])){a.label=i[1];break}if(6===i[0]&&a.label<o[1]){a.label=o[1],o=i;break}if(o&&a.label<o[2]){a.label=o[2],a.ops.push(i);break}o[2]&&a.ops.pop(),a.trys.pop();continue}i=e.call(t,a)}catch(s){i=[6,s],r=0}finally{n=o=0}if(5&i[0])throw i[1];return{value:i[0]?i[1]:void 0,done:!0}}([i,s])}}}function l(t){var e="function"==typeof Symbol&&Symbol.iterator,n=e&&t[e],r=0;if(n)return n.call(t);if(t&&"number"==typeof t.length)return{next:function(){return t&&r>=t.length&&(t=void 0),{value:t&&t[r++],done:!t}}};throw new TypeError(e?"Object is not iterable.":"Symbol.iterator is not defined.")}function f(t,e){var n="function"==typeof Symbol&&t[Symbol.iterator];if(!n)return t;var r,o,i=n.call(t),a=[];try{for(;(void 0===e||e-- >0)&&!(r=i.next()).done;)a.push(r.value)}catch(s){o={error:s}}finally{try{r&&!r.done&&(n=i.return)&&n.call(i)}finally{if(o)throw o.error}}return a}function p(){for(var t=[],e=0;e<arguments.length;e++)t=t.concat(f(arguments[e]));return t}var h=Array.isArray||function(t){return t&&"number"==typeof t.length};function d(t){return null!==t&&"object"==typeof t}function y(t){return"function"==typeof t}function v(t){return Error.call(this),this.message=t?t.length+" errors occurred during unsubscription:\n"+t.map(function(t,e){return e+1+") "+t.toString()}).join("\n  "):"",this.name="UnsubscriptionError",this.errors=t,this}v.prototype=Object.create(Error.prototype);var g=v,m=function(){function t(t){this.closed=!1,this._parent=null,this._parents=null,this._subscriptions=null,t&&(this._unsubscribe=t)}var e;return t.prototype.unsubscribe=function(){var t,e=!1;if(!this.closed){var n=this._parent,r=this._parents,o=this._unsubscribe,i=this._subscriptions;this.closed=!0,this._parent=null,this._parents=null,this._subscriptions=null;for(var a=-1,s=r?r.length:0;n;)n.remove(this),n=++a<s&&r[a]||null;if(y(o))try{o.call(this)}catch(c){e=!0,t=c instanceof g?b(c.errors):[c]}if(h(i))for(a=-1,s=i.length;++a<s;){var u=i[a];if(d(u))try{u.unsubscribe()}catch(c){e=!0,t=t||[],c instanceof g?t=t.concat(b(c.errors)):t.push(c)}}if(e)throw new g(t)}},t.prototype.add=function(e){var n=e;switch(typeof e){case"function":n=new t(e);case"object":if(n===this||n.closed||"function"!=typeof n.unsubscribe)return n;if(this.closed)return n.unsubscribe(),n;if(!(n instanceof t)){var r=n;(n=new t)._subscriptions=[r]}break;default:if(!e)return t.EMPTY;throw new Error("unrecognized teardown "+e+" added to Subscription.")}if(n._addParent(this)){var o=this._subscriptions;o?o.push(n):this._subscriptions=[n]}return n},t.prototype.remove=function(t){var e=this._subscriptions;if(e){var n=e.indexOf(t);-1!==n&&e.splice(n,1)}},t.prototype._addParent=function(t){var e=this._parent,n=this._parents;return e!==t&&(e?n?-1===n.indexOf(t)&&(n.push(t),!0):(this._parents=[t],!0):(this._parent=t,!0))},t.EMPTY=((e=new t).closed=!0,e),t}();function b(t){return t.reduce(function(t,e){return t.concat(e instanceof g?e.errors:e)},[])}var _=!1,w={Promise:void 0,set useDeprecatedSynchronousErrorHandling(t){_=t},get useDeprecatedSynchronousErrorHandling(){return _}};function E(t){setTimeout(function(){throw t})}var S={closed:!0,next:function(t){},error:function(t){if(w.useDeprecatedSynchronousErrorHandling)throw t;E(t)},complete:function(){}},x="function"==typeof Symbol?Symbol("rxSubscriber"):"@@rxSubscriber_"+Math.random(),T=function(t){function e(n,r,o){var i=t.call(this)||this;switch(i.syncErrorValue=null,i.syncErrorThrown=!1,i.syncErrorThrowable=!1,i.isStopped=!1,arguments.length){case 0:i.destination=S;break;case 1:if(!n){i.destination=S;break}if("object"==typeof n){n instanceof e?(i.syncErrorThrowable=n.syncErrorThrowable,i.destination=n,n.add(i)):(i.syncErrorThrowable=!0,i.destination=new k(i,n));break}default:i.syncErrorThrowable=!0,i.destination=new k(i,n,r,o)}return i}return o(e,t),e.prototype[x]=function(){return this},e.create=function(t,n,r){var o=new e(t,n,r);return o.syncErrorThrowable=!1,o},e.prototype.next=function(t){this.isStopped||this._next(t)},e.prototype.error=function(t){this.isStopped||(this.isStopped=!0,this._error(t))},e.prototype.complete=function(){this.isStopped||(this.isStopped=!0,this._complete())},e.prototype.unsubscribe=function(){this.closed||(this.isStopped=!0,t.prototype.unsubscribe.call(this))},e.prototype._next=function(t){this.destination.next(t)},e.prototype._error=function(t){this.destination.error(t),this.unsubscribe()},e.prototype._complete=function(){this.destination.complete(),this.unsubscribe()},e.prototype._unsubscribeAndRecycle=function(){var t=this._parent,e=this._parents;return this._parent=null,this._parents=null,this.unsubscribe(),this.closed=!1,this.isStopped=!1,this._parent=t,this._parents=e,this},e}(m),k=function(t){function e(e,n,r,o){var i,a=t.call(this)||this;a._parentSubscriber=e;var s=a;return y(n)?i=n:n&&(i=n.next,r=n.error,o=n.complete,n!==S&&(y((s=Object.create(n)).unsubscribe)&&a.add(s.unsubscribe.bind(s)),s.unsubscribe=a.unsubscribe.bind(a))),a._context=s,a._next=i,a._error=r,a._complete=o,a}return o(e,t),e.prototype.next=function(t){if(!this.isStopped&&this._next){var e=this._parentSubscriber;w.useDeprecatedSynchronousErrorHandling&&e.syncErrorThrowable?this.__tryOrSetError(e,this._next,t)&&this.unsubscribe():this.__tryOrUnsub(this._next,t)}},e.prototype.error=function(t){if(!this.isStopped){var e=this._parentSubscriber,n=w.useDeprecatedSynchronousErrorHandling;if(this._error)n&&e.syncErrorThrowable?(this.__tryOrSetError(e,this._error,t),this.unsubscribe()):(this.__tryOrUnsub(this._error,t),this.unsubscribe());else if(e.syncErrorThrowable)n?(e.syncErrorValue=t,e.syncErrorThrown=!0):E(t),this.unsubscribe();else{if(this.unsubscribe(),n)throw t;E(t)}}},e.prototype.complete=function(){var t=this;if(!this.isStopped){var e=this._parentSubscriber;if(this._complete){var n=function(){return t._complete.call(t._context)};w.useDeprecatedSynchronousErrorHandling&&e.syncErrorThrowable?(this.__tryOrSetError(e,n),this.unsubscribe()):(this.__tryOrUnsub(n),this.unsubscribe())}else this.unsubscribe()}},e.prototype.__tryOrUnsub=function(t,e){try{t.call(this._context,e)}catch(n){if(this.unsubscribe(),w.useDeprecatedSynchronousErrorHandling)throw n;E(n)}},e.prototype.__tryOrSetError=function(t,e,n){if(!w.useDeprecatedSynchronousErrorHandling)throw new Error("bad call");try{e.call(this._context,n)}catch(r){return w.useDeprecatedSynchronousErrorHandling?(t.syncErrorValue=r,t.syncErrorThrown=!0,!0):(E(r),!0)}return!1},e.prototype._unsubscribe=function(){var t=this._parentSubscriber;this._context=null,this._parentSubscriber=null,t.unsubscribe()},e}(T),A="function"==typeof Symbol&&Symbol.observable||"@@observable";var I=function(){function t(t){this._isScalar=!1,t&&(this._subscribe=t)}return t.prototype.lift=function(e){var n=new t;return n.source=this,n.operator=e,n},t.prototype.subscribe=function(t,e,n){var r=this.operator,o=function(t,e,n){if(t){if(t instanceof T)return t;if(t[x])return t[x]()}return t||e||n?new T(t,e,n):new T(S)}(t,e,n);if(o.add(r?r.call(o,this.source):this.source||w.useDeprecatedSynchronousErrorHandling&&!o.syncErrorThrowable?this._subscribe(o):this._trySubscribe(o)),w.useDeprecatedSynchronousErrorHandling&&o.syncErrorThrowable&&(o.syncErrorThrowable=!1,o.syncErrorThrown))throw o.syncErrorValue;return o},t.prototype._trySubscribe=function(t){try{return this._subscribe(t)}catch(e){w.useDeprecatedSynchronousErrorHandling&&(t.syncErrorThrown=!0,t.syncErrorValue=e),function(t){for(;t;){var e=t.destination;if(t.closed||t.isStopped)return!1;t=e&&e instanceof T?e:null}return!0}(t)?t.error(e):console.warn(e)}},t.prototype.forEach=function(t,e){var n=this;return new(e=C(e))(function(e,r){var o;o=n.subscribe(function(e){try{t(e)}catch(n){r(n),o&&o.unsubscribe()}},r,e)})},t.prototype._subscribe=function(t){var e=this.source;return e&&e.subscribe(t)},t.prototype[A]=function(){return this},t.prototype.pipe=function(){for(var t=[],e=0;e<arguments.length;e++)t[e]=arguments[e];return 0===t.length?this:((n=t)?1===n.length?n[0]:function(t){return n.reduce(function(t,e){return e(t)},t)}:function(){})(this);var n},t.prototype.toPromise=function(t){var e=this;return new(t=C(t))(function(t,n){var r;e.subscribe(function(t){return r=t},function(t){return n(t)},function(){return t(r)})})},t.create=function(e){return new t(e)},t}();function C(t){if(t||(t=w.Promise||Promise),!t)throw new Error("no Promise impl found");return t}function O(){return Error.call(this),this.message="object unsubscribed",this.name="ObjectUnsubscribedError",this}O.prototype=Object.create(Error.prototype);var P=O,R=function(t){function e(e,n){var r=t.call(this)||this;return r.subject=e,r.subscriber=n,r.closed=!1,r}return o(e,t),e.prototype.unsubscribe=function(){if(!this.closed){this.closed=!0;var t=this.subject,e=t.observers;if(this.subject=null,e&&0!==e.length&&!t.isStopped&&!t.closed){var n=e.indexOf(this.subscriber);-1!==n&&e.splice(n,1)}}},e}(m),D=function(t){function e(e){var n=t.call(this,e)||this;return n.destination=e,n}return o(e,t),e}(T),N=function(t){function e(){var e=t.call(this)||this;return e.observers=[],e.closed=!1,e.isStopped=!1,e.hasError=!1,e.thrownError=null,e}return o(e,t),e.prototype[x]=function(){return new D(this)},e.prototype.lift=function(t){var e=new M(this,this);return e.operator=t,e},e.prototype.next=function(t){if(this.closed)throw new P;if(!this.isStopped)for(var e=this.observers,n=e.length,r=e.slice(),o=0;o<n;o++)r[o].next(t)},e.prototype.error=function(t){if(this.closed)throw new P;this.hasError=!0,this.thrownError=t,this.isStopped=!0;for(var e=this.observers,n=e.length,r=e.slice(),o=0;o<n;o++)r[o].error(t);this.observers.length=0},e.prototype.complete=function(){if(this.closed)throw new P;this.isStopped=!0;for(var t=this.observers,e=t.length,n=t.slice(),r=0;r<e;r++)n[r].complete();this.observers.length=0},e.prototype.unsubscribe=function(){this.isStopped=!0,this.closed=!0,this.observers=null},e.prototype._trySubscribe=function(e){if(this.closed)throw new P;return t.prototype._trySubscribe.call(this,e)},e.prototype._subscribe=function(t){if(this.closed)throw new P;return this.hasError?(t.error(this.thrownError),m.EMPTY):this.isStopped?(t.complete(),m.EMPTY):(this.observers.push(t),new R(this,t))},e.prototype.asObservable=function(){var t=new I;return t.source=this,t},e.create=function(t,e){return new M(t,e)},e}(I),M=function(t){function e(e,n){var r=t.call(this)||this;return r.destination=e,r.source=n,r}return o(e,t),e.prototype.next=function(t){var e=this.destination;e&&e.next&&e.next(t)},e.prototype.error=function(t){var e=this.destination;e&&e.error&&this.destination.error(t)},e.prototype.complete=function(){var t=this.destination;t&&t.complete&&this.destination.complete()},e.prototype._subscribe=function(t){return this.source?this.source.subscribe(t):m.EMPTY},e}(N),j=function(t){function e(e,n,r){var o=t.call(this)||this;return o.parent=e,o.outerValue=n,o.outerIndex=r,o.index=0,o}return o(e,t),e.prototype._next=function(t){this.parent.notifyNext(this.outerValue,t,this.outerIndex,this.index++,this)},e.prototype._error=function(t){this.parent.notifyError(t,this),this.unsubscribe()},e.prototype._complete=function(){this.parent.notifyComplete(this),this.unsubscribe()},e}(T),F=function(t){return function(e){for(var n=0,r=t.length;n<r&&!e.closed;n++)e.next(t[n]);e.closed||e.complete()}},L=function(t){return function(e){return t.then(function(t){e.closed||(e.next(t),e.complete())},function(t){return e.error(t)}).then(null,E),e}};function B(){return"function"==typeof Symbol&&Symbol.iterator?Symbol.iterator:"@@iterator"}var V=B(),H=function(t){return function(e){for(var n=t[V]();;){var r=n.next();if(r.done){e.complete();break}if(e.next(r.value),e.closed)break}return"function"==typeof n.return&&e.add(function(){n.return&&n.return()}),e}},z=function(t){return function(e){var n=t[A]();if("function"!=typeof n.subscribe)throw new TypeError("Provided object does not correctly implement Symbol.observable");return n.subscribe(e)}},U=function(t){return t&&"number"==typeof t.length&&"function"!=typeof t};function W(t){return!!t&&"function"!=typeof t.subscribe&&"function"==typeof t.then}var Z=function(t){if(t instanceof I)return function(e){return t._isScalar?(e.next(t.value),void e.complete()):t.subscribe(e)};if(t&&"function"==typeof t[A])return z(t);if(U(t))return F(t);if(W(t))return L(t);if(t&&"function"==typeof t[V])return H(t);var e=d(t)?"an invalid object":"'"+t+"'";throw new TypeError("You provided "+e+" where a stream was expected. You can provide an Observable, Promise, Array, or Iterable.")},q=function(t){function e(){return null!==t&&t.apply(this,arguments)||this}return o(e,t),e.prototype.notifyNext=function(t,e,n,r,o){this.destination.next(e)},e.prototype.notifyError=function(t,e){this.destination.error(t)},e.prototype.notifyComplete=function(t){this.destination.complete()},e}(T),G=function(){function t(t,e){this.project=t,this.thisArg=e}return t.prototype.call=function(t,e){return e.subscribe(new K(t,this.project,this.thisArg))},t}(),K=function(t){function e(e,n,r){var o=t.call(this,e)||this;return o.project=n,o.count=0,o.thisArg=r||o,o}return o(e,t),e.prototype._next=function(t){var e;try{e=this.project.call(this.thisArg,t,this.count++)}catch(n){return void this.destination.error(n)}this.destination.next(e)},e}(T);function Q(t,e){return new I(e?function(n){var r=new m,o=0;return r.add(e.schedule(function(){o!==t.length?(n.next(t[o++]),n.closed||r.add(this.schedule())):n.complete()})),r}:F(t))}var Y=function(){function t(t,e){void 0===e&&(e=Number.POSITIVE_INFINITY),this.project=t,this.concurrent=e}return t.prototype.call=function(t,e){return e.subscribe(new X(t,this.project,this.concurrent))},t}(),X=function(t){function e(e,n,r){void 0===r&&(r=Number.POSITIVE_INFINITY);var o=t.call(this,e)||this;return o.project=n,o.concurrent=r,o.hasCompleted=!1,o.buffer=[],o.active=0,o.index=0,o}return o(e,t),e.prototype._next=function(t){this.active<this.concurrent?this._tryNext(t):this.buffer.push(t)},e.prototype._tryNext=function(t){var e,n=this.index++;try{e=this.project(t,n)}catch(r){return void this.destination.error(r)}this.active++,this._innerSub(e,t,n)},e.prototype._innerSub=function(t,e,n){var r,o,i=new j(this,void 0,void 0);this.destination.add(i),r=t,void 0===(o=i)&&(o=new j(this,e,n)),o.closed||Z(r)(o)},e.prototype._complete=function(){this.hasCompleted=!0,0===this.active&&0===this.buffer.length&&this.destination.complete(),this.unsubscribe()},e.prototype.notifyNext=function(t,e,n,r,o){this.destination.next(e)},e.prototype.notifyComplete=function(t){var e=this.buffer;this.remove(t),this.active--,e.length>0?this._next(e.shift()):0===this.active&&this.hasCompleted&&this.destination.complete()},e}(q);function J(t){return t}function $(){return function(t){return t.lift(new tt(t))}}var tt=function(){function t(t){this.connectable=t}return t.prototype.call=function(t,e){var n=this.connectable;n._refCount++;var r=new et(t,n),o=e.subscribe(r);return r.closed||(r.connection=n.connect()),o},t}(),et=function(t){function e(e,n){var r=t.call(this,e)||this;return r.connectable=n,r}return o(e,t),e.prototype._unsubscribe=function(){var t=this.connectable;if(t){this.connectable=null;var e=t._refCount;if(e<=0)this.connection=null;else if(t._refCount=e-1,e>1)this.connection=null;else{var n=this.connection,r=t._connection;this.connection=null,!r||n&&r!==n||r.unsubscribe()}}else this.connection=null},e}(T),nt=function(t){function e(e,n){var r=t.call(this)||this;return r.source=e,r.subjectFactory=n,r._refCount=0,r._isComplete=!1,r}return o(e,t),e.prototype._subscribe=function(t){return this.getSubject().subscribe(t)},e.prototype.getSubject=function(){var t=this._subject;return t&&!t.isStopped||(this._subject=this.subjectFactory()),this._subject},e.prototype.connect=function(){var t=this._connection;return t||(this._isComplete=!1,(t=this._connection=new m).add(this.source.subscribe(new ot(this.getSubject(),this))),t.closed?(this._connection=null,t=m.EMPTY):this._connection=t),t},e.prototype.refCount=function(){return $()(this)},e}(I).prototype,rt={operator:{value:null},_refCount:{value:0,writable:!0},_subject:{value:null,writable:!0},_connection:{value:null,writable:!0},_subscribe:{value:nt._subscribe},_isComplete:{value:nt._isComplete,writable:!0},getSubject:{value:nt.getSubject},connect:{value:nt.connect},refCount:{value:nt.refCount}},ot=function(t){function e(e,n){var r=t.call(this,e)||this;return r.connectable=n,r}return o(e,t),e.prototype._error=function(e){this._unsubscribe(),t.prototype._error.call(this,e)},e.prototype._complete=function(){this.connectable._isComplete=!0,this._unsubscribe(),t.prototype._complete.call(this)},e.prototype._unsubscribe=function(){var t=this.connectable;if(t){this.connectable=null;var e=t._connection;t._refCount=0,t._subject=null,t._connection=null,e&&e.unsubscribe()}},e}(D);function it(){return new N}var at="__parameters__";function st(t,e,n){var r=function(t){return function(){for(var e=[],n=0;n<arguments.length;n++)e[n]=arguments[n];if(t){var r=t.apply(void 0,p(e));for(var o in r)this[o]=r[o]}}}(e);function o(){for(var t,e=[],n=0;n<arguments.length;n++)e[n]=arguments[n];if(this instanceof o)return r.apply(this,e),this;var i=new((t=o).bind.apply(t,p([void 0],e)));return a.annotation=i,a;function a(t,e,n){for(var r=t.hasOwnProperty(at)?t[at]:Object.defineProperty(t,at,{value:[]})[at];r.length<=n;)r.push(null);return(r[n]=r[n]||[]).push(i),t}}return n&&(o.prototype=Object.create(n.prototype)),o.prototype.ngMetadataName=t,o.annotationCls=o,o}var ut=st("Inject",function(t){return{token:t}}),ct=st("Optional"),lt=st("Self"),ft=st("SkipSelf"),pt=function(t){return t[t.Default=0]="Default",t[t.Host=1]="Host",t[t.Self=2]="Self",t[t.SkipSelf=4]="SkipSelf",t[t.Optional=8]="Optional",t}({});function ht(t){for(var e in t)if(t[e]===ht)return e;throw Error("Could not find renamed property on target object.")}function dt(t){return{providedIn:t.providedIn||null,factory:t.factory,value:void 0}}function yt(t){return t&&t.hasOwnProperty(vt)?t[vt]:null}var vt=ht({ngInjectableDef:ht});function gt(t){if("string"==typeof t)return t;if(t instanceof Array)return"["+t.map(gt).join(", ")+"]";if(null==t)return""+t;if(t.overriddenName)return""+t.overriddenName;if(t.name)return""+t.name;var e=t.toString();if(null==e)return""+e;var n=e.indexOf("\n");return-1===n?e:e.substring(0,n)}var mt=ht({__forward_ref__:ht});function bt(t){return t.__forward_ref__=bt,t.toString=function(){return gt(this())},t}function _t(t){var e=t;return"function"==typeof e&&e.hasOwnProperty(mt)&&e.__forward_ref__===bt?e():t}function wt(){var t="undefined"!=typeof globalThis&&globalThis,e="undefined"!=typeof window&&window,n="undefined"!=typeof self&&"undefined"!=typeof WorkerGlobalScope&&self instanceof WorkerGlobalScope&&self,r="undefined"!=typeof global&&global;return t||r||e||n}var Et,St=wt(),xt=void 0;function Tt(t){var e=xt;return xt=t,e}var kt=function(){function t(t,e){this._desc=t,this.ngMetadataName="InjectionToken",this.ngInjectableDef=void 0,"number"==typeof e?this.__NG_ELEMENT_ID__=e:void 0!==e&&(this.ngInjectableDef=dt({providedIn:e.providedIn||"root",factory:e.factory}))}return t.prototype.toString=function(){return"InjectionToken "+this._desc},t}(),At="__source",It=new Object,Ct=new kt("INJECTOR",-1),Ot=function(){function t(){}return t.prototype.get=function(t,e){if(void 0===e&&(e=It),e===It){var n=new Error("NullInjectorError: No provider for "+gt(t)+"!");throw n.name="NullInjectorError",n}return e},t}(),Pt=function(){function t(){}return t.create=function(t,e){return Array.isArray(t)?new Bt(t,e):new Bt(t.providers,t.parent,t.name||null)},t.THROW_IF_NOT_FOUND=It,t.NULL=new Ot,t.ngInjectableDef=dt({providedIn:"any",factory:function(){return void 0===t&&(t=pt.Default),(Et||function(t,e){if(void 0===e&&(e=pt.Default),void 0===xt)throw new Error("inject() must be called from an injection context");return null===xt?function(t,e,n){var r=yt(t);if(r&&"root"==r.providedIn)return void 0===r.value?r.value=r.factory():r.value;if(n&pt.Optional)return null;throw new Error("Injector: NOT_FOUND ["+gt(t)+"]")}(t,0,e):xt.get(t,e&pt.Optional?null:void 0,e)})(Ct,t);var t}}),t.__NG_ELEMENT_ID__=-1,t}(),Rt=function(t){return t},Dt=[],Nt=Rt,Mt=function(){return Array.prototype.slice.call(arguments)},jt=ht({provide:String,useValue:ht}),Ft=/\n/gm,Lt="\u0275",Bt=function(){function t(t,e,n){void 0===e&&(e=Pt.NULL),void 0===n&&(n=null),this.parent=e,this.source=n;var r=this._records=new Map;r.set(Pt,{token:Pt,fn:Rt,deps:Dt,value:this,useNew:!1}),r.set(Ct,{token:Ct,fn:Rt,deps:Dt,value:this,useNew:!1}),function t(e,n){if(n)if((n=_t(n))instanceof Array)for(var r=0;r<n.length;r++)t(e,n[r]);else{if("function"==typeof n)throw zt("Function/Class not supported",n);if(!n||"object"!=typeof n||!n.provide)throw zt("Unexpected provider",n);var o=_t(n.provide),i=function(t){var e=function(t){var e=Dt,n=t.deps;if(n&&n.length){e=[];for(var r=0;r<n.length;r++){var o=6;if((u=_t(n[r]))instanceof Array)for(var i=0,a=u;i<a.length;i++){var s=a[i];s instanceof ct||s==ct?o|=1:s instanceof ft||s==ft?o&=-3:s instanceof lt||s==lt?o&=-5:u=s instanceof ut?s.token:_t(s)}e.push({token:u,options:o})}}else if(t.useExisting){var u;e=[{token:u=_t(t.useExisting),options:6}]}else if(!(n||jt in t))throw zt("'deps' required",t);return e}(t),n=Rt,r=Dt,o=!1,i=_t(t.provide);if(jt in t)r=t.useValue;else if(t.useFactory)n=t.useFactory;else if(t.useExisting);else if(t.useClass)o=!0,n=_t(t.useClass);else{if("function"!=typeof i)throw zt("StaticProvider does not have [useValue|useFactory|useExisting|useClass] or [provide] is not newable",t);o=!0,n=i}return{deps:e,fn:n,useNew:o,value:r}}(n);if(!0===n.multi){var a=e.get(o);if(a){if(a.fn!==Mt)throw Vt(o)}else e.set(o,a={token:n.provide,deps:[],useNew:!1,fn:Mt,value:Dt});a.deps.push({token:o=n,options:6})}var s=e.get(o);if(s&&s.fn==Mt)throw Vt(o);e.set(o,i)}}(r,t)}return t.prototype.get=function(t,e,n){void 0===n&&(n=pt.Default);var r=this._records.get(t);try{return function t(e,n,r,o,i,a){try{return function(e,n,r,o,i,a){var s,u;if(!n||a&pt.SkipSelf)a&pt.Self||(u=o.get(e,i,pt.Default));else{if((u=n.value)==Nt)throw Error(Lt+"Circular dependency");if(u===Dt){n.value=Nt;var c=n.useNew,l=n.fn,f=n.deps,h=Dt;if(f.length){h=[];for(var d=0;d<f.length;d++){var y=f[d],v=y.options,g=2&v?r.get(y.token):void 0;h.push(t(y.token,g,r,g||4&v?o:Pt.NULL,1&v?null:Pt.THROW_IF_NOT_FOUND,pt.Default))}}n.value=u=c?new((s=l).bind.apply(s,p([void 0],h))):l.apply(void 0,h)}}return u}(e,n,r,o,i,a)}catch(s){throw s instanceof Error||(s=new Error(s)),(s.ngTempTokenPath=s.ngTempTokenPath||[]).unshift(e),n&&n.value==Nt&&(n.value=Dt),s}}(t,r,this._records,this.parent,e,n)}catch(o){return function(t,e,n,r){var o=t.ngTempTokenPath;throw e[At]&&o.unshift(e[At]),t.message=Ht("\n"+t.message,o,"StaticInjectorError",r),t.ngTokenPath=o,t.ngTempTokenPath=null,t}(o,t,0,this.source)}},t.prototype.toString=function(){var t=[];return this._records.forEach(function(e,n){return t.push(gt(n))}),"StaticInjector["+t.join(", ")+"]"},t}();function Vt(t){return zt("Cannot mix multi providers and regular providers",t)}function Ht(t,e,n,r){void 0===r&&(r=null),t=t&&"\n"===t.charAt(0)&&t.charAt(1)==Lt?t.substr(2):t;var o=gt(e);if(e instanceof Array)o=e.map(gt).join(" -> ");else if("object"==typeof e){var i=[];for(var a in e)if(e.hasOwnProperty(a)){var s=e[a];i.push(a+":"+("string"==typeof s?JSON.stringify(s):gt(s)))}o="{"+i.join(", ")+"}"}return n+(r?"("+r+")":"")+"["+o+"]: "+t.replace(Ft,"\n  ")}function zt(t,e){return new Error(Ht(t,e,"StaticInjectorError"))}var Ut="ngDebugContext",Wt="ngOriginalError",Zt="ngErrorLogger",qt=function(t){return t[t.Emulated=0]="Emulated",t[t.Native=1]="Native",t[t.None=2]="None",t[t.ShadowDom=3]="ShadowDom",t}({}),Gt=function(){return("undefined"!=typeof requestAnimationFrame&&requestAnimationFrame||setTimeout).bind(St)}();function Kt(t){return t[Ut]}function Qt(t){return t[Wt]}function Yt(t){for(var e=[],n=1;n<arguments.length;n++)e[n-1]=arguments[n];t.error.apply(t,p(e))}var Xt=function(){function t(){this._console=console}return t.prototype.handleError=function(t){var e=this._findOriginalError(t),n=this._findContext(t),r=function(t){return t[Zt]||Yt}(t);r(this._console,"ERROR",t),e&&r(this._console,"ORIGINAL ERROR",e),n&&r(this._console,"ERROR CONTEXT",n)},t.prototype._findContext=function(t){return t?Kt(t)?Kt(t):this._findContext(Qt(t)):null},t.prototype._findOriginalError=function(t){for(var e=Qt(t);e&&Qt(e);)e=Qt(e);return e},t}(),Jt=!0,$t=!1;function te(){return $t=!0,Jt}var ee=function(){function t(t){if(this.defaultDoc=t,this.inertDocument=this.defaultDoc.implementation.createHTMLDocument("sanitization-inert"),this.inertBodyElement=this.inertDocument.body,null==this.inertBodyElement){var e=this.inertDocument.createElement("html");this.inertDocument.appendChild(e),this.inertBodyElement=this.inertDocument.createElement("body"),e.appendChild(this.inertBodyElement)}this.inertBodyElement.innerHTML='<svg><g onload="this.parentNode.remove()"></g></svg>',!this.inertBodyElement.querySelector||this.inertBodyElement.querySelector("svg")?(this.inertBodyElement.innerHTML='<svg><p><style><img src="</style><img src=x onerror=alert(1)//">',this.getInertBodyElement=this.inertBodyElement.querySelector&&this.inertBodyElement.querySelector("svg img")&&function(){try{return!!window.DOMParser}catch(t){return!1}}()?this.getInertBodyElement_DOMParser:this.getInertBodyElement_InertDocument):this.getInertBodyElement=this.getInertBodyElement_XHR}return t.prototype.getInertBodyElement_XHR=function(t){t="<body><remove></remove>"+t+"</body>";try{t=encodeURI(t)}catch(r){return null}var e=new XMLHttpRequest;e.responseType="document",e.open("GET","data:text/html;charset=utf-8,"+t,!1),e.send(void 0);var n=e.response.body;return n.removeChild(n.firstChild),n},t.prototype.getInertBodyElement_DOMParser=function(t){t="<body><remove></remove>"+t+"</body>";try{var e=(new window.DOMParser).parseFromString(t,"text/html").body;return e.removeChild(e.firstChild),e}catch(n){return null}},t.prototype.getInertBodyElement_InertDocument=function(t){var e=this.inertDocument.createElement("template");return"content"in e?(e.innerHTML=t,e):(this.inertBodyElement.innerHTML=t,this.defaultDoc.documentMode&&this.stripCustomNsAttrs(this.inertBodyElement),this.inertBodyElement)},t.prototype.stripCustomNsAttrs=function(t){for(var e=t.attributes,n=e.length-1;0<n;n--){var r=e.item(n).name;"xmlns:ns1"!==r&&0!==r.indexOf("ns1:")||t.removeAttribute(r)}for(var o=t.firstChild;o;)o.nodeType===Node.ELEMENT_NODE&&this.stripCustomNsAttrs(o),o=o.nextSibling},t}(),ne=/^(?:(?:https?|mailto|ftp|tel|file):|[^&:/?#]*(?:[/?#]|$))/gi,re=/^data:(?:image\/(?:bmp|gif|jpeg|jpg|png|tiff|webp)|video\/(?:mpeg|mp4|ogg|webm)|audio\/(?:mp3|oga|ogg|opus));base64,[a-z0-9+\/]+=*$/i;function oe(t){return(t=String(t)).match(ne)||t.match(re)?t:(te()&&console.warn("WARNING: sanitizing unsafe URL value "+t+" (see http://g.co/ng/security#xss)"),"unsafe:"+t)}function ie(t){var e,n,r={};try{for(var o=l(t.split(",")),i=o.next();!i.done;i=o.next())r[i.value]=!0}catch(a){e={error:a}}finally{try{i&&!i.done&&(n=o.return)&&n.call(o)}finally{if(e)throw e.error}}return r}function ae(){for(var t,e,n=[],r=0;r<arguments.length;r++)n[r]=arguments[r];var o={};try{for(var i=l(n),a=i.next();!a.done;a=i.next()){var s=a.value;for(var u in s)s.hasOwnProperty(u)&&(o[u]=!0)}}catch(c){t={error:c}}finally{try{a&&!a.done&&(e=i.return)&&e.call(i)}finally{if(t)throw t.error}}return o}var se,ue=ie("area,br,col,hr,img,wbr"),ce=ie("colgroup,dd,dt,li,p,tbody,td,tfoot,th,thead,tr"),le=ie("rp,rt"),fe=ae(le,ce),pe=ae(ue,ae(ce,ie("address,article,aside,blockquote,caption,center,del,details,dialog,dir,div,dl,figure,figcaption,footer,h1,h2,h3,h4,h5,h6,header,hgroup,hr,ins,main,map,menu,nav,ol,pre,section,summary,table,ul")),ae(le,ie("a,abbr,acronym,audio,b,bdi,bdo,big,br,cite,code,del,dfn,em,font,i,img,ins,kbd,label,map,mark,picture,q,ruby,rp,rt,s,samp,small,source,span,strike,strong,sub,sup,time,track,tt,u,var,video")),fe),he=ie("background,cite,href,itemtype,longdesc,poster,src,xlink:href"),de=ie("srcset"),ye=ae(he,de,ie("abbr,accesskey,align,alt,autoplay,axis,bgcolor,border,cellpadding,cellspacing,class,clear,color,cols,colspan,compact,controls,coords,datetime,default,dir,download,face,headers,height,hidden,hreflang,hspace,ismap,itemscope,itemprop,kind,label,lang,language,loop,media,muted,nohref,nowrap,open,preload,rel,rev,role,rows,rowspan,rules,scope,scrolling,shape,size,sizes,span,srclang,start,summary,tabindex,target,title,translate,type,usemap,valign,value,vspace,width"),ie("aria-activedescendant,aria-atomic,aria-autocomplete,aria-busy,aria-checked,aria-colcount,aria-colindex,aria-colspan,aria-controls,aria-current,aria-describedby,aria-details,aria-disabled,aria-dropeffect,aria-errormessage,aria-expanded,aria-flowto,aria-grabbed,aria-haspopup,aria-hidden,aria-invalid,aria-keyshortcuts,aria-label,aria-labelledby,aria-level,aria-live,aria-modal,aria-multiline,aria-multiselectable,aria-orientation,aria-owns,aria-placeholder,aria-posinset,aria-pressed,aria-readonly,aria-relevant,aria-required,aria-roledescription,aria-rowcount,aria-rowindex,aria-rowspan,aria-selected,aria-setsize,aria-sort,aria-valuemax,aria-valuemin,aria-valuenow,aria-valuetext")),ve=ie("script,style,template"),ge=function(){function t(){this.sanitizedSomething=!1,this.buf=[]}return t.prototype.sanitizeChildren=function(t){for(var e=t.firstChild,n=!0;e;)if(e.nodeType===Node.ELEMENT_NODE?n=this.startElement(e):e.nodeType===Node.TEXT_NODE?this.chars(e.nodeValue):this.sanitizedSomething=!0,n&&e.firstChild)e=e.firstChild;else for(;e;){e.nodeType===Node.ELEMENT_NODE&&this.endElement(e);var r=this.checkClobberedElement(e,e.nextSibling);if(r){e=r;break}e=this.checkClobberedElement(e,e.parentNode)}return this.buf.join("")},t.prototype.startElement=function(t){var e,n=t.nodeName.toLowerCase();if(!pe.hasOwnProperty(n))return this.sanitizedSomething=!0,!ve.hasOwnProperty(n);this.buf.push("<"),this.buf.push(n);for(var r=t.attributes,o=0;o<r.length;o++){var i=r.item(o),a=i.name,s=a.toLowerCase();if(ye.hasOwnProperty(s)){var u=i.value;he[s]&&(u=oe(u)),de[s]&&(e=u,u=(e=String(e)).split(",").map(function(t){return oe(t.trim())}).join(", ")),this.buf.push(" ",a,'="',_e(u),'"')}else this.sanitizedSomething=!0}return this.buf.push(">"),!0},t.prototype.endElement=function(t){var e=t.nodeName.toLowerCase();pe.hasOwnProperty(e)&&!ue.hasOwnProperty(e)&&(this.buf.push("</"),this.buf.push(e),this.buf.push(">"))},t.prototype.chars=function(t){this.buf.push(_e(t))},t.prototype.checkClobberedElement=function(t,e){if(e&&(t.compareDocumentPosition(e)&Node.DOCUMENT_POSITION_CONTAINED_BY)===Node.DOCUMENT_POSITION_CONTAINED_BY)throw new Error("Failed to sanitize html because the element is clobbered: "+t.outerHTML);return e},t}(),me=/[\uD800-\uDBFF][\uDC00-\uDFFF]/g,be=/([^\#-~ |!])/g;function _e(t){return t.replace(/&/g,"&amp;").replace(me,function(t){return"&#"+(1024*(t.charCodeAt(0)-55296)+(t.charCodeAt(1)-56320)+65536)+";"}).replace(be,function(t){return"&#"+t.charCodeAt(0)+";"}).replace(/</g,"&lt;").replace(/>/g,"&gt;")}function we(t){return"content"in t&&function(t){return t.nodeType===Node.ELEMENT_NODE&&"TEMPLATE"===t.nodeName}(t)?t.content:null}var Ee=function(t){return t[t.NONE=0]="NONE",t[t.HTML=1]="HTML",t[t.STYLE=2]="STYLE",t[t.SCRIPT=3]="SCRIPT",t[t.URL=4]="URL",t[t.RESOURCE_URL=5]="RESOURCE_URL",t}({}),Se=function(){return function(){}}(),xe=new RegExp("^([-,.\"'%_!# a-zA-Z0-9]+|(?:(?:matrix|translate|scale|rotate|skew|perspective)(?:X|Y|3d)?|(?:rgb|hsl)a?|(?:repeating-)?(?:linear|radial)-gradient|(?:calc|attr))\\([-0-9.%, #a-zA-Z]+\\))$","g"),Te=/^url\(([^)]+)\)$/,ke=/([A-Z])/g;function Ae(t){try{return null!=t?t.toString().slice(0,30):t}catch(e){return"[ERROR] Exception while trying to serialize the value"}}function Ie(t){return!!t&&"function"==typeof t.then}var Ce=null;function Oe(){if(!Ce){var t=St.Symbol;if(t&&t.iterator)Ce=t.iterator;else for(var e=Object.getOwnPropertyNames(Map.prototype),n=0;n<e.length;++n){var r=e[n];"entries"!==r&&"size"!==r&&Map.prototype[r]===Map.prototype.entries&&(Ce=r)}}return Ce}function Pe(t,e){return t===e||"number"==typeof t&&"number"==typeof e&&isNaN(t)&&isNaN(e)}function Re(t,e){var n=Ne(t),r=Ne(e);return n&&r?function(t,e,n){for(var r=t[Oe()](),o=e[Oe()]();;){var i=r.next(),a=o.next();if(i.done&&a.done)return!0;if(i.done||a.done)return!1;if(!n(i.value,a.value))return!1}}(t,e,Re):!(n||!t||"object"!=typeof t&&"function"!=typeof t||r||!e||"object"!=typeof e&&"function"!=typeof e)||Pe(t,e)}var De=function(){function t(t){this.wrapped=t}return t.wrap=function(e){return new t(e)},t.unwrap=function(e){return t.isWrapped(e)?e.wrapped:e},t.isWrapped=function(e){return e instanceof t},t}();function Ne(t){return!!Me(t)&&(Array.isArray(t)||!(t instanceof Map)&&Oe()in t)}function Me(t){return null!==t&&("function"==typeof t||"object"==typeof t)}var je=function(){function t(t,e,n){this.previousValue=t,this.currentValue=e,this.firstChange=n}return t.prototype.isFirstChange=function(){return this.firstChange},t}(),Fe=new kt("The presence of this token marks an injector as being the root injector."),Le=function(){return function(){}}(),Be=function(){return function(){}}();function Ve(t){var e=Error("No component factory found for "+gt(t)+". Did you add it to @NgModule.entryComponents?");return e[He]=t,e}var He="ngComponent",ze=function(){function t(){}return t.prototype.resolveComponentFactory=function(t){throw Ve(t)},t}(),Ue=function(){function t(){}return t.NULL=new ze,t}(),We=function(){function t(t,e,n){this._parent=e,this._ngModule=n,this._factories=new Map;for(var r=0;r<t.length;r++){var o=t[r];this._factories.set(o.componentType,o)}}return t.prototype.resolveComponentFactory=function(t){var e=this._factories.get(t);if(!e&&this._parent&&(e=this._parent.resolveComponentFactory(t)),!e)throw Ve(t);return new Ze(e,this._ngModule)},t}(),Ze=function(t){function e(e,n){var r=t.call(this)||this;return r.factory=e,r.ngModule=n,r.selector=e.selector,r.componentType=e.componentType,r.ngContentSelectors=e.ngContentSelectors,r.inputs=e.inputs,r.outputs=e.outputs,r}return o(e,t),e.prototype.create=function(t,e,n,r){return this.factory.create(t,e,n,r||this.ngModule)},e}(Be),qe=function(){return function(){}}(),Ge=function(){return function(){}}();function Ke(){for(var t=[],e=0;e<arguments.length;e++)t[e]=arguments[e]}var Qe=function(){function t(t){this.nativeElement=t}return t.__NG_ELEMENT_ID__=function(){return Ye(t)},t}(),Ye=Ke,Xe=function(){return function(){}}(),Je=function(){return function(){}}(),$e=function(t){return t[t.Important=1]="Important",t[t.DashCase=2]="DashCase",t}({}),tn=function(){function t(){}return t.__NG_ELEMENT_ID__=function(){return en()},t}(),en=Ke,nn=function(){return function(t){this.full=t,this.major=t.split(".")[0],this.minor=t.split(".")[1],this.patch=t.split(".").slice(2).join(".")}}(),rn=new nn("8.0.3"),on=function(){function t(){}return t.prototype.supports=function(t){return Ne(t)},t.prototype.create=function(t){return new sn(t)},t}(),an=function(t,e){return e},sn=function(){function t(t){this.length=0,this._linkedRecords=null,this._unlinkedRecords=null,this._previousItHead=null,this._itHead=null,this._itTail=null,this._additionsHead=null,this._additionsTail=null,this._movesHead=null,this._movesTail=null,this._removalsHead=null,this._removalsTail=null,this._identityChangesHead=null,this._identityChangesTail=null,this._trackByFn=t||an}return t.prototype.forEachItem=function(t){var e;for(e=this._itHead;null!==e;e=e._next)t(e)},t.prototype.forEachOperation=function(t){for(var e=this._itHead,n=this._removalsHead,r=0,o=null;e||n;){var i=!n||e&&e.currentIndex<fn(n,r,o)?e:n,a=fn(i,r,o),s=i.currentIndex;if(i===n)r--,n=n._nextRemoved;else if(e=e._next,null==i.previousIndex)r++;else{o||(o=[]);var u=a-r,c=s-r;if(u!=c){for(var l=0;l<u;l++){var f=l<o.length?o[l]:o[l]=0,p=f+l;c<=p&&p<u&&(o[l]=f+1)}o[i.previousIndex]=c-u}}a!==s&&t(i,a,s)}},t.prototype.forEachPreviousItem=function(t){var e;for(e=this._previousItHead;null!==e;e=e._nextPrevious)t(e)},t.prototype.forEachAddedItem=function(t){var e;for(e=this._additionsHead;null!==e;e=e._nextAdded)t(e)},t.prototype.forEachMovedItem=function(t){var e;for(e=this._movesHead;null!==e;e=e._nextMoved)t(e)},t.prototype.forEachRemovedItem=function(t){var e;for(e=this._removalsHead;null!==e;e=e._nextRemoved)t(e)},t.prototype.forEachIdentityChange=function(t){var e;for(e=this._identityChangesHead;null!==e;e=e._nextIdentityChange)t(e)},t.prototype.diff=function(t){if(null==t&&(t=[]),!Ne(t))throw new Error("Error trying to diff '"+gt(t)+"'. Only arrays and iterables are allowed");return this.check(t)?this:null},t.prototype.onDestroy=function(){},t.prototype.check=function(t){var e=this;this._reset();var n,r,o,i=this._itHead,a=!1;if(Array.isArray(t)){this.length=t.length;for(var s=0;s<this.length;s++)o=this._trackByFn(s,r=t[s]),null!==i&&Pe(i.trackById,o)?(a&&(i=this._verifyReinsertion(i,r,o,s)),Pe(i.item,r)||this._addIdentityChange(i,r)):(i=this._mismatch(i,r,o,s),a=!0),i=i._next}else n=0,function(t,e){if(Array.isArray(t))for(var n=0;n<t.length;n++)e(t[n]);else for(var r=t[Oe()](),o=void 0;!(o=r.next()).done;)e(o.value)}(t,function(t){o=e._trackByFn(n,t),null!==i&&Pe(i.trackById,o)?(a&&(i=e._verifyReinsertion(i,t,o,n)),Pe(i.item,t)||e._addIdentityChange(i,t)):(i=e._mismatch(i,t,o,n),a=!0),i=i._next,n++}),this.length=n;return this._truncate(i),this.collection=t,this.isDirty},Object.defineProperty(t.prototype,"isDirty",{get:function(){return null!==this._additionsHead||null!==this._movesHead||null!==this._removalsHead||null!==this._identityChangesHead},enumerable:!0,configurable:!0}),t.prototype._reset=function(){if(this.isDirty){var t=void 0,e=void 0;for(t=this._previousItHead=this._itHead;null!==t;t=t._next)t._nextPrevious=t._next;for(t=this._additionsHead;null!==t;t=t._nextAdded)t.previousIndex=t.currentIndex;for(this._additionsHead=this._additionsTail=null,t=this._movesHead;null!==t;t=e)t.previousIndex=t.currentIndex,e=t._nextMoved;this._movesHead=this._movesTail=null,this._removalsHead=this._removalsTail=null,this._identityChangesHead=this._identityChangesTail=null}},t.prototype._mismatch=function(t,e,n,r){var o;return null===t?o=this._itTail:(o=t._prev,this._remove(t)),null!==(t=null===this._linkedRecords?null:this._linkedRecords.get(n,r))?(Pe(t.item,e)||this._addIdentityChange(t,e),this._moveAfter(t,o,r)):null!==(t=null===this._unlinkedRecords?null:this._unlinkedRecords.get(n,null))?(Pe(t.item,e)||this._addIdentityChange(t,e),this._reinsertAfter(t,o,r)):t=this._addAfter(new un(e,n),o,r),t},t.prototype._verifyReinsertion=function(t,e,n,r){var o=null===this._unlinkedRecords?null:this._unlinkedRecords.get(n,null);return null!==o?t=this._reinsertAfter(o,t._prev,r):t.currentIndex!=r&&(t.currentIndex=r,this._addToMoves(t,r)),t},t.prototype._truncate=function(t){for(;null!==t;){var e=t._next;this._addToRemovals(this._unlink(t)),t=e}null!==this._unlinkedRecords&&this._unlinkedRecords.clear(),null!==this._additionsTail&&(this._additionsTail._nextAdded=null),null!==this._movesTail&&(this._movesTail._nextMoved=null),null!==this._itTail&&(this._itTail._next=null),null!==this._removalsTail&&(this._removalsTail._nextRemoved=null),null!==this._identityChangesTail&&(this._identityChangesTail._nextIdentityChange=null)},t.prototype._reinsertAfter=function(t,e,n){null!==this._unlinkedRecords&&this._unlinkedRecords.remove(t);var r=t._prevRemoved,o=t._nextRemoved;return null===r?this._removalsHead=o:r._nextRemoved=o,null===o?this._removalsTail=r:o._prevRemoved=r,this._insertAfter(t,e,n),this._addToMoves(t,n),t},t.prototype._moveAfter=function(t,e,n){return this._unlink(t),this._insertAfter(t,e,n),this._addToMoves(t,n),t},t.prototype._addAfter=function(t,e,n){return this._insertAfter(t,e,n),this._additionsTail=null===this._additionsTail?this._additionsHead=t:this._additionsTail._nextAdded=t,t},t.prototype._insertAfter=function(t,e,n){var r=null===e?this._itHead:e._next;return t._next=r,t._prev=e,null===r?this._itTail=t:r._prev=t,null===e?this._itHead=t:e._next=t,null===this._linkedRecords&&(this._linkedRecords=new ln),this._linkedRecords.put(t),t.currentIndex=n,t},t.prototype._remove=function(t){return this._addToRemovals(this._unlink(t))},t.prototype._unlink=function(t){null!==this._linkedRecords&&this._linkedRecords.remove(t);var e=t._prev,n=t._next;return null===e?this._itHead=n:e._next=n,null===n?this._itTail=e:n._prev=e,t},t.prototype._addToMoves=function(t,e){return t.previousIndex===e?t:(this._movesTail=null===this._movesTail?this._movesHead=t:this._movesTail._nextMoved=t,t)},t.prototype._addToRemovals=function(t){return null===this._unlinkedRecords&&(this._unlinkedRecords=new ln),this._unlinkedRecords.put(t),t.currentIndex=null,t._nextRemoved=null,null===this._removalsTail?(this._removalsTail=this._removalsHead=t,t._prevRemoved=null):(t._prevRemoved=this._removalsTail,this._removalsTail=this._removalsTail._nextRemoved=t),t},t.prototype._addIdentityChange=function(t,e){return t.item=e,this._identityChangesTail=null===this._identityChangesTail?this._identityChangesHead=t:this._identityChangesTail._nextIdentityChange=t,t},t}(),un=function(){return function(t,e){this.item=t,this.trackById=e,this.currentIndex=null,this.previousIndex=null,this._nextPrevious=null,this._prev=null,this._next=null,this._prevDup=null,this._nextDup=null,this._prevRemoved=null,this._nextRemoved=null,this._nextAdded=null,this._nextMoved=null,this._nextIdentityChange=null}}(),cn=function(){function t(){this._head=null,this._tail=null}return t.prototype.add=function(t){null===this._head?(this._head=this._tail=t,t._nextDup=null,t._prevDup=null):(this._tail._nextDup=t,t._prevDup=this._tail,t._nextDup=null,this._tail=t)},t.prototype.get=function(t,e){var n;for(n=this._head;null!==n;n=n._nextDup)if((null===e||e<=n.currentIndex)&&Pe(n.trackById,t))return n;return null},t.prototype.remove=function(t){var e=t._prevDup,n=t._nextDup;return null===e?this._head=n:e._nextDup=n,null===n?this._tail=e:n._prevDup=e,null===this._head},t}(),ln=function(){function t(){this.map=new Map}return t.prototype.put=function(t){var e=t.trackById,n=this.map.get(e);n||(n=new cn,this.map.set(e,n)),n.add(t)},t.prototype.get=function(t,e){var n=this.map.get(t);return n?n.get(t,e):null},t.prototype.remove=function(t){var e=t.trackById;return this.map.get(e).remove(t)&&this.map.delete(e),t},Object.defineProperty(t.prototype,"isEmpty",{get:function(){return 0===this.map.size},enumerable:!0,configurable:!0}),t.prototype.clear=function(){this.map.clear()},t}();function fn(t,e,n){var r=t.previousIndex;if(null===r)return r;var o=0;return n&&r<n.length&&(o=n[r]),r+e+o}var pn=function(){function t(){}return t.prototype.supports=function(t){return t instanceof Map||Me(t)},t.prototype.create=function(){return new hn},t}(),hn=function(){function t(){this._records=new Map,this._mapHead=null,this._appendAfter=null,this._previousMapHead=null,this._changesHead=null,this._changesTail=null,this._additionsHead=null,this._additionsTail=null,this._removalsHead=null,this._removalsTail=null}return Object.defineProperty(t.prototype,"isDirty",{get:function(){return null!==this._additionsHead||null!==this._changesHead||null!==this._removalsHead},enumerable:!0,configurable:!0}),t.prototype.forEachItem=function(t){var e;for(e=this._mapHead;null!==e;e=e._next)t(e)},t.prototype.forEachPreviousItem=function(t){var e;for(e=this._previousMapHead;null!==e;e=e._nextPrevious)t(e)},t.prototype.forEachChangedItem=function(t){var e;for(e=this._changesHead;null!==e;e=e._nextChanged)t(e)},t.prototype.forEachAddedItem=function(t){var e;for(e=this._additionsHead;null!==e;e=e._nextAdded)t(e)},t.prototype.forEachRemovedItem=function(t){var e;for(e=this._removalsHead;null!==e;e=e._nextRemoved)t(e)},t.prototype.diff=function(t){if(t){if(!(t instanceof Map||Me(t)))throw new Error("Error trying to diff '"+gt(t)+"'. Only maps and objects are allowed")}else t=new Map;return this.check(t)?this:null},t.prototype.onDestroy=function(){},t.prototype.check=function(t){var e=this;this._reset();var n=this._mapHead;if(this._appendAfter=null,this._forEach(t,function(t,r){if(n&&n.key===r)e._maybeAddToChanges(n,t),e._appendAfter=n,n=n._next;else{var o=e._getOrCreateRecordForKey(r,t);n=e._insertBeforeOrAppend(n,o)}}),n){n._prev&&(n._prev._next=null),this._removalsHead=n;for(var r=n;null!==r;r=r._nextRemoved)r===this._mapHead&&(this._mapHead=null),this._records.delete(r.key),r._nextRemoved=r._next,r.previousValue=r.currentValue,r.currentValue=null,r._prev=null,r._next=null}return this._changesTail&&(this._changesTail._nextChanged=null),this._additionsTail&&(this._additionsTail._nextAdded=null),this.isDirty},t.prototype._insertBeforeOrAppend=function(t,e){if(t){var n=t._prev;return e._next=t,e._prev=n,t._prev=e,n&&(n._next=e),t===this._mapHead&&(this._mapHead=e),this._appendAfter=t,t}return this._appendAfter?(this._appendAfter._next=e,e._prev=this._appendAfter):this._mapHead=e,this._appendAfter=e,null},t.prototype._getOrCreateRecordForKey=function(t,e){if(this._records.has(t)){var n=this._records.get(t);this._maybeAddToChanges(n,e);var r=n._prev,o=n._next;return r&&(r._next=o),o&&(o._prev=r),n._next=null,n._prev=null,n}var i=new dn(t);return this._records.set(t,i),i.currentValue=e,this._addToAdditions(i),i},t.prototype._reset=function(){if(this.isDirty){var t=void 0;for(this._previousMapHead=this._mapHead,t=this._previousMapHead;null!==t;t=t._next)t._nextPrevious=t._next;for(t=this._changesHead;null!==t;t=t._nextChanged)t.previousValue=t.currentValue;for(t=this._additionsHead;null!=t;t=t._nextAdded)t.previousValue=t.currentValue;this._changesHead=this._changesTail=null,this._additionsHead=this._additionsTail=null,this._removalsHead=null}},t.prototype._maybeAddToChanges=function(t,e){Pe(e,t.currentValue)||(t.previousValue=t.currentValue,t.currentValue=e,this._addToChanges(t))},t.prototype._addToAdditions=function(t){null===this._additionsHead?this._additionsHead=this._additionsTail=t:(this._additionsTail._nextAdded=t,this._additionsTail=t)},t.prototype._addToChanges=function(t){null===this._changesHead?this._changesHead=this._changesTail=t:(this._changesTail._nextChanged=t,this._changesTail=t)},t.prototype._forEach=function(t,e){t instanceof Map?t.forEach(e):Object.keys(t).forEach(function(n){return e(t[n],n)})},t}(),dn=function(){return function(t){this.key=t,this.previousValue=null,this.currentValue=null,this._nextPrevious=null,this._next=null,this._prev=null,this._nextAdded=null,this._nextRemoved=null,this._nextChanged=null}}(),yn=function(){function t(t){this.factories=t}return t.create=function(e,n){if(null!=n){var r=n.factories.slice();e=e.concat(r)}return new t(e)},t.extend=function(e){return{provide:t,useFactory:function(n){if(!n)throw new Error("Cannot extend IterableDiffers without a parent injector");return t.create(e,n)},deps:[[t,new ft,new ct]]}},t.prototype.find=function(t){var e,n=this.factories.find(function(e){return e.supports(t)});if(null!=n)return n;throw new Error("Cannot find a differ supporting object '"+t+"' of type '"+((e=t).name||typeof e)+"'")},t.ngInjectableDef=dt({providedIn:"root",factory:function(){return new t([new on])}}),t}(),vn=function(){function t(t){this.factories=t}return t.create=function(e,n){if(n){var r=n.factories.slice();e=e.concat(r)}return new t(e)},t.extend=function(e){return{provide:t,useFactory:function(n){if(!n)throw new Error("Cannot extend KeyValueDiffers without a parent injector");return t.create(e,n)},deps:[[t,new ft,new ct]]}},t.prototype.find=function(t){var e=this.factories.find(function(e){return e.supports(t)});if(e)return e;throw new Error("Cannot find a differ supporting object '"+t+"'")},t.ngInjectableDef=dt({providedIn:"root",factory:function(){return new t([new pn])}}),t}(),gn=function(){function t(){}return t.__NG_ELEMENT_ID__=function(){return mn()},t}(),mn=function(){for(var t=[],e=0;e<arguments.length;e++)t[e]=arguments[e]},bn=[new pn],_n=new yn([new on]),wn=new vn(bn),En=function(){function t(){}return t.__NG_ELEMENT_ID__=function(){return Sn(t,Qe)},t}(),Sn=Ke,xn=function(){function t(){}return t.__NG_ELEMENT_ID__=function(){return Tn(t,Qe)},t}(),Tn=Ke;function kn(t,e,n,r){var o="ExpressionChangedAfterItHasBeenCheckedError: Expression has changed after it was checked. Previous value: '"+e+"'. Current value: '"+n+"'.";return r&&(o+=" It seems like the view has been created after its parent and its children have been dirty checked. Has it been created in a change detection hook ?"),function(t,e){var n=new Error(t);return An(n,e),n}(o,t)}function An(t,e){t[Ut]=e,t[Zt]=e.logError.bind(e)}function In(t){return new Error("ViewDestroyedError: Attempt to use a destroyed view: "+t)}function Cn(t,e,n){var r=t.state,o=1792&r;return o===e?(t.state=-1793&r|n,t.initIndex=-1,!0):o===n}function On(t,e,n){return(1792&t.state)===e&&t.initIndex<=n&&(t.initIndex=n+1,!0)}function Pn(t,e){return t.nodes[e]}function Rn(t,e){return t.nodes[e]}function Dn(t,e){return t.nodes[e]}function Nn(t,e){return t.nodes[e]}function Mn(t,e){return t.nodes[e]}var jn={setCurrentNode:void 0,createRootView:void 0,createEmbeddedView:void 0,createComponentView:void 0,createNgModuleRef:void 0,overrideProvider:void 0,overrideComponentView:void 0,clearOverrides:void 0,checkAndUpdateView:void 0,checkNoChangesView:void 0,destroyView:void 0,resolveDep:void 0,createDebugContext:void 0,handleEvent:void 0,updateDirectives:void 0,updateRenderer:void 0,dirtyParentQueries:void 0},Fn=function(){},Ln=new Map;function Bn(t){var e=Ln.get(t);return e||(e=gt(t)+"_"+Ln.size,Ln.set(t,e)),e}var Vn="$$undefined",Hn="$$empty";function zn(t){return{id:Vn,styles:t.styles,encapsulation:t.encapsulation,data:t.data}}var Un=0;function Wn(t,e,n,r){return!(!(2&t.state)&&Pe(t.oldValues[e.bindingIndex+n],r))}function Zn(t,e,n,r){return!!Wn(t,e,n,r)&&(t.oldValues[e.bindingIndex+n]=r,!0)}function qn(t,e,n,r){var o=t.oldValues[e.bindingIndex+n];if(1&t.state||!Re(o,r)){var i=e.bindings[n].name;throw kn(jn.createDebugContext(t,e.nodeIndex),i+": "+o,i+": "+r,0!=(1&t.state))}}function Gn(t){for(var e=t;e;)2&e.def.flags&&(e.state|=8),e=e.viewContainerParent||e.parent}function Kn(t,e){for(var n=t;n&&n!==e;)n.state|=64,n=n.viewContainerParent||n.parent}function Qn(t,e,n,r){try{return Gn(33554432&t.def.nodes[e].flags?Rn(t,e).componentView:t),jn.handleEvent(t,e,n,r)}catch(o){t.root.errorHandler.handleError(o)}}function Yn(t){return t.parent?Rn(t.parent,t.parentNodeDef.nodeIndex):null}function Xn(t){return t.parent?t.parentNodeDef.parent:null}function Jn(t,e){switch(201347067&e.flags){case 1:return Rn(t,e.nodeIndex).renderElement;case 2:return Pn(t,e.nodeIndex).renderText}}function $n(t){return!!t.parent&&!!(32768&t.parentNodeDef.flags)}function tr(t){return!(!t.parent||32768&t.parentNodeDef.flags)}function er(t){return 1<<t%32}function nr(t){var e={},n=0,r={};return t&&t.forEach(function(t){var o=f(t,2),i=o[0],a=o[1];"number"==typeof i?(e[i]=a,n|=er(i)):r[i]=a}),{matchedQueries:e,references:r,matchedQueryIds:n}}function rr(t,e){return t.map(function(t){var n,r,o;return Array.isArray(t)?(o=(n=f(t,2))[0],r=n[1]):(o=0,r=t),r&&("function"==typeof r||"object"==typeof r)&&e&&Object.defineProperty(r,At,{value:e,configurable:!0}),{flags:o,token:r,tokenKey:Bn(r)}})}function or(t,e,n){var r=n.renderParent;return r?0==(1&r.flags)||0==(33554432&r.flags)||r.element.componentRendererType&&r.element.componentRendererType.encapsulation===qt.Native?Rn(t,n.renderParent.nodeIndex).renderElement:void 0:e}var ir=new WeakMap;function ar(t){var e=ir.get(t);return e||((e=t(function(){return Fn})).factory=t,ir.set(t,e)),e}function sr(t,e,n,r,o){3===e&&(n=t.renderer.parentNode(Jn(t,t.def.lastRenderRootNode))),ur(t,e,0,t.def.nodes.length-1,n,r,o)}function ur(t,e,n,r,o,i,a){for(var s=n;s<=r;s++){var u=t.def.nodes[s];11&u.flags&&lr(t,u,e,o,i,a),s+=u.childCount}}function cr(t,e,n,r,o,i){for(var a=t;a&&!$n(a);)a=a.parent;for(var s=a.parent,u=Xn(a),c=u.nodeIndex+u.childCount,l=u.nodeIndex+1;l<=c;l++){var f=s.def.nodes[l];f.ngContentIndex===e&&lr(s,f,n,r,o,i),l+=f.childCount}if(!s.parent){var p=t.root.projectableNodes[e];if(p)for(l=0;l<p.length;l++)fr(t,p[l],n,r,o,i)}}function lr(t,e,n,r,o,i){if(8&e.flags)cr(t,e.ngContent.index,n,r,o,i);else{var a=Jn(t,e);if(3===n&&33554432&e.flags&&48&e.bindingFlags?(16&e.bindingFlags&&fr(t,a,n,r,o,i),32&e.bindingFlags&&fr(Rn(t,e.nodeIndex).componentView,a,n,r,o,i)):fr(t,a,n,r,o,i),16777216&e.flags)for(var s=Rn(t,e.nodeIndex).viewContainer._embeddedViews,u=0;u<s.length;u++)sr(s[u],n,r,o,i);1&e.flags&&!e.element.name&&ur(t,n,e.nodeIndex+1,e.nodeIndex+e.childCount,r,o,i)}}function fr(t,e,n,r,o,i){var a=t.renderer;switch(n){case 1:a.appendChild(r,e);break;case 2:a.insertBefore(r,e,o);break;case 3:a.removeChild(r,e);break;case 0:i.push(e)}}var pr=/^:([^:]+):(.+)$/;function hr(t){if(":"===t[0]){var e=t.match(pr);return[e[1],e[2]]}return["",t]}function dr(t){for(var e=0,n=0;n<t.length;n++)e|=t[n].flags;return e}var yr=new Object,vr=Bn(Pt),gr=Bn(Ct),mr=Bn(qe);function br(t,e,n,r){return n=_t(n),{index:-1,deps:rr(r,gt(e)),flags:t,token:e,value:n}}function _r(t,e,n){void 0===n&&(n=Pt.THROW_IF_NOT_FOUND);var r,o,i=Tt(t);try{if(8&e.flags)return e.token;if(2&e.flags&&(n=null),1&e.flags)return t._parent.get(e.token,n);var a=e.tokenKey;switch(a){case vr:case gr:case mr:return t}var s,u=t._def.providersByKey[a];if(u){var c=t._providers[u.index];return void 0===c&&(c=t._providers[u.index]=wr(t,u)),c===yr?void 0:c}if((s=yt(e.token))&&(r=t,null!=(o=s).providedIn&&(function(t,e){return t._def.modules.indexOf(o.providedIn)>-1}(r)||"root"===o.providedIn&&r._def.isRoot))){var l=t._providers.length;return t._def.providers[l]=t._def.providersByKey[e.tokenKey]={flags:5120,value:s.factory,deps:[],index:l,token:e.token},t._providers[l]=yr,t._providers[l]=wr(t,t._def.providersByKey[e.tokenKey])}return 4&e.flags?n:t._parent.get(e.token,n)}finally{Tt(i)}}function wr(t,e){var n;switch(201347067&e.flags){case 512:n=function(t,e,n){var r=n.length;switch(r){case 0:return new e;case 1:return new e(_r(t,n[0]));case 2:return new e(_r(t,n[0]),_r(t,n[1]));case 3:return new e(_r(t,n[0]),_r(t,n[1]),_r(t,n[2]));default:for(var o=new Array(r),i=0;i<r;i++)o[i]=_r(t,n[i]);return new(e.bind.apply(e,p([void 0],o)))}}(t,e.value,e.deps);break;case 1024:n=function(t,e,n){var r=n.length;switch(r){case 0:return e();case 1:return e(_r(t,n[0]));case 2:return e(_r(t,n[0]),_r(t,n[1]));case 3:return e(_r(t,n[0]),_r(t,n[1]),_r(t,n[2]));default:for(var o=Array(r),i=0;i<r;i++)o[i]=_r(t,n[i]);return e.apply(void 0,p(o))}}(t,e.value,e.deps);break;case 2048:n=_r(t,e.deps[0]);break;case 256:n=e.value}return n===yr||null===n||"object"!=typeof n||131072&e.flags||"function"!=typeof n.ngOnDestroy||(e.flags|=131072),void 0===n?yr:n}function Er(t,e){var n=t.viewContainer._embeddedViews;if((null==e||e>=n.length)&&(e=n.length-1),e<0)return null;var r=n[e];return r.viewContainerParent=null,kr(n,e),jn.dirtyParentQueries(r),xr(r),r}function Sr(t,e,n){var r=e?Jn(e,e.def.lastRenderRootNode):t.renderElement,o=n.renderer.parentNode(r),i=n.renderer.nextSibling(r);sr(n,2,o,i,void 0)}function xr(t){sr(t,3,null,null,void 0)}function Tr(t,e,n){e>=t.length?t.push(n):t.splice(e,0,n)}function kr(t,e){e>=t.length-1?t.pop():t.splice(e,1)}var Ar=new Object;function Ir(t,e,n,r,o,i){return new Cr(t,e,n,r,o,i)}var Cr=function(t){function e(e,n,r,o,i,a){var s=t.call(this)||this;return s.selector=e,s.componentType=n,s._inputs=o,s._outputs=i,s.ngContentSelectors=a,s.viewDefFactory=r,s}return o(e,t),Object.defineProperty(e.prototype,"inputs",{get:function(){var t=[],e=this._inputs;for(var n in e)t.push({propName:n,templateName:e[n]});return t},enumerable:!0,configurable:!0}),Object.defineProperty(e.prototype,"outputs",{get:function(){var t=[];for(var e in this._outputs)t.push({propName:e,templateName:this._outputs[e]});return t},enumerable:!0,configurable:!0}),e.prototype.create=function(t,e,n,r){if(!r)throw new Error("ngModule should be provided");var o=ar(this.viewDefFactory),i=o.nodes[0].element.componentProvider.nodeIndex,a=jn.createRootView(t,e||[],n,o,r,Ar),s=Dn(a,i).instance;return n&&a.renderer.setAttribute(Rn(a,0).renderElement,"ng-version",rn.full),new Or(a,new Nr(a),s)},e}(Be),Or=function(t){function e(e,n,r){var o=t.call(this)||this;return o._view=e,o._viewRef=n,o._component=r,o._elDef=o._view.def.nodes[0],o.hostView=n,o.changeDetectorRef=n,o.instance=r,o}return o(e,t),Object.defineProperty(e.prototype,"location",{get:function(){return new Qe(Rn(this._view,this._elDef.nodeIndex).renderElement)},enumerable:!0,configurable:!0}),Object.defineProperty(e.prototype,"injector",{get:function(){return new Lr(this._view,this._elDef)},enumerable:!0,configurable:!0}),Object.defineProperty(e.prototype,"componentType",{get:function(){return this._component.constructor},enumerable:!0,configurable:!0}),e.prototype.destroy=function(){this._viewRef.destroy()},e.prototype.onDestroy=function(t){this._viewRef.onDestroy(t)},e}(Le);function Pr(t,e,n){return new Rr(t,e,n)}var Rr=function(){function t(t,e,n){this._view=t,this._elDef=e,this._data=n,this._embeddedViews=[]}return Object.defineProperty(t.prototype,"element",{get:function(){return new Qe(this._data.renderElement)},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"injector",{get:function(){return new Lr(this._view,this._elDef)},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"parentInjector",{get:function(){for(var t=this._view,e=this._elDef.parent;!e&&t;)e=Xn(t),t=t.parent;return t?new Lr(t,e):new Lr(this._view,null)},enumerable:!0,configurable:!0}),t.prototype.clear=function(){for(var t=this._embeddedViews.length-1;t>=0;t--){var e=Er(this._data,t);jn.destroyView(e)}},t.prototype.get=function(t){var e=this._embeddedViews[t];if(e){var n=new Nr(e);return n.attachToViewContainerRef(this),n}return null},Object.defineProperty(t.prototype,"length",{get:function(){return this._embeddedViews.length},enumerable:!0,configurable:!0}),t.prototype.createEmbeddedView=function(t,e,n){var r=t.createEmbeddedView(e||{});return this.insert(r,n),r},t.prototype.createComponent=function(t,e,n,r,o){var i=n||this.parentInjector;o||t instanceof Ze||(o=i.get(qe));var a=t.create(i,r,void 0,o);return this.insert(a.hostView,e),a},t.prototype.insert=function(t,e){if(t.destroyed)throw new Error("Cannot insert a destroyed View in a ViewContainer!");var n,r,o,i,a=t;return i=(n=this._data).viewContainer._embeddedViews,null==(r=e)&&(r=i.length),(o=a._view).viewContainerParent=this._view,Tr(i,r,o),function(t,e){var n=Yn(e);if(n&&n!==t&&!(16&e.state)){e.state|=16;var r=n.template._projectedViews;r||(r=n.template._projectedViews=[]),r.push(e),function(t,n){if(!(4&n.flags)){e.parent.def.nodeFlags|=4,n.flags|=4;for(var r=n.parent;r;)r.childFlags|=4,r=r.parent}}(0,e.parentNodeDef)}}(n,o),jn.dirtyParentQueries(o),Sr(n,r>0?i[r-1]:null,o),a.attachToViewContainerRef(this),t},t.prototype.move=function(t,e){if(t.destroyed)throw new Error("Cannot move a destroyed View in a ViewContainer!");var n,r,o,i,a,s=this._embeddedViews.indexOf(t._view);return o=e,a=(i=(n=this._data).viewContainer._embeddedViews)[r=s],kr(i,r),null==o&&(o=i.length),Tr(i,o,a),jn.dirtyParentQueries(a),xr(a),Sr(n,o>0?i[o-1]:null,a),t},t.prototype.indexOf=function(t){return this._embeddedViews.indexOf(t._view)},t.prototype.remove=function(t){var e=Er(this._data,t);e&&jn.destroyView(e)},t.prototype.detach=function(t){var e=Er(this._data,t);return e?new Nr(e):null},t}();function Dr(t){return new Nr(t)}var Nr=function(){function t(t){this._view=t,this._viewContainerRef=null,this._appRef=null}return Object.defineProperty(t.prototype,"rootNodes",{get:function(){return sr(this._view,0,void 0,void 0,t=[]),t;var t},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"context",{get:function(){return this._view.context},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"destroyed",{get:function(){return 0!=(128&this._view.state)},enumerable:!0,configurable:!0}),t.prototype.markForCheck=function(){Gn(this._view)},t.prototype.detach=function(){this._view.state&=-5},t.prototype.detectChanges=function(){var t=this._view.root.rendererFactory;t.begin&&t.begin();try{jn.checkAndUpdateView(this._view)}finally{t.end&&t.end()}},t.prototype.checkNoChanges=function(){jn.checkNoChangesView(this._view)},t.prototype.reattach=function(){this._view.state|=4},t.prototype.onDestroy=function(t){this._view.disposables||(this._view.disposables=[]),this._view.disposables.push(t)},t.prototype.destroy=function(){this._appRef?this._appRef.detachView(this):this._viewContainerRef&&this._viewContainerRef.detach(this._viewContainerRef.indexOf(this)),jn.destroyView(this._view)},t.prototype.detachFromAppRef=function(){this._appRef=null,xr(this._view),jn.dirtyParentQueries(this._view)},t.prototype.attachToAppRef=function(t){if(this._viewContainerRef)throw new Error("This view is already attached to a ViewContainer!");this._appRef=t},t.prototype.attachToViewContainerRef=function(t){if(this._appRef)throw new Error("This view is already attached directly to the ApplicationRef!");this._viewContainerRef=t},t}();function Mr(t,e){return new jr(t,e)}var jr=function(t){function e(e,n){var r=t.call(this)||this;return r._parentView=e,r._def=n,r}return o(e,t),e.prototype.createEmbeddedView=function(t){return new Nr(jn.createEmbeddedView(this._parentView,this._def,this._def.element.template,t))},Object.defineProperty(e.prototype,"elementRef",{get:function(){return new Qe(Rn(this._parentView,this._def.nodeIndex).renderElement)},enumerable:!0,configurable:!0}),e}(En);function Fr(t,e){return new Lr(t,e)}var Lr=function(){function t(t,e){this.view=t,this.elDef=e}return t.prototype.get=function(t,e){return void 0===e&&(e=Pt.THROW_IF_NOT_FOUND),jn.resolveDep(this.view,this.elDef,!!this.elDef&&0!=(33554432&this.elDef.flags),{flags:0,token:t,tokenKey:Bn(t)},e)},t}();function Br(t){return new Vr(t.renderer)}var Vr=function(){function t(t){this.delegate=t}return t.prototype.selectRootElement=function(t){return this.delegate.selectRootElement(t)},t.prototype.createElement=function(t,e){var n=f(hr(e),2),r=this.delegate.createElement(n[1],n[0]);return t&&this.delegate.appendChild(t,r),r},t.prototype.createViewRoot=function(t){return t},t.prototype.createTemplateAnchor=function(t){var e=this.delegate.createComment("");return t&&this.delegate.appendChild(t,e),e},t.prototype.createText=function(t,e){var n=this.delegate.createText(e);return t&&this.delegate.appendChild(t,n),n},t.prototype.projectNodes=function(t,e){for(var n=0;n<e.length;n++)this.delegate.appendChild(t,e[n])},t.prototype.attachViewAfter=function(t,e){for(var n=this.delegate.parentNode(t),r=this.delegate.nextSibling(t),o=0;o<e.length;o++)this.delegate.insertBefore(n,e[o],r)},t.prototype.detachView=function(t){for(var e=0;e<t.length;e++){var n=t[e],r=this.delegate.parentNode(n);this.delegate.removeChild(r,n)}},t.prototype.destroyView=function(t,e){for(var n=0;n<e.length;n++)this.delegate.destroyNode(e[n])},t.prototype.listen=function(t,e,n){return this.delegate.listen(t,e,n)},t.prototype.listenGlobal=function(t,e,n){return this.delegate.listen(t,e,n)},t.prototype.setElementProperty=function(t,e,n){this.delegate.setProperty(t,e,n)},t.prototype.setElementAttribute=function(t,e,n){var r=f(hr(e),2),o=r[0],i=r[1];null!=n?this.delegate.setAttribute(t,i,n,o):this.delegate.removeAttribute(t,i,o)},t.prototype.setBindingDebugInfo=function(t,e,n){},t.prototype.setElementClass=function(t,e,n){n?this.delegate.addClass(t,e):this.delegate.removeClass(t,e)},t.prototype.setElementStyle=function(t,e,n){null!=n?this.delegate.setStyle(t,e,n):this.delegate.removeStyle(t,e)},t.prototype.invokeElementMethod=function(t,e,n){t[e].apply(t,n)},t.prototype.setText=function(t,e){this.delegate.setValue(t,e)},t.prototype.animate=function(){throw new Error("Renderer.animate is no longer supported!")},t}();function Hr(t,e,n,r){return new zr(t,e,n,r)}var zr=function(){function t(t,e,n,r){this._moduleType=t,this._parent=e,this._bootstrapComponents=n,this._def=r,this._destroyListeners=[],this._destroyed=!1,this.injector=this,function(t){for(var e=t._def,n=t._providers=new Array(e.providers.length),r=0;r<e.providers.length;r++){var o=e.providers[r];4096&o.flags||void 0===n[r]&&(n[r]=wr(t,o))}}(this)}return t.prototype.get=function(t,e,n){void 0===e&&(e=Pt.THROW_IF_NOT_FOUND),void 0===n&&(n=pt.Default);var r=0;return n&pt.SkipSelf?r|=1:n&pt.Self&&(r|=4),_r(this,{token:t,tokenKey:Bn(t),flags:r},e)},Object.defineProperty(t.prototype,"instance",{get:function(){return this.get(this._moduleType)},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"componentFactoryResolver",{get:function(){return this.get(Ue)},enumerable:!0,configurable:!0}),t.prototype.destroy=function(){if(this._destroyed)throw new Error("The ng module "+gt(this.instance.constructor)+" has already been destroyed.");this._destroyed=!0,function(t,e){for(var n=t._def,r=new Set,o=0;o<n.providers.length;o++)if(131072&n.providers[o].flags){var i=t._providers[o];if(i&&i!==yr){var a=i.ngOnDestroy;"function"!=typeof a||r.has(i)||(a.apply(i),r.add(i))}}}(this),this._destroyListeners.forEach(function(t){return t()})},t.prototype.onDestroy=function(t){this._destroyListeners.push(t)},t}(),Ur=Bn(Xe),Wr=Bn(tn),Zr=Bn(Qe),qr=Bn(xn),Gr=Bn(En),Kr=Bn(gn),Qr=Bn(Pt),Yr=Bn(Ct);function Xr(t,e,n,r,o,i,a,s){var u=[];if(a)for(var c in a){var l=f(a[c],2);u[l[0]]={flags:8,name:c,nonMinifiedName:l[1],ns:null,securityContext:null,suffix:null}}var p=[];if(s)for(var h in s)p.push({type:1,propName:h,target:null,eventName:s[h]});return function(t,e,n,r,o,i,a,s,u){var c=nr(n),l=c.matchedQueries,f=c.references,p=c.matchedQueryIds;u||(u=[]),s||(s=[]),i=_t(i);var h=rr(a,gt(o));return{nodeIndex:-1,parent:null,renderParent:null,bindingIndex:-1,outputIndex:-1,checkIndex:t,flags:e,childFlags:0,directChildFlags:0,childMatchedQueries:0,matchedQueries:l,matchedQueryIds:p,references:f,ngContentIndex:-1,childCount:r,bindings:s,bindingFlags:dr(s),outputs:u,element:null,provider:{token:o,value:i,deps:h},text:null,query:null,ngContent:null}}(t,e|=16384,n,r,o,o,i,u,p)}function Jr(t,e){return no(t,e)}function $r(t,e){for(var n=t;n.parent&&!$n(n);)n=n.parent;return ro(n.parent,Xn(n),!0,e.provider.value,e.provider.deps)}function to(t,e){var n,r=ro(t,e.parent,(32768&e.flags)>0,e.provider.value,e.provider.deps);if(e.outputs.length)for(var o=0;o<e.outputs.length;o++){var i=e.outputs[o],a=r[i.propName];if(!(n=a)||"function"!=typeof n.subscribe)throw new Error("@Output "+i.propName+" not initialized in '"+r.constructor.name+"'.");var s=a.subscribe(eo(t,e.parent.nodeIndex,i.eventName));t.disposables[e.outputIndex+o]=s.unsubscribe.bind(s)}return r}function eo(t,e,n){return function(r){return Qn(t,e,n,r)}}function no(t,e){var n=(8192&e.flags)>0,r=e.provider;switch(201347067&e.flags){case 512:return ro(t,e.parent,n,r.value,r.deps);case 1024:return function(t,e,n,r,o){var i=o.length;switch(i){case 0:return r();case 1:return r(io(t,e,n,o[0]));case 2:return r(io(t,e,n,o[0]),io(t,e,n,o[1]));case 3:return r(io(t,e,n,o[0]),io(t,e,n,o[1]),io(t,e,n,o[2]));default:for(var a=Array(i),s=0;s<i;s++)a[s]=io(t,e,n,o[s]);return r.apply(void 0,p(a))}}(t,e.parent,n,r.value,r.deps);case 2048:return io(t,e.parent,n,r.deps[0]);case 256:return r.value}}function ro(t,e,n,r,o){var i=o.length;switch(i){case 0:return new r;case 1:return new r(io(t,e,n,o[0]));case 2:return new r(io(t,e,n,o[0]),io(t,e,n,o[1]));case 3:return new r(io(t,e,n,o[0]),io(t,e,n,o[1]),io(t,e,n,o[2]));default:for(var a=new Array(i),s=0;s<i;s++)a[s]=io(t,e,n,o[s]);return new(r.bind.apply(r,p([void 0],a)))}}var oo={};function io(t,e,n,r,o){if(void 0===o&&(o=Pt.THROW_IF_NOT_FOUND),8&r.flags)return r.token;var i=t;2&r.flags&&(o=null);var a=r.tokenKey;a===Kr&&(n=!(!e||!e.element.componentView)),e&&1&r.flags&&(n=!1,e=e.parent);for(var s=t;s;){if(e)switch(a){case Ur:return Br(ao(s,e,n));case Wr:return ao(s,e,n).renderer;case Zr:return new Qe(Rn(s,e.nodeIndex).renderElement);case qr:return Rn(s,e.nodeIndex).viewContainer;case Gr:if(e.element.template)return Rn(s,e.nodeIndex).template;break;case Kr:return Dr(ao(s,e,n));case Qr:case Yr:return Fr(s,e);default:var u=(n?e.element.allProviders:e.element.publicProviders)[a];if(u){var c=Dn(s,u.nodeIndex);return c||(c={instance:no(s,u)},s.nodes[u.nodeIndex]=c),c.instance}}n=$n(s),e=Xn(s),s=s.parent,4&r.flags&&(s=null)}var l=i.root.injector.get(r.token,oo);return l!==oo||o===oo?l:i.root.ngModule.injector.get(r.token,o)}function ao(t,e,n){var r;if(n)r=Rn(t,e.nodeIndex).componentView;else for(r=t;r.parent&&!$n(r);)r=r.parent;return r}function so(t,e,n,r,o,i){if(32768&n.flags){var a=Rn(t,n.parent.nodeIndex).componentView;2&a.def.flags&&(a.state|=8)}if(e.instance[n.bindings[r].name]=o,524288&n.flags){i=i||{};var s=De.unwrap(t.oldValues[n.bindingIndex+r]);i[n.bindings[r].nonMinifiedName]=new je(s,o,0!=(2&t.state))}return t.oldValues[n.bindingIndex+r]=o,i}function uo(t,e){if(t.def.nodeFlags&e)for(var n=t.def.nodes,r=0,o=0;o<n.length;o++){var i=n[o],a=i.parent;for(!a&&i.flags&e&&lo(t,o,i.flags&e,r++),0==(i.childFlags&e)&&(o+=i.childCount);a&&1&a.flags&&o===a.nodeIndex+a.childCount;)a.directChildFlags&e&&(r=co(t,a,e,r)),a=a.parent}}function co(t,e,n,r){for(var o=e.nodeIndex+1;o<=e.nodeIndex+e.childCount;o++){var i=t.def.nodes[o];i.flags&n&&lo(t,o,i.flags&n,r++),o+=i.childCount}return r}function lo(t,e,n,r){var o=Dn(t,e);if(o){var i=o.instance;i&&(jn.setCurrentNode(t,e),1048576&n&&On(t,512,r)&&i.ngAfterContentInit(),2097152&n&&i.ngAfterContentChecked(),4194304&n&&On(t,768,r)&&i.ngAfterViewInit(),8388608&n&&i.ngAfterViewChecked(),131072&n&&i.ngOnDestroy())}}var fo=new kt("SCHEDULER_TOKEN",{providedIn:"root",factory:function(){return Gt}}),po=function(t){function e(e){void 0===e&&(e=!1);var n=t.call(this)||this;return n.__isAsync=e,n}return o(e,t),e.prototype.emit=function(e){t.prototype.next.call(this,e)},e.prototype.subscribe=function(e,n,r){var o,i=function(t){return null},a=function(){return null};e&&"object"==typeof e?(o=this.__isAsync?function(t){setTimeout(function(){return e.next(t)})}:function(t){e.next(t)},e.error&&(i=this.__isAsync?function(t){setTimeout(function(){return e.error(t)})}:function(t){e.error(t)}),e.complete&&(a=this.__isAsync?function(){setTimeout(function(){return e.complete()})}:function(){e.complete()})):(o=this.__isAsync?function(t){setTimeout(function(){return e(t)})}:function(t){e(t)},n&&(i=this.__isAsync?function(t){setTimeout(function(){return n(t)})}:function(t){n(t)}),r&&(a=this.__isAsync?function(){setTimeout(function(){return r()})}:function(){r()}));var s=t.prototype.subscribe.call(this,o,i,a);return e instanceof m&&e.add(s),s},e}(N),ho=function(){function t(){this.dirty=!0,this._results=[],this.changes=new po,this.length=0}return t.prototype.map=function(t){return this._results.map(t)},t.prototype.filter=function(t){return this._results.filter(t)},t.prototype.find=function(t){return this._results.find(t)},t.prototype.reduce=function(t,e){return this._results.reduce(t,e)},t.prototype.forEach=function(t){this._results.forEach(t)},t.prototype.some=function(t){return this._results.some(t)},t.prototype.toArray=function(){return this._results.slice()},t.prototype[Oe()]=function(){return this._results[Oe()]()},t.prototype.toString=function(){return this._results.toString()},t.prototype.reset=function(t){this._results=function t(e,n){void 0===n&&(n=e);for(var r=0;r<e.length;r++){var o=e[r];Array.isArray(o)?(n===e&&(n=e.slice(0,r)),t(o,n)):n!==e&&n.push(o)}return n}(t),this.dirty=!1,this.length=this._results.length,this.last=this._results[this.length-1],this.first=this._results[0]},t.prototype.notifyOnChanges=function(){this.changes.emit(this)},t.prototype.setDirty=function(){this.dirty=!0},t.prototype.destroy=function(){this.changes.complete(),this.changes.unsubscribe()},t}(),yo=new kt("Application Initializer"),vo=function(){function t(t){var e=this;this.appInits=t,this.initialized=!1,this.done=!1,this.donePromise=new Promise(function(t,n){e.resolve=t,e.reject=n})}return t.prototype.runInitializers=function(){var t=this;if(!this.initialized){var e=[],n=function(){t.done=!0,t.resolve()};if(this.appInits)for(var r=0;r<this.appInits.length;r++){var o=this.appInits[r]();Ie(o)&&e.push(o)}Promise.all(e).then(function(){n()}).catch(function(e){t.reject(e)}),0===e.length&&n(),this.initialized=!0}},t}(),go=new kt("AppId");function mo(){return""+bo()+bo()+bo()}function bo(){return String.fromCharCode(97+Math.floor(25*Math.random()))}var _o=new kt("Platform Initializer"),wo=new kt("Platform ID"),Eo=new kt("appBootstrapListener"),So=function(){function t(){}return t.prototype.log=function(t){console.log(t)},t.prototype.warn=function(t){console.warn(t)},t}();function xo(){throw new Error("Runtime compiler is not loaded")}var To,ko,Ao=xo,Io=xo,Co=xo,Oo=xo,Po=function(){function t(){this.compileModuleSync=Ao,this.compileModuleAsync=Io,this.compileModuleAndAllComponentsSync=Co,this.compileModuleAndAllComponentsAsync=Oo}return t.prototype.clearCache=function(){},t.prototype.clearCacheFor=function(t){},t.prototype.getModuleId=function(t){},t}(),Ro=function(){return function(){}}();function Do(){var t=St.wtf;return!(!t||!(To=t.trace)||(ko=To.events,0))}var No=Do();function Mo(t,e){return null}var jo=No?function(t,e){return void 0===e&&(e=null),ko.createScope(t,e)}:function(t,e){return Mo},Fo=No?function(t,e){return To.leaveScope(t,e),e}:function(t,e){return e},Lo=function(){return Promise.resolve(0)}();function Bo(t){"undefined"==typeof Zone?Lo.then(function(){t&&t.apply(null,null)}):Zone.current.scheduleMicroTask("scheduleMicrotask",t)}var Vo=function(){function t(t){var e,n=t.enableLongStackTrace,r=void 0!==n&&n;if(this.hasPendingMicrotasks=!1,this.hasPendingMacrotasks=!1,this.isStable=!0,this.onUnstable=new po(!1),this.onMicrotaskEmpty=new po(!1),this.onStable=new po(!1),this.onError=new po(!1),"undefined"==typeof Zone)throw new Error("In this configuration Angular requires Zone.js");Zone.assertZonePatched(),this._nesting=0,this._outer=this._inner=Zone.current,Zone.wtfZoneSpec&&(this._inner=this._inner.fork(Zone.wtfZoneSpec)),Zone.TaskTrackingZoneSpec&&(this._inner=this._inner.fork(new Zone.TaskTrackingZoneSpec)),r&&Zone.longStackTraceZoneSpec&&(this._inner=this._inner.fork(Zone.longStackTraceZoneSpec)),(e=this)._inner=e._inner.fork({name:"angular",properties:{isAngularZone:!0},onInvokeTask:function(t,n,r,o,i,a){try{return Wo(e),t.invokeTask(r,o,i,a)}finally{Zo(e)}},onInvoke:function(t,n,r,o,i,a,s){try{return Wo(e),t.invoke(r,o,i,a,s)}finally{Zo(e)}},onHasTask:function(t,n,r,o){t.hasTask(r,o),n===r&&("microTask"==o.change?(e.hasPendingMicrotasks=o.microTask,Uo(e)):"macroTask"==o.change&&(e.hasPendingMacrotasks=o.macroTask))},onHandleError:function(t,n,r,o){return t.handleError(r,o),e.runOutsideAngular(function(){return e.onError.emit(o)}),!1}})}return t.isInAngularZone=function(){return!0===Zone.current.get("isAngularZone")},t.assertInAngularZone=function(){if(!t.isInAngularZone())throw new Error("Expected to be in Angular Zone, but it is not!")},t.assertNotInAngularZone=function(){if(t.isInAngularZone())throw new Error("Expected to not be in Angular Zone, but it is!")},t.prototype.run=function(t,e,n){return this._inner.run(t,e,n)},t.prototype.runTask=function(t,e,n,r){var o=this._inner,i=o.scheduleEventTask("NgZoneEvent: "+r,t,zo,Ho,Ho);try{return o.runTask(i,e,n)}finally{o.cancelTask(i)}},t.prototype.runGuarded=function(t,e,n){return this._inner.runGuarded(t,e,n)},t.prototype.runOutsideAngular=function(t){return this._outer.run(t)},t}();function Ho(){}var zo={};function Uo(t){if(0==t._nesting&&!t.hasPendingMicrotasks&&!t.isStable)try{t._nesting++,t.onMicrotaskEmpty.emit(null)}finally{if(t._nesting--,!t.hasPendingMicrotasks)try{t.runOutsideAngular(function(){return t.onStable.emit(null)})}finally{t.isStable=!0}}}function Wo(t){t._nesting++,t.isStable&&(t.isStable=!1,t.onUnstable.emit(null))}function Zo(t){t._nesting--,Uo(t)}var qo,Go=function(){function t(){this.hasPendingMicrotasks=!1,this.hasPendingMacrotasks=!1,this.isStable=!0,this.onUnstable=new po,this.onMicrotaskEmpty=new po,this.onStable=new po,this.onError=new po}return t.prototype.run=function(t){return t()},t.prototype.runGuarded=function(t){return t()},t.prototype.runOutsideAngular=function(t){return t()},t.prototype.runTask=function(t){return t()},t}(),Ko=function(){function t(t){var e=this;this._ngZone=t,this._pendingCount=0,this._isZoneStable=!0,this._didWork=!1,this._callbacks=[],this.taskTrackingZone=null,this._watchAngularEvents(),t.run(function(){e.taskTrackingZone="undefined"==typeof Zone?null:Zone.current.get("TaskTrackingZone")})}return t.prototype._watchAngularEvents=function(){var t=this;this._ngZone.onUnstable.subscribe({next:function(){t._didWork=!0,t._isZoneStable=!1}}),this._ngZone.runOutsideAngular(function(){t._ngZone.onStable.subscribe({next:function(){Vo.assertNotInAngularZone(),Bo(function(){t._isZoneStable=!0,t._runCallbacksIfReady()})}})})},t.prototype.increasePendingRequestCount=function(){return this._pendingCount+=1,this._didWork=!0,this._pendingCount},t.prototype.decreasePendingRequestCount=function(){if(this._pendingCount-=1,this._pendingCount<0)throw new Error("pending async requests below zero");return this._runCallbacksIfReady(),this._pendingCount},t.prototype.isStable=function(){return this._isZoneStable&&0===this._pendingCount&&!this._ngZone.hasPendingMacrotasks},t.prototype._runCallbacksIfReady=function(){var t=this;if(this.isStable())Bo(function(){for(;0!==t._callbacks.length;){var e=t._callbacks.pop();clearTimeout(e.timeoutId),e.doneCb(t._didWork)}t._didWork=!1});else{var e=this.getPendingTasks();this._callbacks=this._callbacks.filter(function(t){return!t.updateCb||!t.updateCb(e)||(clearTimeout(t.timeoutId),!1)}),this._didWork=!0}},t.prototype.getPendingTasks=function(){return this.taskTrackingZone?this.taskTrackingZone.macroTasks.map(function(t){return{source:t.source,creationLocation:t.creationLocation,data:t.data}}):[]},t.prototype.addCallback=function(t,e,n){var r=this,o=-1;e&&e>0&&(o=setTimeout(function(){r._callbacks=r._callbacks.filter(function(t){return t.timeoutId!==o}),t(r._didWork,r.getPendingTasks())},e)),this._callbacks.push({doneCb:t,timeoutId:o,updateCb:n})},t.prototype.whenStable=function(t,e,n){if(n&&!this.taskTrackingZone)throw new Error('Task tracking zone is required when passing an update callback to whenStable(). Is "zone.js/dist/task-tracking.js" loaded?');this.addCallback(t,e,n),this._runCallbacksIfReady()},t.prototype.getPendingRequestCount=function(){return this._pendingCount},t.prototype.findProviders=function(t,e,n){return[]},t}(),Qo=function(){function t(){this._applications=new Map,Yo.addToWindow(this)}return t.prototype.registerApplication=function(t,e){this._applications.set(t,e)},t.prototype.unregisterApplication=function(t){this._applications.delete(t)},t.prototype.unregisterAllApplications=function(){this._applications.clear()},t.prototype.getTestability=function(t){return this._applications.get(t)||null},t.prototype.getAllTestabilities=function(){return Array.from(this._applications.values())},t.prototype.getAllRootElements=function(){return Array.from(this._applications.keys())},t.prototype.findTestabilityInTree=function(t,e){return void 0===e&&(e=!0),Yo.findTestabilityInTree(this,t,e)},a([s("design:paramtypes",[])],t)}(),Yo=new(function(){function t(){}return t.prototype.addToWindow=function(t){},t.prototype.findTestabilityInTree=function(t,e,n){return null},t}()),Xo=new kt("AllowMultipleToken"),Jo=function(){return function(t,e){this.name=t,this.token=e}}();function $o(t,e,n){void 0===n&&(n=[]);var r="Platform: "+e,o=new kt(r);return function(e){void 0===e&&(e=[]);var i=ti();if(!i||i.injector.get(Xo,!1))if(t)t(n.concat(e).concat({provide:o,useValue:!0}));else{var a=n.concat(e).concat({provide:o,useValue:!0});!function(t){if(qo&&!qo.destroyed&&!qo.injector.get(Xo,!1))throw new Error("There can be only one platform. Destroy the previous one to create a new one.");qo=t.get(ei);var e=t.get(_o,null);e&&e.forEach(function(t){return t()})}(Pt.create({providers:a,name:r}))}return function(t){var e=ti();if(!e)throw new Error("No platform exists!");if(!e.injector.get(t,null))throw new Error("A platform with a different configuration has been created. Please destroy it first.");return e}(o)}}function ti(){return qo&&!qo.destroyed?qo:null}var ei=function(){function t(t){this._injector=t,this._modules=[],this._destroyListeners=[],this._destroyed=!1}return t.prototype.bootstrapModuleFactory=function(t,e){var n,r=this,o="noop"===(n=e?e.ngZone:void 0)?new Go:("zone.js"===n?void 0:n)||new Vo({enableLongStackTrace:te()}),i=[{provide:Vo,useValue:o}];return o.run(function(){var e=Pt.create({providers:i,parent:r.injector,name:t.moduleType.name}),n=t.create(e),a=n.injector.get(Xt,null);if(!a)throw new Error("No ErrorHandler. Is platform module (BrowserModule) included?");return n.onDestroy(function(){return oi(r._modules,n)}),o.runOutsideAngular(function(){return o.onError.subscribe({next:function(t){a.handleError(t)}})}),function(t,e,o){try{var i=((a=n.injector.get(vo)).runInitializers(),a.donePromise.then(function(){return r._moduleDoBootstrap(n),n}));return Ie(i)?i.catch(function(n){throw e.runOutsideAngular(function(){return t.handleError(n)}),n}):i}catch(s){throw e.runOutsideAngular(function(){return t.handleError(s)}),s}var a}(a,o)})},t.prototype.bootstrapModule=function(t,e){var n=this;void 0===e&&(e=[]);var r=ni({},e);return function(t,e,n){return t.get(Ro).createCompiler([e]).compileModuleAsync(n)}(this.injector,r,t).then(function(t){return n.bootstrapModuleFactory(t,r)})},t.prototype._moduleDoBootstrap=function(t){var e=t.injector.get(ri);if(t._bootstrapComponents.length>0)t._bootstrapComponents.forEach(function(t){return e.bootstrap(t)});else{if(!t.instance.ngDoBootstrap)throw new Error("The module "+gt(t.instance.constructor)+' was bootstrapped, but it does not declare "@NgModule.bootstrap" components nor a "ngDoBootstrap" method. Please define one of these.');t.instance.ngDoBootstrap(e)}this._modules.push(t)},t.prototype.onDestroy=function(t){this._destroyListeners.push(t)},Object.defineProperty(t.prototype,"injector",{get:function(){return this._injector},enumerable:!0,configurable:!0}),t.prototype.destroy=function(){if(this._destroyed)throw new Error("The platform has already been destroyed!");this._modules.slice().forEach(function(t){return t.destroy()}),this._destroyListeners.forEach(function(t){return t()}),this._destroyed=!0},Object.defineProperty(t.prototype,"destroyed",{get:function(){return this._destroyed},enumerable:!0,configurable:!0}),t}();function ni(t,e){return Array.isArray(e)?e.reduce(ni,t):i({},t,e)}var ri=function(){function t(t,e,n,r,o,i){var a=this;this._zone=t,this._console=e,this._injector=n,this._exceptionHandler=r,this._componentFactoryResolver=o,this._initStatus=i,this._bootstrapListeners=[],this._views=[],this._runningTick=!1,this._enforceNoNewChanges=!1,this._stable=!0,this.componentTypes=[],this.components=[],this._enforceNoNewChanges=te(),this._zone.onMicrotaskEmpty.subscribe({next:function(){a._zone.run(function(){a.tick()})}});var s=new I(function(t){a._stable=a._zone.isStable&&!a._zone.hasPendingMacrotasks&&!a._zone.hasPendingMicrotasks,a._zone.runOutsideAngular(function(){t.next(a._stable),t.complete()})}),u=new I(function(t){var e;a._zone.runOutsideAngular(function(){e=a._zone.onStable.subscribe(function(){Vo.assertNotInAngularZone(),Bo(function(){a._stable||a._zone.hasPendingMacrotasks||a._zone.hasPendingMicrotasks||(a._stable=!0,t.next(!0))})})});var n=a._zone.onUnstable.subscribe(function(){Vo.assertInAngularZone(),a._stable&&(a._stable=!1,a._zone.runOutsideAngular(function(){t.next(!1)}))});return function(){e.unsubscribe(),n.unsubscribe()}});this.isStable=function(){for(var t=[],e=0;e<arguments.length;e++)t[e]=arguments[e];var n,r=Number.POSITIVE_INFINITY,o=null,i=t[t.length-1];return(n=i)&&"function"==typeof n.schedule?(o=t.pop(),t.length>1&&"number"==typeof t[t.length-1]&&(r=t.pop())):"number"==typeof i&&(r=t.pop()),null===o&&1===t.length&&t[0]instanceof I?t[0]:function(t){return void 0===t&&(t=Number.POSITIVE_INFINITY),function t(e,n,r){return void 0===r&&(r=Number.POSITIVE_INFINITY),"function"==typeof n?function(o){return o.pipe(t(function(t,r){return(o=e(t,r),o instanceof I?o:new I(Z(o))).pipe(function(t,e){return function(e){return e.lift(new G(t,void 0))}}(function(e,o){return n(t,e,r,o)}));var o},r))}:("number"==typeof n&&(r=n),function(t){return t.lift(new Y(e,r))})}(J,t)}(r)(Q(t,o))}(s,u.pipe(function(t){return $()((e=it,function(t){var n;n="function"==typeof e?e:function(){return e};var r=Object.create(t,rt);return r.source=t,r.subjectFactory=n,r})(t));var e}))}var e;return e=t,t.prototype.bootstrap=function(t,e){var n,r=this;if(!this._initStatus.done)throw new Error("Cannot bootstrap as there are still asynchronous initializers running. Bootstrap components in the `ngDoBootstrap` method of the root module.");n=t instanceof Be?t:this._componentFactoryResolver.resolveComponentFactory(t),this.componentTypes.push(n.componentType);var o=n instanceof Ze?null:this._injector.get(qe),i=n.create(Pt.NULL,[],e||n.selector,o);i.onDestroy(function(){r._unloadComponent(i)});var a=i.injector.get(Ko,null);return a&&i.injector.get(Qo).registerApplication(i.location.nativeElement,a),this._loadComponent(i),te()&&this._console.log("Angular is running in the development mode. Call enableProdMode() to enable the production mode."),i},t.prototype.tick=function(){var t,n,r,o,i=this;if(this._runningTick)throw new Error("ApplicationRef.tick is called recursively");var a=e._tickScope();try{this._runningTick=!0;try{for(var s=l(this._views),u=s.next();!u.done;u=s.next())u.value.detectChanges()}catch(p){t={error:p}}finally{try{u&&!u.done&&(n=s.return)&&n.call(s)}finally{if(t)throw t.error}}if(this._enforceNoNewChanges)try{for(var c=l(this._views),f=c.next();!f.done;f=c.next())f.value.checkNoChanges()}catch(h){r={error:h}}finally{try{f&&!f.done&&(o=c.return)&&o.call(c)}finally{if(r)throw r.error}}}catch(d){this._zone.runOutsideAngular(function(){return i._exceptionHandler.handleError(d)})}finally{this._runningTick=!1,Fo(a)}},t.prototype.attachView=function(t){var e=t;this._views.push(e),e.attachToAppRef(this)},t.prototype.detachView=function(t){var e=t;oi(this._views,e),e.detachFromAppRef()},t.prototype._loadComponent=function(t){this.attachView(t.hostView),this.tick(),this.components.push(t),this._injector.get(Eo,[]).concat(this._bootstrapListeners).forEach(function(e){return e(t)})},t.prototype._unloadComponent=function(t){this.detachView(t.hostView),oi(this.components,t)},t.prototype.ngOnDestroy=function(){this._views.slice().forEach(function(t){return t.destroy()})},Object.defineProperty(t.prototype,"viewCount",{get:function(){return this._views.length},enumerable:!0,configurable:!0}),t._tickScope=jo("ApplicationRef#tick()"),t}();function oi(t,e){var n=t.indexOf(e);n>-1&&t.splice(n,1)}var ii=function(){return function(t,e){this.name=t,this.callback=e}}(),ai=function(){function t(t,e,n){this.listeners=[],this.parent=null,this._debugContext=n,this.nativeNode=t,e&&e instanceof si&&e.addChild(this)}return Object.defineProperty(t.prototype,"injector",{get:function(){return this._debugContext.injector},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"componentInstance",{get:function(){return this._debugContext.component},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"context",{get:function(){return this._debugContext.context},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"references",{get:function(){return this._debugContext.references},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"providerTokens",{get:function(){return this._debugContext.providerTokens},enumerable:!0,configurable:!0}),t}(),si=function(t){function e(e,n,r){var o=t.call(this,e,n,r)||this;return o.properties={},o.attributes={},o.classes={},o.styles={},o.childNodes=[],o.nativeElement=e,o}return o(e,t),e.prototype.addChild=function(t){t&&(this.childNodes.push(t),t.parent=this)},e.prototype.removeChild=function(t){var e=this.childNodes.indexOf(t);-1!==e&&(t.parent=null,this.childNodes.splice(e,1))},e.prototype.insertChildrenAfter=function(t,e){var n,r=this,o=this.childNodes.indexOf(t);-1!==o&&((n=this.childNodes).splice.apply(n,p([o+1,0],e)),e.forEach(function(e){e.parent&&e.parent.removeChild(e),t.parent=r}))},e.prototype.insertBefore=function(t,e){var n=this.childNodes.indexOf(t);-1===n?this.addChild(e):(e.parent&&e.parent.removeChild(e),e.parent=this,this.childNodes.splice(n,0,e))},e.prototype.query=function(t){return this.queryAll(t)[0]||null},e.prototype.queryAll=function(t){var e=[];return function t(e,n,r){e.childNodes.forEach(function(e){e instanceof si&&(n(e)&&r.push(e),t(e,n,r))})}(this,t,e),e},e.prototype.queryAllNodes=function(t){var e=[];return function t(e,n,r){e instanceof si&&e.childNodes.forEach(function(e){n(e)&&r.push(e),e instanceof si&&t(e,n,r)})}(this,t,e),e},Object.defineProperty(e.prototype,"children",{get:function(){return this.childNodes.filter(function(t){return t instanceof e})},enumerable:!0,configurable:!0}),e.prototype.triggerEventHandler=function(t,e){this.listeners.forEach(function(n){n.name==t&&n.callback(e)})},e}(ai),ui=new Map,ci=function(t){return ui.get(t)||null};function li(t){ui.set(t.nativeNode,t)}var fi=$o(null,"core",[{provide:wo,useValue:"unknown"},{provide:ei,deps:[Pt]},{provide:Qo,deps:[]},{provide:So,deps:[]}]),pi=new kt("LocaleId");function hi(){return _n}function di(){return wn}function yi(t){return t||"en-US"}function vi(t){var e=[];return t.onStable.subscribe(function(){for(;e.length;)e.pop()()}),function(t){e.push(t)}}var gi=function(){return function(t){}}();function mi(t,e,n,r,o,i){t|=1;var a=nr(e);return{nodeIndex:-1,parent:null,renderParent:null,bindingIndex:-1,outputIndex:-1,flags:t,checkIndex:-1,childFlags:0,directChildFlags:0,childMatchedQueries:0,matchedQueries:a.matchedQueries,matchedQueryIds:a.matchedQueryIds,references:a.references,ngContentIndex:n,childCount:r,bindings:[],bindingFlags:0,outputs:[],element:{ns:null,name:null,attrs:null,template:i?ar(i):null,componentProvider:null,componentView:null,componentRendererType:null,publicProviders:null,allProviders:null,handleEvent:o||Fn},provider:null,text:null,query:null,ngContent:null}}function bi(t,e,n,r,o,i,a,s,u,c,l,p){var h;void 0===a&&(a=[]),c||(c=Fn);var d=nr(n),y=d.matchedQueries,v=d.references,g=d.matchedQueryIds,m=null,b=null;i&&(m=(h=f(hr(i),2))[0],b=h[1]),s=s||[];for(var _=new Array(s.length),w=0;w<s.length;w++){var E=f(s[w],3),S=E[0],x=E[2],T=f(hr(E[1]),2),k=T[0],A=T[1],I=void 0,C=void 0;switch(15&S){case 4:C=x;break;case 1:case 8:I=x}_[w]={flags:S,ns:k,name:A,nonMinifiedName:A,securityContext:I,suffix:C}}u=u||[];var O=new Array(u.length);for(w=0;w<u.length;w++){var P=f(u[w],2);O[w]={type:0,target:P[0],eventName:P[1],propName:null}}var R=(a=a||[]).map(function(t){var e=f(t,2),n=e[1],r=f(hr(e[0]),2);return[r[0],r[1],n]});return p=function(t){if(t&&t.id===Vn){var e=null!=t.encapsulation&&t.encapsulation!==qt.None||t.styles.length||Object.keys(t.data).length;t.id=e?"c"+Un++:Hn}return t&&t.id===Hn&&(t=null),t||null}(p),l&&(e|=33554432),{nodeIndex:-1,parent:null,renderParent:null,bindingIndex:-1,outputIndex:-1,checkIndex:t,flags:e|=1,childFlags:0,directChildFlags:0,childMatchedQueries:0,matchedQueries:y,matchedQueryIds:g,references:v,ngContentIndex:r,childCount:o,bindings:_,bindingFlags:dr(_),outputs:O,element:{ns:m,name:b,attrs:R,template:null,componentProvider:null,componentView:l||null,componentRendererType:p,publicProviders:null,allProviders:null,handleEvent:c||Fn},provider:null,text:null,query:null,ngContent:null}}function _i(t,e,n){var r,o=n.element,i=t.root.selectorOrNode,a=t.renderer;if(t.parent||!i){r=o.name?a.createElement(o.name,o.ns):a.createComment("");var s=or(t,e,n);s&&a.appendChild(s,r)}else r=a.selectRootElement(i,!!o.componentRendererType&&o.componentRendererType.encapsulation===qt.ShadowDom);if(o.attrs)for(var u=0;u<o.attrs.length;u++){var c=f(o.attrs[u],3);a.setAttribute(r,c[1],c[2],c[0])}return r}function wi(t,e,n,r){for(var o=0;o<n.outputs.length;o++){var i=n.outputs[o],a=Ei(t,n.nodeIndex,(f=i.eventName,(l=i.target)?l+":"+f:f)),s=i.target,u=t;"component"===i.target&&(s=null,u=e);var c=u.renderer.listen(s||r,i.eventName,a);t.disposables[n.outputIndex+o]=c}var l,f}function Ei(t,e,n){return function(r){return Qn(t,e,n,r)}}function Si(t,e,n,r){if(!Zn(t,e,n,r))return!1;var o=e.bindings[n],i=Rn(t,e.nodeIndex),a=i.renderElement,s=o.name;switch(15&o.flags){case 1:!function(t,e,n,r,o,i){var a=e.securityContext,s=a?t.root.sanitizer.sanitize(a,i):i;s=null!=s?s.toString():null;var u=t.renderer;null!=i?u.setAttribute(n,o,s,r):u.removeAttribute(n,o,r)}(t,o,a,o.ns,s,r);break;case 2:!function(t,e,n,r){var o=t.renderer;r?o.addClass(e,n):o.removeClass(e,n)}(t,a,s,r);break;case 4:!function(t,e,n,r,o){var i=t.root.sanitizer.sanitize(Ee.STYLE,o);if(null!=i){i=i.toString();var a=e.suffix;null!=a&&(i+=a)}else i=null;var s=t.renderer;null!=i?s.setStyle(n,r,i):s.removeStyle(n,r)}(t,o,a,s,r);break;case 8:!function(t,e,n,r,o){var i=e.securityContext,a=i?t.root.sanitizer.sanitize(i,o):o;t.renderer.setProperty(n,r,a)}(33554432&e.flags&&32&o.flags?i.componentView:t,o,a,s,r)}return!0}function xi(t,e,n){var r=[];for(var o in n)r.push({propName:o,bindingType:n[o]});return{nodeIndex:-1,parent:null,renderParent:null,bindingIndex:-1,outputIndex:-1,checkIndex:-1,flags:t,childFlags:0,directChildFlags:0,childMatchedQueries:0,ngContentIndex:-1,matchedQueries:{},matchedQueryIds:0,references:{},childCount:0,bindings:[],bindingFlags:0,outputs:[],element:null,provider:null,text:null,query:{id:e,filterId:er(e),bindings:r},ngContent:null}}function Ti(t){for(var e=t.def.nodeMatchedQueries;t.parent&&tr(t);){var n=t.parentNodeDef;t=t.parent;for(var r=n.nodeIndex+n.childCount,o=0;o<=r;o++)67108864&(i=t.def.nodes[o]).flags&&536870912&i.flags&&(i.query.filterId&e)===i.query.filterId&&Mn(t,o).setDirty(),!(1&i.flags&&o+i.childCount<n.nodeIndex)&&67108864&i.childFlags&&536870912&i.childFlags||(o+=i.childCount)}if(134217728&t.def.nodeFlags)for(o=0;o<t.def.nodes.length;o++){var i;134217728&(i=t.def.nodes[o]).flags&&536870912&i.flags&&Mn(t,o).setDirty(),o+=i.childCount}}function ki(t,e){var n=Mn(t,e.nodeIndex);if(n.dirty){var r,o=void 0;if(67108864&e.flags){var i=e.parent.parent;o=Ai(t,i.nodeIndex,i.nodeIndex+i.childCount,e.query,[]),r=Dn(t,e.parent.nodeIndex).instance}else 134217728&e.flags&&(o=Ai(t,0,t.def.nodes.length-1,e.query,[]),r=t.component);n.reset(o);for(var a=e.query.bindings,s=!1,u=0;u<a.length;u++){var c=a[u],l=void 0;switch(c.bindingType){case 0:l=n.first;break;case 1:l=n,s=!0}r[c.propName]=l}s&&n.notifyOnChanges()}}function Ai(t,e,n,r,o){for(var i=e;i<=n;i++){var a=t.def.nodes[i],s=a.matchedQueries[r.id];if(null!=s&&o.push(Ii(t,a,s)),1&a.flags&&a.element.template&&(a.element.template.nodeMatchedQueries&r.filterId)===r.filterId){var u=Rn(t,i);if((a.childMatchedQueries&r.filterId)===r.filterId&&(Ai(t,i+1,i+a.childCount,r,o),i+=a.childCount),16777216&a.flags)for(var c=u.viewContainer._embeddedViews,l=0;l<c.length;l++){var f=c[l],p=Yn(f);p&&p===u&&Ai(f,0,f.def.nodes.length-1,r,o)}var h=u.template._projectedViews;if(h)for(l=0;l<h.length;l++){var d=h[l];Ai(d,0,d.def.nodes.length-1,r,o)}}(a.childMatchedQueries&r.filterId)!==r.filterId&&(i+=a.childCount)}return o}function Ii(t,e,n){if(null!=n)switch(n){case 1:return Rn(t,e.nodeIndex).renderElement;case 0:return new Qe(Rn(t,e.nodeIndex).renderElement);case 2:return Rn(t,e.nodeIndex).template;case 3:return Rn(t,e.nodeIndex).viewContainer;case 4:return Dn(t,e.nodeIndex).instance}}function Ci(t,e,n){var r=or(t,e,n);r&&cr(t,n.ngContent.index,1,r,null,void 0)}function Oi(t,e,n){for(var r=new Array(n.length-1),o=1;o<n.length;o++)r[o-1]={flags:8,name:null,ns:null,nonMinifiedName:null,securityContext:null,suffix:n[o]};return{nodeIndex:-1,parent:null,renderParent:null,bindingIndex:-1,outputIndex:-1,checkIndex:t,flags:2,childFlags:0,directChildFlags:0,childMatchedQueries:0,matchedQueries:{},matchedQueryIds:0,references:{},ngContentIndex:e,childCount:0,bindings:r,bindingFlags:8,outputs:[],element:null,provider:null,text:{prefix:n[0]},query:null,ngContent:null}}function Pi(t,e,n){var r,o=t.renderer;r=o.createText(n.text.prefix);var i=or(t,e,n);return i&&o.appendChild(i,r),{renderText:r}}function Ri(t,e){return(null!=t?t.toString():"")+e.suffix}function Di(t,e,n,r){for(var o=0,i=0,a=0,s=0,u=0,c=null,l=null,f=!1,p=!1,h=null,d=0;d<e.length;d++){var y=e[d];if(y.nodeIndex=d,y.parent=c,y.bindingIndex=o,y.outputIndex=i,y.renderParent=l,a|=y.flags,u|=y.matchedQueryIds,y.element){var v=y.element;v.publicProviders=c?c.element.publicProviders:Object.create(null),v.allProviders=v.publicProviders,f=!1,p=!1,y.element.template&&(u|=y.element.template.nodeMatchedQueries)}if(Mi(c,y,e.length),o+=y.bindings.length,i+=y.outputs.length,!l&&3&y.flags&&(h=y),20224&y.flags){f||(f=!0,c.element.publicProviders=Object.create(c.element.publicProviders),c.element.allProviders=c.element.publicProviders);var g=0!=(32768&y.flags);0==(8192&y.flags)||g?c.element.publicProviders[Bn(y.provider.token)]=y:(p||(p=!0,c.element.allProviders=Object.create(c.element.publicProviders)),c.element.allProviders[Bn(y.provider.token)]=y),g&&(c.element.componentProvider=y)}if(c?(c.childFlags|=y.flags,c.directChildFlags|=y.flags,c.childMatchedQueries|=y.matchedQueryIds,y.element&&y.element.template&&(c.childMatchedQueries|=y.element.template.nodeMatchedQueries)):s|=y.flags,y.childCount>0)c=y,Ni(y)||(l=y);else for(;c&&d===c.nodeIndex+c.childCount;){var m=c.parent;m&&(m.childFlags|=c.childFlags,m.childMatchedQueries|=c.childMatchedQueries),l=(c=m)&&Ni(c)?c.renderParent:c}}return{factory:null,nodeFlags:a,rootNodeFlags:s,nodeMatchedQueries:u,flags:t,nodes:e,updateDirectives:n||Fn,updateRenderer:r||Fn,handleEvent:function(t,n,r,o){return e[n].element.handleEvent(t,r,o)},bindingCount:o,outputCount:i,lastRenderRootNode:h}}function Ni(t){return 0!=(1&t.flags)&&null===t.element.name}function Mi(t,e,n){var r=e.element&&e.element.template;if(r){if(!r.lastRenderRootNode)throw new Error("Illegal State: Embedded templates without nodes are not allowed!");if(r.lastRenderRootNode&&16777216&r.lastRenderRootNode.flags)throw new Error("Illegal State: Last root node of a template can't have embedded views, at index "+e.nodeIndex+"!")}if(20224&e.flags&&0==(1&(t?t.flags:0)))throw new Error("Illegal State: StaticProvider/Directive nodes need to be children of elements or anchors, at index "+e.nodeIndex+"!");if(e.query){if(67108864&e.flags&&(!t||0==(16384&t.flags)))throw new Error("Illegal State: Content Query nodes need to be children of directives, at index "+e.nodeIndex+"!");if(134217728&e.flags&&t)throw new Error("Illegal State: View Query nodes have to be top level nodes, at index "+e.nodeIndex+"!")}if(e.childCount){var o=t?t.nodeIndex+t.childCount:n-1;if(e.nodeIndex<=o&&e.nodeIndex+e.childCount>o)throw new Error("Illegal State: childCount of node leads outside of parent, at index "+e.nodeIndex+"!")}}function ji(t,e,n,r){var o=Bi(t.root,t.renderer,t,e,n);return Vi(o,t.component,r),Hi(o),o}function Fi(t,e,n){var r=Bi(t,t.renderer,null,null,e);return Vi(r,n,n),Hi(r),r}function Li(t,e,n,r){var o,i=e.element.componentRendererType;return o=i?t.root.rendererFactory.createRenderer(r,i):t.root.renderer,Bi(t.root,o,t,e.element.componentProvider,n)}function Bi(t,e,n,r,o){var i=new Array(o.nodes.length),a=o.outputCount?new Array(o.outputCount):null;return{def:o,parent:n,viewContainerParent:null,parentNodeDef:r,context:null,component:null,nodes:i,state:13,root:t,renderer:e,oldValues:new Array(o.bindingCount),disposables:a,initIndex:-1}}function Vi(t,e,n){t.component=e,t.context=n}function Hi(t){var e;$n(t)&&(e=Rn(t.parent,t.parentNodeDef.parent.nodeIndex).renderElement);for(var n=t.def,r=t.nodes,o=0;o<n.nodes.length;o++){var i=n.nodes[o];jn.setCurrentNode(t,o);var a=void 0;switch(201347067&i.flags){case 1:var s=_i(t,e,i),u=void 0;if(33554432&i.flags){var c=ar(i.element.componentView);u=jn.createComponentView(t,i,c,s)}wi(t,u,i,s),a={renderElement:s,componentView:u,viewContainer:null,template:i.element.template?Mr(t,i):void 0},16777216&i.flags&&(a.viewContainer=Pr(t,i,a));break;case 2:a=Pi(t,e,i);break;case 512:case 1024:case 2048:case 256:(a=r[o])||4096&i.flags||(a={instance:Jr(t,i)});break;case 16:a={instance:$r(t,i)};break;case 16384:(a=r[o])||(a={instance:to(t,i)}),32768&i.flags&&Vi(Rn(t,i.parent.nodeIndex).componentView,a.instance,a.instance);break;case 32:case 64:case 128:a={value:void 0};break;case 67108864:case 134217728:a=new ho;break;case 8:Ci(t,e,i),a=void 0}r[o]=a}Yi(t,Qi.CreateViewNodes),ta(t,201326592,268435456,0)}function zi(t){Zi(t),jn.updateDirectives(t,1),Xi(t,Qi.CheckNoChanges),jn.updateRenderer(t,1),Yi(t,Qi.CheckNoChanges),t.state&=-97}function Ui(t){1&t.state?(t.state&=-2,t.state|=2):t.state&=-3,Cn(t,0,256),Zi(t),jn.updateDirectives(t,0),Xi(t,Qi.CheckAndUpdate),ta(t,67108864,536870912,0);var e=Cn(t,256,512);uo(t,2097152|(e?1048576:0)),jn.updateRenderer(t,0),Yi(t,Qi.CheckAndUpdate),ta(t,134217728,536870912,0),uo(t,8388608|((e=Cn(t,512,768))?4194304:0)),2&t.def.flags&&(t.state&=-9),t.state&=-97,Cn(t,768,1024)}function Wi(t,e,n,r,o,i,a,s,u,c,l,f,h){return 0===n?function(t,e,n,r,o,i,a,s,u,c,l,f){switch(201347067&e.flags){case 1:return function(t,e,n,r,o,i,a,s,u,c,l,f){var p=e.bindings.length,h=!1;return p>0&&Si(t,e,0,n)&&(h=!0),p>1&&Si(t,e,1,r)&&(h=!0),p>2&&Si(t,e,2,o)&&(h=!0),p>3&&Si(t,e,3,i)&&(h=!0),p>4&&Si(t,e,4,a)&&(h=!0),p>5&&Si(t,e,5,s)&&(h=!0),p>6&&Si(t,e,6,u)&&(h=!0),p>7&&Si(t,e,7,c)&&(h=!0),p>8&&Si(t,e,8,l)&&(h=!0),p>9&&Si(t,e,9,f)&&(h=!0),h}(t,e,n,r,o,i,a,s,u,c,l,f);case 2:return function(t,e,n,r,o,i,a,s,u,c,l,f){var p=!1,h=e.bindings,d=h.length;if(d>0&&Zn(t,e,0,n)&&(p=!0),d>1&&Zn(t,e,1,r)&&(p=!0),d>2&&Zn(t,e,2,o)&&(p=!0),d>3&&Zn(t,e,3,i)&&(p=!0),d>4&&Zn(t,e,4,a)&&(p=!0),d>5&&Zn(t,e,5,s)&&(p=!0),d>6&&Zn(t,e,6,u)&&(p=!0),d>7&&Zn(t,e,7,c)&&(p=!0),d>8&&Zn(t,e,8,l)&&(p=!0),d>9&&Zn(t,e,9,f)&&(p=!0),p){var y=e.text.prefix;d>0&&(y+=Ri(n,h[0])),d>1&&(y+=Ri(r,h[1])),d>2&&(y+=Ri(o,h[2])),d>3&&(y+=Ri(i,h[3])),d>4&&(y+=Ri(a,h[4])),d>5&&(y+=Ri(s,h[5])),d>6&&(y+=Ri(u,h[6])),d>7&&(y+=Ri(c,h[7])),d>8&&(y+=Ri(l,h[8])),d>9&&(y+=Ri(f,h[9]));var v=Pn(t,e.nodeIndex).renderText;t.renderer.setValue(v,y)}return p}(t,e,n,r,o,i,a,s,u,c,l,f);case 16384:return function(t,e,n,r,o,i,a,s,u,c,l,f){var p=Dn(t,e.nodeIndex),h=p.instance,d=!1,y=void 0,v=e.bindings.length;return v>0&&Wn(t,e,0,n)&&(d=!0,y=so(t,p,e,0,n,y)),v>1&&Wn(t,e,1,r)&&(d=!0,y=so(t,p,e,1,r,y)),v>2&&Wn(t,e,2,o)&&(d=!0,y=so(t,p,e,2,o,y)),v>3&&Wn(t,e,3,i)&&(d=!0,y=so(t,p,e,3,i,y)),v>4&&Wn(t,e,4,a)&&(d=!0,y=so(t,p,e,4,a,y)),v>5&&Wn(t,e,5,s)&&(d=!0,y=so(t,p,e,5,s,y)),v>6&&Wn(t,e,6,u)&&(d=!0,y=so(t,p,e,6,u,y)),v>7&&Wn(t,e,7,c)&&(d=!0,y=so(t,p,e,7,c,y)),v>8&&Wn(t,e,8,l)&&(d=!0,y=so(t,p,e,8,l,y)),v>9&&Wn(t,e,9,f)&&(d=!0,y=so(t,p,e,9,f,y)),y&&h.ngOnChanges(y),65536&e.flags&&On(t,256,e.nodeIndex)&&h.ngOnInit(),262144&e.flags&&h.ngDoCheck(),d}(t,e,n,r,o,i,a,s,u,c,l,f);case 32:case 64:case 128:return function(t,e,n,r,o,i,a,s,u,c,l,f){var p=e.bindings,h=!1,d=p.length;if(d>0&&Zn(t,e,0,n)&&(h=!0),d>1&&Zn(t,e,1,r)&&(h=!0),d>2&&Zn(t,e,2,o)&&(h=!0),d>3&&Zn(t,e,3,i)&&(h=!0),d>4&&Zn(t,e,4,a)&&(h=!0),d>5&&Zn(t,e,5,s)&&(h=!0),d>6&&Zn(t,e,6,u)&&(h=!0),d>7&&Zn(t,e,7,c)&&(h=!0),d>8&&Zn(t,e,8,l)&&(h=!0),d>9&&Zn(t,e,9,f)&&(h=!0),h){var y=Nn(t,e.nodeIndex),v=void 0;switch(201347067&e.flags){case 32:v=new Array(p.length),d>0&&(v[0]=n),d>1&&(v[1]=r),d>2&&(v[2]=o),d>3&&(v[3]=i),d>4&&(v[4]=a),d>5&&(v[5]=s),d>6&&(v[6]=u),d>7&&(v[7]=c),d>8&&(v[8]=l),d>9&&(v[9]=f);break;case 64:v={},d>0&&(v[p[0].name]=n),d>1&&(v[p[1].name]=r),d>2&&(v[p[2].name]=o),d>3&&(v[p[3].name]=i),d>4&&(v[p[4].name]=a),d>5&&(v[p[5].name]=s),d>6&&(v[p[6].name]=u),d>7&&(v[p[7].name]=c),d>8&&(v[p[8].name]=l),d>9&&(v[p[9].name]=f);break;case 128:var g=n;switch(d){case 1:v=g.transform(n);break;case 2:v=g.transform(r);break;case 3:v=g.transform(r,o);break;case 4:v=g.transform(r,o,i);break;case 5:v=g.transform(r,o,i,a);break;case 6:v=g.transform(r,o,i,a,s);break;case 7:v=g.transform(r,o,i,a,s,u);break;case 8:v=g.transform(r,o,i,a,s,u,c);break;case 9:v=g.transform(r,o,i,a,s,u,c,l);break;case 10:v=g.transform(r,o,i,a,s,u,c,l,f)}}y.value=v}return h}(t,e,n,r,o,i,a,s,u,c,l,f);default:throw"unreachable"}}(t,e,r,o,i,a,s,u,c,l,f,h):function(t,e,n){switch(201347067&e.flags){case 1:return function(t,e,n){for(var r=!1,o=0;o<n.length;o++)Si(t,e,o,n[o])&&(r=!0);return r}(t,e,n);case 2:return function(t,e,n){for(var r=e.bindings,o=!1,i=0;i<n.length;i++)Zn(t,e,i,n[i])&&(o=!0);if(o){var a="";for(i=0;i<n.length;i++)a+=Ri(n[i],r[i]);a=e.text.prefix+a;var s=Pn(t,e.nodeIndex).renderText;t.renderer.setValue(s,a)}return o}(t,e,n);case 16384:return function(t,e,n){for(var r=Dn(t,e.nodeIndex),o=r.instance,i=!1,a=void 0,s=0;s<n.length;s++)Wn(t,e,s,n[s])&&(i=!0,a=so(t,r,e,s,n[s],a));return a&&o.ngOnChanges(a),65536&e.flags&&On(t,256,e.nodeIndex)&&o.ngOnInit(),262144&e.flags&&o.ngDoCheck(),i}(t,e,n);case 32:case 64:case 128:return function(t,e,n){for(var r=e.bindings,o=!1,i=0;i<n.length;i++)Zn(t,e,i,n[i])&&(o=!0);if(o){var a=Nn(t,e.nodeIndex),s=void 0;switch(201347067&e.flags){case 32:s=n;break;case 64:for(s={},i=0;i<n.length;i++)s[r[i].name]=n[i];break;case 128:var u=n[0],c=n.slice(1);s=u.transform.apply(u,p(c))}a.value=s}return o}(t,e,n);default:throw"unreachable"}}(t,e,r)}function Zi(t){var e=t.def;if(4&e.nodeFlags)for(var n=0;n<e.nodes.length;n++){var r=e.nodes[n];if(4&r.flags){var o=Rn(t,n).template._projectedViews;if(o)for(var i=0;i<o.length;i++){var a=o[i];a.state|=32,Kn(a,t)}}else 0==(4&r.childFlags)&&(n+=r.childCount)}}function qi(t,e,n,r,o,i,a,s,u,c,l,f,p){return 0===n?function(t,e,n,r,o,i,a,s,u,c,l,f){var p=e.bindings.length;p>0&&qn(t,e,0,n),p>1&&qn(t,e,1,r),p>2&&qn(t,e,2,o),p>3&&qn(t,e,3,i),p>4&&qn(t,e,4,a),p>5&&qn(t,e,5,s),p>6&&qn(t,e,6,u),p>7&&qn(t,e,7,c),p>8&&qn(t,e,8,l),p>9&&qn(t,e,9,f)}(t,e,r,o,i,a,s,u,c,l,f,p):function(t,e,n){for(var r=0;r<n.length;r++)qn(t,e,r,n[r])}(t,e,r),!1}function Gi(t,e){if(Mn(t,e.nodeIndex).dirty)throw kn(jn.createDebugContext(t,e.nodeIndex),"Query "+e.query.id+" not dirty","Query "+e.query.id+" dirty",0!=(1&t.state))}function Ki(t){if(!(128&t.state)){if(Xi(t,Qi.Destroy),Yi(t,Qi.Destroy),uo(t,131072),t.disposables)for(var e=0;e<t.disposables.length;e++)t.disposables[e]();!function(t){if(16&t.state){var e=Yn(t);if(e){var n=e.template._projectedViews;n&&(kr(n,n.indexOf(t)),jn.dirtyParentQueries(t))}}}(t),t.renderer.destroyNode&&function(t){for(var e=t.def.nodes.length,n=0;n<e;n++){var r=t.def.nodes[n];1&r.flags?t.renderer.destroyNode(Rn(t,n).renderElement):2&r.flags?t.renderer.destroyNode(Pn(t,n).renderText):(67108864&r.flags||134217728&r.flags)&&Mn(t,n).destroy()}}(t),$n(t)&&t.renderer.destroy(),t.state|=128}}var Qi=function(t){return t[t.CreateViewNodes=0]="CreateViewNodes",t[t.CheckNoChanges=1]="CheckNoChanges",t[t.CheckNoChangesProjectedViews=2]="CheckNoChangesProjectedViews",t[t.CheckAndUpdate=3]="CheckAndUpdate",t[t.CheckAndUpdateProjectedViews=4]="CheckAndUpdateProjectedViews",t[t.Destroy=5]="Destroy",t}({});function Yi(t,e){var n=t.def;if(33554432&n.nodeFlags)for(var r=0;r<n.nodes.length;r++){var o=n.nodes[r];33554432&o.flags?Ji(Rn(t,r).componentView,e):0==(33554432&o.childFlags)&&(r+=o.childCount)}}function Xi(t,e){var n=t.def;if(16777216&n.nodeFlags)for(var r=0;r<n.nodes.length;r++){var o=n.nodes[r];if(16777216&o.flags)for(var i=Rn(t,r).viewContainer._embeddedViews,a=0;a<i.length;a++)Ji(i[a],e);else 0==(16777216&o.childFlags)&&(r+=o.childCount)}}function Ji(t,e){var n=t.state;switch(e){case Qi.CheckNoChanges:0==(128&n)&&(12==(12&n)?zi(t):64&n&&$i(t,Qi.CheckNoChangesProjectedViews));break;case Qi.CheckNoChangesProjectedViews:0==(128&n)&&(32&n?zi(t):64&n&&$i(t,e));break;case Qi.CheckAndUpdate:0==(128&n)&&(12==(12&n)?Ui(t):64&n&&$i(t,Qi.CheckAndUpdateProjectedViews));break;case Qi.CheckAndUpdateProjectedViews:0==(128&n)&&(32&n?Ui(t):64&n&&$i(t,e));break;case Qi.Destroy:Ki(t);break;case Qi.CreateViewNodes:Hi(t)}}function $i(t,e){Xi(t,e),Yi(t,e)}function ta(t,e,n,r){if(t.def.nodeFlags&e&&t.def.nodeFlags&n)for(var o=t.def.nodes.length,i=0;i<o;i++){var a=t.def.nodes[i];if(a.flags&e&&a.flags&n)switch(jn.setCurrentNode(t,a.nodeIndex),r){case 0:ki(t,a);break;case 1:Gi(t,a)}a.childFlags&e&&a.childFlags&n||(i+=a.childCount)}}var ea=!1;function na(t,e,n,r,o,i){var a=o.injector.get(Je);return Fi(oa(t,o,a,e,n),r,i)}function ra(t,e,n,r,o,i){var a=o.injector.get(Je),s=oa(t,o,new ja(a),e,n),u=da(r);return Na(Sa.create,Fi,null,[s,u,i])}function oa(t,e,n,r,o){var i=e.injector.get(Se),a=e.injector.get(Xt),s=n.createRenderer(null,null);return{ngModule:e,injector:t,projectableNodes:r,selectorOrNode:o,sanitizer:i,rendererFactory:n,renderer:s,errorHandler:a}}function ia(t,e,n,r){var o=da(n);return Na(Sa.create,ji,null,[t,e,o,r])}function aa(t,e,n,r){return n=la.get(e.element.componentProvider.provider.token)||da(n),Na(Sa.create,Li,null,[t,e,n,r])}function sa(t,e,n,r){return Hr(t,e,n,function(t){var e=function(t){var e=!1,n=!1;return 0===ua.size?{hasOverrides:e,hasDeprecatedOverrides:n}:(t.providers.forEach(function(t){var r=ua.get(t.token);3840&t.flags&&r&&(e=!0,n=n||r.deprecatedBehavior)}),t.modules.forEach(function(t){ca.forEach(function(r,o){yt(o).providedIn===t&&(e=!0,n=n||r.deprecatedBehavior)})}),{hasOverrides:e,hasDeprecatedOverrides:n})}(t),n=e.hasDeprecatedOverrides;return e.hasOverrides?(function(t){for(var e=0;e<t.providers.length;e++){var r=t.providers[e];n&&(r.flags|=4096);var o=ua.get(r.token);o&&(r.flags=-3841&r.flags|o.flags,r.deps=rr(o.deps),r.value=o.value)}if(ca.size>0){var i=new Set(t.modules);ca.forEach(function(e,r){if(i.has(yt(r).providedIn)){var o={token:r,flags:e.flags|(n?4096:0),deps:rr(e.deps),value:e.value,index:t.providers.length};t.providers.push(o),t.providersByKey[Bn(r)]=o}})}}(t=t.factory(function(){return Fn})),t):t}(r))}var ua=new Map,ca=new Map,la=new Map;function fa(t){var e;ua.set(t.token,t),"function"==typeof t.token&&(e=yt(t.token))&&"function"==typeof e.providedIn&&ca.set(t.token,t)}function pa(t,e){var n=ar(e.viewDefFactory),r=ar(n.nodes[0].element.componentView);la.set(t,r)}function ha(){ua.clear(),ca.clear(),la.clear()}function da(t){if(0===ua.size)return t;var e=function(t){for(var e=[],n=null,r=0;r<t.nodes.length;r++){var o=t.nodes[r];1&o.flags&&(n=o),n&&3840&o.flags&&ua.has(o.provider.token)&&(e.push(n.nodeIndex),n=null)}return e}(t);if(0===e.length)return t;t=t.factory(function(){return Fn});for(var n=0;n<e.length;n++)r(t,e[n]);return t;function r(t,e){for(var n=e+1;n<t.nodes.length;n++){var r=t.nodes[n];if(1&r.flags)return;if(3840&r.flags){var o=r.provider,i=ua.get(o.token);i&&(r.flags=-3841&r.flags|i.flags,o.deps=rr(i.deps),o.value=i.value)}}}}function ya(t,e,n,r,o,i,a,s,u,c,l,f,p){var h=t.def.nodes[e];return Wi(t,h,n,r,o,i,a,s,u,c,l,f,p),224&h.flags?Nn(t,e).value:void 0}function va(t,e,n,r,o,i,a,s,u,c,l,f,p){var h=t.def.nodes[e];return qi(t,h,n,r,o,i,a,s,u,c,l,f,p),224&h.flags?Nn(t,e).value:void 0}function ga(t){return Na(Sa.detectChanges,Ui,null,[t])}function ma(t){return Na(Sa.checkNoChanges,zi,null,[t])}function ba(t){return Na(Sa.destroy,Ki,null,[t])}var _a,wa,Ea,Sa=function(t){return t[t.create=0]="create",t[t.detectChanges=1]="detectChanges",t[t.checkNoChanges=2]="checkNoChanges",t[t.destroy=3]="destroy",t[t.handleEvent=4]="handleEvent",t}({});function xa(t,e){wa=t,Ea=e}function Ta(t,e,n,r){return xa(t,e),Na(Sa.handleEvent,t.def.handleEvent,null,[t,e,n,r])}function ka(t,e){if(128&t.state)throw In(Sa[_a]);return xa(t,Oa(t,0)),t.def.updateDirectives(function(t,n,r){for(var o=[],i=3;i<arguments.length;i++)o[i-3]=arguments[i];var a=t.def.nodes[n];return 0===e?Ia(t,a,r,o):Ca(t,a,r,o),16384&a.flags&&xa(t,Oa(t,n)),224&a.flags?Nn(t,a.nodeIndex).value:void 0},t)}function Aa(t,e){if(128&t.state)throw In(Sa[_a]);return xa(t,Pa(t,0)),t.def.updateRenderer(function(t,n,r){for(var o=[],i=3;i<arguments.length;i++)o[i-3]=arguments[i];var a=t.def.nodes[n];return 0===e?Ia(t,a,r,o):Ca(t,a,r,o),3&a.flags&&xa(t,Pa(t,n)),224&a.flags?Nn(t,a.nodeIndex).value:void 0},t)}function Ia(t,e,n,r){if(Wi.apply(void 0,p([t,e,n],r))){var o=1===n?r[0]:r;if(16384&e.flags){for(var i={},a=0;a<e.bindings.length;a++){var s=e.bindings[a],u=o[a];8&s.flags&&(i[(h=s.nonMinifiedName,"ng-reflect-"+h.replace(/[$@]/g,"_").replace(ke,function(){for(var t=[],e=0;e<arguments.length;e++)t[e]=arguments[e];return"-"+t[1].toLowerCase()}))]=Ae(u))}var c=e.parent,l=Rn(t,c.nodeIndex).renderElement;if(c.element.name)for(var f in i)null!=(u=i[f])?t.renderer.setAttribute(l,f,u):t.renderer.removeAttribute(l,f);else t.renderer.setValue(l,"bindings="+JSON.stringify(i,null,2))}}var h}function Ca(t,e,n,r){qi.apply(void 0,p([t,e,n],r))}function Oa(t,e){for(var n=e;n<t.def.nodes.length;n++){var r=t.def.nodes[n];if(16384&r.flags&&r.bindings&&r.bindings.length)return n}return null}function Pa(t,e){for(var n=e;n<t.def.nodes.length;n++){var r=t.def.nodes[n];if(3&r.flags&&r.bindings&&r.bindings.length)return n}return null}var Ra=function(){function t(t,e){this.view=t,this.nodeIndex=e,null==e&&(this.nodeIndex=e=0),this.nodeDef=t.def.nodes[e];for(var n=this.nodeDef,r=t;n&&0==(1&n.flags);)n=n.parent;if(!n)for(;!n&&r;)n=Xn(r),r=r.parent;this.elDef=n,this.elView=r}return Object.defineProperty(t.prototype,"elOrCompView",{get:function(){return Rn(this.elView,this.elDef.nodeIndex).componentView||this.view},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"injector",{get:function(){return Fr(this.elView,this.elDef)},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"component",{get:function(){return this.elOrCompView.component},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"context",{get:function(){return this.elOrCompView.context},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"providerTokens",{get:function(){var t=[];if(this.elDef)for(var e=this.elDef.nodeIndex+1;e<=this.elDef.nodeIndex+this.elDef.childCount;e++){var n=this.elView.def.nodes[e];20224&n.flags&&t.push(n.provider.token),e+=n.childCount}return t},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"references",{get:function(){var t={};if(this.elDef){Da(this.elView,this.elDef,t);for(var e=this.elDef.nodeIndex+1;e<=this.elDef.nodeIndex+this.elDef.childCount;e++){var n=this.elView.def.nodes[e];20224&n.flags&&Da(this.elView,n,t),e+=n.childCount}}return t},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"componentRenderElement",{get:function(){var t=function(t){for(;t&&!$n(t);)t=t.parent;return t.parent?Rn(t.parent,Xn(t).nodeIndex):null}(this.elOrCompView);return t?t.renderElement:void 0},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"renderNode",{get:function(){return 2&this.nodeDef.flags?Jn(this.view,this.nodeDef):Jn(this.elView,this.elDef)},enumerable:!0,configurable:!0}),t.prototype.logError=function(t){for(var e,n,r=[],o=1;o<arguments.length;o++)r[o-1]=arguments[o];2&this.nodeDef.flags?(e=this.view.def,n=this.nodeDef.nodeIndex):(e=this.elView.def,n=this.elDef.nodeIndex);var i=function(t,e){for(var n=-1,r=0;r<=e;r++)3&t.nodes[r].flags&&n++;return n}(e,n),a=-1;e.factory(function(){var e;return++a===i?(e=t.error).bind.apply(e,p([t],r)):Fn}),a<i&&(t.error("Illegal state: the ViewDefinitionFactory did not call the logger!"),t.error.apply(t,p(r)))},t}();function Da(t,e,n){for(var r in e.references)n[r]=Ii(t,e,e.references[r])}function Na(t,e,n,r){var o=_a,i=wa,a=Ea;try{_a=t;var s=e.apply(n,r);return wa=i,Ea=a,_a=o,s}catch(u){if(Kt(u)||!wa)throw u;throw function(t,e){return t instanceof Error||(t=new Error(t.toString())),An(t,e),t}(u,Ma())}}function Ma(){return wa?new Ra(wa,Ea):null}var ja=function(){function t(t){this.delegate=t}return t.prototype.createRenderer=function(t,e){return new Fa(this.delegate.createRenderer(t,e))},t.prototype.begin=function(){this.delegate.begin&&this.delegate.begin()},t.prototype.end=function(){this.delegate.end&&this.delegate.end()},t.prototype.whenRenderingDone=function(){return this.delegate.whenRenderingDone?this.delegate.whenRenderingDone():Promise.resolve(null)},t}(),Fa=function(){function t(t){this.delegate=t,this.debugContextFactory=Ma,this.data=this.delegate.data}return t.prototype.createDebugContext=function(t){return this.debugContextFactory(t)},t.prototype.destroyNode=function(t){!function(t){ui.delete(t.nativeNode)}(ci(t)),this.delegate.destroyNode&&this.delegate.destroyNode(t)},t.prototype.destroy=function(){this.delegate.destroy()},t.prototype.createElement=function(t,e){var n=this.delegate.createElement(t,e),r=this.createDebugContext(n);if(r){var o=new si(n,null,r);o.name=t,li(o)}return n},t.prototype.createComment=function(t){var e=this.delegate.createComment(t),n=this.createDebugContext(e);return n&&li(new ai(e,null,n)),e},t.prototype.createText=function(t){var e=this.delegate.createText(t),n=this.createDebugContext(e);return n&&li(new ai(e,null,n)),e},t.prototype.appendChild=function(t,e){var n=ci(t),r=ci(e);n&&r&&n instanceof si&&n.addChild(r),this.delegate.appendChild(t,e)},t.prototype.insertBefore=function(t,e,n){var r=ci(t),o=ci(e),i=ci(n);r&&o&&r instanceof si&&r.insertBefore(i,o),this.delegate.insertBefore(t,e,n)},t.prototype.removeChild=function(t,e){var n=ci(t),r=ci(e);n&&r&&n instanceof si&&n.removeChild(r),this.delegate.removeChild(t,e)},t.prototype.selectRootElement=function(t,e){var n=this.delegate.selectRootElement(t,e),r=Ma();return r&&li(new si(n,null,r)),n},t.prototype.setAttribute=function(t,e,n,r){var o=ci(t);o&&o instanceof si&&(o.attributes[r?r+":"+e:e]=n),this.delegate.setAttribute(t,e,n,r)},t.prototype.removeAttribute=function(t,e,n){var r=ci(t);r&&r instanceof si&&(r.attributes[n?n+":"+e:e]=null),this.delegate.removeAttribute(t,e,n)},t.prototype.addClass=function(t,e){var n=ci(t);n&&n instanceof si&&(n.classes[e]=!0),this.delegate.addClass(t,e)},t.prototype.removeClass=function(t,e){var n=ci(t);n&&n instanceof si&&(n.classes[e]=!1),this.delegate.removeClass(t,e)},t.prototype.setStyle=function(t,e,n,r){var o=ci(t);o&&o instanceof si&&(o.styles[e]=n),this.delegate.setStyle(t,e,n,r)},t.prototype.removeStyle=function(t,e,n){var r=ci(t);r&&r instanceof si&&(r.styles[e]=null),this.delegate.removeStyle(t,e,n)},t.prototype.setProperty=function(t,e,n){var r=ci(t);r&&r instanceof si&&(r.properties[e]=n),this.delegate.setProperty(t,e,n)},t.prototype.listen=function(t,e,n){if("string"!=typeof t){var r=ci(t);r&&r.listeners.push(new ii(e,n))}return this.delegate.listen(t,e,n)},t.prototype.parentNode=function(t){return this.delegate.parentNode(t)},t.prototype.nextSibling=function(t){return this.delegate.nextSibling(t)},t.prototype.setValue=function(t,e){return this.delegate.setValue(t,e)},t}();function La(t,e,n){return new Ba(t,e,n)}var Ba=function(t){function e(e,n,r){var o=t.call(this)||this;return o.moduleType=e,o._bootstrapComponents=n,o._ngModuleDefFactory=r,o}return o(e,t),e.prototype.create=function(t){!function(){if(!ea){ea=!0;var t=te()?{setCurrentNode:xa,createRootView:ra,createEmbeddedView:ia,createComponentView:aa,createNgModuleRef:sa,overrideProvider:fa,overrideComponentView:pa,clearOverrides:ha,checkAndUpdateView:ga,checkNoChangesView:ma,destroyView:ba,createDebugContext:function(t,e){return new Ra(t,e)},handleEvent:Ta,updateDirectives:ka,updateRenderer:Aa}:{setCurrentNode:function(){},createRootView:na,createEmbeddedView:ji,createComponentView:Li,createNgModuleRef:Hr,overrideProvider:Fn,overrideComponentView:Fn,clearOverrides:Fn,checkAndUpdateView:Ui,checkNoChangesView:zi,destroyView:Ki,createDebugContext:function(t,e){return new Ra(t,e)},handleEvent:function(t,e,n,r){return t.def.handleEvent(t,e,n,r)},updateDirectives:function(t,e){return t.def.updateDirectives(0===e?ya:va,t)},updateRenderer:function(t,e){return t.def.updateRenderer(0===e?ya:va,t)}};jn.setCurrentNode=t.setCurrentNode,jn.createRootView=t.createRootView,jn.createEmbeddedView=t.createEmbeddedView,jn.createComponentView=t.createComponentView,jn.createNgModuleRef=t.createNgModuleRef,jn.overrideProvider=t.overrideProvider,jn.overrideComponentView=t.overrideComponentView,jn.clearOverrides=t.clearOverrides,jn.checkAndUpdateView=t.checkAndUpdateView,jn.checkNoChangesView=t.checkNoChangesView,jn.destroyView=t.destroyView,jn.resolveDep=io,jn.createDebugContext=t.createDebugContext,jn.handleEvent=t.handleEvent,jn.updateDirectives=t.updateDirectives,jn.updateRenderer=t.updateRenderer,jn.dirtyParentQueries=Ti}}();var e=function(t){var e=Array.from(t.providers),n=Array.from(t.modules),r={};for(var o in t.providersByKey)r[o]=t.providersByKey[o];return{factory:t.factory,isRoot:t.isRoot,providers:e,modules:n,providersByKey:r}}(ar(this._ngModuleDefFactory));return jn.createNgModuleRef(this.moduleType,t||Pt.NULL,this._bootstrapComponents,e)},e}(Ge),Va=(n("0TWp"),n("hgfv")),Ha=function(){return function(){}}(),za=function(t){return t[t.NotAccepted=0]="NotAccepted",t[t.Processing=1]="Processing",t[t.DoneWithoutError=2]="DoneWithoutError",t[t.DoneWithError=3]="DoneWithError",t}({}),Ua=function(){function t(){}return t.prototype.getInstaller=function(){return u(this,void 0,void 0,function(){var t;return c(this,function(e){switch(e.label){case 0:return this.installer?[3,2]:(t=this,[4,window.backend.wailsBind.Self()]);case 1:t.installer=e.sent(),this.installer.status=za.NotAccepted,e.label=2;case 2:return[2,this.installer]}})})},t.prototype.setInstallerStatus=function(t){this.installer.status=t},t.ngInjectableDef=dt({factory:function(){return new t},token:t,providedIn:"root"}),t}(),Wa=function(){return function(t){var e=this;this.Status=za,t.getInstaller().then(function(t){return e.installer=t})}}(),Za=function(){return function(){}}(),qa=new kt("appBaseHref"),Ga=void 0,Ka=["en",[["a","p"],["AM","PM"],Ga],[["AM","PM"],Ga,Ga],[["S","M","T","W","T","F","S"],["Sun","Mon","Tue","Wed","Thu","Fri","Sat"],["Sunday","Monday","Tuesday","Wednesday","Thursday","Friday","Saturday"],["Su","Mo","Tu","We","Th","Fr","Sa"]],Ga,[["J","F","M","A","M","J","J","A","S","O","N","D"],["Jan","Feb","Mar","Apr","May","Jun","Jul","Aug","Sep","Oct","Nov","Dec"],["January","February","March","April","May","June","July","August","September","October","November","December"]],Ga,[["B","A"],["BC","AD"],["Before Christ","Anno Domini"]],0,[6,0],["M/d/yy","MMM d, y","MMMM d, y","EEEE, MMMM d, y"],["h:mm a","h:mm:ss a","h:mm:ss a z","h:mm:ss a zzzz"],["{1}, {0}",Ga,"{1} 'at' {0}",Ga],[".",",",";","%","+","-","E","\xd7","\u2030","\u221e","NaN",":"],["#,##0.###","#,##0%","\xa4#,##0.00","#E0"],"$","US Dollar",{},function(t){var e=Math.floor(Math.abs(t)),n=t.toString().replace(/^[^.]*\.?/,"").length;return 1===e&&0===n?1:5}],Qa={},Ya=function(t){return t[t.Zero=0]="Zero",t[t.One=1]="One",t[t.Two=2]="Two",t[t.Few=3]="Few",t[t.Many=4]="Many",t[t.Other=5]="Other",t}({}),Xa=new kt("UseV4Plurals"),Ja=function(){return function(){}}(),$a=function(t){function e(e,n){var r=t.call(this)||this;return r.locale=e,r.deprecatedPluralFn=n,r}return o(e,t),e.prototype.getPluralCategory=function(t,e){switch(this.deprecatedPluralFn?this.deprecatedPluralFn(e||this.locale,t):function(t){return function(t){var e=t.toLowerCase().replace(/_/g,"-"),n=Qa[e];if(n)return n;var r=e.split("-")[0];if(n=Qa[r])return n;if("en"===r)return Ka;throw new Error('Missing locale data for the locale "'+t+'".')}(t)[18]}(e||this.locale)(t)){case Ya.Zero:return"zero";case Ya.One:return"one";case Ya.Two:return"two";case Ya.Few:return"few";case Ya.Many:return"many";default:return"other"}},e}(Ja),ts=function(){function t(t,e,n,r){this.$implicit=t,this.ngForOf=e,this.index=n,this.count=r}return Object.defineProperty(t.prototype,"first",{get:function(){return 0===this.index},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"last",{get:function(){return this.index===this.count-1},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"even",{get:function(){return this.index%2==0},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"odd",{get:function(){return!this.even},enumerable:!0,configurable:!0}),t}(),es=function(){function t(t,e,n){this._viewContainer=t,this._template=e,this._differs=n,this._ngForOfDirty=!0,this._differ=null}return Object.defineProperty(t.prototype,"ngForOf",{set:function(t){this._ngForOf=t,this._ngForOfDirty=!0},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"ngForTrackBy",{get:function(){return this._trackByFn},set:function(t){te()&&null!=t&&"function"!=typeof t&&console&&console.warn&&console.warn("trackBy must be a function, but received "+JSON.stringify(t)+". See https://angular.io/docs/ts/latest/api/common/index/NgFor-directive.html#!#change-propagation for more information."),this._trackByFn=t},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"ngForTemplate",{set:function(t){t&&(this._template=t)},enumerable:!0,configurable:!0}),t.prototype.ngDoCheck=function(){if(this._ngForOfDirty){this._ngForOfDirty=!1;var t=this._ngForOf;if(!this._differ&&t)try{this._differ=this._differs.find(t).create(this.ngForTrackBy)}catch(r){throw new Error("Cannot find a differ supporting object '"+t+"' of type '"+((e=t).name||typeof e)+"'. NgFor only supports binding to Iterables such as Arrays.")}}var e;if(this._differ){var n=this._differ.diff(this._ngForOf);n&&this._applyChanges(n)}},t.prototype._applyChanges=function(t){var e=this,n=[];t.forEachOperation(function(t,r,o){if(null==t.previousIndex){var i=e._viewContainer.createEmbeddedView(e._template,new ts(null,e._ngForOf,-1,-1),o),a=new ns(t,i);n.push(a)}else null==o?e._viewContainer.remove(r):(i=e._viewContainer.get(r),e._viewContainer.move(i,o),a=new ns(t,i),n.push(a))});for(var r=0;r<n.length;r++)this._perViewChange(n[r].view,n[r].record);r=0;for(var o=this._viewContainer.length;r<o;r++){var i=this._viewContainer.get(r);i.context.index=r,i.context.count=o,i.context.ngForOf=this._ngForOf}t.forEachIdentityChange(function(t){e._viewContainer.get(t.currentIndex).context.$implicit=t.item})},t.prototype._perViewChange=function(t,e){t.context.$implicit=e.item},t.ngTemplateContextGuard=function(t,e){return!0},t}(),ns=function(){return function(t,e){this.record=t,this.view=e}}(),rs=function(){function t(t,e){this._viewContainer=t,this._context=new os,this._thenTemplateRef=null,this._elseTemplateRef=null,this._thenViewRef=null,this._elseViewRef=null,this._thenTemplateRef=e}return Object.defineProperty(t.prototype,"ngIf",{set:function(t){this._context.$implicit=this._context.ngIf=t,this._updateView()},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"ngIfThen",{set:function(t){is("ngIfThen",t),this._thenTemplateRef=t,this._thenViewRef=null,this._updateView()},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"ngIfElse",{set:function(t){is("ngIfElse",t),this._elseTemplateRef=t,this._elseViewRef=null,this._updateView()},enumerable:!0,configurable:!0}),t.prototype._updateView=function(){this._context.$implicit?this._thenViewRef||(this._viewContainer.clear(),this._elseViewRef=null,this._thenTemplateRef&&(this._thenViewRef=this._viewContainer.createEmbeddedView(this._thenTemplateRef,this._context))):this._elseViewRef||(this._viewContainer.clear(),this._thenViewRef=null,this._elseTemplateRef&&(this._elseViewRef=this._viewContainer.createEmbeddedView(this._elseTemplateRef,this._context)))},t.ngTemplateGuard_ngIf=function(t,e){return!0},t}(),os=function(){return function(){this.$implicit=null,this.ngIf=null}}();function is(t,e){if(e&&!e.createEmbeddedView)throw new Error(t+" must be a TemplateRef, but received '"+gt(e)+"'.")}var as=function(){return function(){}}(),ss=new kt("DocumentToken"),us="server",cs=function(){function t(t){this.sanitizer=t}return t.prototype.ngAfterViewInit=function(){var t=this;setTimeout(function(){new ls(t.conditions.nativeElement).onReachingBottom(function(){return t.isRead=!0})})},t.prototype.onAccept=function(){this.installer.mustReadAllConditions&&!this.isRead||(this.installer.status=za.Processing)},t}(),ls=function(){function t(t){this.el=t}return t.prototype.onReachingBottom=function(t){var e=this;if(this.isScrolledBottom)return t();var n=function(){if(e.isScrolledBottom)return e.el.removeEventListener("scroll",n),t()};this.el.addEventListener("scroll",n)},Object.defineProperty(t.prototype,"isScrolledBottom",{get:function(){var t=this.el.scrollHeight-this.el.offsetHeight;return t<this.el.offsetHeight||this.el.scrollTop/t>.96},enumerable:!0,configurable:!0}),t}(),fs=null;function ps(){return fs}var hs,ds=function(t){function e(){var e=t.call(this)||this;e._animationPrefix=null,e._transitionEnd=null;try{var n=e.createElement("div",document);if(null!=e.getStyle(n,"animationName"))e._animationPrefix="";else for(var r=["Webkit","Moz","O","ms"],o=0;o<r.length;o++)if(null!=e.getStyle(n,r[o]+"AnimationName")){e._animationPrefix="-"+r[o].toLowerCase()+"-";break}var i={WebkitTransition:"webkitTransitionEnd",MozTransition:"transitionend",OTransition:"oTransitionEnd otransitionend",transition:"transitionend"};Object.keys(i).forEach(function(t){null!=e.getStyle(n,t)&&(e._transitionEnd=i[t])})}catch(a){e._animationPrefix=null,e._transitionEnd=null}return e}return o(e,t),e.prototype.getDistributedNodes=function(t){return t.getDistributedNodes()},e.prototype.resolveAndSetHref=function(t,e,n){t.href=null==n?e:e+"/../"+n},e.prototype.supportsDOMEvents=function(){return!0},e.prototype.supportsNativeShadowDOM=function(){return"function"==typeof document.body.createShadowRoot},e.prototype.getAnimationPrefix=function(){return this._animationPrefix?this._animationPrefix:""},e.prototype.getTransitionEnd=function(){return this._transitionEnd?this._transitionEnd:""},e.prototype.supportsAnimation=function(){return null!=this._animationPrefix&&null!=this._transitionEnd},e}(function(){function t(){this.resourceLoaderType=null}return Object.defineProperty(t.prototype,"attrToPropMap",{get:function(){return this._attrToPropMap},set:function(t){this._attrToPropMap=t},enumerable:!0,configurable:!0}),t}()),ys={class:"className",innerHtml:"innerHTML",readonly:"readOnly",tabindex:"tabIndex"},vs={"\b":"Backspace","\t":"Tab","\x7f":"Delete","\x1b":"Escape",Del:"Delete",Esc:"Escape",Left:"ArrowLeft",Right:"ArrowRight",Up:"ArrowUp",Down:"ArrowDown",Menu:"ContextMenu",Scroll:"ScrollLock",Win:"OS"},gs={A:"1",B:"2",C:"3",D:"4",E:"5",F:"6",G:"7",H:"8",I:"9",J:"*",K:"+",M:"-",N:".",O:"/","`":"0","\x90":"NumLock"},ms=function(){if(St.Node)return St.Node.prototype.contains||function(t){return!!(16&this.compareDocumentPosition(t))}}(),bs=function(t){function e(){return null!==t&&t.apply(this,arguments)||this}return o(e,t),e.prototype.parse=function(t){throw new Error("parse not implemented")},e.makeCurrent=function(){var t;t=new e,fs||(fs=t)},e.prototype.hasProperty=function(t,e){return e in t},e.prototype.setProperty=function(t,e,n){t[e]=n},e.prototype.getProperty=function(t,e){return t[e]},e.prototype.invoke=function(t,e,n){var r;(r=t)[e].apply(r,p(n))},e.prototype.logError=function(t){window.console&&(console.error?console.error(t):console.log(t))},e.prototype.log=function(t){window.console&&window.console.log&&window.console.log(t)},e.prototype.logGroup=function(t){window.console&&window.console.group&&window.console.group(t)},e.prototype.logGroupEnd=function(){window.console&&window.console.groupEnd&&window.console.groupEnd()},Object.defineProperty(e.prototype,"attrToPropMap",{get:function(){return ys},enumerable:!0,configurable:!0}),e.prototype.contains=function(t,e){return ms.call(t,e)},e.prototype.querySelector=function(t,e){return t.querySelector(e)},e.prototype.querySelectorAll=function(t,e){return t.querySelectorAll(e)},e.prototype.on=function(t,e,n){t.addEventListener(e,n,!1)},e.prototype.onAndCancel=function(t,e,n){return t.addEventListener(e,n,!1),function(){t.removeEventListener(e,n,!1)}},e.prototype.dispatchEvent=function(t,e){t.dispatchEvent(e)},e.prototype.createMouseEvent=function(t){var e=this.getDefaultDocument().createEvent("MouseEvent");return e.initEvent(t,!0,!0),e},e.prototype.createEvent=function(t){var e=this.getDefaultDocument().createEvent("Event");return e.initEvent(t,!0,!0),e},e.prototype.preventDefault=function(t){t.preventDefault(),t.returnValue=!1},e.prototype.isPrevented=function(t){return t.defaultPrevented||null!=t.returnValue&&!t.returnValue},e.prototype.getInnerHTML=function(t){return t.innerHTML},e.prototype.getTemplateContent=function(t){return"content"in t&&this.isTemplateElement(t)?t.content:null},e.prototype.getOuterHTML=function(t){return t.outerHTML},e.prototype.nodeName=function(t){return t.nodeName},e.prototype.nodeValue=function(t){return t.nodeValue},e.prototype.type=function(t){return t.type},e.prototype.content=function(t){return this.hasProperty(t,"content")?t.content:t},e.prototype.firstChild=function(t){return t.firstChild},e.prototype.nextSibling=function(t){return t.nextSibling},e.prototype.parentElement=function(t){return t.parentNode},e.prototype.childNodes=function(t){return t.childNodes},e.prototype.childNodesAsList=function(t){for(var e=t.childNodes,n=new Array(e.length),r=0;r<e.length;r++)n[r]=e[r];return n},e.prototype.clearNodes=function(t){for(;t.firstChild;)t.removeChild(t.firstChild)},e.prototype.appendChild=function(t,e){t.appendChild(e)},e.prototype.removeChild=function(t,e){t.removeChild(e)},e.prototype.replaceChild=function(t,e,n){t.replaceChild(e,n)},e.prototype.remove=function(t){return t.parentNode&&t.parentNode.removeChild(t),t},e.prototype.insertBefore=function(t,e,n){t.insertBefore(n,e)},e.prototype.insertAllBefore=function(t,e,n){n.forEach(function(n){return t.insertBefore(n,e)})},e.prototype.insertAfter=function(t,e,n){t.insertBefore(n,e.nextSibling)},e.prototype.setInnerHTML=function(t,e){t.innerHTML=e},e.prototype.getText=function(t){return t.textContent},e.prototype.setText=function(t,e){t.textContent=e},e.prototype.getValue=function(t){return t.value},e.prototype.setValue=function(t,e){t.value=e},e.prototype.getChecked=function(t){return t.checked},e.prototype.setChecked=function(t,e){t.checked=e},e.prototype.createComment=function(t){return this.getDefaultDocument().createComment(t)},e.prototype.createTemplate=function(t){var e=this.getDefaultDocument().createElement("template");return e.innerHTML=t,e},e.prototype.createElement=function(t,e){return(e=e||this.getDefaultDocument()).createElement(t)},e.prototype.createElementNS=function(t,e,n){return(n=n||this.getDefaultDocument()).createElementNS(t,e)},e.prototype.createTextNode=function(t,e){return(e=e||this.getDefaultDocument()).createTextNode(t)},e.prototype.createScriptTag=function(t,e,n){var r=(n=n||this.getDefaultDocument()).createElement("SCRIPT");return r.setAttribute(t,e),r},e.prototype.createStyleElement=function(t,e){var n=(e=e||this.getDefaultDocument()).createElement("style");return this.appendChild(n,this.createTextNode(t,e)),n},e.prototype.createShadowRoot=function(t){return t.createShadowRoot()},e.prototype.getShadowRoot=function(t){return t.shadowRoot},e.prototype.getHost=function(t){return t.host},e.prototype.clone=function(t){return t.cloneNode(!0)},e.prototype.getElementsByClassName=function(t,e){return t.getElementsByClassName(e)},e.prototype.getElementsByTagName=function(t,e){return t.getElementsByTagName(e)},e.prototype.classList=function(t){return Array.prototype.slice.call(t.classList,0)},e.prototype.addClass=function(t,e){t.classList.add(e)},e.prototype.removeClass=function(t,e){t.classList.remove(e)},e.prototype.hasClass=function(t,e){return t.classList.contains(e)},e.prototype.setStyle=function(t,e,n){t.style[e]=n},e.prototype.removeStyle=function(t,e){t.style[e]=""},e.prototype.getStyle=function(t,e){return t.style[e]},e.prototype.hasStyle=function(t,e,n){var r=this.getStyle(t,e)||"";return n?r==n:r.length>0},e.prototype.tagName=function(t){return t.tagName},e.prototype.attributeMap=function(t){for(var e=new Map,n=t.attributes,r=0;r<n.length;r++){var o=n.item(r);e.set(o.name,o.value)}return e},e.prototype.hasAttribute=function(t,e){return t.hasAttribute(e)},e.prototype.hasAttributeNS=function(t,e,n){return t.hasAttributeNS(e,n)},e.prototype.getAttribute=function(t,e){return t.getAttribute(e)},e.prototype.getAttributeNS=function(t,e,n){return t.getAttributeNS(e,n)},e.prototype.setAttribute=function(t,e,n){t.setAttribute(e,n)},e.prototype.setAttributeNS=function(t,e,n,r){t.setAttributeNS(e,n,r)},e.prototype.removeAttribute=function(t,e){t.removeAttribute(e)},e.prototype.removeAttributeNS=function(t,e,n){t.removeAttributeNS(e,n)},e.prototype.templateAwareRoot=function(t){return this.isTemplateElement(t)?this.content(t):t},e.prototype.createHtmlDocument=function(){return document.implementation.createHTMLDocument("fakeTitle")},e.prototype.getDefaultDocument=function(){return document},e.prototype.getBoundingClientRect=function(t){try{return t.getBoundingClientRect()}catch(e){return{top:0,bottom:0,left:0,right:0,width:0,height:0}}},e.prototype.getTitle=function(t){return t.title},e.prototype.setTitle=function(t,e){t.title=e||""},e.prototype.elementMatches=function(t,e){return!!this.isElementNode(t)&&(t.matches&&t.matches(e)||t.msMatchesSelector&&t.msMatchesSelector(e)||t.webkitMatchesSelector&&t.webkitMatchesSelector(e))},e.prototype.isTemplateElement=function(t){return this.isElementNode(t)&&"TEMPLATE"===t.nodeName},e.prototype.isTextNode=function(t){return t.nodeType===Node.TEXT_NODE},e.prototype.isCommentNode=function(t){return t.nodeType===Node.COMMENT_NODE},e.prototype.isElementNode=function(t){return t.nodeType===Node.ELEMENT_NODE},e.prototype.hasShadowRoot=function(t){return null!=t.shadowRoot&&t instanceof HTMLElement},e.prototype.isShadowRoot=function(t){return t instanceof DocumentFragment},e.prototype.importIntoDoc=function(t){return document.importNode(this.templateAwareRoot(t),!0)},e.prototype.adoptNode=function(t){return document.adoptNode(t)},e.prototype.getHref=function(t){return t.getAttribute("href")},e.prototype.getEventKey=function(t){var e=t.key;if(null==e){if(null==(e=t.keyIdentifier))return"Unidentified";e.startsWith("U+")&&(e=String.fromCharCode(parseInt(e.substring(2),16)),3===t.location&&gs.hasOwnProperty(e)&&(e=gs[e]))}return vs[e]||e},e.prototype.getGlobalEventTarget=function(t,e){return"window"===e?window:"document"===e?t:"body"===e?t.body:null},e.prototype.getHistory=function(){return window.history},e.prototype.getLocation=function(){return window.location},e.prototype.getBaseHref=function(t){var e,n=_s||(_s=document.querySelector("base"))?_s.getAttribute("href"):null;return null==n?null:(e=n,hs||(hs=document.createElement("a")),hs.setAttribute("href",e),"/"===hs.pathname.charAt(0)?hs.pathname:"/"+hs.pathname)},e.prototype.resetBaseElement=function(){_s=null},e.prototype.getUserAgent=function(){return window.navigator.userAgent},e.prototype.setData=function(t,e,n){this.setAttribute(t,"data-"+e,n)},e.prototype.getData=function(t,e){return this.getAttribute(t,"data-"+e)},e.prototype.getComputedStyle=function(t){return getComputedStyle(t)},e.prototype.supportsWebAnimation=function(){return"function"==typeof Element.prototype.animate},e.prototype.performanceNow=function(){return window.performance&&window.performance.now?window.performance.now():(new Date).getTime()},e.prototype.supportsCookies=function(){return!0},e.prototype.getCookie=function(t){return function(t,e){var n,r;e=encodeURIComponent(e);try{for(var o=l(t.split(";")),i=o.next();!i.done;i=o.next()){var a=i.value,s=a.indexOf("="),u=f(-1==s?[a,""]:[a.slice(0,s),a.slice(s+1)],2),c=u[1];if(u[0].trim()===e)return decodeURIComponent(c)}}catch(p){n={error:p}}finally{try{i&&!i.done&&(r=o.return)&&r.call(o)}finally{if(n)throw n.error}}return null}(document.cookie,t)},e.prototype.setCookie=function(t,e){document.cookie=encodeURIComponent(t)+"="+encodeURIComponent(e)},e}(ds),_s=null;function ws(){return!!window.history.pushState}var Es=function(t){function e(e){var n=t.call(this)||this;return n._doc=e,n._init(),n}var n;return o(e,t),e.prototype._init=function(){this.location=ps().getLocation(),this._history=ps().getHistory()},e.prototype.getBaseHrefFromDOM=function(){return ps().getBaseHref(this._doc)},e.prototype.onPopState=function(t){ps().getGlobalEventTarget(this._doc,"window").addEventListener("popstate",t,!1)},e.prototype.onHashChange=function(t){ps().getGlobalEventTarget(this._doc,"window").addEventListener("hashchange",t,!1)},Object.defineProperty(e.prototype,"href",{get:function(){return this.location.href},enumerable:!0,configurable:!0}),Object.defineProperty(e.prototype,"protocol",{get:function(){return this.location.protocol},enumerable:!0,configurable:!0}),Object.defineProperty(e.prototype,"hostname",{get:function(){return this.location.hostname},enumerable:!0,configurable:!0}),Object.defineProperty(e.prototype,"port",{get:function(){return this.location.port},enumerable:!0,configurable:!0}),Object.defineProperty(e.prototype,"pathname",{get:function(){return this.location.pathname},set:function(t){this.location.pathname=t},enumerable:!0,configurable:!0}),Object.defineProperty(e.prototype,"search",{get:function(){return this.location.search},enumerable:!0,configurable:!0}),Object.defineProperty(e.prototype,"hash",{get:function(){return this.location.hash},enumerable:!0,configurable:!0}),e.prototype.pushState=function(t,e,n){ws()?this._history.pushState(t,e,n):this.location.hash=n},e.prototype.replaceState=function(t,e,n){ws()?this._history.replaceState(t,e,n):this.location.hash=n},e.prototype.forward=function(){this._history.forward()},e.prototype.back=function(){this._history.back()},e.prototype.getState=function(){return this._history.state},a([(n=ut(ss),function(t,e){n(t,e,0)}),s("design:paramtypes",[Object])],e)}(Za),Ss=new kt("TRANSITION_ID"),xs=[{provide:yo,useFactory:function(t,e,n){return function(){n.get(vo).donePromise.then(function(){var n=ps();Array.prototype.slice.apply(n.querySelectorAll(e,"style[ng-transition]")).filter(function(e){return n.getAttribute(e,"ng-transition")===t}).forEach(function(t){return n.remove(t)})})}},deps:[Ss,ss,Pt],multi:!0}],Ts=function(){function t(){}return t.init=function(){var e;e=new t,Yo=e},t.prototype.addToWindow=function(t){St.getAngularTestability=function(e,n){void 0===n&&(n=!0);var r=t.findTestabilityInTree(e,n);if(null==r)throw new Error("Could not find testability for element.");return r},St.getAllAngularTestabilities=function(){return t.getAllTestabilities()},St.getAllAngularRootElements=function(){return t.getAllRootElements()},St.frameworkStabilizers||(St.frameworkStabilizers=[]),St.frameworkStabilizers.push(function(t){var e=St.getAllAngularTestabilities(),n=e.length,r=!1,o=function(e){r=r||e,0==--n&&t(r)};e.forEach(function(t){t.whenStable(o)})})},t.prototype.findTestabilityInTree=function(t,e,n){if(null==e)return null;var r=t.getTestability(e);return null!=r?r:n?ps().isShadowRoot(e)?this.findTestabilityInTree(t,ps().getHost(e),!0):this.findTestabilityInTree(t,ps().parentElement(e),!0):null},t}();function ks(t,e){"undefined"!=typeof COMPILED&&COMPILED||((St.ng=St.ng||{})[t]=e)}var As=function(){return{ApplicationRef:ri,NgZone:Vo}}();function Is(t){return ci(t)}var Cs=new kt("EventManagerPlugins"),Os=function(){function t(t,e){var n=this;this._zone=e,this._eventNameToPlugin=new Map,t.forEach(function(t){return t.manager=n}),this._plugins=t.slice().reverse()}return t.prototype.addEventListener=function(t,e,n){return this._findPluginFor(e).addEventListener(t,e,n)},t.prototype.addGlobalEventListener=function(t,e,n){return this._findPluginFor(e).addGlobalEventListener(t,e,n)},t.prototype.getZone=function(){return this._zone},t.prototype._findPluginFor=function(t){var e=this._eventNameToPlugin.get(t);if(e)return e;for(var n=this._plugins,r=0;r<n.length;r++){var o=n[r];if(o.supports(t))return this._eventNameToPlugin.set(t,o),o}throw new Error("No event manager plugin found for event "+t)},t}(),Ps=function(){function t(t){this._doc=t}return t.prototype.addGlobalEventListener=function(t,e,n){var r=ps().getGlobalEventTarget(this._doc,t);if(!r)throw new Error("Unsupported event target "+r+" for event "+e);return this.addEventListener(r,e,n)},t}(),Rs=function(){function t(){this._stylesSet=new Set}return t.prototype.addStyles=function(t){var e=this,n=new Set;t.forEach(function(t){e._stylesSet.has(t)||(e._stylesSet.add(t),n.add(t))}),this.onStylesAdded(n)},t.prototype.onStylesAdded=function(t){},t.prototype.getAllStyles=function(){return Array.from(this._stylesSet)},t}(),Ds=function(t){function e(e){var n=t.call(this)||this;return n._doc=e,n._hostNodes=new Set,n._styleNodes=new Set,n._hostNodes.add(e.head),n}return o(e,t),e.prototype._addStylesToHost=function(t,e){var n=this;t.forEach(function(t){var r=n._doc.createElement("style");r.textContent=t,n._styleNodes.add(e.appendChild(r))})},e.prototype.addHost=function(t){this._addStylesToHost(this._stylesSet,t),this._hostNodes.add(t)},e.prototype.removeHost=function(t){this._hostNodes.delete(t)},e.prototype.onStylesAdded=function(t){var e=this;this._hostNodes.forEach(function(n){return e._addStylesToHost(t,n)})},e.prototype.ngOnDestroy=function(){this._styleNodes.forEach(function(t){return ps().remove(t)})},e}(Rs),Ns={svg:"http://www.w3.org/2000/svg",xhtml:"http://www.w3.org/1999/xhtml",xlink:"http://www.w3.org/1999/xlink",xml:"http://www.w3.org/XML/1998/namespace",xmlns:"http://www.w3.org/2000/xmlns/"},Ms=/%COMP%/g,js="_nghost-%COMP%",Fs="_ngcontent-%COMP%";function Ls(t,e,n){for(var r=0;r<e.length;r++){var o=e[r];Array.isArray(o)?Ls(t,o,n):(o=o.replace(Ms,t),n.push(o))}return n}function Bs(t){return function(e){!1===t(e)&&(e.preventDefault(),e.returnValue=!1)}}var Vs=function(){function t(t,e,n){this.eventManager=t,this.sharedStylesHost=e,this.appId=n,this.rendererByCompId=new Map,this.defaultRenderer=new Hs(t)}return t.prototype.createRenderer=function(t,e){if(!t||!e)return this.defaultRenderer;switch(e.encapsulation){case qt.Emulated:var n=this.rendererByCompId.get(e.id);return n||(n=new Ws(this.eventManager,this.sharedStylesHost,e,this.appId),this.rendererByCompId.set(e.id,n)),n.applyToHost(t),n;case qt.Native:case qt.ShadowDom:return new Zs(this.eventManager,this.sharedStylesHost,t,e);default:if(!this.rendererByCompId.has(e.id)){var r=Ls(e.id,e.styles,[]);this.sharedStylesHost.addStyles(r),this.rendererByCompId.set(e.id,this.defaultRenderer)}return this.defaultRenderer}},t.prototype.begin=function(){},t.prototype.end=function(){},t}(),Hs=function(){function t(t){this.eventManager=t,this.data=Object.create(null)}return t.prototype.destroy=function(){},t.prototype.createElement=function(t,e){return e?document.createElementNS(Ns[e]||e,t):document.createElement(t)},t.prototype.createComment=function(t){return document.createComment(t)},t.prototype.createText=function(t){return document.createTextNode(t)},t.prototype.appendChild=function(t,e){t.appendChild(e)},t.prototype.insertBefore=function(t,e,n){t&&t.insertBefore(e,n)},t.prototype.removeChild=function(t,e){t&&t.removeChild(e)},t.prototype.selectRootElement=function(t,e){var n="string"==typeof t?document.querySelector(t):t;if(!n)throw new Error('The selector "'+t+'" did not match any elements');return e||(n.textContent=""),n},t.prototype.parentNode=function(t){return t.parentNode},t.prototype.nextSibling=function(t){return t.nextSibling},t.prototype.setAttribute=function(t,e,n,r){if(r){e=r+":"+e;var o=Ns[r];o?t.setAttributeNS(o,e,n):t.setAttribute(e,n)}else t.setAttribute(e,n)},t.prototype.removeAttribute=function(t,e,n){if(n){var r=Ns[n];r?t.removeAttributeNS(r,e):t.removeAttribute(n+":"+e)}else t.removeAttribute(e)},t.prototype.addClass=function(t,e){t.classList.add(e)},t.prototype.removeClass=function(t,e){t.classList.remove(e)},t.prototype.setStyle=function(t,e,n,r){r&$e.DashCase?t.style.setProperty(e,n,r&$e.Important?"important":""):t.style[e]=n},t.prototype.removeStyle=function(t,e,n){n&$e.DashCase?t.style.removeProperty(e):t.style[e]=""},t.prototype.setProperty=function(t,e,n){Us(e,"property"),t[e]=n},t.prototype.setValue=function(t,e){t.nodeValue=e},t.prototype.listen=function(t,e,n){return Us(e,"listener"),"string"==typeof t?this.eventManager.addGlobalEventListener(t,e,Bs(n)):this.eventManager.addEventListener(t,e,Bs(n))},t}(),zs=function(){return"@".charCodeAt(0)}();function Us(t,e){if(t.charCodeAt(0)===zs)throw new Error("Found the synthetic "+e+" "+t+'. Please include either "BrowserAnimationsModule" or "NoopAnimationsModule" in your application.')}var Ws=function(t){function e(e,n,r,o){var i=t.call(this,e)||this;i.component=r;var a=Ls(o+"-"+r.id,r.styles,[]);return n.addStyles(a),i.contentAttr=Fs.replace(Ms,o+"-"+r.id),i.hostAttr=js.replace(Ms,o+"-"+r.id),i}return o(e,t),e.prototype.applyToHost=function(e){t.prototype.setAttribute.call(this,e,this.hostAttr,"")},e.prototype.createElement=function(e,n){var r=t.prototype.createElement.call(this,e,n);return t.prototype.setAttribute.call(this,r,this.contentAttr,""),r},e}(Hs),Zs=function(t){function e(e,n,r,o){var i=t.call(this,e)||this;i.sharedStylesHost=n,i.hostEl=r,i.component=o,i.shadowRoot=o.encapsulation===qt.ShadowDom?r.attachShadow({mode:"open"}):r.createShadowRoot(),i.sharedStylesHost.addHost(i.shadowRoot);for(var a=Ls(o.id,o.styles,[]),s=0;s<a.length;s++){var u=document.createElement("style");u.textContent=a[s],i.shadowRoot.appendChild(u)}return i}return o(e,t),e.prototype.nodeOrShadowRoot=function(t){return t===this.hostEl?this.shadowRoot:t},e.prototype.destroy=function(){this.sharedStylesHost.removeHost(this.shadowRoot)},e.prototype.appendChild=function(e,n){return t.prototype.appendChild.call(this,this.nodeOrShadowRoot(e),n)},e.prototype.insertBefore=function(e,n,r){return t.prototype.insertBefore.call(this,this.nodeOrShadowRoot(e),n,r)},e.prototype.removeChild=function(e,n){return t.prototype.removeChild.call(this,this.nodeOrShadowRoot(e),n)},e.prototype.parentNode=function(e){return this.nodeOrShadowRoot(t.prototype.parentNode.call(this,this.nodeOrShadowRoot(e)))},e}(Hs),qs=function(){return"undefined"!=typeof Zone&&Zone.__symbol__||function(t){return"__zone_symbol__"+t}}(),Gs=qs("addEventListener"),Ks=qs("removeEventListener"),Qs={},Ys="__zone_symbol__propagationStopped",Xs=function(){var t="undefined"!=typeof Zone&&Zone[qs("BLACK_LISTED_EVENTS")];if(t){var e={};return t.forEach(function(t){e[t]=t}),e}}(),Js=function(t){return!!Xs&&Xs.hasOwnProperty(t)},$s=function(t){var e=Qs[t.type];if(e){var n=this[e];if(n){var r=[t];if(1===n.length)return(a=n[0]).zone!==Zone.current?a.zone.run(a.handler,this,r):a.handler.apply(this,r);for(var o=n.slice(),i=0;i<o.length&&!0!==t[Ys];i++){var a;(a=o[i]).zone!==Zone.current?a.zone.run(a.handler,this,r):a.handler.apply(this,r)}}}},tu=function(t){function e(e,n,r){var o=t.call(this,e)||this;return o.ngZone=n,r&&function(t){return t===us}(r)||o.patchEvent(),o}return o(e,t),e.prototype.patchEvent=function(){if("undefined"!=typeof Event&&Event&&Event.prototype&&!Event.prototype.__zone_symbol__stopImmediatePropagation){var t=Event.prototype.__zone_symbol__stopImmediatePropagation=Event.prototype.stopImmediatePropagation;Event.prototype.stopImmediatePropagation=function(){this&&(this[Ys]=!0),t&&t.apply(this,arguments)}}},e.prototype.supports=function(t){return!0},e.prototype.addEventListener=function(t,e,n){var r=this,o=n;if(!t[Gs]||Vo.isInAngularZone()&&!Js(e))t.addEventListener(e,o,!1);else{var i=Qs[e];i||(i=Qs[e]=qs("ANGULAR"+e+"FALSE"));var a=t[i],s=a&&a.length>0;a||(a=t[i]=[]);var u=Js(e)?Zone.root:Zone.current;if(0===a.length)a.push({zone:u,handler:o});else{for(var c=!1,l=0;l<a.length;l++)if(a[l].handler===o){c=!0;break}c||a.push({zone:u,handler:o})}s||t[Gs](e,$s,!1)}return function(){return r.removeEventListener(t,e,o)}},e.prototype.removeEventListener=function(t,e,n){var r=t[Ks];if(!r)return t.removeEventListener.apply(t,[e,n,!1]);var o=Qs[e],i=o&&t[o];if(!i)return t.removeEventListener.apply(t,[e,n,!1]);for(var a=!1,s=0;s<i.length;s++)if(i[s].handler===n){a=!0,i.splice(s,1);break}a?0===i.length&&r.apply(t,[e,$s,!1]):t.removeEventListener.apply(t,[e,n,!1])},e}(Ps),eu={pan:!0,panstart:!0,panmove:!0,panend:!0,pancancel:!0,panleft:!0,panright:!0,panup:!0,pandown:!0,pinch:!0,pinchstart:!0,pinchmove:!0,pinchend:!0,pinchcancel:!0,pinchin:!0,pinchout:!0,press:!0,pressup:!0,rotate:!0,rotatestart:!0,rotatemove:!0,rotateend:!0,rotatecancel:!0,swipe:!0,swipeleft:!0,swiperight:!0,swipeup:!0,swipedown:!0,tap:!0},nu=new kt("HammerGestureConfig"),ru=new kt("HammerLoader"),ou=function(){function t(){this.events=[],this.overrides={}}return t.prototype.buildHammer=function(t){var e=new Hammer(t,this.options);for(var n in e.get("pinch").set({enable:!0}),e.get("rotate").set({enable:!0}),this.overrides)e.get(n).set(this.overrides[n]);return e},t}(),iu=function(t){function e(e,n,r,o){var i=t.call(this,e)||this;return i._config=n,i.console=r,i.loader=o,i}return o(e,t),e.prototype.supports=function(t){return!(!eu.hasOwnProperty(t.toLowerCase())&&!this.isCustomEvent(t)||!window.Hammer&&!this.loader&&(this.console.warn('The "'+t+'" event cannot be bound because Hammer.JS is not loaded and no custom loader has been specified.'),1))},e.prototype.addEventListener=function(t,e,n){var r=this,o=this.manager.getZone();if(e=e.toLowerCase(),!window.Hammer&&this.loader){var i=!1,a=function(){i=!0};return this.loader().then(function(){if(!window.Hammer)return r.console.warn("The custom HAMMER_LOADER completed, but Hammer.JS is not present."),void(a=function(){});i||(a=r.addEventListener(t,e,n))}).catch(function(){r.console.warn('The "'+e+'" event cannot be bound because the custom Hammer.JS loader failed.'),a=function(){}}),function(){a()}}return o.runOutsideAngular(function(){var i=r._config.buildHammer(t),a=function(t){o.runGuarded(function(){n(t)})};return i.on(e,a),function(){i.off(e,a),"function"==typeof i.destroy&&i.destroy()}})},e.prototype.isCustomEvent=function(t){return this._config.events.indexOf(t)>-1},e}(Ps),au=["alt","control","meta","shift"],su={alt:function(t){return t.altKey},control:function(t){return t.ctrlKey},meta:function(t){return t.metaKey},shift:function(t){return t.shiftKey}},uu=function(t){function e(e){return t.call(this,e)||this}var n;return o(e,t),n=e,e.prototype.supports=function(t){return null!=n.parseEventName(t)},e.prototype.addEventListener=function(t,e,r){var o=n.parseEventName(e),i=n.eventCallback(o.fullKey,r,this.manager.getZone());return this.manager.getZone().runOutsideAngular(function(){return ps().onAndCancel(t,o.domEventName,i)})},e.parseEventName=function(t){var e=t.toLowerCase().split("."),r=e.shift();if(0===e.length||"keydown"!==r&&"keyup"!==r)return null;var o=n._normalizeKey(e.pop()),i="";if(au.forEach(function(t){var n=e.indexOf(t);n>-1&&(e.splice(n,1),i+=t+".")}),i+=o,0!=e.length||0===o.length)return null;var a={};return a.domEventName=r,a.fullKey=i,a},e.getEventFullKey=function(t){var e="",n=ps().getEventKey(t);return" "===(n=n.toLowerCase())?n="space":"."===n&&(n="dot"),au.forEach(function(r){r!=n&&(0,su[r])(t)&&(e+=r+".")}),e+=n},e.eventCallback=function(t,e,r){return function(o){n.getEventFullKey(o)===t&&r.runGuarded(function(){return e(o)})}},e._normalizeKey=function(t){switch(t){case"esc":return"escape";default:return t}},e}(Ps),cu=function(){return function(){}}(),lu=function(t){function e(e){var n=t.call(this)||this;return n._doc=e,n}return o(e,t),e.prototype.sanitize=function(t,e){if(null==e)return null;switch(t){case Ee.NONE:return e;case Ee.HTML:return e instanceof pu?e.changingThisBreaksApplicationSecurity:(this.checkNotSafeValue(e,"HTML"),function(t,e){var n=null;try{se=se||new ee(t);var r=e?String(e):"";n=se.getInertBodyElement(r);var o=5,i=r;do{if(0===o)throw new Error("Failed to sanitize html because the input is unstable");o--,r=i,i=n.innerHTML,n=se.getInertBodyElement(r)}while(r!==i);var a=new ge,s=a.sanitizeChildren(we(n)||n);return te()&&a.sanitizedSomething&&console.warn("WARNING: sanitizing HTML stripped some content, see http://g.co/ng/security#xss"),s}finally{if(n)for(var u=we(n)||n;u.firstChild;)u.removeChild(u.firstChild)}}(this._doc,String(e)));case Ee.STYLE:return e instanceof hu?e.changingThisBreaksApplicationSecurity:(this.checkNotSafeValue(e,"Style"),function(t){if(!(t=String(t).trim()))return"";var e=t.match(Te);return e&&oe(e[1])===e[1]||t.match(xe)&&function(t){for(var e=!0,n=!0,r=0;r<t.length;r++){var o=t.charAt(r);"'"===o&&n?e=!e:'"'===o&&e&&(n=!n)}return e&&n}(t)?t:(te()&&console.warn("WARNING: sanitizing unsafe style value "+t+" (see http://g.co/ng/security#xss)."),"unsafe")}(e));case Ee.SCRIPT:if(e instanceof du)return e.changingThisBreaksApplicationSecurity;throw this.checkNotSafeValue(e,"Script"),new Error("unsafe value used in a script context");case Ee.URL:return e instanceof vu||e instanceof yu?e.changingThisBreaksApplicationSecurity:(this.checkNotSafeValue(e,"URL"),oe(String(e)));case Ee.RESOURCE_URL:if(e instanceof vu)return e.changingThisBreaksApplicationSecurity;throw this.checkNotSafeValue(e,"ResourceURL"),new Error("unsafe value used in a resource URL context (see http://g.co/ng/security#xss)");default:throw new Error("Unexpected SecurityContext "+t+" (see http://g.co/ng/security#xss)")}},e.prototype.checkNotSafeValue=function(t,e){if(t instanceof fu)throw new Error("Required a safe "+e+", got a "+t.getTypeName()+" (see http://g.co/ng/security#xss)")},e.prototype.bypassSecurityTrustHtml=function(t){return new pu(t)},e.prototype.bypassSecurityTrustStyle=function(t){return new hu(t)},e.prototype.bypassSecurityTrustScript=function(t){return new du(t)},e.prototype.bypassSecurityTrustUrl=function(t){return new yu(t)},e.prototype.bypassSecurityTrustResourceUrl=function(t){return new vu(t)},e}(cu),fu=function(){function t(t){this.changingThisBreaksApplicationSecurity=t}return t.prototype.toString=function(){return"SafeValue must use [property]=binding: "+this.changingThisBreaksApplicationSecurity+" (see http://g.co/ng/security#xss)"},t}(),pu=function(t){function e(){return null!==t&&t.apply(this,arguments)||this}return o(e,t),e.prototype.getTypeName=function(){return"HTML"},e}(fu),hu=function(t){function e(){return null!==t&&t.apply(this,arguments)||this}return o(e,t),e.prototype.getTypeName=function(){return"Style"},e}(fu),du=function(t){function e(){return null!==t&&t.apply(this,arguments)||this}return o(e,t),e.prototype.getTypeName=function(){return"Script"},e}(fu),yu=function(t){function e(){return null!==t&&t.apply(this,arguments)||this}return o(e,t),e.prototype.getTypeName=function(){return"URL"},e}(fu),vu=function(t){function e(){return null!==t&&t.apply(this,arguments)||this}return o(e,t),e.prototype.getTypeName=function(){return"ResourceURL"},e}(fu),gu=$o(fi,"browser",[{provide:wo,useValue:"browser"},{provide:_o,useValue:function(){bs.makeCurrent(),Ts.init()},multi:!0},{provide:Za,useClass:Es,deps:[ss]},{provide:ss,useFactory:function(){return document},deps:[]}]);function mu(){return new Xt}var bu=function(){function t(t){if(t)throw new Error("BrowserModule has already been loaded. If you need access to common directives such as NgIf and NgFor from a lazy loaded module, import CommonModule instead.")}var e;return e=t,t.withServerTransition=function(t){return{ngModule:e,providers:[{provide:go,useValue:t.appId},{provide:Ss,useExisting:go},xs]}},t}();"undefined"!=typeof window&&window;var _u=zn({encapsulation:0,styles:[[".wrapper[_ngcontent-%COMP%]{display:-ms-flexbox;display:flex;height:100vh;margin:0 auto;-ms-flex-pack:center;justify-content:center;-ms-flex-direction:column;flex-direction:column;padding:25px}.btn-wrapper[_ngcontent-%COMP%]{position:absolute;width:100%;height:60px;bottom:0;left:0;background:#fff;display:-ms-flexbox;display:flex;-ms-flex-pack:end;justify-content:flex-end;-ms-flex-align:end;align-items:flex-end;text-align:center;padding-right:25px;padding-bottom:10px}.accept-btn-wrapper[_ngcontent-%COMP%]{display:-ms-flexbox;display:flex;-ms-flex-direction:column;flex-direction:column}.accept-btn-wrapper.disabled[_ngcontent-%COMP%]:hover   .accept-btn-tooltip[_ngcontent-%COMP%]{opacity:1;margin-bottom:10px}.accept-btn-wrapper.disabled[_ngcontent-%COMP%]   .accept-btn[_ngcontent-%COMP%]{background-color:#ccc;color:#666;border:1px solid #ccc;cursor:not-allowed}.accept-btn[_ngcontent-%COMP%]{padding:10px 25px;background:#3ac7d3;cursor:pointer;color:#fff;border-radius:4px;border:1px solid #3ac7d3;transition:background .3s,color .3s,border .3s;-ms-flex-item-align:end;align-self:flex-end}.accept-btn-tooltip[_ngcontent-%COMP%]{margin-bottom:0;opacity:0;background-color:#555;color:#fff;border-radius:6px;padding:10px;transition:margin .3s,opacity .3s}.conditions[_ngcontent-%COMP%]{padding:25px 25px 75px;overflow:auto;height:calc(100vh - 60px);position:absolute;top:0;left:0;width:100%;border-bottom:1px solid rgba(58,199,211,.9)}.condition[_ngcontent-%COMP%]{width:100%;min-height:100px;box-shadow:rgba(100,100,111,.2) 0 7px 29px 0;margin-top:25px;margin-bottom:25px;padding:25px;background-color:#fff;border-radius:6px}"]],data:{animation:[{type:7,name:"fadeInList",definitions:[{type:1,expr:"* => *",animation:[{type:11,selector:":leave",animation:[{type:12,timings:100,animation:[{type:4,styles:{type:6,styles:{opacity:0},offset:null},timings:"0.5s"}]}],options:{optional:!0}},{type:11,selector:":enter",animation:[{type:6,styles:{opacity:0},offset:null},{type:12,timings:100,animation:[{type:4,styles:{type:6,styles:{opacity:1},offset:null},timings:"0.5s"}]}],options:{optional:!0}}],options:null}],options:{}}]}});function wu(t){return Di(0,[(t()(),bi(0,0,null,null,2,"div",[["class","condition"]],null,null,null,null,null)),(t()(),bi(1,0,null,null,0,"h3",[],[[8,"innerHTML",1]],null,null,null,null)),(t()(),bi(2,0,null,null,0,"p",[],[[8,"innerHTML",1]],null,null,null,null))],null,function(t,e){var n=e.component;t(e,1,0,n.sanitizer.bypassSecurityTrustHtml(e.context.$implicit.title)),t(e,2,0,n.sanitizer.bypassSecurityTrustHtml(e.context.$implicit.body))})}function Eu(t){return Di(0,[(t()(),bi(0,0,null,null,0,"div",[["class","accept-btn-tooltip"]],[[8,"innerHTML",1]],null,null,null,null))],null,function(t,e){var n=e.component;t(e,0,0,n.sanitizer.bypassSecurityTrustHtml(n.installer.texts.readAllConditionsTooltip))})}function Su(t){return Di(0,[(t()(),bi(0,0,null,null,10,"div",[["class","wrapper"]],null,null,null,null,null)),(t()(),bi(1,0,[[1,0],["conditions",1]],null,4,"div",[["class","conditions"]],null,null,null,null,null)),(t()(),bi(2,0,null,null,0,"h1",[],[[8,"innerHTML",1]],null,null,null,null)),(t()(),bi(3,0,null,null,2,"div",[],[[24,"@fadeInList",0]],null,null,null,null)),(t()(),mi(16777216,null,null,1,null,wu)),Xr(5,278528,null,0,es,[xn,En,yn],{ngForOf:[0,"ngForOf"]},null),(t()(),bi(6,0,null,null,4,"div",[["class","btn-wrapper"]],null,null,null,null,null)),(t()(),bi(7,0,null,null,3,"div",[["class","accept-btn-wrapper"]],[[2,"disabled",null]],null,null,null,null)),(t()(),mi(16777216,null,null,1,null,Eu)),Xr(9,16384,null,0,rs,[xn,En],{ngIf:[0,"ngIf"]},null),(t()(),bi(10,0,null,null,0,"div",[["class","accept-btn"]],[[8,"innerHTML",1]],[[null,"click"]],function(t,e,n){var r=!0;return"click"===e&&(r=!1!==t.component.onAccept()&&r),r},null,null))],function(t,e){var n=e.component;t(e,5,0,n.installer.conditions),t(e,9,0,!n.isRead&&n.installer.mustReadAllConditions)},function(t,e){var n=e.component;t(e,2,0,n.sanitizer.bypassSecurityTrustHtml(n.installer.title)),t(e,3,0,n.installer.conditions.length),t(e,7,0,!n.isRead&&n.installer.mustReadAllConditions),t(e,10,0,n.sanitizer.bypassSecurityTrustHtml(n.installer.texts.acceptButton))})}function xu(t){return Di(0,[xi(671088640,1,{conditions:0}),(t()(),mi(16777216,null,null,1,null,Su)),Xr(2,16384,null,0,rs,[xn,En],{ngIf:[0,"ngIf"]},null)],function(t,e){t(e,2,0,e.component.installer)},null)}var Tu=function(){function t(t,e){var n=this;this.sanitizer=t,this.installerService=e,this.loadAnimation=!1,e.getInstaller().then(function(t){n.installer=t,n.processEachStep()})}return t.prototype.ngOnInit=function(){this.preloadPage()},t.prototype.preloadPage=function(){return u(this,void 0,void 0,function(){return c(this,function(t){switch(t.label){case 0:return[4,this.sleep(100)];case 1:return t.sent(),this.loadAnimation=!0,[2]}})})},t.prototype.processEachStep=function(t){return void 0===t&&(t=0),u(this,void 0,void 0,function(){return c(this,function(e){switch(e.label){case 0:if(this.noStepsRemaining(t))return[2,this.installer.status=za.DoneWithoutError];this.setUpcomingStep(t),e.label=1;case 1:return e.trys.push([1,3,,4]),[4,this.processStepAtIndex(t)];case 2:return e.sent(),[3,4];case 3:return e.sent(),[2,this.installer.status=za.DoneWithError];case 4:return[2,this.processEachStep(t+1)]}})})},t.prototype.setUpcomingStep=function(t){return this.upcomingStep=this.noUpcomingSteps(t)?null:this.installer.steps[t+1]},t.prototype.noStepsRemaining=function(t){return t===this.installer.steps.length},t.prototype.noUpcomingSteps=function(t){return t+1>=this.installer.steps.length},t.prototype.processStepAtIndex=function(t){return u(this,void 0,void 0,function(){var e;return c(this,function(n){switch(n.label){case 0:this.runningStep=e=this.installer.steps[t],n.label=1;case 1:return n.trys.push([1,3,,4]),[4,this.installStep(t)];case 2:return n.sent(),[3,4];case 3:throw n.sent();case 4:return e.done=!0,[4,this.sleep(1e3)];case 5:return n.sent(),this.lastDoneStep=e,[2]}})})},t.prototype.sleep=function(t){return new Promise(function(e){return setTimeout(e,t)})},t.prototype.installStep=function(t){return u(this,void 0,void 0,function(){var e;return c(this,function(n){switch(n.label){case 0:return n.trys.push([0,2,,3]),[4,window.backend.wailsBind.InstallStep(t)];case 1:return n.sent(),[3,3];case 2:throw e=n.sent(),this.installerService.errMsg=e,e;case 3:return[2]}})})},t}(),ku=zn({encapsulation:0,styles:[[".wrapper[_ngcontent-%COMP%]{height:100vh;width:100%;overflow:hidden}.anchor[_ngcontent-%COMP%]{position:relative;top:50%;left:50%;-webkit-transform:translate(-50%,-50%);-ms-transform:translate(-50%,-50%);transform:translate(-50%,-50%);width:80%}.anchor-layer[_ngcontent-%COMP%]{opacity:0}.anchor-layer[_ngcontent-%COMP%], .process[_ngcontent-%COMP%]{width:100%;background-color:#fff;border-radius:6px;box-shadow:rgba(100,100,111,.2) 0 7px 29px 0;color:#495966;display:-ms-flexbox;display:flex;-ms-flex-align:center;align-items:center}.process[_ngcontent-%COMP%]{position:absolute;opacity:0;transition:opacity .3s,top .5s,left .5s;-webkit-transform:translate3d(0,0,0);transform:translate3d(0,0,0);left:0;top:0}.far-upcoming[_ngcontent-%COMP%]{top:100%;left:-10%}.long-done[_ngcontent-%COMP%]{top:-100%;left:-10%}.process.done[_ngcontent-%COMP%]{opacity:.7;top:-75px;left:-20px;z-index:1}.process.upcoming[_ngcontent-%COMP%]{top:75%;left:-20px;z-index:0;opacity:.4}.process.running[_ngcontent-%COMP%]{opacity:1;z-index:2}.status-icon[_ngcontent-%COMP%]{background-position-x:center;background-position-y:center;background-size:contain;width:40px;height:40px}.status-icon.upcoming[_ngcontent-%COMP%]{background-image:url('data:image/png;base64, iVBORw0KGgoAAAANSUhEUgAAADAAAAAwCAYAAABXAvmHAAAAaUlEQVRoge3WQQqAIABE0TmX9wcP4IFsFUTkQrKGkf/AdfNBQgkAAAAATEpt3b3hlVJbj444A2IjrgGREfeAuIingKURow/8ceIDlkQQ4L5CX4seL234G3XvmRY9XtrkMefeAAAAACDTAcYM2ojNfB3lAAAAAElFTkSuQmCC')}.status-icon.done[_ngcontent-%COMP%]{background-image:url('data:image/png;base64, iVBORw0KGgoAAAANSUhEUgAAADAAAAAwCAYAAABXAvmHAAAAfklEQVRoge3SwQmAMBBE0dSV/iEFWJCe5iIoJBFmR/5rYP/AtgYAAPA/fRxnH8fp7lii+MgR9/ioEU/xEQOIdyHehXgX4l1s8V8cKhG/erBU/Oxh+8/vBNjjd0LKxK8ElYufCSsbL2+B5eMlOl6i4yU6XqLjJTpeouMBAMCGCw3HibHxitv9AAAAAElFTkSuQmCC')}.status-wrapper[_ngcontent-%COMP%]{padding:20px}.loader[_ngcontent-%COMP%]{border:4px solid #f3f3f3;border-top:4px solid #3ac7d3;border-radius:50%;height:40px;width:40px;-webkit-animation:2s linear infinite spin;animation:2s linear infinite spin}@-webkit-keyframes spin{0%{-webkit-transform:rotate(0);transform:rotate(0)}100%{-webkit-transform:rotate(360deg);transform:rotate(360deg)}}@keyframes spin{0%{-webkit-transform:rotate(0);transform:rotate(0)}100%{-webkit-transform:rotate(360deg);transform:rotate(360deg)}}.description[_ngcontent-%COMP%]{position:relative;-ms-flex-preferred-size:100%;flex-basis:100%;padding:20px 20px 20px 0}.index[_ngcontent-%COMP%]{position:absolute;color:#c1c1c1;top:15px;right:15px}.first-appear[_ngcontent-%COMP%]{top:75%!important;left:-20px!important;opacity:0!important}"]],data:{}});function Au(t){return Di(0,[(t()(),bi(0,0,null,null,0,"div",[["class","loader"]],null,null,null,null,null))],null,null)}function Iu(t){return Di(0,[(t()(),bi(0,0,null,null,0,"div",[["class","status-icon"]],[[2,"done",null],[2,"upcoming",null]],null,null,null,null))],null,function(t,e){t(e,0,0,e.parent.context.$implicit.done,e.parent.context.$implicit===e.component.upcomingStep)})}function Cu(t){return Di(0,[(t()(),bi(0,0,null,null,9,"div",[["class","process"]],[[2,"long-done",null],[2,"done",null],[2,"running",null],[2,"upcoming",null],[2,"far-upcoming",null],[2,"first-appear",null]],null,null,null,null)),(t()(),bi(1,0,null,null,4,"div",[["class","status-wrapper"]],null,null,null,null,null)),(t()(),mi(16777216,null,null,1,null,Au)),Xr(3,16384,null,0,rs,[xn,En],{ngIf:[0,"ngIf"]},null),(t()(),mi(16777216,null,null,1,null,Iu)),Xr(5,16384,null,0,rs,[xn,En],{ngIf:[0,"ngIf"]},null),(t()(),bi(6,0,null,null,3,"div",[["class","description"]],null,null,null,null,null)),(t()(),bi(7,0,null,null,1,"div",[["class","index"]],null,null,null,null,null)),(t()(),Oi(8,null,["","/",""])),(t()(),bi(9,0,null,null,0,"p",[],[[8,"innerHTML",1]],null,null,null,null))],function(t,e){var n=e.component;t(e,3,0,e.context.$implicit===n.runningStep&&!e.context.$implicit.done),t(e,5,0,e.context.$implicit!==n.runningStep||e.context.$implicit.done)},function(t,e){var n=e.component;t(e,0,0,e.context.$implicit.done&&e.context.$implicit!==n.lastDoneStep&&e.context.$implicit!==n.runningStep,e.context.$implicit===n.lastDoneStep,e.context.$implicit===n.runningStep,e.context.$implicit===n.upcomingStep,!e.context.$implicit.done&&e.context.$implicit!==n.upcomingStep&&e.context.$implicit!==n.runningStep,!n.loadAnimation),t(e,8,0,e.context.index+1,n.installer.steps.length),t(e,9,0,n.sanitizer.bypassSecurityTrustHtml(e.context.$implicit.description))})}function Ou(t){return Di(0,[(t()(),bi(0,0,null,null,6,"div",[["class","anchor"]],null,null,null,null,null)),(t()(),bi(1,0,null,null,3,"div",[["class","anchor-layer"]],null,null,null,null,null)),(t()(),bi(2,0,null,null,0,"div",[["class","status-icon"]],null,null,null,null,null)),(t()(),bi(3,0,null,null,1,"div",[["class","description"]],null,null,null,null,null)),(t()(),bi(4,0,null,null,0,"p",[],[[8,"innerHTML",1]],null,null,null,null)),(t()(),mi(16777216,null,null,1,null,Cu)),Xr(6,278528,null,0,es,[xn,En,yn],{ngForOf:[0,"ngForOf"]},null)],function(t,e){t(e,6,0,e.component.installer.steps)},function(t,e){var n=e.component;t(e,4,0,n.sanitizer.bypassSecurityTrustHtml(n.runningStep.description))})}function Pu(t){return Di(0,[(t()(),bi(0,0,null,null,2,"div",[["class","wrapper"]],null,null,null,null,null)),(t()(),mi(16777216,null,null,1,null,Ou)),Xr(2,16384,null,0,rs,[xn,En],{ngIf:[0,"ngIf"]},null)],function(t,e){var n=e.component;t(e,2,0,n.installer&&n.runningStep)},null)}var Ru=function(){function t(t,e){var n=this;this.sanitizer=t,this.expanded=!1,this.successMessageLoaded=!1,e.getInstaller().then(function(t){n.installer=t})}return t.prototype.ngAfterViewInit=function(){var t=this;setTimeout(function(){t.successMessageLoaded=!0},100)},t.prototype.toggleExpand=function(){this.expanded=!this.expanded},t}(),Du=zn({encapsulation:0,styles:[[".wrapper[_ngcontent-%COMP%]{height:100vh;padding:50px 50px 100px;overflow:auto}.success-msg[_ngcontent-%COMP%]{margin-bottom:25px;opacity:0;margin-top:75px;transition:opacity .6s,margin-top .6s}.success-msg.loaded[_ngcontent-%COMP%]{margin-top:25px;opacity:1}.step-history[_ngcontent-%COMP%], .success-msg[_ngcontent-%COMP%]{position:relative;width:100%;min-height:100px;box-shadow:rgba(100,100,111,.2) 0 7px 29px 0;padding:25px;background-color:#fff;border-radius:6px}.step-history[_ngcontent-%COMP%]{margin-bottom:25px}.success-icon[_ngcontent-%COMP%]{background-image:url('data:image/png;base64, iVBORw0KGgoAAAANSUhEUgAAADAAAAAwCAYAAABXAvmHAAAAfklEQVRoge3SwQmAMBBE0dSV/iEFWJCe5iIoJBFmR/5rYP/AtgYAAPA/fRxnH8fp7lii+MgR9/ioEU/xEQOIdyHehXgX4l1s8V8cKhG/erBU/Oxh+8/vBNjjd0LKxK8ElYufCSsbL2+B5eMlOl6i4yU6XqLjJTpeouMBAMCGCw3HibHxitv9AAAAAElFTkSuQmCC');background-size:contain;background-repeat:no-repeat;position:absolute;top:-25px;left:50%;-webkit-transform:translateX(-50%);-ms-transform:translateX(-50%);transform:translateX(-50%);width:50px;height:50px;background-color:#fff;border-radius:50%;border:1px solid #3ac7d3}.see-more[_ngcontent-%COMP%]{background-image:url(data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAEgAAABICAYAAABV7bNHAAAAa0lEQVR4nO3QwQ2AMAwDwK7HCCzA/hvABrRIfrjoTsrXcjwGAAAAAAAAAAA/dJzX/Xa75kTMyqyWasuJWC0zK9WWE9P2mIEM1JET0/aYgQzUkRPT9ljdQF9K7ZYTlSrTlgMAAAAAAAAAwE4eiNUjAuDVNJ0AAAAASUVORK5CYII=);height:20px;width:50px;background-size:50%;background-repeat:no-repeat;cursor:pointer}.description[_ngcontent-%COMP%]{font-size:12px;padding-top:20px;padding-bottom:20px;border-bottom:1px solid rgba(0,0,0,.2);font-style:italic}"]],data:{animation:[{type:7,name:"fadeIn",definitions:[{type:0,name:"in",styles:{type:6,styles:{opacity:1},offset:null},options:void 0},{type:1,expr:":enter",animation:[{type:6,styles:{opacity:0},offset:null},{type:4,styles:null,timings:300}],options:null},{type:1,expr:":leave",animation:{type:4,styles:{type:6,styles:{opacity:0},offset:null},timings:300},options:null}],options:{}}]}});function Nu(t){return Di(0,[(t()(),bi(0,0,null,null,0,"div",[["class","description"]],[[8,"innerHTML",1]],null,null,null,null))],null,function(t,e){t(e,0,0,e.component.sanitizer.bypassSecurityTrustHtml(e.context.$implicit.description))})}function Mu(t){return Di(0,[(t()(),bi(0,0,null,null,3,"div",[["class","step-history"]],[[24,"@fadeIn",0]],null,null,null,null)),(t()(),bi(1,0,null,null,0,"h3",[],[[8,"innerHTML",1]],null,null,null,null)),(t()(),mi(16777216,null,null,1,null,Nu)),Xr(3,278528,null,0,es,[xn,En,yn],{ngForOf:[0,"ngForOf"]},null)],function(t,e){t(e,3,0,e.component.installer.steps)},function(t,e){var n=e.component;t(e,0,0,"in"),t(e,1,0,n.sanitizer.bypassSecurityTrustHtml(n.installer.texts.completedSteps))})}function ju(t){return Di(0,[(t()(),bi(0,0,null,null,6,"div",[["class","wrapper"]],null,null,null,null,null)),(t()(),bi(1,0,null,null,3,"div",[["class","success-msg"]],[[2,"loaded",null]],null,null,null,null)),(t()(),bi(2,0,null,null,0,"div",[["class","success-icon"]],null,null,null,null,null)),(t()(),bi(3,0,null,null,0,"p",[],[[8,"innerHTML",1]],null,null,null,null)),(t()(),bi(4,0,null,null,0,"div",[["class","see-more"]],null,[[null,"click"]],function(t,e,n){var r=!0;return"click"===e&&(r=!1!==t.component.toggleExpand()&&r),r},null,null)),(t()(),mi(16777216,null,null,1,null,Mu)),Xr(6,16384,null,0,rs,[xn,En],{ngIf:[0,"ngIf"]},null)],function(t,e){t(e,6,0,e.component.expanded)},function(t,e){var n=e.component;t(e,1,0,n.successMessageLoaded),t(e,3,0,n.sanitizer.bypassSecurityTrustHtml(n.installer.texts.success))})}function Fu(t){return Di(0,[(t()(),mi(16777216,null,null,1,null,ju)),Xr(1,16384,null,0,rs,[xn,En],{ngIf:[0,"ngIf"]},null)],function(t,e){t(e,1,0,e.component.installer)},null)}var Lu=function(){function t(t,e){var n=this;this.sanitizer=t,this.msgLoaded=!1,e.getInstaller().then(function(t){n.installer=t,n.errMsg=e.errMsg})}return t.prototype.ngAfterViewInit=function(){var t=this;setTimeout(function(){t.msgLoaded=!0},100)},t}(),Bu=zn({encapsulation:0,styles:[[".wrapper[_ngcontent-%COMP%]{height:100vh;margin:0 auto;padding:50px 50px 100px}.fail-msg[_ngcontent-%COMP%]{margin-bottom:25px;opacity:0;margin-top:75px;transition:opacity .6s,margin-top .6s;position:relative;width:100%;min-height:100px;box-shadow:rgba(100,100,111,.2) 0 7px 29px 0;padding:25px;background-color:#fff;border-radius:6px}.fail-msg.loaded[_ngcontent-%COMP%]{margin-top:25px;opacity:1}"]],data:{}});function Vu(t){return Di(0,[(t()(),bi(0,0,null,null,4,"div",[["class","fail-msg"]],[[2,"loaded",null]],null,null,null,null)),(t()(),bi(1,0,null,null,0,"div",[["class","fail-icon"]],null,null,null,null,null)),(t()(),bi(2,0,null,null,0,"p",[],[[8,"innerHTML",1]],null,null,null,null)),(t()(),bi(3,0,null,null,1,"i",[],null,null,null,null,null)),(t()(),Oi(4,null,["",""]))],null,function(t,e){var n=e.component;t(e,0,0,n.msgLoaded),t(e,2,0,n.sanitizer.bypassSecurityTrustHtml(n.installer.texts.fail)),t(e,4,0,n.errMsg||"undefined")})}function Hu(t){return Di(0,[(t()(),bi(0,0,null,null,2,"div",[["class","wrapper"]],null,null,null,null,null)),(t()(),mi(16777216,null,null,1,null,Vu)),Xr(2,16384,null,0,rs,[xn,En],{ngIf:[0,"ngIf"]},null)],function(t,e){t(e,2,0,e.component.installer)},null)}var zu=zn({encapsulation:0,styles:[[".wrapper[_ngcontent-%COMP%]{height:100vh;width:100%;background:#ebf1f5}"]],data:{}});function Uu(t){return Di(0,[(t()(),bi(0,0,null,null,1,"app-conditions",[],null,null,null,xu,_u)),Xr(1,4243456,null,0,cs,[cu],{installer:[0,"installer"]},null)],function(t,e){t(e,1,0,e.component.installer)},null)}function Wu(t){return Di(0,[(t()(),bi(0,0,null,null,1,"app-steps",[],null,null,null,Pu,ku)),Xr(1,114688,null,0,Tu,[cu,Ua],null,null)],function(t,e){t(e,1,0)},null)}function Zu(t){return Di(0,[(t()(),bi(0,0,null,null,1,"app-success",[],null,null,null,Fu,Du)),Xr(1,4243456,null,0,Ru,[cu,Ua],null,null)],null,null)}function qu(t){return Di(0,[(t()(),bi(0,0,null,null,1,"app-fail",[],null,null,null,Hu,Bu)),Xr(1,4243456,null,0,Lu,[cu,Ua],null,null)],null,null)}function Gu(t){return Di(0,[(t()(),bi(0,0,null,null,8,"div",[["class","wrapper"]],null,null,null,null,null)),(t()(),mi(16777216,null,null,1,null,Uu)),Xr(2,16384,null,0,rs,[xn,En],{ngIf:[0,"ngIf"]},null),(t()(),mi(16777216,null,null,1,null,Wu)),Xr(4,16384,null,0,rs,[xn,En],{ngIf:[0,"ngIf"]},null),(t()(),mi(16777216,null,null,1,null,Zu)),Xr(6,16384,null,0,rs,[xn,En],{ngIf:[0,"ngIf"]},null),(t()(),mi(16777216,null,null,1,null,qu)),Xr(8,16384,null,0,rs,[xn,En],{ngIf:[0,"ngIf"]},null)],function(t,e){var n=e.component;t(e,2,0,n.installer.status===n.Status.NotAccepted),t(e,4,0,n.installer.status===n.Status.Processing),t(e,6,0,n.installer.status===n.Status.DoneWithoutError),t(e,8,0,n.installer.status===n.Status.DoneWithError)},null)}function Ku(t){return Di(0,[(t()(),mi(16777216,null,null,1,null,Gu)),Xr(1,16384,null,0,rs,[xn,En],{ngIf:[0,"ngIf"]},null)],function(t,e){t(e,1,0,e.component.installer)},null)}function Qu(t){return Di(0,[(t()(),bi(0,0,null,null,1,"div",[["id","app"]],null,null,null,Ku,zu)),Xr(1,49152,null,0,Wa,[Ua],null,null)],null,null)}var Yu=Ir('[id="app"]',Wa,Qu,{},{},[]),Xu=function(){return function(){}}(),Ju=function(){return function(){}}(),$u="*";function tc(t,e){return void 0===e&&(e=null),{type:2,steps:t,options:e}}function ec(t){return{type:6,styles:t,offset:null}}function nc(t){Promise.resolve(null).then(t)}var rc=function(){function t(t,e){void 0===t&&(t=0),void 0===e&&(e=0),this._onDoneFns=[],this._onStartFns=[],this._onDestroyFns=[],this._started=!1,this._destroyed=!1,this._finished=!1,this.parentPlayer=null,this.totalTime=t+e}return t.prototype._onFinish=function(){this._finished||(this._finished=!0,this._onDoneFns.forEach(function(t){return t()}),this._onDoneFns=[])},t.prototype.onStart=function(t){this._onStartFns.push(t)},t.prototype.onDone=function(t){this._onDoneFns.push(t)},t.prototype.onDestroy=function(t){this._onDestroyFns.push(t)},t.prototype.hasStarted=function(){return this._started},t.prototype.init=function(){},t.prototype.play=function(){this.hasStarted()||(this._onStart(),this.triggerMicrotask()),this._started=!0},t.prototype.triggerMicrotask=function(){var t=this;nc(function(){return t._onFinish()})},t.prototype._onStart=function(){this._onStartFns.forEach(function(t){return t()}),this._onStartFns=[]},t.prototype.pause=function(){},t.prototype.restart=function(){},t.prototype.finish=function(){this._onFinish()},t.prototype.destroy=function(){this._destroyed||(this._destroyed=!0,this.hasStarted()||this._onStart(),this.finish(),this._onDestroyFns.forEach(function(t){return t()}),this._onDestroyFns=[])},t.prototype.reset=function(){},t.prototype.setPosition=function(t){},t.prototype.getPosition=function(){return 0},t.prototype.triggerCallback=function(t){var e="start"==t?this._onStartFns:this._onDoneFns;e.forEach(function(t){return t()}),e.length=0},t}(),oc=function(){function t(t){var e=this;this._onDoneFns=[],this._onStartFns=[],this._finished=!1,this._started=!1,this._destroyed=!1,this._onDestroyFns=[],this.parentPlayer=null,this.totalTime=0,this.players=t;var n=0,r=0,o=0,i=this.players.length;0==i?nc(function(){return e._onFinish()}):this.players.forEach(function(t){t.onDone(function(){++n==i&&e._onFinish()}),t.onDestroy(function(){++r==i&&e._onDestroy()}),t.onStart(function(){++o==i&&e._onStart()})}),this.totalTime=this.players.reduce(function(t,e){return Math.max(t,e.totalTime)},0)}return t.prototype._onFinish=function(){this._finished||(this._finished=!0,this._onDoneFns.forEach(function(t){return t()}),this._onDoneFns=[])},t.prototype.init=function(){this.players.forEach(function(t){return t.init()})},t.prototype.onStart=function(t){this._onStartFns.push(t)},t.prototype._onStart=function(){this.hasStarted()||(this._started=!0,this._onStartFns.forEach(function(t){return t()}),this._onStartFns=[])},t.prototype.onDone=function(t){this._onDoneFns.push(t)},t.prototype.onDestroy=function(t){this._onDestroyFns.push(t)},t.prototype.hasStarted=function(){return this._started},t.prototype.play=function(){this.parentPlayer||this.init(),this._onStart(),this.players.forEach(function(t){return t.play()})},t.prototype.pause=function(){this.players.forEach(function(t){return t.pause()})},t.prototype.restart=function(){this.players.forEach(function(t){return t.restart()})},t.prototype.finish=function(){this._onFinish(),this.players.forEach(function(t){return t.finish()})},t.prototype.destroy=function(){this._onDestroy()},t.prototype._onDestroy=function(){this._destroyed||(this._destroyed=!0,this._onFinish(),this.players.forEach(function(t){return t.destroy()}),this._onDestroyFns.forEach(function(t){return t()}),this._onDestroyFns=[])},t.prototype.reset=function(){this.players.forEach(function(t){return t.reset()}),this._destroyed=!1,this._finished=!1,this._started=!1},t.prototype.setPosition=function(t){var e=t*this.totalTime;this.players.forEach(function(t){var n=t.totalTime?Math.min(1,e/t.totalTime):1;t.setPosition(n)})},t.prototype.getPosition=function(){var t=0;return this.players.forEach(function(e){var n=e.getPosition();t=Math.min(n,t)}),t},t.prototype.beforeDestroy=function(){this.players.forEach(function(t){t.beforeDestroy&&t.beforeDestroy()})},t.prototype.triggerCallback=function(t){var e="start"==t?this._onStartFns:this._onDoneFns;e.forEach(function(t){return t()}),e.length=0},t}(),ic="!";function ac(){return"undefined"!=typeof process}function sc(t){switch(t.length){case 0:return new rc;case 1:return t[0];default:return new oc(t)}}function uc(t,e,n,r,o,i){void 0===o&&(o={}),void 0===i&&(i={});var a=[],s=[],u=-1,c=null;if(r.forEach(function(t){var n=t.offset,r=n==u,l=r&&c||{};Object.keys(t).forEach(function(n){var r=n,s=t[n];if("offset"!==n)switch(r=e.normalizePropertyName(r,a),s){case ic:s=o[n];break;case $u:s=i[n];break;default:s=e.normalizeStyleValue(n,r,s,a)}l[r]=s}),r||s.push(l),c=l,u=n}),a.length)throw new Error("Unable to animate due to the following errors:\n - "+a.join("\n - "));return s}function cc(t,e,n,r){switch(e){case"start":t.onStart(function(){return r(n&&lc(n,"start",t))});break;case"done":t.onDone(function(){return r(n&&lc(n,"done",t))});break;case"destroy":t.onDestroy(function(){return r(n&&lc(n,"destroy",t))})}}function lc(t,e,n){var r=n.totalTime,o=fc(t.element,t.triggerName,t.fromState,t.toState,e||t.phaseName,null==r?t.totalTime:r,!!n.disabled),i=t._data;return null!=i&&(o._data=i),o}function fc(t,e,n,r,o,i,a){return void 0===o&&(o=""),void 0===i&&(i=0),{element:t,triggerName:e,fromState:n,toState:r,phaseName:o,totalTime:i,disabled:!!a}}function pc(t,e,n){var r;return t instanceof Map?(r=t.get(e))||t.set(e,r=n):(r=t[e])||(r=t[e]=n),r}function hc(t){var e=t.indexOf(":");return[t.substring(1,e),t.substr(e+1)]}var dc=function(t,e){return!1},yc=function(t,e){return!1},vc=function(t,e,n){return[]},gc=ac();(gc||"undefined"!=typeof Element)&&(dc=function(t,e){return t.contains(e)},yc=function(){if(gc||Element.prototype.matches)return function(t,e){return t.matches(e)};var t=Element.prototype,e=t.matchesSelector||t.mozMatchesSelector||t.msMatchesSelector||t.oMatchesSelector||t.webkitMatchesSelector;return e?function(t,n){return e.apply(t,[n])}:yc}(),vc=function(t,e,n){var r=[];if(n)r.push.apply(r,p(t.querySelectorAll(e)));else{var o=t.querySelector(e);o&&r.push(o)}return r});var mc=null,bc=!1;function _c(t){mc||(mc=("undefined"!=typeof document?document.body:null)||{},bc=!!mc.style&&"WebkitAppearance"in mc.style);var e=!0;return mc.style&&!function(t){return"ebkit"==t.substring(1,6)}(t)&&!(e=t in mc.style)&&bc&&(e="Webkit"+t.charAt(0).toUpperCase()+t.substr(1)in mc.style),e}var wc=yc,Ec=dc,Sc=vc;function xc(t){var e={};return Object.keys(t).forEach(function(n){var r=n.replace(/([a-z])([A-Z])/g,"$1-$2");e[r]=t[n]}),e}var Tc=function(){function t(){}return t.prototype.validateStyleProperty=function(t){return _c(t)},t.prototype.matchesElement=function(t,e){return wc(t,e)},t.prototype.containsElement=function(t,e){return Ec(t,e)},t.prototype.query=function(t,e,n){return Sc(t,e,n)},t.prototype.computeStyle=function(t,e,n){return n||""},t.prototype.animate=function(t,e,n,r,o,i,a){return void 0===i&&(i=[]),new rc(n,r)},t}(),kc=function(){function t(){}return t.NOOP=new Tc,t}(),Ac=1e3;function Ic(t){if("number"==typeof t)return t;var e=t.match(/^(-?[\.\d]+)(m?s)/);return!e||e.length<2?0:Cc(parseFloat(e[1]),e[2])}function Cc(t,e){switch(e){case"s":return t*Ac;default:return t}}function Oc(t,e,n){return t.hasOwnProperty("duration")?t:function(t,e,n){var r,o=0,i="";if("string"==typeof t){var a=t.match(/^(-?[\.\d]+)(m?s)(?:\s+(-?[\.\d]+)(m?s))?(?:\s+([-a-z]+(?:\(.+?\))?))?$/i);if(null===a)return e.push('The provided timing value "'+t+'" is invalid.'),{duration:0,delay:0,easing:""};r=Cc(parseFloat(a[1]),a[2]);var s=a[3];null!=s&&(o=Cc(parseFloat(s),a[4]));var u=a[5];u&&(i=u)}else r=t;if(!n){var c=!1,l=e.length;r<0&&(e.push("Duration values below 0 are not allowed for this animation step."),c=!0),o<0&&(e.push("Delay values below 0 are not allowed for this animation step."),c=!0),c&&e.splice(l,0,'The provided timing value "'+t+'" is invalid.')}return{duration:r,delay:o,easing:i}}(t,e,n)}function Pc(t,e){return void 0===e&&(e={}),Object.keys(t).forEach(function(n){e[n]=t[n]}),e}function Rc(t,e,n){if(void 0===n&&(n={}),e)for(var r in t)n[r]=t[r];else Pc(t,n);return n}function Dc(t,e,n){return n?e+":"+n+";":""}function Nc(t){for(var e="",n=0;n<t.style.length;n++)e+=Dc(0,r=t.style.item(n),t.style.getPropertyValue(r));for(var r in t.style)t.style.hasOwnProperty(r)&&!r.startsWith("_")&&(e+=Dc(0,r.replace(/([a-z])([A-Z])/g,"$1-$2").toLowerCase(),t.style[r]));t.setAttribute("style",e)}function Mc(t,e,n){t.style&&(Object.keys(e).forEach(function(r){var o=Uc(r);n&&!n.hasOwnProperty(r)&&(n[r]=t.style[o]),t.style[o]=e[r]}),ac()&&Nc(t))}function jc(t,e){t.style&&(Object.keys(e).forEach(function(e){var n=Uc(e);t.style[n]=""}),ac()&&Nc(t))}function Fc(t){return Array.isArray(t)?1==t.length?t[0]:tc(t):t}var Lc=new RegExp("{{\\s*(.+?)\\s*}}","g");function Bc(t){var e=[];if("string"==typeof t){for(var n=t.toString(),r=void 0;r=Lc.exec(n);)e.push(r[1]);Lc.lastIndex=0}return e}function Vc(t,e,n){var r=t.toString(),o=r.replace(Lc,function(t,r){var o=e[r];return e.hasOwnProperty(r)||(n.push("Please provide a value for the animation param "+r),o=""),o.toString()});return o==r?t:o}function Hc(t){for(var e=[],n=t.next();!n.done;)e.push(n.value),n=t.next();return e}var zc=/-+([a-z0-9])/g;function Uc(t){return t.replace(zc,function(){for(var t=[],e=0;e<arguments.length;e++)t[e]=arguments[e];return t[1].toUpperCase()})}function Wc(t,e){return 0===t||0===e}function Zc(t,e,n){var r=Object.keys(n);if(r.length&&e.length){var o=e[0],i=[];if(r.forEach(function(t){o.hasOwnProperty(t)||i.push(t),o[t]=n[t]}),i.length)for(var a=function(){var n=e[s];i.forEach(function(e){n[e]=Gc(t,e)})},s=1;s<e.length;s++)a()}return e}function qc(t,e,n){switch(e.type){case 7:return t.visitTrigger(e,n);case 0:return t.visitState(e,n);case 1:return t.visitTransition(e,n);case 2:return t.visitSequence(e,n);case 3:return t.visitGroup(e,n);case 4:return t.visitAnimate(e,n);case 5:return t.visitKeyframes(e,n);case 6:return t.visitStyle(e,n);case 8:return t.visitReference(e,n);case 9:return t.visitAnimateChild(e,n);case 10:return t.visitAnimateRef(e,n);case 11:return t.visitQuery(e,n);case 12:return t.visitStagger(e,n);default:throw new Error("Unable to resolve animation metadata node #"+e.type)}}function Gc(t,e){return window.getComputedStyle(t)[e]}var Kc="*",Qc=new Set(["true","1"]),Yc=new Set(["false","0"]);function Xc(t,e){var n=Qc.has(t)||Yc.has(t),r=Qc.has(e)||Yc.has(e);return function(o,i){var a=t==Kc||t==o,s=e==Kc||e==i;return!a&&n&&"boolean"==typeof o&&(a=o?Qc.has(t):Yc.has(t)),!s&&r&&"boolean"==typeof i&&(s=i?Qc.has(e):Yc.has(e)),a&&s}}var Jc=new RegExp("s*:selfs*,?","g");function $c(t,e,n){return new tl(t).build(e,n)}var tl=function(){function t(t){this._driver=t}return t.prototype.build=function(t,e){var n=new el(e);return this._resetContextStyleTimingState(n),qc(this,Fc(t),n)},t.prototype._resetContextStyleTimingState=function(t){t.currentQuerySelector="",t.collectedStyles={},t.collectedStyles[""]={},t.currentTime=0},t.prototype.visitTrigger=function(t,e){var n=this,r=e.queryCount=0,o=e.depCount=0,i=[],a=[];return"@"==t.name.charAt(0)&&e.errors.push("animation triggers cannot be prefixed with an `@` sign (e.g. trigger('@foo', [...]))"),t.definitions.forEach(function(t){if(n._resetContextStyleTimingState(e),0==t.type){var s=t,u=s.name;u.toString().split(/\s*,\s*/).forEach(function(t){s.name=t,i.push(n.visitState(s,e))}),s.name=u}else if(1==t.type){var c=n.visitTransition(t,e);r+=c.queryCount,o+=c.depCount,a.push(c)}else e.errors.push("only state() and transition() definitions can sit inside of a trigger()")}),{type:7,name:t.name,states:i,transitions:a,queryCount:r,depCount:o,options:null}},t.prototype.visitState=function(t,e){var n=this.visitStyle(t.styles,e),r=t.options&&t.options.params||null;if(n.containsDynamicStyles){var o=new Set,i=r||{};if(n.styles.forEach(function(t){if(nl(t)){var e=t;Object.keys(e).forEach(function(t){Bc(e[t]).forEach(function(t){i.hasOwnProperty(t)||o.add(t)})})}}),o.size){var a=Hc(o.values());e.errors.push('state("'+t.name+'", ...) must define default values for all the following style substitutions: '+a.join(", "))}}return{type:0,name:t.name,style:n,options:r?{params:r}:null}},t.prototype.visitTransition=function(t,e){e.queryCount=0,e.depCount=0;var n,r,o,i=qc(this,Fc(t.animation),e);return{type:1,matchers:(n=t.expr,r=e.errors,o=[],"string"==typeof n?n.split(/\s*,\s*/).forEach(function(t){return function(t,e,n){if(":"==t[0]){var r=function(t,e){switch(t){case":enter":return"void => *";case":leave":return"* => void";case":increment":return function(t,e){return parseFloat(e)>parseFloat(t)};case":decrement":return function(t,e){return parseFloat(e)<parseFloat(t)};default:return e.push('The transition alias value "'+t+'" is not supported'),"* => *"}}(t,n);if("function"==typeof r)return void e.push(r);t=r}var o=t.match(/^(\*|[-\w]+)\s*(<?[=-]>)\s*(\*|[-\w]+)$/);if(null==o||o.length<4)return n.push('The provided transition expression "'+t+'" is not supported'),e;var i=o[1],a=o[2],s=o[3];e.push(Xc(i,s)),"<"!=a[0]||i==Kc&&s==Kc||e.push(Xc(s,i))}(t,o,r)}):o.push(n),o),animation:i,queryCount:e.queryCount,depCount:e.depCount,options:rl(t.options)}},t.prototype.visitSequence=function(t,e){var n=this;return{type:2,steps:t.steps.map(function(t){return qc(n,t,e)}),options:rl(t.options)}},t.prototype.visitGroup=function(t,e){var n=this,r=e.currentTime,o=0,i=t.steps.map(function(t){e.currentTime=r;var i=qc(n,t,e);return o=Math.max(o,e.currentTime),i});return e.currentTime=o,{type:3,steps:i,options:rl(t.options)}},t.prototype.visitAnimate=function(t,e){var n,r=function(t,e){var n=null;if(t.hasOwnProperty("duration"))n=t;else if("number"==typeof t)return ol(Oc(t,e).duration,0,"");var r=t;if(r.split(/\s+/).some(function(t){return"{"==t.charAt(0)&&"{"==t.charAt(1)})){var o=ol(0,0,"");return o.dynamic=!0,o.strValue=r,o}return ol((n=n||Oc(r,e)).duration,n.delay,n.easing)}(t.timings,e.errors);e.currentAnimateTimings=r;var o=t.styles?t.styles:ec({});if(5==o.type)n=this.visitKeyframes(o,e);else{var i=t.styles,a=!1;if(!i){a=!0;var s={};r.easing&&(s.easing=r.easing),i=ec(s)}e.currentTime+=r.duration+r.delay;var u=this.visitStyle(i,e);u.isEmptyStep=a,n=u}return e.currentAnimateTimings=null,{type:4,timings:r,style:n,options:null}},t.prototype.visitStyle=function(t,e){var n=this._makeStyleAst(t,e);return this._validateStyleAst(n,e),n},t.prototype._makeStyleAst=function(t,e){var n=[];Array.isArray(t.styles)?t.styles.forEach(function(t){"string"==typeof t?t==$u?n.push(t):e.errors.push("The provided style string value "+t+" is not allowed."):n.push(t)}):n.push(t.styles);var r=!1,o=null;return n.forEach(function(t){if(nl(t)){var e=t,n=e.easing;if(n&&(o=n,delete e.easing),!r)for(var i in e)if(e[i].toString().indexOf("{{")>=0){r=!0;break}}}),{type:6,styles:n,easing:o,offset:t.offset,containsDynamicStyles:r,options:null}},t.prototype._validateStyleAst=function(t,e){var n=this,r=e.currentAnimateTimings,o=e.currentTime,i=e.currentTime;r&&i>0&&(i-=r.duration+r.delay),t.styles.forEach(function(t){"string"!=typeof t&&Object.keys(t).forEach(function(r){if(n._driver.validateStyleProperty(r)){var a,s,u,c=e.collectedStyles[e.currentQuerySelector],l=c[r],f=!0;l&&(i!=o&&i>=l.startTime&&o<=l.endTime&&(e.errors.push('The CSS property "'+r+'" that exists between the times of "'+l.startTime+'ms" and "'+l.endTime+'ms" is also being animated in a parallel animation between the times of "'+i+'ms" and "'+o+'ms"'),f=!1),i=l.startTime),f&&(c[r]={startTime:i,endTime:o}),e.options&&(a=e.errors,s=e.options.params||{},(u=Bc(t[r])).length&&u.forEach(function(t){s.hasOwnProperty(t)||a.push("Unable to resolve the local animation param "+t+" in the given list of values")}))}else e.errors.push('The provided animation property "'+r+'" is not a supported CSS property for animations')})})},t.prototype.visitKeyframes=function(t,e){var n=this,r={type:5,styles:[],options:null};if(!e.currentAnimateTimings)return e.errors.push("keyframes() must be placed inside of a call to animate()"),r;var o=0,i=[],a=!1,s=!1,u=0,c=t.steps.map(function(t){var r=n._makeStyleAst(t,e),c=null!=r.offset?r.offset:function(t){if("string"==typeof t)return null;var e=null;if(Array.isArray(t))t.forEach(function(t){if(nl(t)&&t.hasOwnProperty("offset")){var n=t;e=parseFloat(n.offset),delete n.offset}});else if(nl(t)&&t.hasOwnProperty("offset")){var n=t;e=parseFloat(n.offset),delete n.offset}return e}(r.styles),l=0;return null!=c&&(o++,l=r.offset=c),s=s||l<0||l>1,a=a||l<u,u=l,i.push(l),r});s&&e.errors.push("Please ensure that all keyframe offsets are between 0 and 1"),a&&e.errors.push("Please ensure that all keyframe offsets are in order");var l=t.steps.length,f=0;o>0&&o<l?e.errors.push("Not all style() steps within the declared keyframes() contain offsets"):0==o&&(f=1/(l-1));var p=l-1,h=e.currentTime,d=e.currentAnimateTimings,y=d.duration;return c.forEach(function(t,o){var a=f>0?o==p?1:f*o:i[o],s=a*y;e.currentTime=h+d.delay+s,d.duration=s,n._validateStyleAst(t,e),t.offset=a,r.styles.push(t)}),r},t.prototype.visitReference=function(t,e){return{type:8,animation:qc(this,Fc(t.animation),e),options:rl(t.options)}},t.prototype.visitAnimateChild=function(t,e){return e.depCount++,{type:9,options:rl(t.options)}},t.prototype.visitAnimateRef=function(t,e){return{type:10,animation:this.visitReference(t.animation,e),options:rl(t.options)}},t.prototype.visitQuery=function(t,e){var n=e.currentQuerySelector,r=t.options||{};e.queryCount++,e.currentQuery=t;var o=f(function(t){var e=!!t.split(/\s*,\s*/).find(function(t){return":self"==t});return e&&(t=t.replace(Jc,"")),[t=t.replace(/@\*/g,".ng-trigger").replace(/@\w+/g,function(t){return".ng-trigger-"+t.substr(1)}).replace(/:animating/g,".ng-animating"),e]}(t.selector),2),i=o[0],a=o[1];e.currentQuerySelector=n.length?n+" "+i:i,pc(e.collectedStyles,e.currentQuerySelector,{});var s=qc(this,Fc(t.animation),e);return e.currentQuery=null,e.currentQuerySelector=n,{type:11,selector:i,limit:r.limit||0,optional:!!r.optional,includeSelf:a,animation:s,originalSelector:t.selector,options:rl(t.options)}},t.prototype.visitStagger=function(t,e){e.currentQuery||e.errors.push("stagger() can only be used inside of query()");var n="full"===t.timings?{duration:0,delay:0,easing:"full"}:Oc(t.timings,e.errors,!0);return{type:12,animation:qc(this,Fc(t.animation),e),timings:n,options:null}},t}(),el=function(){return function(t){this.errors=t,this.queryCount=0,this.depCount=0,this.currentTransition=null,this.currentQuery=null,this.currentQuerySelector=null,this.currentAnimateTimings=null,this.currentTime=0,this.collectedStyles={},this.options=null}}();function nl(t){return!Array.isArray(t)&&"object"==typeof t}function rl(t){var e;return t?(t=Pc(t)).params&&(t.params=(e=t.params)?Pc(e):null):t={},t}function ol(t,e,n){return{duration:t,delay:e,easing:n}}function il(t,e,n,r,o,i,a,s){return void 0===a&&(a=null),void 0===s&&(s=!1),{type:1,element:t,keyframes:e,preStyleProps:n,postStyleProps:r,duration:o,delay:i,totalTime:o+i,easing:a,subTimeline:s}}var al=function(){function t(){this._map=new Map}return t.prototype.consume=function(t){var e=this._map.get(t);return e?this._map.delete(t):e=[],e},t.prototype.append=function(t,e){var n=this._map.get(t);n||this._map.set(t,n=[]),n.push.apply(n,p(e))},t.prototype.has=function(t){return this._map.has(t)},t.prototype.clear=function(){this._map.clear()},t}(),sl=new RegExp(":enter","g"),ul=new RegExp(":leave","g");function cl(t,e,n,r,o,i,a,s,u,c){return void 0===i&&(i={}),void 0===a&&(a={}),void 0===c&&(c=[]),(new ll).buildKeyframes(t,e,n,r,o,i,a,s,u,c)}var ll=function(){function t(){}return t.prototype.buildKeyframes=function(t,e,n,r,o,i,a,s,u,c){void 0===c&&(c=[]),u=u||new al;var l=new pl(t,e,u,r,o,c,[]);l.options=s,l.currentTimeline.setStyles([i],null,l.errors,s),qc(this,n,l);var f=l.timelines.filter(function(t){return t.containsAnimation()});if(f.length&&Object.keys(a).length){var p=f[f.length-1];p.allowOnlyTimelineStyles()||p.setStyles([a],null,l.errors,s)}return f.length?f.map(function(t){return t.buildKeyframes()}):[il(e,[],[],[],0,0,"",!1)]},t.prototype.visitTrigger=function(t,e){},t.prototype.visitState=function(t,e){},t.prototype.visitTransition=function(t,e){},t.prototype.visitAnimateChild=function(t,e){var n=e.subInstructions.consume(e.element);if(n){var r=e.createSubContext(t.options),o=e.currentTimeline.currentTime,i=this._visitSubInstructions(n,r,r.options);o!=i&&e.transformIntoNewTimeline(i)}e.previousNode=t},t.prototype.visitAnimateRef=function(t,e){var n=e.createSubContext(t.options);n.transformIntoNewTimeline(),this.visitReference(t.animation,n),e.transformIntoNewTimeline(n.currentTimeline.currentTime),e.previousNode=t},t.prototype._visitSubInstructions=function(t,e,n){var r=e.currentTimeline.currentTime,o=null!=n.duration?Ic(n.duration):null,i=null!=n.delay?Ic(n.delay):null;return 0!==o&&t.forEach(function(t){var n=e.appendInstructionToTimeline(t,o,i);r=Math.max(r,n.duration+n.delay)}),r},t.prototype.visitReference=function(t,e){e.updateOptions(t.options,!0),qc(this,t.animation,e),e.previousNode=t},t.prototype.visitSequence=function(t,e){var n=this,r=e.subContextCount,o=e,i=t.options;if(i&&(i.params||i.delay)&&((o=e.createSubContext(i)).transformIntoNewTimeline(),null!=i.delay)){6==o.previousNode.type&&(o.currentTimeline.snapshotCurrentStyles(),o.previousNode=fl);var a=Ic(i.delay);o.delayNextStep(a)}t.steps.length&&(t.steps.forEach(function(t){return qc(n,t,o)}),o.currentTimeline.applyStylesToKeyframe(),o.subContextCount>r&&o.transformIntoNewTimeline()),e.previousNode=t},t.prototype.visitGroup=function(t,e){var n=this,r=[],o=e.currentTimeline.currentTime,i=t.options&&t.options.delay?Ic(t.options.delay):0;t.steps.forEach(function(a){var s=e.createSubContext(t.options);i&&s.delayNextStep(i),qc(n,a,s),o=Math.max(o,s.currentTimeline.currentTime),r.push(s.currentTimeline)}),r.forEach(function(t){return e.currentTimeline.mergeTimelineCollectedStyles(t)}),e.transformIntoNewTimeline(o),e.previousNode=t},t.prototype._visitTiming=function(t,e){if(t.dynamic){var n=t.strValue;return Oc(e.params?Vc(n,e.params,e.errors):n,e.errors)}return{duration:t.duration,delay:t.delay,easing:t.easing}},t.prototype.visitAnimate=function(t,e){var n=e.currentAnimateTimings=this._visitTiming(t.timings,e),r=e.currentTimeline;n.delay&&(e.incrementTime(n.delay),r.snapshotCurrentStyles());var o=t.style;5==o.type?this.visitKeyframes(o,e):(e.incrementTime(n.duration),this.visitStyle(o,e),r.applyStylesToKeyframe()),e.currentAnimateTimings=null,e.previousNode=t},t.prototype.visitStyle=function(t,e){var n=e.currentTimeline,r=e.currentAnimateTimings;!r&&n.getCurrentStyleProperties().length&&n.forwardFrame();var o=r&&r.easing||t.easing;t.isEmptyStep?n.applyEmptyStep(o):n.setStyles(t.styles,o,e.errors,e.options),e.previousNode=t},t.prototype.visitKeyframes=function(t,e){var n=e.currentAnimateTimings,r=e.currentTimeline.duration,o=n.duration,i=e.createSubContext().currentTimeline;i.easing=n.easing,t.styles.forEach(function(t){i.forwardTime((t.offset||0)*o),i.setStyles(t.styles,t.easing,e.errors,e.options),i.applyStylesToKeyframe()}),e.currentTimeline.mergeTimelineCollectedStyles(i),e.transformIntoNewTimeline(r+o),e.previousNode=t},t.prototype.visitQuery=function(t,e){var n=this,r=e.currentTimeline.currentTime,o=t.options||{},i=o.delay?Ic(o.delay):0;i&&(6===e.previousNode.type||0==r&&e.currentTimeline.getCurrentStyleProperties().length)&&(e.currentTimeline.snapshotCurrentStyles(),e.previousNode=fl);var a=r,s=e.invokeQuery(t.selector,t.originalSelector,t.limit,t.includeSelf,!!o.optional,e.errors);e.currentQueryTotal=s.length;var u=null;s.forEach(function(r,o){e.currentQueryIndex=o;var s=e.createSubContext(t.options,r);i&&s.delayNextStep(i),r===e.element&&(u=s.currentTimeline),qc(n,t.animation,s),s.currentTimeline.applyStylesToKeyframe(),a=Math.max(a,s.currentTimeline.currentTime)}),e.currentQueryIndex=0,e.currentQueryTotal=0,e.transformIntoNewTimeline(a),u&&(e.currentTimeline.mergeTimelineCollectedStyles(u),e.currentTimeline.snapshotCurrentStyles()),e.previousNode=t},t.prototype.visitStagger=function(t,e){var n=e.parentContext,r=e.currentTimeline,o=t.timings,i=Math.abs(o.duration),a=i*(e.currentQueryTotal-1),s=i*e.currentQueryIndex;switch(o.duration<0?"reverse":o.easing){case"reverse":s=a-s;break;case"full":s=n.currentStaggerTime}var u=e.currentTimeline;s&&u.delayNextStep(s);var c=u.currentTime;qc(this,t.animation,e),e.previousNode=t,n.currentStaggerTime=r.currentTime-c+(r.startTime-n.currentTimeline.startTime)},t}(),fl={},pl=function(){function t(t,e,n,r,o,i,a,s){this._driver=t,this.element=e,this.subInstructions=n,this._enterClassName=r,this._leaveClassName=o,this.errors=i,this.timelines=a,this.parentContext=null,this.currentAnimateTimings=null,this.previousNode=fl,this.subContextCount=0,this.options={},this.currentQueryIndex=0,this.currentQueryTotal=0,this.currentStaggerTime=0,this.currentTimeline=s||new hl(this._driver,e,0),a.push(this.currentTimeline)}return Object.defineProperty(t.prototype,"params",{get:function(){return this.options.params},enumerable:!0,configurable:!0}),t.prototype.updateOptions=function(t,e){var n=this;if(t){var r=t,o=this.options;null!=r.duration&&(o.duration=Ic(r.duration)),null!=r.delay&&(o.delay=Ic(r.delay));var i=r.params;if(i){var a=o.params;a||(a=this.options.params={}),Object.keys(i).forEach(function(t){e&&a.hasOwnProperty(t)||(a[t]=Vc(i[t],a,n.errors))})}}},t.prototype._copyOptions=function(){var t={};if(this.options){var e=this.options.params;if(e){var n=t.params={};Object.keys(e).forEach(function(t){n[t]=e[t]})}}return t},t.prototype.createSubContext=function(e,n,r){void 0===e&&(e=null);var o=n||this.element,i=new t(this._driver,o,this.subInstructions,this._enterClassName,this._leaveClassName,this.errors,this.timelines,this.currentTimeline.fork(o,r||0));return i.previousNode=this.previousNode,i.currentAnimateTimings=this.currentAnimateTimings,i.options=this._copyOptions(),i.updateOptions(e),i.currentQueryIndex=this.currentQueryIndex,i.currentQueryTotal=this.currentQueryTotal,i.parentContext=this,this.subContextCount++,i},t.prototype.transformIntoNewTimeline=function(t){return this.previousNode=fl,this.currentTimeline=this.currentTimeline.fork(this.element,t),this.timelines.push(this.currentTimeline),this.currentTimeline},t.prototype.appendInstructionToTimeline=function(t,e,n){var r={duration:null!=e?e:t.duration,delay:this.currentTimeline.currentTime+(null!=n?n:0)+t.delay,easing:""},o=new dl(this._driver,t.element,t.keyframes,t.preStyleProps,t.postStyleProps,r,t.stretchStartingKeyframe);return this.timelines.push(o),r},t.prototype.incrementTime=function(t){this.currentTimeline.forwardTime(this.currentTimeline.duration+t)},t.prototype.delayNextStep=function(t){t>0&&this.currentTimeline.delayNextStep(t)},t.prototype.invokeQuery=function(t,e,n,r,o,i){var a=[];if(r&&a.push(this.element),t.length>0){t=(t=t.replace(sl,"."+this._enterClassName)).replace(ul,"."+this._leaveClassName);var s=this._driver.query(this.element,t,1!=n);0!==n&&(s=n<0?s.slice(s.length+n,s.length):s.slice(0,n)),a.push.apply(a,p(s))}return o||0!=a.length||i.push('`query("'+e+'")` returned zero elements. (Use `query("'+e+'", { optional: true })` if you wish to allow this.)'),a},t}(),hl=function(){function t(t,e,n,r){this._driver=t,this.element=e,this.startTime=n,this._elementTimelineStylesLookup=r,this.duration=0,this._previousKeyframe={},this._currentKeyframe={},this._keyframes=new Map,this._styleSummary={},this._pendingStyles={},this._backFill={},this._currentEmptyStepKeyframe=null,this._elementTimelineStylesLookup||(this._elementTimelineStylesLookup=new Map),this._localTimelineStyles=Object.create(this._backFill,{}),this._globalTimelineStyles=this._elementTimelineStylesLookup.get(e),this._globalTimelineStyles||(this._globalTimelineStyles=this._localTimelineStyles,this._elementTimelineStylesLookup.set(e,this._localTimelineStyles)),this._loadKeyframe()}return t.prototype.containsAnimation=function(){switch(this._keyframes.size){case 0:return!1;case 1:return this.getCurrentStyleProperties().length>0;default:return!0}},t.prototype.getCurrentStyleProperties=function(){return Object.keys(this._currentKeyframe)},Object.defineProperty(t.prototype,"currentTime",{get:function(){return this.startTime+this.duration},enumerable:!0,configurable:!0}),t.prototype.delayNextStep=function(t){var e=1==this._keyframes.size&&Object.keys(this._pendingStyles).length;this.duration||e?(this.forwardTime(this.currentTime+t),e&&this.snapshotCurrentStyles()):this.startTime+=t},t.prototype.fork=function(e,n){return this.applyStylesToKeyframe(),new t(this._driver,e,n||this.currentTime,this._elementTimelineStylesLookup)},t.prototype._loadKeyframe=function(){this._currentKeyframe&&(this._previousKeyframe=this._currentKeyframe),this._currentKeyframe=this._keyframes.get(this.duration),this._currentKeyframe||(this._currentKeyframe=Object.create(this._backFill,{}),this._keyframes.set(this.duration,this._currentKeyframe))},t.prototype.forwardFrame=function(){this.duration+=1,this._loadKeyframe()},t.prototype.forwardTime=function(t){this.applyStylesToKeyframe(),this.duration=t,this._loadKeyframe()},t.prototype._updateStyle=function(t,e){this._localTimelineStyles[t]=e,this._globalTimelineStyles[t]=e,this._styleSummary[t]={time:this.currentTime,value:e}},t.prototype.allowOnlyTimelineStyles=function(){return this._currentEmptyStepKeyframe!==this._currentKeyframe},t.prototype.applyEmptyStep=function(t){var e=this;t&&(this._previousKeyframe.easing=t),Object.keys(this._globalTimelineStyles).forEach(function(t){e._backFill[t]=e._globalTimelineStyles[t]||$u,e._currentKeyframe[t]=$u}),this._currentEmptyStepKeyframe=this._currentKeyframe},t.prototype.setStyles=function(t,e,n,r){var o=this;e&&(this._previousKeyframe.easing=e);var i=r&&r.params||{},a=function(t,e){var n,r={};return t.forEach(function(t){"*"===t?(n=n||Object.keys(e)).forEach(function(t){r[t]=$u}):Rc(t,!1,r)}),r}(t,this._globalTimelineStyles);Object.keys(a).forEach(function(t){var e=Vc(a[t],i,n);o._pendingStyles[t]=e,o._localTimelineStyles.hasOwnProperty(t)||(o._backFill[t]=o._globalTimelineStyles.hasOwnProperty(t)?o._globalTimelineStyles[t]:$u),o._updateStyle(t,e)})},t.prototype.applyStylesToKeyframe=function(){var t=this,e=this._pendingStyles,n=Object.keys(e);0!=n.length&&(this._pendingStyles={},n.forEach(function(n){t._currentKeyframe[n]=e[n]}),Object.keys(this._localTimelineStyles).forEach(function(e){t._currentKeyframe.hasOwnProperty(e)||(t._currentKeyframe[e]=t._localTimelineStyles[e])}))},t.prototype.snapshotCurrentStyles=function(){var t=this;Object.keys(this._localTimelineStyles).forEach(function(e){var n=t._localTimelineStyles[e];t._pendingStyles[e]=n,t._updateStyle(e,n)})},t.prototype.getFinalKeyframe=function(){return this._keyframes.get(this.duration)},Object.defineProperty(t.prototype,"properties",{get:function(){var t=[];for(var e in this._currentKeyframe)t.push(e);return t},enumerable:!0,configurable:!0}),t.prototype.mergeTimelineCollectedStyles=function(t){var e=this;Object.keys(t._styleSummary).forEach(function(n){var r=e._styleSummary[n],o=t._styleSummary[n];(!r||o.time>r.time)&&e._updateStyle(n,o.value)})},t.prototype.buildKeyframes=function(){var t=this;this.applyStylesToKeyframe();var e=new Set,n=new Set,r=1===this._keyframes.size&&0===this.duration,o=[];this._keyframes.forEach(function(i,a){var s=Rc(i,!0);Object.keys(s).forEach(function(t){var r=s[t];r==ic?e.add(t):r==$u&&n.add(t)}),r||(s.offset=a/t.duration),o.push(s)});var i=e.size?Hc(e.values()):[],a=n.size?Hc(n.values()):[];if(r){var s=o[0],u=Pc(s);s.offset=0,u.offset=1,o=[s,u]}return il(this.element,o,i,a,this.duration,this.startTime,this.easing,!1)},t}(),dl=function(t){function e(e,n,r,o,i,a,s){void 0===s&&(s=!1);var u=t.call(this,e,n,a.delay)||this;return u.element=n,u.keyframes=r,u.preStyleProps=o,u.postStyleProps=i,u._stretchStartingKeyframe=s,u.timings={duration:a.duration,delay:a.delay,easing:a.easing},u}return o(e,t),e.prototype.containsAnimation=function(){return this.keyframes.length>1},e.prototype.buildKeyframes=function(){var t=this.keyframes,e=this.timings,n=e.delay,r=e.duration,o=e.easing;if(this._stretchStartingKeyframe&&n){var i=[],a=r+n,s=n/a,u=Rc(t[0],!1);u.offset=0,i.push(u);var c=Rc(t[0],!1);c.offset=yl(s),i.push(c);for(var l=t.length-1,f=1;f<=l;f++){var p=Rc(t[f],!1);p.offset=yl((n+p.offset*r)/a),i.push(p)}r=a,n=0,o="",t=i}return il(this.element,t,this.preStyleProps,this.postStyleProps,r,n,o,!0)},e}(hl);function yl(t,e){void 0===e&&(e=3);var n=Math.pow(10,e-1);return Math.round(t*n)/n}var vl=function(){return function(){}}(),gl=function(t){function e(){return null!==t&&t.apply(this,arguments)||this}return o(e,t),e.prototype.normalizePropertyName=function(t,e){return Uc(t)},e.prototype.normalizeStyleValue=function(t,e,n,r){var o="",i=n.toString().trim();if(ml[e]&&0!==n&&"0"!==n)if("number"==typeof n)o="px";else{var a=n.match(/^[+-]?[\d\.]+([a-z]*)$/);a&&0==a[1].length&&r.push("Please provide a CSS unit value for "+t+":"+n)}return i+o},e}(vl),ml=function(){return t="width,height,minWidth,minHeight,maxWidth,maxHeight,left,top,bottom,right,fontSize,outlineWidth,outlineOffset,paddingTop,paddingLeft,paddingBottom,paddingRight,marginTop,marginLeft,marginBottom,marginRight,borderRadius,borderWidth,borderTopWidth,borderLeftWidth,borderRightWidth,borderBottomWidth,textIndent,perspective".split(","),e={},t.forEach(function(t){return e[t]=!0}),e;var t,e}();function bl(t,e,n,r,o,i,a,s,u,c,l,f,p){return{type:0,element:t,triggerName:e,isRemovalTransition:o,fromState:n,fromStyles:i,toState:r,toStyles:a,timelines:s,queriedElements:u,preStyleProps:c,postStyleProps:l,totalTime:f,errors:p}}var _l={},wl=function(){function t(t,e,n){this._triggerName=t,this.ast=e,this._stateStyles=n}return t.prototype.match=function(t,e,n,r){return function(t,e,n,r,o){return t.some(function(t){return t(e,n,r,o)})}(this.ast.matchers,t,e,n,r)},t.prototype.buildStyles=function(t,e,n){var r=this._stateStyles["*"],o=this._stateStyles[t],i=r?r.buildStyles(e,n):{};return o?o.buildStyles(e,n):i},t.prototype.build=function(t,e,n,r,o,a,s,u,c,l){var f=[],p=this.ast.options&&this.ast.options.params||_l,h=this.buildStyles(n,s&&s.params||_l,f),d=u&&u.params||_l,y=this.buildStyles(r,d,f),v=new Set,g=new Map,m=new Map,b="void"===r,_={params:i({},p,d)},w=l?[]:cl(t,e,this.ast.animation,o,a,h,y,_,c,f),E=0;if(w.forEach(function(t){E=Math.max(t.duration+t.delay,E)}),f.length)return bl(e,this._triggerName,n,r,b,h,y,[],[],g,m,E,f);w.forEach(function(t){var n=t.element,r=pc(g,n,{});t.preStyleProps.forEach(function(t){return r[t]=!0});var o=pc(m,n,{});t.postStyleProps.forEach(function(t){return o[t]=!0}),n!==e&&v.add(n)});var S=Hc(v.values());return bl(e,this._triggerName,n,r,b,h,y,w,S,g,m,E)},t}(),El=function(){function t(t,e){this.styles=t,this.defaultParams=e}return t.prototype.buildStyles=function(t,e){var n={},r=Pc(this.defaultParams);return Object.keys(t).forEach(function(e){var n=t[e];null!=n&&(r[e]=n)}),this.styles.styles.forEach(function(t){if("string"!=typeof t){var o=t;Object.keys(o).forEach(function(t){var i=o[t];i.length>1&&(i=Vc(i,r,e)),n[t]=i})}}),n},t}(),Sl=function(){function t(t,e){var n=this;this.name=t,this.ast=e,this.transitionFactories=[],this.states={},e.states.forEach(function(t){n.states[t.name]=new El(t.style,t.options&&t.options.params||{})}),xl(this.states,"true","1"),xl(this.states,"false","0"),e.transitions.forEach(function(e){n.transitionFactories.push(new wl(t,e,n.states))}),this.fallbackTransition=new wl(t,{type:1,animation:{type:2,steps:[],options:null},matchers:[function(t,e){return!0}],options:null,queryCount:0,depCount:0},this.states)}return Object.defineProperty(t.prototype,"containsQueries",{get:function(){return this.ast.queryCount>0},enumerable:!0,configurable:!0}),t.prototype.matchTransition=function(t,e,n,r){return this.transitionFactories.find(function(o){return o.match(t,e,n,r)})||null},t.prototype.matchStyles=function(t,e,n){return this.fallbackTransition.buildStyles(t,e,n)},t}();function xl(t,e,n){t.hasOwnProperty(e)?t.hasOwnProperty(n)||(t[n]=t[e]):t.hasOwnProperty(n)&&(t[e]=t[n])}var Tl=new al,kl=function(){function t(t,e,n){this.bodyNode=t,this._driver=e,this._normalizer=n,this._animations={},this._playersById={},this.players=[]}return t.prototype.register=function(t,e){var n=[],r=$c(this._driver,e,n);if(n.length)throw new Error("Unable to build the animation due to the following errors: "+n.join("\n"));this._animations[t]=r},t.prototype._buildPlayer=function(t,e,n){var r=t.element,o=uc(0,this._normalizer,0,t.keyframes,e,n);return this._driver.animate(r,o,t.duration,t.delay,t.easing,[],!0)},t.prototype.create=function(t,e,n){var r=this;void 0===n&&(n={});var o,i=[],a=this._animations[t],s=new Map;if(a?(o=cl(this._driver,e,a,"ng-enter","ng-leave",{},{},n,Tl,i)).forEach(function(t){var e=pc(s,t.element,{});t.postStyleProps.forEach(function(t){return e[t]=null})}):(i.push("The requested animation doesn't exist or has already been destroyed"),o=[]),i.length)throw new Error("Unable to create the animation due to the following errors: "+i.join("\n"));s.forEach(function(t,e){Object.keys(t).forEach(function(n){t[n]=r._driver.computeStyle(e,n,$u)})});var u=sc(o.map(function(t){var e=s.get(t.element);return r._buildPlayer(t,{},e)}));return this._playersById[t]=u,u.onDestroy(function(){return r.destroy(t)}),this.players.push(u),u},t.prototype.destroy=function(t){var e=this._getPlayer(t);e.destroy(),delete this._playersById[t];var n=this.players.indexOf(e);n>=0&&this.players.splice(n,1)},t.prototype._getPlayer=function(t){var e=this._playersById[t];if(!e)throw new Error("Unable to find the timeline player referenced by "+t);return e},t.prototype.listen=function(t,e,n,r){var o=fc(e,"","","");return cc(this._getPlayer(t),n,o,r),function(){}},t.prototype.command=function(t,e,n,r){if("register"!=n)if("create"!=n){var o=this._getPlayer(t);switch(n){case"play":o.play();break;case"pause":o.pause();break;case"reset":o.reset();break;case"restart":o.restart();break;case"finish":o.finish();break;case"init":o.init();break;case"setPosition":o.setPosition(parseFloat(r[0]));break;case"destroy":this.destroy(t)}}else this.create(t,e,r[0]||{});else this.register(t,r[0])},t}(),Al=[],Il={namespaceId:"",setForRemoval:!1,setForMove:!1,hasAnimation:!1,removedBeforeQueried:!1},Cl={namespaceId:"",setForMove:!1,setForRemoval:!1,hasAnimation:!1,removedBeforeQueried:!0},Ol="__ng_removed",Pl=function(){function t(t,e){void 0===e&&(e=""),this.namespaceId=e;var n=t&&t.hasOwnProperty("value");if(this.value=function(t){return null!=t?t:null}(n?t.value:t),n){var r=Pc(t);delete r.value,this.options=r}else this.options={};this.options.params||(this.options.params={})}return Object.defineProperty(t.prototype,"params",{get:function(){return this.options.params},enumerable:!0,configurable:!0}),t.prototype.absorbOptions=function(t){var e=t.params;if(e){var n=this.options.params;Object.keys(e).forEach(function(t){null==n[t]&&(n[t]=e[t])})}},t}(),Rl=new Pl("void"),Dl=function(){function t(t,e,n){this.id=t,this.hostElement=e,this._engine=n,this.players=[],this._triggers={},this._queue=[],this._elementListeners=new Map,this._hostClassName="ng-tns-"+t,Hl(e,this._hostClassName)}return t.prototype.listen=function(t,e,n,r){var o,i=this;if(!this._triggers.hasOwnProperty(e))throw new Error('Unable to listen on the animation trigger event "'+n+'" because the animation trigger "'+e+"\" doesn't exist!");if(null==n||0==n.length)throw new Error('Unable to listen on the animation trigger "'+e+'" because the provided event is undefined!');if("start"!=(o=n)&&"done"!=o)throw new Error('The provided animation trigger event "'+n+'" for the animation trigger "'+e+'" is not supported!');var a=pc(this._elementListeners,t,[]),s={name:e,phase:n,callback:r};a.push(s);var u=pc(this._engine.statesByElement,t,{});return u.hasOwnProperty(e)||(Hl(t,"ng-trigger"),Hl(t,"ng-trigger-"+e),u[e]=Rl),function(){i._engine.afterFlush(function(){var t=a.indexOf(s);t>=0&&a.splice(t,1),i._triggers[e]||delete u[e]})}},t.prototype.register=function(t,e){return!this._triggers[t]&&(this._triggers[t]=e,!0)},t.prototype._getTrigger=function(t){var e=this._triggers[t];if(!e)throw new Error('The provided animation trigger "'+t+'" has not been registered!');return e},t.prototype.trigger=function(t,e,n,r){var o=this;void 0===r&&(r=!0);var i=this._getTrigger(e),a=new Ml(this.id,e,t),s=this._engine.statesByElement.get(t);s||(Hl(t,"ng-trigger"),Hl(t,"ng-trigger-"+e),this._engine.statesByElement.set(t,s={}));var u=s[e],c=new Pl(n,this.id);if(!(n&&n.hasOwnProperty("value"))&&u&&c.absorbOptions(u.options),s[e]=c,u||(u=Rl),"void"===c.value||u.value!==c.value){var l=pc(this._engine.playersByElement,t,[]);l.forEach(function(t){t.namespaceId==o.id&&t.triggerName==e&&t.queued&&t.destroy()});var f=i.matchTransition(u.value,c.value,t,c.params),p=!1;if(!f){if(!r)return;f=i.fallbackTransition,p=!0}return this._engine.totalQueuedPlayers++,this._queue.push({element:t,triggerName:e,transition:f,fromState:u,toState:c,player:a,isFallbackTransition:p}),p||(Hl(t,"ng-animate-queued"),a.onStart(function(){zl(t,"ng-animate-queued")})),a.onDone(function(){var e=o.players.indexOf(a);e>=0&&o.players.splice(e,1);var n=o._engine.playersByElement.get(t);if(n){var r=n.indexOf(a);r>=0&&n.splice(r,1)}}),this.players.push(a),l.push(a),a}if(!function(t,e){var n=Object.keys(t),r=Object.keys(e);if(n.length!=r.length)return!1;for(var o=0;o<n.length;o++){var i=n[o];if(!e.hasOwnProperty(i)||t[i]!==e[i])return!1}return!0}(u.params,c.params)){var h=[],d=i.matchStyles(u.value,u.params,h),y=i.matchStyles(c.value,c.params,h);h.length?this._engine.reportError(h):this._engine.afterFlush(function(){jc(t,d),Mc(t,y)})}},t.prototype.deregister=function(t){var e=this;delete this._triggers[t],this._engine.statesByElement.forEach(function(e,n){delete e[t]}),this._elementListeners.forEach(function(n,r){e._elementListeners.set(r,n.filter(function(e){return e.name!=t}))})},t.prototype.clearElementCache=function(t){this._engine.statesByElement.delete(t),this._elementListeners.delete(t);var e=this._engine.playersByElement.get(t);e&&(e.forEach(function(t){return t.destroy()}),this._engine.playersByElement.delete(t))},t.prototype._signalRemovalForInnerTriggers=function(t,e,n){var r=this;void 0===n&&(n=!1),this._engine.driver.query(t,".ng-trigger",!0).forEach(function(t){if(!t[Ol]){var n=r._engine.fetchNamespacesByElement(t);n.size?n.forEach(function(n){return n.triggerLeaveAnimation(t,e,!1,!0)}):r.clearElementCache(t)}})},t.prototype.triggerLeaveAnimation=function(t,e,n,r){var o=this,i=this._engine.statesByElement.get(t);if(i){var a=[];if(Object.keys(i).forEach(function(e){if(o._triggers[e]){var n=o.trigger(t,e,"void",r);n&&a.push(n)}}),a.length)return this._engine.markElementAsRemoved(this.id,t,!0,e),n&&sc(a).onDone(function(){return o._engine.processLeaveNode(t)}),!0}return!1},t.prototype.prepareLeaveAnimationListeners=function(t){var e=this,n=this._elementListeners.get(t);if(n){var r=new Set;n.forEach(function(n){var o=n.name;if(!r.has(o)){r.add(o);var i=e._triggers[o].fallbackTransition,a=e._engine.statesByElement.get(t)[o]||Rl,s=new Pl("void"),u=new Ml(e.id,o,t);e._engine.totalQueuedPlayers++,e._queue.push({element:t,triggerName:o,transition:i,fromState:a,toState:s,player:u,isFallbackTransition:!0})}})}},t.prototype.removeNode=function(t,e){var n=this,r=this._engine;if(t.childElementCount&&this._signalRemovalForInnerTriggers(t,e,!0),!this.triggerLeaveAnimation(t,e,!0)){var o=!1;if(r.totalAnimations){var i=r.players.length?r.playersByQueriedElement.get(t):[];if(i&&i.length)o=!0;else for(var a=t;a=a.parentNode;)if(r.statesByElement.get(a)){o=!0;break}}this.prepareLeaveAnimationListeners(t),o?r.markElementAsRemoved(this.id,t,!1,e):(r.afterFlush(function(){return n.clearElementCache(t)}),r.destroyInnerAnimations(t),r._onRemovalComplete(t,e))}},t.prototype.insertNode=function(t,e){Hl(t,this._hostClassName)},t.prototype.drainQueuedTransitions=function(t){var e=this,n=[];return this._queue.forEach(function(r){var o=r.player;if(!o.destroyed){var i=r.element,a=e._elementListeners.get(i);a&&a.forEach(function(e){if(e.name==r.triggerName){var n=fc(i,r.triggerName,r.fromState.value,r.toState.value);n._data=t,cc(r.player,e.phase,n,e.callback)}}),o.markedForDestroy?e._engine.afterFlush(function(){o.destroy()}):n.push(r)}}),this._queue=[],n.sort(function(t,n){var r=t.transition.ast.depCount,o=n.transition.ast.depCount;return 0==r||0==o?r-o:e._engine.driver.containsElement(t.element,n.element)?1:-1})},t.prototype.destroy=function(t){this.players.forEach(function(t){return t.destroy()}),this._signalRemovalForInnerTriggers(this.hostElement,t)},t.prototype.elementContainsData=function(t){var e=!1;return this._elementListeners.has(t)&&(e=!0),!!this._queue.find(function(e){return e.element===t})||e},t}(),Nl=function(){function t(t,e,n){this.bodyNode=t,this.driver=e,this._normalizer=n,this.players=[],this.newHostElements=new Map,this.playersByElement=new Map,this.playersByQueriedElement=new Map,this.statesByElement=new Map,this.disabledNodes=new Set,this.totalAnimations=0,this.totalQueuedPlayers=0,this._namespaceLookup={},this._namespaceList=[],this._flushFns=[],this._whenQuietFns=[],this.namespacesByHostElement=new Map,this.collectedEnterElements=[],this.collectedLeaveElements=[],this.onRemovalComplete=function(t,e){}}return t.prototype._onRemovalComplete=function(t,e){this.onRemovalComplete(t,e)},Object.defineProperty(t.prototype,"queuedPlayers",{get:function(){var t=[];return this._namespaceList.forEach(function(e){e.players.forEach(function(e){e.queued&&t.push(e)})}),t},enumerable:!0,configurable:!0}),t.prototype.createNamespace=function(t,e){var n=new Dl(t,e,this);return e.parentNode?this._balanceNamespaceList(n,e):(this.newHostElements.set(e,n),this.collectEnterElement(e)),this._namespaceLookup[t]=n},t.prototype._balanceNamespaceList=function(t,e){var n=this._namespaceList.length-1;if(n>=0){for(var r=!1,o=n;o>=0;o--)if(this.driver.containsElement(this._namespaceList[o].hostElement,e)){this._namespaceList.splice(o+1,0,t),r=!0;break}r||this._namespaceList.splice(0,0,t)}else this._namespaceList.push(t);return this.namespacesByHostElement.set(e,t),t},t.prototype.register=function(t,e){var n=this._namespaceLookup[t];return n||(n=this.createNamespace(t,e)),n},t.prototype.registerTrigger=function(t,e,n){var r=this._namespaceLookup[t];r&&r.register(e,n)&&this.totalAnimations++},t.prototype.destroy=function(t,e){var n=this;if(t){var r=this._fetchNamespace(t);this.afterFlush(function(){n.namespacesByHostElement.delete(r.hostElement),delete n._namespaceLookup[t];var e=n._namespaceList.indexOf(r);e>=0&&n._namespaceList.splice(e,1)}),this.afterFlushAnimationsDone(function(){return r.destroy(e)})}},t.prototype._fetchNamespace=function(t){return this._namespaceLookup[t]},t.prototype.fetchNamespacesByElement=function(t){var e=new Set,n=this.statesByElement.get(t);if(n)for(var r=Object.keys(n),o=0;o<r.length;o++){var i=n[r[o]].namespaceId;if(i){var a=this._fetchNamespace(i);a&&e.add(a)}}return e},t.prototype.trigger=function(t,e,n,r){if(jl(e)){var o=this._fetchNamespace(t);if(o)return o.trigger(e,n,r),!0}return!1},t.prototype.insertNode=function(t,e,n,r){if(jl(e)){var o=e[Ol];if(o&&o.setForRemoval){o.setForRemoval=!1,o.setForMove=!0;var i=this.collectedLeaveElements.indexOf(e);i>=0&&this.collectedLeaveElements.splice(i,1)}if(t){var a=this._fetchNamespace(t);a&&a.insertNode(e,n)}r&&this.collectEnterElement(e)}},t.prototype.collectEnterElement=function(t){this.collectedEnterElements.push(t)},t.prototype.markElementAsDisabled=function(t,e){e?this.disabledNodes.has(t)||(this.disabledNodes.add(t),Hl(t,"ng-animate-disabled")):this.disabledNodes.has(t)&&(this.disabledNodes.delete(t),zl(t,"ng-animate-disabled"))},t.prototype.removeNode=function(t,e,n,r){if(jl(e)){var o=t?this._fetchNamespace(t):null;if(o?o.removeNode(e,r):this.markElementAsRemoved(t,e,!1,r),n){var i=this.namespacesByHostElement.get(e);i&&i.id!==t&&i.removeNode(e,r)}}else this._onRemovalComplete(e,r)},t.prototype.markElementAsRemoved=function(t,e,n,r){this.collectedLeaveElements.push(e),e[Ol]={namespaceId:t,setForRemoval:r,hasAnimation:n,removedBeforeQueried:!1}},t.prototype.listen=function(t,e,n,r,o){return jl(e)?this._fetchNamespace(t).listen(e,n,r,o):function(){}},t.prototype._buildInstruction=function(t,e,n,r,o){return t.transition.build(this.driver,t.element,t.fromState.value,t.toState.value,n,r,t.fromState.options,t.toState.options,e,o)},t.prototype.destroyInnerAnimations=function(t){var e=this,n=this.driver.query(t,".ng-trigger",!0);n.forEach(function(t){return e.destroyActiveAnimationsForElement(t)}),0!=this.playersByQueriedElement.size&&(n=this.driver.query(t,".ng-animating",!0)).forEach(function(t){return e.finishActiveQueriedAnimationOnElement(t)})},t.prototype.destroyActiveAnimationsForElement=function(t){var e=this.playersByElement.get(t);e&&e.forEach(function(t){t.queued?t.markedForDestroy=!0:t.destroy()})},t.prototype.finishActiveQueriedAnimationOnElement=function(t){var e=this.playersByQueriedElement.get(t);e&&e.forEach(function(t){return t.finish()})},t.prototype.whenRenderingDone=function(){var t=this;return new Promise(function(e){if(t.players.length)return sc(t.players).onDone(function(){return e()});e()})},t.prototype.processLeaveNode=function(t){var e=this,n=t[Ol];if(n&&n.setForRemoval){if(t[Ol]=Il,n.namespaceId){this.destroyInnerAnimations(t);var r=this._fetchNamespace(n.namespaceId);r&&r.clearElementCache(t)}this._onRemovalComplete(t,n.setForRemoval)}this.driver.matchesElement(t,".ng-animate-disabled")&&this.markElementAsDisabled(t,!1),this.driver.query(t,".ng-animate-disabled",!0).forEach(function(t){e.markElementAsDisabled(t,!1)})},t.prototype.flush=function(t){var e=this;void 0===t&&(t=-1);var n=[];if(this.newHostElements.size&&(this.newHostElements.forEach(function(t,n){return e._balanceNamespaceList(t,n)}),this.newHostElements.clear()),this.totalAnimations&&this.collectedEnterElements.length)for(var r=0;r<this.collectedEnterElements.length;r++)Hl(this.collectedEnterElements[r],"ng-star-inserted");if(this._namespaceList.length&&(this.totalQueuedPlayers||this.collectedLeaveElements.length)){var o=[];try{n=this._flushAnimations(o,t)}finally{for(r=0;r<o.length;r++)o[r]()}}else for(r=0;r<this.collectedLeaveElements.length;r++)this.processLeaveNode(this.collectedLeaveElements[r]);if(this.totalQueuedPlayers=0,this.collectedEnterElements.length=0,this.collectedLeaveElements.length=0,this._flushFns.forEach(function(t){return t()}),this._flushFns=[],this._whenQuietFns.length){var i=this._whenQuietFns;this._whenQuietFns=[],n.length?sc(n).onDone(function(){i.forEach(function(t){return t()})}):i.forEach(function(t){return t()})}},t.prototype.reportError=function(t){throw new Error("Unable to process animations due to the following failed trigger transitions\n "+t.join("\n"))},t.prototype._flushAnimations=function(t,e){var n=this,r=new al,o=[],a=new Map,s=[],u=new Map,c=new Map,l=new Map,f=new Set;this.disabledNodes.forEach(function(t){f.add(t);for(var e=n.driver.query(t,".ng-animate-queued",!0),r=0;r<e.length;r++)f.add(e[r])});var h=this.bodyNode,d=Array.from(this.statesByElement.keys()),y=Bl(d,this.collectedEnterElements),v=new Map,g=0;y.forEach(function(t,e){var n="ng-enter"+g++;v.set(e,n),t.forEach(function(t){return Hl(t,n)})});for(var m=[],b=new Set,_=new Set,w=0;w<this.collectedLeaveElements.length;w++)(L=(F=this.collectedLeaveElements[w])[Ol])&&L.setForRemoval&&(m.push(F),b.add(F),L.hasAnimation?this.driver.query(F,".ng-star-inserted",!0).forEach(function(t){return b.add(t)}):_.add(F));var E=new Map,S=Bl(d,Array.from(b));S.forEach(function(t,e){var n="ng-leave"+g++;E.set(e,n),t.forEach(function(t){return Hl(t,n)})}),t.push(function(){y.forEach(function(t,e){var n=v.get(e);t.forEach(function(t){return zl(t,n)})}),S.forEach(function(t,e){var n=E.get(e);t.forEach(function(t){return zl(t,n)})}),m.forEach(function(t){n.processLeaveNode(t)})});for(var x=[],T=[],k=this._namespaceList.length-1;k>=0;k--)this._namespaceList[k].drainQueuedTransitions(e).forEach(function(t){var e=t.player,i=t.element;if(x.push(e),n.collectedEnterElements.length){var a=i[Ol];if(a&&a.setForMove)return void e.destroy()}var f=!h||!n.driver.containsElement(h,i),p=E.get(i),d=v.get(i),y=n._buildInstruction(t,r,d,p,f);if(y.errors&&y.errors.length)T.push(y);else{if(f)return e.onStart(function(){return jc(i,y.fromStyles)}),e.onDestroy(function(){return Mc(i,y.toStyles)}),void o.push(e);if(t.isFallbackTransition)return e.onStart(function(){return jc(i,y.fromStyles)}),e.onDestroy(function(){return Mc(i,y.toStyles)}),void o.push(e);y.timelines.forEach(function(t){return t.stretchStartingKeyframe=!0}),r.append(i,y.timelines),s.push({instruction:y,player:e,element:i}),y.queriedElements.forEach(function(t){return pc(u,t,[]).push(e)}),y.preStyleProps.forEach(function(t,e){var n=Object.keys(t);if(n.length){var r=c.get(e);r||c.set(e,r=new Set),n.forEach(function(t){return r.add(t)})}}),y.postStyleProps.forEach(function(t,e){var n=Object.keys(t),r=l.get(e);r||l.set(e,r=new Set),n.forEach(function(t){return r.add(t)})})}});if(T.length){var A=[];T.forEach(function(t){A.push("@"+t.triggerName+" has failed due to:\n"),t.errors.forEach(function(t){return A.push("- "+t+"\n")})}),x.forEach(function(t){return t.destroy()}),this.reportError(A)}var I=new Map,C=new Map;s.forEach(function(t){var e=t.element;r.has(e)&&(C.set(e,e),n._beforeAnimationBuild(t.player.namespaceId,t.instruction,I))}),o.forEach(function(t){var e=t.element;n._getPreviousPlayers(e,!1,t.namespaceId,t.triggerName,null).forEach(function(t){pc(I,e,[]).push(t),t.destroy()})});var O=m.filter(function(t){return Wl(t,c,l)}),P=new Map;Ll(P,this.driver,_,l,$u).forEach(function(t){Wl(t,c,l)&&O.push(t)});var R=new Map;y.forEach(function(t,e){Ll(R,n.driver,new Set(t),c,ic)}),O.forEach(function(t){var e=P.get(t),n=R.get(t);P.set(t,i({},e,n))});var D=[],N=[],M={};s.forEach(function(t){var e=t.element,i=t.player,s=t.instruction;if(r.has(e)){if(f.has(e))return i.onDestroy(function(){return Mc(e,s.toStyles)}),i.disabled=!0,i.overrideTotalTime(s.totalTime),void o.push(i);var u=M;if(C.size>1){for(var c=e,l=[];c=c.parentNode;){var p=C.get(c);if(p){u=p;break}l.push(c)}l.forEach(function(t){return C.set(t,u)})}var h=n._buildAnimation(i.namespaceId,s,I,a,R,P);if(i.setRealPlayer(h),u===M)D.push(i);else{var d=n.playersByElement.get(u);d&&d.length&&(i.parentPlayer=sc(d)),o.push(i)}}else jc(e,s.fromStyles),i.onDestroy(function(){return Mc(e,s.toStyles)}),N.push(i),f.has(e)&&o.push(i)}),N.forEach(function(t){var e=a.get(t.element);if(e&&e.length){var n=sc(e);t.setRealPlayer(n)}}),o.forEach(function(t){t.parentPlayer?t.syncPlayerEvents(t.parentPlayer):t.destroy()});for(var j=0;j<m.length;j++){var F,L=(F=m[j])[Ol];if(zl(F,"ng-leave"),!L||!L.hasAnimation){var B=[];if(u.size){var V=u.get(F);V&&V.length&&B.push.apply(B,p(V));for(var H=this.driver.query(F,".ng-animating",!0),z=0;z<H.length;z++){var U=u.get(H[z]);U&&U.length&&B.push.apply(B,p(U))}}var W=B.filter(function(t){return!t.destroyed});W.length?Ul(this,F,W):this.processLeaveNode(F)}}return m.length=0,D.forEach(function(t){n.players.push(t),t.onDone(function(){t.destroy();var e=n.players.indexOf(t);n.players.splice(e,1)}),t.play()}),D},t.prototype.elementContainsData=function(t,e){var n=!1,r=e[Ol];return r&&r.setForRemoval&&(n=!0),this.playersByElement.has(e)&&(n=!0),this.playersByQueriedElement.has(e)&&(n=!0),this.statesByElement.has(e)&&(n=!0),this._fetchNamespace(t).elementContainsData(e)||n},t.prototype.afterFlush=function(t){this._flushFns.push(t)},t.prototype.afterFlushAnimationsDone=function(t){this._whenQuietFns.push(t)},t.prototype._getPreviousPlayers=function(t,e,n,r,o){var i=[];if(e){var a=this.playersByQueriedElement.get(t);a&&(i=a)}else{var s=this.playersByElement.get(t);if(s){var u=!o||"void"==o;s.forEach(function(t){t.queued||(u||t.triggerName==r)&&i.push(t)})}}return(n||r)&&(i=i.filter(function(t){return!(n&&n!=t.namespaceId||r&&r!=t.triggerName)})),i},t.prototype._beforeAnimationBuild=function(t,e,n){var r,o,i=e.element,a=e.isRemovalTransition?void 0:t,s=e.isRemovalTransition?void 0:e.triggerName,u=function(t){var r=t.element,o=r!==i,u=pc(n,r,[]);c._getPreviousPlayers(r,o,a,s,e.toState).forEach(function(t){var e=t.getRealPlayer();e.beforeDestroy&&e.beforeDestroy(),t.destroy(),u.push(t)})},c=this;try{for(var f=l(e.timelines),p=f.next();!p.done;p=f.next())u(p.value)}catch(h){r={error:h}}finally{try{p&&!p.done&&(o=f.return)&&o.call(f)}finally{if(r)throw r.error}}jc(i,e.fromStyles)},t.prototype._buildAnimation=function(t,e,n,r,o,i){var a=this,s=e.triggerName,u=e.element,c=[],l=new Set,f=new Set,p=e.timelines.map(function(e){var p=e.element;l.add(p);var h=p[Ol];if(h&&h.removedBeforeQueried)return new rc(e.duration,e.delay);var d,y,v=p!==u,g=(d=(n.get(p)||Al).map(function(t){return t.getRealPlayer()}),y=[],function t(e,n){for(var r=0;r<e.length;r++){var o=e[r];o instanceof oc?t(o.players,n):n.push(o)}}(d,y),y).filter(function(t){return!!t.element&&t.element===p}),m=o.get(p),b=i.get(p),_=uc(0,a._normalizer,0,e.keyframes,m,b),w=a._buildPlayer(e,_,g);if(e.subTimeline&&r&&f.add(p),v){var E=new Ml(t,s,p);E.setRealPlayer(w),c.push(E)}return w});c.forEach(function(t){pc(a.playersByQueriedElement,t.element,[]).push(t),t.onDone(function(){return function(t,e,n){var r;if(t instanceof Map){if(r=t.get(e)){if(r.length){var o=r.indexOf(n);r.splice(o,1)}0==r.length&&t.delete(e)}}else(r=t[e])&&(r.length&&(o=r.indexOf(n),r.splice(o,1)),0==r.length&&delete t[e]);return r}(a.playersByQueriedElement,t.element,t)})}),l.forEach(function(t){return Hl(t,"ng-animating")});var h=sc(p);return h.onDestroy(function(){l.forEach(function(t){return zl(t,"ng-animating")}),Mc(u,e.toStyles)}),f.forEach(function(t){pc(r,t,[]).push(h)}),h},t.prototype._buildPlayer=function(t,e,n){return e.length>0?this.driver.animate(t.element,e,t.duration,t.delay,t.easing,n):new rc(t.duration,t.delay)},t}(),Ml=function(){function t(t,e,n){this.namespaceId=t,this.triggerName=e,this.element=n,this._player=new rc,this._containsRealPlayer=!1,this._queuedCallbacks={},this.destroyed=!1,this.markedForDestroy=!1,this.disabled=!1,this.queued=!0,this.totalTime=0}return t.prototype.setRealPlayer=function(t){var e=this;this._containsRealPlayer||(this._player=t,Object.keys(this._queuedCallbacks).forEach(function(n){e._queuedCallbacks[n].forEach(function(e){return cc(t,n,void 0,e)})}),this._queuedCallbacks={},this._containsRealPlayer=!0,this.overrideTotalTime(t.totalTime),this.queued=!1)},t.prototype.getRealPlayer=function(){return this._player},t.prototype.overrideTotalTime=function(t){this.totalTime=t},t.prototype.syncPlayerEvents=function(t){var e=this,n=this._player;n.triggerCallback&&t.onStart(function(){return n.triggerCallback("start")}),t.onDone(function(){return e.finish()}),t.onDestroy(function(){return e.destroy()})},t.prototype._queueEvent=function(t,e){pc(this._queuedCallbacks,t,[]).push(e)},t.prototype.onDone=function(t){this.queued&&this._queueEvent("done",t),this._player.onDone(t)},t.prototype.onStart=function(t){this.queued&&this._queueEvent("start",t),this._player.onStart(t)},t.prototype.onDestroy=function(t){this.queued&&this._queueEvent("destroy",t),this._player.onDestroy(t)},t.prototype.init=function(){this._player.init()},t.prototype.hasStarted=function(){return!this.queued&&this._player.hasStarted()},t.prototype.play=function(){!this.queued&&this._player.play()},t.prototype.pause=function(){!this.queued&&this._player.pause()},t.prototype.restart=function(){!this.queued&&this._player.restart()},t.prototype.finish=function(){this._player.finish()},t.prototype.destroy=function(){this.destroyed=!0,this._player.destroy()},t.prototype.reset=function(){!this.queued&&this._player.reset()},t.prototype.setPosition=function(t){this.queued||this._player.setPosition(t)},t.prototype.getPosition=function(){return this.queued?0:this._player.getPosition()},t.prototype.triggerCallback=function(t){var e=this._player;e.triggerCallback&&e.triggerCallback(t)},t}();function jl(t){return t&&1===t.nodeType}function Fl(t,e){var n=t.style.display;return t.style.display=null!=e?e:"none",n}function Ll(t,e,n,r,o){var i=[];n.forEach(function(t){return i.push(Fl(t))});var a=[];r.forEach(function(n,r){var i={};n.forEach(function(t){var n=i[t]=e.computeStyle(r,t,o);n&&0!=n.length||(r[Ol]=Cl,a.push(r))}),t.set(r,i)});var s=0;return n.forEach(function(t){return Fl(t,i[s++])}),a}function Bl(t,e){var n=new Map;if(t.forEach(function(t){return n.set(t,[])}),0==e.length)return n;var r=new Set(e),o=new Map;return e.forEach(function(t){var e=function t(e){if(!e)return 1;var i=o.get(e);if(i)return i;var a=e.parentNode;return i=n.has(a)?a:r.has(a)?1:t(a),o.set(e,i),i}(t);1!==e&&n.get(e).push(t)}),n}var Vl="$$classes";function Hl(t,e){if(t.classList)t.classList.add(e);else{var n=t[Vl];n||(n=t[Vl]={}),n[e]=!0}}function zl(t,e){if(t.classList)t.classList.remove(e);else{var n=t[Vl];n&&delete n[e]}}function Ul(t,e,n){sc(n).onDone(function(){return t.processLeaveNode(e)})}function Wl(t,e,n){var r=n.get(t);if(!r)return!1;var o=e.get(t);return o?r.forEach(function(t){return o.add(t)}):e.set(t,r),n.delete(t),!0}var Zl=function(){function t(t,e,n){var r=this;this.bodyNode=t,this._driver=e,this._triggerCache={},this.onRemovalComplete=function(t,e){},this._transitionEngine=new Nl(t,e,n),this._timelineEngine=new kl(t,e,n),this._transitionEngine.onRemovalComplete=function(t,e){return r.onRemovalComplete(t,e)}}return t.prototype.registerTrigger=function(t,e,n,r,o){var i=t+"-"+r,a=this._triggerCache[i];if(!a){var s=[],u=$c(this._driver,o,s);if(s.length)throw new Error('The animation trigger "'+r+'" has failed to build due to the following errors:\n - '+s.join("\n - "));a=function(t,e){return new Sl(t,e)}(r,u),this._triggerCache[i]=a}this._transitionEngine.registerTrigger(e,r,a)},t.prototype.register=function(t,e){this._transitionEngine.register(t,e)},t.prototype.destroy=function(t,e){this._transitionEngine.destroy(t,e)},t.prototype.onInsert=function(t,e,n,r){this._transitionEngine.insertNode(t,e,n,r)},t.prototype.onRemove=function(t,e,n,r){this._transitionEngine.removeNode(t,e,r||!1,n)},t.prototype.disableAnimations=function(t,e){this._transitionEngine.markElementAsDisabled(t,e)},t.prototype.process=function(t,e,n,r){if("@"==n.charAt(0)){var o=f(hc(n),2);this._timelineEngine.command(o[0],e,o[1],r)}else this._transitionEngine.trigger(t,e,n,r)},t.prototype.listen=function(t,e,n,r,o){if("@"==n.charAt(0)){var i=f(hc(n),2);return this._timelineEngine.listen(i[0],e,i[1],o)}return this._transitionEngine.listen(t,e,n,r,o)},t.prototype.flush=function(t){void 0===t&&(t=-1),this._transitionEngine.flush(t)},Object.defineProperty(t.prototype,"players",{get:function(){return this._transitionEngine.players.concat(this._timelineEngine.players)},enumerable:!0,configurable:!0}),t.prototype.whenRenderingDone=function(){return this._transitionEngine.whenRenderingDone()},t}();function ql(t,e){var n=null,r=null;return Array.isArray(e)&&e.length?(n=Kl(e[0]),e.length>1&&(r=Kl(e[e.length-1]))):e&&(n=Kl(e)),n||r?new Gl(t,n,r):null}var Gl=function(){function t(e,n,r){this._element=e,this._startStyles=n,this._endStyles=r,this._state=0;var o=t.initialStylesByElement.get(e);o||t.initialStylesByElement.set(e,o={}),this._initialStyles=o}return t.prototype.start=function(){this._state<1&&(this._startStyles&&Mc(this._element,this._startStyles,this._initialStyles),this._state=1)},t.prototype.finish=function(){this.start(),this._state<2&&(Mc(this._element,this._initialStyles),this._endStyles&&(Mc(this._element,this._endStyles),this._endStyles=null),this._state=1)},t.prototype.destroy=function(){this.finish(),this._state<3&&(t.initialStylesByElement.delete(this._element),this._startStyles&&(jc(this._element,this._startStyles),this._endStyles=null),this._endStyles&&(jc(this._element,this._endStyles),this._endStyles=null),Mc(this._element,this._initialStyles),this._state=3)},t.initialStylesByElement=new WeakMap,t}();function Kl(t){for(var e=null,n=Object.keys(t),r=0;r<n.length;r++){var o=n[r];Ql(o)&&((e=e||{})[o]=t[o])}return e}function Ql(t){return"display"===t||"position"===t}var Yl="animation",Xl="animationend",Jl=function(){function t(t,e,n,r,o,i,a){var s=this;this._element=t,this._name=e,this._duration=n,this._delay=r,this._easing=o,this._fillMode=i,this._onDoneFn=a,this._finished=!1,this._destroyed=!1,this._startTime=0,this._position=0,this._eventFn=function(t){return s._handleCallback(t)}}return t.prototype.apply=function(){var t,e,n;e=this._duration+"ms "+this._easing+" "+this._delay+"ms 1 normal "+this._fillMode+" "+this._name,(n=of(t=this._element,"").trim()).length&&(function(t,e){for(var n=0;n<t.length;n++)","===t.charAt(n)&&0}(n),e=n+", "+e),rf(t,"",e),nf(this._element,this._eventFn,!1),this._startTime=Date.now()},t.prototype.pause=function(){$l(this._element,this._name,"paused")},t.prototype.resume=function(){$l(this._element,this._name,"running")},t.prototype.setPosition=function(t){var e=tf(this._element,this._name);this._position=t*this._duration,rf(this._element,"Delay","-"+this._position+"ms",e)},t.prototype.getPosition=function(){return this._position},t.prototype._handleCallback=function(t){var e=t._ngTestManualTimestamp||Date.now(),n=1e3*parseFloat(t.elapsedTime.toFixed(3));t.animationName==this._name&&Math.max(e-this._startTime,0)>=this._delay&&n>=this._duration&&this.finish()},t.prototype.finish=function(){this._finished||(this._finished=!0,this._onDoneFn(),nf(this._element,this._eventFn,!0))},t.prototype.destroy=function(){var t,e,n,r;this._destroyed||(this._destroyed=!0,this.finish(),e=this._name,(r=ef(n=of(t=this._element,"").split(","),e))>=0&&(n.splice(r,1),rf(t,"",n.join(","))))},t}();function $l(t,e,n){rf(t,"PlayState",n,tf(t,e))}function tf(t,e){var n=of(t,"");return n.indexOf(",")>0?ef(n.split(","),e):ef([n],e)}function ef(t,e){for(var n=0;n<t.length;n++)if(t[n].indexOf(e)>=0)return n;return-1}function nf(t,e,n){n?t.removeEventListener(Xl,e):t.addEventListener(Xl,e)}function rf(t,e,n,r){var o=Yl+e;if(null!=r){var i=t.style[o];if(i.length){var a=i.split(",");a[r]=n,n=a.join(",")}}t.style[o]=n}function of(t,e){return t.style[Yl+e]}var af="linear",sf=function(){function t(t,e,n,r,o,i,a,s){this.element=t,this.keyframes=e,this.animationName=n,this._duration=r,this._delay=o,this._finalStyles=a,this._specialStyles=s,this._onDoneFns=[],this._onStartFns=[],this._onDestroyFns=[],this._started=!1,this.currentSnapshot={},this._state=0,this.easing=i||af,this.totalTime=r+o,this._buildStyler()}return t.prototype.onStart=function(t){this._onStartFns.push(t)},t.prototype.onDone=function(t){this._onDoneFns.push(t)},t.prototype.onDestroy=function(t){this._onDestroyFns.push(t)},t.prototype.destroy=function(){this.init(),this._state>=4||(this._state=4,this._styler.destroy(),this._flushStartFns(),this._flushDoneFns(),this._specialStyles&&this._specialStyles.destroy(),this._onDestroyFns.forEach(function(t){return t()}),this._onDestroyFns=[])},t.prototype._flushDoneFns=function(){this._onDoneFns.forEach(function(t){return t()}),this._onDoneFns=[]},t.prototype._flushStartFns=function(){this._onStartFns.forEach(function(t){return t()}),this._onStartFns=[]},t.prototype.finish=function(){this.init(),this._state>=3||(this._state=3,this._styler.finish(),this._flushStartFns(),this._specialStyles&&this._specialStyles.finish(),this._flushDoneFns())},t.prototype.setPosition=function(t){this._styler.setPosition(t)},t.prototype.getPosition=function(){return this._styler.getPosition()},t.prototype.hasStarted=function(){return this._state>=2},t.prototype.init=function(){this._state>=1||(this._state=1,this._styler.apply(),this._delay&&this._styler.pause())},t.prototype.play=function(){this.init(),this.hasStarted()||(this._flushStartFns(),this._state=2,this._specialStyles&&this._specialStyles.start()),this._styler.resume()},t.prototype.pause=function(){this.init(),this._styler.pause()},t.prototype.restart=function(){this.reset(),this.play()},t.prototype.reset=function(){this._styler.destroy(),this._buildStyler(),this._styler.apply()},t.prototype._buildStyler=function(){var t=this;this._styler=new Jl(this.element,this.animationName,this._duration,this._delay,this.easing,"forwards",function(){return t.finish()})},t.prototype.triggerCallback=function(t){var e="start"==t?this._onStartFns:this._onDoneFns;e.forEach(function(t){return t()}),e.length=0},t.prototype.beforeDestroy=function(){var t=this;this.init();var e={};if(this.hasStarted()){var n=this._state>=3;Object.keys(this._finalStyles).forEach(function(r){"offset"!=r&&(e[r]=n?t._finalStyles[r]:Gc(t.element,r))})}this.currentSnapshot=e},t}(),uf=function(t){function e(e,n){var r=t.call(this)||this;return r.element=e,r._startingStyles={},r.__initialized=!1,r._styles=xc(n),r}return o(e,t),e.prototype.init=function(){var e=this;!this.__initialized&&this._startingStyles&&(this.__initialized=!0,Object.keys(this._styles).forEach(function(t){e._startingStyles[t]=e.element.style[t]}),t.prototype.init.call(this))},e.prototype.play=function(){var e=this;this._startingStyles&&(this.init(),Object.keys(this._styles).forEach(function(t){return e.element.style.setProperty(t,e._styles[t])}),t.prototype.play.call(this))},e.prototype.destroy=function(){var e=this;this._startingStyles&&(Object.keys(this._startingStyles).forEach(function(t){var n=e._startingStyles[t];n?e.element.style.setProperty(t,n):e.element.style.removeProperty(t)}),this._startingStyles=null,t.prototype.destroy.call(this))},e}(rc),cf=function(){function t(){this._count=0,this._head=document.querySelector("head"),this._warningIssued=!1}return t.prototype.validateStyleProperty=function(t){return _c(t)},t.prototype.matchesElement=function(t,e){return wc(t,e)},t.prototype.containsElement=function(t,e){return Ec(t,e)},t.prototype.query=function(t,e,n){return Sc(t,e,n)},t.prototype.computeStyle=function(t,e,n){return window.getComputedStyle(t)[e]},t.prototype.buildKeyframeElement=function(t,e,n){n=n.map(function(t){return xc(t)});var r="@keyframes "+e+" {\n",o="";n.forEach(function(t){o=" ";var e=parseFloat(t.offset);r+=""+o+100*e+"% {\n",o+=" ",Object.keys(t).forEach(function(e){var n=t[e];switch(e){case"offset":return;case"easing":return void(n&&(r+=o+"animation-timing-function: "+n+";\n"));default:return void(r+=""+o+e+": "+n+";\n")}}),r+=o+"}\n"}),r+="}\n";var i=document.createElement("style");return i.innerHTML=r,i},t.prototype.animate=function(t,e,n,r,o,i,a){void 0===i&&(i=[]),a&&this._notifyFaultyScrubber();var s=i.filter(function(t){return t instanceof sf}),u={};Wc(n,r)&&s.forEach(function(t){var e=t.currentSnapshot;Object.keys(e).forEach(function(t){return u[t]=e[t]})});var c=function(t){var e={};return t&&(Array.isArray(t)?t:[t]).forEach(function(t){Object.keys(t).forEach(function(n){"offset"!=n&&"easing"!=n&&(e[n]=t[n])})}),e}(e=Zc(t,e,u));if(0==n)return new uf(t,c);var l="gen_css_kf_"+this._count++,f=this.buildKeyframeElement(t,l,e);document.querySelector("head").appendChild(f);var p=ql(t,e),h=new sf(t,e,l,n,r,o,c,p);return h.onDestroy(function(){var t;(t=f).parentNode.removeChild(t)}),h},t.prototype._notifyFaultyScrubber=function(){this._warningIssued||(console.warn("@angular/animations: please load the web-animations.js polyfill to allow programmatic access...\n","  visit http://bit.ly/IWukam to learn more about using the web-animation-js polyfill."),this._warningIssued=!0)},t}(),lf=function(){function t(t,e,n,r){this.element=t,this.keyframes=e,this.options=n,this._specialStyles=r,this._onDoneFns=[],this._onStartFns=[],this._onDestroyFns=[],this._initialized=!1,this._finished=!1,this._started=!1,this._destroyed=!1,this.time=0,this.parentPlayer=null,this.currentSnapshot={},this._duration=n.duration,this._delay=n.delay||0,this.time=this._duration+this._delay}return t.prototype._onFinish=function(){this._finished||(this._finished=!0,this._onDoneFns.forEach(function(t){return t()}),this._onDoneFns=[])},t.prototype.init=function(){this._buildPlayer(),this._preparePlayerBeforeStart()},t.prototype._buildPlayer=function(){var t=this;if(!this._initialized){this._initialized=!0;var e=this.keyframes;this.domPlayer=this._triggerWebAnimation(this.element,e,this.options),this._finalKeyframe=e.length?e[e.length-1]:{},this.domPlayer.addEventListener("finish",function(){return t._onFinish()})}},t.prototype._preparePlayerBeforeStart=function(){this._delay?this._resetDomPlayerState():this.domPlayer.pause()},t.prototype._triggerWebAnimation=function(t,e,n){return t.animate(e,n)},t.prototype.onStart=function(t){this._onStartFns.push(t)},t.prototype.onDone=function(t){this._onDoneFns.push(t)},t.prototype.onDestroy=function(t){this._onDestroyFns.push(t)},t.prototype.play=function(){this._buildPlayer(),this.hasStarted()||(this._onStartFns.forEach(function(t){return t()}),this._onStartFns=[],this._started=!0,this._specialStyles&&this._specialStyles.start()),this.domPlayer.play()},t.prototype.pause=function(){this.init(),this.domPlayer.pause()},t.prototype.finish=function(){this.init(),this._specialStyles&&this._specialStyles.finish(),this._onFinish(),this.domPlayer.finish()},t.prototype.reset=function(){this._resetDomPlayerState(),this._destroyed=!1,this._finished=!1,this._started=!1},t.prototype._resetDomPlayerState=function(){this.domPlayer&&this.domPlayer.cancel()},t.prototype.restart=function(){this.reset(),this.play()},t.prototype.hasStarted=function(){return this._started},t.prototype.destroy=function(){this._destroyed||(this._destroyed=!0,this._resetDomPlayerState(),this._onFinish(),this._specialStyles&&this._specialStyles.destroy(),this._onDestroyFns.forEach(function(t){return t()}),this._onDestroyFns=[])},t.prototype.setPosition=function(t){this.domPlayer.currentTime=t*this.time},t.prototype.getPosition=function(){return this.domPlayer.currentTime/this.time},Object.defineProperty(t.prototype,"totalTime",{get:function(){return this._delay+this._duration},enumerable:!0,configurable:!0}),t.prototype.beforeDestroy=function(){var t=this,e={};this.hasStarted()&&Object.keys(this._finalKeyframe).forEach(function(n){"offset"!=n&&(e[n]=t._finished?t._finalKeyframe[n]:Gc(t.element,n))}),this.currentSnapshot=e},t.prototype.triggerCallback=function(t){var e="start"==t?this._onStartFns:this._onDoneFns;e.forEach(function(t){return t()}),e.length=0},t}(),ff=function(){function t(){this._isNativeImpl=/\{\s*\[native\s+code\]\s*\}/.test(pf().toString()),this._cssKeyframesDriver=new cf}return t.prototype.validateStyleProperty=function(t){return _c(t)},t.prototype.matchesElement=function(t,e){return wc(t,e)},t.prototype.containsElement=function(t,e){return Ec(t,e)},t.prototype.query=function(t,e,n){return Sc(t,e,n)},t.prototype.computeStyle=function(t,e,n){return window.getComputedStyle(t)[e]},t.prototype.overrideWebAnimationsSupport=function(t){this._isNativeImpl=t},t.prototype.animate=function(t,e,n,r,o,i,a){if(void 0===i&&(i=[]),!a&&!this._isNativeImpl)return this._cssKeyframesDriver.animate(t,e,n,r,o,i);var s={duration:n,delay:r,fill:0==r?"both":"forwards"};o&&(s.easing=o);var u={},c=i.filter(function(t){return t instanceof lf});Wc(n,r)&&c.forEach(function(t){var e=t.currentSnapshot;Object.keys(e).forEach(function(t){return u[t]=e[t]})});var l=ql(t,e=Zc(t,e=e.map(function(t){return Rc(t,!1)}),u));return new lf(t,e,s,l)},t}();function pf(){return"undefined"!=typeof window&&void 0!==window.document&&Element.prototype.animate||{}}var hf=function(t){function e(e,n){var r=t.call(this)||this;return r._nextAnimationId=0,r._renderer=e.createRenderer(n.body,{id:"0",encapsulation:qt.None,styles:[],data:{animation:[]}}),r}return o(e,t),e.prototype.build=function(t){var e=this._nextAnimationId.toString();this._nextAnimationId++;var n=Array.isArray(t)?tc(t):t;return vf(this._renderer,null,e,"register",[n]),new df(e,this._renderer)},e}(Xu),df=function(t){function e(e,n){var r=t.call(this)||this;return r._id=e,r._renderer=n,r}return o(e,t),e.prototype.create=function(t,e){return new yf(this._id,t,e||{},this._renderer)},e}(Ju),yf=function(){function t(t,e,n,r){this.id=t,this.element=e,this._renderer=r,this.parentPlayer=null,this._started=!1,this.totalTime=0,this._command("create",n)}return t.prototype._listen=function(t,e){return this._renderer.listen(this.element,"@@"+this.id+":"+t,e)},t.prototype._command=function(t){for(var e=[],n=1;n<arguments.length;n++)e[n-1]=arguments[n];return vf(this._renderer,this.element,this.id,t,e)},t.prototype.onDone=function(t){this._listen("done",t)},t.prototype.onStart=function(t){this._listen("start",t)},t.prototype.onDestroy=function(t){this._listen("destroy",t)},t.prototype.init=function(){this._command("init")},t.prototype.hasStarted=function(){return this._started},t.prototype.play=function(){this._command("play"),this._started=!0},t.prototype.pause=function(){this._command("pause")},t.prototype.restart=function(){this._command("restart")},t.prototype.finish=function(){this._command("finish")},t.prototype.destroy=function(){this._command("destroy")},t.prototype.reset=function(){this._command("reset")},t.prototype.setPosition=function(t){this._command("setPosition",t)},t.prototype.getPosition=function(){return 0},t}();function vf(t,e,n,r,o){return t.setProperty(e,"@@"+n+":"+r,o)}var gf=function(){function t(t,e,n){this.delegate=t,this.engine=e,this._zone=n,this._currentId=0,this._microtaskId=1,this._animationCallbacksBuffer=[],this._rendererCache=new Map,this._cdRecurDepth=0,this.promise=Promise.resolve(0),e.onRemovalComplete=function(t,e){e&&e.parentNode(t)&&e.removeChild(t.parentNode,t)}}return t.prototype.createRenderer=function(t,e){var n=this,r=this.delegate.createRenderer(t,e);if(!(t&&e&&e.data&&e.data.animation)){var o=this._rendererCache.get(r);return o||(o=new mf("",r,this.engine),this._rendererCache.set(r,o)),o}var i=e.id,a=e.id+"-"+this._currentId;return this._currentId++,this.engine.register(a,t),e.data.animation.forEach(function(e){return n.engine.registerTrigger(i,a,t,e.name,e)}),new bf(this,a,r,this.engine)},t.prototype.begin=function(){this._cdRecurDepth++,this.delegate.begin&&this.delegate.begin()},t.prototype._scheduleCountTask=function(){var t=this;this.promise.then(function(){t._microtaskId++})},t.prototype.scheduleListenerCallback=function(t,e,n){var r=this;t>=0&&t<this._microtaskId?this._zone.run(function(){return e(n)}):(0==this._animationCallbacksBuffer.length&&Promise.resolve(null).then(function(){r._zone.run(function(){r._animationCallbacksBuffer.forEach(function(t){var e=f(t,2);(0,e[0])(e[1])}),r._animationCallbacksBuffer=[]})}),this._animationCallbacksBuffer.push([e,n]))},t.prototype.end=function(){var t=this;this._cdRecurDepth--,0==this._cdRecurDepth&&this._zone.runOutsideAngular(function(){t._scheduleCountTask(),t.engine.flush(t._microtaskId)}),this.delegate.end&&this.delegate.end()},t.prototype.whenRenderingDone=function(){return this.engine.whenRenderingDone()},t}(),mf=function(){function t(t,e,n){this.namespaceId=t,this.delegate=e,this.engine=n,this.destroyNode=this.delegate.destroyNode?function(t){return e.destroyNode(t)}:null}return Object.defineProperty(t.prototype,"data",{get:function(){return this.delegate.data},enumerable:!0,configurable:!0}),t.prototype.destroy=function(){this.engine.destroy(this.namespaceId,this.delegate),this.delegate.destroy()},t.prototype.createElement=function(t,e){return this.delegate.createElement(t,e)},t.prototype.createComment=function(t){return this.delegate.createComment(t)},t.prototype.createText=function(t){return this.delegate.createText(t)},t.prototype.appendChild=function(t,e){this.delegate.appendChild(t,e),this.engine.onInsert(this.namespaceId,e,t,!1)},t.prototype.insertBefore=function(t,e,n){this.delegate.insertBefore(t,e,n),this.engine.onInsert(this.namespaceId,e,t,!0)},t.prototype.removeChild=function(t,e,n){this.engine.onRemove(this.namespaceId,e,this.delegate,n)},t.prototype.selectRootElement=function(t,e){return this.delegate.selectRootElement(t,e)},t.prototype.parentNode=function(t){return this.delegate.parentNode(t)},t.prototype.nextSibling=function(t){return this.delegate.nextSibling(t)},t.prototype.setAttribute=function(t,e,n,r){this.delegate.setAttribute(t,e,n,r)},t.prototype.removeAttribute=function(t,e,n){this.delegate.removeAttribute(t,e,n)},t.prototype.addClass=function(t,e){this.delegate.addClass(t,e)},t.prototype.removeClass=function(t,e){this.delegate.removeClass(t,e)},t.prototype.setStyle=function(t,e,n,r){this.delegate.setStyle(t,e,n,r)},t.prototype.removeStyle=function(t,e,n){this.delegate.removeStyle(t,e,n)},t.prototype.setProperty=function(t,e,n){"@"==e.charAt(0)&&"@.disabled"==e?this.disableAnimations(t,!!n):this.delegate.setProperty(t,e,n)},t.prototype.setValue=function(t,e){this.delegate.setValue(t,e)},t.prototype.listen=function(t,e,n){return this.delegate.listen(t,e,n)},t.prototype.disableAnimations=function(t,e){this.engine.disableAnimations(t,e)},t}(),bf=function(t){function e(e,n,r,o){var i=t.call(this,n,r,o)||this;return i.factory=e,i.namespaceId=n,i}return o(e,t),e.prototype.setProperty=function(t,e,n){"@"==e.charAt(0)?"."==e.charAt(1)&&"@.disabled"==e?this.disableAnimations(t,n=void 0===n||!!n):this.engine.process(this.namespaceId,t,e.substr(1),n):this.delegate.setProperty(t,e,n)},e.prototype.listen=function(t,e,n){var r,o,i,a=this;if("@"==e.charAt(0)){var s=function(t){switch(t){case"body":return document.body;case"document":return document;case"window":return window;default:return t}}(t),u=e.substr(1),c="";return"@"!=u.charAt(0)&&(u=(r=f((o=u,i=o.indexOf("."),[o.substring(0,i),o.substr(i+1)]),2))[0],c=r[1]),this.engine.listen(this.namespaceId,s,u,c,function(t){a.factory.scheduleListenerCallback(t._data||-1,n,t)})}return this.delegate.listen(t,e,n)},e}(mf),_f=function(t){function e(e,n,r){return t.call(this,e.body,n,r)||this}return o(e,t),e}(Zl);function wf(){return"function"==typeof pf()?new ff:new cf}function Ef(){return new gl}function Sf(t,e,n){return new gf(t,e,n)}var xf=new kt("AnimationModuleType"),Tf=function(){return function(){}}(),kf=function(){return function(){}}(),Af=new nn("8.2.3");try{"undefined"!=typeof Intl&&Intl}catch(Df){}Error;var If=new nn("8.2.3"),Cf=new kt("mat-sanity-checks",{providedIn:"root",factory:function(){return!0}}),Of=function(){function t(t,e){this._sanityChecksEnabled=t,this._hammerLoader=e,this._hasDoneGlobalChecks=!1,this._hasCheckedHammer=!1,this._document="object"==typeof document&&document?document:null,this._window="object"==typeof window&&window?window:null,this._areChecksEnabled()&&!this._hasDoneGlobalChecks&&(this._checkDoctypeIsDefined(),this._checkThemeIsPresent(),this._checkCdkVersionMatch(),this._hasDoneGlobalChecks=!0)}return t.prototype._areChecksEnabled=function(){return this._sanityChecksEnabled&&te()&&!this._isTestEnv()},t.prototype._isTestEnv=function(){var t=this._window;return t&&(t.__karma__||t.jasmine)},t.prototype._checkDoctypeIsDefined=function(){this._document&&!this._document.doctype&&console.warn("Current document does not have a doctype. This may cause some Angular Material components not to behave as expected.")},t.prototype._checkThemeIsPresent=function(){if(this._document&&this._document.body&&"function"==typeof getComputedStyle){var t=this._document.createElement("div");t.classList.add("mat-theme-loaded-marker"),this._document.body.appendChild(t);var e=getComputedStyle(t);e&&"none"!==e.display&&console.warn("Could not find Angular Material core theme. Most Material components may not work as expected. For more info refer to the theming guide: https://material.angular.io/guide/theming"),this._document.body.removeChild(t)}},t.prototype._checkCdkVersionMatch=function(){If.full!==Af.full&&console.warn("The Angular Material version ("+If.full+") does not match the Angular CDK version ("+Af.full+").\nPlease ensure the versions of these two packages exactly match.")},t.prototype._checkHammerIsAvailable=function(){!this._hasCheckedHammer&&this._window&&(!this._areChecksEnabled()||this._window.Hammer||this._hammerLoader||console.warn("Could not find HammerJS. Certain Angular Material components may not work correctly."),this._hasCheckedHammer=!0)},t}(),Pf=function(){return function(){}}(),Rf=La(Ha,[Wa],function(t){return function(t){for(var e={},n=[],r=!1,o=0;o<t.length;o++){var i=t[o];i.token===Fe&&!0===i.value&&(r=!0),1073741824&i.flags&&n.push(i.token),i.index=o,e[Bn(i.token)]=i}return{factory:null,providersByKey:e,providers:t,modules:n,isRoot:r}}([br(512,Ue,We,[[8,[Yu]],[3,Ue],qe]),br(5120,pi,yi,[[3,pi]]),br(4608,Ja,$a,[pi,[2,Xa]]),br(5120,fo,vi,[Vo]),br(4608,Po,Po,[]),br(5120,go,mo,[]),br(5120,yn,hi,[]),br(5120,vn,di,[]),br(4608,cu,lu,[ss]),br(6144,Se,null,[cu]),br(4608,nu,ou,[]),br(5120,Cs,function(t,e,n,r,o,i,a,s){return[new tu(t,e,n),new uu(r),new iu(o,i,a,s)]},[ss,Vo,wo,ss,ss,nu,So,[2,ru]]),br(4608,Os,Os,[Cs,Vo]),br(135680,Ds,Ds,[ss]),br(4608,Vs,Vs,[Os,Ds,go]),br(5120,kc,wf,[]),br(5120,vl,Ef,[]),br(4608,Zl,_f,[ss,kc,vl]),br(5120,Je,Sf,[Vs,Zl,Vo]),br(6144,Rs,null,[Ds]),br(4608,Ko,Ko,[Vo]),br(4608,Xu,hf,[Je,ss]),br(1073742336,as,as,[]),br(1024,Xt,mu,[]),br(1024,yo,function(t){return[(e=t,ks("probe",Is),ks("coreTokens",i({},As,(e||[]).reduce(function(t,e){return t[e.name]=e.token,t},{}))),function(){return Is})];var e},[[2,Jo]]),br(512,vo,vo,[[2,yo]]),br(131584,ri,ri,[Vo,So,Pt,Xt,Ue,vo]),br(1073742336,gi,gi,[ri]),br(1073742336,bu,bu,[[3,bu]]),br(1073742336,Tf,Tf,[]),br(1073742336,kf,kf,[]),br(1073742336,Of,Of,[[2,Cf],[2,ru]]),br(1073742336,Pf,Pf,[]),br(1073742336,Ha,Ha,[]),br(256,Fe,!0,[]),br(256,xf,"BrowserAnimations",[]),br(256,qa,"/",[])])});(function(){if($t)throw new Error("Cannot enable prod mode after platform setup.");Jt=!1})(),Va.Init(function(){gu().bootstrapModuleFactory(Rf).catch(function(t){return console.error(t)})})},zfnd:function(t,e,n){var r=n("glrk"),o=n("hh1v"),i=n("8GlL");t.exports=function(t,e){if(r(t),o(e)&&e.constructor===t)return e;var n=i.f(t);return(0,n.resolve)(e),n.promise}},zk60:function(t,e,n){var r=n("2oRo");t.exports=function(t,e){try{Object.defineProperty(r,t,{value:e,configurable:!0,writable:!0})}catch(n){r[t]=e}return e}},zn8P:function(t,e){function n(t){return Promise.resolve().then(function(){var e=new Error("Cannot find module '"+t+"'");throw e.code="MODULE_NOT_FOUND",e})}n.keys=function(){return[]},n.resolve=n,t.exports=n,n.id="zn8P"},zuhW:function(t,e,n){var r=n("I+eb"),o=n("hh1v"),i=n("8YOa").onFreeze,a=n("uy83"),s=n("0Dky"),u=Object.preventExtensions;r({target:"Object",stat:!0,forced:s(function(){u(1)}),sham:!a},{preventExtensions:function(t){return u&&o(t)?u(i(t)):t}})}});
\ No newline at end of file
+!function(t){var e={};function n(r){if(e[r])return e[r].exports;var o=e[r]={i:r,l:!1,exports:{}};return t[r].call(o.exports,o,o.exports,n),o.l=!0,o.exports}n.m=t,n.c=e,n.d=function(t,e,r){n.o(t,e)||Object.defineProperty(t,e,{enumerable:!0,get:r})},n.r=function(t){"undefined"!=typeof Symbol&&Symbol.toStringTag&&Object.defineProperty(t,Symbol.toStringTag,{value:"Module"}),Object.defineProperty(t,"__esModule",{value:!0})},n.t=function(t,e){if(1&e&&(t=n(t)),8&e)return t;if(4&e&&"object"==typeof t&&t&&t.__esModule)return t;var r=Object.create(null);if(n.r(r),Object.defineProperty(r,"default",{enumerable:!0,value:t}),2&e&&"string"!=typeof t)for(var o in t)n.d(r,o,(function(e){return t[e]}).bind(null,o));return r},n.n=function(t){var e=t&&t.__esModule?function(){return t.default}:function(){return t};return n.d(e,"a",e),e},n.o=function(t,e){return Object.prototype.hasOwnProperty.call(t,e)},n.p="",n(n.s=0)}({"+2oP":function(t,e,n){"use strict";var r=n("I+eb"),o=n("hh1v"),i=n("6LWA"),a=n("I8vh"),s=n("UMSQ"),u=n("/GqU"),c=n("hBjN"),l=n("tiKp"),f=n("Hd5f")("slice"),p=l("species"),h=[].slice,d=Math.max;r({target:"Array",proto:!0,forced:!f},{slice:function(t,e){var n,r,l,f=u(this),y=s(f.length),v=a(t,y),g=a(void 0===e?y:e,y);if(i(f)&&("function"!=typeof(n=f.constructor)||n!==Array&&!i(n.prototype)?o(n)&&null===(n=n[p])&&(n=void 0):n=void 0,n===Array||void 0===n))return h.call(f,v,g);for(r=new(void 0===n?Array:n)(d(g-v,0)),l=0;v<g;v++,l++)v in f&&c(r,l,f[v]);return r.length=l,r}})},"+M1K":function(t,e,n){var r=n("ppGB");t.exports=function(t){var e=r(t);if(e<0)throw RangeError("The argument can't be less than 0");return e}},"+MnM":function(t,e,n){var r=n("I+eb"),o=n("2oRo"),i=n("1E5z");r({global:!0},{Reflect:{}}),i(o.Reflect,"Reflect",!0)},"+ywr":function(t,e,n){n("dOgj")("Uint32",function(t){return function(e,n,r){return t(this,e,n,r)}})},"/5zm":function(t,e,n){var r=n("I+eb"),o=n("jrUv"),i=Math.cosh,a=Math.abs,s=Math.E;r({target:"Math",stat:!0,forced:!i||i(710)===1/0},{cosh:function(t){var e=o(a(t)-1)+1;return(e+1/(e*s*s))*(s/2)}})},"/GqU":function(t,e,n){var r=n("RK3t"),o=n("HYAF");t.exports=function(t){return r(o(t))}},"/OPJ":function(t,e,n){var r=n("0Dky"),o=n("2oRo").RegExp;t.exports=r(function(){var t=o(".","s");return!(t.dotAll&&t.exec("\n")&&"s"===t.flags)})},"/Yfv":function(t,e,n){n("dOgj")("Int8",function(t){return function(e,n,r){return t(this,e,n,r)}})},"/b8u":function(t,e,n){var r=n("STAE");t.exports=r&&!Symbol.sham&&"symbol"==typeof Symbol.iterator},"/byt":function(t,e){t.exports={CSSRuleList:0,CSSStyleDeclaration:0,CSSValueList:0,ClientRectList:0,DOMRectList:0,DOMStringList:0,DOMTokenList:1,DataTransferItemList:0,FileList:0,HTMLAllCollection:0,HTMLCollection:0,HTMLFormElement:0,HTMLSelectElement:0,MediaList:0,MimeTypeArray:0,NamedNodeMap:0,NodeList:1,PaintRequestList:0,Plugin:0,PluginArray:0,SVGLengthList:0,SVGNumberList:0,SVGPathSegList:0,SVGPointList:0,SVGStringList:0,SVGTransformList:0,SourceBufferList:0,StyleSheetList:0,TextTrackCueList:0,TextTrackList:0,TouchList:0}},"/qmn":function(t,e,n){var r=n("2oRo");t.exports=r.Promise},0:function(t,e,n){t.exports=n("zUnb")},"07d7":function(t,e,n){var r=n("AO7/"),o=n("busE"),i=n("sEFX");r||o(Object.prototype,"toString",i,{unsafe:!0})},"0BK2":function(t,e){t.exports={}},"0Dky":function(t,e){t.exports=function(t){try{return!!t()}catch(e){return!0}}},"0GbY":function(t,e,n){var r=n("2oRo");t.exports=function(t,e){return arguments.length<2?"function"==typeof(n=r[t])?n:void 0:r[t]&&r[t][e];var n}},"0TWp":function(t,e,n){!function(){"use strict";!function(t){var e=t.performance;function n(t){e&&e.mark&&e.mark(t)}function r(t,n){e&&e.measure&&e.measure(t,n)}n("Zone");var o=!0===t.__zone_symbol__forceDuplicateZoneCheck;if(t.Zone){if(o||"function"!=typeof t.Zone.__symbol__)throw new Error("Zone already loaded.");return t.Zone}var i,a=function(){function e(t,e){this._parent=t,this._name=e?e.name||"unnamed":"<root>",this._properties=e&&e.properties||{},this._zoneDelegate=new u(this,this._parent&&this._parent._zoneDelegate,e)}return e.assertZonePatched=function(){if(t.Promise!==A.ZoneAwarePromise)throw new Error("Zone.js has detected that ZoneAwarePromise `(window|global).Promise` has been overwritten.\nMost likely cause is that a Promise polyfill has been loaded after Zone.js (Polyfilling Promise api is not necessary when zone.js is loaded. If you must load one, do so before loading zone.js.)")},Object.defineProperty(e,"root",{get:function(){for(var t=e.current;t.parent;)t=t.parent;return t},enumerable:!0,configurable:!0}),Object.defineProperty(e,"current",{get:function(){return C.zone},enumerable:!0,configurable:!0}),Object.defineProperty(e,"currentTask",{get:function(){return O},enumerable:!0,configurable:!0}),e.__load_patch=function(i,a){if(A.hasOwnProperty(i)){if(o)throw Error("Already loaded patch: "+i)}else if(!t["__Zone_disable_"+i]){var s="Zone:"+i;n(s),A[i]=a(t,e,I),r(s,s)}},Object.defineProperty(e.prototype,"parent",{get:function(){return this._parent},enumerable:!0,configurable:!0}),Object.defineProperty(e.prototype,"name",{get:function(){return this._name},enumerable:!0,configurable:!0}),e.prototype.get=function(t){var e=this.getZoneWith(t);if(e)return e._properties[t]},e.prototype.getZoneWith=function(t){for(var e=this;e;){if(e._properties.hasOwnProperty(t))return e;e=e._parent}return null},e.prototype.fork=function(t){if(!t)throw new Error("ZoneSpec required!");return this._zoneDelegate.fork(this,t)},e.prototype.wrap=function(t,e){if("function"!=typeof t)throw new Error("Expecting function got: "+t);var n=this._zoneDelegate.intercept(this,t,e),r=this;return function(){return r.runGuarded(n,this,arguments,e)}},e.prototype.run=function(t,e,n,r){C={parent:C,zone:this};try{return this._zoneDelegate.invoke(this,t,e,n,r)}finally{C=C.parent}},e.prototype.runGuarded=function(t,e,n,r){void 0===e&&(e=null),C={parent:C,zone:this};try{try{return this._zoneDelegate.invoke(this,t,e,n,r)}catch(o){if(this._zoneDelegate.handleError(this,o))throw o}}finally{C=C.parent}},e.prototype.runTask=function(t,e,n){if(t.zone!=this)throw new Error("A task can only be run in the zone of creation! (Creation: "+(t.zone||g).name+"; Execution: "+this.name+")");if(t.state!==m||t.type!==k&&t.type!==T){var r=t.state!=w;r&&t._transitionTo(w,_),t.runCount++;var o=O;O=t,C={parent:C,zone:this};try{t.type==T&&t.data&&!t.data.isPeriodic&&(t.cancelFn=void 0);try{return this._zoneDelegate.invokeTask(this,t,e,n)}catch(i){if(this._zoneDelegate.handleError(this,i))throw i}}finally{t.state!==m&&t.state!==S&&(t.type==k||t.data&&t.data.isPeriodic?r&&t._transitionTo(_,w):(t.runCount=0,this._updateTaskCount(t,-1),r&&t._transitionTo(m,w,m))),C=C.parent,O=o}}},e.prototype.scheduleTask=function(t){if(t.zone&&t.zone!==this)for(var e=this;e;){if(e===t.zone)throw Error("can not reschedule task to "+this.name+" which is descendants of the original zone "+t.zone.name);e=e.parent}t._transitionTo(b,m);var n=[];t._zoneDelegates=n,t._zone=this;try{t=this._zoneDelegate.scheduleTask(this,t)}catch(r){throw t._transitionTo(S,b,m),this._zoneDelegate.handleError(this,r),r}return t._zoneDelegates===n&&this._updateTaskCount(t,1),t.state==b&&t._transitionTo(_,b),t},e.prototype.scheduleMicroTask=function(t,e,n,r){return this.scheduleTask(new c(x,t,e,n,r,void 0))},e.prototype.scheduleMacroTask=function(t,e,n,r,o){return this.scheduleTask(new c(T,t,e,n,r,o))},e.prototype.scheduleEventTask=function(t,e,n,r,o){return this.scheduleTask(new c(k,t,e,n,r,o))},e.prototype.cancelTask=function(t){if(t.zone!=this)throw new Error("A task can only be cancelled in the zone of creation! (Creation: "+(t.zone||g).name+"; Execution: "+this.name+")");t._transitionTo(E,_,w);try{this._zoneDelegate.cancelTask(this,t)}catch(e){throw t._transitionTo(S,E),this._zoneDelegate.handleError(this,e),e}return this._updateTaskCount(t,-1),t._transitionTo(m,E),t.runCount=0,t},e.prototype._updateTaskCount=function(t,e){var n=t._zoneDelegates;-1==e&&(t._zoneDelegates=null);for(var r=0;r<n.length;r++)n[r]._updateTaskCount(t.type,e)},e.__symbol__=D,e}(),s={name:"",onHasTask:function(t,e,n,r){return t.hasTask(n,r)},onScheduleTask:function(t,e,n,r){return t.scheduleTask(n,r)},onInvokeTask:function(t,e,n,r,o,i){return t.invokeTask(n,r,o,i)},onCancelTask:function(t,e,n,r){return t.cancelTask(n,r)}},u=function(){function t(t,e,n){this._taskCounts={microTask:0,macroTask:0,eventTask:0},this.zone=t,this._parentDelegate=e,this._forkZS=n&&(n&&n.onFork?n:e._forkZS),this._forkDlgt=n&&(n.onFork?e:e._forkDlgt),this._forkCurrZone=n&&(n.onFork?this.zone:e.zone),this._interceptZS=n&&(n.onIntercept?n:e._interceptZS),this._interceptDlgt=n&&(n.onIntercept?e:e._interceptDlgt),this._interceptCurrZone=n&&(n.onIntercept?this.zone:e.zone),this._invokeZS=n&&(n.onInvoke?n:e._invokeZS),this._invokeDlgt=n&&(n.onInvoke?e:e._invokeDlgt),this._invokeCurrZone=n&&(n.onInvoke?this.zone:e.zone),this._handleErrorZS=n&&(n.onHandleError?n:e._handleErrorZS),this._handleErrorDlgt=n&&(n.onHandleError?e:e._handleErrorDlgt),this._handleErrorCurrZone=n&&(n.onHandleError?this.zone:e.zone),this._scheduleTaskZS=n&&(n.onScheduleTask?n:e._scheduleTaskZS),this._scheduleTaskDlgt=n&&(n.onScheduleTask?e:e._scheduleTaskDlgt),this._scheduleTaskCurrZone=n&&(n.onScheduleTask?this.zone:e.zone),this._invokeTaskZS=n&&(n.onInvokeTask?n:e._invokeTaskZS),this._invokeTaskDlgt=n&&(n.onInvokeTask?e:e._invokeTaskDlgt),this._invokeTaskCurrZone=n&&(n.onInvokeTask?this.zone:e.zone),this._cancelTaskZS=n&&(n.onCancelTask?n:e._cancelTaskZS),this._cancelTaskDlgt=n&&(n.onCancelTask?e:e._cancelTaskDlgt),this._cancelTaskCurrZone=n&&(n.onCancelTask?this.zone:e.zone),this._hasTaskZS=null,this._hasTaskDlgt=null,this._hasTaskDlgtOwner=null,this._hasTaskCurrZone=null;var r=n&&n.onHasTask;(r||e&&e._hasTaskZS)&&(this._hasTaskZS=r?n:s,this._hasTaskDlgt=e,this._hasTaskDlgtOwner=this,this._hasTaskCurrZone=t,n.onScheduleTask||(this._scheduleTaskZS=s,this._scheduleTaskDlgt=e,this._scheduleTaskCurrZone=this.zone),n.onInvokeTask||(this._invokeTaskZS=s,this._invokeTaskDlgt=e,this._invokeTaskCurrZone=this.zone),n.onCancelTask||(this._cancelTaskZS=s,this._cancelTaskDlgt=e,this._cancelTaskCurrZone=this.zone))}return t.prototype.fork=function(t,e){return this._forkZS?this._forkZS.onFork(this._forkDlgt,this.zone,t,e):new a(t,e)},t.prototype.intercept=function(t,e,n){return this._interceptZS?this._interceptZS.onIntercept(this._interceptDlgt,this._interceptCurrZone,t,e,n):e},t.prototype.invoke=function(t,e,n,r,o){return this._invokeZS?this._invokeZS.onInvoke(this._invokeDlgt,this._invokeCurrZone,t,e,n,r,o):e.apply(n,r)},t.prototype.handleError=function(t,e){return!this._handleErrorZS||this._handleErrorZS.onHandleError(this._handleErrorDlgt,this._handleErrorCurrZone,t,e)},t.prototype.scheduleTask=function(t,e){var n=e;if(this._scheduleTaskZS)this._hasTaskZS&&n._zoneDelegates.push(this._hasTaskDlgtOwner),(n=this._scheduleTaskZS.onScheduleTask(this._scheduleTaskDlgt,this._scheduleTaskCurrZone,t,e))||(n=e);else if(e.scheduleFn)e.scheduleFn(e);else{if(e.type!=x)throw new Error("Task is missing scheduleFn.");y(e)}return n},t.prototype.invokeTask=function(t,e,n,r){return this._invokeTaskZS?this._invokeTaskZS.onInvokeTask(this._invokeTaskDlgt,this._invokeTaskCurrZone,t,e,n,r):e.callback.apply(n,r)},t.prototype.cancelTask=function(t,e){var n;if(this._cancelTaskZS)n=this._cancelTaskZS.onCancelTask(this._cancelTaskDlgt,this._cancelTaskCurrZone,t,e);else{if(!e.cancelFn)throw Error("Task is not cancelable");n=e.cancelFn(e)}return n},t.prototype.hasTask=function(t,e){try{this._hasTaskZS&&this._hasTaskZS.onHasTask(this._hasTaskDlgt,this._hasTaskCurrZone,t,e)}catch(n){this.handleError(t,n)}},t.prototype._updateTaskCount=function(t,e){var n=this._taskCounts,r=n[t],o=n[t]=r+e;if(o<0)throw new Error("More tasks executed then were scheduled.");0!=r&&0!=o||this.hasTask(this.zone,{microTask:n.microTask>0,macroTask:n.macroTask>0,eventTask:n.eventTask>0,change:t})},t}(),c=function(){function e(n,r,o,i,a,s){this._zone=null,this.runCount=0,this._zoneDelegates=null,this._state="notScheduled",this.type=n,this.source=r,this.data=i,this.scheduleFn=a,this.cancelFn=s,this.callback=o;var u=this;this.invoke=n===k&&i&&i.useG?e.invokeTask:function(){return e.invokeTask.call(t,u,this,arguments)}}return e.invokeTask=function(t,e,n){t||(t=this),P++;try{return t.runCount++,t.zone.runTask(t,e,n)}finally{1==P&&v(),P--}},Object.defineProperty(e.prototype,"zone",{get:function(){return this._zone},enumerable:!0,configurable:!0}),Object.defineProperty(e.prototype,"state",{get:function(){return this._state},enumerable:!0,configurable:!0}),e.prototype.cancelScheduleRequest=function(){this._transitionTo(m,b)},e.prototype._transitionTo=function(t,e,n){if(this._state!==e&&this._state!==n)throw new Error(this.type+" '"+this.source+"': can not transition to '"+t+"', expecting state '"+e+"'"+(n?" or '"+n+"'":"")+", was '"+this._state+"'.");this._state=t,t==m&&(this._zoneDelegates=null)},e.prototype.toString=function(){return this.data&&void 0!==this.data.handleId?this.data.handleId.toString():Object.prototype.toString.call(this)},e.prototype.toJSON=function(){return{type:this.type,state:this.state,source:this.source,zone:this.zone.name,runCount:this.runCount}},e}(),l=D("setTimeout"),f=D("Promise"),p=D("then"),h=[],d=!1;function y(e){if(0===P&&0===h.length)if(i||t[f]&&(i=t[f].resolve(0)),i){var n=i[p];n||(n=i.then),n.call(i,v)}else t[l](v,0);e&&h.push(e)}function v(){if(!d){for(d=!0;h.length;){var t=h;h=[];for(var e=0;e<t.length;e++){var n=t[e];try{n.zone.runTask(n,null,null)}catch(r){I.onUnhandledError(r)}}}I.microtaskDrainDone(),d=!1}}var g={name:"NO ZONE"},m="notScheduled",b="scheduling",_="scheduled",w="running",E="canceling",S="unknown",x="microTask",T="macroTask",k="eventTask",A={},I={symbol:D,currentZoneFrame:function(){return C},onUnhandledError:R,microtaskDrainDone:R,scheduleMicroTask:y,showUncaughtError:function(){return!a[D("ignoreConsoleErrorUncaughtError")]},patchEventTarget:function(){return[]},patchOnProperties:R,patchMethod:function(){return R},bindArguments:function(){return[]},patchThen:function(){return R},patchMacroTask:function(){return R},setNativePromise:function(t){t&&"function"==typeof t.resolve&&(i=t.resolve(0))},patchEventPrototype:function(){return R},isIEOrEdge:function(){return!1},getGlobalObjects:function(){},ObjectDefineProperty:function(){return R},ObjectGetOwnPropertyDescriptor:function(){},ObjectCreate:function(){},ArraySlice:function(){return[]},patchClass:function(){return R},wrapWithCurrentZone:function(){return R},filterProperties:function(){return[]},attachOriginToPatched:function(){return R},_redefineProperty:function(){return R},patchCallbacks:function(){return R}},C={parent:null,zone:new a(null,null)},O=null,P=0;function R(){}function D(t){return"__zone_symbol__"+t}r("Zone","Zone"),t.Zone=a}("undefined"!=typeof window&&window||"undefined"!=typeof self&&self||global);var t=function(t){var e="function"==typeof Symbol&&t[Symbol.iterator],n=0;return e?e.call(t):{next:function(){return t&&n>=t.length&&(t=void 0),{value:t&&t[n++],done:!t}}}};Zone.__load_patch("ZoneAwarePromise",function(e,n,r){var o=Object.getOwnPropertyDescriptor,i=Object.defineProperty,a=r.symbol,s=[],u=a("Promise"),c=a("then"),l="__creationTrace__";r.onUnhandledError=function(t){if(r.showUncaughtError()){var e=t&&t.rejection;e?console.error("Unhandled Promise rejection:",e instanceof Error?e.message:e,"; Zone:",t.zone.name,"; Task:",t.task&&t.task.source,"; Value:",e,e instanceof Error?e.stack:void 0):console.error(t)}},r.microtaskDrainDone=function(){for(;s.length;)for(var t=function(){var t=s.shift();try{t.zone.runGuarded(function(){throw t})}catch(e){p(e)}};s.length;)t()};var f=a("unhandledPromiseRejectionHandler");function p(t){r.onUnhandledError(t);try{var e=n[f];e&&"function"==typeof e&&e.call(this,t)}catch(o){}}function h(t){return t&&t.then}function d(t){return t}function y(t){return N.reject(t)}var v=a("state"),g=a("value"),m=a("finally"),b=a("parentPromiseValue"),_=a("parentPromiseState"),w="Promise.then",E=null,S=!0,x=!1,T=0;function k(t,e){return function(n){try{O(t,e,n)}catch(r){O(t,!1,r)}}}var A=function(){var t=!1;return function(e){return function(){t||(t=!0,e.apply(null,arguments))}}},I="Promise resolved with itself",C=a("currentTaskTrace");function O(t,e,o){var a,u=A();if(t===o)throw new TypeError(I);if(t[v]===E){var c=null;try{"object"!=typeof o&&"function"!=typeof o||(c=o&&o.then)}catch(y){return u(function(){O(t,!1,y)})(),t}if(e!==x&&o instanceof N&&o.hasOwnProperty(v)&&o.hasOwnProperty(g)&&o[v]!==E)R(o),O(t,o[v],o[g]);else if(e!==x&&"function"==typeof c)try{c.call(o,u(k(t,e)),u(k(t,!1)))}catch(y){u(function(){O(t,!1,y)})()}else{t[v]=e;var f=t[g];if(t[g]=o,t[m]===m&&e===S&&(t[v]=t[_],t[g]=t[b]),e===x&&o instanceof Error){var p=n.currentTask&&n.currentTask.data&&n.currentTask.data[l];p&&i(o,C,{configurable:!0,enumerable:!1,writable:!0,value:p})}for(var h=0;h<f.length;)D(t,f[h++],f[h++],f[h++],f[h++]);if(0==f.length&&e==x){t[v]=T;try{throw new Error("Uncaught (in promise): "+((a=o)&&a.toString===Object.prototype.toString?(a.constructor&&a.constructor.name||"")+": "+JSON.stringify(a):a?a.toString():Object.prototype.toString.call(a))+(o&&o.stack?"\n"+o.stack:""))}catch(y){var d=y;d.rejection=o,d.promise=t,d.zone=n.current,d.task=n.currentTask,s.push(d),r.scheduleMicroTask()}}}}return t}var P=a("rejectionHandledHandler");function R(t){if(t[v]===T){try{var e=n[P];e&&"function"==typeof e&&e.call(this,{rejection:t[g],promise:t})}catch(o){}t[v]=x;for(var r=0;r<s.length;r++)t===s[r].promise&&s.splice(r,1)}}function D(t,e,n,r,o){R(t);var i=t[v],a=i?"function"==typeof r?r:d:"function"==typeof o?o:y;e.scheduleMicroTask(w,function(){try{var r=t[g],o=n&&m===n[m];o&&(n[b]=r,n[_]=i);var s=e.run(a,void 0,o&&a!==y&&a!==d?[]:[r]);O(n,!0,s)}catch(u){O(n,!1,u)}},n)}var N=function(){function e(t){if(!(this instanceof e))throw new Error("Must be an instanceof Promise.");this[v]=E,this[g]=[];try{t&&t(k(this,S),k(this,x))}catch(n){O(this,!1,n)}}return e.toString=function(){return"function ZoneAwarePromise() { [native code] }"},e.resolve=function(t){return O(new this(null),S,t)},e.reject=function(t){return O(new this(null),x,t)},e.race=function(e){var n,r,o,i,a=new this(function(t,e){o=t,i=e});function s(t){o(t)}function u(t){i(t)}try{for(var c=t(e),l=c.next();!l.done;l=c.next()){var f=l.value;h(f)||(f=this.resolve(f)),f.then(s,u)}}catch(p){n={error:p}}finally{try{l&&!l.done&&(r=c.return)&&r.call(c)}finally{if(n)throw n.error}}return a},e.all=function(e){var n,r,o,i,a=new this(function(t,e){o=t,i=e}),s=2,u=0,c=[],l=function(t){h(t)||(t=f.resolve(t));var e=u;t.then(function(t){c[e]=t,0==--s&&o(c)},i),s++,u++},f=this;try{for(var p=t(e),d=p.next();!d.done;d=p.next())l(d.value)}catch(y){n={error:y}}finally{try{d&&!d.done&&(r=p.return)&&r.call(p)}finally{if(n)throw n.error}}return 0==(s-=2)&&o(c),a},Object.defineProperty(e.prototype,Symbol.toStringTag,{get:function(){return"Promise"},enumerable:!0,configurable:!0}),e.prototype.then=function(t,e){var r=new this.constructor(null),o=n.current;return this[v]==E?this[g].push(o,r,t,e):D(this,o,r,t,e),r},e.prototype.catch=function(t){return this.then(null,t)},e.prototype.finally=function(t){var e=new this.constructor(null);e[m]=m;var r=n.current;return this[v]==E?this[g].push(r,e,t,t):D(this,r,e,t,t),e},e}();N.resolve=N.resolve,N.reject=N.reject,N.race=N.race,N.all=N.all;var M=e[u]=e.Promise,j=n.__symbol__("ZoneAwarePromise"),F=o(e,"Promise");F&&!F.configurable||(F&&delete F.writable,F&&delete F.value,F||(F={configurable:!0,enumerable:!0}),F.get=function(){return e[j]?e[j]:e[u]},F.set=function(t){t===N?e[j]=t:(e[u]=t,t.prototype[c]||V(t),r.setNativePromise(t))},i(e,"Promise",F)),e.Promise=N;var L,B=a("thenPatched");function V(t){var e=t.prototype,n=o(e,"then");if(!n||!1!==n.writable&&n.configurable){var r=e.then;e[c]=r,t.prototype.then=function(t,e){var n=this;return new N(function(t,e){r.call(n,t,e)}).then(t,e)},t[B]=!0}}if(r.patchThen=V,M){V(M);var H=e.fetch;"function"==typeof H&&(e[r.symbol("fetch")]=H,e.fetch=(L=H,function(){var t=L.apply(this,arguments);if(t instanceof N)return t;var e=t.constructor;return e[B]||V(e),t}))}return Promise[n.__symbol__("uncaughtPromiseErrors")]=s,N});var e=Object.getOwnPropertyDescriptor,n=Object.defineProperty,r=Object.getPrototypeOf,o=Object.create,i=Array.prototype.slice,a="addEventListener",s="removeEventListener",u=Zone.__symbol__(a),c=Zone.__symbol__(s),l="true",f="false",p="__zone_symbol__";function h(t,e){return Zone.current.wrap(t,e)}function d(t,e,n,r,o){return Zone.current.scheduleMacroTask(t,e,n,r,o)}var y=Zone.__symbol__,v="undefined"!=typeof window,g=v?window:void 0,m=v&&g||"object"==typeof self&&self||global,b="removeAttribute",_=[null];function w(t,e){for(var n=t.length-1;n>=0;n--)"function"==typeof t[n]&&(t[n]=h(t[n],e+"_"+n));return t}function E(t){return!t||!1!==t.writable&&!("function"==typeof t.get&&void 0===t.set)}var S="undefined"!=typeof WorkerGlobalScope&&self instanceof WorkerGlobalScope,x=!("nw"in m)&&void 0!==m.process&&"[object process]"==={}.toString.call(m.process),T=!x&&!S&&!(!v||!g.HTMLElement),k=void 0!==m.process&&"[object process]"==={}.toString.call(m.process)&&!S&&!(!v||!g.HTMLElement),A={},I=function(t){if(t=t||m.event){var e=A[t.type];e||(e=A[t.type]=y("ON_PROPERTY"+t.type));var n,r=this||t.target||m,o=r[e];return T&&r===g&&"error"===t.type?!0===(n=o&&o.call(this,t.message,t.filename,t.lineno,t.colno,t.error))&&t.preventDefault():null==(n=o&&o.apply(this,arguments))||n||t.preventDefault(),n}};function C(t,r,o){var i=e(t,r);if(!i&&o&&e(o,r)&&(i={enumerable:!0,configurable:!0}),i&&i.configurable){var a=y("on"+r+"patched");if(!t.hasOwnProperty(a)||!t[a]){delete i.writable,delete i.value;var s=i.get,u=i.set,c=r.substr(2),l=A[c];l||(l=A[c]=y("ON_PROPERTY"+c)),i.set=function(e){var n=this;n||t!==m||(n=m),n&&(n[l]&&n.removeEventListener(c,I),u&&u.apply(n,_),"function"==typeof e?(n[l]=e,n.addEventListener(c,I,!1)):n[l]=null)},i.get=function(){var e=this;if(e||t!==m||(e=m),!e)return null;var n=e[l];if(n)return n;if(s){var o=s&&s.call(this);if(o)return i.set.call(this,o),"function"==typeof e[b]&&e.removeAttribute(r),o}return null},n(t,r,i),t[a]=!0}}}function O(t,e,n){if(e)for(var r=0;r<e.length;r++)C(t,"on"+e[r],n);else{var o=[];for(var i in t)"on"==i.substr(0,2)&&o.push(i);for(var a=0;a<o.length;a++)C(t,o[a],n)}}var P=y("originalInstance");function R(t){var e=m[t];if(e){m[y(t)]=e,m[t]=function(){var n=w(arguments,t);switch(n.length){case 0:this[P]=new e;break;case 1:this[P]=new e(n[0]);break;case 2:this[P]=new e(n[0],n[1]);break;case 3:this[P]=new e(n[0],n[1],n[2]);break;case 4:this[P]=new e(n[0],n[1],n[2],n[3]);break;default:throw new Error("Arg list too long.")}},j(m[t],e);var r,o=new e(function(){});for(r in o)"XMLHttpRequest"===t&&"responseBlob"===r||function(e){"function"==typeof o[e]?m[t].prototype[e]=function(){return this[P][e].apply(this[P],arguments)}:n(m[t].prototype,e,{set:function(n){"function"==typeof n?(this[P][e]=h(n,t+"."+e),j(this[P][e],n)):this[P][e]=n},get:function(){return this[P][e]}})}(r);for(r in e)"prototype"!==r&&e.hasOwnProperty(r)&&(m[t][r]=e[r])}}var D=!1;function N(t,n,o){for(var i=t;i&&!i.hasOwnProperty(n);)i=r(i);!i&&t[n]&&(i=t);var a,s,u=y(n),c=null;if(i&&!(c=i[u])&&(c=i[u]=i[n],E(i&&e(i,n)))){var l=o(c,u,n);i[n]=function(){return l(this,arguments)},j(i[n],c),D&&(a=c,s=i[n],"function"==typeof Object.getOwnPropertySymbols&&Object.getOwnPropertySymbols(a).forEach(function(t){var e=Object.getOwnPropertyDescriptor(a,t);Object.defineProperty(s,t,{get:function(){return a[t]},set:function(n){(!e||e.writable&&"function"==typeof e.set)&&(a[t]=n)},enumerable:!e||e.enumerable,configurable:!e||e.configurable})}))}return c}function M(t,e,n){var r=null;function o(t){var e=t.data;return e.args[e.cbIdx]=function(){t.invoke.apply(this,arguments)},r.apply(e.target,e.args),t}r=N(t,e,function(t){return function(e,r){var i=n(e,r);return i.cbIdx>=0&&"function"==typeof r[i.cbIdx]?d(i.name,r[i.cbIdx],i,o):t.apply(e,r)}})}function j(t,e){t[y("OriginalDelegate")]=e}var F=!1,L=!1;function B(){try{var t=g.navigator.userAgent;if(-1!==t.indexOf("MSIE ")||-1!==t.indexOf("Trident/"))return!0}catch(e){}return!1}function V(){if(F)return L;F=!0;try{var t=g.navigator.userAgent;-1===t.indexOf("MSIE ")&&-1===t.indexOf("Trident/")&&-1===t.indexOf("Edge/")||(L=!0)}catch(e){}return L}Zone.__load_patch("toString",function(t){var e=Function.prototype.toString,n=y("OriginalDelegate"),r=y("Promise"),o=y("Error"),i=function(){if("function"==typeof this){var i=this[n];if(i)return"function"==typeof i?e.call(i):Object.prototype.toString.call(i);if(this===Promise){var a=t[r];if(a)return e.call(a)}if(this===Error){var s=t[o];if(s)return e.call(s)}}return e.call(this)};i[n]=e,Function.prototype.toString=i;var a=Object.prototype.toString;Object.prototype.toString=function(){return this instanceof Promise?"[object Promise]":a.call(this)}});var H=!1;if("undefined"!=typeof window)try{var z=Object.defineProperty({},"passive",{get:function(){H=!0}});window.addEventListener("test",z,z),window.removeEventListener("test",z,z)}catch(Tt){H=!1}var U={useG:!0},W={},Z={},q=/^__zone_symbol__(\w+)(true|false)$/,G="__zone_symbol__propagationStopped";function K(t,e,n){var o=n&&n.add||a,i=n&&n.rm||s,u=n&&n.listeners||"eventListeners",c=n&&n.rmAll||"removeAllListeners",h=y(o),d="."+o+":",v="prependListener",g="."+v+":",m=function(t,e,n){if(!t.isRemoved){var r=t.callback;"object"==typeof r&&r.handleEvent&&(t.callback=function(t){return r.handleEvent(t)},t.originalDelegate=r),t.invoke(t,e,[n]);var o=t.options;o&&"object"==typeof o&&o.once&&e[i].call(e,n.type,t.originalDelegate?t.originalDelegate:t.callback,o)}},b=function(e){if(e=e||t.event){var n=this||e.target||t,r=n[W[e.type][f]];if(r)if(1===r.length)m(r[0],n,e);else for(var o=r.slice(),i=0;i<o.length&&(!e||!0!==e[G]);i++)m(o[i],n,e)}},_=function(e){if(e=e||t.event){var n=this||e.target||t,r=n[W[e.type][l]];if(r)if(1===r.length)m(r[0],n,e);else for(var o=r.slice(),i=0;i<o.length&&(!e||!0!==e[G]);i++)m(o[i],n,e)}};function w(e,n){if(!e)return!1;var a=!0;n&&void 0!==n.useG&&(a=n.useG);var s=n&&n.vh,m=!0;n&&void 0!==n.chkDup&&(m=n.chkDup);var w=!1;n&&void 0!==n.rt&&(w=n.rt);for(var E=e;E&&!E.hasOwnProperty(o);)E=r(E);if(!E&&e[o]&&(E=e),!E)return!1;if(E[h])return!1;var S,T=n&&n.eventNameToString,k={},A=E[h]=E[o],I=E[y(i)]=E[i],C=E[y(u)]=E[u],O=E[y(c)]=E[c];function P(t){H||"boolean"==typeof k.options||null==k.options||(t.options=!!k.options.capture,k.options=t.options)}n&&n.prepend&&(S=E[y(n.prepend)]=E[n.prepend]);var R=a?function(t){if(!k.isExisting)return P(t),A.call(k.target,k.eventName,k.capture?_:b,k.options)}:function(t){return P(t),A.call(k.target,k.eventName,t.invoke,k.options)},D=a?function(t){if(!t.isRemoved){var e=W[t.eventName],n=void 0;e&&(n=e[t.capture?l:f]);var r=n&&t.target[n];if(r)for(var o=0;o<r.length;o++)if(r[o]===t){r.splice(o,1),t.isRemoved=!0,0===r.length&&(t.allRemoved=!0,t.target[n]=null);break}}if(t.allRemoved)return I.call(t.target,t.eventName,t.capture?_:b,t.options)}:function(t){return I.call(t.target,t.eventName,t.invoke,t.options)},N=n&&n.diff?n.diff:function(t,e){var n=typeof e;return"function"===n&&t.callback===e||"object"===n&&t.originalDelegate===e},M=Zone[Zone.__symbol__("BLACK_LISTED_EVENTS")],F=function(e,n,r,o,i,u){return void 0===i&&(i=!1),void 0===u&&(u=!1),function(){var c=this||t,h=arguments[0],d=arguments[1];if(!d)return e.apply(this,arguments);if(x&&"uncaughtException"===h)return e.apply(this,arguments);var y=!1;if("function"!=typeof d){if(!d.handleEvent)return e.apply(this,arguments);y=!0}if(!s||s(e,d,c,arguments)){var v,g=arguments[2];if(M)for(var b=0;b<M.length;b++)if(h===M[b])return e.apply(this,arguments);var _=!1;void 0===g?v=!1:!0===g?v=!0:!1===g?v=!1:(v=!!g&&!!g.capture,_=!!g&&!!g.once);var w,E=Zone.current,S=W[h];if(S)w=S[v?l:f];else{var A=(T?T(h):h)+f,I=(T?T(h):h)+l,C=p+A,O=p+I;W[h]={},W[h][f]=C,W[h][l]=O,w=v?O:C}var P,R=c[w],D=!1;if(R){if(D=!0,m)for(b=0;b<R.length;b++)if(N(R[b],d))return}else R=c[w]=[];var j=c.constructor.name,F=Z[j];F&&(P=F[h]),P||(P=j+n+(T?T(h):h)),k.options=g,_&&(k.options.once=!1),k.target=c,k.capture=v,k.eventName=h,k.isExisting=D;var L=a?U:void 0;L&&(L.taskData=k);var B=E.scheduleEventTask(P,d,L,r,o);return k.target=null,L&&(L.taskData=null),_&&(g.once=!0),(H||"boolean"!=typeof B.options)&&(B.options=g),B.target=c,B.capture=v,B.eventName=h,y&&(B.originalDelegate=d),u?R.unshift(B):R.push(B),i?c:void 0}}};return E[o]=F(A,d,R,D,w),S&&(E[v]=F(S,g,function(t){return S.call(k.target,k.eventName,t.invoke,k.options)},D,w,!0)),E[i]=function(){var e,n=this||t,r=arguments[0],o=arguments[2];e=void 0!==o&&(!0===o||!1!==o&&!!o&&!!o.capture);var i=arguments[1];if(!i)return I.apply(this,arguments);if(!s||s(I,i,n,arguments)){var a,u=W[r];u&&(a=u[e?l:f]);var c=a&&n[a];if(c)for(var p=0;p<c.length;p++){var h=c[p];if(N(h,i))return c.splice(p,1),h.isRemoved=!0,0===c.length&&(h.allRemoved=!0,n[a]=null),h.zone.cancelTask(h),w?n:void 0}return I.apply(this,arguments)}},E[u]=function(){for(var e=arguments[0],n=[],r=Q(this||t,T?T(e):e),o=0;o<r.length;o++){var i=r[o];n.push(i.originalDelegate?i.originalDelegate:i.callback)}return n},E[c]=function(){var e=this||t,n=arguments[0];if(n){var r=W[n];if(r){var o=e[r[f]],a=e[r[l]];if(o){var s=o.slice();for(h=0;h<s.length;h++)this[i].call(this,n,(u=s[h]).originalDelegate?u.originalDelegate:u.callback,u.options)}if(a)for(s=a.slice(),h=0;h<s.length;h++){var u;this[i].call(this,n,(u=s[h]).originalDelegate?u.originalDelegate:u.callback,u.options)}}}else{for(var p=Object.keys(e),h=0;h<p.length;h++){var d=q.exec(p[h]),y=d&&d[1];y&&"removeListener"!==y&&this[c].call(this,y)}this[c].call(this,"removeListener")}if(w)return this},j(E[o],A),j(E[i],I),O&&j(E[c],O),C&&j(E[u],C),!0}for(var E=[],S=0;S<e.length;S++)E[S]=w(e[S],n);return E}function Q(t,e){var n=[];for(var r in t){var o=q.exec(r),i=o&&o[1];if(i&&(!e||i===e)){var a=t[r];if(a)for(var s=0;s<a.length;s++)n.push(a[s])}}return n}function Y(t,e){var n=t.Event;n&&n.prototype&&e.patchMethod(n.prototype,"stopImmediatePropagation",function(t){return function(e,n){e[G]=!0,t&&t.apply(e,n)}})}function X(t,e,n,r,o){var i=Zone.__symbol__(r);if(!e[i]){var a=e[i]=e[r];e[r]=function(i,s,u){return s&&s.prototype&&o.forEach(function(e){var o=n+"."+r+"::"+e,i=s.prototype;if(i.hasOwnProperty(e)){var a=t.ObjectGetOwnPropertyDescriptor(i,e);a&&a.value?(a.value=t.wrapWithCurrentZone(a.value,o),t._redefineProperty(s.prototype,e,a)):i[e]&&(i[e]=t.wrapWithCurrentZone(i[e],o))}else i[e]&&(i[e]=t.wrapWithCurrentZone(i[e],o))}),a.call(e,i,s,u)},t.attachOriginToPatched(e[r],a)}}var J=Zone.__symbol__,$=Object[J("defineProperty")]=Object.defineProperty,tt=Object[J("getOwnPropertyDescriptor")]=Object.getOwnPropertyDescriptor,et=Object.create,nt=J("unconfigurables");function rt(t,e,n){var r=n.configurable;return at(t,e,n=it(t,e,n),r)}function ot(t,e){return t&&t[nt]&&t[nt][e]}function it(t,e,n){return Object.isFrozen(n)||(n.configurable=!0),n.configurable||(t[nt]||Object.isFrozen(t)||$(t,nt,{writable:!0,value:{}}),t[nt]&&(t[nt][e]=!0)),n}function at(t,e,n,r){try{return $(t,e,n)}catch(i){if(!n.configurable)throw i;void 0===r?delete n.configurable:n.configurable=r;try{return $(t,e,n)}catch(i){var o=null;try{o=JSON.stringify(n)}catch(i){o=n.toString()}console.log("Attempting to configure '"+e+"' with descriptor '"+o+"' on object '"+t+"' and got error, giving up: "+i)}}}var st=["absolutedeviceorientation","afterinput","afterprint","appinstalled","beforeinstallprompt","beforeprint","beforeunload","devicelight","devicemotion","deviceorientation","deviceorientationabsolute","deviceproximity","hashchange","languagechange","message","mozbeforepaint","offline","online","paint","pageshow","pagehide","popstate","rejectionhandled","storage","unhandledrejection","unload","userproximity","vrdisplyconnected","vrdisplaydisconnected","vrdisplaypresentchange"],ut=["encrypted","waitingforkey","msneedkey","mozinterruptbegin","mozinterruptend"],ct=["load"],lt=["blur","error","focus","load","resize","scroll","messageerror"],ft=["bounce","finish","start"],pt=["loadstart","progress","abort","error","load","progress","timeout","loadend","readystatechange"],ht=["upgradeneeded","complete","abort","success","error","blocked","versionchange","close"],dt=["close","error","open","message"],yt=["error","message"],vt=["abort","animationcancel","animationend","animationiteration","auxclick","beforeinput","blur","cancel","canplay","canplaythrough","change","compositionstart","compositionupdate","compositionend","cuechange","click","close","contextmenu","curechange","dblclick","drag","dragend","dragenter","dragexit","dragleave","dragover","drop","durationchange","emptied","ended","error","focus","focusin","focusout","gotpointercapture","input","invalid","keydown","keypress","keyup","load","loadstart","loadeddata","loadedmetadata","lostpointercapture","mousedown","mouseenter","mouseleave","mousemove","mouseout","mouseover","mouseup","mousewheel","orientationchange","pause","play","playing","pointercancel","pointerdown","pointerenter","pointerleave","pointerlockchange","mozpointerlockchange","webkitpointerlockerchange","pointerlockerror","mozpointerlockerror","webkitpointerlockerror","pointermove","pointout","pointerover","pointerup","progress","ratechange","reset","resize","scroll","seeked","seeking","select","selectionchange","selectstart","show","sort","stalled","submit","suspend","timeupdate","volumechange","touchcancel","touchmove","touchstart","touchend","transitioncancel","transitionend","waiting","wheel"].concat(["webglcontextrestored","webglcontextlost","webglcontextcreationerror"],["autocomplete","autocompleteerror"],["toggle"],["afterscriptexecute","beforescriptexecute","DOMContentLoaded","freeze","fullscreenchange","mozfullscreenchange","webkitfullscreenchange","msfullscreenchange","fullscreenerror","mozfullscreenerror","webkitfullscreenerror","msfullscreenerror","readystatechange","visibilitychange","resume"],st,["beforecopy","beforecut","beforepaste","copy","cut","paste","dragstart","loadend","animationstart","search","transitionrun","transitionstart","webkitanimationend","webkitanimationiteration","webkitanimationstart","webkittransitionend"],["activate","afterupdate","ariarequest","beforeactivate","beforedeactivate","beforeeditfocus","beforeupdate","cellchange","controlselect","dataavailable","datasetchanged","datasetcomplete","errorupdate","filterchange","layoutcomplete","losecapture","move","moveend","movestart","propertychange","resizeend","resizestart","rowenter","rowexit","rowsdelete","rowsinserted","command","compassneedscalibration","deactivate","help","mscontentzoom","msmanipulationstatechanged","msgesturechange","msgesturedoubletap","msgestureend","msgesturehold","msgesturestart","msgesturetap","msgotpointercapture","msinertiastart","mslostpointercapture","mspointercancel","mspointerdown","mspointerenter","mspointerhover","mspointerleave","mspointermove","mspointerout","mspointerover","mspointerup","pointerout","mssitemodejumplistitemremoved","msthumbnailclick","stop","storagecommit"]);function gt(t,e,n){if(!n||0===n.length)return e;var r=n.filter(function(e){return e.target===t});if(!r||0===r.length)return e;var o=r[0].ignoreProperties;return e.filter(function(t){return-1===o.indexOf(t)})}function mt(t,e,n,r){t&&O(t,gt(t,e,n),r)}function bt(t,e){if((!x||k)&&!Zone[t.symbol("patchEvents")]){var n="undefined"!=typeof WebSocket,o=e.__Zone_ignore_on_properties;if(T){var i=window,a=B?[{target:i,ignoreProperties:["error"]}]:[];mt(i,vt.concat(["messageerror"]),o?o.concat(a):o,r(i)),mt(Document.prototype,vt,o),void 0!==i.SVGElement&&mt(i.SVGElement.prototype,vt,o),mt(Element.prototype,vt,o),mt(HTMLElement.prototype,vt,o),mt(HTMLMediaElement.prototype,ut,o),mt(HTMLFrameSetElement.prototype,st.concat(lt),o),mt(HTMLBodyElement.prototype,st.concat(lt),o),mt(HTMLFrameElement.prototype,ct,o),mt(HTMLIFrameElement.prototype,ct,o);var s=i.HTMLMarqueeElement;s&&mt(s.prototype,ft,o);var u=i.Worker;u&&mt(u.prototype,yt,o)}var c=e.XMLHttpRequest;c&&mt(c.prototype,pt,o);var l=e.XMLHttpRequestEventTarget;l&&mt(l&&l.prototype,pt,o),"undefined"!=typeof IDBIndex&&(mt(IDBIndex.prototype,ht,o),mt(IDBRequest.prototype,ht,o),mt(IDBOpenDBRequest.prototype,ht,o),mt(IDBDatabase.prototype,ht,o),mt(IDBTransaction.prototype,ht,o),mt(IDBCursor.prototype,ht,o)),n&&mt(WebSocket.prototype,dt,o)}}function _t(t,e){var n=e.getGlobalObjects(),r=n.eventNames,o=n.globalSources,i=n.zoneSymbolEventNames,a=n.TRUE_STR,s=n.FALSE_STR,u=n.ZONE_SYMBOL_PREFIX,c="Anchor,Area,Audio,BR,Base,BaseFont,Body,Button,Canvas,Content,DList,Directory,Div,Embed,FieldSet,Font,Form,Frame,FrameSet,HR,Head,Heading,Html,IFrame,Image,Input,Keygen,LI,Label,Legend,Link,Map,Marquee,Media,Menu,Meta,Meter,Mod,OList,Object,OptGroup,Option,Output,Paragraph,Pre,Progress,Quote,Script,Select,Source,Span,Style,TableCaption,TableCell,TableCol,Table,TableRow,TableSection,TextArea,Title,Track,UList,Unknown,Video",l="ApplicationCache,EventSource,FileReader,InputMethodContext,MediaController,MessagePort,Node,Performance,SVGElementInstance,SharedWorker,TextTrack,TextTrackCue,TextTrackList,WebKitNamedFlow,Window,Worker,WorkerGlobalScope,XMLHttpRequest,XMLHttpRequestEventTarget,XMLHttpRequestUpload,IDBRequest,IDBOpenDBRequest,IDBDatabase,IDBTransaction,IDBCursor,DBIndex,WebSocket".split(","),f=[],p=t.wtf,h=c.split(",");p?f=h.map(function(t){return"HTML"+t+"Element"}).concat(l):t.EventTarget?f.push("EventTarget"):f=l;for(var d=t.__Zone_disable_IE_check||!1,y=t.__Zone_enable_cross_context_check||!1,v=e.isIEOrEdge(),g="function __BROWSERTOOLS_CONSOLE_SAFEFUNC() { [native code] }",m=0;m<r.length;m++){var b=u+((x=r[m])+s),_=u+(x+a);i[x]={},i[x][s]=b,i[x][a]=_}for(m=0;m<c.length;m++)for(var w=h[m],E=o[w]={},S=0;S<r.length;S++){var x;E[x=r[S]]=w+".addEventListener:"+x}var T=[];for(m=0;m<f.length;m++){var k=t[f[m]];T.push(k&&k.prototype)}return e.patchEventTarget(t,T,{vh:function(t,e,n,r){if(!d&&v){if(y)try{var o;if("[object FunctionWrapper]"===(o=e.toString())||o==g)return t.apply(n,r),!1}catch(i){return t.apply(n,r),!1}else if("[object FunctionWrapper]"===(o=e.toString())||o==g)return t.apply(n,r),!1}else if(y)try{e.toString()}catch(i){return t.apply(n,r),!1}return!0}}),Zone[e.symbol("patchEventTarget")]=!!t.EventTarget,!0}function wt(t,e){var n=t.getGlobalObjects();if((!n.isNode||n.isMix)&&!function(t,e){var n=t.getGlobalObjects();if((n.isBrowser||n.isMix)&&!t.ObjectGetOwnPropertyDescriptor(HTMLElement.prototype,"onclick")&&"undefined"!=typeof Element){var r=t.ObjectGetOwnPropertyDescriptor(Element.prototype,"onclick");if(r&&!r.configurable)return!1;if(r){t.ObjectDefineProperty(Element.prototype,"onclick",{enumerable:!0,configurable:!0,get:function(){return!0}});var o=!!document.createElement("div").onclick;return t.ObjectDefineProperty(Element.prototype,"onclick",r),o}}var i=e.XMLHttpRequest;if(!i)return!1;var a=i.prototype,s=t.ObjectGetOwnPropertyDescriptor(a,"onreadystatechange");if(s)return t.ObjectDefineProperty(a,"onreadystatechange",{enumerable:!0,configurable:!0,get:function(){return!0}}),o=!!(c=new i).onreadystatechange,t.ObjectDefineProperty(a,"onreadystatechange",s||{}),o;var u=t.symbol("fake");t.ObjectDefineProperty(a,"onreadystatechange",{enumerable:!0,configurable:!0,get:function(){return this[u]},set:function(t){this[u]=t}});var c,l=function(){};return(c=new i).onreadystatechange=l,o=c[u]===l,c.onreadystatechange=null,o}(t,e)){var r="undefined"!=typeof WebSocket;!function(t){for(var e=t.getGlobalObjects().eventNames,n=t.symbol("unbound"),r=function(r){var o=e[r],i="on"+o;self.addEventListener(o,function(e){var r,o,a=e.target;for(o=a?a.constructor.name+"."+i:"unknown."+i;a;)a[i]&&!a[i][n]&&((r=t.wrapWithCurrentZone(a[i],o))[n]=a[i],a[i]=r),a=a.parentElement},!0)},o=0;o<e.length;o++)r(o)}(t),t.patchClass("XMLHttpRequest"),r&&function(t,e){var n=t.getGlobalObjects(),r=n.ADD_EVENT_LISTENER_STR,o=n.REMOVE_EVENT_LISTENER_STR,i=e.WebSocket;e.EventTarget||t.patchEventTarget(e,[i.prototype]),e.WebSocket=function(e,n){var a,s,u=arguments.length>1?new i(e,n):new i(e),c=t.ObjectGetOwnPropertyDescriptor(u,"onmessage");return c&&!1===c.configurable?(a=t.ObjectCreate(u),s=u,[r,o,"send","close"].forEach(function(e){a[e]=function(){var n=t.ArraySlice.call(arguments);if(e===r||e===o){var i=n.length>0?n[0]:void 0;if(i){var s=Zone.__symbol__("ON_PROPERTY"+i);u[s]=a[s]}}return u[e].apply(u,n)}})):a=u,t.patchOnProperties(a,["close","error","message","open"],s),a};var a=e.WebSocket;for(var s in i)a[s]=i[s]}(t,e),Zone[t.symbol("patchEvents")]=!0}}Zone.__load_patch("util",function(t,r,u){u.patchOnProperties=O,u.patchMethod=N,u.bindArguments=w,u.patchMacroTask=M;var c=r.__symbol__("BLACK_LISTED_EVENTS"),d=r.__symbol__("UNPATCHED_EVENTS");t[d]&&(t[c]=t[d]),t[c]&&(r[c]=r[d]=t[c]),u.patchEventPrototype=Y,u.patchEventTarget=K,u.isIEOrEdge=V,u.ObjectDefineProperty=n,u.ObjectGetOwnPropertyDescriptor=e,u.ObjectCreate=o,u.ArraySlice=i,u.patchClass=R,u.wrapWithCurrentZone=h,u.filterProperties=gt,u.attachOriginToPatched=j,u._redefineProperty=rt,u.patchCallbacks=X,u.getGlobalObjects=function(){return{globalSources:Z,zoneSymbolEventNames:W,eventNames:vt,isBrowser:T,isMix:k,isNode:x,TRUE_STR:l,FALSE_STR:f,ZONE_SYMBOL_PREFIX:p,ADD_EVENT_LISTENER_STR:a,REMOVE_EVENT_LISTENER_STR:s}}}),function(t){t.__zone_symbol__legacyPatch=function(){var e=t.Zone;e.__load_patch("registerElement",function(t,e,n){!function(t,e){var n=e.getGlobalObjects();(n.isBrowser||n.isMix)&&"registerElement"in t.document&&e.patchCallbacks(e,document,"Document","registerElement",["createdCallback","attachedCallback","detachedCallback","attributeChangedCallback"])}(t,n)}),e.__load_patch("EventTargetLegacy",function(t,e,n){_t(t,n),wt(n,t)})}}("undefined"!=typeof window&&window||"undefined"!=typeof self&&self||global);var Et=y("zoneTask");function St(t,e,n,r){var o=null,i=null;n+=r;var a={};function s(e){var n=e.data;return n.args[0]=function(){try{e.invoke.apply(this,arguments)}finally{e.data&&e.data.isPeriodic||("number"==typeof n.handleId?delete a[n.handleId]:n.handleId&&(n.handleId[Et]=null))}},n.handleId=o.apply(t,n.args),e}function u(t){return i(t.data.handleId)}o=N(t,e+=r,function(n){return function(o,i){if("function"==typeof i[0]){var c=d(e,i[0],{isPeriodic:"Interval"===r,delay:"Timeout"===r||"Interval"===r?i[1]||0:void 0,args:i},s,u);if(!c)return c;var l=c.data.handleId;return"number"==typeof l?a[l]=c:l&&(l[Et]=c),l&&l.ref&&l.unref&&"function"==typeof l.ref&&"function"==typeof l.unref&&(c.ref=l.ref.bind(l),c.unref=l.unref.bind(l)),"number"==typeof l||l?l:c}return n.apply(t,i)}}),i=N(t,n,function(e){return function(n,r){var o,i=r[0];"number"==typeof i?o=a[i]:(o=i&&i[Et])||(o=i),o&&"string"==typeof o.type?"notScheduled"!==o.state&&(o.cancelFn&&o.data.isPeriodic||0===o.runCount)&&("number"==typeof i?delete a[i]:i&&(i[Et]=null),o.zone.cancelTask(o)):e.apply(t,r)}})}function xt(t,e){if(!Zone[e.symbol("patchEventTarget")]){for(var n=e.getGlobalObjects(),r=n.eventNames,o=n.zoneSymbolEventNames,i=n.TRUE_STR,a=n.FALSE_STR,s=n.ZONE_SYMBOL_PREFIX,u=0;u<r.length;u++){var c=r[u],l=s+(c+a),f=s+(c+i);o[c]={},o[c][a]=l,o[c][i]=f}var p=t.EventTarget;if(p&&p.prototype)return e.patchEventTarget(t,[p&&p.prototype]),!0}}Zone.__load_patch("legacy",function(t){var e=t[Zone.__symbol__("legacyPatch")];e&&e()}),Zone.__load_patch("timers",function(t){St(t,"set","clear","Timeout"),St(t,"set","clear","Interval"),St(t,"set","clear","Immediate")}),Zone.__load_patch("requestAnimationFrame",function(t){St(t,"request","cancel","AnimationFrame"),St(t,"mozRequest","mozCancel","AnimationFrame"),St(t,"webkitRequest","webkitCancel","AnimationFrame")}),Zone.__load_patch("blocking",function(t,e){for(var n=["alert","prompt","confirm"],r=0;r<n.length;r++)N(t,n[r],function(n,r,o){return function(r,i){return e.current.run(n,t,i,o)}})}),Zone.__load_patch("EventTarget",function(t,e,n){!function(t,e){e.patchEventPrototype(t,e)}(t,n),xt(t,n);var r=t.XMLHttpRequestEventTarget;r&&r.prototype&&n.patchEventTarget(t,[r.prototype]),R("MutationObserver"),R("WebKitMutationObserver"),R("IntersectionObserver"),R("FileReader")}),Zone.__load_patch("on_property",function(t,e,n){bt(n,t),Object.defineProperty=function(t,e,n){if(ot(t,e))throw new TypeError("Cannot assign to read only property '"+e+"' of "+t);var r=n.configurable;return"prototype"!==e&&(n=it(t,e,n)),at(t,e,n,r)},Object.defineProperties=function(t,e){return Object.keys(e).forEach(function(n){Object.defineProperty(t,n,e[n])}),t},Object.create=function(t,e){return"object"!=typeof e||Object.isFrozen(e)||Object.keys(e).forEach(function(n){e[n]=it(t,n,e[n])}),et(t,e)},Object.getOwnPropertyDescriptor=function(t,e){var n=tt(t,e);return n&&ot(t,e)&&(n.configurable=!1),n}}),Zone.__load_patch("customElements",function(t,e,n){!function(t,e){var n=e.getGlobalObjects();(n.isBrowser||n.isMix)&&t.customElements&&"customElements"in t&&e.patchCallbacks(e,t.customElements,"customElements","define",["connectedCallback","disconnectedCallback","adoptedCallback","attributeChangedCallback"])}(t,n)}),Zone.__load_patch("XHR",function(t,e){!function(l){var f=t.XMLHttpRequest;if(f){var p=f.prototype,h=p[u],v=p[c];if(!h){var g=t.XMLHttpRequestEventTarget;if(g){var m=g.prototype;h=m[u],v=m[c]}}var b="readystatechange",_="scheduled",w=N(p,"open",function(){return function(t,e){return t[r]=0==e[2],t[a]=e[1],w.apply(t,e)}}),E=y("fetchTaskAborting"),S=y("fetchTaskScheduling"),x=N(p,"send",function(){return function(t,n){if(!0===e.current[S])return x.apply(t,n);if(t[r])return x.apply(t,n);var o={target:t,url:t[a],isPeriodic:!1,args:n,aborted:!1},i=d("XMLHttpRequest.send",A,o,k,I);t&&!0===t[s]&&!o.aborted&&i.state===_&&i.invoke()}}),T=N(p,"abort",function(){return function(t,r){var o=t[n];if(o&&"string"==typeof o.type){if(null==o.cancelFn||o.data&&o.data.aborted)return;o.zone.cancelTask(o)}else if(!0===e.current[E])return T.apply(t,r)}})}function k(t){var e=t.data,r=e.target;r[i]=!1,r[s]=!1;var a=r[o];h||(h=r[u],v=r[c]),a&&v.call(r,b,a);var l=r[o]=function(){if(r.readyState===r.DONE)if(!e.aborted&&r[i]&&t.state===_){var n=r.__zone_symbol__loadfalse;if(n&&n.length>0){var o=t.invoke;t.invoke=function(){for(var n=r.__zone_symbol__loadfalse,i=0;i<n.length;i++)n[i]===t&&n.splice(i,1);e.aborted||t.state!==_||o.call(t)},n.push(t)}else t.invoke()}else e.aborted||!1!==r[i]||(r[s]=!0)};return h.call(r,b,l),r[n]||(r[n]=t),x.apply(r,e.args),r[i]=!0,t}function A(){}function I(t){var e=t.data;return e.aborted=!0,T.apply(e.target,e.args)}}();var n=y("xhrTask"),r=y("xhrSync"),o=y("xhrListener"),i=y("xhrScheduled"),a=y("xhrURL"),s=y("xhrErrorBeforeScheduled")}),Zone.__load_patch("geolocation",function(t){t.navigator&&t.navigator.geolocation&&function(t,n){for(var r=t.constructor.name,o=function(o){var i=n[o],a=t[i];if(a){if(!E(e(t,i)))return"continue";t[i]=function(t){var e=function(){return t.apply(this,w(arguments,r+"."+i))};return j(e,t),e}(a)}},i=0;i<n.length;i++)o(i)}(t.navigator.geolocation,["getCurrentPosition","watchPosition"])}),Zone.__load_patch("PromiseRejectionEvent",function(t,e){function n(e){return function(n){Q(t,e).forEach(function(r){var o=t.PromiseRejectionEvent;if(o){var i=new o(e,{promise:n.promise,reason:n.rejection});r.invoke(i)}})}}t.PromiseRejectionEvent&&(e[y("unhandledPromiseRejectionHandler")]=n("unhandledrejection"),e[y("rejectionHandledHandler")]=n("rejectionhandled"))})}()},"0TkE":function(t,e,n){"use strict";var r=n("67WC"),o=n("tycR").find,i=r.aTypedArray;(0,r.exportTypedArrayMethod)("find",function(t){return o(i(this),t,arguments.length>1?arguments[1]:void 0)})},"0boY":function(t,e,n){n("I+eb")({target:"Date",proto:!0},{toGMTString:Date.prototype.toUTCString})},"0eef":function(t,e,n){"use strict";var r={}.propertyIsEnumerable,o=Object.getOwnPropertyDescriptor,i=o&&!r.call({1:2},1);e.f=i?function(t){var e=o(this,t);return!!e&&e.enumerable}:r},"0oug":function(t,e,n){n("dG/n")("iterator")},"0rvr":function(t,e,n){var r=n("glrk"),o=n("O741");t.exports=Object.setPrototypeOf||("__proto__"in{}?function(){var t,e=!1,n={};try{(t=Object.getOwnPropertyDescriptor(Object.prototype,"__proto__").set).call(n,[]),e=n instanceof Array}catch(i){}return function(n,i){return r(n),o(i),e?t.call(n,i):n.__proto__=i,n}}():void 0)},"14Sl":function(t,e,n){"use strict";n("rB9j");var r=n("busE"),o=n("kmMV"),i=n("0Dky"),a=n("tiKp"),s=n("kRJp"),u=a("species"),c=RegExp.prototype;t.exports=function(t,e,n,l){var f=a(t),p=!i(function(){var e={};return e[f]=function(){return 7},7!=""[t](e)}),h=p&&!i(function(){var e=!1,n=/a/;return"split"===t&&((n={}).constructor={},n.constructor[u]=function(){return n},n.flags="",n[f]=/./[f]),n.exec=function(){return e=!0,null},n[f](""),!e});if(!p||!h||n){var d=/./[f],y=e(f,""[t],function(t,e,n,r,i){var a=e.exec;return a===o||a===c.exec?p&&!i?{done:!0,value:d.call(e,n,r)}:{done:!0,value:t.call(n,e,r)}:{done:!1}});r(String.prototype,t,y[0]),r(c,f,y[1])}l&&s(c[f],"sham",!0)}},"1E5z":function(t,e,n){var r=n("m/L8").f,o=n("UTVS"),i=n("tiKp")("toStringTag");t.exports=function(t,e,n){t&&!o(t=n?t:t.prototype,i)&&r(t,i,{configurable:!0,value:e})}},"1MNl":function(t,e,n){var r=n("NC/Y"),o=n("2oRo");t.exports=/ipad|iphone|ipod/i.test(r)&&void 0!==o.Pebble},"1Y/n":function(t,e,n){var r=n("HAuM"),o=n("ewvW"),i=n("RK3t"),a=n("UMSQ"),s=function(t){return function(e,n,s,u){r(n);var c=o(e),l=i(c),f=a(c.length),p=t?f-1:0,h=t?-1:1;if(s<2)for(;;){if(p in l){u=l[p],p+=h;break}if(p+=h,t?p<0:f<=p)throw TypeError("Reduce of empty array with no initial value")}for(;t?p>=0:f>p;p+=h)p in l&&(u=n(u,l[p],p,c));return u}};t.exports={left:s(!1),right:s(!0)}},"1dYe":function(t,e,n){"use strict";var r=n("67WC"),o=n("tycR").forEach,i=r.aTypedArray;(0,r.exportTypedArrayMethod)("forEach",function(t){o(i(this),t,arguments.length>1?arguments[1]:void 0)})},"1t3B":function(t,e,n){var r=n("I+eb"),o=n("0GbY"),i=n("glrk");r({target:"Reflect",stat:!0,sham:!n("uy83")},{preventExtensions:function(t){i(t);try{var e=o("Object","preventExtensions");return e&&e(t),!0}catch(n){return!1}}})},"2/pz":function(t,e,n){"use strict";var r=n("I+eb"),o=n("HAuM"),i=n("0GbY"),a=n("8GlL"),s=n("5mdu"),u=n("ImZN");r({target:"Promise",stat:!0},{any:function(t){var e=this,n=a.f(e),r=n.resolve,c=n.reject,l=s(function(){var n=o(e.resolve),a=[],s=0,l=1,f=!1;u(t,function(t){var o=s++,u=!1;a.push(void 0),l++,n.call(e,t).then(function(t){u||f||(f=!0,r(t))},function(t){u||f||(u=!0,a[o]=t,--l||c(new(i("AggregateError"))(a,"No one promise resolved")))})}),--l||c(new(i("AggregateError"))(a,"No one promise resolved"))});return l.error&&c(l.value),n.promise}})},"25bX":function(t,e,n){var r=n("I+eb"),o=n("glrk"),i=Object.isExtensible;r({target:"Reflect",stat:!0},{isExtensible:function(t){return o(t),!i||i(t)}})},"27RR":function(t,e,n){var r=n("I+eb"),o=n("g6v/"),i=n("Vu81"),a=n("/GqU"),s=n("Bs8V"),u=n("hBjN");r({target:"Object",stat:!0,sham:!o},{getOwnPropertyDescriptors:function(t){for(var e,n,r=a(t),o=s.f,c=i(r),l={},f=0;c.length>f;)void 0!==(n=o(r,e=c[f++]))&&u(l,e,n);return l}})},"2A+d":function(t,e,n){var r=n("I+eb"),o=n("/GqU"),i=n("UMSQ"),a=n("V37c");r({target:"String",stat:!0},{raw:function(t){for(var e=o(t.raw),n=i(e.length),r=arguments.length,s=[],u=0;n>u;)s.push(a(e[u++])),u<r&&s.push(a(arguments[u]));return s.join("")}})},"2B1R":function(t,e,n){"use strict";var r=n("I+eb"),o=n("tycR").map;r({target:"Array",proto:!0,forced:!n("Hd5f")("map")},{map:function(t){return o(this,t,arguments.length>1?arguments[1]:void 0)}})},"2Zix":function(t,e,n){var r=n("NC/Y");t.exports=/MSIE|Trident/.test(r)},"2bX/":function(t,e,n){var r=n("0GbY"),o=n("/b8u");t.exports=o?function(t){return"symbol"==typeof t}:function(t){var e=r("Symbol");return"function"==typeof e&&Object(t)instanceof e}},"2oRo":function(t,e){var n=function(t){return t&&t.Math==Math&&t};t.exports=n("object"==typeof globalThis&&globalThis)||n("object"==typeof window&&window)||n("object"==typeof self&&self)||n("object"==typeof global&&global)||function(){return this}()||Function("return this")()},"33Wh":function(t,e,n){var r=n("yoRg"),o=n("eDl+");t.exports=Object.keys||function(t){return r(t,o)}},"37lR":function(t,e){t.exports=function(t,e){for(var n=0,r=e.length,o=new t(r);r>n;)o[n]=e[n++];return o}},"3I1R":function(t,e,n){n("dG/n")("hasInstance")},"3KgV":function(t,e,n){var r=n("I+eb"),o=n("uy83"),i=n("0Dky"),a=n("hh1v"),s=n("8YOa").onFreeze,u=Object.freeze;r({target:"Object",stat:!0,forced:i(function(){u(1)}),sham:!o},{freeze:function(t){return u&&a(t)?u(s(t)):t}})},"3bBZ":function(t,e,n){var r=n("2oRo"),o=n("/byt"),i=n("4mDm"),a=n("kRJp"),s=n("tiKp"),u=s("iterator"),c=s("toStringTag"),l=i.values;for(var f in o){var p=r[f],h=p&&p.prototype;if(h){if(h[u]!==l)try{a(h,u,l)}catch(y){h[u]=l}if(h[c]||a(h,c,f),o[f])for(var d in i)if(h[d]!==i[d])try{a(h,d,i[d])}catch(y){h[d]=i[d]}}}},"4Brf":function(t,e,n){"use strict";var r=n("I+eb"),o=n("g6v/"),i=n("2oRo"),a=n("UTVS"),s=n("hh1v"),u=n("m/L8").f,c=n("6JNq"),l=i.Symbol;if(o&&"function"==typeof l&&(!("description"in l.prototype)||void 0!==l().description)){var f={},p=function(){var t=arguments.length<1||void 0===arguments[0]?void 0:String(arguments[0]),e=this instanceof p?new l(t):void 0===t?l():l(t);return""===t&&(f[e]=!0),e};c(p,l);var h=p.prototype=l.prototype;h.constructor=p;var d=h.toString,y="Symbol(test)"==String(l("test")),v=/^Symbol\((.*)\)[^)]+$/;u(h,"description",{configurable:!0,get:function(){var t=s(this)?this.valueOf():this,e=d.call(t);if(a(f,t))return"";var n=y?e.slice(7,-1):e.replace(v,"$1");return""===n?void 0:n}}),r({global:!0,forced:!0},{Symbol:p})}},"4WOD":function(t,e,n){var r=n("UTVS"),o=n("ewvW"),i=n("93I0"),a=n("4Xet"),s=i("IE_PROTO"),u=Object.prototype;t.exports=a?Object.getPrototypeOf:function(t){return t=o(t),r(t,s)?t[s]:"function"==typeof t.constructor&&t instanceof t.constructor?t.constructor.prototype:t instanceof Object?u:null}},"4Xet":function(t,e,n){var r=n("0Dky");t.exports=!r(function(){function t(){}return t.prototype.constructor=null,Object.getPrototypeOf(new t)!==t.prototype})},"4h0Y":function(t,e,n){var r=n("I+eb"),o=n("0Dky"),i=n("hh1v"),a=Object.isFrozen;r({target:"Object",stat:!0,forced:o(function(){a(1)})},{isFrozen:function(t){return!i(t)||!!a&&a(t)}})},"4l63":function(t,e,n){var r=n("I+eb"),o=n("wg0c");r({global:!0,forced:parseInt!=o},{parseInt:o})},"4mDm":function(t,e,n){"use strict";var r=n("/GqU"),o=n("RNIs"),i=n("P4y1"),a=n("afO8"),s=n("fdAy"),u=a.set,c=a.getterFor("Array Iterator");t.exports=s(Array,"Array",function(t,e){u(this,{type:"Array Iterator",target:r(t),index:0,kind:e})},function(){var t=c(this),e=t.target,n=t.kind,r=t.index++;return!e||r>=e.length?(t.target=void 0,{value:void 0,done:!0}):"keys"==n?{value:r,done:!1}:"values"==n?{value:e[r],done:!1}:{value:[r,e[r]],done:!1}},"values"),i.Arguments=i.Array,o("keys"),o("values"),o("entries")},"4oU/":function(t,e,n){var r=n("2oRo").isFinite;t.exports=Number.isFinite||function(t){return"number"==typeof t&&r(t)}},"4syw":function(t,e,n){var r=n("busE");t.exports=function(t,e,n){for(var o in e)r(t,o,e[o],n);return t}},"4wMS":function(t,e){t.exports={Debug:function(t){window.wails.Log.Debug(t)},Info:function(t){window.wails.Log.Info(t)},Warning:function(t){window.wails.Log.Warning(t)},Error:function(t){window.wails.Log.Error(t)},Fatal:function(t){window.wails.Log.Fatal(t)}}},"4yNf":function(t,e,n){"use strict";var r=n("I+eb"),o=n("HYAF"),i=n("ppGB"),a=n("V37c"),s="".slice,u=Math.max,c=Math.min;r({target:"String",proto:!0},{substr:function(t,e){var n,r,l=a(o(this)),f=l.length,p=i(t);return p===1/0&&(p=0),p<0&&(p=u(f+p,0)),(n=void 0===e?f:i(e))<=0||n===1/0?"":p>=(r=c(p+n,f))?"":s.call(l,p,r)}})},"5D5o":function(t,e,n){var r=n("I+eb"),o=n("0Dky"),i=n("hh1v"),a=Object.isSealed;r({target:"Object",stat:!0,forced:o(function(){a(1)})},{isSealed:function(t){return!i(t)||!!a&&a(t)}})},"5DmW":function(t,e,n){var r=n("I+eb"),o=n("0Dky"),i=n("/GqU"),a=n("Bs8V").f,s=n("g6v/"),u=o(function(){a(1)});r({target:"Object",stat:!0,forced:!s||u,sham:!s},{getOwnPropertyDescriptor:function(t,e){return a(i(t),e)}})},"5Tg+":function(t,e,n){var r=n("tiKp");e.f=r},"5Yz+":function(t,e,n){"use strict";var r=n("/GqU"),o=n("ppGB"),i=n("UMSQ"),a=n("pkCn"),s=Math.min,u=[].lastIndexOf,c=!!u&&1/[1].lastIndexOf(1,-0)<0,l=a("lastIndexOf");t.exports=c||!l?function(t){if(c)return u.apply(this,arguments)||0;var e=r(this),n=i(e.length),a=n-1;for(arguments.length>1&&(a=s(a,o(arguments[1]))),a<0&&(a=n+a);a>=0;a--)if(a in e&&e[a]===t)return a||0;return-1}:u},"5mdu":function(t,e){t.exports=function(t){try{return{error:!1,value:t()}}catch(e){return{error:!0,value:e}}}},"5s+n":function(t,e,n){"use strict";var r,o,i,a,s=n("I+eb"),u=n("xDBR"),c=n("2oRo"),l=n("0GbY"),f=n("/qmn"),p=n("busE"),h=n("4syw"),d=n("0rvr"),y=n("1E5z"),v=n("JiZb"),g=n("hh1v"),m=n("HAuM"),b=n("GarU"),_=n("iSVu"),w=n("ImZN"),E=n("HH4o"),S=n("SEBh"),x=n("LPSS").set,T=n("tXUg"),k=n("zfnd"),A=n("RN6c"),I=n("8GlL"),C=n("5mdu"),O=n("afO8"),P=n("lMq5"),R=n("tiKp"),D=n("YGnB"),N=n("YF1G"),M=n("LQDL"),j=R("species"),F="Promise",L=O.get,B=O.set,V=O.getterFor(F),H=f&&f.prototype,z=f,U=H,W=c.TypeError,Z=c.document,q=c.process,G=I.f,K=G,Q=!!(Z&&Z.createEvent&&c.dispatchEvent),Y="function"==typeof PromiseRejectionEvent,X=!1,J=P(F,function(){var t=_(z),e=t!==String(z);if(!e&&66===M)return!0;if(u&&!U.finally)return!0;if(M>=51&&/native code/.test(t))return!1;var n=new z(function(t){t(1)}),r=function(t){t(function(){},function(){})};return(n.constructor={})[j]=r,!(X=n.then(function(){})instanceof r)||!e&&D&&!Y}),$=J||!E(function(t){z.all(t).catch(function(){})}),tt=function(t){var e;return!(!g(t)||"function"!=typeof(e=t.then))&&e},et=function(t,e){if(!t.notified){t.notified=!0;var n=t.reactions;T(function(){for(var r=t.value,o=1==t.state,i=0;n.length>i;){var a,s,u,c=n[i++],l=o?c.ok:c.fail,f=c.resolve,p=c.reject,h=c.domain;try{l?(o||(2===t.rejection&&it(t),t.rejection=1),!0===l?a=r:(h&&h.enter(),a=l(r),h&&(h.exit(),u=!0)),a===c.promise?p(W("Promise-chain cycle")):(s=tt(a))?s.call(a,f,p):f(a)):p(r)}catch(d){h&&!u&&h.exit(),p(d)}}t.reactions=[],t.notified=!1,e&&!t.rejection&&rt(t)})}},nt=function(t,e,n){var r,o;Q?((r=Z.createEvent("Event")).promise=e,r.reason=n,r.initEvent(t,!1,!0),c.dispatchEvent(r)):r={promise:e,reason:n},!Y&&(o=c["on"+t])?o(r):"unhandledrejection"===t&&A("Unhandled promise rejection",n)},rt=function(t){x.call(c,function(){var e,n=t.facade,r=t.value;if(ot(t)&&(e=C(function(){N?q.emit("unhandledRejection",r,n):nt("unhandledrejection",n,r)}),t.rejection=N||ot(t)?2:1,e.error))throw e.value})},ot=function(t){return 1!==t.rejection&&!t.parent},it=function(t){x.call(c,function(){var e=t.facade;N?q.emit("rejectionHandled",e):nt("rejectionhandled",e,t.value)})},at=function(t,e,n){return function(r){t(e,r,n)}},st=function(t,e,n){t.done||(t.done=!0,n&&(t=n),t.value=e,t.state=2,et(t,!0))},ut=function(t,e,n){if(!t.done){t.done=!0,n&&(t=n);try{if(t.facade===e)throw W("Promise can't be resolved itself");var r=tt(e);r?T(function(){var n={done:!1};try{r.call(e,at(ut,n,t),at(st,n,t))}catch(o){st(n,o,t)}}):(t.value=e,t.state=1,et(t,!1))}catch(o){st({done:!1},o,t)}}};if(J&&(z=function(t){b(this,z,F),m(t),r.call(this);var e=L(this);try{t(at(ut,e),at(st,e))}catch(n){st(e,n)}},(r=function(t){B(this,{type:F,done:!1,notified:!1,parent:!1,reactions:[],rejection:!1,state:0,value:void 0})}).prototype=h(U=z.prototype,{then:function(t,e){var n=V(this),r=G(S(this,z));return r.ok="function"!=typeof t||t,r.fail="function"==typeof e&&e,r.domain=N?q.domain:void 0,n.parent=!0,n.reactions.push(r),0!=n.state&&et(n,!1),r.promise},catch:function(t){return this.then(void 0,t)}}),o=function(){var t=new r,e=L(t);this.promise=t,this.resolve=at(ut,e),this.reject=at(st,e)},I.f=G=function(t){return t===z||t===i?new o(t):K(t)},!u&&"function"==typeof f&&H!==Object.prototype)){a=H.then,X||(p(H,"then",function(t,e){var n=this;return new z(function(t,e){a.call(n,t,e)}).then(t,e)},{unsafe:!0}),p(H,"catch",U.catch,{unsafe:!0}));try{delete H.constructor}catch(ct){}d&&d(H,U)}s({global:!0,wrap:!0,forced:J},{Promise:z}),y(z,F,!1,!0),v(F),i=l(F),s({target:F,stat:!0,forced:J},{reject:function(t){var e=G(this);return e.reject.call(void 0,t),e.promise}}),s({target:F,stat:!0,forced:u||J},{resolve:function(t){return k(u&&this===i?z:this,t)}}),s({target:F,stat:!0,forced:$},{all:function(t){var e=this,n=G(e),r=n.resolve,o=n.reject,i=C(function(){var n=m(e.resolve),i=[],a=0,s=1;w(t,function(t){var u=a++,c=!1;i.push(void 0),s++,n.call(e,t).then(function(t){c||(c=!0,i[u]=t,--s||r(i))},o)}),--s||r(i)});return i.error&&o(i.value),n.promise},race:function(t){var e=this,n=G(e),r=n.reject,o=C(function(){var o=m(e.resolve);w(t,function(t){o.call(e,t).then(n.resolve,r)})});return o.error&&r(o.value),n.promise}})},"5uH8":function(t,e,n){n("I+eb")({target:"Number",stat:!0},{MIN_SAFE_INTEGER:-9007199254740991})},"5xtp":function(t,e,n){"use strict";var r=n("I+eb"),o=n("g6v/"),i=n("6x0u"),a=n("ewvW"),s=n("HAuM"),u=n("m/L8");o&&r({target:"Object",proto:!0,forced:i},{__defineSetter__:function(t,e){u.f(a(this),t,{set:s(e),enumerable:!0,configurable:!0})}})},"67WC":function(t,e,n){"use strict";var r,o,i,a=n("qYE9"),s=n("g6v/"),u=n("2oRo"),c=n("hh1v"),l=n("UTVS"),f=n("9d/t"),p=n("kRJp"),h=n("busE"),d=n("m/L8").f,y=n("4WOD"),v=n("0rvr"),g=n("tiKp"),m=n("kOOl"),b=u.Int8Array,_=b&&b.prototype,w=u.Uint8ClampedArray,E=w&&w.prototype,S=b&&y(b),x=_&&y(_),T=Object.prototype,k=T.isPrototypeOf,A=g("toStringTag"),I=m("TYPED_ARRAY_TAG"),C=m("TYPED_ARRAY_CONSTRUCTOR"),O=a&&!!v&&"Opera"!==f(u.opera),P=!1,R={Int8Array:1,Uint8Array:1,Uint8ClampedArray:1,Int16Array:2,Uint16Array:2,Int32Array:4,Uint32Array:4,Float32Array:4,Float64Array:8},D={BigInt64Array:8,BigUint64Array:8},N=function(t){if(!c(t))return!1;var e=f(t);return l(R,e)||l(D,e)};for(r in R)(i=(o=u[r])&&o.prototype)?p(i,C,o):O=!1;for(r in D)(i=(o=u[r])&&o.prototype)&&p(i,C,o);if((!O||"function"!=typeof S||S===Function.prototype)&&(S=function(){throw TypeError("Incorrect invocation")},O))for(r in R)u[r]&&v(u[r],S);if((!O||!x||x===T)&&(x=S.prototype,O))for(r in R)u[r]&&v(u[r].prototype,x);if(O&&y(E)!==x&&v(E,x),s&&!l(x,A))for(r in P=!0,d(x,A,{get:function(){return c(this)?this[I]:void 0}}),R)u[r]&&p(u[r],I,r);t.exports={NATIVE_ARRAY_BUFFER_VIEWS:O,TYPED_ARRAY_CONSTRUCTOR:C,TYPED_ARRAY_TAG:P&&I,aTypedArray:function(t){if(N(t))return t;throw TypeError("Target is not a typed array")},aTypedArrayConstructor:function(t){if(v&&!k.call(S,t))throw TypeError("Target is not a typed array constructor");return t},exportTypedArrayMethod:function(t,e,n){if(s){if(n)for(var r in R){var o=u[r];if(o&&l(o.prototype,t))try{delete o.prototype[t]}catch(i){}}x[t]&&!n||h(x,t,n?e:O&&_[t]||e)}},exportTypedArrayStaticMethod:function(t,e,n){var r,o;if(s){if(v){if(n)for(r in R)if((o=u[r])&&l(o,t))try{delete o[t]}catch(i){}if(S[t]&&!n)return;try{return h(S,t,n?e:O&&S[t]||e)}catch(i){}}for(r in R)!(o=u[r])||o[t]&&!n||h(o,t,e)}},isView:function(t){if(!c(t))return!1;var e=f(t);return"DataView"===e||l(R,e)||l(D,e)},isTypedArray:N,TypedArray:S,TypedArrayPrototype:x}},"6JNq":function(t,e,n){var r=n("UTVS"),o=n("Vu81"),i=n("Bs8V"),a=n("m/L8");t.exports=function(t,e){for(var n=o(e),s=a.f,u=i.f,c=0;c<n.length;c++){var l=n[c];r(t,l)||s(t,l,u(e,l))}}},"6LWA":function(t,e,n){var r=n("xrYK");t.exports=Array.isArray||function(t){return"Array"==r(t)}},"6R/c":function(t,e,n){"use strict";var r=n("67WC"),o=n("TWQb").indexOf,i=r.aTypedArray;(0,r.exportTypedArrayMethod)("indexOf",function(t){return o(i(this),t,arguments.length>1?arguments[1]:void 0)})},"6VoE":function(t,e,n){var r=n("tiKp"),o=n("P4y1"),i=r("iterator"),a=Array.prototype;t.exports=function(t){return void 0!==t&&(o.Array===t||a[i]===t)}},"6cQw":function(t,e,n){var r=n("I+eb"),o=n("0GbY"),i=n("0Dky"),a=o("JSON","stringify"),s=/[\uD800-\uDFFF]/g,u=/^[\uD800-\uDBFF]$/,c=/^[\uDC00-\uDFFF]$/,l=function(t,e,n){var r=n.charAt(e-1),o=n.charAt(e+1);return u.test(t)&&!c.test(o)||c.test(t)&&!u.test(r)?"\\u"+t.charCodeAt(0).toString(16):t},f=i(function(){return'"\\udf06\\ud834"'!==a("\udf06\ud834")||'"\\udead"'!==a("\udead")});a&&r({target:"JSON",stat:!0,forced:f},{stringify:function(t,e,n){var r=a.apply(null,arguments);return"string"==typeof r?r.replace(s,l):r}})},"6x0u":function(t,e,n){"use strict";var r=n("xDBR"),o=n("2oRo"),i=n("0Dky"),a=n("USzg");t.exports=r||!i(function(){if(!(a&&a<535)){var t=Math.random();__defineSetter__.call(null,t,function(){}),delete o[t]}})},"7+kd":function(t,e,n){n("dG/n")("isConcatSpreadable")},"7+zs":function(t,e,n){var r=n("kRJp"),o=n("UesL"),i=n("tiKp")("toPrimitive"),a=Date.prototype;i in a||r(a,i,o)},"7JcK":function(t,e,n){"use strict";var r=n("67WC"),o=n("iqeF"),i=r.aTypedArrayConstructor;(0,r.exportTypedArrayStaticMethod)("of",function(){for(var t=0,e=arguments.length,n=new(i(this))(e);e>t;)n[t]=arguments[t++];return n},o)},"7ueG":function(t,e,n){"use strict";var r=n("I+eb"),o=n("WKiH").start,i=n("yNLB")("trimStart"),a=i?function(){return o(this)}:"".trimStart;r({target:"String",proto:!0,forced:i},{trimStart:a,trimLeft:a})},"8AyJ":function(t,e,n){n("I+eb")({target:"Number",stat:!0},{isFinite:n("4oU/")})},"8GlL":function(t,e,n){"use strict";var r=n("HAuM"),o=function(t){var e,n;this.promise=new t(function(t,r){if(void 0!==e||void 0!==n)throw TypeError("Bad Promise constructor");e=t,n=r}),this.resolve=r(e),this.reject=r(n)};t.exports.f=function(t){return new o(t)}},"8YOa":function(t,e,n){var r=n("I+eb"),o=n("0BK2"),i=n("hh1v"),a=n("UTVS"),s=n("m/L8").f,u=n("JBy8"),c=n("BX/b"),l=n("kOOl"),f=n("uy83"),p=!1,h=l("meta"),d=0,y=Object.isExtensible||function(){return!0},v=function(t){s(t,h,{value:{objectID:"O"+d++,weakData:{}}})},g=t.exports={enable:function(){g.enable=function(){},p=!0;var t=u.f,e=[].splice,n={};n[h]=1,t(n).length&&(u.f=function(n){for(var r=t(n),o=0,i=r.length;o<i;o++)if(r[o]===h){e.call(r,o,1);break}return r},r({target:"Object",stat:!0,forced:!0},{getOwnPropertyNames:c.f}))},fastKey:function(t,e){if(!i(t))return"symbol"==typeof t?t:("string"==typeof t?"S":"P")+t;if(!a(t,h)){if(!y(t))return"F";if(!e)return"E";v(t)}return t[h].objectID},getWeakData:function(t,e){if(!a(t,h)){if(!y(t))return!0;if(!e)return!1;v(t)}return t[h].weakData},onFreeze:function(t){return f&&p&&y(t)&&!a(t,h)&&v(t),t}};o[h]=!0},"90hW":function(t,e){t.exports=Math.sign||function(t){return 0==(t=+t)||t!=t?t:t<0?-1:1}},"93I0":function(t,e,n){var r=n("VpIT"),o=n("kOOl"),i=r("keys");t.exports=function(t){return i[t]||(i[t]=o(t))}},"94Xl":function(t,e,n){n("JiZb")("Array")},"9LPj":function(t,e,n){"use strict";var r=n("I+eb"),o=n("0Dky"),i=n("ewvW"),a=n("wE6v");r({target:"Date",proto:!0,forced:o(function(){return null!==new Date(NaN).toJSON()||1!==Date.prototype.toJSON.call({toISOString:function(){return 1}})})},{toJSON:function(t){var e=i(this),n=a(e,"number");return"number"!=typeof n||isFinite(n)?e.toISOString():null}})},"9N29":function(t,e,n){"use strict";var r=n("I+eb"),o=n("1Y/n").right,i=n("pkCn"),a=n("LQDL"),s=n("YF1G");r({target:"Array",proto:!0,forced:!i("reduceRight")||!s&&a>79&&a<83},{reduceRight:function(t){return o(this,t,arguments.length,arguments.length>1?arguments[1]:void 0)}})},"9bJ7":function(t,e,n){"use strict";var r=n("I+eb"),o=n("ZUd8").codeAt;r({target:"String",proto:!0},{codePointAt:function(t){return o(this,t)}})},"9d/t":function(t,e,n){var r=n("AO7/"),o=n("xrYK"),i=n("tiKp")("toStringTag"),a="Arguments"==o(function(){return arguments}());t.exports=r?o:function(t){var e,n,r;return void 0===t?"Undefined":null===t?"Null":"string"==typeof(n=function(t,e){try{return t[e]}catch(n){}}(e=Object(t),i))?n:a?o(e):"Object"==(r=o(e))&&"function"==typeof e.callee?"Arguments":r}},"9mRW":function(t,e,n){n("I+eb")({target:"Math",stat:!0},{fround:n("vo4V")})},"9tb/":function(t,e,n){var r=n("I+eb"),o=n("I8vh"),i=String.fromCharCode,a=String.fromCodePoint;r({target:"String",stat:!0,forced:!!a&&1!=a.length},{fromCodePoint:function(t){for(var e,n=[],r=arguments.length,a=0;r>a;){if(e=+arguments[a++],o(e,1114111)!==e)throw RangeError(e+" is not a valid code point");n.push(e<65536?i(e):i(55296+((e-=65536)>>10),e%1024+56320))}return n.join("")}})},A2ZE:function(t,e,n){var r=n("HAuM");t.exports=function(t,e,n){if(r(t),void 0===e)return t;switch(n){case 0:return function(){return t.call(e)};case 1:return function(n){return t.call(e,n)};case 2:return function(n,r){return t.call(e,n,r)};case 3:return function(n,r,o){return t.call(e,n,r,o)}}return function(){return t.apply(e,arguments)}}},ALS0:function(t,e,n){"use strict";n("rB9j");var r,o,i=n("I+eb"),a=n("hh1v"),s=(r=!1,(o=/[ac]/).exec=function(){return r=!0,/./.exec.apply(this,arguments)},!0===o.test("abc")&&r),u=/./.test;i({target:"RegExp",proto:!0,forced:!s},{test:function(t){if("function"!=typeof this.exec)return u.call(this,t);var e=this.exec(t);if(null!==e&&!a(e))throw new Error("RegExp exec method returned something other than an Object or null");return!!e}})},"AO7/":function(t,e,n){var r={};r[n("tiKp")("toStringTag")]="z",t.exports="[object z]"===String(r)},AmFO:function(t,e,n){var r=n("I+eb"),o=n("0Dky"),i=n("jrUv"),a=Math.abs,s=Math.exp,u=Math.E;r({target:"Math",stat:!0,forced:o(function(){return-2e-17!=Math.sinh(-2e-17)})},{sinh:function(t){return a(t=+t)<1?(i(t)-i(-t))/2:(s(t-1)-s(-t-1))*(u/2)}})},B6y2:function(t,e,n){var r=n("I+eb"),o=n("b1O7").values;r({target:"Object",stat:!0},{values:function(t){return o(t)}})},BIHw:function(t,e,n){"use strict";var r=n("I+eb"),o=n("or9q"),i=n("ewvW"),a=n("UMSQ"),s=n("ppGB"),u=n("ZfDv");r({target:"Array",proto:!0},{flat:function(){var t=arguments.length?arguments[0]:void 0,e=i(this),n=a(e.length),r=u(e,0);return r.length=o(r,e,e,n,0,void 0===t?1:s(t)),r}})},BNF5:function(t,e,n){var r=n("NC/Y").match(/firefox\/(\d+)/i);t.exports=!!r&&+r[1]},BNMt:function(t,e,n){"use strict";var r=n("I+eb"),o=n("hXpO");r({target:"String",proto:!0,forced:n("rwPt")("blink")},{blink:function(){return o(this,"blink","","")}})},BTho:function(t,e,n){"use strict";var r=n("HAuM"),o=n("hh1v"),i=[].slice,a={};t.exports=Function.bind||function(t){var e=r(this),n=i.call(arguments,1),s=function(){var r=n.concat(i.call(arguments));return this instanceof s?function(t,e,n){if(!(e in a)){for(var r=[],o=0;o<e;o++)r[o]="a["+o+"]";a[e]=Function("C,a","return new C("+r.join(",")+")")}return a[e](t,n)}(e,r.length,r):e.apply(t,r)};return o(e.prototype)&&(s.prototype=e.prototype),s}},"BX/b":function(t,e,n){var r=n("/GqU"),o=n("JBy8").f,i={}.toString,a="object"==typeof window&&window&&Object.getOwnPropertyNames?Object.getOwnPropertyNames(window):[];t.exports.f=function(t){return a&&"[object Window]"==i.call(t)?function(t){try{return o(t)}catch(e){return a.slice()}}(t):o(r(t))}},Bs8V:function(t,e,n){var r=n("g6v/"),o=n("0eef"),i=n("XGwC"),a=n("/GqU"),s=n("oEtG"),u=n("UTVS"),c=n("DPsx"),l=Object.getOwnPropertyDescriptor;e.f=r?l:function(t,e){if(t=a(t),e=s(e),c)try{return l(t,e)}catch(n){}if(u(t,e))return i(!o.f.call(t,e),t[e])}},C0Ia:function(t,e,n){var r=n("hh1v"),o=n("6LWA"),i=n("tiKp")("species");t.exports=function(t){var e;return o(t)&&("function"!=typeof(e=t.constructor)||e!==Array&&!o(e.prototype)?r(e)&&null===(e=e[i])&&(e=void 0):e=void 0),void 0===e?Array:e}},CpAL:function(t,e,n){"use strict";var r=n("I+eb"),o=n("0Dky")(function(){return 120!==new Date(16e11).getYear()}),i=Date.prototype.getFullYear;r({target:"Date",proto:!0,forced:o},{getYear:function(){return i.call(this)-1900}})},CsgD:function(t,e,n){var r=n("I+eb"),o=n("jrUv");r({target:"Math",stat:!0,forced:o!=Math.expm1},{expm1:o})},Cwz8:function(t,e){t.exports={OpenURL:function(t){return window.wails.Browser.OpenURL(t)},OpenFile:function(t){return window.wails.Browser.OpenFile(t)}}},CyXQ:function(t,e,n){var r=n("ppGB"),o=n("UMSQ");t.exports=function(t){if(void 0===t)return 0;var e=r(t),n=o(e);if(e!==n)throw RangeError("Wrong length or index");return n}},DEfu:function(t,e,n){var r=n("2oRo");n("1E5z")(r.JSON,"JSON",!0)},DLK6:function(t,e,n){var r=n("ewvW"),o=Math.floor,i="".replace,a=/\$([$&'`]|\d{1,2}|<[^>]*>)/g,s=/\$([$&'`]|\d{1,2})/g;t.exports=function(t,e,n,u,c,l){var f=n+t.length,p=u.length,h=s;return void 0!==c&&(c=r(c),h=a),i.call(l,h,function(r,i){var a;switch(i.charAt(0)){case"$":return"$";case"&":return t;case"`":return e.slice(0,n);case"'":return e.slice(f);case"<":a=c[i.slice(1,-1)];break;default:var s=+i;if(0===s)return r;if(s>p){var l=o(s/10);return 0===l?r:l<=p?void 0===u[l-1]?i.charAt(1):u[l-1]+i.charAt(1):r}a=u[s-1]}return void 0===a?"":a})}},DMt2:function(t,e,n){var r=n("UMSQ"),o=n("V37c"),i=n("EUja"),a=n("HYAF"),s=Math.ceil,u=function(t){return function(e,n,u){var c,l,f=o(a(e)),p=f.length,h=void 0===u?" ":o(u),d=r(n);return d<=p||""==h?f:((l=i.call(h,s((c=d-p)/h.length))).length>c&&(l=l.slice(0,c)),t?f+l:l+f)}};t.exports={start:u(!1),end:u(!0)}},DPsx:function(t,e,n){var r=n("g6v/"),o=n("0Dky"),i=n("zBJ4");t.exports=!r&&!o(function(){return 7!=Object.defineProperty(i("div"),"a",{get:function(){return 7}}).a})},DQNa:function(t,e,n){var r=n("busE"),o=Date.prototype,i=o.toString,a=o.getTime;"Invalid Date"!=String(new Date(NaN))&&r(o,"toString",function(){var t=a.call(this);return t==t?i.call(this):"Invalid Date"})},DSw1:function(t,e){t.exports={New:function(t,e){return window.wails.Store.New(t,e)}}},DTth:function(t,e,n){var r=n("0Dky"),o=n("tiKp"),i=n("xDBR"),a=o("iterator");t.exports=!r(function(){var t=new URL("b?a=1&b=2&c=3","http://a"),e=t.searchParams,n="";return t.pathname="c%20d",e.forEach(function(t,r){e.delete("b"),n+=r+t}),i&&!t.toJSON||!e.sort||"http://a/c%20d?a=1&c=3"!==t.href||"3"!==e.get("c")||"a=1"!==String(new URLSearchParams("?a=1"))||!e[a]||"a"!==new URL("https://a@b").username||"b"!==new URLSearchParams(new URLSearchParams("a=b")).get("a")||"xn--e1aybc"!==new URL("http://\u0442\u0435\u0441\u0442").host||"#%D0%B1"!==new URL("http://a#\u0431").hash||"a1c3"!==n||"x"!==new URL("http://x",void 0).host})},E5NM:function(t,e,n){"use strict";var r=n("I+eb"),o=n("hXpO");r({target:"String",proto:!0,forced:n("rwPt")("big")},{big:function(){return o(this,"big","","")}})},E9XD:function(t,e,n){"use strict";var r=n("I+eb"),o=n("1Y/n").left,i=n("pkCn"),a=n("LQDL"),s=n("YF1G");r({target:"Array",proto:!0,forced:!i("reduce")||!s&&a>79&&a<83},{reduce:function(t){return o(this,t,arguments.length,arguments.length>1?arguments[1]:void 0)}})},EHx7:function(t,e,n){var r=n("0Dky"),o=n("2oRo").RegExp;t.exports=r(function(){var t=o("(?<a>b)","g");return"b"!==t.exec("b").groups.a||"bc"!=="b".replace(t,"$<a>c")})},ENF9:function(t,e,n){"use strict";var r,o=n("2oRo"),i=n("4syw"),a=n("8YOa"),s=n("bWFh"),u=n("rKzb"),c=n("hh1v"),l=n("afO8").enforce,f=n("f5p1"),p=!o.ActiveXObject&&"ActiveXObject"in o,h=Object.isExtensible,d=function(t){return function(){return t(this,arguments.length?arguments[0]:void 0)}},y=t.exports=s("WeakMap",d,u);if(f&&p){r=u.getConstructor(d,"WeakMap",!0),a.enable();var v=y.prototype,g=v.delete,m=v.has,b=v.get,_=v.set;i(v,{delete:function(t){if(c(t)&&!h(t)){var e=l(this);return e.frozen||(e.frozen=new r),g.call(this,t)||e.frozen.delete(t)}return g.call(this,t)},has:function(t){if(c(t)&&!h(t)){var e=l(this);return e.frozen||(e.frozen=new r),m.call(this,t)||e.frozen.has(t)}return m.call(this,t)},get:function(t){if(c(t)&&!h(t)){var e=l(this);return e.frozen||(e.frozen=new r),m.call(this,t)?b.call(this,t):e.frozen.get(t)}return b.call(this,t)},set:function(t,e){if(c(t)&&!h(t)){var n=l(this);n.frozen||(n.frozen=new r),m.call(this,t)?_.call(this,t,e):n.frozen.set(t,e)}else _.call(this,t,e);return this}})}},EUja:function(t,e,n){"use strict";var r=n("ppGB"),o=n("V37c"),i=n("HYAF");t.exports=function(t){var e=o(i(this)),n="",a=r(t);if(a<0||a==1/0)throw RangeError("Wrong number of repetitions");for(;a>0;(a>>>=1)&&(e+=e))1&a&&(n+=e);return n}},EnZy:function(t,e,n){"use strict";var r=n("14Sl"),o=n("ROdP"),i=n("glrk"),a=n("HYAF"),s=n("SEBh"),u=n("iqWW"),c=n("UMSQ"),l=n("V37c"),f=n("FMNM"),p=n("kmMV"),h=n("n3/R"),d=n("0Dky"),y=h.UNSUPPORTED_Y,v=[].push,g=Math.min;r("split",function(t,e,n){var r;return r="c"=="abbc".split(/(b)*/)[1]||4!="test".split(/(?:)/,-1).length||2!="ab".split(/(?:ab)*/).length||4!=".".split(/(.?)(.?)/).length||".".split(/()()/).length>1||"".split(/.?/).length?function(t,n){var r=l(a(this)),i=void 0===n?4294967295:n>>>0;if(0===i)return[];if(void 0===t)return[r];if(!o(t))return e.call(r,t,i);for(var s,u,c,f=[],h=0,d=new RegExp(t.source,(t.ignoreCase?"i":"")+(t.multiline?"m":"")+(t.unicode?"u":"")+(t.sticky?"y":"")+"g");(s=p.call(d,r))&&!((u=d.lastIndex)>h&&(f.push(r.slice(h,s.index)),s.length>1&&s.index<r.length&&v.apply(f,s.slice(1)),c=s[0].length,h=u,f.length>=i));)d.lastIndex===s.index&&d.lastIndex++;return h===r.length?!c&&d.test("")||f.push(""):f.push(r.slice(h)),f.length>i?f.slice(0,i):f}:"0".split(void 0,0).length?function(t,n){return void 0===t&&0===n?[]:e.call(this,t,n)}:e,[function(e,n){var o=a(this),i=null==e?void 0:e[t];return void 0!==i?i.call(e,o,n):r.call(l(o),e,n)},function(t,o){var a=i(this),p=l(t),h=n(r,a,p,o,r!==e);if(h.done)return h.value;var d=s(a,RegExp),v=a.unicode,m=new d(y?"^(?:"+a.source+")":a,(a.ignoreCase?"i":"")+(a.multiline?"m":"")+(a.unicode?"u":"")+(y?"g":"y")),b=void 0===o?4294967295:o>>>0;if(0===b)return[];if(0===p.length)return null===f(m,p)?[p]:[];for(var _=0,w=0,E=[];w<p.length;){m.lastIndex=y?0:w;var S,x=f(m,y?p.slice(w):p);if(null===x||(S=g(c(m.lastIndex+(y?w:0)),p.length))===_)w=u(p,w,v);else{if(E.push(p.slice(_,w)),E.length===b)return E;for(var T=1;T<=x.length-1;T++)if(E.push(x[T]),E.length===b)return E;w=_=S}}return E.push(p.slice(_)),E}]},!!d(function(){var t=/(?:)/,e=t.exec;t.exec=function(){return e.apply(this,arguments)};var n="ab".split(t);return 2!==n.length||"a"!==n[0]||"b"!==n[1]}),y)},Ep9I:function(t,e){t.exports=Object.is||function(t,e){return t===e?0!==t||1/t==1/e:t!=t&&e!=e}},Eqjn:function(t,e,n){"use strict";var r=n("I+eb"),o=n("g6v/"),i=n("6x0u"),a=n("ewvW"),s=n("HAuM"),u=n("m/L8");o&&r({target:"Object",proto:!0,forced:i},{__defineGetter__:function(t,e){u.f(a(this),t,{get:s(e),enumerable:!0,configurable:!0})}})},"Ew+T":function(t,e,n){var r=n("I+eb"),o=n("2oRo"),i=n("LPSS");r({global:!0,bind:!0,enumerable:!0,forced:!o.setImmediate||!o.clearImmediate},{setImmediate:i.set,clearImmediate:i.clear})},ExoC:function(t,e,n){n("I+eb")({target:"Object",stat:!0},{setPrototypeOf:n("0rvr")})},F8JR:function(t,e,n){"use strict";var r=n("tycR").forEach,o=n("pkCn")("forEach");t.exports=o?[].forEach:function(t){return r(this,t,arguments.length>1?arguments[1]:void 0)}},FDzp:function(t,e,n){n("dOgj")("Int32",function(t){return function(e,n,r){return t(this,e,n,r)}})},FEiF:function(t,e,n){var r=n("37lR"),o=n("trfz");t.exports=function(t,e){return r(o(t),e)}},FF6l:function(t,e,n){"use strict";var r=n("ewvW"),o=n("I8vh"),i=n("UMSQ"),a=Math.min;t.exports=[].copyWithin||function(t,e){var n=r(this),s=i(n.length),u=o(t,s),c=o(e,s),l=arguments.length>2?arguments[2]:void 0,f=a((void 0===l?s:o(l,s))-c,s-u),p=1;for(c<u&&u<c+f&&(p=-1,c+=f-1,u+=f-1);f-- >0;)c in n?n[u]=n[c]:delete n[u],u+=p,c+=p;return n}},FMNM:function(t,e,n){var r=n("xrYK"),o=n("kmMV");t.exports=function(t,e){var n=t.exec;if("function"==typeof n){var i=n.call(t,e);if("object"!=typeof i)throw TypeError("RegExp exec method returned something other than an Object or null");return i}if("RegExp"!==r(t))throw TypeError("RegExp#exec called on incompatible receiver");return o.call(t,e)}},FZtP:function(t,e,n){var r=n("2oRo"),o=n("/byt"),i=n("F8JR"),a=n("kRJp");for(var s in o){var u=r[s],c=u&&u.prototype;if(c&&c.forEach!==i)try{a(c,"forEach",i)}catch(l){c.forEach=i}}},"Fwt+":function(t,e,n){"use strict";var r=n("67WC"),o=n("UMSQ"),i=n("I8vh"),a=n("trfz"),s=r.aTypedArray;(0,r.exportTypedArrayMethod)("subarray",function(t,e){var n=s(this),r=n.length,u=i(t,r);return new(a(n))(n.buffer,n.byteOffset+u*n.BYTES_PER_ELEMENT,o((void 0===e?r:i(e,r))-u))})},"G+Rx":function(t,e,n){var r=n("0GbY");t.exports=r("document","documentElement")},"G/JM":function(t,e,n){n("I+eb")({target:"Reflect",stat:!0},{ownKeys:n("Vu81")})},GC2F:function(t,e,n){var r=n("+M1K");t.exports=function(t,e){var n=r(t);if(n%e)throw RangeError("Wrong offset");return n}},GKVU:function(t,e,n){"use strict";var r=n("I+eb"),o=n("hXpO");r({target:"String",proto:!0,forced:n("rwPt")("anchor")},{anchor:function(t){return o(this,"a","name",t)}})},GRPF:function(t,e,n){"use strict";var r=n("I+eb"),o=n("hXpO");r({target:"String",proto:!0,forced:n("rwPt")("fontsize")},{fontsize:function(t){return o(this,"font","size",t)}})},GXvd:function(t,e,n){n("dG/n")("species")},GarU:function(t,e){t.exports=function(t,e,n){if(!(t instanceof e))throw TypeError("Incorrect "+(n?n+" ":"")+"invocation");return t}},"H+LF":function(t,e,n){"use strict";n("bWFh")("WeakSet",function(t){return function(){return t(this,arguments.length?arguments[0]:void 0)}},n("rKzb"))},HAuM:function(t,e){t.exports=function(t){if("function"!=typeof t)throw TypeError(String(t)+" is not a function");return t}},HH4o:function(t,e,n){var r=n("tiKp")("iterator"),o=!1;try{var i=0,a={next:function(){return{done:!!i++}},return:function(){o=!0}};a[r]=function(){return this},Array.from(a,function(){throw 2})}catch(s){}t.exports=function(t,e){if(!e&&!o)return!1;var n=!1;try{var i={};i[r]=function(){return{next:function(){return{done:n=!0}}}},t(i)}catch(s){}return n}},HL5w:function(t,e){t.exports=function(t){window.wails._.Init(t)}},HNyW:function(t,e,n){var r=n("NC/Y");t.exports=/(?:ipad|iphone|ipod).*applewebkit/i.test(r)},HRxU:function(t,e,n){var r=n("I+eb"),o=n("g6v/");r({target:"Object",stat:!0,forced:!o,sham:!o},{defineProperties:n("N+g0")})},HYAF:function(t,e){t.exports=function(t){if(null==t)throw TypeError("Can't call method on "+t);return t}},Hd5f:function(t,e,n){var r=n("0Dky"),o=n("tiKp"),i=n("LQDL"),a=o("species");t.exports=function(t){return i>=51||!r(function(){var e=[];return(e.constructor={})[a]=function(){return{foo:1}},1!==e[t](Boolean).foo})}},HiXI:function(t,e,n){"use strict";var r=n("I+eb"),o=n("WKiH").end,i=n("yNLB")("trimEnd"),a=i?function(){return o(this)}:"".trimEnd;r({target:"String",proto:!0,forced:i},{trimEnd:a,trimRight:a})},HsHA:function(t,e){var n=Math.log;t.exports=Math.log1p||function(t){return(t=+t)>-1e-8&&t<1e-8?t-t*t/2:n(1+t)}},"I+eb":function(t,e,n){var r=n("2oRo"),o=n("Bs8V").f,i=n("kRJp"),a=n("busE"),s=n("zk60"),u=n("6JNq"),c=n("lMq5");t.exports=function(t,e){var n,l,f,p,h,d=t.target,y=t.global,v=t.stat;if(n=y?r:v?r[d]||s(d,{}):(r[d]||{}).prototype)for(l in e){if(p=e[l],f=t.noTargetGet?(h=o(n,l))&&h.value:n[l],!c(y?l:d+(v?".":"#")+l,t.forced)&&void 0!==f){if(typeof p==typeof f)continue;u(p,f)}(t.sham||f&&f.sham)&&i(p,"sham",!0),a(n,l,p,t)}}},I1Gw:function(t,e,n){n("dG/n")("split")},I8vh:function(t,e,n){var r=n("ppGB"),o=Math.max,i=Math.min;t.exports=function(t,e){var n=r(t);return n<0?o(n+e,0):i(n,e)}},I9xj:function(t,e,n){n("1E5z")(Math,"Math",!0)},"IL/d":function(t,e,n){"use strict";var r=n("iqeF");(0,n("67WC").exportTypedArrayStaticMethod)("from",n("oHi+"),r)},IZzc:function(t,e,n){"use strict";var r=n("67WC"),o=n("2oRo"),i=n("0Dky"),a=n("HAuM"),s=n("UMSQ"),u=n("rdv8"),c=n("BNF5"),l=n("2Zix"),f=n("LQDL"),p=n("USzg"),h=r.aTypedArray,d=r.exportTypedArrayMethod,y=o.Uint16Array,v=y&&y.prototype.sort,g=!!v&&!i(function(){var t=new y(2);t.sort(null),t.sort({})}),m=!!v&&!i(function(){if(f)return f<74;if(c)return c<67;if(l)return!0;if(p)return p<602;var t,e,n=new y(516),r=Array(516);for(t=0;t<516;t++)e=t%4,n[t]=515-t,r[t]=t-2*e+3;for(n.sort(function(t,e){return(t/4|0)-(e/4|0)}),t=0;t<516;t++)if(n[t]!==r[t])return!0});d("sort",function(t){if(void 0!==t&&a(t),m)return v.call(this,t);h(this);var e,n=s(this.length),r=Array(n);for(e=0;e<n;e++)r[e]=this[e];for(r=u(this,function(t){return function(e,n){return void 0!==t?+t(e,n)||0:n!=n?-1:e!=e?1:0===e&&0===n?1/e>0&&1/n<0?1:-1:e>n}}(t)),e=0;e<n;e++)this[e]=r[e];return this},!m||g)},ImZN:function(t,e,n){var r=n("glrk"),o=n("6VoE"),i=n("UMSQ"),a=n("A2ZE"),s=n("NaFW"),u=n("KmKo"),c=function(t,e){this.stopped=t,this.result=e};t.exports=function(t,e,n){var l,f,p,h,d,y,v,g=!(!n||!n.AS_ENTRIES),m=!(!n||!n.IS_ITERATOR),b=!(!n||!n.INTERRUPTED),_=a(e,n&&n.that,1+g+b),w=function(t){return l&&u(l),new c(!0,t)},E=function(t){return g?(r(t),b?_(t[0],t[1],w):_(t[0],t[1])):b?_(t,w):_(t)};if(m)l=t;else{if("function"!=typeof(f=s(t)))throw TypeError("Target is not iterable");if(o(f)){for(p=0,h=i(t.length);h>p;p++)if((d=E(t[p]))&&d instanceof c)return d;return new c(!1)}l=f.call(t)}for(y=l.next;!(v=y.call(l)).done;){try{d=E(v.value)}catch(S){throw u(l),S}if("object"==typeof d&&d&&d instanceof c)return d}return new c(!1)}},IxXR:function(t,e,n){"use strict";var r=n("I+eb"),o=n("hXpO");r({target:"String",proto:!0,forced:n("rwPt")("strike")},{strike:function(){return o(this,"strike","","")}})},J30X:function(t,e,n){n("I+eb")({target:"Array",stat:!0},{isArray:n("6LWA")})},JBy8:function(t,e,n){var r=n("yoRg"),o=n("eDl+").concat("length","prototype");e.f=Object.getOwnPropertyNames||function(t){return r(t,o)}},JTJg:function(t,e,n){"use strict";var r=n("I+eb"),o=n("WjRb"),i=n("HYAF"),a=n("V37c");r({target:"String",proto:!0,forced:!n("qxPZ")("includes")},{includes:function(t){return!!~a(i(this)).indexOf(a(o(t)),arguments.length>1?arguments[1]:void 0)}})},JaFt:function(t,e,n){"use strict";var r=n("67WC"),o=n("1Y/n").right,i=r.aTypedArray;(0,r.exportTypedArrayMethod)("reduceRight",function(t){return o(i(this),t,arguments.length,arguments.length>1?arguments[1]:void 0)})},JevA:function(t,e,n){var r=n("I+eb"),o=n("wg0c");r({target:"Number",stat:!0,forced:Number.parseInt!=o},{parseInt:o})},JfAA:function(t,e,n){"use strict";var r=n("busE"),o=n("glrk"),i=n("V37c"),a=n("0Dky"),s=n("rW0t"),u=RegExp.prototype,c=u.toString;(a(function(){return"/a/b"!=c.call({source:"a",flags:"b"})})||"toString"!=c.name)&&r(RegExp.prototype,"toString",function(){var t=o(this),e=i(t.source),n=t.flags;return"/"+e+"/"+i(void 0===n&&t instanceof RegExp&&!("flags"in u)?s.call(t):n)},{unsafe:!0})},JiZb:function(t,e,n){"use strict";var r=n("0GbY"),o=n("m/L8"),i=n("tiKp"),a=n("g6v/"),s=i("species");t.exports=function(t){var e=r(t);a&&e&&!e[s]&&(0,o.f)(e,s,{configurable:!0,get:function(){return this}})}},Junv:function(t,e,n){"use strict";var r=n("I+eb"),o=n("6LWA"),i=[].reverse,a=[1,2];r({target:"Array",proto:!0,forced:String(a)===String(a.reverse())},{reverse:function(){return o(this)&&(this.length=this.length),i.call(this)}})},KVSy:function(t,e,n){"use strict";var r=n("67WC"),o=n("trfz"),i=n("0Dky"),a=r.aTypedArray,s=[].slice;(0,r.exportTypedArrayMethod)("slice",function(t,e){for(var n=s.call(a(this),t,e),r=o(this),i=0,u=n.length,c=new r(u);u>i;)c[i]=n[i++];return c},i(function(){new Int8Array(1).slice()}))},KhsS:function(t,e,n){n("dG/n")("match")},KmKo:function(t,e,n){var r=n("glrk");t.exports=function(t){var e=t.return;if(void 0!==e)return r(e.call(t)).value}},KvGi:function(t,e,n){n("I+eb")({target:"Math",stat:!0},{sign:n("90hW")})},Kxld:function(t,e,n){n("I+eb")({target:"Object",stat:!0},{is:n("Ep9I")})},Kz25:function(t,e,n){"use strict";n("PKPk");var r,o=n("I+eb"),i=n("g6v/"),a=n("DTth"),s=n("2oRo"),u=n("N+g0"),c=n("busE"),l=n("GarU"),f=n("UTVS"),p=n("YNrV"),h=n("TfTi"),d=n("ZUd8").codeAt,y=n("X7LM"),v=n("V37c"),g=n("1E5z"),m=n("mGGf"),b=n("afO8"),_=s.URL,w=m.URLSearchParams,E=m.getState,S=b.set,x=b.getterFor("URL"),T=Math.floor,k=Math.pow,A=/[A-Za-z]/,I=/[\d+-.A-Za-z]/,C=/\d/,O=/^0x/i,P=/^[0-7]+$/,R=/^\d+$/,D=/^[\dA-Fa-f]+$/,N=/[\0\t\n\r #%/:<>?@[\\\]^|]/,M=/[\0\t\n\r #/:<>?@[\\\]^|]/,j=/^[\u0000-\u0020]+|[\u0000-\u0020]+$/g,F=/[\t\n\r]/g,L=function(t,e){var n,r,o;if("["==e.charAt(0)){if("]"!=e.charAt(e.length-1))return"Invalid host";if(!(n=V(e.slice(1,-1))))return"Invalid host";t.host=n}else if(K(t)){if(e=y(e),N.test(e))return"Invalid host";if(null===(n=B(e)))return"Invalid host";t.host=n}else{if(M.test(e))return"Invalid host";for(n="",r=h(e),o=0;o<r.length;o++)n+=q(r[o],z);t.host=n}},B=function(t){var e,n,r,o,i,a,s,u=t.split(".");if(u.length&&""==u[u.length-1]&&u.pop(),(e=u.length)>4)return t;for(n=[],r=0;r<e;r++){if(""==(o=u[r]))return t;if(i=10,o.length>1&&"0"==o.charAt(0)&&(i=O.test(o)?16:8,o=o.slice(8==i?1:2)),""===o)a=0;else{if(!(10==i?R:8==i?P:D).test(o))return t;a=parseInt(o,i)}n.push(a)}for(r=0;r<e;r++)if(a=n[r],r==e-1){if(a>=k(256,5-e))return null}else if(a>255)return null;for(s=n.pop(),r=0;r<n.length;r++)s+=n[r]*k(256,3-r);return s},V=function(t){var e,n,r,o,i,a,s,u=[0,0,0,0,0,0,0,0],c=0,l=null,f=0,p=function(){return t.charAt(f)};if(":"==p()){if(":"!=t.charAt(1))return;f+=2,l=++c}for(;p();){if(8==c)return;if(":"!=p()){for(e=n=0;n<4&&D.test(p());)e=16*e+parseInt(p(),16),f++,n++;if("."==p()){if(0==n)return;if(f-=n,c>6)return;for(r=0;p();){if(o=null,r>0){if(!("."==p()&&r<4))return;f++}if(!C.test(p()))return;for(;C.test(p());){if(i=parseInt(p(),10),null===o)o=i;else{if(0==o)return;o=10*o+i}if(o>255)return;f++}u[c]=256*u[c]+o,2!=++r&&4!=r||c++}if(4!=r)return;break}if(":"==p()){if(f++,!p())return}else if(p())return;u[c++]=e}else{if(null!==l)return;f++,l=++c}}if(null!==l)for(a=c-l,c=7;0!=c&&a>0;)s=u[c],u[c--]=u[l+a-1],u[l+--a]=s;else if(8!=c)return;return u},H=function(t){var e,n,r,o;if("number"==typeof t){for(e=[],n=0;n<4;n++)e.unshift(t%256),t=T(t/256);return e.join(".")}if("object"==typeof t){for(e="",r=function(t){for(var e=null,n=1,r=null,o=0,i=0;i<8;i++)0!==t[i]?(o>n&&(e=r,n=o),r=null,o=0):(null===r&&(r=i),++o);return o>n&&(e=r,n=o),e}(t),n=0;n<8;n++)o&&0===t[n]||(o&&(o=!1),r===n?(e+=n?":":"::",o=!0):(e+=t[n].toString(16),n<7&&(e+=":")));return"["+e+"]"}return t},z={},U=p({},z,{" ":1,'"':1,"<":1,">":1,"`":1}),W=p({},U,{"#":1,"?":1,"{":1,"}":1}),Z=p({},W,{"/":1,":":1,";":1,"=":1,"@":1,"[":1,"\\":1,"]":1,"^":1,"|":1}),q=function(t,e){var n=d(t,0);return n>32&&n<127&&!f(e,t)?t:encodeURIComponent(t)},G={ftp:21,file:null,http:80,https:443,ws:80,wss:443},K=function(t){return f(G,t.scheme)},Q=function(t){return""!=t.username||""!=t.password},Y=function(t){return!t.host||t.cannotBeABaseURL||"file"==t.scheme},X=function(t,e){var n;return 2==t.length&&A.test(t.charAt(0))&&(":"==(n=t.charAt(1))||!e&&"|"==n)},J=function(t){var e;return t.length>1&&X(t.slice(0,2))&&(2==t.length||"/"===(e=t.charAt(2))||"\\"===e||"?"===e||"#"===e)},$=function(t){var e=t.path,n=e.length;!n||"file"==t.scheme&&1==n&&X(e[0],!0)||e.pop()},tt=function(t){return"."===t||"%2e"===t.toLowerCase()},et={},nt={},rt={},ot={},it={},at={},st={},ut={},ct={},lt={},ft={},pt={},ht={},dt={},yt={},vt={},gt={},mt={},bt={},_t={},wt={},Et=function(t,e,n,o){var i,a,s,u,c,l=n||et,p=0,d="",y=!1,v=!1,g=!1;for(n||(t.scheme="",t.username="",t.password="",t.host=null,t.port=null,t.path=[],t.query=null,t.fragment=null,t.cannotBeABaseURL=!1,e=e.replace(j,"")),e=e.replace(F,""),i=h(e);p<=i.length;){switch(a=i[p],l){case et:if(!a||!A.test(a)){if(n)return"Invalid scheme";l=rt;continue}d+=a.toLowerCase(),l=nt;break;case nt:if(a&&(I.test(a)||"+"==a||"-"==a||"."==a))d+=a.toLowerCase();else{if(":"!=a){if(n)return"Invalid scheme";d="",l=rt,p=0;continue}if(n&&(K(t)!=f(G,d)||"file"==d&&(Q(t)||null!==t.port)||"file"==t.scheme&&!t.host))return;if(t.scheme=d,n)return void(K(t)&&G[t.scheme]==t.port&&(t.port=null));d="","file"==t.scheme?l=dt:K(t)&&o&&o.scheme==t.scheme?l=ot:K(t)?l=ut:"/"==i[p+1]?(l=it,p++):(t.cannotBeABaseURL=!0,t.path.push(""),l=bt)}break;case rt:if(!o||o.cannotBeABaseURL&&"#"!=a)return"Invalid scheme";if(o.cannotBeABaseURL&&"#"==a){t.scheme=o.scheme,t.path=o.path.slice(),t.query=o.query,t.fragment="",t.cannotBeABaseURL=!0,l=wt;break}l="file"==o.scheme?dt:at;continue;case ot:if("/"!=a||"/"!=i[p+1]){l=at;continue}l=ct,p++;break;case it:if("/"==a){l=lt;break}l=mt;continue;case at:if(t.scheme=o.scheme,a==r)t.username=o.username,t.password=o.password,t.host=o.host,t.port=o.port,t.path=o.path.slice(),t.query=o.query;else if("/"==a||"\\"==a&&K(t))l=st;else if("?"==a)t.username=o.username,t.password=o.password,t.host=o.host,t.port=o.port,t.path=o.path.slice(),t.query="",l=_t;else{if("#"!=a){t.username=o.username,t.password=o.password,t.host=o.host,t.port=o.port,t.path=o.path.slice(),t.path.pop(),l=mt;continue}t.username=o.username,t.password=o.password,t.host=o.host,t.port=o.port,t.path=o.path.slice(),t.query=o.query,t.fragment="",l=wt}break;case st:if(!K(t)||"/"!=a&&"\\"!=a){if("/"!=a){t.username=o.username,t.password=o.password,t.host=o.host,t.port=o.port,l=mt;continue}l=lt}else l=ct;break;case ut:if(l=ct,"/"!=a||"/"!=d.charAt(p+1))continue;p++;break;case ct:if("/"!=a&&"\\"!=a){l=lt;continue}break;case lt:if("@"==a){y&&(d="%40"+d),y=!0,s=h(d);for(var m=0;m<s.length;m++){var b=s[m];if(":"!=b||g){var _=q(b,Z);g?t.password+=_:t.username+=_}else g=!0}d=""}else if(a==r||"/"==a||"?"==a||"#"==a||"\\"==a&&K(t)){if(y&&""==d)return"Invalid authority";p-=h(d).length+1,d="",l=ft}else d+=a;break;case ft:case pt:if(n&&"file"==t.scheme){l=vt;continue}if(":"!=a||v){if(a==r||"/"==a||"?"==a||"#"==a||"\\"==a&&K(t)){if(K(t)&&""==d)return"Invalid host";if(n&&""==d&&(Q(t)||null!==t.port))return;if(u=L(t,d))return u;if(d="",l=gt,n)return;continue}"["==a?v=!0:"]"==a&&(v=!1),d+=a}else{if(""==d)return"Invalid host";if(u=L(t,d))return u;if(d="",l=ht,n==pt)return}break;case ht:if(!C.test(a)){if(a==r||"/"==a||"?"==a||"#"==a||"\\"==a&&K(t)||n){if(""!=d){var w=parseInt(d,10);if(w>65535)return"Invalid port";t.port=K(t)&&w===G[t.scheme]?null:w,d=""}if(n)return;l=gt;continue}return"Invalid port"}d+=a;break;case dt:if(t.scheme="file","/"==a||"\\"==a)l=yt;else{if(!o||"file"!=o.scheme){l=mt;continue}if(a==r)t.host=o.host,t.path=o.path.slice(),t.query=o.query;else if("?"==a)t.host=o.host,t.path=o.path.slice(),t.query="",l=_t;else{if("#"!=a){J(i.slice(p).join(""))||(t.host=o.host,t.path=o.path.slice(),$(t)),l=mt;continue}t.host=o.host,t.path=o.path.slice(),t.query=o.query,t.fragment="",l=wt}}break;case yt:if("/"==a||"\\"==a){l=vt;break}o&&"file"==o.scheme&&!J(i.slice(p).join(""))&&(X(o.path[0],!0)?t.path.push(o.path[0]):t.host=o.host),l=mt;continue;case vt:if(a==r||"/"==a||"\\"==a||"?"==a||"#"==a){if(!n&&X(d))l=mt;else if(""==d){if(t.host="",n)return;l=gt}else{if(u=L(t,d))return u;if("localhost"==t.host&&(t.host=""),n)return;d="",l=gt}continue}d+=a;break;case gt:if(K(t)){if(l=mt,"/"!=a&&"\\"!=a)continue}else if(n||"?"!=a)if(n||"#"!=a){if(a!=r&&(l=mt,"/"!=a))continue}else t.fragment="",l=wt;else t.query="",l=_t;break;case mt:if(a==r||"/"==a||"\\"==a&&K(t)||!n&&("?"==a||"#"==a)){if(".."===(c=(c=d).toLowerCase())||"%2e."===c||".%2e"===c||"%2e%2e"===c?($(t),"/"==a||"\\"==a&&K(t)||t.path.push("")):tt(d)?"/"==a||"\\"==a&&K(t)||t.path.push(""):("file"==t.scheme&&!t.path.length&&X(d)&&(t.host&&(t.host=""),d=d.charAt(0)+":"),t.path.push(d)),d="","file"==t.scheme&&(a==r||"?"==a||"#"==a))for(;t.path.length>1&&""===t.path[0];)t.path.shift();"?"==a?(t.query="",l=_t):"#"==a&&(t.fragment="",l=wt)}else d+=q(a,W);break;case bt:"?"==a?(t.query="",l=_t):"#"==a?(t.fragment="",l=wt):a!=r&&(t.path[0]+=q(a,z));break;case _t:n||"#"!=a?a!=r&&("'"==a&&K(t)?t.query+="%27":t.query+="#"==a?"%23":q(a,z)):(t.fragment="",l=wt);break;case wt:a!=r&&(t.fragment+=q(a,U))}p++}},St=function(t){var e,n,r=l(this,St,"URL"),o=arguments.length>1?arguments[1]:void 0,a=v(t),s=S(r,{type:"URL"});if(void 0!==o)if(o instanceof St)e=x(o);else if(n=Et(e={},v(o)))throw TypeError(n);if(n=Et(s,a,null,e))throw TypeError(n);var u=s.searchParams=new w,c=E(u);c.updateSearchParams(s.query),c.updateURL=function(){s.query=String(u)||null},i||(r.href=Tt.call(r),r.origin=kt.call(r),r.protocol=At.call(r),r.username=It.call(r),r.password=Ct.call(r),r.host=Ot.call(r),r.hostname=Pt.call(r),r.port=Rt.call(r),r.pathname=Dt.call(r),r.search=Nt.call(r),r.searchParams=Mt.call(r),r.hash=jt.call(r))},xt=St.prototype,Tt=function(){var t=x(this),e=t.scheme,n=t.username,r=t.password,o=t.host,i=t.port,a=t.path,s=t.query,u=t.fragment,c=e+":";return null!==o?(c+="//",Q(t)&&(c+=n+(r?":"+r:"")+"@"),c+=H(o),null!==i&&(c+=":"+i)):"file"==e&&(c+="//"),c+=t.cannotBeABaseURL?a[0]:a.length?"/"+a.join("/"):"",null!==s&&(c+="?"+s),null!==u&&(c+="#"+u),c},kt=function(){var t=x(this),e=t.scheme,n=t.port;if("blob"==e)try{return new St(e.path[0]).origin}catch(r){return"null"}return"file"!=e&&K(t)?e+"://"+H(t.host)+(null!==n?":"+n:""):"null"},At=function(){return x(this).scheme+":"},It=function(){return x(this).username},Ct=function(){return x(this).password},Ot=function(){var t=x(this),e=t.host,n=t.port;return null===e?"":null===n?H(e):H(e)+":"+n},Pt=function(){var t=x(this).host;return null===t?"":H(t)},Rt=function(){var t=x(this).port;return null===t?"":String(t)},Dt=function(){var t=x(this),e=t.path;return t.cannotBeABaseURL?e[0]:e.length?"/"+e.join("/"):""},Nt=function(){var t=x(this).query;return t?"?"+t:""},Mt=function(){return x(this).searchParams},jt=function(){var t=x(this).fragment;return t?"#"+t:""},Ft=function(t,e){return{get:t,set:e,configurable:!0,enumerable:!0}};if(i&&u(xt,{href:Ft(Tt,function(t){var e=x(this),n=v(t),r=Et(e,n);if(r)throw TypeError(r);E(e.searchParams).updateSearchParams(e.query)}),origin:Ft(kt),protocol:Ft(At,function(t){var e=x(this);Et(e,v(t)+":",et)}),username:Ft(It,function(t){var e=x(this),n=h(v(t));if(!Y(e)){e.username="";for(var r=0;r<n.length;r++)e.username+=q(n[r],Z)}}),password:Ft(Ct,function(t){var e=x(this),n=h(v(t));if(!Y(e)){e.password="";for(var r=0;r<n.length;r++)e.password+=q(n[r],Z)}}),host:Ft(Ot,function(t){var e=x(this);e.cannotBeABaseURL||Et(e,v(t),ft)}),hostname:Ft(Pt,function(t){var e=x(this);e.cannotBeABaseURL||Et(e,v(t),pt)}),port:Ft(Rt,function(t){var e=x(this);Y(e)||(""==(t=v(t))?e.port=null:Et(e,t,ht))}),pathname:Ft(Dt,function(t){var e=x(this);e.cannotBeABaseURL||(e.path=[],Et(e,v(t),gt))}),search:Ft(Nt,function(t){var e=x(this);""==(t=v(t))?e.query=null:("?"==t.charAt(0)&&(t=t.slice(1)),e.query="",Et(e,t,_t)),E(e.searchParams).updateSearchParams(e.query)}),searchParams:Ft(Mt),hash:Ft(jt,function(t){var e=x(this);""!=(t=v(t))?("#"==t.charAt(0)&&(t=t.slice(1)),e.fragment="",Et(e,t,wt)):e.fragment=null})}),c(xt,"toJSON",function(){return Tt.call(this)},{enumerable:!0}),c(xt,"toString",function(){return Tt.call(this)},{enumerable:!0}),_){var Lt=_.createObjectURL,Bt=_.revokeObjectURL;Lt&&c(St,"createObjectURL",function(t){return Lt.apply(_,arguments)}),Bt&&c(St,"revokeObjectURL",function(t){return Bt.apply(_,arguments)})}g(St,"URL"),o({global:!0,forced:!a,sham:!i},{URL:St})},LD7m:function(t,e,n){var r=n("g6v/"),o=n("n3/R").UNSUPPORTED_Y,i=n("m/L8").f,a=n("afO8").get,s=RegExp.prototype;r&&o&&i(s,"sticky",{configurable:!0,get:function(){if(this!==s){if(this instanceof RegExp)return!!a(this).sticky;throw TypeError("Incompatible receiver, RegExp required")}}})},LKBx:function(t,e,n){"use strict";var r,o=n("I+eb"),i=n("Bs8V").f,a=n("UMSQ"),s=n("V37c"),u=n("WjRb"),c=n("HYAF"),l=n("qxPZ"),f=n("xDBR"),p="".startsWith,h=Math.min,d=l("startsWith");o({target:"String",proto:!0,forced:!(!f&&!d&&(r=i(String.prototype,"startsWith"),r&&!r.writable)||d)},{startsWith:function(t){var e=s(c(this));u(t);var n=a(h(arguments.length>1?arguments[1]:void 0,e.length)),r=s(t);return p?p.call(e,r,n):e.slice(n,n+r.length)===r}})},LPSS:function(t,e,n){var r,o,i,a,s=n("2oRo"),u=n("0Dky"),c=n("A2ZE"),l=n("G+Rx"),f=n("zBJ4"),p=n("HNyW"),h=n("YF1G"),d=s.setImmediate,y=s.clearImmediate,v=s.process,g=s.MessageChannel,m=s.Dispatch,b=0,_={};try{r=s.location}catch(T){}var w=function(t){if(_.hasOwnProperty(t)){var e=_[t];delete _[t],e()}},E=function(t){return function(){w(t)}},S=function(t){w(t.data)},x=function(t){s.postMessage(String(t),r.protocol+"//"+r.host)};d&&y||(d=function(t){for(var e=[],n=arguments.length,r=1;n>r;)e.push(arguments[r++]);return _[++b]=function(){("function"==typeof t?t:Function(t)).apply(void 0,e)},o(b),b},y=function(t){delete _[t]},h?o=function(t){v.nextTick(E(t))}:m&&m.now?o=function(t){m.now(E(t))}:g&&!p?(a=(i=new g).port2,i.port1.onmessage=S,o=c(a.postMessage,a,1)):s.addEventListener&&"function"==typeof postMessage&&!s.importScripts&&r&&"file:"!==r.protocol&&!u(x)?(o=x,s.addEventListener("message",S,!1)):o="onreadystatechange"in f("script")?function(t){l.appendChild(f("script")).onreadystatechange=function(){l.removeChild(this),w(t)}}:function(t){setTimeout(E(t),0)}),t.exports={set:d,clear:y}},LQDL:function(t,e,n){var r,o,i=n("2oRo"),a=n("NC/Y"),s=i.process,u=i.Deno,c=s&&s.versions||u&&u.version,l=c&&c.v8;l?o=(r=l.split("."))[0]<4?1:r[0]+r[1]:a&&(!(r=a.match(/Edge\/(\d+)/))||r[1]>=74)&&(r=a.match(/Chrome\/(\d+)/))&&(o=r[1]),t.exports=o&&+o},LSZE:function(t,e,n){n("pNMO"),n("4Brf"),n("tjZM"),n("3I1R"),n("7+kd"),n("0oug"),n("KhsS"),n("jt2F"),n("gOCb"),n("a57n"),n("GXvd"),n("I1Gw"),n("gXIK"),n("lEou"),n("gbiT"),n("lnpS"),n("ma9I"),n("qHT+"),n("piMb"),n("yyme"),n("TeQF"),n("fbCW"),n("x0AG"),n("BIHw"),n("XbcX"),n("QWBl"),n("pjDv"),n("yq1k"),n("yXV3"),n("J30X"),n("4mDm"),n("oVuX"),n("uqXc"),n("2B1R"),n("Xe3L"),n("E9XD"),n("9N29"),n("Junv"),n("+2oP"),n("Rfxz"),n("ToJy"),n("94Xl"),n("pDQq"),n("QGkA"),n("c9m3"),n("wZ/5"),n("gtqK"),n("rOQg"),n("tCCV"),n("CpAL"),n("brp2"),n("kyGr"),n("0boY"),n("rMz7"),n("9LPj"),n("7+zs"),n("DQNa"),n("TRJa"),n("wLYn"),n("tW5y"),n("sMBO"),n("bFeb"),n("6cQw"),n("DEfu"),n("Tskq"),n("Uydy"),n("eajv"),n("n/mU"),n("PqOI"),n("QNnp"),n("/5zm"),n("CsgD"),n("9mRW"),n("QFcT"),n("vAFs"),n("a5NK"),n("yiG3"),n("kNcU"),n("KvGi"),n("AmFO"),n("eJiR"),n("I9xj"),n("tl/u"),n("qePV"),n("NbN+"),n("8AyJ"),n("i6QF"),n("kSko"),n("WDsR"),n("r/Vq"),n("5uH8"),n("w1rZ"),n("JevA"),n("toAj"),n("VC3L"),n("zKZe"),n("uL8W"),n("Eqjn"),n("HRxU"),n("eoL8"),n("5xtp"),n("T63A"),n("3KgV"),n("wfmh"),n("5DmW"),n("27RR"),n("cDke"),n("NBAS"),n("Kxld"),n("yQYn"),n("4h0Y"),n("5D5o"),n("tkto"),n("v5b1"),n("W/eh"),n("zuhW"),n("r5Og"),n("ExoC"),n("07d7"),n("B6y2"),n("rNhl"),n("4l63"),n("5s+n"),n("gg6r"),n("2/pz"),n("p532"),n("pv2x"),n("SuFq"),n("PzqY"),n("rBZX"),n("XUE8"),n("nkod"),n("f3jH"),n("x2An"),n("25bX"),n("G/JM"),n("1t3B"),n("ftMj"),n("i5pp"),n("+MnM"),n("TWNs"),n("xgco"),n("rB9j"),n("U3f4"),n("LD7m"),n("ALS0"),n("JfAA"),n("YGK4"),n("9bJ7"),n("inlA"),n("9tb/"),n("JTJg"),n("PKPk"),n("Rm1S"),n("ofBz"),n("hDyC"),n("TZCg"),n("2A+d"),n("OM9Z"),n("UxlC"),n("W4Ht"),n("hByQ"),n("EnZy"),n("LKBx"),n("4yNf"),n("SYor"),n("HiXI"),n("7ueG"),n("GKVU"),n("E5NM"),n("BNMt"),n("zHFu"),n("x83w"),n("l2dK"),n("GRPF"),n("xdBZ"),n("mRH6"),n("yWo2"),n("IxXR"),n("TFPT"),n("Zk8X"),n("z8NH"),n("SpvK"),n("/Yfv"),n("iwkZ"),n("FDzp"),n("XMab"),n("ilnZ"),n("hMMk"),n("+ywr"),n("moxL"),n("qXVe"),n("c162"),n("waxf"),n("0TkE"),n("Onu3"),n("1dYe"),n("IL/d"),n("gvgV"),n("6R/c"),n("YL0P"),n("X5Zq"),n("MoCz"),n("P8wP"),n("7JcK"),n("ypFw"),n("JaFt"),n("zSZm"),n("PF2M"),n("KVSy"),n("ZJ55"),n("IZzc"),n("Fwt+"),n("s5qe"),n("cvf0"),n("klfK"),n("ENF9"),n("H+LF"),n("FZtP"),n("3bBZ"),n("Ew+T"),n("n5b4"),n("R5XZ"),n("Kz25"),n("vxnP"),n("mGGf"),t.exports=n("Qo9l")},MoCz:function(t,e,n){"use strict";var r=n("67WC"),o=n("5Yz+"),i=r.aTypedArray;(0,r.exportTypedArrayMethod)("lastIndexOf",function(t){return o.apply(i(this),arguments)})},"N+g0":function(t,e,n){var r=n("g6v/"),o=n("m/L8"),i=n("glrk"),a=n("33Wh");t.exports=r?Object.defineProperties:function(t,e){i(t);for(var n,r=a(e),s=r.length,u=0;s>u;)o.f(t,n=r[u++],e[n]);return t}},NBAS:function(t,e,n){var r=n("I+eb"),o=n("0Dky"),i=n("ewvW"),a=n("4WOD"),s=n("4Xet");r({target:"Object",stat:!0,forced:o(function(){a(1)}),sham:!s},{getPrototypeOf:function(t){return a(i(t))}})},"NC/Y":function(t,e,n){var r=n("0GbY");t.exports=r("navigator","userAgent")||""},NaFW:function(t,e,n){var r=n("9d/t"),o=n("P4y1"),i=n("tiKp")("iterator");t.exports=function(t){if(null!=t)return t[i]||t["@@iterator"]||o[r(t)]}},"NbN+":function(t,e,n){n("I+eb")({target:"Number",stat:!0},{EPSILON:Math.pow(2,-52)})},O741:function(t,e,n){var r=n("hh1v");t.exports=function(t){if(!r(t)&&null!==t)throw TypeError("Can't set "+String(t)+" as a prototype");return t}},OM9Z:function(t,e,n){n("I+eb")({target:"String",proto:!0},{repeat:n("EUja")})},Onu3:function(t,e,n){"use strict";var r=n("67WC"),o=n("tycR").findIndex,i=r.aTypedArray;(0,r.exportTypedArrayMethod)("findIndex",function(t){return o(i(this),t,arguments.length>1?arguments[1]:void 0)})},P4y1:function(t,e){t.exports={}},P8wP:function(t,e,n){"use strict";var r=n("67WC"),o=n("tycR").map,i=n("trfz"),a=r.aTypedArray;(0,r.exportTypedArrayMethod)("map",function(t){return o(a(this),t,arguments.length>1?arguments[1]:void 0,function(t,e){return new(i(t))(e)})})},PF2M:function(t,e,n){"use strict";var r=n("67WC"),o=n("UMSQ"),i=n("GC2F"),a=n("ewvW"),s=n("0Dky"),u=r.aTypedArray;(0,r.exportTypedArrayMethod)("set",function(t){u(this);var e=i(arguments.length>1?arguments[1]:void 0,1),n=this.length,r=a(t),s=o(r.length),c=0;if(s+e>n)throw RangeError("Wrong length");for(;c<s;)this[e+c]=r[c++]},s(function(){new Int8Array(1).set({})}))},PKPk:function(t,e,n){"use strict";var r=n("ZUd8").charAt,o=n("V37c"),i=n("afO8"),a=n("fdAy"),s=i.set,u=i.getterFor("String Iterator");a(String,"String",function(t){s(this,{type:"String Iterator",string:o(t),index:0})},function(){var t,e=u(this),n=e.string,o=e.index;return o>=n.length?{value:void 0,done:!0}:(t=r(n,o),e.index+=t.length,{value:t,done:!1})})},PqOI:function(t,e,n){var r=n("I+eb"),o=n("90hW"),i=Math.abs,a=Math.pow;r({target:"Math",stat:!0},{cbrt:function(t){return o(t=+t)*a(i(t),1/3)}})},PzqY:function(t,e,n){var r=n("I+eb"),o=n("g6v/"),i=n("glrk"),a=n("oEtG"),s=n("m/L8");r({target:"Reflect",stat:!0,forced:n("0Dky")(function(){Reflect.defineProperty(s.f({},1,{value:1}),1,{value:2})}),sham:!o},{defineProperty:function(t,e,n){i(t);var r=a(e);i(n);try{return s.f(t,r,n),!0}catch(o){return!1}}})},QFcT:function(t,e,n){var r=n("I+eb"),o=Math.hypot,i=Math.abs,a=Math.sqrt;r({target:"Math",stat:!0,forced:!!o&&o(1/0,NaN)!==1/0},{hypot:function(t,e){for(var n,r,o=0,s=0,u=arguments.length,c=0;s<u;)c<(n=i(arguments[s++]))?(o=o*(r=c/n)*r+1,c=n):o+=n>0?(r=n/c)*r:n;return c===1/0?1/0:c*a(o)}})},QGkA:function(t,e,n){n("RNIs")("flat")},QIpd:function(t,e,n){var r=n("xrYK");t.exports=function(t){if("number"!=typeof t&&"Number"!=r(t))throw TypeError("Incorrect invocation");return+t}},QNnp:function(t,e,n){var r=n("I+eb"),o=Math.floor,i=Math.log,a=Math.LOG2E;r({target:"Math",stat:!0},{clz32:function(t){return(t>>>=0)?31-o(i(t+.5)*a):32}})},QWBl:function(t,e,n){"use strict";var r=n("I+eb"),o=n("F8JR");r({target:"Array",proto:!0,forced:[].forEach!=o},{forEach:o})},Qo9l:function(t,e,n){var r=n("2oRo");t.exports=r},R5XZ:function(t,e,n){var r=n("I+eb"),o=n("2oRo"),i=n("NC/Y"),a=[].slice,s=function(t){return function(e,n){var r=arguments.length>2,o=r?a.call(arguments,2):void 0;return t(r?function(){("function"==typeof e?e:Function(e)).apply(this,o)}:e,n)}};r({global:!0,bind:!0,forced:/MSIE .\./.test(i)},{setTimeout:s(o.setTimeout),setInterval:s(o.setInterval)})},RK3t:function(t,e,n){var r=n("0Dky"),o=n("xrYK"),i="".split;t.exports=r(function(){return!Object("z").propertyIsEnumerable(0)})?function(t){return"String"==o(t)?i.call(t,""):Object(t)}:Object},RN6c:function(t,e,n){var r=n("2oRo");t.exports=function(t,e){var n=r.console;n&&n.error&&(1===arguments.length?n.error(t):n.error(t,e))}},RNIs:function(t,e,n){var r=n("tiKp"),o=n("fHMY"),i=n("m/L8"),a=r("unscopables"),s=Array.prototype;null==s[a]&&i.f(s,a,{configurable:!0,value:o(null)}),t.exports=function(t){s[a][t]=!0}},ROdP:function(t,e,n){var r=n("hh1v"),o=n("xrYK"),i=n("tiKp")("match");t.exports=function(t){var e;return r(t)&&(void 0!==(e=t[i])?!!e:"RegExp"==o(t))}},Rfxz:function(t,e,n){"use strict";var r=n("I+eb"),o=n("tycR").some;r({target:"Array",proto:!0,forced:!n("pkCn")("some")},{some:function(t){return o(this,t,arguments.length>1?arguments[1]:void 0)}})},Rm1S:function(t,e,n){"use strict";var r=n("14Sl"),o=n("glrk"),i=n("UMSQ"),a=n("V37c"),s=n("HYAF"),u=n("iqWW"),c=n("FMNM");r("match",function(t,e,n){return[function(e){var n=s(this),r=null==e?void 0:e[t];return void 0!==r?r.call(e,n):new RegExp(e)[t](a(n))},function(t){var r=o(this),s=a(t),l=n(e,r,s);if(l.done)return l.value;if(!r.global)return c(r,s);var f=r.unicode;r.lastIndex=0;for(var p,h=[],d=0;null!==(p=c(r,s));){var y=a(p[0]);h[d]=y,""===y&&(r.lastIndex=u(s,i(r.lastIndex),f)),d++}return 0===d?null:h}]})},SEBh:function(t,e,n){var r=n("glrk"),o=n("HAuM"),i=n("tiKp")("species");t.exports=function(t,e){var n,a=r(t).constructor;return void 0===a||null==(n=r(a)[i])?e:o(n)}},SFrS:function(t,e,n){var r=n("hh1v");t.exports=function(t,e){var n,o;if("string"===e&&"function"==typeof(n=t.toString)&&!r(o=n.call(t)))return o;if("function"==typeof(n=t.valueOf)&&!r(o=n.call(t)))return o;if("string"!==e&&"function"==typeof(n=t.toString)&&!r(o=n.call(t)))return o;throw TypeError("Can't convert object to primitive value")}},STAE:function(t,e,n){var r=n("LQDL"),o=n("0Dky");t.exports=!!Object.getOwnPropertySymbols&&!o(function(){var t=Symbol();return!String(t)||!(Object(t)instanceof Symbol)||!Symbol.sham&&r&&r<41})},SYor:function(t,e,n){"use strict";var r=n("I+eb"),o=n("WKiH").trim;r({target:"String",proto:!0,forced:n("yNLB")("trim")},{trim:function(){return o(this)}})},SpvK:function(t,e,n){n("dOgj")("Float64",function(t){return function(e,n,r){return t(this,e,n,r)}})},SuFq:function(t,e,n){var r=n("I+eb"),o=n("0GbY"),i=n("HAuM"),a=n("glrk"),s=n("hh1v"),u=n("fHMY"),c=n("BTho"),l=n("0Dky"),f=o("Reflect","construct"),p=l(function(){function t(){}return!(f(function(){},[],t)instanceof t)}),h=!l(function(){f(function(){})}),d=p||h;r({target:"Reflect",stat:!0,forced:d,sham:d},{construct:function(t,e){i(t),a(e);var n=arguments.length<3?t:i(arguments[2]);if(h&&!p)return f(t,e,n);if(t==n){switch(e.length){case 0:return new t;case 1:return new t(e[0]);case 2:return new t(e[0],e[1]);case 3:return new t(e[0],e[1],e[2]);case 4:return new t(e[0],e[1],e[2],e[3])}var r=[null];return r.push.apply(r,e),new(c.apply(t,r))}var o=n.prototype,l=u(s(o)?o:Object.prototype),d=Function.apply.call(t,l,e);return s(d)?d:l}})},T63A:function(t,e,n){var r=n("I+eb"),o=n("b1O7").entries;r({target:"Object",stat:!0},{entries:function(t){return o(t)}})},TFPT:function(t,e,n){"use strict";var r=n("I+eb"),o=n("hXpO");r({target:"String",proto:!0,forced:n("rwPt")("sub")},{sub:function(){return o(this,"sub","","")}})},TRJa:function(t,e,n){"use strict";var r=n("I+eb"),o=n("V37c"),i=/[\w*+\-./@]/,a=function(t,e){for(var n=t.toString(16);n.length<e;)n="0"+n;return n};r({global:!0},{escape:function(t){for(var e,n,r=o(t),s="",u=r.length,c=0;c<u;)e=r.charAt(c++),i.test(e)?s+=e:s+=(n=e.charCodeAt(0))<256?"%"+a(n,2):"%u"+a(n,4).toUpperCase();return s}})},TWNs:function(t,e,n){var r=n("g6v/"),o=n("2oRo"),i=n("lMq5"),a=n("cVYH"),s=n("kRJp"),u=n("m/L8").f,c=n("JBy8").f,l=n("ROdP"),f=n("V37c"),p=n("rW0t"),h=n("n3/R"),d=n("busE"),y=n("0Dky"),v=n("UTVS"),g=n("afO8").enforce,m=n("JiZb"),b=n("tiKp"),_=n("/OPJ"),w=n("EHx7"),E=b("match"),S=o.RegExp,x=S.prototype,T=/^\?<[^\s\d!#%&*+<=>@^][^\s!#%&*+<=>@^]*>/,k=/a/g,A=/a/g,I=new S(k)!==k,C=h.UNSUPPORTED_Y;if(i("RegExp",r&&(!I||C||_||w||y(function(){return A[E]=!1,S(k)!=k||S(A)==A||"/a/i"!=S(k,"i")})))){for(var O=function(t,e){var n,r,o,i,u,c,h=this instanceof O,d=l(t),y=void 0===e,m=[],b=t;if(!h&&d&&y&&t.constructor===O)return t;if((d||t instanceof O)&&(t=t.source,y&&(e="flags"in b?b.flags:p.call(b))),t=void 0===t?"":f(t),e=void 0===e?"":f(e),b=t,_&&"dotAll"in k&&(r=!!e&&e.indexOf("s")>-1)&&(e=e.replace(/s/g,"")),n=e,C&&"sticky"in k&&(o=!!e&&e.indexOf("y")>-1)&&(e=e.replace(/y/g,"")),w&&(t=(i=function(t){for(var e,n=t.length,r=0,o="",i=[],a={},s=!1,u=!1,c=0,l="";r<=n;r++){if("\\"===(e=t.charAt(r)))e+=t.charAt(++r);else if("]"===e)s=!1;else if(!s)switch(!0){case"["===e:s=!0;break;case"("===e:T.test(t.slice(r+1))&&(r+=2,u=!0),o+=e,c++;continue;case">"===e&&u:if(""===l||v(a,l))throw new SyntaxError("Invalid capture group name");a[l]=!0,i.push([l,c]),u=!1,l="";continue}u?l+=e:o+=e}return[o,i]}(t))[0],m=i[1]),u=a(S(t,e),h?this:x,O),(r||o||m.length)&&(c=g(u),r&&(c.dotAll=!0,c.raw=O(function(t){for(var e,n=t.length,r=0,o="",i=!1;r<=n;r++)"\\"!==(e=t.charAt(r))?i||"."!==e?("["===e?i=!0:"]"===e&&(i=!1),o+=e):o+="[\\s\\S]":o+=e+t.charAt(++r);return o}(t),n)),o&&(c.sticky=!0),m.length&&(c.groups=m)),t!==b)try{s(u,"source",""===b?"(?:)":b)}catch(E){}return u},P=function(t){t in O||u(O,t,{configurable:!0,get:function(){return S[t]},set:function(e){S[t]=e}})},R=c(S),D=0;R.length>D;)P(R[D++]);x.constructor=O,O.prototype=x,d(o,"RegExp",O)}m("RegExp")},TWQb:function(t,e,n){var r=n("/GqU"),o=n("UMSQ"),i=n("I8vh"),a=function(t){return function(e,n,a){var s,u=r(e),c=o(u.length),l=i(a,c);if(t&&n!=n){for(;c>l;)if((s=u[l++])!=s)return!0}else for(;c>l;l++)if((t||l in u)&&u[l]===n)return t||l||0;return!t&&-1}};t.exports={includes:a(!0),indexOf:a(!1)}},TZCg:function(t,e,n){"use strict";var r=n("I+eb"),o=n("DMt2").start;r({target:"String",proto:!0,forced:n("mgyK")},{padStart:function(t){return o(this,t,arguments.length>1?arguments[1]:void 0)}})},TeQF:function(t,e,n){"use strict";var r=n("I+eb"),o=n("tycR").filter;r({target:"Array",proto:!0,forced:!n("Hd5f")("filter")},{filter:function(t){return o(this,t,arguments.length>1?arguments[1]:void 0)}})},TfTi:function(t,e,n){"use strict";var r=n("A2ZE"),o=n("ewvW"),i=n("m92n"),a=n("6VoE"),s=n("UMSQ"),u=n("hBjN"),c=n("NaFW");t.exports=function(t){var e,n,l,f,p,h,d=o(t),y="function"==typeof this?this:Array,v=arguments.length,g=v>1?arguments[1]:void 0,m=void 0!==g,b=c(d),_=0;if(m&&(g=r(g,v>2?arguments[2]:void 0,2)),null==b||y==Array&&a(b))for(n=new y(e=s(d.length));e>_;_++)h=m?g(d[_],_):d[_],u(n,_,h);else for(p=(f=b.call(d)).next,n=new y;!(l=p.call(f)).done;_++)h=m?i(f,g,[l.value,_],!0):l.value,u(n,_,h);return n.length=_,n}},ToJy:function(t,e,n){"use strict";var r=n("I+eb"),o=n("HAuM"),i=n("ewvW"),a=n("UMSQ"),s=n("V37c"),u=n("0Dky"),c=n("rdv8"),l=n("pkCn"),f=n("BNF5"),p=n("2Zix"),h=n("LQDL"),d=n("USzg"),y=[],v=y.sort,g=u(function(){y.sort(void 0)}),m=u(function(){y.sort(null)}),b=l("sort"),_=!u(function(){if(h)return h<70;if(!(f&&f>3)){if(p)return!0;if(d)return d<603;var t,e,n,r,o="";for(t=65;t<76;t++){switch(e=String.fromCharCode(t),t){case 66:case 69:case 70:case 72:n=3;break;case 68:case 71:n=4;break;default:n=2}for(r=0;r<47;r++)y.push({k:e+r,v:n})}for(y.sort(function(t,e){return e.v-t.v}),r=0;r<y.length;r++)e=y[r].k.charAt(0),o.charAt(o.length-1)!==e&&(o+=e);return"DGBEFHACIJK"!==o}});r({target:"Array",proto:!0,forced:g||!m||!b||!_},{sort:function(t){void 0!==t&&o(t);var e=i(this);if(_)return void 0===t?v.call(e):v.call(e,t);var n,r,u=[],l=a(e.length);for(r=0;r<l;r++)r in e&&u.push(e[r]);for(n=(u=c(u,function(t){return function(e,n){return void 0===n?-1:void 0===e?1:void 0!==t?+t(e,n)||0:s(e)>s(n)?1:-1}}(t))).length,r=0;r<n;)e[r]=u[r++];for(;r<l;)delete e[r++];return e}})},Tskq:function(t,e,n){"use strict";var r=n("bWFh"),o=n("ZWaQ");t.exports=r("Map",function(t){return function(){return t(this,arguments.length?arguments[0]:void 0)}},o)},U3f4:function(t,e,n){var r=n("g6v/"),o=n("m/L8"),i=n("rW0t"),a=n("0Dky");r&&a(function(){return"sy"!==Object.getOwnPropertyDescriptor(RegExp.prototype,"flags").get.call({dotAll:!0,sticky:!0})})&&o.f(RegExp.prototype,"flags",{configurable:!0,get:i})},UMSQ:function(t,e,n){var r=n("ppGB"),o=Math.min;t.exports=function(t){return t>0?o(r(t),9007199254740991):0}},USzg:function(t,e,n){var r=n("NC/Y").match(/AppleWebKit\/(\d+)\./);t.exports=!!r&&+r[1]},UTVS:function(t,e,n){var r=n("ewvW"),o={}.hasOwnProperty;t.exports=Object.hasOwn||function(t,e){return o.call(r(t),e)}},UesL:function(t,e,n){"use strict";var r=n("glrk"),o=n("SFrS");t.exports=function(t){if(r(this),"string"===t||"default"===t)t="string";else if("number"!==t)throw TypeError("Incorrect hint");return o(this,t)}},UxlC:function(t,e,n){"use strict";var r=n("14Sl"),o=n("0Dky"),i=n("glrk"),a=n("ppGB"),s=n("UMSQ"),u=n("V37c"),c=n("HYAF"),l=n("iqWW"),f=n("DLK6"),p=n("FMNM"),h=n("tiKp")("replace"),d=Math.max,y=Math.min,v="$0"==="a".replace(/./,"$0"),g=!!/./[h]&&""===/./[h]("a","$0");r("replace",function(t,e,n){var r=g?"$":"$0";return[function(t,n){var r=c(this),o=null==t?void 0:t[h];return void 0!==o?o.call(t,r,n):e.call(u(r),t,n)},function(t,o){var c=i(this),h=u(t);if("string"==typeof o&&-1===o.indexOf(r)&&-1===o.indexOf("$<")){var v=n(e,c,h,o);if(v.done)return v.value}var g="function"==typeof o;g||(o=u(o));var m=c.global;if(m){var b=c.unicode;c.lastIndex=0}for(var _=[];;){var w=p(c,h);if(null===w)break;if(_.push(w),!m)break;""===u(w[0])&&(c.lastIndex=l(h,s(c.lastIndex),b))}for(var E,S="",x=0,T=0;T<_.length;T++){for(var k=u((w=_[T])[0]),A=d(y(a(w.index),h.length),0),I=[],C=1;C<w.length;C++)I.push(void 0===(E=w[C])?E:String(E));var O=w.groups;if(g){var P=[k].concat(I,A,h);void 0!==O&&P.push(O);var R=u(o.apply(void 0,P))}else R=f(k,h,A,I,O,o);A>=x&&(S+=h.slice(x,A)+R,x=A+k.length)}return S+h.slice(x)}]},!!o(function(){var t=/./;return t.exec=function(){var t=[];return t.groups={a:"7"},t},"7"!=="".replace(t,"$<a>")})||!v||g)},Uydy:function(t,e,n){var r=n("I+eb"),o=n("HsHA"),i=Math.acosh,a=Math.log,s=Math.sqrt,u=Math.LN2;r({target:"Math",stat:!0,forced:!i||710!=Math.floor(i(Number.MAX_VALUE))||i(1/0)!=1/0},{acosh:function(t){return(t=+t)<1?NaN:t>94906265.62425156?a(t)+u:o(t-1+s(t-1)*s(t+1))}})},V37c:function(t,e,n){var r=n("2bX/");t.exports=function(t){if(r(t))throw TypeError("Cannot convert a Symbol value to a string");return String(t)}},VC3L:function(t,e,n){"use strict";var r=n("I+eb"),o=n("0Dky"),i=n("QIpd"),a=1..toPrecision;r({target:"Number",proto:!0,forced:o(function(){return"1"!==a.call(1,void 0)})||!o(function(){a.call({})})},{toPrecision:function(t){return void 0===t?a.call(i(this)):a.call(i(this),t)}})},VpIT:function(t,e,n){var r=n("xDBR"),o=n("xs3f");(t.exports=function(t,e){return o[t]||(o[t]=void 0!==e?e:{})})("versions",[]).push({version:"3.16.3",mode:r?"pure":"global",copyright:"\xa9 2021 Denis Pushkarev (zloirock.ru)"})},Vu81:function(t,e,n){var r=n("0GbY"),o=n("JBy8"),i=n("dBg+"),a=n("glrk");t.exports=r("Reflect","ownKeys")||function(t){var e=o.f(a(t)),n=i.f;return n?e.concat(n(t)):e}},"W/eh":function(t,e,n){"use strict";var r=n("I+eb"),o=n("g6v/"),i=n("6x0u"),a=n("ewvW"),s=n("oEtG"),u=n("4WOD"),c=n("Bs8V").f;o&&r({target:"Object",proto:!0,forced:i},{__lookupSetter__:function(t){var e,n=a(this),r=s(t);do{if(e=c(n,r))return e.set}while(n=u(n))}})},W4Ht:function(t,e,n){"use strict";var r=n("I+eb"),o=n("HYAF"),i=n("ROdP"),a=n("V37c"),s=n("rW0t"),u=n("DLK6"),c=n("tiKp"),l=n("xDBR"),f=c("replace"),p=RegExp.prototype,h=Math.max,d=function(t,e,n){return n>t.length?-1:""===e?n:t.indexOf(e,n)};r({target:"String",proto:!0},{replaceAll:function(t,e){var n,r,c,y,v,g,m,b,_=o(this),w=0,E=0,S="";if(null!=t){if((n=i(t))&&!~a(o("flags"in p?t.flags:s.call(t))).indexOf("g"))throw TypeError("`.replaceAll` does not allow non-global regexes");if(void 0!==(r=t[f]))return r.call(t,_,e);if(l&&n)return a(_).replace(t,e)}for(c=a(_),y=a(t),(v="function"==typeof e)||(e=a(e)),m=h(1,g=y.length),w=d(c,y,0);-1!==w;)b=v?a(e(y,w,c)):u(y,c,w,[],void 0,e),S+=c.slice(E,w)+b,E=w+g,w=d(c,y,w+m);return E<c.length&&(S+=c.slice(E)),S}})},WDsR:function(t,e,n){var r=n("I+eb"),o=n("Xol8"),i=Math.abs;r({target:"Number",stat:!0},{isSafeInteger:function(t){return o(t)&&i(t)<=9007199254740991}})},WJkJ:function(t,e){t.exports="\t\n\v\f\r \xa0\u1680\u2000\u2001\u2002\u2003\u2004\u2005\u2006\u2007\u2008\u2009\u200a\u202f\u205f\u3000\u2028\u2029\ufeff"},WKiH:function(t,e,n){var r=n("HYAF"),o=n("V37c"),i="["+n("WJkJ")+"]",a=RegExp("^"+i+i+"*"),s=RegExp(i+i+"*$"),u=function(t){return function(e){var n=o(r(e));return 1&t&&(n=n.replace(a,"")),2&t&&(n=n.replace(s,"")),n}};t.exports={start:u(1),end:u(2),trim:u(3)}},WjRb:function(t,e,n){var r=n("ROdP");t.exports=function(t){if(r(t))throw TypeError("The method doesn't accept regular expressions");return t}},X5Zq:function(t,e,n){"use strict";var r=n("67WC"),o=r.aTypedArray,i=[].join;(0,r.exportTypedArrayMethod)("join",function(t){return i.apply(o(this),arguments)})},X7LM:function(t,e,n){"use strict";var r=/[^\0-\u007E]/,o=/[.\u3002\uFF0E\uFF61]/g,i="Overflow: input needs wider integers to process",a=Math.floor,s=String.fromCharCode,u=function(t){return t+22+75*(t<26)},c=function(t,e,n){var r=0;for(t=n?a(t/700):t>>1,t+=a(t/e);t>455;r+=36)t=a(t/35);return a(r+36*t/(t+38))},l=function(t){var e,n,r=[],o=(t=function(t){for(var e=[],n=0,r=t.length;n<r;){var o=t.charCodeAt(n++);if(o>=55296&&o<=56319&&n<r){var i=t.charCodeAt(n++);56320==(64512&i)?e.push(((1023&o)<<10)+(1023&i)+65536):(e.push(o),n--)}else e.push(o)}return e}(t)).length,l=128,f=0,p=72;for(e=0;e<t.length;e++)(n=t[e])<128&&r.push(s(n));var h=r.length,d=h;for(h&&r.push("-");d<o;){var y=2147483647;for(e=0;e<t.length;e++)(n=t[e])>=l&&n<y&&(y=n);var v=d+1;if(y-l>a((2147483647-f)/v))throw RangeError(i);for(f+=(y-l)*v,l=y,e=0;e<t.length;e++){if((n=t[e])<l&&++f>2147483647)throw RangeError(i);if(n==l){for(var g=f,m=36;;m+=36){var b=m<=p?1:m>=p+26?26:m-p;if(g<b)break;var _=g-b,w=36-b;r.push(s(u(b+_%w))),g=a(_/w)}r.push(s(u(g))),p=c(f,v,d==h),f=0,++d}}++f,++l}return r.join("")};t.exports=function(t){var e,n,i=[],a=t.toLowerCase().replace(o,".").split(".");for(e=0;e<a.length;e++)i.push(r.test(n=a[e])?"xn--"+l(n):n);return i.join(".")}},XGwC:function(t,e){t.exports=function(t,e){return{enumerable:!(1&t),configurable:!(2&t),writable:!(4&t),value:e}}},XMab:function(t,e,n){n("dOgj")("Uint8",function(t){return function(e,n,r){return t(this,e,n,r)}})},XUE8:function(t,e,n){var r=n("I+eb"),o=n("hh1v"),i=n("glrk"),a=n("xg1e"),s=n("Bs8V"),u=n("4WOD");r({target:"Reflect",stat:!0},{get:function t(e,n){var r,c,l=arguments.length<3?e:arguments[2];return i(e)===l?e[n]:(r=s.f(e,n))?a(r)?r.value:void 0===r.get?void 0:r.get.call(l):o(c=u(e))?t(c,n,l):void 0}})},XbcX:function(t,e,n){"use strict";var r=n("I+eb"),o=n("or9q"),i=n("ewvW"),a=n("UMSQ"),s=n("HAuM"),u=n("ZfDv");r({target:"Array",proto:!0},{flatMap:function(t){var e,n=i(this),r=a(n.length);return s(t),(e=u(n,0)).length=o(e,n,n,r,0,1,t,arguments.length>1?arguments[1]:void 0),e}})},Xe3L:function(t,e,n){"use strict";var r=n("I+eb"),o=n("0Dky"),i=n("hBjN");r({target:"Array",stat:!0,forced:o(function(){function t(){}return!(Array.of.call(t)instanceof t)})},{of:function(){for(var t=0,e=arguments.length,n=new("function"==typeof this?this:Array)(e);e>t;)i(n,t,arguments[t++]);return n.length=e,n}})},Xol8:function(t,e,n){var r=n("hh1v"),o=Math.floor;t.exports=function(t){return!r(t)&&isFinite(t)&&o(t)===t}},YF1G:function(t,e,n){var r=n("xrYK"),o=n("2oRo");t.exports="process"==r(o.process)},YGK4:function(t,e,n){"use strict";var r=n("bWFh"),o=n("ZWaQ");t.exports=r("Set",function(t){return function(){return t(this,arguments.length?arguments[0]:void 0)}},o)},YGnB:function(t,e){t.exports="object"==typeof window},YL0P:function(t,e,n){"use strict";var r=n("2oRo"),o=n("67WC"),i=n("4mDm"),a=n("tiKp")("iterator"),s=r.Uint8Array,u=i.values,c=i.keys,l=i.entries,f=o.aTypedArray,p=o.exportTypedArrayMethod,h=s&&s.prototype[a],d=!!h&&("values"==h.name||null==h.name),y=function(){return u.call(f(this))};p("entries",function(){return l.call(f(this))}),p("keys",function(){return c.call(f(this))}),p("values",y,!d),p(a,y,!d)},YNrV:function(t,e,n){"use strict";var r=n("g6v/"),o=n("0Dky"),i=n("33Wh"),a=n("dBg+"),s=n("0eef"),u=n("ewvW"),c=n("RK3t"),l=Object.assign,f=Object.defineProperty;t.exports=!l||o(function(){if(r&&1!==l({b:1},l(f({},"a",{enumerable:!0,get:function(){f(this,"b",{value:3,enumerable:!1})}}),{b:2})).b)return!0;var t={},e={},n=Symbol();return t[n]=7,"abcdefghijklmnopqrst".split("").forEach(function(t){e[t]=t}),7!=l({},t)[n]||"abcdefghijklmnopqrst"!=i(l({},e)).join("")})?function(t,e){for(var n=u(t),o=arguments.length,l=1,f=a.f,p=s.f;o>l;)for(var h,d=c(arguments[l++]),y=f?i(d).concat(f(d)):i(d),v=y.length,g=0;v>g;)h=y[g++],r&&!p.call(d,h)||(n[h]=d[h]);return n}:l},Yhre:function(t,e,n){"use strict";var r=n("2oRo"),o=n("g6v/"),i=n("qYE9"),a=n("kRJp"),s=n("4syw"),u=n("0Dky"),c=n("GarU"),l=n("ppGB"),f=n("UMSQ"),p=n("CyXQ"),h=n("d6cI"),d=n("4WOD"),y=n("0rvr"),v=n("JBy8").f,g=n("m/L8").f,m=n("gdVl"),b=n("1E5z"),_=n("afO8"),w=_.get,E=_.set,S=r.ArrayBuffer,x=S,T=r.DataView,k=T&&T.prototype,A=Object.prototype,I=r.RangeError,C=h.pack,O=h.unpack,P=function(t){return[255&t]},R=function(t){return[255&t,t>>8&255]},D=function(t){return[255&t,t>>8&255,t>>16&255,t>>24&255]},N=function(t){return t[3]<<24|t[2]<<16|t[1]<<8|t[0]},M=function(t){return C(t,23,4)},j=function(t){return C(t,52,8)},F=function(t,e){g(t.prototype,e,{get:function(){return w(this)[e]}})},L=function(t,e,n,r){var o=p(n),i=w(t);if(o+e>i.byteLength)throw I("Wrong index");var a=w(i.buffer).bytes,s=o+i.byteOffset,u=a.slice(s,s+e);return r?u:u.reverse()},B=function(t,e,n,r,o,i){var a=p(n),s=w(t);if(a+e>s.byteLength)throw I("Wrong index");for(var u=w(s.buffer).bytes,c=a+s.byteOffset,l=r(+o),f=0;f<e;f++)u[c+f]=l[i?f:e-f-1]};if(i){if(!u(function(){S(1)})||!u(function(){new S(-1)})||u(function(){return new S,new S(1.5),new S(NaN),"ArrayBuffer"!=S.name})){for(var V,H=(x=function(t){return c(this,x),new S(p(t))}).prototype=S.prototype,z=v(S),U=0;z.length>U;)(V=z[U++])in x||a(x,V,S[V]);H.constructor=x}y&&d(k)!==A&&y(k,A);var W=new T(new x(2)),Z=k.setInt8;W.setInt8(0,2147483648),W.setInt8(1,2147483649),!W.getInt8(0)&&W.getInt8(1)||s(k,{setInt8:function(t,e){Z.call(this,t,e<<24>>24)},setUint8:function(t,e){Z.call(this,t,e<<24>>24)}},{unsafe:!0})}else x=function(t){c(this,x,"ArrayBuffer");var e=p(t);E(this,{bytes:m.call(new Array(e),0),byteLength:e}),o||(this.byteLength=e)},T=function(t,e,n){c(this,T,"DataView"),c(t,x,"DataView");var r=w(t).byteLength,i=l(e);if(i<0||i>r)throw I("Wrong offset");if(i+(n=void 0===n?r-i:f(n))>r)throw I("Wrong length");E(this,{buffer:t,byteLength:n,byteOffset:i}),o||(this.buffer=t,this.byteLength=n,this.byteOffset=i)},o&&(F(x,"byteLength"),F(T,"buffer"),F(T,"byteLength"),F(T,"byteOffset")),s(T.prototype,{getInt8:function(t){return L(this,1,t)[0]<<24>>24},getUint8:function(t){return L(this,1,t)[0]},getInt16:function(t){var e=L(this,2,t,arguments.length>1?arguments[1]:void 0);return(e[1]<<8|e[0])<<16>>16},getUint16:function(t){var e=L(this,2,t,arguments.length>1?arguments[1]:void 0);return e[1]<<8|e[0]},getInt32:function(t){return N(L(this,4,t,arguments.length>1?arguments[1]:void 0))},getUint32:function(t){return N(L(this,4,t,arguments.length>1?arguments[1]:void 0))>>>0},getFloat32:function(t){return O(L(this,4,t,arguments.length>1?arguments[1]:void 0),23)},getFloat64:function(t){return O(L(this,8,t,arguments.length>1?arguments[1]:void 0),52)},setInt8:function(t,e){B(this,1,t,P,e)},setUint8:function(t,e){B(this,1,t,P,e)},setInt16:function(t,e){B(this,2,t,R,e,arguments.length>2?arguments[2]:void 0)},setUint16:function(t,e){B(this,2,t,R,e,arguments.length>2?arguments[2]:void 0)},setInt32:function(t,e){B(this,4,t,D,e,arguments.length>2?arguments[2]:void 0)},setUint32:function(t,e){B(this,4,t,D,e,arguments.length>2?arguments[2]:void 0)},setFloat32:function(t,e){B(this,4,t,M,e,arguments.length>2?arguments[2]:void 0)},setFloat64:function(t,e){B(this,8,t,j,e,arguments.length>2?arguments[2]:void 0)}});b(x,"ArrayBuffer"),b(T,"DataView"),t.exports={ArrayBuffer:x,DataView:T}},ZJ55:function(t,e,n){"use strict";var r=n("67WC"),o=n("tycR").some,i=r.aTypedArray;(0,r.exportTypedArrayMethod)("some",function(t){return o(i(this),t,arguments.length>1?arguments[1]:void 0)})},ZOXb:function(t,e,n){"use strict";var r=n("0Dky"),o=n("DMt2").start,i=Math.abs,a=Date.prototype,s=a.getTime,u=a.toISOString;t.exports=r(function(){return"0385-07-25T07:06:39.999Z"!=u.call(new Date(-5e13-1))})||!r(function(){u.call(new Date(NaN))})?function(){if(!isFinite(s.call(this)))throw RangeError("Invalid time value");var t=this.getUTCFullYear(),e=this.getUTCMilliseconds(),n=t<0?"-":t>9999?"+":"";return n+o(i(t),n?6:4,0)+"-"+o(this.getUTCMonth()+1,2,0)+"-"+o(this.getUTCDate(),2,0)+"T"+o(this.getUTCHours(),2,0)+":"+o(this.getUTCMinutes(),2,0)+":"+o(this.getUTCSeconds(),2,0)+"."+o(e,3,0)+"Z"}:u},ZUd8:function(t,e,n){var r=n("ppGB"),o=n("V37c"),i=n("HYAF"),a=function(t){return function(e,n){var a,s,u=o(i(e)),c=r(n),l=u.length;return c<0||c>=l?t?"":void 0:(a=u.charCodeAt(c))<55296||a>56319||c+1===l||(s=u.charCodeAt(c+1))<56320||s>57343?t?u.charAt(c):a:t?u.slice(c,c+2):s-56320+(a-55296<<10)+65536}};t.exports={codeAt:a(!1),charAt:a(!0)}},ZWaQ:function(t,e,n){"use strict";var r=n("m/L8").f,o=n("fHMY"),i=n("4syw"),a=n("A2ZE"),s=n("GarU"),u=n("ImZN"),c=n("fdAy"),l=n("JiZb"),f=n("g6v/"),p=n("8YOa").fastKey,h=n("afO8"),d=h.set,y=h.getterFor;t.exports={getConstructor:function(t,e,n,c){var l=t(function(t,r){s(t,l,e),d(t,{type:e,index:o(null),first:void 0,last:void 0,size:0}),f||(t.size=0),null!=r&&u(r,t[c],{that:t,AS_ENTRIES:n})}),h=y(e),v=function(t,e,n){var r,o,i=h(t),a=g(t,e);return a?a.value=n:(i.last=a={index:o=p(e,!0),key:e,value:n,previous:r=i.last,next:void 0,removed:!1},i.first||(i.first=a),r&&(r.next=a),f?i.size++:t.size++,"F"!==o&&(i.index[o]=a)),t},g=function(t,e){var n,r=h(t),o=p(e);if("F"!==o)return r.index[o];for(n=r.first;n;n=n.next)if(n.key==e)return n};return i(l.prototype,{clear:function(){for(var t=h(this),e=t.index,n=t.first;n;)n.removed=!0,n.previous&&(n.previous=n.previous.next=void 0),delete e[n.index],n=n.next;t.first=t.last=void 0,f?t.size=0:this.size=0},delete:function(t){var e=h(this),n=g(this,t);if(n){var r=n.next,o=n.previous;delete e.index[n.index],n.removed=!0,o&&(o.next=r),r&&(r.previous=o),e.first==n&&(e.first=r),e.last==n&&(e.last=o),f?e.size--:this.size--}return!!n},forEach:function(t){for(var e,n=h(this),r=a(t,arguments.length>1?arguments[1]:void 0,3);e=e?e.next:n.first;)for(r(e.value,e.key,this);e&&e.removed;)e=e.previous},has:function(t){return!!g(this,t)}}),i(l.prototype,n?{get:function(t){var e=g(this,t);return e&&e.value},set:function(t,e){return v(this,0===t?0:t,e)}}:{add:function(t){return v(this,t=0===t?0:t,t)}}),f&&r(l.prototype,"size",{get:function(){return h(this).size}}),l},setStrong:function(t,e,n){var r=e+" Iterator",o=y(e),i=y(r);c(t,e,function(t,e){d(this,{type:r,target:t,state:o(t),kind:e,last:void 0})},function(){for(var t=i(this),e=t.kind,n=t.last;n&&n.removed;)n=n.previous;return t.target&&(t.last=n=n?n.next:t.state.first)?"keys"==e?{value:n.key,done:!1}:"values"==e?{value:n.value,done:!1}:{value:[n.key,n.value],done:!1}:(t.target=void 0,{value:void 0,done:!0})},n?"entries":"values",!n,!0),l(e)}}},ZfDv:function(t,e,n){var r=n("C0Ia");t.exports=function(t,e){return new(r(t))(0===e?0:e)}},Zk8X:function(t,e,n){"use strict";var r=n("I+eb"),o=n("hXpO");r({target:"String",proto:!0,forced:n("rwPt")("sup")},{sup:function(){return o(this,"sup","","")}})},a57n:function(t,e,n){n("dG/n")("search")},a5NK:function(t,e,n){var r=n("I+eb"),o=Math.log,i=Math.LOG10E;r({target:"Math",stat:!0},{log10:function(t){return o(t)*i}})},afO8:function(t,e,n){var r,o,i,a=n("f5p1"),s=n("2oRo"),u=n("hh1v"),c=n("kRJp"),l=n("UTVS"),f=n("xs3f"),p=n("93I0"),h=n("0BK2");if(a||f.state){var d=f.state||(f.state=new(0,s.WeakMap)),y=d.get,v=d.has,g=d.set;r=function(t,e){if(v.call(d,t))throw new TypeError("Object already initialized");return e.facade=t,g.call(d,t,e),e},o=function(t){return y.call(d,t)||{}},i=function(t){return v.call(d,t)}}else{var m=p("state");h[m]=!0,r=function(t,e){if(l(t,m))throw new TypeError("Object already initialized");return e.facade=t,c(t,m,e),e},o=function(t){return l(t,m)?t[m]:{}},i=function(t){return l(t,m)}}t.exports={set:r,get:o,has:i,enforce:function(t){return i(t)?o(t):r(t,{})},getterFor:function(t){return function(e){var n;if(!u(e)||(n=o(e)).type!==t)throw TypeError("Incompatible receiver, "+t+" required");return n}}}},b1O7:function(t,e,n){var r=n("g6v/"),o=n("33Wh"),i=n("/GqU"),a=n("0eef").f,s=function(t){return function(e){for(var n,s=i(e),u=o(s),c=u.length,l=0,f=[];c>l;)n=u[l++],r&&!a.call(s,n)||f.push(t?[n,s[n]]:s[n]);return f}};t.exports={entries:s(!0),values:s(!1)}},bFeb:function(t,e,n){n("I+eb")({global:!0},{globalThis:n("2oRo")})},bWFh:function(t,e,n){"use strict";var r=n("I+eb"),o=n("2oRo"),i=n("lMq5"),a=n("busE"),s=n("8YOa"),u=n("ImZN"),c=n("GarU"),l=n("hh1v"),f=n("0Dky"),p=n("HH4o"),h=n("1E5z"),d=n("cVYH");t.exports=function(t,e,n){var y=-1!==t.indexOf("Map"),v=-1!==t.indexOf("Weak"),g=y?"set":"add",m=o[t],b=m&&m.prototype,_=m,w={},E=function(t){var e=b[t];a(b,t,"add"==t?function(t){return e.call(this,0===t?0:t),this}:"delete"==t?function(t){return!(v&&!l(t))&&e.call(this,0===t?0:t)}:"get"==t?function(t){return v&&!l(t)?void 0:e.call(this,0===t?0:t)}:"has"==t?function(t){return!(v&&!l(t))&&e.call(this,0===t?0:t)}:function(t,n){return e.call(this,0===t?0:t,n),this})};if(i(t,"function"!=typeof m||!(v||b.forEach&&!f(function(){(new m).entries().next()}))))_=n.getConstructor(e,t,y,g),s.enable();else if(i(t,!0)){var S=new _,x=S[g](v?{}:-0,1)!=S,T=f(function(){S.has(1)}),k=p(function(t){new m(t)}),A=!v&&f(function(){for(var t=new m,e=5;e--;)t[g](e,e);return!t.has(-0)});k||((_=e(function(e,n){c(e,_,t);var r=d(new m,e,_);return null!=n&&u(n,r[g],{that:r,AS_ENTRIES:y}),r})).prototype=b,b.constructor=_),(T||A)&&(E("delete"),E("has"),y&&E("get")),(A||x)&&E(g),v&&b.clear&&delete b.clear}return w[t]=_,r({global:!0,forced:_!=m},w),h(_,t),v||n.setStrong(_,t,y),_}},brp2:function(t,e,n){n("I+eb")({target:"Date",stat:!0},{now:function(){return(new Date).getTime()}})},busE:function(t,e,n){var r=n("2oRo"),o=n("kRJp"),i=n("UTVS"),a=n("zk60"),s=n("iSVu"),u=n("afO8"),c=u.get,l=u.enforce,f=String(String).split("String");(t.exports=function(t,e,n,s){var u,c=!!s&&!!s.unsafe,p=!!s&&!!s.enumerable,h=!!s&&!!s.noTargetGet;"function"==typeof n&&("string"!=typeof e||i(n,"name")||o(n,"name",e),(u=l(n)).source||(u.source=f.join("string"==typeof e?e:""))),t!==r?(c?!h&&t[e]&&(p=!0):delete t[e],p?t[e]=n:o(t,e,n)):p?t[e]=n:a(e,n)})(Function.prototype,"toString",function(){return"function"==typeof this&&c(this).source||s(this)})},c162:function(t,e,n){"use strict";var r=n("67WC"),o=n("gdVl"),i=r.aTypedArray;(0,r.exportTypedArrayMethod)("fill",function(t){return o.apply(i(this),arguments)})},c9m3:function(t,e,n){n("RNIs")("flatMap")},cDke:function(t,e,n){var r=n("I+eb"),o=n("0Dky"),i=n("BX/b").f;r({target:"Object",stat:!0,forced:o(function(){return!Object.getOwnPropertyNames(1)})},{getOwnPropertyNames:i})},cVYH:function(t,e,n){var r=n("hh1v"),o=n("0rvr");t.exports=function(t,e,n){var i,a;return o&&"function"==typeof(i=e.constructor)&&i!==n&&r(a=i.prototype)&&a!==n.prototype&&o(t,a),t}},cvf0:function(t,e,n){"use strict";var r=n("67WC").exportTypedArrayMethod,o=n("0Dky"),i=n("2oRo").Uint8Array,a=i&&i.prototype||{},s=[].toString,u=[].join;o(function(){s.call({})})&&(s=function(){return u.call(this)}),r("toString",s,a.toString!=s)},d6cI:function(t,e){var n=Math.abs,r=Math.pow,o=Math.floor,i=Math.log,a=Math.LN2;t.exports={pack:function(t,e,s){var u,c,l,f=new Array(s),p=8*s-e-1,h=(1<<p)-1,d=h>>1,y=23===e?r(2,-24)-r(2,-77):0,v=t<0||0===t&&1/t<0?1:0,g=0;for((t=n(t))!=t||t===1/0?(c=t!=t?1:0,u=h):(u=o(i(t)/a),t*(l=r(2,-u))<1&&(u--,l*=2),(t+=u+d>=1?y/l:y*r(2,1-d))*l>=2&&(u++,l/=2),u+d>=h?(c=0,u=h):u+d>=1?(c=(t*l-1)*r(2,e),u+=d):(c=t*r(2,d-1)*r(2,e),u=0));e>=8;f[g++]=255&c,c/=256,e-=8);for(u=u<<e|c,p+=e;p>0;f[g++]=255&u,u/=256,p-=8);return f[--g]|=128*v,f},unpack:function(t,e){var n,o=t.length,i=8*o-e-1,a=(1<<i)-1,s=a>>1,u=i-7,c=o-1,l=t[c--],f=127&l;for(l>>=7;u>0;f=256*f+t[c],c--,u-=8);for(n=f&(1<<-u)-1,f>>=-u,u+=e;u>0;n=256*n+t[c],c--,u-=8);if(0===f)f=1-s;else{if(f===a)return n?NaN:l?-1/0:1/0;n+=r(2,e),f-=s}return(l?-1:1)*n*r(2,f-e)}}},"dBg+":function(t,e){e.f=Object.getOwnPropertySymbols},"dG/n":function(t,e,n){var r=n("Qo9l"),o=n("UTVS"),i=n("5Tg+"),a=n("m/L8").f;t.exports=function(t){var e=r.Symbol||(r.Symbol={});o(e,t)||a(e,t,{value:i.f(t)})}},dOgj:function(t,e,n){"use strict";var r=n("I+eb"),o=n("2oRo"),i=n("g6v/"),a=n("iqeF"),s=n("67WC"),u=n("Yhre"),c=n("GarU"),l=n("XGwC"),f=n("kRJp"),p=n("Xol8"),h=n("UMSQ"),d=n("CyXQ"),y=n("GC2F"),v=n("oEtG"),g=n("UTVS"),m=n("9d/t"),b=n("hh1v"),_=n("2bX/"),w=n("fHMY"),E=n("0rvr"),S=n("JBy8").f,x=n("oHi+"),T=n("tycR").forEach,k=n("JiZb"),A=n("m/L8"),I=n("Bs8V"),C=n("afO8"),O=n("cVYH"),P=C.get,R=C.set,D=A.f,N=I.f,M=Math.round,j=o.RangeError,F=u.ArrayBuffer,L=u.DataView,B=s.NATIVE_ARRAY_BUFFER_VIEWS,V=s.TYPED_ARRAY_CONSTRUCTOR,H=s.TYPED_ARRAY_TAG,z=s.TypedArray,U=s.TypedArrayPrototype,W=s.aTypedArrayConstructor,Z=s.isTypedArray,q=function(t,e){for(var n=0,r=e.length,o=new(W(t))(r);r>n;)o[n]=e[n++];return o},G=function(t,e){D(t,e,{get:function(){return P(this)[e]}})},K=function(t){var e;return t instanceof F||"ArrayBuffer"==(e=m(t))||"SharedArrayBuffer"==e},Q=function(t,e){return Z(t)&&!_(e)&&e in t&&p(+e)&&e>=0},Y=function(t,e){return e=v(e),Q(t,e)?l(2,t[e]):N(t,e)},X=function(t,e,n){return e=v(e),!(Q(t,e)&&b(n)&&g(n,"value"))||g(n,"get")||g(n,"set")||n.configurable||g(n,"writable")&&!n.writable||g(n,"enumerable")&&!n.enumerable?D(t,e,n):(t[e]=n.value,t)};i?(B||(I.f=Y,A.f=X,G(U,"buffer"),G(U,"byteOffset"),G(U,"byteLength"),G(U,"length")),r({target:"Object",stat:!0,forced:!B},{getOwnPropertyDescriptor:Y,defineProperty:X}),t.exports=function(t,e,n){var i=t.match(/\d+$/)[0]/8,s=t+(n?"Clamped":"")+"Array",u="get"+t,l="set"+t,p=o[s],v=p,g=v&&v.prototype,m={},_=function(t,e){D(t,e,{get:function(){return function(t,e){var n=P(t);return n.view[u](e*i+n.byteOffset,!0)}(this,e)},set:function(t){return function(t,e,r){var o=P(t);n&&(r=(r=M(r))<0?0:r>255?255:255&r),o.view[l](e*i+o.byteOffset,r,!0)}(this,e,t)},enumerable:!0})};B?a&&(v=e(function(t,e,n,r){return c(t,v,s),O(b(e)?K(e)?void 0!==r?new p(e,y(n,i),r):void 0!==n?new p(e,y(n,i)):new p(e):Z(e)?q(v,e):x.call(v,e):new p(d(e)),t,v)}),E&&E(v,z),T(S(p),function(t){t in v||f(v,t,p[t])}),v.prototype=g):(v=e(function(t,e,n,r){c(t,v,s);var o,a,u,l=0,f=0;if(b(e)){if(!K(e))return Z(e)?q(v,e):x.call(v,e);o=e,f=y(n,i);var p=e.byteLength;if(void 0===r){if(p%i)throw j("Wrong length");if((a=p-f)<0)throw j("Wrong length")}else if((a=h(r)*i)+f>p)throw j("Wrong length");u=a/i}else u=d(e),o=new F(a=u*i);for(R(t,{buffer:o,byteOffset:f,byteLength:a,length:u,view:new L(o)});l<u;)_(t,l++)}),E&&E(v,z),g=v.prototype=w(U)),g.constructor!==v&&f(g,"constructor",v),f(g,V,v),H&&f(g,H,s),m[s]=v,r({global:!0,forced:v!=p,sham:!B},m),"BYTES_PER_ELEMENT"in v||f(v,"BYTES_PER_ELEMENT",i),"BYTES_PER_ELEMENT"in g||f(g,"BYTES_PER_ELEMENT",i),k(s)}):t.exports=function(){}},"eDl+":function(t,e){t.exports=["constructor","hasOwnProperty","isPrototypeOf","propertyIsEnumerable","toLocaleString","toString","valueOf"]},eJiR:function(t,e,n){var r=n("I+eb"),o=n("jrUv"),i=Math.exp;r({target:"Math",stat:!0},{tanh:function(t){var e=o(t=+t),n=o(-t);return e==1/0?1:n==1/0?-1:(e-n)/(i(t)+i(-t))}})},eajv:function(t,e,n){var r=n("I+eb"),o=Math.asinh,i=Math.log,a=Math.sqrt;r({target:"Math",stat:!0,forced:!(o&&1/o(0)>0)},{asinh:function t(e){return isFinite(e=+e)&&0!=e?e<0?-t(-e):i(e+a(e*e+1)):e}})},eoL8:function(t,e,n){var r=n("I+eb"),o=n("g6v/");r({target:"Object",stat:!0,forced:!o,sham:!o},{defineProperty:n("m/L8").f})},ewvW:function(t,e,n){var r=n("HYAF");t.exports=function(t){return Object(r(t))}},f3jH:function(t,e,n){var r=n("I+eb"),o=n("glrk"),i=n("4WOD");r({target:"Reflect",stat:!0,sham:!n("4Xet")},{getPrototypeOf:function(t){return i(o(t))}})},f5p1:function(t,e,n){var r=n("2oRo"),o=n("iSVu"),i=r.WeakMap;t.exports="function"==typeof i&&/native code/.test(o(i))},fHMY:function(t,e,n){var r,o=n("glrk"),i=n("N+g0"),a=n("eDl+"),s=n("0BK2"),u=n("G+Rx"),c=n("zBJ4"),l=n("93I0")("IE_PROTO"),f=function(){},p=function(t){return"<script>"+t+"<\/script>"},h=function(t){t.write(p("")),t.close();var e=t.parentWindow.Object;return t=null,e},d=function(){try{r=new ActiveXObject("htmlfile")}catch(o){}var t,e;d="undefined"!=typeof document?document.domain&&r?h(r):((e=c("iframe")).style.display="none",u.appendChild(e),e.src=String("javascript:"),(t=e.contentWindow.document).open(),t.write(p("document.F=Object")),t.close(),t.F):h(r);for(var n=a.length;n--;)delete d.prototype[a[n]];return d()};s[l]=!0,t.exports=Object.create||function(t,e){var n;return null!==t?(f.prototype=o(t),n=new f,f.prototype=null,n[l]=t):n=d(),void 0===e?n:i(n,e)}},fbCW:function(t,e,n){"use strict";var r=n("I+eb"),o=n("tycR").find,i=n("RNIs"),a=!0;"find"in[]&&Array(1).find(function(){a=!1}),r({target:"Array",proto:!0,forced:a},{find:function(t){return o(this,t,arguments.length>1?arguments[1]:void 0)}}),i("find")},fdAy:function(t,e,n){"use strict";var r=n("I+eb"),o=n("ntOU"),i=n("4WOD"),a=n("0rvr"),s=n("1E5z"),u=n("kRJp"),c=n("busE"),l=n("tiKp"),f=n("xDBR"),p=n("P4y1"),h=n("rpNk"),d=h.IteratorPrototype,y=h.BUGGY_SAFARI_ITERATORS,v=l("iterator"),g=function(){return this};t.exports=function(t,e,n,l,h,m,b){o(n,e,l);var _,w,E,S=function(t){if(t===h&&I)return I;if(!y&&t in k)return k[t];switch(t){case"keys":case"values":case"entries":return function(){return new n(this,t)}}return function(){return new n(this)}},x=e+" Iterator",T=!1,k=t.prototype,A=k[v]||k["@@iterator"]||h&&k[h],I=!y&&A||S(h),C="Array"==e&&k.entries||A;if(C&&(_=i(C.call(new t)),d!==Object.prototype&&_.next&&(f||i(_)===d||(a?a(_,d):"function"!=typeof _[v]&&u(_,v,g)),s(_,x,!0,!0),f&&(p[x]=g))),"values"==h&&A&&"values"!==A.name&&(T=!0,I=function(){return A.call(this)}),f&&!b||k[v]===I||u(k,v,I),p[e]=I,h)if(w={values:S("values"),keys:m?I:S("keys"),entries:S("entries")},b)for(E in w)!y&&!T&&E in k||c(k,E,w[E]);else r({target:e,proto:!0,forced:y||T},w);return w}},fhKU:function(t,e,n){var r=n("2oRo"),o=n("V37c"),i=n("WKiH").trim,a=n("WJkJ"),s=r.parseFloat,u=1/s(a+"-0")!=-1/0;t.exports=u?function(t){var e=i(o(t)),n=s(e);return 0===n&&"-"==e.charAt(0)?-0:n}:s},ftMj:function(t,e,n){var r=n("I+eb"),o=n("glrk"),i=n("hh1v"),a=n("xg1e"),s=n("0Dky"),u=n("m/L8"),c=n("Bs8V"),l=n("4WOD"),f=n("XGwC");r({target:"Reflect",stat:!0,forced:s(function(){var t=function(){},e=u.f(new t,"a",{configurable:!0});return!1!==Reflect.set(t.prototype,"a",1,e)})},{set:function t(e,n,r){var s,p,h,d=arguments.length<4?e:arguments[3],y=c.f(o(e),n);if(!y){if(i(p=l(e)))return t(p,n,r,d);y=f(0)}if(a(y)){if(!1===y.writable||!i(d))return!1;if(s=c.f(d,n)){if(s.get||s.set||!1===s.writable)return!1;s.value=r,u.f(d,n,s)}else u.f(d,n,f(0,r))}else{if(void 0===(h=y.set))return!1;h.call(d,r)}return!0}})},"g6v/":function(t,e,n){var r=n("0Dky");t.exports=!r(function(){return 7!=Object.defineProperty({},1,{get:function(){return 7}})[1]})},gOCb:function(t,e,n){n("dG/n")("replace")},gXIK:function(t,e,n){n("dG/n")("toPrimitive")},gbiT:function(t,e,n){n("dG/n")("unscopables")},gdVl:function(t,e,n){"use strict";var r=n("ewvW"),o=n("I8vh"),i=n("UMSQ");t.exports=function(t){for(var e=r(this),n=i(e.length),a=arguments.length,s=o(a>1?arguments[1]:void 0,n),u=a>2?arguments[2]:void 0,c=void 0===u?n:o(u,n);c>s;)e[s++]=t;return e}},gg6r:function(t,e,n){"use strict";var r=n("I+eb"),o=n("HAuM"),i=n("8GlL"),a=n("5mdu"),s=n("ImZN");r({target:"Promise",stat:!0},{allSettled:function(t){var e=this,n=i.f(e),r=n.resolve,u=n.reject,c=a(function(){var n=o(e.resolve),i=[],a=0,u=1;s(t,function(t){var o=a++,s=!1;i.push(void 0),u++,n.call(e,t).then(function(t){s||(s=!0,i[o]={status:"fulfilled",value:t},--u||r(i))},function(t){s||(s=!0,i[o]={status:"rejected",reason:t},--u||r(i))})}),--u||r(i)});return c.error&&u(c.value),n.promise}})},glrk:function(t,e,n){var r=n("hh1v");t.exports=function(t){if(!r(t))throw TypeError(String(t)+" is not an object");return t}},gtqK:function(t,e,n){var r=n("I+eb"),o=n("67WC");r({target:"ArrayBuffer",stat:!0,forced:!o.NATIVE_ARRAY_BUFFER_VIEWS},{isView:o.isView})},gvgV:function(t,e,n){"use strict";var r=n("67WC"),o=n("TWQb").includes,i=r.aTypedArray;(0,r.exportTypedArrayMethod)("includes",function(t){return o(i(this),t,arguments.length>1?arguments[1]:void 0)})},hBjN:function(t,e,n){"use strict";var r=n("oEtG"),o=n("m/L8"),i=n("XGwC");t.exports=function(t,e,n){var a=r(e);a in t?o.f(t,a,i(0,n)):t[a]=n}},hByQ:function(t,e,n){"use strict";var r=n("14Sl"),o=n("glrk"),i=n("HYAF"),a=n("Ep9I"),s=n("V37c"),u=n("FMNM");r("search",function(t,e,n){return[function(e){var n=i(this),r=null==e?void 0:e[t];return void 0!==r?r.call(e,n):new RegExp(e)[t](s(n))},function(t){var r=o(this),i=s(t),c=n(e,r,i);if(c.done)return c.value;var l=r.lastIndex;a(l,0)||(r.lastIndex=0);var f=u(r,i);return a(r.lastIndex,l)||(r.lastIndex=l),null===f?-1:f.index}]})},hDyC:function(t,e,n){"use strict";var r=n("I+eb"),o=n("DMt2").end;r({target:"String",proto:!0,forced:n("mgyK")},{padEnd:function(t){return o(this,t,arguments.length>1?arguments[1]:void 0)}})},hMMk:function(t,e,n){n("dOgj")("Uint16",function(t){return function(e,n,r){return t(this,e,n,r)}})},hXpO:function(t,e,n){var r=n("HYAF"),o=n("V37c"),i=/"/g;t.exports=function(t,e,n,a){var s=o(r(t)),u="<"+e;return""!==n&&(u+=" "+n+'="'+o(a).replace(i,"&quot;")+'"'),u+">"+s+"</"+e+">"}},hgfv:function(t,e,n){const r=n("4wMS"),o=n("Cwz8"),i=n("jyPT"),a=n("HL5w"),s=n("DSw1");t.exports={Log:r,Browser:o,Events:i,Init:a,Store:s}},hh1v:function(t,e){t.exports=function(t){return"object"==typeof t?null!==t:"function"==typeof t}},i5pp:function(t,e,n){var r=n("I+eb"),o=n("glrk"),i=n("O741"),a=n("0rvr");a&&r({target:"Reflect",stat:!0},{setPrototypeOf:function(t,e){o(t),i(e);try{return a(t,e),!0}catch(n){return!1}}})},i6QF:function(t,e,n){n("I+eb")({target:"Number",stat:!0},{isInteger:n("Xol8")})},iSVu:function(t,e,n){var r=n("xs3f"),o=Function.toString;"function"!=typeof r.inspectSource&&(r.inspectSource=function(t){return o.call(t)}),t.exports=r.inspectSource},ilnZ:function(t,e,n){n("dOgj")("Uint8",function(t){return function(e,n,r){return t(this,e,n,r)}},!0)},inlA:function(t,e,n){"use strict";var r,o=n("I+eb"),i=n("Bs8V").f,a=n("UMSQ"),s=n("V37c"),u=n("WjRb"),c=n("HYAF"),l=n("qxPZ"),f=n("xDBR"),p="".endsWith,h=Math.min,d=l("endsWith");o({target:"String",proto:!0,forced:!(!f&&!d&&(r=i(String.prototype,"endsWith"),r&&!r.writable)||d)},{endsWith:function(t){var e=s(c(this));u(t);var n=arguments.length>1?arguments[1]:void 0,r=a(e.length),o=void 0===n?r:h(a(n),r),i=s(t);return p?p.call(e,i,o):e.slice(o-i.length,o)===i}})},iqWW:function(t,e,n){"use strict";var r=n("ZUd8").charAt;t.exports=function(t,e,n){return e+(n?r(t,e).length:1)}},iqeF:function(t,e,n){var r=n("2oRo"),o=n("0Dky"),i=n("HH4o"),a=n("67WC").NATIVE_ARRAY_BUFFER_VIEWS,s=r.ArrayBuffer,u=r.Int8Array;t.exports=!a||!o(function(){u(1)})||!o(function(){new u(-1)})||!i(function(t){new u,new u(null),new u(1.5),new u(t)},!0)||o(function(){return 1!==new u(new s(2),1,void 0).length})},iwkZ:function(t,e,n){n("dOgj")("Int16",function(t){return function(e,n,r){return t(this,e,n,r)}})},jrUv:function(t,e){var n=Math.expm1,r=Math.exp;t.exports=!n||n(10)>22025.465794806718||n(10)<22025.465794806718||-2e-17!=n(-2e-17)?function(t){return 0==(t=+t)?t:t>-1e-6&&t<1e-6?t+t*t/2:r(t)-1}:n},jt2F:function(t,e,n){n("dG/n")("matchAll")},jyPT:function(t,e){function n(t,e,n){window.wails.Events.OnMultiple(t,e,n)}t.exports={OnMultiple:n,On:function(t,e){n(t,e)},Once:function(t,e){n(t,e,1)},Emit:function(t){var e=[t].slice.call(arguments);return window.wails.Events.Emit.apply(null,e)},Heartbeat:function(t,e,n){window.wails.Events.Heartbeat(t,e,n)},Acknowledge:function(t){return window.wails.Events.Acknowledge(t)}}},kNcU:function(t,e,n){var r=n("I+eb"),o=Math.log,i=Math.LN2;r({target:"Math",stat:!0},{log2:function(t){return o(t)/i}})},kOOl:function(t,e){var n=0,r=Math.random();t.exports=function(t){return"Symbol("+String(void 0===t?"":t)+")_"+(++n+r).toString(36)}},kRJp:function(t,e,n){var r=n("g6v/"),o=n("m/L8"),i=n("XGwC");t.exports=r?function(t,e,n){return o.f(t,e,i(1,n))}:function(t,e,n){return t[e]=n,t}},kSko:function(t,e,n){n("I+eb")({target:"Number",stat:!0},{isNaN:function(t){return t!=t}})},klfK:function(t,e,n){"use strict";var r=n("I+eb"),o=n("V37c"),i=String.fromCharCode,a=/^[\da-f]{2}$/i,s=/^[\da-f]{4}$/i;r({global:!0},{unescape:function(t){for(var e,n,r=o(t),u="",c=r.length,l=0;l<c;){if("%"===(e=r.charAt(l++)))if("u"===r.charAt(l)){if(n=r.slice(l+1,l+5),s.test(n)){u+=i(parseInt(n,16)),l+=5;continue}}else if(n=r.slice(l,l+2),a.test(n)){u+=i(parseInt(n,16)),l+=2;continue}u+=e}return u}})},kmMV:function(t,e,n){"use strict";var r,o,i=n("V37c"),a=n("rW0t"),s=n("n3/R"),u=n("VpIT"),c=n("fHMY"),l=n("afO8").get,f=n("/OPJ"),p=n("EHx7"),h=RegExp.prototype.exec,d=u("native-string-replace",String.prototype.replace),y=h,v=(o=/b*/g,h.call(r=/a/,"a"),h.call(o,"a"),0!==r.lastIndex||0!==o.lastIndex),g=s.UNSUPPORTED_Y||s.BROKEN_CARET,m=void 0!==/()??/.exec("")[1];(v||m||g||f||p)&&(y=function(t){var e,n,r,o,s,u,f,p=this,b=l(p),_=i(t),w=b.raw;if(w)return w.lastIndex=p.lastIndex,e=y.call(w,_),p.lastIndex=w.lastIndex,e;var E=b.groups,S=g&&p.sticky,x=a.call(p),T=p.source,k=0,A=_;if(S&&(-1===(x=x.replace("y","")).indexOf("g")&&(x+="g"),A=_.slice(p.lastIndex),p.lastIndex>0&&(!p.multiline||p.multiline&&"\n"!==_.charAt(p.lastIndex-1))&&(T="(?: "+T+")",A=" "+A,k++),n=new RegExp("^(?:"+T+")",x)),m&&(n=new RegExp("^"+T+"$(?!\\s)",x)),v&&(r=p.lastIndex),o=h.call(S?n:p,A),S?o?(o.input=o.input.slice(k),o[0]=o[0].slice(k),o.index=p.lastIndex,p.lastIndex+=o[0].length):p.lastIndex=0:v&&o&&(p.lastIndex=p.global?o.index+o[0].length:r),m&&o&&o.length>1&&d.call(o[0],n,function(){for(s=1;s<arguments.length-2;s++)void 0===arguments[s]&&(o[s]=void 0)}),o&&E)for(o.groups=u=c(null),s=0;s<E.length;s++)u[(f=E[s])[0]]=o[f[1]];return o}),t.exports=y},kyGr:function(t,e,n){"use strict";var r=n("I+eb"),o=n("ppGB"),i=Date.prototype.getTime,a=Date.prototype.setFullYear;r({target:"Date",proto:!0},{setYear:function(t){i.call(this);var e=o(t);return a.call(this,0<=e&&e<=99?e+1900:e)}})},l2dK:function(t,e,n){"use strict";var r=n("I+eb"),o=n("hXpO");r({target:"String",proto:!0,forced:n("rwPt")("fontcolor")},{fontcolor:function(t){return o(this,"font","color",t)}})},lEou:function(t,e,n){n("dG/n")("toStringTag")},lMq5:function(t,e,n){var r=n("0Dky"),o=/#|\.prototype\./,i=function(t,e){var n=s[a(t)];return n==c||n!=u&&("function"==typeof e?r(e):!!e)},a=i.normalize=function(t){return String(t).replace(o,".").toLowerCase()},s=i.data={},u=i.NATIVE="N",c=i.POLYFILL="P";t.exports=i},lnpS:function(t,e,n){"use strict";var r=n("I+eb"),o=n("4WOD"),i=n("0rvr"),a=n("fHMY"),s=n("kRJp"),u=n("XGwC"),c=n("ImZN"),l=n("V37c"),f=function(t,e){var n=this;if(!(n instanceof f))return new f(t,e);i&&(n=i(new Error(void 0),o(n))),void 0!==e&&s(n,"message",l(e));var r=[];return c(t,r.push,{that:r}),s(n,"errors",r),n};f.prototype=a(Error.prototype,{constructor:u(5,f),message:u(5,""),name:u(5,"AggregateError")}),r({global:!0},{AggregateError:f})},"m/L8":function(t,e,n){var r=n("g6v/"),o=n("DPsx"),i=n("glrk"),a=n("oEtG"),s=Object.defineProperty;e.f=r?s:function(t,e,n){if(i(t),e=a(e),i(n),o)try{return s(t,e,n)}catch(r){}if("get"in n||"set"in n)throw TypeError("Accessors not supported");return"value"in n&&(t[e]=n.value),t}},m92n:function(t,e,n){var r=n("glrk"),o=n("KmKo");t.exports=function(t,e,n,i){try{return i?e(r(n)[0],n[1]):e(n)}catch(a){throw o(t),a}}},mGGf:function(t,e,n){"use strict";n("4mDm");var r=n("I+eb"),o=n("0GbY"),i=n("DTth"),a=n("busE"),s=n("4syw"),u=n("1E5z"),c=n("ntOU"),l=n("afO8"),f=n("GarU"),p=n("UTVS"),h=n("A2ZE"),d=n("9d/t"),y=n("glrk"),v=n("hh1v"),g=n("V37c"),m=n("fHMY"),b=n("XGwC"),_=n("mh/w"),w=n("NaFW"),E=n("tiKp"),S=o("fetch"),x=o("Request"),T=x&&x.prototype,k=o("Headers"),A=E("iterator"),I=l.set,C=l.getterFor("URLSearchParams"),O=l.getterFor("URLSearchParamsIterator"),P=/\+/g,R=Array(4),D=function(t){return R[t-1]||(R[t-1]=RegExp("((?:%[\\da-f]{2}){"+t+"})","gi"))},N=function(t){try{return decodeURIComponent(t)}catch(e){return t}},M=function(t){var e=t.replace(P," "),n=4;try{return decodeURIComponent(e)}catch(r){for(;n;)e=e.replace(D(n--),N);return e}},j=/[!'()~]|%20/g,F={"!":"%21","'":"%27","(":"%28",")":"%29","~":"%7E","%20":"+"},L=function(t){return F[t]},B=function(t){return encodeURIComponent(t).replace(j,L)},V=function(t,e){if(e)for(var n,r,o=e.split("&"),i=0;i<o.length;)(n=o[i++]).length&&(r=n.split("="),t.push({key:M(r.shift()),value:M(r.join("="))}))},H=function(t){this.entries.length=0,V(this.entries,t)},z=function(t,e){if(t<e)throw TypeError("Not enough arguments")},U=c(function(t,e){I(this,{type:"URLSearchParamsIterator",iterator:_(C(t).entries),kind:e})},"Iterator",function(){var t=O(this),e=t.kind,n=t.iterator.next(),r=n.value;return n.done||(n.value="keys"===e?r.key:"values"===e?r.value:[r.key,r.value]),n}),W=function(){f(this,W,"URLSearchParams");var t,e,n,r,o,i,a,s,u,c=arguments.length>0?arguments[0]:void 0,l=[];if(I(this,{type:"URLSearchParams",entries:l,updateURL:function(){},updateSearchParams:H}),void 0!==c)if(v(c))if("function"==typeof(t=w(c)))for(n=(e=t.call(c)).next;!(r=n.call(e)).done;){if((a=(i=(o=_(y(r.value))).next).call(o)).done||(s=i.call(o)).done||!i.call(o).done)throw TypeError("Expected sequence with length 2");l.push({key:g(a.value),value:g(s.value)})}else for(u in c)p(c,u)&&l.push({key:u,value:g(c[u])});else V(l,"string"==typeof c?"?"===c.charAt(0)?c.slice(1):c:g(c))},Z=W.prototype;if(s(Z,{append:function(t,e){z(arguments.length,2);var n=C(this);n.entries.push({key:g(t),value:g(e)}),n.updateURL()},delete:function(t){z(arguments.length,1);for(var e=C(this),n=e.entries,r=g(t),o=0;o<n.length;)n[o].key===r?n.splice(o,1):o++;e.updateURL()},get:function(t){z(arguments.length,1);for(var e=C(this).entries,n=g(t),r=0;r<e.length;r++)if(e[r].key===n)return e[r].value;return null},getAll:function(t){z(arguments.length,1);for(var e=C(this).entries,n=g(t),r=[],o=0;o<e.length;o++)e[o].key===n&&r.push(e[o].value);return r},has:function(t){z(arguments.length,1);for(var e=C(this).entries,n=g(t),r=0;r<e.length;)if(e[r++].key===n)return!0;return!1},set:function(t,e){z(arguments.length,1);for(var n,r=C(this),o=r.entries,i=!1,a=g(t),s=g(e),u=0;u<o.length;u++)(n=o[u]).key===a&&(i?o.splice(u--,1):(i=!0,n.value=s));i||o.push({key:a,value:s}),r.updateURL()},sort:function(){var t,e,n,r=C(this),o=r.entries,i=o.slice();for(o.length=0,n=0;n<i.length;n++){for(t=i[n],e=0;e<n;e++)if(o[e].key>t.key){o.splice(e,0,t);break}e===n&&o.push(t)}r.updateURL()},forEach:function(t){for(var e,n=C(this).entries,r=h(t,arguments.length>1?arguments[1]:void 0,3),o=0;o<n.length;)r((e=n[o++]).value,e.key,this)},keys:function(){return new U(this,"keys")},values:function(){return new U(this,"values")},entries:function(){return new U(this,"entries")}},{enumerable:!0}),a(Z,A,Z.entries),a(Z,"toString",function(){for(var t,e=C(this).entries,n=[],r=0;r<e.length;)t=e[r++],n.push(B(t.key)+"="+B(t.value));return n.join("&")},{enumerable:!0}),u(W,"URLSearchParams"),r({global:!0,forced:!i},{URLSearchParams:W}),!i&&"function"==typeof k){var q=function(t){if(v(t)){var e,n=t.body;if("URLSearchParams"===d(n))return(e=t.headers?new k(t.headers):new k).has("content-type")||e.set("content-type","application/x-www-form-urlencoded;charset=UTF-8"),m(t,{body:b(0,String(n)),headers:b(0,e)})}return t};if("function"==typeof S&&r({global:!0,enumerable:!0,forced:!0},{fetch:function(t){return S(t,arguments.length>1?q(arguments[1]):{})}}),"function"==typeof x){var G=function(t){return f(this,G,"Request"),new x(t,arguments.length>1?q(arguments[1]):{})};T.constructor=G,G.prototype=T,r({global:!0,forced:!0},{Request:G})}}t.exports={URLSearchParams:W,getState:C}},mRH6:function(t,e,n){"use strict";var r=n("I+eb"),o=n("hXpO");r({target:"String",proto:!0,forced:n("rwPt")("link")},{link:function(t){return o(this,"a","href",t)}})},ma9I:function(t,e,n){"use strict";var r=n("I+eb"),o=n("0Dky"),i=n("6LWA"),a=n("hh1v"),s=n("ewvW"),u=n("UMSQ"),c=n("hBjN"),l=n("ZfDv"),f=n("Hd5f"),p=n("tiKp"),h=n("LQDL"),d=p("isConcatSpreadable"),y=h>=51||!o(function(){var t=[];return t[d]=!1,t.concat()[0]!==t}),v=f("concat"),g=function(t){if(!a(t))return!1;var e=t[d];return void 0!==e?!!e:i(t)};r({target:"Array",proto:!0,forced:!y||!v},{concat:function(t){var e,n,r,o,i,a=s(this),f=l(a,0),p=0;for(e=-1,r=arguments.length;e<r;e++)if(g(i=-1===e?a:arguments[e])){if(p+(o=u(i.length))>9007199254740991)throw TypeError("Maximum allowed index exceeded");for(n=0;n<o;n++,p++)n in i&&c(f,p,i[n])}else{if(p>=9007199254740991)throw TypeError("Maximum allowed index exceeded");c(f,p++,i)}return f.length=p,f}})},mgyK:function(t,e,n){var r=n("NC/Y");t.exports=/Version\/10(?:\.\d+){1,2}(?: [\w./]+)?(?: Mobile\/\w+)? Safari\//.test(r)},"mh/w":function(t,e,n){var r=n("glrk"),o=n("NaFW");t.exports=function(t){var e=o(t);if("function"!=typeof e)throw TypeError(String(t)+" is not iterable");return r(e.call(t))}},moxL:function(t,e,n){"use strict";var r=n("67WC"),o=n("FF6l"),i=r.aTypedArray;(0,r.exportTypedArrayMethod)("copyWithin",function(t,e){return o.call(i(this),t,e,arguments.length>2?arguments[2]:void 0)})},"n/mU":function(t,e,n){var r=n("I+eb"),o=Math.atanh,i=Math.log;r({target:"Math",stat:!0,forced:!(o&&1/o(-0)<0)},{atanh:function(t){return 0==(t=+t)?t:i((1+t)/(1-t))/2}})},"n3/R":function(t,e,n){var r=n("0Dky"),o=n("2oRo").RegExp;e.UNSUPPORTED_Y=r(function(){var t=o("a","y");return t.lastIndex=2,null!=t.exec("abcd")}),e.BROKEN_CARET=r(function(){var t=o("^r","gy");return t.lastIndex=2,null!=t.exec("str")})},n5b4:function(t,e,n){var r=n("I+eb"),o=n("2oRo"),i=n("tXUg"),a=n("YF1G"),s=o.process;r({global:!0,enumerable:!0,noTargetGet:!0},{queueMicrotask:function(t){var e=a&&s.domain;i(e?e.bind(t):t)}})},nkod:function(t,e,n){var r=n("I+eb"),o=n("g6v/"),i=n("glrk"),a=n("Bs8V");r({target:"Reflect",stat:!0,sham:!o},{getOwnPropertyDescriptor:function(t,e){return a.f(i(t),e)}})},ntOU:function(t,e,n){"use strict";var r=n("rpNk").IteratorPrototype,o=n("fHMY"),i=n("XGwC"),a=n("1E5z"),s=n("P4y1"),u=function(){return this};t.exports=function(t,e,n){var c=e+" Iterator";return t.prototype=o(r,{next:i(1,n)}),a(t,c,!1,!0),s[c]=u,t}},oEtG:function(t,e,n){var r=n("wE6v"),o=n("2bX/");t.exports=function(t){var e=r(t,"string");return o(e)?e:String(e)}},"oHi+":function(t,e,n){var r=n("ewvW"),o=n("UMSQ"),i=n("NaFW"),a=n("6VoE"),s=n("A2ZE"),u=n("67WC").aTypedArrayConstructor;t.exports=function(t){var e,n,c,l,f,p,h=r(t),d=arguments.length,y=d>1?arguments[1]:void 0,v=void 0!==y,g=i(h);if(null!=g&&!a(g))for(p=(f=g.call(h)).next,h=[];!(l=p.call(f)).done;)h.push(l.value);for(v&&d>2&&(y=s(y,arguments[2],2)),n=o(h.length),c=new(u(this))(n),e=0;n>e;e++)c[e]=v?y(h[e],e):h[e];return c}},oVuX:function(t,e,n){"use strict";var r=n("I+eb"),o=n("RK3t"),i=n("/GqU"),a=n("pkCn"),s=[].join,u=o!=Object,c=a("join",",");r({target:"Array",proto:!0,forced:u||!c},{join:function(t){return s.call(i(this),void 0===t?",":t)}})},ofBz:function(t,e,n){"use strict";var r=n("I+eb"),o=n("ntOU"),i=n("HYAF"),a=n("UMSQ"),s=n("V37c"),u=n("HAuM"),c=n("glrk"),l=n("xrYK"),f=n("ROdP"),p=n("rW0t"),h=n("kRJp"),d=n("0Dky"),y=n("tiKp"),v=n("SEBh"),g=n("iqWW"),m=n("afO8"),b=n("xDBR"),_=y("matchAll"),w=m.set,E=m.getterFor("RegExp String Iterator"),S=RegExp.prototype,x=S.exec,T="".matchAll,k=!!T&&!d(function(){"a".matchAll(/./)}),A=o(function(t,e,n,r){w(this,{type:"RegExp String Iterator",regexp:t,string:e,global:n,unicode:r,done:!1})},"RegExp String",function(){var t=E(this);if(t.done)return{value:void 0,done:!0};var e=t.regexp,n=t.string,r=function(t,e){var n,r=t.exec;if("function"==typeof r){if("object"!=typeof(n=r.call(t,e)))throw TypeError("Incorrect exec result");return n}return x.call(t,e)}(e,n);return null===r?{value:void 0,done:t.done=!0}:t.global?(""===s(r[0])&&(e.lastIndex=g(n,a(e.lastIndex),t.unicode)),{value:r,done:!1}):(t.done=!0,{value:r,done:!1})}),I=function(t){var e,n,r,o,i,u,l=c(this),f=s(t);return e=v(l,RegExp),void 0===(n=l.flags)&&l instanceof RegExp&&!("flags"in S)&&(n=p.call(l)),r=void 0===n?"":s(n),o=new e(e===RegExp?l.source:l,r),i=!!~r.indexOf("g"),u=!!~r.indexOf("u"),o.lastIndex=a(l.lastIndex),new A(o,f,i,u)};r({target:"String",proto:!0,forced:k},{matchAll:function(t){var e,n,r,o=i(this);if(null!=t){if(f(t)&&!~s(i("flags"in S?t.flags:p.call(t))).indexOf("g"))throw TypeError("`.matchAll` does not allow non-global regexes");if(k)return T.apply(o,arguments);if(void 0===(n=t[_])&&b&&"RegExp"==l(t)&&(n=I),null!=n)return u(n).call(t,o)}else if(k)return T.apply(o,arguments);return e=s(o),r=new RegExp(t,"g"),b?I.call(r,e):r[_](e)}}),b||_ in S||h(S,_,I)},or9q:function(t,e,n){"use strict";var r=n("6LWA"),o=n("UMSQ"),i=n("A2ZE"),a=function(t,e,n,s,u,c,l,f){for(var p,h=u,d=0,y=!!l&&i(l,f,3);d<s;){if(d in n){if(p=y?y(n[d],d,e):n[d],c>0&&r(p))h=a(t,e,p,o(p.length),h,c-1)-1;else{if(h>=9007199254740991)throw TypeError("Exceed the acceptable array length");t[h]=p}h++}d++}return h};t.exports=a},p532:function(t,e,n){"use strict";var r=n("I+eb"),o=n("xDBR"),i=n("/qmn"),a=n("0Dky"),s=n("0GbY"),u=n("SEBh"),c=n("zfnd"),l=n("busE");if(r({target:"Promise",proto:!0,real:!0,forced:!!i&&a(function(){i.prototype.finally.call({then:function(){}},function(){})})},{finally:function(t){var e=u(this,s("Promise")),n="function"==typeof t;return this.then(n?function(n){return c(e,t()).then(function(){return n})}:t,n?function(n){return c(e,t()).then(function(){throw n})}:t)}}),!o&&"function"==typeof i){var f=s("Promise").prototype.finally;i.prototype.finally!==f&&l(i.prototype,"finally",f,{unsafe:!0})}},pDQq:function(t,e,n){"use strict";var r=n("I+eb"),o=n("I8vh"),i=n("ppGB"),a=n("UMSQ"),s=n("ewvW"),u=n("ZfDv"),c=n("hBjN"),l=n("Hd5f")("splice"),f=Math.max,p=Math.min;r({target:"Array",proto:!0,forced:!l},{splice:function(t,e){var n,r,l,h,d,y,v=s(this),g=a(v.length),m=o(t,g),b=arguments.length;if(0===b?n=r=0:1===b?(n=0,r=g-m):(n=b-2,r=p(f(i(e),0),g-m)),g+n-r>9007199254740991)throw TypeError("Maximum allowed length exceeded");for(l=u(v,r),h=0;h<r;h++)(d=m+h)in v&&c(l,h,v[d]);if(l.length=r,n<r){for(h=m;h<g-r;h++)y=h+n,(d=h+r)in v?v[y]=v[d]:delete v[y];for(h=g;h>g-r+n;h--)delete v[h-1]}else if(n>r)for(h=g-r;h>m;h--)y=h+n-1,(d=h+r-1)in v?v[y]=v[d]:delete v[y];for(h=0;h<n;h++)v[h+m]=arguments[h+2];return v.length=g-r+n,l}})},pLQz:function(t,e,n){var r=n("NC/Y");t.exports=/web0s(?!.*chrome)/i.test(r)},pNMO:function(t,e,n){"use strict";var r=n("I+eb"),o=n("2oRo"),i=n("0GbY"),a=n("xDBR"),s=n("g6v/"),u=n("STAE"),c=n("0Dky"),l=n("UTVS"),f=n("6LWA"),p=n("hh1v"),h=n("2bX/"),d=n("glrk"),y=n("ewvW"),v=n("/GqU"),g=n("oEtG"),m=n("V37c"),b=n("XGwC"),_=n("fHMY"),w=n("33Wh"),E=n("JBy8"),S=n("BX/b"),x=n("dBg+"),T=n("Bs8V"),k=n("m/L8"),A=n("0eef"),I=n("kRJp"),C=n("busE"),O=n("VpIT"),P=n("93I0"),R=n("0BK2"),D=n("kOOl"),N=n("tiKp"),M=n("5Tg+"),j=n("dG/n"),F=n("1E5z"),L=n("afO8"),B=n("tycR").forEach,V=P("hidden"),H=N("toPrimitive"),z=L.set,U=L.getterFor("Symbol"),W=Object.prototype,Z=o.Symbol,q=i("JSON","stringify"),G=T.f,K=k.f,Q=S.f,Y=A.f,X=O("symbols"),J=O("op-symbols"),$=O("string-to-symbol-registry"),tt=O("symbol-to-string-registry"),et=O("wks"),nt=o.QObject,rt=!nt||!nt.prototype||!nt.prototype.findChild,ot=s&&c(function(){return 7!=_(K({},"a",{get:function(){return K(this,"a",{value:7}).a}})).a})?function(t,e,n){var r=G(W,e);r&&delete W[e],K(t,e,n),r&&t!==W&&K(W,e,r)}:K,it=function(t,e){var n=X[t]=_(Z.prototype);return z(n,{type:"Symbol",tag:t,description:e}),s||(n.description=e),n},at=function(t,e,n){t===W&&at(J,e,n),d(t);var r=g(e);return d(n),l(X,r)?(n.enumerable?(l(t,V)&&t[V][r]&&(t[V][r]=!1),n=_(n,{enumerable:b(0,!1)})):(l(t,V)||K(t,V,b(1,{})),t[V][r]=!0),ot(t,r,n)):K(t,r,n)},st=function(t,e){d(t);var n=v(e),r=w(n).concat(ft(n));return B(r,function(e){s&&!ut.call(n,e)||at(t,e,n[e])}),t},ut=function(t){var e=g(t),n=Y.call(this,e);return!(this===W&&l(X,e)&&!l(J,e))&&(!(n||!l(this,e)||!l(X,e)||l(this,V)&&this[V][e])||n)},ct=function(t,e){var n=v(t),r=g(e);if(n!==W||!l(X,r)||l(J,r)){var o=G(n,r);return!o||!l(X,r)||l(n,V)&&n[V][r]||(o.enumerable=!0),o}},lt=function(t){var e=Q(v(t)),n=[];return B(e,function(t){l(X,t)||l(R,t)||n.push(t)}),n},ft=function(t){var e=t===W,n=Q(e?J:v(t)),r=[];return B(n,function(t){!l(X,t)||e&&!l(W,t)||r.push(X[t])}),r};u||(C((Z=function(){if(this instanceof Z)throw TypeError("Symbol is not a constructor");var t=arguments.length&&void 0!==arguments[0]?m(arguments[0]):void 0,e=D(t),n=function(t){this===W&&n.call(J,t),l(this,V)&&l(this[V],e)&&(this[V][e]=!1),ot(this,e,b(1,t))};return s&&rt&&ot(W,e,{configurable:!0,set:n}),it(e,t)}).prototype,"toString",function(){return U(this).tag}),C(Z,"withoutSetter",function(t){return it(D(t),t)}),A.f=ut,k.f=at,T.f=ct,E.f=S.f=lt,x.f=ft,M.f=function(t){return it(N(t),t)},s&&(K(Z.prototype,"description",{configurable:!0,get:function(){return U(this).description}}),a||C(W,"propertyIsEnumerable",ut,{unsafe:!0}))),r({global:!0,wrap:!0,forced:!u,sham:!u},{Symbol:Z}),B(w(et),function(t){j(t)}),r({target:"Symbol",stat:!0,forced:!u},{for:function(t){var e=m(t);if(l($,e))return $[e];var n=Z(e);return $[e]=n,tt[n]=e,n},keyFor:function(t){if(!h(t))throw TypeError(t+" is not a symbol");if(l(tt,t))return tt[t]},useSetter:function(){rt=!0},useSimple:function(){rt=!1}}),r({target:"Object",stat:!0,forced:!u,sham:!s},{create:function(t,e){return void 0===e?_(t):st(_(t),e)},defineProperty:at,defineProperties:st,getOwnPropertyDescriptor:ct}),r({target:"Object",stat:!0,forced:!u},{getOwnPropertyNames:lt,getOwnPropertySymbols:ft}),r({target:"Object",stat:!0,forced:c(function(){x.f(1)})},{getOwnPropertySymbols:function(t){return x.f(y(t))}}),q&&r({target:"JSON",stat:!0,forced:!u||c(function(){var t=Z();return"[null]"!=q([t])||"{}"!=q({a:t})||"{}"!=q(Object(t))})},{stringify:function(t,e,n){for(var r,o=[t],i=1;arguments.length>i;)o.push(arguments[i++]);if(r=e,(p(e)||void 0!==t)&&!h(t))return f(e)||(e=function(t,e){if("function"==typeof r&&(e=r.call(this,t,e)),!h(e))return e}),o[1]=e,q.apply(null,o)}}),Z.prototype[H]||I(Z.prototype,H,Z.prototype.valueOf),F(Z,"Symbol"),R[V]=!0},piMb:function(t,e,n){"use strict";var r=n("I+eb"),o=n("tycR").every;r({target:"Array",proto:!0,forced:!n("pkCn")("every")},{every:function(t){return o(this,t,arguments.length>1?arguments[1]:void 0)}})},pjDv:function(t,e,n){var r=n("I+eb"),o=n("TfTi");r({target:"Array",stat:!0,forced:!n("HH4o")(function(t){Array.from(t)})},{from:o})},pkCn:function(t,e,n){"use strict";var r=n("0Dky");t.exports=function(t,e){var n=[][t];return!!n&&r(function(){n.call(null,e||function(){throw 1},1)})}},ppGB:function(t,e){var n=Math.ceil,r=Math.floor;t.exports=function(t){return isNaN(t=+t)?0:(t>0?r:n)(t)}},pv2x:function(t,e,n){var r=n("I+eb"),o=n("0GbY"),i=n("HAuM"),a=n("glrk"),s=n("0Dky"),u=o("Reflect","apply"),c=Function.apply;r({target:"Reflect",stat:!0,forced:!s(function(){u(function(){})})},{apply:function(t,e,n){return i(t),a(n),u?u(t,e,n):c.call(t,e,n)}})},"qHT+":function(t,e,n){var r=n("I+eb"),o=n("FF6l"),i=n("RNIs");r({target:"Array",proto:!0},{copyWithin:o}),i("copyWithin")},qXVe:function(t,e,n){"use strict";var r=n("67WC"),o=n("tycR").every,i=r.aTypedArray;(0,r.exportTypedArrayMethod)("every",function(t){return o(i(this),t,arguments.length>1?arguments[1]:void 0)})},qYE9:function(t,e){t.exports="undefined"!=typeof ArrayBuffer&&"undefined"!=typeof DataView},qePV:function(t,e,n){"use strict";var r=n("g6v/"),o=n("2oRo"),i=n("lMq5"),a=n("busE"),s=n("UTVS"),u=n("xrYK"),c=n("cVYH"),l=n("2bX/"),f=n("wE6v"),p=n("0Dky"),h=n("fHMY"),d=n("JBy8").f,y=n("Bs8V").f,v=n("m/L8").f,g=n("WKiH").trim,m=o.Number,b=m.prototype,_="Number"==u(h(b)),w=function(t){if(l(t))throw TypeError("Cannot convert a Symbol value to a number");var e,n,r,o,i,a,s,u,c=f(t,"number");if("string"==typeof c&&c.length>2)if(43===(e=(c=g(c)).charCodeAt(0))||45===e){if(88===(n=c.charCodeAt(2))||120===n)return NaN}else if(48===e){switch(c.charCodeAt(1)){case 66:case 98:r=2,o=49;break;case 79:case 111:r=8,o=55;break;default:return+c}for(a=(i=c.slice(2)).length,s=0;s<a;s++)if((u=i.charCodeAt(s))<48||u>o)return NaN;return parseInt(i,r)}return+c};if(i("Number",!m(" 0o1")||!m("0b1")||m("+0x1"))){for(var E,S=function(t){var e=arguments.length<1?0:t,n=this;return n instanceof S&&(_?p(function(){b.valueOf.call(n)}):"Number"!=u(n))?c(new m(w(e)),n,S):w(e)},x=r?d(m):"MAX_VALUE,MIN_VALUE,NaN,NEGATIVE_INFINITY,POSITIVE_INFINITY,EPSILON,isFinite,isInteger,isNaN,isSafeInteger,MAX_SAFE_INTEGER,MIN_SAFE_INTEGER,parseFloat,parseInt,isInteger,fromString,range".split(","),T=0;x.length>T;T++)s(m,E=x[T])&&!s(S,E)&&v(S,E,y(m,E));S.prototype=b,b.constructor=S,a(o,"Number",S)}},qxPZ:function(t,e,n){var r=n("tiKp")("match");t.exports=function(t){var e=/./;try{"/./"[t](e)}catch(n){try{return e[r]=!1,"/./"[t](e)}catch(o){}}return!1}},"r/Vq":function(t,e,n){n("I+eb")({target:"Number",stat:!0},{MAX_SAFE_INTEGER:9007199254740991})},r5Og:function(t,e,n){var r=n("I+eb"),o=n("hh1v"),i=n("8YOa").onFreeze,a=n("uy83"),s=n("0Dky"),u=Object.seal;r({target:"Object",stat:!0,forced:s(function(){u(1)}),sham:!a},{seal:function(t){return u&&o(t)?u(i(t)):t}})},rB9j:function(t,e,n){"use strict";var r=n("I+eb"),o=n("kmMV");r({target:"RegExp",proto:!0,forced:/./.exec!==o},{exec:o})},rBZX:function(t,e,n){var r=n("I+eb"),o=n("glrk"),i=n("Bs8V").f;r({target:"Reflect",stat:!0},{deleteProperty:function(t,e){var n=i(o(t),e);return!(n&&!n.configurable)&&delete t[e]}})},rKzb:function(t,e,n){"use strict";var r=n("4syw"),o=n("8YOa").getWeakData,i=n("glrk"),a=n("hh1v"),s=n("GarU"),u=n("ImZN"),c=n("tycR"),l=n("UTVS"),f=n("afO8"),p=f.set,h=f.getterFor,d=c.find,y=c.findIndex,v=0,g=function(t){return t.frozen||(t.frozen=new m)},m=function(){this.entries=[]},b=function(t,e){return d(t.entries,function(t){return t[0]===e})};m.prototype={get:function(t){var e=b(this,t);if(e)return e[1]},has:function(t){return!!b(this,t)},set:function(t,e){var n=b(this,t);n?n[1]=e:this.entries.push([t,e])},delete:function(t){var e=y(this.entries,function(e){return e[0]===t});return~e&&this.entries.splice(e,1),!!~e}},t.exports={getConstructor:function(t,e,n,c){var f=t(function(t,r){s(t,f,e),p(t,{type:e,id:v++,frozen:void 0}),null!=r&&u(r,t[c],{that:t,AS_ENTRIES:n})}),d=h(e),y=function(t,e,n){var r=d(t),a=o(i(e),!0);return!0===a?g(r).set(e,n):a[r.id]=n,t};return r(f.prototype,{delete:function(t){var e=d(this);if(!a(t))return!1;var n=o(t);return!0===n?g(e).delete(t):n&&l(n,e.id)&&delete n[e.id]},has:function(t){var e=d(this);if(!a(t))return!1;var n=o(t);return!0===n?g(e).has(t):n&&l(n,e.id)}}),r(f.prototype,n?{get:function(t){var e=d(this);if(a(t)){var n=o(t);return!0===n?g(e).get(t):n?n[e.id]:void 0}},set:function(t,e){return y(this,t,e)}}:{add:function(t){return y(this,t,!0)}}),f}}},rMz7:function(t,e,n){var r=n("I+eb"),o=n("ZOXb");r({target:"Date",proto:!0,forced:Date.prototype.toISOString!==o},{toISOString:o})},rNhl:function(t,e,n){var r=n("I+eb"),o=n("fhKU");r({global:!0,forced:parseFloat!=o},{parseFloat:o})},rOQg:function(t,e,n){"use strict";var r=n("I+eb"),o=n("0Dky"),i=n("Yhre"),a=n("glrk"),s=n("I8vh"),u=n("UMSQ"),c=n("SEBh"),l=i.ArrayBuffer,f=i.DataView,p=l.prototype.slice;r({target:"ArrayBuffer",proto:!0,unsafe:!0,forced:o(function(){return!new l(2).slice(1,void 0).byteLength})},{slice:function(t,e){if(void 0!==p&&void 0===e)return p.call(a(this),t);for(var n=a(this).byteLength,r=s(t,n),o=s(void 0===e?n:e,n),i=new(c(this,l))(u(o-r)),h=new f(this),d=new f(i),y=0;r<o;)d.setUint8(y++,h.getUint8(r++));return i}})},rW0t:function(t,e,n){"use strict";var r=n("glrk");t.exports=function(){var t=r(this),e="";return t.global&&(e+="g"),t.ignoreCase&&(e+="i"),t.multiline&&(e+="m"),t.dotAll&&(e+="s"),t.unicode&&(e+="u"),t.sticky&&(e+="y"),e}},rdv8:function(t,e){var n=Math.floor,r=function(t,e){var a=t.length,s=n(a/2);return a<8?o(t,e):i(r(t.slice(0,s),e),r(t.slice(s),e),e)},o=function(t,e){for(var n,r,o=t.length,i=1;i<o;){for(r=i,n=t[i];r&&e(t[r-1],n)>0;)t[r]=t[--r];r!==i++&&(t[r]=n)}return t},i=function(t,e,n){for(var r=t.length,o=e.length,i=0,a=0,s=[];i<r||a<o;)s.push(i<r&&a<o?n(t[i],e[a])<=0?t[i++]:e[a++]:i<r?t[i++]:e[a++]);return s};t.exports=r},rpNk:function(t,e,n){"use strict";var r,o,i,a=n("0Dky"),s=n("4WOD"),u=n("kRJp"),c=n("UTVS"),l=n("tiKp"),f=n("xDBR"),p=l("iterator"),h=!1;[].keys&&("next"in(i=[].keys())?(o=s(s(i)))!==Object.prototype&&(r=o):h=!0);var d=null==r||a(function(){var t={};return r[p].call(t)!==t});d&&(r={}),f&&!d||c(r,p)||u(r,p,function(){return this}),t.exports={IteratorPrototype:r,BUGGY_SAFARI_ITERATORS:h}},rwPt:function(t,e,n){var r=n("0Dky");t.exports=function(t){return r(function(){var e=""[t]('"');return e!==e.toLowerCase()||e.split('"').length>3})}},s5qe:function(t,e,n){"use strict";var r=n("2oRo"),o=n("67WC"),i=n("0Dky"),a=r.Int8Array,s=o.aTypedArray,u=o.exportTypedArrayMethod,c=[].toLocaleString,l=[].slice,f=!!a&&i(function(){c.call(new a(1))});u("toLocaleString",function(){return c.apply(f?l.call(s(this)):s(this),arguments)},i(function(){return[1,2].toLocaleString()!=new a([1,2]).toLocaleString()})||!i(function(){a.prototype.toLocaleString.call([1,2])}))},sEFX:function(t,e,n){"use strict";var r=n("AO7/"),o=n("9d/t");t.exports=r?{}.toString:function(){return"[object "+o(this)+"]"}},sMBO:function(t,e,n){var r=n("g6v/"),o=n("m/L8").f,i=Function.prototype,a=i.toString,s=/^\s*function ([^ (]*)/;!r||"name"in i||o(i,"name",{configurable:!0,get:function(){try{return a.call(this).match(s)[1]}catch(t){return""}}})},tCCV:function(t,e,n){var r=n("I+eb"),o=n("Yhre");r({global:!0,forced:!n("qYE9")},{DataView:o.DataView})},tW5y:function(t,e,n){"use strict";var r=n("hh1v"),o=n("m/L8"),i=n("4WOD"),a=n("tiKp")("hasInstance"),s=Function.prototype;a in s||o.f(s,a,{value:function(t){if("function"!=typeof this||!r(t))return!1;if(!r(this.prototype))return t instanceof this;for(;t=i(t);)if(this.prototype===t)return!0;return!1}})},tXUg:function(t,e,n){var r,o,i,a,s,u,c,l,f=n("2oRo"),p=n("Bs8V").f,h=n("LPSS").set,d=n("HNyW"),y=n("1MNl"),v=n("pLQz"),g=n("YF1G"),m=f.MutationObserver||f.WebKitMutationObserver,b=f.document,_=f.process,w=f.Promise,E=p(f,"queueMicrotask"),S=E&&E.value;S||(r=function(){var t,e;for(g&&(t=_.domain)&&t.exit();o;){e=o.fn,o=o.next;try{e()}catch(n){throw o?a():i=void 0,n}}i=void 0,t&&t.enter()},d||g||v||!m||!b?!y&&w&&w.resolve?((c=w.resolve(void 0)).constructor=w,l=c.then,a=function(){l.call(c,r)}):a=g?function(){_.nextTick(r)}:function(){h.call(f,r)}:(s=!0,u=b.createTextNode(""),new m(r).observe(u,{characterData:!0}),a=function(){u.data=s=!s})),t.exports=S||function(t){var e={fn:t,next:void 0};i&&(i.next=e),o||(o=e,a()),i=e}},tiKp:function(t,e,n){var r=n("2oRo"),o=n("VpIT"),i=n("UTVS"),a=n("kOOl"),s=n("STAE"),u=n("/b8u"),c=o("wks"),l=r.Symbol,f=u?l:l&&l.withoutSetter||a;t.exports=function(t){return i(c,t)&&(s||"string"==typeof c[t])||(c[t]=s&&i(l,t)?l[t]:f("Symbol."+t)),c[t]}},tjZM:function(t,e,n){n("dG/n")("asyncIterator")},tkto:function(t,e,n){var r=n("I+eb"),o=n("ewvW"),i=n("33Wh");r({target:"Object",stat:!0,forced:n("0Dky")(function(){i(1)})},{keys:function(t){return i(o(t))}})},"tl/u":function(t,e,n){var r=n("I+eb"),o=Math.ceil,i=Math.floor;r({target:"Math",stat:!0},{trunc:function(t){return(t>0?i:o)(t)}})},toAj:function(t,e,n){"use strict";var r=n("I+eb"),o=n("ppGB"),i=n("QIpd"),a=n("EUja"),s=n("0Dky"),u=1..toFixed,c=Math.floor,l=function(t,e,n){return 0===e?n:e%2==1?l(t,e-1,n*t):l(t*t,e/2,n)},f=function(t,e,n){for(var r=-1,o=n;++r<6;)t[r]=(o+=e*t[r])%1e7,o=c(o/1e7)},p=function(t,e){for(var n=6,r=0;--n>=0;)t[n]=c((r+=t[n])/e),r=r%e*1e7},h=function(t){for(var e=6,n="";--e>=0;)if(""!==n||0===e||0!==t[e]){var r=String(t[e]);n=""===n?r:n+a.call("0",7-r.length)+r}return n};r({target:"Number",proto:!0,forced:u&&("0.000"!==8e-5.toFixed(3)||"1"!==.9.toFixed(0)||"1.25"!==1.255.toFixed(2)||"1000000000000000128"!==(0xde0b6b3a7640080).toFixed(0))||!s(function(){u.call({})})},{toFixed:function(t){var e,n,r,s,u=i(this),c=o(t),d=[0,0,0,0,0,0],y="",v="0";if(c<0||c>20)throw RangeError("Incorrect fraction digits");if(u!=u)return"NaN";if(u<=-1e21||u>=1e21)return String(u);if(u<0&&(y="-",u=-u),u>1e-21)if(n=(e=function(t){for(var e=0,n=u*l(2,69,1);n>=4096;)e+=12,n/=4096;for(;n>=2;)e+=1,n/=2;return e}()-69)<0?u*l(2,-e,1):u/l(2,e,1),n*=4503599627370496,(e=52-e)>0){for(f(d,0,n),r=c;r>=7;)f(d,1e7,0),r-=7;for(f(d,l(10,r,1),0),r=e-1;r>=23;)p(d,1<<23),r-=23;p(d,1<<r),f(d,1,1),p(d,2),v=h(d)}else f(d,0,n),f(d,1<<-e,0),v=h(d)+a.call("0",c);return c>0?y+((s=v.length)<=c?"0."+a.call("0",c-s)+v:v.slice(0,s-c)+"."+v.slice(s-c)):y+v}})},trfz:function(t,e,n){var r=n("67WC"),o=n("SEBh"),i=r.TYPED_ARRAY_CONSTRUCTOR,a=r.aTypedArrayConstructor;t.exports=function(t){return a(o(t,t[i]))}},tycR:function(t,e,n){var r=n("A2ZE"),o=n("RK3t"),i=n("ewvW"),a=n("UMSQ"),s=n("ZfDv"),u=[].push,c=function(t){var e=1==t,n=2==t,c=3==t,l=4==t,f=6==t,p=7==t,h=5==t||f;return function(d,y,v,g){for(var m,b,_=i(d),w=o(_),E=r(y,v,3),S=a(w.length),x=0,T=g||s,k=e?T(d,S):n||p?T(d,0):void 0;S>x;x++)if((h||x in w)&&(b=E(m=w[x],x,_),t))if(e)k[x]=b;else if(b)switch(t){case 3:return!0;case 5:return m;case 6:return x;case 2:u.call(k,m)}else switch(t){case 4:return!1;case 7:u.call(k,m)}return f?-1:c||l?l:k}};t.exports={forEach:c(0),map:c(1),filter:c(2),some:c(3),every:c(4),find:c(5),findIndex:c(6),filterReject:c(7)}},uL8W:function(t,e,n){n("I+eb")({target:"Object",stat:!0,sham:!n("g6v/")},{create:n("fHMY")})},uqXc:function(t,e,n){var r=n("I+eb"),o=n("5Yz+");r({target:"Array",proto:!0,forced:o!==[].lastIndexOf},{lastIndexOf:o})},uy83:function(t,e,n){var r=n("0Dky");t.exports=!r(function(){return Object.isExtensible(Object.preventExtensions({}))})},v5b1:function(t,e,n){"use strict";var r=n("I+eb"),o=n("g6v/"),i=n("6x0u"),a=n("ewvW"),s=n("oEtG"),u=n("4WOD"),c=n("Bs8V").f;o&&r({target:"Object",proto:!0,forced:i},{__lookupGetter__:function(t){var e,n=a(this),r=s(t);do{if(e=c(n,r))return e.get}while(n=u(n))}})},vAFs:function(t,e,n){var r=n("I+eb"),o=n("0Dky"),i=Math.imul;r({target:"Math",stat:!0,forced:o(function(){return-5!=i(4294967295,5)||2!=i.length})},{imul:function(t,e){var n=+t,r=+e,o=65535&n,i=65535&r;return 0|o*i+((65535&n>>>16)*i+o*(65535&r>>>16)<<16>>>0)}})},vo4V:function(t,e,n){var r=n("90hW"),o=Math.abs,i=Math.pow,a=i(2,-52),s=i(2,-23),u=i(2,127)*(2-s),c=i(2,-126);t.exports=Math.fround||function(t){var e,n,i=o(t),l=r(t);return i<c?l*(i/c/s+1/a-1/a)*c*s:(n=(e=(1+s/a)*i)-(e-i))>u||n!=n?l*(1/0):l*n}},vxnP:function(t,e,n){"use strict";n("I+eb")({target:"URL",proto:!0,enumerable:!0},{toJSON:function(){return URL.prototype.toString.call(this)}})},w1rZ:function(t,e,n){var r=n("I+eb"),o=n("fhKU");r({target:"Number",stat:!0,forced:Number.parseFloat!=o},{parseFloat:o})},wE6v:function(t,e,n){var r=n("hh1v"),o=n("2bX/"),i=n("SFrS"),a=n("tiKp")("toPrimitive");t.exports=function(t,e){if(!r(t)||o(t))return t;var n,s=t[a];if(void 0!==s){if(void 0===e&&(e="default"),n=s.call(t,e),!r(n)||o(n))return n;throw TypeError("Can't convert object to primitive value")}return void 0===e&&(e="number"),i(t,e)}},wLYn:function(t,e,n){n("I+eb")({target:"Function",proto:!0},{bind:n("BTho")})},"wZ/5":function(t,e,n){"use strict";var r=n("I+eb"),o=n("2oRo"),i=n("Yhre"),a=n("JiZb"),s=i.ArrayBuffer;r({global:!0,forced:o.ArrayBuffer!==s},{ArrayBuffer:s}),a("ArrayBuffer")},waxf:function(t,e,n){"use strict";var r=n("67WC"),o=n("tycR").filter,i=n("FEiF"),a=r.aTypedArray;(0,r.exportTypedArrayMethod)("filter",function(t){var e=o(a(this),t,arguments.length>1?arguments[1]:void 0);return i(this,e)})},wfmh:function(t,e,n){var r=n("I+eb"),o=n("ImZN"),i=n("hBjN");r({target:"Object",stat:!0},{fromEntries:function(t){var e={};return o(t,function(t,n){i(e,t,n)},{AS_ENTRIES:!0}),e}})},wg0c:function(t,e,n){var r=n("2oRo"),o=n("V37c"),i=n("WKiH").trim,a=n("WJkJ"),s=r.parseInt,u=/^[+-]?0[Xx]/,c=8!==s(a+"08")||22!==s(a+"0x16");t.exports=c?function(t,e){var n=i(o(t));return s(n,e>>>0||(u.test(n)?16:10))}:s},x0AG:function(t,e,n){"use strict";var r=n("I+eb"),o=n("tycR").findIndex,i=n("RNIs"),a=!0;"findIndex"in[]&&Array(1).findIndex(function(){a=!1}),r({target:"Array",proto:!0,forced:a},{findIndex:function(t){return o(this,t,arguments.length>1?arguments[1]:void 0)}}),i("findIndex")},x2An:function(t,e,n){n("I+eb")({target:"Reflect",stat:!0},{has:function(t,e){return e in t}})},x83w:function(t,e,n){"use strict";var r=n("I+eb"),o=n("hXpO");r({target:"String",proto:!0,forced:n("rwPt")("fixed")},{fixed:function(){return o(this,"tt","","")}})},xDBR:function(t,e){t.exports=!1},xdBZ:function(t,e,n){"use strict";var r=n("I+eb"),o=n("hXpO");r({target:"String",proto:!0,forced:n("rwPt")("italics")},{italics:function(){return o(this,"i","","")}})},xg1e:function(t,e,n){var r=n("UTVS");t.exports=function(t){return void 0!==t&&(r(t,"value")||r(t,"writable"))}},xgco:function(t,e,n){var r=n("g6v/"),o=n("/OPJ"),i=n("m/L8").f,a=n("afO8").get,s=RegExp.prototype;r&&o&&i(s,"dotAll",{configurable:!0,get:function(){if(this!==s){if(this instanceof RegExp)return!!a(this).dotAll;throw TypeError("Incompatible receiver, RegExp required")}}})},xrYK:function(t,e){var n={}.toString;t.exports=function(t){return n.call(t).slice(8,-1)}},xs3f:function(t,e,n){var r=n("2oRo"),o=n("zk60"),i=r["__core-js_shared__"]||o("__core-js_shared__",{});t.exports=i},yNLB:function(t,e,n){var r=n("0Dky"),o=n("WJkJ");t.exports=function(t){return r(function(){return!!o[t]()||"\u200b\x85\u180e"!="\u200b\x85\u180e"[t]()||o[t].name!==t})}},yQYn:function(t,e,n){var r=n("I+eb"),o=n("0Dky"),i=n("hh1v"),a=Object.isExtensible;r({target:"Object",stat:!0,forced:o(function(){a(1)})},{isExtensible:function(t){return!!i(t)&&(!a||a(t))}})},yWo2:function(t,e,n){"use strict";var r=n("I+eb"),o=n("hXpO");r({target:"String",proto:!0,forced:n("rwPt")("small")},{small:function(){return o(this,"small","","")}})},yXV3:function(t,e,n){"use strict";var r=n("I+eb"),o=n("TWQb").indexOf,i=n("pkCn"),a=[].indexOf,s=!!a&&1/[1].indexOf(1,-0)<0,u=i("indexOf");r({target:"Array",proto:!0,forced:s||!u},{indexOf:function(t){return s?a.apply(this,arguments)||0:o(this,t,arguments.length>1?arguments[1]:void 0)}})},yiG3:function(t,e,n){n("I+eb")({target:"Math",stat:!0},{log1p:n("HsHA")})},yoRg:function(t,e,n){var r=n("UTVS"),o=n("/GqU"),i=n("TWQb").indexOf,a=n("0BK2");t.exports=function(t,e){var n,s=o(t),u=0,c=[];for(n in s)!r(a,n)&&r(s,n)&&c.push(n);for(;e.length>u;)r(s,n=e[u++])&&(~i(c,n)||c.push(n));return c}},ypFw:function(t,e,n){"use strict";var r=n("67WC"),o=n("1Y/n").left,i=r.aTypedArray;(0,r.exportTypedArrayMethod)("reduce",function(t){return o(i(this),t,arguments.length,arguments.length>1?arguments[1]:void 0)})},yq1k:function(t,e,n){"use strict";var r=n("I+eb"),o=n("TWQb").includes,i=n("RNIs");r({target:"Array",proto:!0},{includes:function(t){return o(this,t,arguments.length>1?arguments[1]:void 0)}}),i("includes")},yyme:function(t,e,n){var r=n("I+eb"),o=n("gdVl"),i=n("RNIs");r({target:"Array",proto:!0},{fill:o}),i("fill")},z8NH:function(t,e,n){n("dOgj")("Float32",function(t){return function(e,n,r){return t(this,e,n,r)}})},zBJ4:function(t,e,n){var r=n("2oRo"),o=n("hh1v"),i=r.document,a=o(i)&&o(i.createElement);t.exports=function(t){return a?i.createElement(t):{}}},zHFu:function(t,e,n){"use strict";var r=n("I+eb"),o=n("hXpO");r({target:"String",proto:!0,forced:n("rwPt")("bold")},{bold:function(){return o(this,"b","","")}})},zKZe:function(t,e,n){var r=n("I+eb"),o=n("YNrV");r({target:"Object",stat:!0,forced:Object.assign!==o},{assign:o})},zSZm:function(t,e,n){"use strict";var r=n("67WC"),o=r.aTypedArray,i=Math.floor;(0,r.exportTypedArrayMethod)("reverse",function(){for(var t,e=o(this).length,n=i(e/2),r=0;r<n;)t=this[r],this[r++]=this[--e],this[e]=t;return this})},zUnb:function(t,e,n){"use strict";n.r(e),n("LSZE");var r=function(t,e){return(r=Object.setPrototypeOf||{__proto__:[]}instanceof Array&&function(t,e){t.__proto__=e}||function(t,e){for(var n in e)e.hasOwnProperty(n)&&(t[n]=e[n])})(t,e)};function o(t,e){function n(){this.constructor=t}r(t,e),t.prototype=null===e?Object.create(e):(n.prototype=e.prototype,new n)}var i=function(){return(i=Object.assign||function(t){for(var e,n=1,r=arguments.length;n<r;n++)for(var o in e=arguments[n])Object.prototype.hasOwnProperty.call(e,o)&&(t[o]=e[o]);return t}).apply(this,arguments)};function a(t,e,n,r){var o,i=arguments.length,a=i<3?e:null===r?r=Object.getOwnPropertyDescriptor(e,n):r;if("object"==typeof Reflect&&"function"==typeof Reflect.decorate)a=Reflect.decorate(t,e,n,r);else for(var s=t.length-1;s>=0;s--)(o=t[s])&&(a=(i<3?o(a):i>3?o(e,n,a):o(e,n))||a);return i>3&&a&&Object.defineProperty(e,n,a),a}function s(t,e){if("object"==typeof Reflect&&"function"==typeof Reflect.metadata)return Reflect.metadata(t,e)}function u(t,e,n,r){return new(n||(n=Promise))(function(o,i){function a(t){try{u(r.next(t))}catch(e){i(e)}}function s(t){try{u(r.throw(t))}catch(e){i(e)}}function u(t){var e;t.done?o(t.value):(e=t.value,e instanceof n?e:new n(function(t){t(e)})).then(a,s)}u((r=r.apply(t,e||[])).next())})}function c(t,e){var n,r,o,i,a={label:0,sent:function(){if(1&o[0])throw o[1];return o[1]},trys:[],ops:[]};return i={next:s(0),throw:s(1),return:s(2)},"function"==typeof Symbol&&(i[Symbol.iterator]=function(){return this}),i;function s(i){return function(s){return function(i){if(n)throw new TypeError("Generator is already executing.");for(;a;)try{if(n=1,r&&(o=2&i[0]?r.return:i[0]?r.throw||((o=r.return)&&o.call(r),0):r.next)&&!(o=o.call(r,i[1])).done)return o;switch(r=0,o&&(i=[2&i[0],o.value]),i[0]){case 0:case 1:o=i;break;case 4:return a.label++,{value:i[1],done:!1};case 5:a.label++,r=i[1],i=[0];continue;case 7:i=a.ops.pop(),a.trys.pop();continue;default:if(!(o=(o=a.trys).length>0&&o[o.length-1])&&(6===i[0]||2===i[0])){a=0;continue}if(3===i[0]&&(!o||i[1]>o[0]&&i[1]<o[3])){a.label=i[1];break}if(6===i[0]&&a.label<o[1]){a.label=o[1],o=i;break}if(o&&a.label<o[2]){a.label=o[2],a.ops.push(i);break}o[2]&&a.ops.pop(),a.trys.pop();continue}i=e.call(t,a)}catch(s){i=[6,s],r=0}finally{n=o=0}if(5&i[0])throw i[1];return{value:i[0]?i[1]:void 0,done:!0}}([i,s])}}}function l(t){var e="function"==typeof Symbol&&Symbol.iterator,n=e&&t[e],r=0;if(n)return n.call(t);if(t&&"number"==typeof t.length)return{next:function(){return t&&r>=t.length&&(t=void 0),{value:t&&t[r++],done:!t}}};throw new TypeError(e?"Object is not iterable.":"Symbol.iterator is not defined.")}function f(t,e){var n="function"==typeof Symbol&&t[Symbol.iterator];if(!n)return t;var r,o,i=n.call(t),a=[];try{for(;(void 0===e||e-- >0)&&!(r=i.next()).done;)a.push(r.value)}catch(s){o={error:s}}finally{try{r&&!r.done&&(n=i.return)&&n.call(i)}finally{if(o)throw o.error}}return a}function p(){for(var t=[],e=0;e<arguments.length;e++)t=t.concat(f(arguments[e]));return t}var h=Array.isArray||function(t){return t&&"number"==typeof t.length};function d(t){return null!==t&&"object"==typeof t}function y(t){return"function"==typeof t}function v(t){return Error.call(this),this.message=t?t.length+" errors occurred during unsubscription:\n"+t.map(function(t,e){return e+1+") "+t.toString()}).join("\n  "):"",this.name="UnsubscriptionError",this.errors=t,this}v.prototype=Object.create(Error.prototype);var g=v,m=function(){function t(t){this.closed=!1,this._parent=null,this._parents=null,this._subscriptions=null,t&&(this._unsubscribe=t)}var e;return t.prototype.unsubscribe=function(){var t,e=!1;if(!this.closed){var n=this._parent,r=this._parents,o=this._unsubscribe,i=this._subscriptions;this.closed=!0,this._parent=null,this._parents=null,this._subscriptions=null;for(var a=-1,s=r?r.length:0;n;)n.remove(this),n=++a<s&&r[a]||null;if(y(o))try{o.call(this)}catch(c){e=!0,t=c instanceof g?b(c.errors):[c]}if(h(i))for(a=-1,s=i.length;++a<s;){var u=i[a];if(d(u))try{u.unsubscribe()}catch(c){e=!0,t=t||[],c instanceof g?t=t.concat(b(c.errors)):t.push(c)}}if(e)throw new g(t)}},t.prototype.add=function(e){var n=e;switch(typeof e){case"function":n=new t(e);case"object":if(n===this||n.closed||"function"!=typeof n.unsubscribe)return n;if(this.closed)return n.unsubscribe(),n;if(!(n instanceof t)){var r=n;(n=new t)._subscriptions=[r]}break;default:if(!e)return t.EMPTY;throw new Error("unrecognized teardown "+e+" added to Subscription.")}if(n._addParent(this)){var o=this._subscriptions;o?o.push(n):this._subscriptions=[n]}return n},t.prototype.remove=function(t){var e=this._subscriptions;if(e){var n=e.indexOf(t);-1!==n&&e.splice(n,1)}},t.prototype._addParent=function(t){var e=this._parent,n=this._parents;return e!==t&&(e?n?-1===n.indexOf(t)&&(n.push(t),!0):(this._parents=[t],!0):(this._parent=t,!0))},t.EMPTY=((e=new t).closed=!0,e),t}();function b(t){return t.reduce(function(t,e){return t.concat(e instanceof g?e.errors:e)},[])}var _=!1,w={Promise:void 0,set useDeprecatedSynchronousErrorHandling(t){_=t},get useDeprecatedSynchronousErrorHandling(){return _}};function E(t){setTimeout(function(){throw t})}var S={closed:!0,next:function(t){},error:function(t){if(w.useDeprecatedSynchronousErrorHandling)throw t;E(t)},complete:function(){}},x="function"==typeof Symbol?Symbol("rxSubscriber"):"@@rxSubscriber_"+Math.random(),T=function(t){function e(n,r,o){var i=t.call(this)||this;switch(i.syncErrorValue=null,i.syncErrorThrown=!1,i.syncErrorThrowable=!1,i.isStopped=!1,arguments.length){case 0:i.destination=S;break;case 1:if(!n){i.destination=S;break}if("object"==typeof n){n instanceof e?(i.syncErrorThrowable=n.syncErrorThrowable,i.destination=n,n.add(i)):(i.syncErrorThrowable=!0,i.destination=new k(i,n));break}default:i.syncErrorThrowable=!0,i.destination=new k(i,n,r,o)}return i}return o(e,t),e.prototype[x]=function(){return this},e.create=function(t,n,r){var o=new e(t,n,r);return o.syncErrorThrowable=!1,o},e.prototype.next=function(t){this.isStopped||this._next(t)},e.prototype.error=function(t){this.isStopped||(this.isStopped=!0,this._error(t))},e.prototype.complete=function(){this.isStopped||(this.isStopped=!0,this._complete())},e.prototype.unsubscribe=function(){this.closed||(this.isStopped=!0,t.prototype.unsubscribe.call(this))},e.prototype._next=function(t){this.destination.next(t)},e.prototype._error=function(t){this.destination.error(t),this.unsubscribe()},e.prototype._complete=function(){this.destination.complete(),this.unsubscribe()},e.prototype._unsubscribeAndRecycle=function(){var t=this._parent,e=this._parents;return this._parent=null,this._parents=null,this.unsubscribe(),this.closed=!1,this.isStopped=!1,this._parent=t,this._parents=e,this},e}(m),k=function(t){function e(e,n,r,o){var i,a=t.call(this)||this;a._parentSubscriber=e;var s=a;return y(n)?i=n:n&&(i=n.next,r=n.error,o=n.complete,n!==S&&(y((s=Object.create(n)).unsubscribe)&&a.add(s.unsubscribe.bind(s)),s.unsubscribe=a.unsubscribe.bind(a))),a._context=s,a._next=i,a._error=r,a._complete=o,a}return o(e,t),e.prototype.next=function(t){if(!this.isStopped&&this._next){var e=this._parentSubscriber;w.useDeprecatedSynchronousErrorHandling&&e.syncErrorThrowable?this.__tryOrSetError(e,this._next,t)&&this.unsubscribe():this.__tryOrUnsub(this._next,t)}},e.prototype.error=function(t){if(!this.isStopped){var e=this._parentSubscriber,n=w.useDeprecatedSynchronousErrorHandling;if(this._error)n&&e.syncErrorThrowable?(this.__tryOrSetError(e,this._error,t),this.unsubscribe()):(this.__tryOrUnsub(this._error,t),this.unsubscribe());else if(e.syncErrorThrowable)n?(e.syncErrorValue=t,e.syncErrorThrown=!0):E(t),this.unsubscribe();else{if(this.unsubscribe(),n)throw t;E(t)}}},e.prototype.complete=function(){var t=this;if(!this.isStopped){var e=this._parentSubscriber;if(this._complete){var n=function(){return t._complete.call(t._context)};w.useDeprecatedSynchronousErrorHandling&&e.syncErrorThrowable?(this.__tryOrSetError(e,n),this.unsubscribe()):(this.__tryOrUnsub(n),this.unsubscribe())}else this.unsubscribe()}},e.prototype.__tryOrUnsub=function(t,e){try{t.call(this._context,e)}catch(n){if(this.unsubscribe(),w.useDeprecatedSynchronousErrorHandling)throw n;E(n)}},e.prototype.__tryOrSetError=function(t,e,n){if(!w.useDeprecatedSynchronousErrorHandling)throw new Error("bad call");try{e.call(this._context,n)}catch(r){return w.useDeprecatedSynchronousErrorHandling?(t.syncErrorValue=r,t.syncErrorThrown=!0,!0):(E(r),!0)}return!1},e.prototype._unsubscribe=function(){var t=this._parentSubscriber;this._context=null,this._parentSubscriber=null,t.unsubscribe()},e}(T),A="function"==typeof Symbol&&Symbol.observable||"@@observable";var I=function(){function t(t){this._isScalar=!1,t&&(this._subscribe=t)}return t.prototype.lift=function(e){var n=new t;return n.source=this,n.operator=e,n},t.prototype.subscribe=function(t,e,n){var r=this.operator,o=function(t,e,n){if(t){if(t instanceof T)return t;if(t[x])return t[x]()}return t||e||n?new T(t,e,n):new T(S)}(t,e,n);if(o.add(r?r.call(o,this.source):this.source||w.useDeprecatedSynchronousErrorHandling&&!o.syncErrorThrowable?this._subscribe(o):this._trySubscribe(o)),w.useDeprecatedSynchronousErrorHandling&&o.syncErrorThrowable&&(o.syncErrorThrowable=!1,o.syncErrorThrown))throw o.syncErrorValue;return o},t.prototype._trySubscribe=function(t){try{return this._subscribe(t)}catch(e){w.useDeprecatedSynchronousErrorHandling&&(t.syncErrorThrown=!0,t.syncErrorValue=e),function(t){for(;t;){var e=t.destination;if(t.closed||t.isStopped)return!1;t=e&&e instanceof T?e:null}return!0}(t)?t.error(e):console.warn(e)}},t.prototype.forEach=function(t,e){var n=this;return new(e=C(e))(function(e,r){var o;o=n.subscribe(function(e){try{t(e)}catch(n){r(n),o&&o.unsubscribe()}},r,e)})},t.prototype._subscribe=function(t){var e=this.source;return e&&e.subscribe(t)},t.prototype[A]=function(){return this},t.prototype.pipe=function(){for(var t=[],e=0;e<arguments.length;e++)t[e]=arguments[e];return 0===t.length?this:((n=t)?1===n.length?n[0]:function(t){return n.reduce(function(t,e){return e(t)},t)}:function(){})(this);var n},t.prototype.toPromise=function(t){var e=this;return new(t=C(t))(function(t,n){var r;e.subscribe(function(t){return r=t},function(t){return n(t)},function(){return t(r)})})},t.create=function(e){return new t(e)},t}();function C(t){if(t||(t=w.Promise||Promise),!t)throw new Error("no Promise impl found");return t}function O(){return Error.call(this),this.message="object unsubscribed",this.name="ObjectUnsubscribedError",this}O.prototype=Object.create(Error.prototype);var P=O,R=function(t){function e(e,n){var r=t.call(this)||this;return r.subject=e,r.subscriber=n,r.closed=!1,r}return o(e,t),e.prototype.unsubscribe=function(){if(!this.closed){this.closed=!0;var t=this.subject,e=t.observers;if(this.subject=null,e&&0!==e.length&&!t.isStopped&&!t.closed){var n=e.indexOf(this.subscriber);-1!==n&&e.splice(n,1)}}},e}(m),D=function(t){function e(e){var n=t.call(this,e)||this;return n.destination=e,n}return o(e,t),e}(T),N=function(t){function e(){var e=t.call(this)||this;return e.observers=[],e.closed=!1,e.isStopped=!1,e.hasError=!1,e.thrownError=null,e}return o(e,t),e.prototype[x]=function(){return new D(this)},e.prototype.lift=function(t){var e=new M(this,this);return e.operator=t,e},e.prototype.next=function(t){if(this.closed)throw new P;if(!this.isStopped)for(var e=this.observers,n=e.length,r=e.slice(),o=0;o<n;o++)r[o].next(t)},e.prototype.error=function(t){if(this.closed)throw new P;this.hasError=!0,this.thrownError=t,this.isStopped=!0;for(var e=this.observers,n=e.length,r=e.slice(),o=0;o<n;o++)r[o].error(t);this.observers.length=0},e.prototype.complete=function(){if(this.closed)throw new P;this.isStopped=!0;for(var t=this.observers,e=t.length,n=t.slice(),r=0;r<e;r++)n[r].complete();this.observers.length=0},e.prototype.unsubscribe=function(){this.isStopped=!0,this.closed=!0,this.observers=null},e.prototype._trySubscribe=function(e){if(this.closed)throw new P;return t.prototype._trySubscribe.call(this,e)},e.prototype._subscribe=function(t){if(this.closed)throw new P;return this.hasError?(t.error(this.thrownError),m.EMPTY):this.isStopped?(t.complete(),m.EMPTY):(this.observers.push(t),new R(this,t))},e.prototype.asObservable=function(){var t=new I;return t.source=this,t},e.create=function(t,e){return new M(t,e)},e}(I),M=function(t){function e(e,n){var r=t.call(this)||this;return r.destination=e,r.source=n,r}return o(e,t),e.prototype.next=function(t){var e=this.destination;e&&e.next&&e.next(t)},e.prototype.error=function(t){var e=this.destination;e&&e.error&&this.destination.error(t)},e.prototype.complete=function(){var t=this.destination;t&&t.complete&&this.destination.complete()},e.prototype._subscribe=function(t){return this.source?this.source.subscribe(t):m.EMPTY},e}(N),j=function(t){function e(e,n,r){var o=t.call(this)||this;return o.parent=e,o.outerValue=n,o.outerIndex=r,o.index=0,o}return o(e,t),e.prototype._next=function(t){this.parent.notifyNext(this.outerValue,t,this.outerIndex,this.index++,this)},e.prototype._error=function(t){this.parent.notifyError(t,this),this.unsubscribe()},e.prototype._complete=function(){this.parent.notifyComplete(this),this.unsubscribe()},e}(T),F=function(t){return function(e){for(var n=0,r=t.length;n<r&&!e.closed;n++)e.next(t[n]);e.closed||e.complete()}},L=function(t){return function(e){return t.then(function(t){e.closed||(e.next(t),e.complete())},function(t){return e.error(t)}).then(null,E),e}};function B(){return"function"==typeof Symbol&&Symbol.iterator?Symbol.iterator:"@@iterator"}var V=B(),H=function(t){return function(e){for(var n=t[V]();;){var r=n.next();if(r.done){e.complete();break}if(e.next(r.value),e.closed)break}return"function"==typeof n.return&&e.add(function(){n.return&&n.return()}),e}},z=function(t){return function(e){var n=t[A]();if("function"!=typeof n.subscribe)throw new TypeError("Provided object does not correctly implement Symbol.observable");return n.subscribe(e)}},U=function(t){return t&&"number"==typeof t.length&&"function"!=typeof t};function W(t){return!!t&&"function"!=typeof t.subscribe&&"function"==typeof t.then}var Z=function(t){if(t instanceof I)return function(e){return t._isScalar?(e.next(t.value),void e.complete()):t.subscribe(e)};if(t&&"function"==typeof t[A])return z(t);if(U(t))return F(t);if(W(t))return L(t);if(t&&"function"==typeof t[V])return H(t);var e=d(t)?"an invalid object":"'"+t+"'";throw new TypeError("You provided "+e+" where a stream was expected. You can provide an Observable, Promise, Array, or Iterable.")},q=function(t){function e(){return null!==t&&t.apply(this,arguments)||this}return o(e,t),e.prototype.notifyNext=function(t,e,n,r,o){this.destination.next(e)},e.prototype.notifyError=function(t,e){this.destination.error(t)},e.prototype.notifyComplete=function(t){this.destination.complete()},e}(T),G=function(){function t(t,e){this.project=t,this.thisArg=e}return t.prototype.call=function(t,e){return e.subscribe(new K(t,this.project,this.thisArg))},t}(),K=function(t){function e(e,n,r){var o=t.call(this,e)||this;return o.project=n,o.count=0,o.thisArg=r||o,o}return o(e,t),e.prototype._next=function(t){var e;try{e=this.project.call(this.thisArg,t,this.count++)}catch(n){return void this.destination.error(n)}this.destination.next(e)},e}(T);function Q(t,e){return new I(e?function(n){var r=new m,o=0;return r.add(e.schedule(function(){o!==t.length?(n.next(t[o++]),n.closed||r.add(this.schedule())):n.complete()})),r}:F(t))}var Y=function(){function t(t,e){void 0===e&&(e=Number.POSITIVE_INFINITY),this.project=t,this.concurrent=e}return t.prototype.call=function(t,e){return e.subscribe(new X(t,this.project,this.concurrent))},t}(),X=function(t){function e(e,n,r){void 0===r&&(r=Number.POSITIVE_INFINITY);var o=t.call(this,e)||this;return o.project=n,o.concurrent=r,o.hasCompleted=!1,o.buffer=[],o.active=0,o.index=0,o}return o(e,t),e.prototype._next=function(t){this.active<this.concurrent?this._tryNext(t):this.buffer.push(t)},e.prototype._tryNext=function(t){var e,n=this.index++;try{e=this.project(t,n)}catch(r){return void this.destination.error(r)}this.active++,this._innerSub(e,t,n)},e.prototype._innerSub=function(t,e,n){var r,o,i=new j(this,void 0,void 0);this.destination.add(i),r=t,void 0===(o=i)&&(o=new j(this,e,n)),o.closed||Z(r)(o)},e.prototype._complete=function(){this.hasCompleted=!0,0===this.active&&0===this.buffer.length&&this.destination.complete(),this.unsubscribe()},e.prototype.notifyNext=function(t,e,n,r,o){this.destination.next(e)},e.prototype.notifyComplete=function(t){var e=this.buffer;this.remove(t),this.active--,e.length>0?this._next(e.shift()):0===this.active&&this.hasCompleted&&this.destination.complete()},e}(q);function J(t){return t}function $(){return function(t){return t.lift(new tt(t))}}var tt=function(){function t(t){this.connectable=t}return t.prototype.call=function(t,e){var n=this.connectable;n._refCount++;var r=new et(t,n),o=e.subscribe(r);return r.closed||(r.connection=n.connect()),o},t}(),et=function(t){function e(e,n){var r=t.call(this,e)||this;return r.connectable=n,r}return o(e,t),e.prototype._unsubscribe=function(){var t=this.connectable;if(t){this.connectable=null;var e=t._refCount;if(e<=0)this.connection=null;else if(t._refCount=e-1,e>1)this.connection=null;else{var n=this.connection,r=t._connection;this.connection=null,!r||n&&r!==n||r.unsubscribe()}}else this.connection=null},e}(T),nt=function(t){function e(e,n){var r=t.call(this)||this;return r.source=e,r.subjectFactory=n,r._refCount=0,r._isComplete=!1,r}return o(e,t),e.prototype._subscribe=function(t){return this.getSubject().subscribe(t)},e.prototype.getSubject=function(){var t=this._subject;return t&&!t.isStopped||(this._subject=this.subjectFactory()),this._subject},e.prototype.connect=function(){var t=this._connection;return t||(this._isComplete=!1,(t=this._connection=new m).add(this.source.subscribe(new ot(this.getSubject(),this))),t.closed?(this._connection=null,t=m.EMPTY):this._connection=t),t},e.prototype.refCount=function(){return $()(this)},e}(I).prototype,rt={operator:{value:null},_refCount:{value:0,writable:!0},_subject:{value:null,writable:!0},_connection:{value:null,writable:!0},_subscribe:{value:nt._subscribe},_isComplete:{value:nt._isComplete,writable:!0},getSubject:{value:nt.getSubject},connect:{value:nt.connect},refCount:{value:nt.refCount}},ot=function(t){function e(e,n){var r=t.call(this,e)||this;return r.connectable=n,r}return o(e,t),e.prototype._error=function(e){this._unsubscribe(),t.prototype._error.call(this,e)},e.prototype._complete=function(){this.connectable._isComplete=!0,this._unsubscribe(),t.prototype._complete.call(this)},e.prototype._unsubscribe=function(){var t=this.connectable;if(t){this.connectable=null;var e=t._connection;t._refCount=0,t._subject=null,t._connection=null,e&&e.unsubscribe()}},e}(D);function it(){return new N}var at="__parameters__";function st(t,e,n){var r=function(t){return function(){for(var e=[],n=0;n<arguments.length;n++)e[n]=arguments[n];if(t){var r=t.apply(void 0,p(e));for(var o in r)this[o]=r[o]}}}(e);function o(){for(var t,e=[],n=0;n<arguments.length;n++)e[n]=arguments[n];if(this instanceof o)return r.apply(this,e),this;var i=new((t=o).bind.apply(t,p([void 0],e)));return a.annotation=i,a;function a(t,e,n){for(var r=t.hasOwnProperty(at)?t[at]:Object.defineProperty(t,at,{value:[]})[at];r.length<=n;)r.push(null);return(r[n]=r[n]||[]).push(i),t}}return n&&(o.prototype=Object.create(n.prototype)),o.prototype.ngMetadataName=t,o.annotationCls=o,o}var ut=st("Inject",function(t){return{token:t}}),ct=st("Optional"),lt=st("Self"),ft=st("SkipSelf"),pt=function(t){return t[t.Default=0]="Default",t[t.Host=1]="Host",t[t.Self=2]="Self",t[t.SkipSelf=4]="SkipSelf",t[t.Optional=8]="Optional",t}({});function ht(t){for(var e in t)if(t[e]===ht)return e;throw Error("Could not find renamed property on target object.")}function dt(t){return{providedIn:t.providedIn||null,factory:t.factory,value:void 0}}function yt(t){return t&&t.hasOwnProperty(vt)?t[vt]:null}var vt=ht({ngInjectableDef:ht});function gt(t){if("string"==typeof t)return t;if(t instanceof Array)return"["+t.map(gt).join(", ")+"]";if(null==t)return""+t;if(t.overriddenName)return""+t.overriddenName;if(t.name)return""+t.name;var e=t.toString();if(null==e)return""+e;var n=e.indexOf("\n");return-1===n?e:e.substring(0,n)}var mt=ht({__forward_ref__:ht});function bt(t){return t.__forward_ref__=bt,t.toString=function(){return gt(this())},t}function _t(t){var e=t;return"function"==typeof e&&e.hasOwnProperty(mt)&&e.__forward_ref__===bt?e():t}function wt(){var t="undefined"!=typeof globalThis&&globalThis,e="undefined"!=typeof window&&window,n="undefined"!=typeof self&&"undefined"!=typeof WorkerGlobalScope&&self instanceof WorkerGlobalScope&&self,r="undefined"!=typeof global&&global;return t||r||e||n}var Et,St=wt(),xt=void 0;function Tt(t){var e=xt;return xt=t,e}var kt=function(){function t(t,e){this._desc=t,this.ngMetadataName="InjectionToken",this.ngInjectableDef=void 0,"number"==typeof e?this.__NG_ELEMENT_ID__=e:void 0!==e&&(this.ngInjectableDef=dt({providedIn:e.providedIn||"root",factory:e.factory}))}return t.prototype.toString=function(){return"InjectionToken "+this._desc},t}(),At="__source",It=new Object,Ct=new kt("INJECTOR",-1),Ot=function(){function t(){}return t.prototype.get=function(t,e){if(void 0===e&&(e=It),e===It){var n=new Error("NullInjectorError: No provider for "+gt(t)+"!");throw n.name="NullInjectorError",n}return e},t}(),Pt=function(){function t(){}return t.create=function(t,e){return Array.isArray(t)?new Bt(t,e):new Bt(t.providers,t.parent,t.name||null)},t.THROW_IF_NOT_FOUND=It,t.NULL=new Ot,t.ngInjectableDef=dt({providedIn:"any",factory:function(){return void 0===t&&(t=pt.Default),(Et||function(t,e){if(void 0===e&&(e=pt.Default),void 0===xt)throw new Error("inject() must be called from an injection context");return null===xt?function(t,e,n){var r=yt(t);if(r&&"root"==r.providedIn)return void 0===r.value?r.value=r.factory():r.value;if(n&pt.Optional)return null;throw new Error("Injector: NOT_FOUND ["+gt(t)+"]")}(t,0,e):xt.get(t,e&pt.Optional?null:void 0,e)})(Ct,t);var t}}),t.__NG_ELEMENT_ID__=-1,t}(),Rt=function(t){return t},Dt=[],Nt=Rt,Mt=function(){return Array.prototype.slice.call(arguments)},jt=ht({provide:String,useValue:ht}),Ft=/\n/gm,Lt="\u0275",Bt=function(){function t(t,e,n){void 0===e&&(e=Pt.NULL),void 0===n&&(n=null),this.parent=e,this.source=n;var r=this._records=new Map;r.set(Pt,{token:Pt,fn:Rt,deps:Dt,value:this,useNew:!1}),r.set(Ct,{token:Ct,fn:Rt,deps:Dt,value:this,useNew:!1}),function t(e,n){if(n)if((n=_t(n))instanceof Array)for(var r=0;r<n.length;r++)t(e,n[r]);else{if("function"==typeof n)throw zt("Function/Class not supported",n);if(!n||"object"!=typeof n||!n.provide)throw zt("Unexpected provider",n);var o=_t(n.provide),i=function(t){var e=function(t){var e=Dt,n=t.deps;if(n&&n.length){e=[];for(var r=0;r<n.length;r++){var o=6;if((u=_t(n[r]))instanceof Array)for(var i=0,a=u;i<a.length;i++){var s=a[i];s instanceof ct||s==ct?o|=1:s instanceof ft||s==ft?o&=-3:s instanceof lt||s==lt?o&=-5:u=s instanceof ut?s.token:_t(s)}e.push({token:u,options:o})}}else if(t.useExisting){var u;e=[{token:u=_t(t.useExisting),options:6}]}else if(!(n||jt in t))throw zt("'deps' required",t);return e}(t),n=Rt,r=Dt,o=!1,i=_t(t.provide);if(jt in t)r=t.useValue;else if(t.useFactory)n=t.useFactory;else if(t.useExisting);else if(t.useClass)o=!0,n=_t(t.useClass);else{if("function"!=typeof i)throw zt("StaticProvider does not have [useValue|useFactory|useExisting|useClass] or [provide] is not newable",t);o=!0,n=i}return{deps:e,fn:n,useNew:o,value:r}}(n);if(!0===n.multi){var a=e.get(o);if(a){if(a.fn!==Mt)throw Vt(o)}else e.set(o,a={token:n.provide,deps:[],useNew:!1,fn:Mt,value:Dt});a.deps.push({token:o=n,options:6})}var s=e.get(o);if(s&&s.fn==Mt)throw Vt(o);e.set(o,i)}}(r,t)}return t.prototype.get=function(t,e,n){void 0===n&&(n=pt.Default);var r=this._records.get(t);try{return function t(e,n,r,o,i,a){try{return function(e,n,r,o,i,a){var s,u;if(!n||a&pt.SkipSelf)a&pt.Self||(u=o.get(e,i,pt.Default));else{if((u=n.value)==Nt)throw Error(Lt+"Circular dependency");if(u===Dt){n.value=Nt;var c=n.useNew,l=n.fn,f=n.deps,h=Dt;if(f.length){h=[];for(var d=0;d<f.length;d++){var y=f[d],v=y.options,g=2&v?r.get(y.token):void 0;h.push(t(y.token,g,r,g||4&v?o:Pt.NULL,1&v?null:Pt.THROW_IF_NOT_FOUND,pt.Default))}}n.value=u=c?new((s=l).bind.apply(s,p([void 0],h))):l.apply(void 0,h)}}return u}(e,n,r,o,i,a)}catch(s){throw s instanceof Error||(s=new Error(s)),(s.ngTempTokenPath=s.ngTempTokenPath||[]).unshift(e),n&&n.value==Nt&&(n.value=Dt),s}}(t,r,this._records,this.parent,e,n)}catch(o){return function(t,e,n,r){var o=t.ngTempTokenPath;throw e[At]&&o.unshift(e[At]),t.message=Ht("\n"+t.message,o,"StaticInjectorError",r),t.ngTokenPath=o,t.ngTempTokenPath=null,t}(o,t,0,this.source)}},t.prototype.toString=function(){var t=[];return this._records.forEach(function(e,n){return t.push(gt(n))}),"StaticInjector["+t.join(", ")+"]"},t}();function Vt(t){return zt("Cannot mix multi providers and regular providers",t)}function Ht(t,e,n,r){void 0===r&&(r=null),t=t&&"\n"===t.charAt(0)&&t.charAt(1)==Lt?t.substr(2):t;var o=gt(e);if(e instanceof Array)o=e.map(gt).join(" -> ");else if("object"==typeof e){var i=[];for(var a in e)if(e.hasOwnProperty(a)){var s=e[a];i.push(a+":"+("string"==typeof s?JSON.stringify(s):gt(s)))}o="{"+i.join(", ")+"}"}return n+(r?"("+r+")":"")+"["+o+"]: "+t.replace(Ft,"\n  ")}function zt(t,e){return new Error(Ht(t,e,"StaticInjectorError"))}var Ut="ngDebugContext",Wt="ngOriginalError",Zt="ngErrorLogger",qt=function(t){return t[t.Emulated=0]="Emulated",t[t.Native=1]="Native",t[t.None=2]="None",t[t.ShadowDom=3]="ShadowDom",t}({}),Gt=function(){return("undefined"!=typeof requestAnimationFrame&&requestAnimationFrame||setTimeout).bind(St)}();function Kt(t){return t[Ut]}function Qt(t){return t[Wt]}function Yt(t){for(var e=[],n=1;n<arguments.length;n++)e[n-1]=arguments[n];t.error.apply(t,p(e))}var Xt=function(){function t(){this._console=console}return t.prototype.handleError=function(t){var e=this._findOriginalError(t),n=this._findContext(t),r=function(t){return t[Zt]||Yt}(t);r(this._console,"ERROR",t),e&&r(this._console,"ORIGINAL ERROR",e),n&&r(this._console,"ERROR CONTEXT",n)},t.prototype._findContext=function(t){return t?Kt(t)?Kt(t):this._findContext(Qt(t)):null},t.prototype._findOriginalError=function(t){for(var e=Qt(t);e&&Qt(e);)e=Qt(e);return e},t}(),Jt=!0,$t=!1;function te(){return $t=!0,Jt}var ee=function(){function t(t){if(this.defaultDoc=t,this.inertDocument=this.defaultDoc.implementation.createHTMLDocument("sanitization-inert"),this.inertBodyElement=this.inertDocument.body,null==this.inertBodyElement){var e=this.inertDocument.createElement("html");this.inertDocument.appendChild(e),this.inertBodyElement=this.inertDocument.createElement("body"),e.appendChild(this.inertBodyElement)}this.inertBodyElement.innerHTML='<svg><g onload="this.parentNode.remove()"></g></svg>',!this.inertBodyElement.querySelector||this.inertBodyElement.querySelector("svg")?(this.inertBodyElement.innerHTML='<svg><p><style><img src="</style><img src=x onerror=alert(1)//">',this.getInertBodyElement=this.inertBodyElement.querySelector&&this.inertBodyElement.querySelector("svg img")&&function(){try{return!!window.DOMParser}catch(t){return!1}}()?this.getInertBodyElement_DOMParser:this.getInertBodyElement_InertDocument):this.getInertBodyElement=this.getInertBodyElement_XHR}return t.prototype.getInertBodyElement_XHR=function(t){t="<body><remove></remove>"+t+"</body>";try{t=encodeURI(t)}catch(r){return null}var e=new XMLHttpRequest;e.responseType="document",e.open("GET","data:text/html;charset=utf-8,"+t,!1),e.send(void 0);var n=e.response.body;return n.removeChild(n.firstChild),n},t.prototype.getInertBodyElement_DOMParser=function(t){t="<body><remove></remove>"+t+"</body>";try{var e=(new window.DOMParser).parseFromString(t,"text/html").body;return e.removeChild(e.firstChild),e}catch(n){return null}},t.prototype.getInertBodyElement_InertDocument=function(t){var e=this.inertDocument.createElement("template");return"content"in e?(e.innerHTML=t,e):(this.inertBodyElement.innerHTML=t,this.defaultDoc.documentMode&&this.stripCustomNsAttrs(this.inertBodyElement),this.inertBodyElement)},t.prototype.stripCustomNsAttrs=function(t){for(var e=t.attributes,n=e.length-1;0<n;n--){var r=e.item(n).name;"xmlns:ns1"!==r&&0!==r.indexOf("ns1:")||t.removeAttribute(r)}for(var o=t.firstChild;o;)o.nodeType===Node.ELEMENT_NODE&&this.stripCustomNsAttrs(o),o=o.nextSibling},t}(),ne=/^(?:(?:https?|mailto|ftp|tel|file):|[^&:/?#]*(?:[/?#]|$))/gi,re=/^data:(?:image\/(?:bmp|gif|jpeg|jpg|png|tiff|webp)|video\/(?:mpeg|mp4|ogg|webm)|audio\/(?:mp3|oga|ogg|opus));base64,[a-z0-9+\/]+=*$/i;function oe(t){return(t=String(t)).match(ne)||t.match(re)?t:(te()&&console.warn("WARNING: sanitizing unsafe URL value "+t+" (see http://g.co/ng/security#xss)"),"unsafe:"+t)}function ie(t){var e,n,r={};try{for(var o=l(t.split(",")),i=o.next();!i.done;i=o.next())r[i.value]=!0}catch(a){e={error:a}}finally{try{i&&!i.done&&(n=o.return)&&n.call(o)}finally{if(e)throw e.error}}return r}function ae(){for(var t,e,n=[],r=0;r<arguments.length;r++)n[r]=arguments[r];var o={};try{for(var i=l(n),a=i.next();!a.done;a=i.next()){var s=a.value;for(var u in s)s.hasOwnProperty(u)&&(o[u]=!0)}}catch(c){t={error:c}}finally{try{a&&!a.done&&(e=i.return)&&e.call(i)}finally{if(t)throw t.error}}return o}var se,ue=ie("area,br,col,hr,img,wbr"),ce=ie("colgroup,dd,dt,li,p,tbody,td,tfoot,th,thead,tr"),le=ie("rp,rt"),fe=ae(le,ce),pe=ae(ue,ae(ce,ie("address,article,aside,blockquote,caption,center,del,details,dialog,dir,div,dl,figure,figcaption,footer,h1,h2,h3,h4,h5,h6,header,hgroup,hr,ins,main,map,menu,nav,ol,pre,section,summary,table,ul")),ae(le,ie("a,abbr,acronym,audio,b,bdi,bdo,big,br,cite,code,del,dfn,em,font,i,img,ins,kbd,label,map,mark,picture,q,ruby,rp,rt,s,samp,small,source,span,strike,strong,sub,sup,time,track,tt,u,var,video")),fe),he=ie("background,cite,href,itemtype,longdesc,poster,src,xlink:href"),de=ie("srcset"),ye=ae(he,de,ie("abbr,accesskey,align,alt,autoplay,axis,bgcolor,border,cellpadding,cellspacing,class,clear,color,cols,colspan,compact,controls,coords,datetime,default,dir,download,face,headers,height,hidden,hreflang,hspace,ismap,itemscope,itemprop,kind,label,lang,language,loop,media,muted,nohref,nowrap,open,preload,rel,rev,role,rows,rowspan,rules,scope,scrolling,shape,size,sizes,span,srclang,start,summary,tabindex,target,title,translate,type,usemap,valign,value,vspace,width"),ie("aria-activedescendant,aria-atomic,aria-autocomplete,aria-busy,aria-checked,aria-colcount,aria-colindex,aria-colspan,aria-controls,aria-current,aria-describedby,aria-details,aria-disabled,aria-dropeffect,aria-errormessage,aria-expanded,aria-flowto,aria-grabbed,aria-haspopup,aria-hidden,aria-invalid,aria-keyshortcuts,aria-label,aria-labelledby,aria-level,aria-live,aria-modal,aria-multiline,aria-multiselectable,aria-orientation,aria-owns,aria-placeholder,aria-posinset,aria-pressed,aria-readonly,aria-relevant,aria-required,aria-roledescription,aria-rowcount,aria-rowindex,aria-rowspan,aria-selected,aria-setsize,aria-sort,aria-valuemax,aria-valuemin,aria-valuenow,aria-valuetext")),ve=ie("script,style,template"),ge=function(){function t(){this.sanitizedSomething=!1,this.buf=[]}return t.prototype.sanitizeChildren=function(t){for(var e=t.firstChild,n=!0;e;)if(e.nodeType===Node.ELEMENT_NODE?n=this.startElement(e):e.nodeType===Node.TEXT_NODE?this.chars(e.nodeValue):this.sanitizedSomething=!0,n&&e.firstChild)e=e.firstChild;else for(;e;){e.nodeType===Node.ELEMENT_NODE&&this.endElement(e);var r=this.checkClobberedElement(e,e.nextSibling);if(r){e=r;break}e=this.checkClobberedElement(e,e.parentNode)}return this.buf.join("")},t.prototype.startElement=function(t){var e,n=t.nodeName.toLowerCase();if(!pe.hasOwnProperty(n))return this.sanitizedSomething=!0,!ve.hasOwnProperty(n);this.buf.push("<"),this.buf.push(n);for(var r=t.attributes,o=0;o<r.length;o++){var i=r.item(o),a=i.name,s=a.toLowerCase();if(ye.hasOwnProperty(s)){var u=i.value;he[s]&&(u=oe(u)),de[s]&&(e=u,u=(e=String(e)).split(",").map(function(t){return oe(t.trim())}).join(", ")),this.buf.push(" ",a,'="',_e(u),'"')}else this.sanitizedSomething=!0}return this.buf.push(">"),!0},t.prototype.endElement=function(t){var e=t.nodeName.toLowerCase();pe.hasOwnProperty(e)&&!ue.hasOwnProperty(e)&&(this.buf.push("</"),this.buf.push(e),this.buf.push(">"))},t.prototype.chars=function(t){this.buf.push(_e(t))},t.prototype.checkClobberedElement=function(t,e){if(e&&(t.compareDocumentPosition(e)&Node.DOCUMENT_POSITION_CONTAINED_BY)===Node.DOCUMENT_POSITION_CONTAINED_BY)throw new Error("Failed to sanitize html because the element is clobbered: "+t.outerHTML);return e},t}(),me=/[\uD800-\uDBFF][\uDC00-\uDFFF]/g,be=/([^\#-~ |!])/g;function _e(t){return t.replace(/&/g,"&amp;").replace(me,function(t){return"&#"+(1024*(t.charCodeAt(0)-55296)+(t.charCodeAt(1)-56320)+65536)+";"}).replace(be,function(t){return"&#"+t.charCodeAt(0)+";"}).replace(/</g,"&lt;").replace(/>/g,"&gt;")}function we(t){return"content"in t&&function(t){return t.nodeType===Node.ELEMENT_NODE&&"TEMPLATE"===t.nodeName}(t)?t.content:null}var Ee=function(t){return t[t.NONE=0]="NONE",t[t.HTML=1]="HTML",t[t.STYLE=2]="STYLE",t[t.SCRIPT=3]="SCRIPT",t[t.URL=4]="URL",t[t.RESOURCE_URL=5]="RESOURCE_URL",t}({}),Se=function(){return function(){}}(),xe=new RegExp("^([-,.\"'%_!# a-zA-Z0-9]+|(?:(?:matrix|translate|scale|rotate|skew|perspective)(?:X|Y|3d)?|(?:rgb|hsl)a?|(?:repeating-)?(?:linear|radial)-gradient|(?:calc|attr))\\([-0-9.%, #a-zA-Z]+\\))$","g"),Te=/^url\(([^)]+)\)$/,ke=/([A-Z])/g;function Ae(t){try{return null!=t?t.toString().slice(0,30):t}catch(e){return"[ERROR] Exception while trying to serialize the value"}}function Ie(t){return!!t&&"function"==typeof t.then}var Ce=null;function Oe(){if(!Ce){var t=St.Symbol;if(t&&t.iterator)Ce=t.iterator;else for(var e=Object.getOwnPropertyNames(Map.prototype),n=0;n<e.length;++n){var r=e[n];"entries"!==r&&"size"!==r&&Map.prototype[r]===Map.prototype.entries&&(Ce=r)}}return Ce}function Pe(t,e){return t===e||"number"==typeof t&&"number"==typeof e&&isNaN(t)&&isNaN(e)}function Re(t,e){var n=Ne(t),r=Ne(e);return n&&r?function(t,e,n){for(var r=t[Oe()](),o=e[Oe()]();;){var i=r.next(),a=o.next();if(i.done&&a.done)return!0;if(i.done||a.done)return!1;if(!n(i.value,a.value))return!1}}(t,e,Re):!(n||!t||"object"!=typeof t&&"function"!=typeof t||r||!e||"object"!=typeof e&&"function"!=typeof e)||Pe(t,e)}var De=function(){function t(t){this.wrapped=t}return t.wrap=function(e){return new t(e)},t.unwrap=function(e){return t.isWrapped(e)?e.wrapped:e},t.isWrapped=function(e){return e instanceof t},t}();function Ne(t){return!!Me(t)&&(Array.isArray(t)||!(t instanceof Map)&&Oe()in t)}function Me(t){return null!==t&&("function"==typeof t||"object"==typeof t)}var je=function(){function t(t,e,n){this.previousValue=t,this.currentValue=e,this.firstChange=n}return t.prototype.isFirstChange=function(){return this.firstChange},t}(),Fe=new kt("The presence of this token marks an injector as being the root injector."),Le=function(){return function(){}}(),Be=function(){return function(){}}();function Ve(t){var e=Error("No component factory found for "+gt(t)+". Did you add it to @NgModule.entryComponents?");return e[He]=t,e}var He="ngComponent",ze=function(){function t(){}return t.prototype.resolveComponentFactory=function(t){throw Ve(t)},t}(),Ue=function(){function t(){}return t.NULL=new ze,t}(),We=function(){function t(t,e,n){this._parent=e,this._ngModule=n,this._factories=new Map;for(var r=0;r<t.length;r++){var o=t[r];this._factories.set(o.componentType,o)}}return t.prototype.resolveComponentFactory=function(t){var e=this._factories.get(t);if(!e&&this._parent&&(e=this._parent.resolveComponentFactory(t)),!e)throw Ve(t);return new Ze(e,this._ngModule)},t}(),Ze=function(t){function e(e,n){var r=t.call(this)||this;return r.factory=e,r.ngModule=n,r.selector=e.selector,r.componentType=e.componentType,r.ngContentSelectors=e.ngContentSelectors,r.inputs=e.inputs,r.outputs=e.outputs,r}return o(e,t),e.prototype.create=function(t,e,n,r){return this.factory.create(t,e,n,r||this.ngModule)},e}(Be),qe=function(){return function(){}}(),Ge=function(){return function(){}}();function Ke(){for(var t=[],e=0;e<arguments.length;e++)t[e]=arguments[e]}var Qe=function(){function t(t){this.nativeElement=t}return t.__NG_ELEMENT_ID__=function(){return Ye(t)},t}(),Ye=Ke,Xe=function(){return function(){}}(),Je=function(){return function(){}}(),$e=function(t){return t[t.Important=1]="Important",t[t.DashCase=2]="DashCase",t}({}),tn=function(){function t(){}return t.__NG_ELEMENT_ID__=function(){return en()},t}(),en=Ke,nn=function(){return function(t){this.full=t,this.major=t.split(".")[0],this.minor=t.split(".")[1],this.patch=t.split(".").slice(2).join(".")}}(),rn=new nn("8.0.3"),on=function(){function t(){}return t.prototype.supports=function(t){return Ne(t)},t.prototype.create=function(t){return new sn(t)},t}(),an=function(t,e){return e},sn=function(){function t(t){this.length=0,this._linkedRecords=null,this._unlinkedRecords=null,this._previousItHead=null,this._itHead=null,this._itTail=null,this._additionsHead=null,this._additionsTail=null,this._movesHead=null,this._movesTail=null,this._removalsHead=null,this._removalsTail=null,this._identityChangesHead=null,this._identityChangesTail=null,this._trackByFn=t||an}return t.prototype.forEachItem=function(t){var e;for(e=this._itHead;null!==e;e=e._next)t(e)},t.prototype.forEachOperation=function(t){for(var e=this._itHead,n=this._removalsHead,r=0,o=null;e||n;){var i=!n||e&&e.currentIndex<fn(n,r,o)?e:n,a=fn(i,r,o),s=i.currentIndex;if(i===n)r--,n=n._nextRemoved;else if(e=e._next,null==i.previousIndex)r++;else{o||(o=[]);var u=a-r,c=s-r;if(u!=c){for(var l=0;l<u;l++){var f=l<o.length?o[l]:o[l]=0,p=f+l;c<=p&&p<u&&(o[l]=f+1)}o[i.previousIndex]=c-u}}a!==s&&t(i,a,s)}},t.prototype.forEachPreviousItem=function(t){var e;for(e=this._previousItHead;null!==e;e=e._nextPrevious)t(e)},t.prototype.forEachAddedItem=function(t){var e;for(e=this._additionsHead;null!==e;e=e._nextAdded)t(e)},t.prototype.forEachMovedItem=function(t){var e;for(e=this._movesHead;null!==e;e=e._nextMoved)t(e)},t.prototype.forEachRemovedItem=function(t){var e;for(e=this._removalsHead;null!==e;e=e._nextRemoved)t(e)},t.prototype.forEachIdentityChange=function(t){var e;for(e=this._identityChangesHead;null!==e;e=e._nextIdentityChange)t(e)},t.prototype.diff=function(t){if(null==t&&(t=[]),!Ne(t))throw new Error("Error trying to diff '"+gt(t)+"'. Only arrays and iterables are allowed");return this.check(t)?this:null},t.prototype.onDestroy=function(){},t.prototype.check=function(t){var e=this;this._reset();var n,r,o,i=this._itHead,a=!1;if(Array.isArray(t)){this.length=t.length;for(var s=0;s<this.length;s++)o=this._trackByFn(s,r=t[s]),null!==i&&Pe(i.trackById,o)?(a&&(i=this._verifyReinsertion(i,r,o,s)),Pe(i.item,r)||this._addIdentityChange(i,r)):(i=this._mismatch(i,r,o,s),a=!0),i=i._next}else n=0,function(t,e){if(Array.isArray(t))for(var n=0;n<t.length;n++)e(t[n]);else for(var r=t[Oe()](),o=void 0;!(o=r.next()).done;)e(o.value)}(t,function(t){o=e._trackByFn(n,t),null!==i&&Pe(i.trackById,o)?(a&&(i=e._verifyReinsertion(i,t,o,n)),Pe(i.item,t)||e._addIdentityChange(i,t)):(i=e._mismatch(i,t,o,n),a=!0),i=i._next,n++}),this.length=n;return this._truncate(i),this.collection=t,this.isDirty},Object.defineProperty(t.prototype,"isDirty",{get:function(){return null!==this._additionsHead||null!==this._movesHead||null!==this._removalsHead||null!==this._identityChangesHead},enumerable:!0,configurable:!0}),t.prototype._reset=function(){if(this.isDirty){var t=void 0,e=void 0;for(t=this._previousItHead=this._itHead;null!==t;t=t._next)t._nextPrevious=t._next;for(t=this._additionsHead;null!==t;t=t._nextAdded)t.previousIndex=t.currentIndex;for(this._additionsHead=this._additionsTail=null,t=this._movesHead;null!==t;t=e)t.previousIndex=t.currentIndex,e=t._nextMoved;this._movesHead=this._movesTail=null,this._removalsHead=this._removalsTail=null,this._identityChangesHead=this._identityChangesTail=null}},t.prototype._mismatch=function(t,e,n,r){var o;return null===t?o=this._itTail:(o=t._prev,this._remove(t)),null!==(t=null===this._linkedRecords?null:this._linkedRecords.get(n,r))?(Pe(t.item,e)||this._addIdentityChange(t,e),this._moveAfter(t,o,r)):null!==(t=null===this._unlinkedRecords?null:this._unlinkedRecords.get(n,null))?(Pe(t.item,e)||this._addIdentityChange(t,e),this._reinsertAfter(t,o,r)):t=this._addAfter(new un(e,n),o,r),t},t.prototype._verifyReinsertion=function(t,e,n,r){var o=null===this._unlinkedRecords?null:this._unlinkedRecords.get(n,null);return null!==o?t=this._reinsertAfter(o,t._prev,r):t.currentIndex!=r&&(t.currentIndex=r,this._addToMoves(t,r)),t},t.prototype._truncate=function(t){for(;null!==t;){var e=t._next;this._addToRemovals(this._unlink(t)),t=e}null!==this._unlinkedRecords&&this._unlinkedRecords.clear(),null!==this._additionsTail&&(this._additionsTail._nextAdded=null),null!==this._movesTail&&(this._movesTail._nextMoved=null),null!==this._itTail&&(this._itTail._next=null),null!==this._removalsTail&&(this._removalsTail._nextRemoved=null),null!==this._identityChangesTail&&(this._identityChangesTail._nextIdentityChange=null)},t.prototype._reinsertAfter=function(t,e,n){null!==this._unlinkedRecords&&this._unlinkedRecords.remove(t);var r=t._prevRemoved,o=t._nextRemoved;return null===r?this._removalsHead=o:r._nextRemoved=o,null===o?this._removalsTail=r:o._prevRemoved=r,this._insertAfter(t,e,n),this._addToMoves(t,n),t},t.prototype._moveAfter=function(t,e,n){return this._unlink(t),this._insertAfter(t,e,n),this._addToMoves(t,n),t},t.prototype._addAfter=function(t,e,n){return this._insertAfter(t,e,n),this._additionsTail=null===this._additionsTail?this._additionsHead=t:this._additionsTail._nextAdded=t,t},t.prototype._insertAfter=function(t,e,n){var r=null===e?this._itHead:e._next;return t._next=r,t._prev=e,null===r?this._itTail=t:r._prev=t,null===e?this._itHead=t:e._next=t,null===this._linkedRecords&&(this._linkedRecords=new ln),this._linkedRecords.put(t),t.currentIndex=n,t},t.prototype._remove=function(t){return this._addToRemovals(this._unlink(t))},t.prototype._unlink=function(t){null!==this._linkedRecords&&this._linkedRecords.remove(t);var e=t._prev,n=t._next;return null===e?this._itHead=n:e._next=n,null===n?this._itTail=e:n._prev=e,t},t.prototype._addToMoves=function(t,e){return t.previousIndex===e?t:(this._movesTail=null===this._movesTail?this._movesHead=t:this._movesTail._nextMoved=t,t)},t.prototype._addToRemovals=function(t){return null===this._unlinkedRecords&&(this._unlinkedRecords=new ln),this._unlinkedRecords.put(t),t.currentIndex=null,t._nextRemoved=null,null===this._removalsTail?(this._removalsTail=this._removalsHead=t,t._prevRemoved=null):(t._prevRemoved=this._removalsTail,this._removalsTail=this._removalsTail._nextRemoved=t),t},t.prototype._addIdentityChange=function(t,e){return t.item=e,this._identityChangesTail=null===this._identityChangesTail?this._identityChangesHead=t:this._identityChangesTail._nextIdentityChange=t,t},t}(),un=function(){return function(t,e){this.item=t,this.trackById=e,this.currentIndex=null,this.previousIndex=null,this._nextPrevious=null,this._prev=null,this._next=null,this._prevDup=null,this._nextDup=null,this._prevRemoved=null,this._nextRemoved=null,this._nextAdded=null,this._nextMoved=null,this._nextIdentityChange=null}}(),cn=function(){function t(){this._head=null,this._tail=null}return t.prototype.add=function(t){null===this._head?(this._head=this._tail=t,t._nextDup=null,t._prevDup=null):(this._tail._nextDup=t,t._prevDup=this._tail,t._nextDup=null,this._tail=t)},t.prototype.get=function(t,e){var n;for(n=this._head;null!==n;n=n._nextDup)if((null===e||e<=n.currentIndex)&&Pe(n.trackById,t))return n;return null},t.prototype.remove=function(t){var e=t._prevDup,n=t._nextDup;return null===e?this._head=n:e._nextDup=n,null===n?this._tail=e:n._prevDup=e,null===this._head},t}(),ln=function(){function t(){this.map=new Map}return t.prototype.put=function(t){var e=t.trackById,n=this.map.get(e);n||(n=new cn,this.map.set(e,n)),n.add(t)},t.prototype.get=function(t,e){var n=this.map.get(t);return n?n.get(t,e):null},t.prototype.remove=function(t){var e=t.trackById;return this.map.get(e).remove(t)&&this.map.delete(e),t},Object.defineProperty(t.prototype,"isEmpty",{get:function(){return 0===this.map.size},enumerable:!0,configurable:!0}),t.prototype.clear=function(){this.map.clear()},t}();function fn(t,e,n){var r=t.previousIndex;if(null===r)return r;var o=0;return n&&r<n.length&&(o=n[r]),r+e+o}var pn=function(){function t(){}return t.prototype.supports=function(t){return t instanceof Map||Me(t)},t.prototype.create=function(){return new hn},t}(),hn=function(){function t(){this._records=new Map,this._mapHead=null,this._appendAfter=null,this._previousMapHead=null,this._changesHead=null,this._changesTail=null,this._additionsHead=null,this._additionsTail=null,this._removalsHead=null,this._removalsTail=null}return Object.defineProperty(t.prototype,"isDirty",{get:function(){return null!==this._additionsHead||null!==this._changesHead||null!==this._removalsHead},enumerable:!0,configurable:!0}),t.prototype.forEachItem=function(t){var e;for(e=this._mapHead;null!==e;e=e._next)t(e)},t.prototype.forEachPreviousItem=function(t){var e;for(e=this._previousMapHead;null!==e;e=e._nextPrevious)t(e)},t.prototype.forEachChangedItem=function(t){var e;for(e=this._changesHead;null!==e;e=e._nextChanged)t(e)},t.prototype.forEachAddedItem=function(t){var e;for(e=this._additionsHead;null!==e;e=e._nextAdded)t(e)},t.prototype.forEachRemovedItem=function(t){var e;for(e=this._removalsHead;null!==e;e=e._nextRemoved)t(e)},t.prototype.diff=function(t){if(t){if(!(t instanceof Map||Me(t)))throw new Error("Error trying to diff '"+gt(t)+"'. Only maps and objects are allowed")}else t=new Map;return this.check(t)?this:null},t.prototype.onDestroy=function(){},t.prototype.check=function(t){var e=this;this._reset();var n=this._mapHead;if(this._appendAfter=null,this._forEach(t,function(t,r){if(n&&n.key===r)e._maybeAddToChanges(n,t),e._appendAfter=n,n=n._next;else{var o=e._getOrCreateRecordForKey(r,t);n=e._insertBeforeOrAppend(n,o)}}),n){n._prev&&(n._prev._next=null),this._removalsHead=n;for(var r=n;null!==r;r=r._nextRemoved)r===this._mapHead&&(this._mapHead=null),this._records.delete(r.key),r._nextRemoved=r._next,r.previousValue=r.currentValue,r.currentValue=null,r._prev=null,r._next=null}return this._changesTail&&(this._changesTail._nextChanged=null),this._additionsTail&&(this._additionsTail._nextAdded=null),this.isDirty},t.prototype._insertBeforeOrAppend=function(t,e){if(t){var n=t._prev;return e._next=t,e._prev=n,t._prev=e,n&&(n._next=e),t===this._mapHead&&(this._mapHead=e),this._appendAfter=t,t}return this._appendAfter?(this._appendAfter._next=e,e._prev=this._appendAfter):this._mapHead=e,this._appendAfter=e,null},t.prototype._getOrCreateRecordForKey=function(t,e){if(this._records.has(t)){var n=this._records.get(t);this._maybeAddToChanges(n,e);var r=n._prev,o=n._next;return r&&(r._next=o),o&&(o._prev=r),n._next=null,n._prev=null,n}var i=new dn(t);return this._records.set(t,i),i.currentValue=e,this._addToAdditions(i),i},t.prototype._reset=function(){if(this.isDirty){var t=void 0;for(this._previousMapHead=this._mapHead,t=this._previousMapHead;null!==t;t=t._next)t._nextPrevious=t._next;for(t=this._changesHead;null!==t;t=t._nextChanged)t.previousValue=t.currentValue;for(t=this._additionsHead;null!=t;t=t._nextAdded)t.previousValue=t.currentValue;this._changesHead=this._changesTail=null,this._additionsHead=this._additionsTail=null,this._removalsHead=null}},t.prototype._maybeAddToChanges=function(t,e){Pe(e,t.currentValue)||(t.previousValue=t.currentValue,t.currentValue=e,this._addToChanges(t))},t.prototype._addToAdditions=function(t){null===this._additionsHead?this._additionsHead=this._additionsTail=t:(this._additionsTail._nextAdded=t,this._additionsTail=t)},t.prototype._addToChanges=function(t){null===this._changesHead?this._changesHead=this._changesTail=t:(this._changesTail._nextChanged=t,this._changesTail=t)},t.prototype._forEach=function(t,e){t instanceof Map?t.forEach(e):Object.keys(t).forEach(function(n){return e(t[n],n)})},t}(),dn=function(){return function(t){this.key=t,this.previousValue=null,this.currentValue=null,this._nextPrevious=null,this._next=null,this._prev=null,this._nextAdded=null,this._nextRemoved=null,this._nextChanged=null}}(),yn=function(){function t(t){this.factories=t}return t.create=function(e,n){if(null!=n){var r=n.factories.slice();e=e.concat(r)}return new t(e)},t.extend=function(e){return{provide:t,useFactory:function(n){if(!n)throw new Error("Cannot extend IterableDiffers without a parent injector");return t.create(e,n)},deps:[[t,new ft,new ct]]}},t.prototype.find=function(t){var e,n=this.factories.find(function(e){return e.supports(t)});if(null!=n)return n;throw new Error("Cannot find a differ supporting object '"+t+"' of type '"+((e=t).name||typeof e)+"'")},t.ngInjectableDef=dt({providedIn:"root",factory:function(){return new t([new on])}}),t}(),vn=function(){function t(t){this.factories=t}return t.create=function(e,n){if(n){var r=n.factories.slice();e=e.concat(r)}return new t(e)},t.extend=function(e){return{provide:t,useFactory:function(n){if(!n)throw new Error("Cannot extend KeyValueDiffers without a parent injector");return t.create(e,n)},deps:[[t,new ft,new ct]]}},t.prototype.find=function(t){var e=this.factories.find(function(e){return e.supports(t)});if(e)return e;throw new Error("Cannot find a differ supporting object '"+t+"'")},t.ngInjectableDef=dt({providedIn:"root",factory:function(){return new t([new pn])}}),t}(),gn=function(){function t(){}return t.__NG_ELEMENT_ID__=function(){return mn()},t}(),mn=function(){for(var t=[],e=0;e<arguments.length;e++)t[e]=arguments[e]},bn=[new pn],_n=new yn([new on]),wn=new vn(bn),En=function(){function t(){}return t.__NG_ELEMENT_ID__=function(){return Sn(t,Qe)},t}(),Sn=Ke,xn=function(){function t(){}return t.__NG_ELEMENT_ID__=function(){return Tn(t,Qe)},t}(),Tn=Ke;function kn(t,e,n,r){var o="ExpressionChangedAfterItHasBeenCheckedError: Expression has changed after it was checked. Previous value: '"+e+"'. Current value: '"+n+"'.";return r&&(o+=" It seems like the view has been created after its parent and its children have been dirty checked. Has it been created in a change detection hook ?"),function(t,e){var n=new Error(t);return An(n,e),n}(o,t)}function An(t,e){t[Ut]=e,t[Zt]=e.logError.bind(e)}function In(t){return new Error("ViewDestroyedError: Attempt to use a destroyed view: "+t)}function Cn(t,e,n){var r=t.state,o=1792&r;return o===e?(t.state=-1793&r|n,t.initIndex=-1,!0):o===n}function On(t,e,n){return(1792&t.state)===e&&t.initIndex<=n&&(t.initIndex=n+1,!0)}function Pn(t,e){return t.nodes[e]}function Rn(t,e){return t.nodes[e]}function Dn(t,e){return t.nodes[e]}function Nn(t,e){return t.nodes[e]}function Mn(t,e){return t.nodes[e]}var jn={setCurrentNode:void 0,createRootView:void 0,createEmbeddedView:void 0,createComponentView:void 0,createNgModuleRef:void 0,overrideProvider:void 0,overrideComponentView:void 0,clearOverrides:void 0,checkAndUpdateView:void 0,checkNoChangesView:void 0,destroyView:void 0,resolveDep:void 0,createDebugContext:void 0,handleEvent:void 0,updateDirectives:void 0,updateRenderer:void 0,dirtyParentQueries:void 0},Fn=function(){},Ln=new Map;function Bn(t){var e=Ln.get(t);return e||(e=gt(t)+"_"+Ln.size,Ln.set(t,e)),e}var Vn="$$undefined",Hn="$$empty";function zn(t){return{id:Vn,styles:t.styles,encapsulation:t.encapsulation,data:t.data}}var Un=0;function Wn(t,e,n,r){return!(!(2&t.state)&&Pe(t.oldValues[e.bindingIndex+n],r))}function Zn(t,e,n,r){return!!Wn(t,e,n,r)&&(t.oldValues[e.bindingIndex+n]=r,!0)}function qn(t,e,n,r){var o=t.oldValues[e.bindingIndex+n];if(1&t.state||!Re(o,r)){var i=e.bindings[n].name;throw kn(jn.createDebugContext(t,e.nodeIndex),i+": "+o,i+": "+r,0!=(1&t.state))}}function Gn(t){for(var e=t;e;)2&e.def.flags&&(e.state|=8),e=e.viewContainerParent||e.parent}function Kn(t,e){for(var n=t;n&&n!==e;)n.state|=64,n=n.viewContainerParent||n.parent}function Qn(t,e,n,r){try{return Gn(33554432&t.def.nodes[e].flags?Rn(t,e).componentView:t),jn.handleEvent(t,e,n,r)}catch(o){t.root.errorHandler.handleError(o)}}function Yn(t){return t.parent?Rn(t.parent,t.parentNodeDef.nodeIndex):null}function Xn(t){return t.parent?t.parentNodeDef.parent:null}function Jn(t,e){switch(201347067&e.flags){case 1:return Rn(t,e.nodeIndex).renderElement;case 2:return Pn(t,e.nodeIndex).renderText}}function $n(t){return!!t.parent&&!!(32768&t.parentNodeDef.flags)}function tr(t){return!(!t.parent||32768&t.parentNodeDef.flags)}function er(t){return 1<<t%32}function nr(t){var e={},n=0,r={};return t&&t.forEach(function(t){var o=f(t,2),i=o[0],a=o[1];"number"==typeof i?(e[i]=a,n|=er(i)):r[i]=a}),{matchedQueries:e,references:r,matchedQueryIds:n}}function rr(t,e){return t.map(function(t){var n,r,o;return Array.isArray(t)?(o=(n=f(t,2))[0],r=n[1]):(o=0,r=t),r&&("function"==typeof r||"object"==typeof r)&&e&&Object.defineProperty(r,At,{value:e,configurable:!0}),{flags:o,token:r,tokenKey:Bn(r)}})}function or(t,e,n){var r=n.renderParent;return r?0==(1&r.flags)||0==(33554432&r.flags)||r.element.componentRendererType&&r.element.componentRendererType.encapsulation===qt.Native?Rn(t,n.renderParent.nodeIndex).renderElement:void 0:e}var ir=new WeakMap;function ar(t){var e=ir.get(t);return e||((e=t(function(){return Fn})).factory=t,ir.set(t,e)),e}function sr(t,e,n,r,o){3===e&&(n=t.renderer.parentNode(Jn(t,t.def.lastRenderRootNode))),ur(t,e,0,t.def.nodes.length-1,n,r,o)}function ur(t,e,n,r,o,i,a){for(var s=n;s<=r;s++){var u=t.def.nodes[s];11&u.flags&&lr(t,u,e,o,i,a),s+=u.childCount}}function cr(t,e,n,r,o,i){for(var a=t;a&&!$n(a);)a=a.parent;for(var s=a.parent,u=Xn(a),c=u.nodeIndex+u.childCount,l=u.nodeIndex+1;l<=c;l++){var f=s.def.nodes[l];f.ngContentIndex===e&&lr(s,f,n,r,o,i),l+=f.childCount}if(!s.parent){var p=t.root.projectableNodes[e];if(p)for(l=0;l<p.length;l++)fr(t,p[l],n,r,o,i)}}function lr(t,e,n,r,o,i){if(8&e.flags)cr(t,e.ngContent.index,n,r,o,i);else{var a=Jn(t,e);if(3===n&&33554432&e.flags&&48&e.bindingFlags?(16&e.bindingFlags&&fr(t,a,n,r,o,i),32&e.bindingFlags&&fr(Rn(t,e.nodeIndex).componentView,a,n,r,o,i)):fr(t,a,n,r,o,i),16777216&e.flags)for(var s=Rn(t,e.nodeIndex).viewContainer._embeddedViews,u=0;u<s.length;u++)sr(s[u],n,r,o,i);1&e.flags&&!e.element.name&&ur(t,n,e.nodeIndex+1,e.nodeIndex+e.childCount,r,o,i)}}function fr(t,e,n,r,o,i){var a=t.renderer;switch(n){case 1:a.appendChild(r,e);break;case 2:a.insertBefore(r,e,o);break;case 3:a.removeChild(r,e);break;case 0:i.push(e)}}var pr=/^:([^:]+):(.+)$/;function hr(t){if(":"===t[0]){var e=t.match(pr);return[e[1],e[2]]}return["",t]}function dr(t){for(var e=0,n=0;n<t.length;n++)e|=t[n].flags;return e}var yr=new Object,vr=Bn(Pt),gr=Bn(Ct),mr=Bn(qe);function br(t,e,n,r){return n=_t(n),{index:-1,deps:rr(r,gt(e)),flags:t,token:e,value:n}}function _r(t,e,n){void 0===n&&(n=Pt.THROW_IF_NOT_FOUND);var r,o,i=Tt(t);try{if(8&e.flags)return e.token;if(2&e.flags&&(n=null),1&e.flags)return t._parent.get(e.token,n);var a=e.tokenKey;switch(a){case vr:case gr:case mr:return t}var s,u=t._def.providersByKey[a];if(u){var c=t._providers[u.index];return void 0===c&&(c=t._providers[u.index]=wr(t,u)),c===yr?void 0:c}if((s=yt(e.token))&&(r=t,null!=(o=s).providedIn&&(function(t,e){return t._def.modules.indexOf(o.providedIn)>-1}(r)||"root"===o.providedIn&&r._def.isRoot))){var l=t._providers.length;return t._def.providers[l]=t._def.providersByKey[e.tokenKey]={flags:5120,value:s.factory,deps:[],index:l,token:e.token},t._providers[l]=yr,t._providers[l]=wr(t,t._def.providersByKey[e.tokenKey])}return 4&e.flags?n:t._parent.get(e.token,n)}finally{Tt(i)}}function wr(t,e){var n;switch(201347067&e.flags){case 512:n=function(t,e,n){var r=n.length;switch(r){case 0:return new e;case 1:return new e(_r(t,n[0]));case 2:return new e(_r(t,n[0]),_r(t,n[1]));case 3:return new e(_r(t,n[0]),_r(t,n[1]),_r(t,n[2]));default:for(var o=new Array(r),i=0;i<r;i++)o[i]=_r(t,n[i]);return new(e.bind.apply(e,p([void 0],o)))}}(t,e.value,e.deps);break;case 1024:n=function(t,e,n){var r=n.length;switch(r){case 0:return e();case 1:return e(_r(t,n[0]));case 2:return e(_r(t,n[0]),_r(t,n[1]));case 3:return e(_r(t,n[0]),_r(t,n[1]),_r(t,n[2]));default:for(var o=Array(r),i=0;i<r;i++)o[i]=_r(t,n[i]);return e.apply(void 0,p(o))}}(t,e.value,e.deps);break;case 2048:n=_r(t,e.deps[0]);break;case 256:n=e.value}return n===yr||null===n||"object"!=typeof n||131072&e.flags||"function"!=typeof n.ngOnDestroy||(e.flags|=131072),void 0===n?yr:n}function Er(t,e){var n=t.viewContainer._embeddedViews;if((null==e||e>=n.length)&&(e=n.length-1),e<0)return null;var r=n[e];return r.viewContainerParent=null,kr(n,e),jn.dirtyParentQueries(r),xr(r),r}function Sr(t,e,n){var r=e?Jn(e,e.def.lastRenderRootNode):t.renderElement,o=n.renderer.parentNode(r),i=n.renderer.nextSibling(r);sr(n,2,o,i,void 0)}function xr(t){sr(t,3,null,null,void 0)}function Tr(t,e,n){e>=t.length?t.push(n):t.splice(e,0,n)}function kr(t,e){e>=t.length-1?t.pop():t.splice(e,1)}var Ar=new Object;function Ir(t,e,n,r,o,i){return new Cr(t,e,n,r,o,i)}var Cr=function(t){function e(e,n,r,o,i,a){var s=t.call(this)||this;return s.selector=e,s.componentType=n,s._inputs=o,s._outputs=i,s.ngContentSelectors=a,s.viewDefFactory=r,s}return o(e,t),Object.defineProperty(e.prototype,"inputs",{get:function(){var t=[],e=this._inputs;for(var n in e)t.push({propName:n,templateName:e[n]});return t},enumerable:!0,configurable:!0}),Object.defineProperty(e.prototype,"outputs",{get:function(){var t=[];for(var e in this._outputs)t.push({propName:e,templateName:this._outputs[e]});return t},enumerable:!0,configurable:!0}),e.prototype.create=function(t,e,n,r){if(!r)throw new Error("ngModule should be provided");var o=ar(this.viewDefFactory),i=o.nodes[0].element.componentProvider.nodeIndex,a=jn.createRootView(t,e||[],n,o,r,Ar),s=Dn(a,i).instance;return n&&a.renderer.setAttribute(Rn(a,0).renderElement,"ng-version",rn.full),new Or(a,new Nr(a),s)},e}(Be),Or=function(t){function e(e,n,r){var o=t.call(this)||this;return o._view=e,o._viewRef=n,o._component=r,o._elDef=o._view.def.nodes[0],o.hostView=n,o.changeDetectorRef=n,o.instance=r,o}return o(e,t),Object.defineProperty(e.prototype,"location",{get:function(){return new Qe(Rn(this._view,this._elDef.nodeIndex).renderElement)},enumerable:!0,configurable:!0}),Object.defineProperty(e.prototype,"injector",{get:function(){return new Lr(this._view,this._elDef)},enumerable:!0,configurable:!0}),Object.defineProperty(e.prototype,"componentType",{get:function(){return this._component.constructor},enumerable:!0,configurable:!0}),e.prototype.destroy=function(){this._viewRef.destroy()},e.prototype.onDestroy=function(t){this._viewRef.onDestroy(t)},e}(Le);function Pr(t,e,n){return new Rr(t,e,n)}var Rr=function(){function t(t,e,n){this._view=t,this._elDef=e,this._data=n,this._embeddedViews=[]}return Object.defineProperty(t.prototype,"element",{get:function(){return new Qe(this._data.renderElement)},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"injector",{get:function(){return new Lr(this._view,this._elDef)},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"parentInjector",{get:function(){for(var t=this._view,e=this._elDef.parent;!e&&t;)e=Xn(t),t=t.parent;return t?new Lr(t,e):new Lr(this._view,null)},enumerable:!0,configurable:!0}),t.prototype.clear=function(){for(var t=this._embeddedViews.length-1;t>=0;t--){var e=Er(this._data,t);jn.destroyView(e)}},t.prototype.get=function(t){var e=this._embeddedViews[t];if(e){var n=new Nr(e);return n.attachToViewContainerRef(this),n}return null},Object.defineProperty(t.prototype,"length",{get:function(){return this._embeddedViews.length},enumerable:!0,configurable:!0}),t.prototype.createEmbeddedView=function(t,e,n){var r=t.createEmbeddedView(e||{});return this.insert(r,n),r},t.prototype.createComponent=function(t,e,n,r,o){var i=n||this.parentInjector;o||t instanceof Ze||(o=i.get(qe));var a=t.create(i,r,void 0,o);return this.insert(a.hostView,e),a},t.prototype.insert=function(t,e){if(t.destroyed)throw new Error("Cannot insert a destroyed View in a ViewContainer!");var n,r,o,i,a=t;return i=(n=this._data).viewContainer._embeddedViews,null==(r=e)&&(r=i.length),(o=a._view).viewContainerParent=this._view,Tr(i,r,o),function(t,e){var n=Yn(e);if(n&&n!==t&&!(16&e.state)){e.state|=16;var r=n.template._projectedViews;r||(r=n.template._projectedViews=[]),r.push(e),function(t,n){if(!(4&n.flags)){e.parent.def.nodeFlags|=4,n.flags|=4;for(var r=n.parent;r;)r.childFlags|=4,r=r.parent}}(0,e.parentNodeDef)}}(n,o),jn.dirtyParentQueries(o),Sr(n,r>0?i[r-1]:null,o),a.attachToViewContainerRef(this),t},t.prototype.move=function(t,e){if(t.destroyed)throw new Error("Cannot move a destroyed View in a ViewContainer!");var n,r,o,i,a,s=this._embeddedViews.indexOf(t._view);return o=e,a=(i=(n=this._data).viewContainer._embeddedViews)[r=s],kr(i,r),null==o&&(o=i.length),Tr(i,o,a),jn.dirtyParentQueries(a),xr(a),Sr(n,o>0?i[o-1]:null,a),t},t.prototype.indexOf=function(t){return this._embeddedViews.indexOf(t._view)},t.prototype.remove=function(t){var e=Er(this._data,t);e&&jn.destroyView(e)},t.prototype.detach=function(t){var e=Er(this._data,t);return e?new Nr(e):null},t}();function Dr(t){return new Nr(t)}var Nr=function(){function t(t){this._view=t,this._viewContainerRef=null,this._appRef=null}return Object.defineProperty(t.prototype,"rootNodes",{get:function(){return sr(this._view,0,void 0,void 0,t=[]),t;var t},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"context",{get:function(){return this._view.context},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"destroyed",{get:function(){return 0!=(128&this._view.state)},enumerable:!0,configurable:!0}),t.prototype.markForCheck=function(){Gn(this._view)},t.prototype.detach=function(){this._view.state&=-5},t.prototype.detectChanges=function(){var t=this._view.root.rendererFactory;t.begin&&t.begin();try{jn.checkAndUpdateView(this._view)}finally{t.end&&t.end()}},t.prototype.checkNoChanges=function(){jn.checkNoChangesView(this._view)},t.prototype.reattach=function(){this._view.state|=4},t.prototype.onDestroy=function(t){this._view.disposables||(this._view.disposables=[]),this._view.disposables.push(t)},t.prototype.destroy=function(){this._appRef?this._appRef.detachView(this):this._viewContainerRef&&this._viewContainerRef.detach(this._viewContainerRef.indexOf(this)),jn.destroyView(this._view)},t.prototype.detachFromAppRef=function(){this._appRef=null,xr(this._view),jn.dirtyParentQueries(this._view)},t.prototype.attachToAppRef=function(t){if(this._viewContainerRef)throw new Error("This view is already attached to a ViewContainer!");this._appRef=t},t.prototype.attachToViewContainerRef=function(t){if(this._appRef)throw new Error("This view is already attached directly to the ApplicationRef!");this._viewContainerRef=t},t}();function Mr(t,e){return new jr(t,e)}var jr=function(t){function e(e,n){var r=t.call(this)||this;return r._parentView=e,r._def=n,r}return o(e,t),e.prototype.createEmbeddedView=function(t){return new Nr(jn.createEmbeddedView(this._parentView,this._def,this._def.element.template,t))},Object.defineProperty(e.prototype,"elementRef",{get:function(){return new Qe(Rn(this._parentView,this._def.nodeIndex).renderElement)},enumerable:!0,configurable:!0}),e}(En);function Fr(t,e){return new Lr(t,e)}var Lr=function(){function t(t,e){this.view=t,this.elDef=e}return t.prototype.get=function(t,e){return void 0===e&&(e=Pt.THROW_IF_NOT_FOUND),jn.resolveDep(this.view,this.elDef,!!this.elDef&&0!=(33554432&this.elDef.flags),{flags:0,token:t,tokenKey:Bn(t)},e)},t}();function Br(t){return new Vr(t.renderer)}var Vr=function(){function t(t){this.delegate=t}return t.prototype.selectRootElement=function(t){return this.delegate.selectRootElement(t)},t.prototype.createElement=function(t,e){var n=f(hr(e),2),r=this.delegate.createElement(n[1],n[0]);return t&&this.delegate.appendChild(t,r),r},t.prototype.createViewRoot=function(t){return t},t.prototype.createTemplateAnchor=function(t){var e=this.delegate.createComment("");return t&&this.delegate.appendChild(t,e),e},t.prototype.createText=function(t,e){var n=this.delegate.createText(e);return t&&this.delegate.appendChild(t,n),n},t.prototype.projectNodes=function(t,e){for(var n=0;n<e.length;n++)this.delegate.appendChild(t,e[n])},t.prototype.attachViewAfter=function(t,e){for(var n=this.delegate.parentNode(t),r=this.delegate.nextSibling(t),o=0;o<e.length;o++)this.delegate.insertBefore(n,e[o],r)},t.prototype.detachView=function(t){for(var e=0;e<t.length;e++){var n=t[e],r=this.delegate.parentNode(n);this.delegate.removeChild(r,n)}},t.prototype.destroyView=function(t,e){for(var n=0;n<e.length;n++)this.delegate.destroyNode(e[n])},t.prototype.listen=function(t,e,n){return this.delegate.listen(t,e,n)},t.prototype.listenGlobal=function(t,e,n){return this.delegate.listen(t,e,n)},t.prototype.setElementProperty=function(t,e,n){this.delegate.setProperty(t,e,n)},t.prototype.setElementAttribute=function(t,e,n){var r=f(hr(e),2),o=r[0],i=r[1];null!=n?this.delegate.setAttribute(t,i,n,o):this.delegate.removeAttribute(t,i,o)},t.prototype.setBindingDebugInfo=function(t,e,n){},t.prototype.setElementClass=function(t,e,n){n?this.delegate.addClass(t,e):this.delegate.removeClass(t,e)},t.prototype.setElementStyle=function(t,e,n){null!=n?this.delegate.setStyle(t,e,n):this.delegate.removeStyle(t,e)},t.prototype.invokeElementMethod=function(t,e,n){t[e].apply(t,n)},t.prototype.setText=function(t,e){this.delegate.setValue(t,e)},t.prototype.animate=function(){throw new Error("Renderer.animate is no longer supported!")},t}();function Hr(t,e,n,r){return new zr(t,e,n,r)}var zr=function(){function t(t,e,n,r){this._moduleType=t,this._parent=e,this._bootstrapComponents=n,this._def=r,this._destroyListeners=[],this._destroyed=!1,this.injector=this,function(t){for(var e=t._def,n=t._providers=new Array(e.providers.length),r=0;r<e.providers.length;r++){var o=e.providers[r];4096&o.flags||void 0===n[r]&&(n[r]=wr(t,o))}}(this)}return t.prototype.get=function(t,e,n){void 0===e&&(e=Pt.THROW_IF_NOT_FOUND),void 0===n&&(n=pt.Default);var r=0;return n&pt.SkipSelf?r|=1:n&pt.Self&&(r|=4),_r(this,{token:t,tokenKey:Bn(t),flags:r},e)},Object.defineProperty(t.prototype,"instance",{get:function(){return this.get(this._moduleType)},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"componentFactoryResolver",{get:function(){return this.get(Ue)},enumerable:!0,configurable:!0}),t.prototype.destroy=function(){if(this._destroyed)throw new Error("The ng module "+gt(this.instance.constructor)+" has already been destroyed.");this._destroyed=!0,function(t,e){for(var n=t._def,r=new Set,o=0;o<n.providers.length;o++)if(131072&n.providers[o].flags){var i=t._providers[o];if(i&&i!==yr){var a=i.ngOnDestroy;"function"!=typeof a||r.has(i)||(a.apply(i),r.add(i))}}}(this),this._destroyListeners.forEach(function(t){return t()})},t.prototype.onDestroy=function(t){this._destroyListeners.push(t)},t}(),Ur=Bn(Xe),Wr=Bn(tn),Zr=Bn(Qe),qr=Bn(xn),Gr=Bn(En),Kr=Bn(gn),Qr=Bn(Pt),Yr=Bn(Ct);function Xr(t,e,n,r,o,i,a,s){var u=[];if(a)for(var c in a){var l=f(a[c],2);u[l[0]]={flags:8,name:c,nonMinifiedName:l[1],ns:null,securityContext:null,suffix:null}}var p=[];if(s)for(var h in s)p.push({type:1,propName:h,target:null,eventName:s[h]});return function(t,e,n,r,o,i,a,s,u){var c=nr(n),l=c.matchedQueries,f=c.references,p=c.matchedQueryIds;u||(u=[]),s||(s=[]),i=_t(i);var h=rr(a,gt(o));return{nodeIndex:-1,parent:null,renderParent:null,bindingIndex:-1,outputIndex:-1,checkIndex:t,flags:e,childFlags:0,directChildFlags:0,childMatchedQueries:0,matchedQueries:l,matchedQueryIds:p,references:f,ngContentIndex:-1,childCount:r,bindings:s,bindingFlags:dr(s),outputs:u,element:null,provider:{token:o,value:i,deps:h},text:null,query:null,ngContent:null}}(t,e|=16384,n,r,o,o,i,u,p)}function Jr(t,e){return no(t,e)}function $r(t,e){for(var n=t;n.parent&&!$n(n);)n=n.parent;return ro(n.parent,Xn(n),!0,e.provider.value,e.provider.deps)}function to(t,e){var n,r=ro(t,e.parent,(32768&e.flags)>0,e.provider.value,e.provider.deps);if(e.outputs.length)for(var o=0;o<e.outputs.length;o++){var i=e.outputs[o],a=r[i.propName];if(!(n=a)||"function"!=typeof n.subscribe)throw new Error("@Output "+i.propName+" not initialized in '"+r.constructor.name+"'.");var s=a.subscribe(eo(t,e.parent.nodeIndex,i.eventName));t.disposables[e.outputIndex+o]=s.unsubscribe.bind(s)}return r}function eo(t,e,n){return function(r){return Qn(t,e,n,r)}}function no(t,e){var n=(8192&e.flags)>0,r=e.provider;switch(201347067&e.flags){case 512:return ro(t,e.parent,n,r.value,r.deps);case 1024:return function(t,e,n,r,o){var i=o.length;switch(i){case 0:return r();case 1:return r(io(t,e,n,o[0]));case 2:return r(io(t,e,n,o[0]),io(t,e,n,o[1]));case 3:return r(io(t,e,n,o[0]),io(t,e,n,o[1]),io(t,e,n,o[2]));default:for(var a=Array(i),s=0;s<i;s++)a[s]=io(t,e,n,o[s]);return r.apply(void 0,p(a))}}(t,e.parent,n,r.value,r.deps);case 2048:return io(t,e.parent,n,r.deps[0]);case 256:return r.value}}function ro(t,e,n,r,o){var i=o.length;switch(i){case 0:return new r;case 1:return new r(io(t,e,n,o[0]));case 2:return new r(io(t,e,n,o[0]),io(t,e,n,o[1]));case 3:return new r(io(t,e,n,o[0]),io(t,e,n,o[1]),io(t,e,n,o[2]));default:for(var a=new Array(i),s=0;s<i;s++)a[s]=io(t,e,n,o[s]);return new(r.bind.apply(r,p([void 0],a)))}}var oo={};function io(t,e,n,r,o){if(void 0===o&&(o=Pt.THROW_IF_NOT_FOUND),8&r.flags)return r.token;var i=t;2&r.flags&&(o=null);var a=r.tokenKey;a===Kr&&(n=!(!e||!e.element.componentView)),e&&1&r.flags&&(n=!1,e=e.parent);for(var s=t;s;){if(e)switch(a){case Ur:return Br(ao(s,e,n));case Wr:return ao(s,e,n).renderer;case Zr:return new Qe(Rn(s,e.nodeIndex).renderElement);case qr:return Rn(s,e.nodeIndex).viewContainer;case Gr:if(e.element.template)return Rn(s,e.nodeIndex).template;break;case Kr:return Dr(ao(s,e,n));case Qr:case Yr:return Fr(s,e);default:var u=(n?e.element.allProviders:e.element.publicProviders)[a];if(u){var c=Dn(s,u.nodeIndex);return c||(c={instance:no(s,u)},s.nodes[u.nodeIndex]=c),c.instance}}n=$n(s),e=Xn(s),s=s.parent,4&r.flags&&(s=null)}var l=i.root.injector.get(r.token,oo);return l!==oo||o===oo?l:i.root.ngModule.injector.get(r.token,o)}function ao(t,e,n){var r;if(n)r=Rn(t,e.nodeIndex).componentView;else for(r=t;r.parent&&!$n(r);)r=r.parent;return r}function so(t,e,n,r,o,i){if(32768&n.flags){var a=Rn(t,n.parent.nodeIndex).componentView;2&a.def.flags&&(a.state|=8)}if(e.instance[n.bindings[r].name]=o,524288&n.flags){i=i||{};var s=De.unwrap(t.oldValues[n.bindingIndex+r]);i[n.bindings[r].nonMinifiedName]=new je(s,o,0!=(2&t.state))}return t.oldValues[n.bindingIndex+r]=o,i}function uo(t,e){if(t.def.nodeFlags&e)for(var n=t.def.nodes,r=0,o=0;o<n.length;o++){var i=n[o],a=i.parent;for(!a&&i.flags&e&&lo(t,o,i.flags&e,r++),0==(i.childFlags&e)&&(o+=i.childCount);a&&1&a.flags&&o===a.nodeIndex+a.childCount;)a.directChildFlags&e&&(r=co(t,a,e,r)),a=a.parent}}function co(t,e,n,r){for(var o=e.nodeIndex+1;o<=e.nodeIndex+e.childCount;o++){var i=t.def.nodes[o];i.flags&n&&lo(t,o,i.flags&n,r++),o+=i.childCount}return r}function lo(t,e,n,r){var o=Dn(t,e);if(o){var i=o.instance;i&&(jn.setCurrentNode(t,e),1048576&n&&On(t,512,r)&&i.ngAfterContentInit(),2097152&n&&i.ngAfterContentChecked(),4194304&n&&On(t,768,r)&&i.ngAfterViewInit(),8388608&n&&i.ngAfterViewChecked(),131072&n&&i.ngOnDestroy())}}var fo=new kt("SCHEDULER_TOKEN",{providedIn:"root",factory:function(){return Gt}}),po=function(t){function e(e){void 0===e&&(e=!1);var n=t.call(this)||this;return n.__isAsync=e,n}return o(e,t),e.prototype.emit=function(e){t.prototype.next.call(this,e)},e.prototype.subscribe=function(e,n,r){var o,i=function(t){return null},a=function(){return null};e&&"object"==typeof e?(o=this.__isAsync?function(t){setTimeout(function(){return e.next(t)})}:function(t){e.next(t)},e.error&&(i=this.__isAsync?function(t){setTimeout(function(){return e.error(t)})}:function(t){e.error(t)}),e.complete&&(a=this.__isAsync?function(){setTimeout(function(){return e.complete()})}:function(){e.complete()})):(o=this.__isAsync?function(t){setTimeout(function(){return e(t)})}:function(t){e(t)},n&&(i=this.__isAsync?function(t){setTimeout(function(){return n(t)})}:function(t){n(t)}),r&&(a=this.__isAsync?function(){setTimeout(function(){return r()})}:function(){r()}));var s=t.prototype.subscribe.call(this,o,i,a);return e instanceof m&&e.add(s),s},e}(N),ho=function(){function t(){this.dirty=!0,this._results=[],this.changes=new po,this.length=0}return t.prototype.map=function(t){return this._results.map(t)},t.prototype.filter=function(t){return this._results.filter(t)},t.prototype.find=function(t){return this._results.find(t)},t.prototype.reduce=function(t,e){return this._results.reduce(t,e)},t.prototype.forEach=function(t){this._results.forEach(t)},t.prototype.some=function(t){return this._results.some(t)},t.prototype.toArray=function(){return this._results.slice()},t.prototype[Oe()]=function(){return this._results[Oe()]()},t.prototype.toString=function(){return this._results.toString()},t.prototype.reset=function(t){this._results=function t(e,n){void 0===n&&(n=e);for(var r=0;r<e.length;r++){var o=e[r];Array.isArray(o)?(n===e&&(n=e.slice(0,r)),t(o,n)):n!==e&&n.push(o)}return n}(t),this.dirty=!1,this.length=this._results.length,this.last=this._results[this.length-1],this.first=this._results[0]},t.prototype.notifyOnChanges=function(){this.changes.emit(this)},t.prototype.setDirty=function(){this.dirty=!0},t.prototype.destroy=function(){this.changes.complete(),this.changes.unsubscribe()},t}(),yo=new kt("Application Initializer"),vo=function(){function t(t){var e=this;this.appInits=t,this.initialized=!1,this.done=!1,this.donePromise=new Promise(function(t,n){e.resolve=t,e.reject=n})}return t.prototype.runInitializers=function(){var t=this;if(!this.initialized){var e=[],n=function(){t.done=!0,t.resolve()};if(this.appInits)for(var r=0;r<this.appInits.length;r++){var o=this.appInits[r]();Ie(o)&&e.push(o)}Promise.all(e).then(function(){n()}).catch(function(e){t.reject(e)}),0===e.length&&n(),this.initialized=!0}},t}(),go=new kt("AppId");function mo(){return""+bo()+bo()+bo()}function bo(){return String.fromCharCode(97+Math.floor(25*Math.random()))}var _o=new kt("Platform Initializer"),wo=new kt("Platform ID"),Eo=new kt("appBootstrapListener"),So=function(){function t(){}return t.prototype.log=function(t){console.log(t)},t.prototype.warn=function(t){console.warn(t)},t}();function xo(){throw new Error("Runtime compiler is not loaded")}var To,ko,Ao=xo,Io=xo,Co=xo,Oo=xo,Po=function(){function t(){this.compileModuleSync=Ao,this.compileModuleAsync=Io,this.compileModuleAndAllComponentsSync=Co,this.compileModuleAndAllComponentsAsync=Oo}return t.prototype.clearCache=function(){},t.prototype.clearCacheFor=function(t){},t.prototype.getModuleId=function(t){},t}(),Ro=function(){return function(){}}();function Do(){var t=St.wtf;return!(!t||!(To=t.trace)||(ko=To.events,0))}var No=Do();function Mo(t,e){return null}var jo=No?function(t,e){return void 0===e&&(e=null),ko.createScope(t,e)}:function(t,e){return Mo},Fo=No?function(t,e){return To.leaveScope(t,e),e}:function(t,e){return e},Lo=function(){return Promise.resolve(0)}();function Bo(t){"undefined"==typeof Zone?Lo.then(function(){t&&t.apply(null,null)}):Zone.current.scheduleMicroTask("scheduleMicrotask",t)}var Vo=function(){function t(t){var e,n=t.enableLongStackTrace,r=void 0!==n&&n;if(this.hasPendingMicrotasks=!1,this.hasPendingMacrotasks=!1,this.isStable=!0,this.onUnstable=new po(!1),this.onMicrotaskEmpty=new po(!1),this.onStable=new po(!1),this.onError=new po(!1),"undefined"==typeof Zone)throw new Error("In this configuration Angular requires Zone.js");Zone.assertZonePatched(),this._nesting=0,this._outer=this._inner=Zone.current,Zone.wtfZoneSpec&&(this._inner=this._inner.fork(Zone.wtfZoneSpec)),Zone.TaskTrackingZoneSpec&&(this._inner=this._inner.fork(new Zone.TaskTrackingZoneSpec)),r&&Zone.longStackTraceZoneSpec&&(this._inner=this._inner.fork(Zone.longStackTraceZoneSpec)),(e=this)._inner=e._inner.fork({name:"angular",properties:{isAngularZone:!0},onInvokeTask:function(t,n,r,o,i,a){try{return Wo(e),t.invokeTask(r,o,i,a)}finally{Zo(e)}},onInvoke:function(t,n,r,o,i,a,s){try{return Wo(e),t.invoke(r,o,i,a,s)}finally{Zo(e)}},onHasTask:function(t,n,r,o){t.hasTask(r,o),n===r&&("microTask"==o.change?(e.hasPendingMicrotasks=o.microTask,Uo(e)):"macroTask"==o.change&&(e.hasPendingMacrotasks=o.macroTask))},onHandleError:function(t,n,r,o){return t.handleError(r,o),e.runOutsideAngular(function(){return e.onError.emit(o)}),!1}})}return t.isInAngularZone=function(){return!0===Zone.current.get("isAngularZone")},t.assertInAngularZone=function(){if(!t.isInAngularZone())throw new Error("Expected to be in Angular Zone, but it is not!")},t.assertNotInAngularZone=function(){if(t.isInAngularZone())throw new Error("Expected to not be in Angular Zone, but it is!")},t.prototype.run=function(t,e,n){return this._inner.run(t,e,n)},t.prototype.runTask=function(t,e,n,r){var o=this._inner,i=o.scheduleEventTask("NgZoneEvent: "+r,t,zo,Ho,Ho);try{return o.runTask(i,e,n)}finally{o.cancelTask(i)}},t.prototype.runGuarded=function(t,e,n){return this._inner.runGuarded(t,e,n)},t.prototype.runOutsideAngular=function(t){return this._outer.run(t)},t}();function Ho(){}var zo={};function Uo(t){if(0==t._nesting&&!t.hasPendingMicrotasks&&!t.isStable)try{t._nesting++,t.onMicrotaskEmpty.emit(null)}finally{if(t._nesting--,!t.hasPendingMicrotasks)try{t.runOutsideAngular(function(){return t.onStable.emit(null)})}finally{t.isStable=!0}}}function Wo(t){t._nesting++,t.isStable&&(t.isStable=!1,t.onUnstable.emit(null))}function Zo(t){t._nesting--,Uo(t)}var qo,Go=function(){function t(){this.hasPendingMicrotasks=!1,this.hasPendingMacrotasks=!1,this.isStable=!0,this.onUnstable=new po,this.onMicrotaskEmpty=new po,this.onStable=new po,this.onError=new po}return t.prototype.run=function(t){return t()},t.prototype.runGuarded=function(t){return t()},t.prototype.runOutsideAngular=function(t){return t()},t.prototype.runTask=function(t){return t()},t}(),Ko=function(){function t(t){var e=this;this._ngZone=t,this._pendingCount=0,this._isZoneStable=!0,this._didWork=!1,this._callbacks=[],this.taskTrackingZone=null,this._watchAngularEvents(),t.run(function(){e.taskTrackingZone="undefined"==typeof Zone?null:Zone.current.get("TaskTrackingZone")})}return t.prototype._watchAngularEvents=function(){var t=this;this._ngZone.onUnstable.subscribe({next:function(){t._didWork=!0,t._isZoneStable=!1}}),this._ngZone.runOutsideAngular(function(){t._ngZone.onStable.subscribe({next:function(){Vo.assertNotInAngularZone(),Bo(function(){t._isZoneStable=!0,t._runCallbacksIfReady()})}})})},t.prototype.increasePendingRequestCount=function(){return this._pendingCount+=1,this._didWork=!0,this._pendingCount},t.prototype.decreasePendingRequestCount=function(){if(this._pendingCount-=1,this._pendingCount<0)throw new Error("pending async requests below zero");return this._runCallbacksIfReady(),this._pendingCount},t.prototype.isStable=function(){return this._isZoneStable&&0===this._pendingCount&&!this._ngZone.hasPendingMacrotasks},t.prototype._runCallbacksIfReady=function(){var t=this;if(this.isStable())Bo(function(){for(;0!==t._callbacks.length;){var e=t._callbacks.pop();clearTimeout(e.timeoutId),e.doneCb(t._didWork)}t._didWork=!1});else{var e=this.getPendingTasks();this._callbacks=this._callbacks.filter(function(t){return!t.updateCb||!t.updateCb(e)||(clearTimeout(t.timeoutId),!1)}),this._didWork=!0}},t.prototype.getPendingTasks=function(){return this.taskTrackingZone?this.taskTrackingZone.macroTasks.map(function(t){return{source:t.source,creationLocation:t.creationLocation,data:t.data}}):[]},t.prototype.addCallback=function(t,e,n){var r=this,o=-1;e&&e>0&&(o=setTimeout(function(){r._callbacks=r._callbacks.filter(function(t){return t.timeoutId!==o}),t(r._didWork,r.getPendingTasks())},e)),this._callbacks.push({doneCb:t,timeoutId:o,updateCb:n})},t.prototype.whenStable=function(t,e,n){if(n&&!this.taskTrackingZone)throw new Error('Task tracking zone is required when passing an update callback to whenStable(). Is "zone.js/dist/task-tracking.js" loaded?');this.addCallback(t,e,n),this._runCallbacksIfReady()},t.prototype.getPendingRequestCount=function(){return this._pendingCount},t.prototype.findProviders=function(t,e,n){return[]},t}(),Qo=function(){function t(){this._applications=new Map,Yo.addToWindow(this)}return t.prototype.registerApplication=function(t,e){this._applications.set(t,e)},t.prototype.unregisterApplication=function(t){this._applications.delete(t)},t.prototype.unregisterAllApplications=function(){this._applications.clear()},t.prototype.getTestability=function(t){return this._applications.get(t)||null},t.prototype.getAllTestabilities=function(){return Array.from(this._applications.values())},t.prototype.getAllRootElements=function(){return Array.from(this._applications.keys())},t.prototype.findTestabilityInTree=function(t,e){return void 0===e&&(e=!0),Yo.findTestabilityInTree(this,t,e)},a([s("design:paramtypes",[])],t)}(),Yo=new(function(){function t(){}return t.prototype.addToWindow=function(t){},t.prototype.findTestabilityInTree=function(t,e,n){return null},t}()),Xo=new kt("AllowMultipleToken"),Jo=function(){return function(t,e){this.name=t,this.token=e}}();function $o(t,e,n){void 0===n&&(n=[]);var r="Platform: "+e,o=new kt(r);return function(e){void 0===e&&(e=[]);var i=ti();if(!i||i.injector.get(Xo,!1))if(t)t(n.concat(e).concat({provide:o,useValue:!0}));else{var a=n.concat(e).concat({provide:o,useValue:!0});!function(t){if(qo&&!qo.destroyed&&!qo.injector.get(Xo,!1))throw new Error("There can be only one platform. Destroy the previous one to create a new one.");qo=t.get(ei);var e=t.get(_o,null);e&&e.forEach(function(t){return t()})}(Pt.create({providers:a,name:r}))}return function(t){var e=ti();if(!e)throw new Error("No platform exists!");if(!e.injector.get(t,null))throw new Error("A platform with a different configuration has been created. Please destroy it first.");return e}(o)}}function ti(){return qo&&!qo.destroyed?qo:null}var ei=function(){function t(t){this._injector=t,this._modules=[],this._destroyListeners=[],this._destroyed=!1}return t.prototype.bootstrapModuleFactory=function(t,e){var n,r=this,o="noop"===(n=e?e.ngZone:void 0)?new Go:("zone.js"===n?void 0:n)||new Vo({enableLongStackTrace:te()}),i=[{provide:Vo,useValue:o}];return o.run(function(){var e=Pt.create({providers:i,parent:r.injector,name:t.moduleType.name}),n=t.create(e),a=n.injector.get(Xt,null);if(!a)throw new Error("No ErrorHandler. Is platform module (BrowserModule) included?");return n.onDestroy(function(){return oi(r._modules,n)}),o.runOutsideAngular(function(){return o.onError.subscribe({next:function(t){a.handleError(t)}})}),function(t,e,o){try{var i=((a=n.injector.get(vo)).runInitializers(),a.donePromise.then(function(){return r._moduleDoBootstrap(n),n}));return Ie(i)?i.catch(function(n){throw e.runOutsideAngular(function(){return t.handleError(n)}),n}):i}catch(s){throw e.runOutsideAngular(function(){return t.handleError(s)}),s}var a}(a,o)})},t.prototype.bootstrapModule=function(t,e){var n=this;void 0===e&&(e=[]);var r=ni({},e);return function(t,e,n){return t.get(Ro).createCompiler([e]).compileModuleAsync(n)}(this.injector,r,t).then(function(t){return n.bootstrapModuleFactory(t,r)})},t.prototype._moduleDoBootstrap=function(t){var e=t.injector.get(ri);if(t._bootstrapComponents.length>0)t._bootstrapComponents.forEach(function(t){return e.bootstrap(t)});else{if(!t.instance.ngDoBootstrap)throw new Error("The module "+gt(t.instance.constructor)+' was bootstrapped, but it does not declare "@NgModule.bootstrap" components nor a "ngDoBootstrap" method. Please define one of these.');t.instance.ngDoBootstrap(e)}this._modules.push(t)},t.prototype.onDestroy=function(t){this._destroyListeners.push(t)},Object.defineProperty(t.prototype,"injector",{get:function(){return this._injector},enumerable:!0,configurable:!0}),t.prototype.destroy=function(){if(this._destroyed)throw new Error("The platform has already been destroyed!");this._modules.slice().forEach(function(t){return t.destroy()}),this._destroyListeners.forEach(function(t){return t()}),this._destroyed=!0},Object.defineProperty(t.prototype,"destroyed",{get:function(){return this._destroyed},enumerable:!0,configurable:!0}),t}();function ni(t,e){return Array.isArray(e)?e.reduce(ni,t):i({},t,e)}var ri=function(){function t(t,e,n,r,o,i){var a=this;this._zone=t,this._console=e,this._injector=n,this._exceptionHandler=r,this._componentFactoryResolver=o,this._initStatus=i,this._bootstrapListeners=[],this._views=[],this._runningTick=!1,this._enforceNoNewChanges=!1,this._stable=!0,this.componentTypes=[],this.components=[],this._enforceNoNewChanges=te(),this._zone.onMicrotaskEmpty.subscribe({next:function(){a._zone.run(function(){a.tick()})}});var s=new I(function(t){a._stable=a._zone.isStable&&!a._zone.hasPendingMacrotasks&&!a._zone.hasPendingMicrotasks,a._zone.runOutsideAngular(function(){t.next(a._stable),t.complete()})}),u=new I(function(t){var e;a._zone.runOutsideAngular(function(){e=a._zone.onStable.subscribe(function(){Vo.assertNotInAngularZone(),Bo(function(){a._stable||a._zone.hasPendingMacrotasks||a._zone.hasPendingMicrotasks||(a._stable=!0,t.next(!0))})})});var n=a._zone.onUnstable.subscribe(function(){Vo.assertInAngularZone(),a._stable&&(a._stable=!1,a._zone.runOutsideAngular(function(){t.next(!1)}))});return function(){e.unsubscribe(),n.unsubscribe()}});this.isStable=function(){for(var t=[],e=0;e<arguments.length;e++)t[e]=arguments[e];var n,r=Number.POSITIVE_INFINITY,o=null,i=t[t.length-1];return(n=i)&&"function"==typeof n.schedule?(o=t.pop(),t.length>1&&"number"==typeof t[t.length-1]&&(r=t.pop())):"number"==typeof i&&(r=t.pop()),null===o&&1===t.length&&t[0]instanceof I?t[0]:function(t){return void 0===t&&(t=Number.POSITIVE_INFINITY),function t(e,n,r){return void 0===r&&(r=Number.POSITIVE_INFINITY),"function"==typeof n?function(o){return o.pipe(t(function(t,r){return(o=e(t,r),o instanceof I?o:new I(Z(o))).pipe(function(t,e){return function(e){return e.lift(new G(t,void 0))}}(function(e,o){return n(t,e,r,o)}));var o},r))}:("number"==typeof n&&(r=n),function(t){return t.lift(new Y(e,r))})}(J,t)}(r)(Q(t,o))}(s,u.pipe(function(t){return $()((e=it,function(t){var n;n="function"==typeof e?e:function(){return e};var r=Object.create(t,rt);return r.source=t,r.subjectFactory=n,r})(t));var e}))}var e;return e=t,t.prototype.bootstrap=function(t,e){var n,r=this;if(!this._initStatus.done)throw new Error("Cannot bootstrap as there are still asynchronous initializers running. Bootstrap components in the `ngDoBootstrap` method of the root module.");n=t instanceof Be?t:this._componentFactoryResolver.resolveComponentFactory(t),this.componentTypes.push(n.componentType);var o=n instanceof Ze?null:this._injector.get(qe),i=n.create(Pt.NULL,[],e||n.selector,o);i.onDestroy(function(){r._unloadComponent(i)});var a=i.injector.get(Ko,null);return a&&i.injector.get(Qo).registerApplication(i.location.nativeElement,a),this._loadComponent(i),te()&&this._console.log("Angular is running in the development mode. Call enableProdMode() to enable the production mode."),i},t.prototype.tick=function(){var t,n,r,o,i=this;if(this._runningTick)throw new Error("ApplicationRef.tick is called recursively");var a=e._tickScope();try{this._runningTick=!0;try{for(var s=l(this._views),u=s.next();!u.done;u=s.next())u.value.detectChanges()}catch(p){t={error:p}}finally{try{u&&!u.done&&(n=s.return)&&n.call(s)}finally{if(t)throw t.error}}if(this._enforceNoNewChanges)try{for(var c=l(this._views),f=c.next();!f.done;f=c.next())f.value.checkNoChanges()}catch(h){r={error:h}}finally{try{f&&!f.done&&(o=c.return)&&o.call(c)}finally{if(r)throw r.error}}}catch(d){this._zone.runOutsideAngular(function(){return i._exceptionHandler.handleError(d)})}finally{this._runningTick=!1,Fo(a)}},t.prototype.attachView=function(t){var e=t;this._views.push(e),e.attachToAppRef(this)},t.prototype.detachView=function(t){var e=t;oi(this._views,e),e.detachFromAppRef()},t.prototype._loadComponent=function(t){this.attachView(t.hostView),this.tick(),this.components.push(t),this._injector.get(Eo,[]).concat(this._bootstrapListeners).forEach(function(e){return e(t)})},t.prototype._unloadComponent=function(t){this.detachView(t.hostView),oi(this.components,t)},t.prototype.ngOnDestroy=function(){this._views.slice().forEach(function(t){return t.destroy()})},Object.defineProperty(t.prototype,"viewCount",{get:function(){return this._views.length},enumerable:!0,configurable:!0}),t._tickScope=jo("ApplicationRef#tick()"),t}();function oi(t,e){var n=t.indexOf(e);n>-1&&t.splice(n,1)}var ii=function(){return function(t,e){this.name=t,this.callback=e}}(),ai=function(){function t(t,e,n){this.listeners=[],this.parent=null,this._debugContext=n,this.nativeNode=t,e&&e instanceof si&&e.addChild(this)}return Object.defineProperty(t.prototype,"injector",{get:function(){return this._debugContext.injector},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"componentInstance",{get:function(){return this._debugContext.component},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"context",{get:function(){return this._debugContext.context},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"references",{get:function(){return this._debugContext.references},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"providerTokens",{get:function(){return this._debugContext.providerTokens},enumerable:!0,configurable:!0}),t}(),si=function(t){function e(e,n,r){var o=t.call(this,e,n,r)||this;return o.properties={},o.attributes={},o.classes={},o.styles={},o.childNodes=[],o.nativeElement=e,o}return o(e,t),e.prototype.addChild=function(t){t&&(this.childNodes.push(t),t.parent=this)},e.prototype.removeChild=function(t){var e=this.childNodes.indexOf(t);-1!==e&&(t.parent=null,this.childNodes.splice(e,1))},e.prototype.insertChildrenAfter=function(t,e){var n,r=this,o=this.childNodes.indexOf(t);-1!==o&&((n=this.childNodes).splice.apply(n,p([o+1,0],e)),e.forEach(function(e){e.parent&&e.parent.removeChild(e),t.parent=r}))},e.prototype.insertBefore=function(t,e){var n=this.childNodes.indexOf(t);-1===n?this.addChild(e):(e.parent&&e.parent.removeChild(e),e.parent=this,this.childNodes.splice(n,0,e))},e.prototype.query=function(t){return this.queryAll(t)[0]||null},e.prototype.queryAll=function(t){var e=[];return function t(e,n,r){e.childNodes.forEach(function(e){e instanceof si&&(n(e)&&r.push(e),t(e,n,r))})}(this,t,e),e},e.prototype.queryAllNodes=function(t){var e=[];return function t(e,n,r){e instanceof si&&e.childNodes.forEach(function(e){n(e)&&r.push(e),e instanceof si&&t(e,n,r)})}(this,t,e),e},Object.defineProperty(e.prototype,"children",{get:function(){return this.childNodes.filter(function(t){return t instanceof e})},enumerable:!0,configurable:!0}),e.prototype.triggerEventHandler=function(t,e){this.listeners.forEach(function(n){n.name==t&&n.callback(e)})},e}(ai),ui=new Map,ci=function(t){return ui.get(t)||null};function li(t){ui.set(t.nativeNode,t)}var fi=$o(null,"core",[{provide:wo,useValue:"unknown"},{provide:ei,deps:[Pt]},{provide:Qo,deps:[]},{provide:So,deps:[]}]),pi=new kt("LocaleId");function hi(){return _n}function di(){return wn}function yi(t){return t||"en-US"}function vi(t){var e=[];return t.onStable.subscribe(function(){for(;e.length;)e.pop()()}),function(t){e.push(t)}}var gi=function(){return function(t){}}();function mi(t,e,n,r,o,i){t|=1;var a=nr(e);return{nodeIndex:-1,parent:null,renderParent:null,bindingIndex:-1,outputIndex:-1,flags:t,checkIndex:-1,childFlags:0,directChildFlags:0,childMatchedQueries:0,matchedQueries:a.matchedQueries,matchedQueryIds:a.matchedQueryIds,references:a.references,ngContentIndex:n,childCount:r,bindings:[],bindingFlags:0,outputs:[],element:{ns:null,name:null,attrs:null,template:i?ar(i):null,componentProvider:null,componentView:null,componentRendererType:null,publicProviders:null,allProviders:null,handleEvent:o||Fn},provider:null,text:null,query:null,ngContent:null}}function bi(t,e,n,r,o,i,a,s,u,c,l,p){var h;void 0===a&&(a=[]),c||(c=Fn);var d=nr(n),y=d.matchedQueries,v=d.references,g=d.matchedQueryIds,m=null,b=null;i&&(m=(h=f(hr(i),2))[0],b=h[1]),s=s||[];for(var _=new Array(s.length),w=0;w<s.length;w++){var E=f(s[w],3),S=E[0],x=E[2],T=f(hr(E[1]),2),k=T[0],A=T[1],I=void 0,C=void 0;switch(15&S){case 4:C=x;break;case 1:case 8:I=x}_[w]={flags:S,ns:k,name:A,nonMinifiedName:A,securityContext:I,suffix:C}}u=u||[];var O=new Array(u.length);for(w=0;w<u.length;w++){var P=f(u[w],2);O[w]={type:0,target:P[0],eventName:P[1],propName:null}}var R=(a=a||[]).map(function(t){var e=f(t,2),n=e[1],r=f(hr(e[0]),2);return[r[0],r[1],n]});return p=function(t){if(t&&t.id===Vn){var e=null!=t.encapsulation&&t.encapsulation!==qt.None||t.styles.length||Object.keys(t.data).length;t.id=e?"c"+Un++:Hn}return t&&t.id===Hn&&(t=null),t||null}(p),l&&(e|=33554432),{nodeIndex:-1,parent:null,renderParent:null,bindingIndex:-1,outputIndex:-1,checkIndex:t,flags:e|=1,childFlags:0,directChildFlags:0,childMatchedQueries:0,matchedQueries:y,matchedQueryIds:g,references:v,ngContentIndex:r,childCount:o,bindings:_,bindingFlags:dr(_),outputs:O,element:{ns:m,name:b,attrs:R,template:null,componentProvider:null,componentView:l||null,componentRendererType:p,publicProviders:null,allProviders:null,handleEvent:c||Fn},provider:null,text:null,query:null,ngContent:null}}function _i(t,e,n){var r,o=n.element,i=t.root.selectorOrNode,a=t.renderer;if(t.parent||!i){r=o.name?a.createElement(o.name,o.ns):a.createComment("");var s=or(t,e,n);s&&a.appendChild(s,r)}else r=a.selectRootElement(i,!!o.componentRendererType&&o.componentRendererType.encapsulation===qt.ShadowDom);if(o.attrs)for(var u=0;u<o.attrs.length;u++){var c=f(o.attrs[u],3);a.setAttribute(r,c[1],c[2],c[0])}return r}function wi(t,e,n,r){for(var o=0;o<n.outputs.length;o++){var i=n.outputs[o],a=Ei(t,n.nodeIndex,(f=i.eventName,(l=i.target)?l+":"+f:f)),s=i.target,u=t;"component"===i.target&&(s=null,u=e);var c=u.renderer.listen(s||r,i.eventName,a);t.disposables[n.outputIndex+o]=c}var l,f}function Ei(t,e,n){return function(r){return Qn(t,e,n,r)}}function Si(t,e,n,r){if(!Zn(t,e,n,r))return!1;var o=e.bindings[n],i=Rn(t,e.nodeIndex),a=i.renderElement,s=o.name;switch(15&o.flags){case 1:!function(t,e,n,r,o,i){var a=e.securityContext,s=a?t.root.sanitizer.sanitize(a,i):i;s=null!=s?s.toString():null;var u=t.renderer;null!=i?u.setAttribute(n,o,s,r):u.removeAttribute(n,o,r)}(t,o,a,o.ns,s,r);break;case 2:!function(t,e,n,r){var o=t.renderer;r?o.addClass(e,n):o.removeClass(e,n)}(t,a,s,r);break;case 4:!function(t,e,n,r,o){var i=t.root.sanitizer.sanitize(Ee.STYLE,o);if(null!=i){i=i.toString();var a=e.suffix;null!=a&&(i+=a)}else i=null;var s=t.renderer;null!=i?s.setStyle(n,r,i):s.removeStyle(n,r)}(t,o,a,s,r);break;case 8:!function(t,e,n,r,o){var i=e.securityContext,a=i?t.root.sanitizer.sanitize(i,o):o;t.renderer.setProperty(n,r,a)}(33554432&e.flags&&32&o.flags?i.componentView:t,o,a,s,r)}return!0}function xi(t,e,n){var r=[];for(var o in n)r.push({propName:o,bindingType:n[o]});return{nodeIndex:-1,parent:null,renderParent:null,bindingIndex:-1,outputIndex:-1,checkIndex:-1,flags:t,childFlags:0,directChildFlags:0,childMatchedQueries:0,ngContentIndex:-1,matchedQueries:{},matchedQueryIds:0,references:{},childCount:0,bindings:[],bindingFlags:0,outputs:[],element:null,provider:null,text:null,query:{id:e,filterId:er(e),bindings:r},ngContent:null}}function Ti(t){for(var e=t.def.nodeMatchedQueries;t.parent&&tr(t);){var n=t.parentNodeDef;t=t.parent;for(var r=n.nodeIndex+n.childCount,o=0;o<=r;o++)67108864&(i=t.def.nodes[o]).flags&&536870912&i.flags&&(i.query.filterId&e)===i.query.filterId&&Mn(t,o).setDirty(),!(1&i.flags&&o+i.childCount<n.nodeIndex)&&67108864&i.childFlags&&536870912&i.childFlags||(o+=i.childCount)}if(134217728&t.def.nodeFlags)for(o=0;o<t.def.nodes.length;o++){var i;134217728&(i=t.def.nodes[o]).flags&&536870912&i.flags&&Mn(t,o).setDirty(),o+=i.childCount}}function ki(t,e){var n=Mn(t,e.nodeIndex);if(n.dirty){var r,o=void 0;if(67108864&e.flags){var i=e.parent.parent;o=Ai(t,i.nodeIndex,i.nodeIndex+i.childCount,e.query,[]),r=Dn(t,e.parent.nodeIndex).instance}else 134217728&e.flags&&(o=Ai(t,0,t.def.nodes.length-1,e.query,[]),r=t.component);n.reset(o);for(var a=e.query.bindings,s=!1,u=0;u<a.length;u++){var c=a[u],l=void 0;switch(c.bindingType){case 0:l=n.first;break;case 1:l=n,s=!0}r[c.propName]=l}s&&n.notifyOnChanges()}}function Ai(t,e,n,r,o){for(var i=e;i<=n;i++){var a=t.def.nodes[i],s=a.matchedQueries[r.id];if(null!=s&&o.push(Ii(t,a,s)),1&a.flags&&a.element.template&&(a.element.template.nodeMatchedQueries&r.filterId)===r.filterId){var u=Rn(t,i);if((a.childMatchedQueries&r.filterId)===r.filterId&&(Ai(t,i+1,i+a.childCount,r,o),i+=a.childCount),16777216&a.flags)for(var c=u.viewContainer._embeddedViews,l=0;l<c.length;l++){var f=c[l],p=Yn(f);p&&p===u&&Ai(f,0,f.def.nodes.length-1,r,o)}var h=u.template._projectedViews;if(h)for(l=0;l<h.length;l++){var d=h[l];Ai(d,0,d.def.nodes.length-1,r,o)}}(a.childMatchedQueries&r.filterId)!==r.filterId&&(i+=a.childCount)}return o}function Ii(t,e,n){if(null!=n)switch(n){case 1:return Rn(t,e.nodeIndex).renderElement;case 0:return new Qe(Rn(t,e.nodeIndex).renderElement);case 2:return Rn(t,e.nodeIndex).template;case 3:return Rn(t,e.nodeIndex).viewContainer;case 4:return Dn(t,e.nodeIndex).instance}}function Ci(t,e,n){var r=or(t,e,n);r&&cr(t,n.ngContent.index,1,r,null,void 0)}function Oi(t,e,n){for(var r=new Array(n.length-1),o=1;o<n.length;o++)r[o-1]={flags:8,name:null,ns:null,nonMinifiedName:null,securityContext:null,suffix:n[o]};return{nodeIndex:-1,parent:null,renderParent:null,bindingIndex:-1,outputIndex:-1,checkIndex:t,flags:2,childFlags:0,directChildFlags:0,childMatchedQueries:0,matchedQueries:{},matchedQueryIds:0,references:{},ngContentIndex:e,childCount:0,bindings:r,bindingFlags:8,outputs:[],element:null,provider:null,text:{prefix:n[0]},query:null,ngContent:null}}function Pi(t,e,n){var r,o=t.renderer;r=o.createText(n.text.prefix);var i=or(t,e,n);return i&&o.appendChild(i,r),{renderText:r}}function Ri(t,e){return(null!=t?t.toString():"")+e.suffix}function Di(t,e,n,r){for(var o=0,i=0,a=0,s=0,u=0,c=null,l=null,f=!1,p=!1,h=null,d=0;d<e.length;d++){var y=e[d];if(y.nodeIndex=d,y.parent=c,y.bindingIndex=o,y.outputIndex=i,y.renderParent=l,a|=y.flags,u|=y.matchedQueryIds,y.element){var v=y.element;v.publicProviders=c?c.element.publicProviders:Object.create(null),v.allProviders=v.publicProviders,f=!1,p=!1,y.element.template&&(u|=y.element.template.nodeMatchedQueries)}if(Mi(c,y,e.length),o+=y.bindings.length,i+=y.outputs.length,!l&&3&y.flags&&(h=y),20224&y.flags){f||(f=!0,c.element.publicProviders=Object.create(c.element.publicProviders),c.element.allProviders=c.element.publicProviders);var g=0!=(32768&y.flags);0==(8192&y.flags)||g?c.element.publicProviders[Bn(y.provider.token)]=y:(p||(p=!0,c.element.allProviders=Object.create(c.element.publicProviders)),c.element.allProviders[Bn(y.provider.token)]=y),g&&(c.element.componentProvider=y)}if(c?(c.childFlags|=y.flags,c.directChildFlags|=y.flags,c.childMatchedQueries|=y.matchedQueryIds,y.element&&y.element.template&&(c.childMatchedQueries|=y.element.template.nodeMatchedQueries)):s|=y.flags,y.childCount>0)c=y,Ni(y)||(l=y);else for(;c&&d===c.nodeIndex+c.childCount;){var m=c.parent;m&&(m.childFlags|=c.childFlags,m.childMatchedQueries|=c.childMatchedQueries),l=(c=m)&&Ni(c)?c.renderParent:c}}return{factory:null,nodeFlags:a,rootNodeFlags:s,nodeMatchedQueries:u,flags:t,nodes:e,updateDirectives:n||Fn,updateRenderer:r||Fn,handleEvent:function(t,n,r,o){return e[n].element.handleEvent(t,r,o)},bindingCount:o,outputCount:i,lastRenderRootNode:h}}function Ni(t){return 0!=(1&t.flags)&&null===t.element.name}function Mi(t,e,n){var r=e.element&&e.element.template;if(r){if(!r.lastRenderRootNode)throw new Error("Illegal State: Embedded templates without nodes are not allowed!");if(r.lastRenderRootNode&&16777216&r.lastRenderRootNode.flags)throw new Error("Illegal State: Last root node of a template can't have embedded views, at index "+e.nodeIndex+"!")}if(20224&e.flags&&0==(1&(t?t.flags:0)))throw new Error("Illegal State: StaticProvider/Directive nodes need to be children of elements or anchors, at index "+e.nodeIndex+"!");if(e.query){if(67108864&e.flags&&(!t||0==(16384&t.flags)))throw new Error("Illegal State: Content Query nodes need to be children of directives, at index "+e.nodeIndex+"!");if(134217728&e.flags&&t)throw new Error("Illegal State: View Query nodes have to be top level nodes, at index "+e.nodeIndex+"!")}if(e.childCount){var o=t?t.nodeIndex+t.childCount:n-1;if(e.nodeIndex<=o&&e.nodeIndex+e.childCount>o)throw new Error("Illegal State: childCount of node leads outside of parent, at index "+e.nodeIndex+"!")}}function ji(t,e,n,r){var o=Bi(t.root,t.renderer,t,e,n);return Vi(o,t.component,r),Hi(o),o}function Fi(t,e,n){var r=Bi(t,t.renderer,null,null,e);return Vi(r,n,n),Hi(r),r}function Li(t,e,n,r){var o,i=e.element.componentRendererType;return o=i?t.root.rendererFactory.createRenderer(r,i):t.root.renderer,Bi(t.root,o,t,e.element.componentProvider,n)}function Bi(t,e,n,r,o){var i=new Array(o.nodes.length),a=o.outputCount?new Array(o.outputCount):null;return{def:o,parent:n,viewContainerParent:null,parentNodeDef:r,context:null,component:null,nodes:i,state:13,root:t,renderer:e,oldValues:new Array(o.bindingCount),disposables:a,initIndex:-1}}function Vi(t,e,n){t.component=e,t.context=n}function Hi(t){var e;$n(t)&&(e=Rn(t.parent,t.parentNodeDef.parent.nodeIndex).renderElement);for(var n=t.def,r=t.nodes,o=0;o<n.nodes.length;o++){var i=n.nodes[o];jn.setCurrentNode(t,o);var a=void 0;switch(201347067&i.flags){case 1:var s=_i(t,e,i),u=void 0;if(33554432&i.flags){var c=ar(i.element.componentView);u=jn.createComponentView(t,i,c,s)}wi(t,u,i,s),a={renderElement:s,componentView:u,viewContainer:null,template:i.element.template?Mr(t,i):void 0},16777216&i.flags&&(a.viewContainer=Pr(t,i,a));break;case 2:a=Pi(t,e,i);break;case 512:case 1024:case 2048:case 256:(a=r[o])||4096&i.flags||(a={instance:Jr(t,i)});break;case 16:a={instance:$r(t,i)};break;case 16384:(a=r[o])||(a={instance:to(t,i)}),32768&i.flags&&Vi(Rn(t,i.parent.nodeIndex).componentView,a.instance,a.instance);break;case 32:case 64:case 128:a={value:void 0};break;case 67108864:case 134217728:a=new ho;break;case 8:Ci(t,e,i),a=void 0}r[o]=a}Yi(t,Qi.CreateViewNodes),ta(t,201326592,268435456,0)}function zi(t){Zi(t),jn.updateDirectives(t,1),Xi(t,Qi.CheckNoChanges),jn.updateRenderer(t,1),Yi(t,Qi.CheckNoChanges),t.state&=-97}function Ui(t){1&t.state?(t.state&=-2,t.state|=2):t.state&=-3,Cn(t,0,256),Zi(t),jn.updateDirectives(t,0),Xi(t,Qi.CheckAndUpdate),ta(t,67108864,536870912,0);var e=Cn(t,256,512);uo(t,2097152|(e?1048576:0)),jn.updateRenderer(t,0),Yi(t,Qi.CheckAndUpdate),ta(t,134217728,536870912,0),uo(t,8388608|((e=Cn(t,512,768))?4194304:0)),2&t.def.flags&&(t.state&=-9),t.state&=-97,Cn(t,768,1024)}function Wi(t,e,n,r,o,i,a,s,u,c,l,f,h){return 0===n?function(t,e,n,r,o,i,a,s,u,c,l,f){switch(201347067&e.flags){case 1:return function(t,e,n,r,o,i,a,s,u,c,l,f){var p=e.bindings.length,h=!1;return p>0&&Si(t,e,0,n)&&(h=!0),p>1&&Si(t,e,1,r)&&(h=!0),p>2&&Si(t,e,2,o)&&(h=!0),p>3&&Si(t,e,3,i)&&(h=!0),p>4&&Si(t,e,4,a)&&(h=!0),p>5&&Si(t,e,5,s)&&(h=!0),p>6&&Si(t,e,6,u)&&(h=!0),p>7&&Si(t,e,7,c)&&(h=!0),p>8&&Si(t,e,8,l)&&(h=!0),p>9&&Si(t,e,9,f)&&(h=!0),h}(t,e,n,r,o,i,a,s,u,c,l,f);case 2:return function(t,e,n,r,o,i,a,s,u,c,l,f){var p=!1,h=e.bindings,d=h.length;if(d>0&&Zn(t,e,0,n)&&(p=!0),d>1&&Zn(t,e,1,r)&&(p=!0),d>2&&Zn(t,e,2,o)&&(p=!0),d>3&&Zn(t,e,3,i)&&(p=!0),d>4&&Zn(t,e,4,a)&&(p=!0),d>5&&Zn(t,e,5,s)&&(p=!0),d>6&&Zn(t,e,6,u)&&(p=!0),d>7&&Zn(t,e,7,c)&&(p=!0),d>8&&Zn(t,e,8,l)&&(p=!0),d>9&&Zn(t,e,9,f)&&(p=!0),p){var y=e.text.prefix;d>0&&(y+=Ri(n,h[0])),d>1&&(y+=Ri(r,h[1])),d>2&&(y+=Ri(o,h[2])),d>3&&(y+=Ri(i,h[3])),d>4&&(y+=Ri(a,h[4])),d>5&&(y+=Ri(s,h[5])),d>6&&(y+=Ri(u,h[6])),d>7&&(y+=Ri(c,h[7])),d>8&&(y+=Ri(l,h[8])),d>9&&(y+=Ri(f,h[9]));var v=Pn(t,e.nodeIndex).renderText;t.renderer.setValue(v,y)}return p}(t,e,n,r,o,i,a,s,u,c,l,f);case 16384:return function(t,e,n,r,o,i,a,s,u,c,l,f){var p=Dn(t,e.nodeIndex),h=p.instance,d=!1,y=void 0,v=e.bindings.length;return v>0&&Wn(t,e,0,n)&&(d=!0,y=so(t,p,e,0,n,y)),v>1&&Wn(t,e,1,r)&&(d=!0,y=so(t,p,e,1,r,y)),v>2&&Wn(t,e,2,o)&&(d=!0,y=so(t,p,e,2,o,y)),v>3&&Wn(t,e,3,i)&&(d=!0,y=so(t,p,e,3,i,y)),v>4&&Wn(t,e,4,a)&&(d=!0,y=so(t,p,e,4,a,y)),v>5&&Wn(t,e,5,s)&&(d=!0,y=so(t,p,e,5,s,y)),v>6&&Wn(t,e,6,u)&&(d=!0,y=so(t,p,e,6,u,y)),v>7&&Wn(t,e,7,c)&&(d=!0,y=so(t,p,e,7,c,y)),v>8&&Wn(t,e,8,l)&&(d=!0,y=so(t,p,e,8,l,y)),v>9&&Wn(t,e,9,f)&&(d=!0,y=so(t,p,e,9,f,y)),y&&h.ngOnChanges(y),65536&e.flags&&On(t,256,e.nodeIndex)&&h.ngOnInit(),262144&e.flags&&h.ngDoCheck(),d}(t,e,n,r,o,i,a,s,u,c,l,f);case 32:case 64:case 128:return function(t,e,n,r,o,i,a,s,u,c,l,f){var p=e.bindings,h=!1,d=p.length;if(d>0&&Zn(t,e,0,n)&&(h=!0),d>1&&Zn(t,e,1,r)&&(h=!0),d>2&&Zn(t,e,2,o)&&(h=!0),d>3&&Zn(t,e,3,i)&&(h=!0),d>4&&Zn(t,e,4,a)&&(h=!0),d>5&&Zn(t,e,5,s)&&(h=!0),d>6&&Zn(t,e,6,u)&&(h=!0),d>7&&Zn(t,e,7,c)&&(h=!0),d>8&&Zn(t,e,8,l)&&(h=!0),d>9&&Zn(t,e,9,f)&&(h=!0),h){var y=Nn(t,e.nodeIndex),v=void 0;switch(201347067&e.flags){case 32:v=new Array(p.length),d>0&&(v[0]=n),d>1&&(v[1]=r),d>2&&(v[2]=o),d>3&&(v[3]=i),d>4&&(v[4]=a),d>5&&(v[5]=s),d>6&&(v[6]=u),d>7&&(v[7]=c),d>8&&(v[8]=l),d>9&&(v[9]=f);break;case 64:v={},d>0&&(v[p[0].name]=n),d>1&&(v[p[1].name]=r),d>2&&(v[p[2].name]=o),d>3&&(v[p[3].name]=i),d>4&&(v[p[4].name]=a),d>5&&(v[p[5].name]=s),d>6&&(v[p[6].name]=u),d>7&&(v[p[7].name]=c),d>8&&(v[p[8].name]=l),d>9&&(v[p[9].name]=f);break;case 128:var g=n;switch(d){case 1:v=g.transform(n);break;case 2:v=g.transform(r);break;case 3:v=g.transform(r,o);break;case 4:v=g.transform(r,o,i);break;case 5:v=g.transform(r,o,i,a);break;case 6:v=g.transform(r,o,i,a,s);break;case 7:v=g.transform(r,o,i,a,s,u);break;case 8:v=g.transform(r,o,i,a,s,u,c);break;case 9:v=g.transform(r,o,i,a,s,u,c,l);break;case 10:v=g.transform(r,o,i,a,s,u,c,l,f)}}y.value=v}return h}(t,e,n,r,o,i,a,s,u,c,l,f);default:throw"unreachable"}}(t,e,r,o,i,a,s,u,c,l,f,h):function(t,e,n){switch(201347067&e.flags){case 1:return function(t,e,n){for(var r=!1,o=0;o<n.length;o++)Si(t,e,o,n[o])&&(r=!0);return r}(t,e,n);case 2:return function(t,e,n){for(var r=e.bindings,o=!1,i=0;i<n.length;i++)Zn(t,e,i,n[i])&&(o=!0);if(o){var a="";for(i=0;i<n.length;i++)a+=Ri(n[i],r[i]);a=e.text.prefix+a;var s=Pn(t,e.nodeIndex).renderText;t.renderer.setValue(s,a)}return o}(t,e,n);case 16384:return function(t,e,n){for(var r=Dn(t,e.nodeIndex),o=r.instance,i=!1,a=void 0,s=0;s<n.length;s++)Wn(t,e,s,n[s])&&(i=!0,a=so(t,r,e,s,n[s],a));return a&&o.ngOnChanges(a),65536&e.flags&&On(t,256,e.nodeIndex)&&o.ngOnInit(),262144&e.flags&&o.ngDoCheck(),i}(t,e,n);case 32:case 64:case 128:return function(t,e,n){for(var r=e.bindings,o=!1,i=0;i<n.length;i++)Zn(t,e,i,n[i])&&(o=!0);if(o){var a=Nn(t,e.nodeIndex),s=void 0;switch(201347067&e.flags){case 32:s=n;break;case 64:for(s={},i=0;i<n.length;i++)s[r[i].name]=n[i];break;case 128:var u=n[0],c=n.slice(1);s=u.transform.apply(u,p(c))}a.value=s}return o}(t,e,n);default:throw"unreachable"}}(t,e,r)}function Zi(t){var e=t.def;if(4&e.nodeFlags)for(var n=0;n<e.nodes.length;n++){var r=e.nodes[n];if(4&r.flags){var o=Rn(t,n).template._projectedViews;if(o)for(var i=0;i<o.length;i++){var a=o[i];a.state|=32,Kn(a,t)}}else 0==(4&r.childFlags)&&(n+=r.childCount)}}function qi(t,e,n,r,o,i,a,s,u,c,l,f,p){return 0===n?function(t,e,n,r,o,i,a,s,u,c,l,f){var p=e.bindings.length;p>0&&qn(t,e,0,n),p>1&&qn(t,e,1,r),p>2&&qn(t,e,2,o),p>3&&qn(t,e,3,i),p>4&&qn(t,e,4,a),p>5&&qn(t,e,5,s),p>6&&qn(t,e,6,u),p>7&&qn(t,e,7,c),p>8&&qn(t,e,8,l),p>9&&qn(t,e,9,f)}(t,e,r,o,i,a,s,u,c,l,f,p):function(t,e,n){for(var r=0;r<n.length;r++)qn(t,e,r,n[r])}(t,e,r),!1}function Gi(t,e){if(Mn(t,e.nodeIndex).dirty)throw kn(jn.createDebugContext(t,e.nodeIndex),"Query "+e.query.id+" not dirty","Query "+e.query.id+" dirty",0!=(1&t.state))}function Ki(t){if(!(128&t.state)){if(Xi(t,Qi.Destroy),Yi(t,Qi.Destroy),uo(t,131072),t.disposables)for(var e=0;e<t.disposables.length;e++)t.disposables[e]();!function(t){if(16&t.state){var e=Yn(t);if(e){var n=e.template._projectedViews;n&&(kr(n,n.indexOf(t)),jn.dirtyParentQueries(t))}}}(t),t.renderer.destroyNode&&function(t){for(var e=t.def.nodes.length,n=0;n<e;n++){var r=t.def.nodes[n];1&r.flags?t.renderer.destroyNode(Rn(t,n).renderElement):2&r.flags?t.renderer.destroyNode(Pn(t,n).renderText):(67108864&r.flags||134217728&r.flags)&&Mn(t,n).destroy()}}(t),$n(t)&&t.renderer.destroy(),t.state|=128}}var Qi=function(t){return t[t.CreateViewNodes=0]="CreateViewNodes",t[t.CheckNoChanges=1]="CheckNoChanges",t[t.CheckNoChangesProjectedViews=2]="CheckNoChangesProjectedViews",t[t.CheckAndUpdate=3]="CheckAndUpdate",t[t.CheckAndUpdateProjectedViews=4]="CheckAndUpdateProjectedViews",t[t.Destroy=5]="Destroy",t}({});function Yi(t,e){var n=t.def;if(33554432&n.nodeFlags)for(var r=0;r<n.nodes.length;r++){var o=n.nodes[r];33554432&o.flags?Ji(Rn(t,r).componentView,e):0==(33554432&o.childFlags)&&(r+=o.childCount)}}function Xi(t,e){var n=t.def;if(16777216&n.nodeFlags)for(var r=0;r<n.nodes.length;r++){var o=n.nodes[r];if(16777216&o.flags)for(var i=Rn(t,r).viewContainer._embeddedViews,a=0;a<i.length;a++)Ji(i[a],e);else 0==(16777216&o.childFlags)&&(r+=o.childCount)}}function Ji(t,e){var n=t.state;switch(e){case Qi.CheckNoChanges:0==(128&n)&&(12==(12&n)?zi(t):64&n&&$i(t,Qi.CheckNoChangesProjectedViews));break;case Qi.CheckNoChangesProjectedViews:0==(128&n)&&(32&n?zi(t):64&n&&$i(t,e));break;case Qi.CheckAndUpdate:0==(128&n)&&(12==(12&n)?Ui(t):64&n&&$i(t,Qi.CheckAndUpdateProjectedViews));break;case Qi.CheckAndUpdateProjectedViews:0==(128&n)&&(32&n?Ui(t):64&n&&$i(t,e));break;case Qi.Destroy:Ki(t);break;case Qi.CreateViewNodes:Hi(t)}}function $i(t,e){Xi(t,e),Yi(t,e)}function ta(t,e,n,r){if(t.def.nodeFlags&e&&t.def.nodeFlags&n)for(var o=t.def.nodes.length,i=0;i<o;i++){var a=t.def.nodes[i];if(a.flags&e&&a.flags&n)switch(jn.setCurrentNode(t,a.nodeIndex),r){case 0:ki(t,a);break;case 1:Gi(t,a)}a.childFlags&e&&a.childFlags&n||(i+=a.childCount)}}var ea=!1;function na(t,e,n,r,o,i){var a=o.injector.get(Je);return Fi(oa(t,o,a,e,n),r,i)}function ra(t,e,n,r,o,i){var a=o.injector.get(Je),s=oa(t,o,new ja(a),e,n),u=da(r);return Na(Sa.create,Fi,null,[s,u,i])}function oa(t,e,n,r,o){var i=e.injector.get(Se),a=e.injector.get(Xt),s=n.createRenderer(null,null);return{ngModule:e,injector:t,projectableNodes:r,selectorOrNode:o,sanitizer:i,rendererFactory:n,renderer:s,errorHandler:a}}function ia(t,e,n,r){var o=da(n);return Na(Sa.create,ji,null,[t,e,o,r])}function aa(t,e,n,r){return n=la.get(e.element.componentProvider.provider.token)||da(n),Na(Sa.create,Li,null,[t,e,n,r])}function sa(t,e,n,r){return Hr(t,e,n,function(t){var e=function(t){var e=!1,n=!1;return 0===ua.size?{hasOverrides:e,hasDeprecatedOverrides:n}:(t.providers.forEach(function(t){var r=ua.get(t.token);3840&t.flags&&r&&(e=!0,n=n||r.deprecatedBehavior)}),t.modules.forEach(function(t){ca.forEach(function(r,o){yt(o).providedIn===t&&(e=!0,n=n||r.deprecatedBehavior)})}),{hasOverrides:e,hasDeprecatedOverrides:n})}(t),n=e.hasDeprecatedOverrides;return e.hasOverrides?(function(t){for(var e=0;e<t.providers.length;e++){var r=t.providers[e];n&&(r.flags|=4096);var o=ua.get(r.token);o&&(r.flags=-3841&r.flags|o.flags,r.deps=rr(o.deps),r.value=o.value)}if(ca.size>0){var i=new Set(t.modules);ca.forEach(function(e,r){if(i.has(yt(r).providedIn)){var o={token:r,flags:e.flags|(n?4096:0),deps:rr(e.deps),value:e.value,index:t.providers.length};t.providers.push(o),t.providersByKey[Bn(r)]=o}})}}(t=t.factory(function(){return Fn})),t):t}(r))}var ua=new Map,ca=new Map,la=new Map;function fa(t){var e;ua.set(t.token,t),"function"==typeof t.token&&(e=yt(t.token))&&"function"==typeof e.providedIn&&ca.set(t.token,t)}function pa(t,e){var n=ar(e.viewDefFactory),r=ar(n.nodes[0].element.componentView);la.set(t,r)}function ha(){ua.clear(),ca.clear(),la.clear()}function da(t){if(0===ua.size)return t;var e=function(t){for(var e=[],n=null,r=0;r<t.nodes.length;r++){var o=t.nodes[r];1&o.flags&&(n=o),n&&3840&o.flags&&ua.has(o.provider.token)&&(e.push(n.nodeIndex),n=null)}return e}(t);if(0===e.length)return t;t=t.factory(function(){return Fn});for(var n=0;n<e.length;n++)r(t,e[n]);return t;function r(t,e){for(var n=e+1;n<t.nodes.length;n++){var r=t.nodes[n];if(1&r.flags)return;if(3840&r.flags){var o=r.provider,i=ua.get(o.token);i&&(r.flags=-3841&r.flags|i.flags,o.deps=rr(i.deps),o.value=i.value)}}}}function ya(t,e,n,r,o,i,a,s,u,c,l,f,p){var h=t.def.nodes[e];return Wi(t,h,n,r,o,i,a,s,u,c,l,f,p),224&h.flags?Nn(t,e).value:void 0}function va(t,e,n,r,o,i,a,s,u,c,l,f,p){var h=t.def.nodes[e];return qi(t,h,n,r,o,i,a,s,u,c,l,f,p),224&h.flags?Nn(t,e).value:void 0}function ga(t){return Na(Sa.detectChanges,Ui,null,[t])}function ma(t){return Na(Sa.checkNoChanges,zi,null,[t])}function ba(t){return Na(Sa.destroy,Ki,null,[t])}var _a,wa,Ea,Sa=function(t){return t[t.create=0]="create",t[t.detectChanges=1]="detectChanges",t[t.checkNoChanges=2]="checkNoChanges",t[t.destroy=3]="destroy",t[t.handleEvent=4]="handleEvent",t}({});function xa(t,e){wa=t,Ea=e}function Ta(t,e,n,r){return xa(t,e),Na(Sa.handleEvent,t.def.handleEvent,null,[t,e,n,r])}function ka(t,e){if(128&t.state)throw In(Sa[_a]);return xa(t,Oa(t,0)),t.def.updateDirectives(function(t,n,r){for(var o=[],i=3;i<arguments.length;i++)o[i-3]=arguments[i];var a=t.def.nodes[n];return 0===e?Ia(t,a,r,o):Ca(t,a,r,o),16384&a.flags&&xa(t,Oa(t,n)),224&a.flags?Nn(t,a.nodeIndex).value:void 0},t)}function Aa(t,e){if(128&t.state)throw In(Sa[_a]);return xa(t,Pa(t,0)),t.def.updateRenderer(function(t,n,r){for(var o=[],i=3;i<arguments.length;i++)o[i-3]=arguments[i];var a=t.def.nodes[n];return 0===e?Ia(t,a,r,o):Ca(t,a,r,o),3&a.flags&&xa(t,Pa(t,n)),224&a.flags?Nn(t,a.nodeIndex).value:void 0},t)}function Ia(t,e,n,r){if(Wi.apply(void 0,p([t,e,n],r))){var o=1===n?r[0]:r;if(16384&e.flags){for(var i={},a=0;a<e.bindings.length;a++){var s=e.bindings[a],u=o[a];8&s.flags&&(i[(h=s.nonMinifiedName,"ng-reflect-"+h.replace(/[$@]/g,"_").replace(ke,function(){for(var t=[],e=0;e<arguments.length;e++)t[e]=arguments[e];return"-"+t[1].toLowerCase()}))]=Ae(u))}var c=e.parent,l=Rn(t,c.nodeIndex).renderElement;if(c.element.name)for(var f in i)null!=(u=i[f])?t.renderer.setAttribute(l,f,u):t.renderer.removeAttribute(l,f);else t.renderer.setValue(l,"bindings="+JSON.stringify(i,null,2))}}var h}function Ca(t,e,n,r){qi.apply(void 0,p([t,e,n],r))}function Oa(t,e){for(var n=e;n<t.def.nodes.length;n++){var r=t.def.nodes[n];if(16384&r.flags&&r.bindings&&r.bindings.length)return n}return null}function Pa(t,e){for(var n=e;n<t.def.nodes.length;n++){var r=t.def.nodes[n];if(3&r.flags&&r.bindings&&r.bindings.length)return n}return null}var Ra=function(){function t(t,e){this.view=t,this.nodeIndex=e,null==e&&(this.nodeIndex=e=0),this.nodeDef=t.def.nodes[e];for(var n=this.nodeDef,r=t;n&&0==(1&n.flags);)n=n.parent;if(!n)for(;!n&&r;)n=Xn(r),r=r.parent;this.elDef=n,this.elView=r}return Object.defineProperty(t.prototype,"elOrCompView",{get:function(){return Rn(this.elView,this.elDef.nodeIndex).componentView||this.view},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"injector",{get:function(){return Fr(this.elView,this.elDef)},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"component",{get:function(){return this.elOrCompView.component},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"context",{get:function(){return this.elOrCompView.context},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"providerTokens",{get:function(){var t=[];if(this.elDef)for(var e=this.elDef.nodeIndex+1;e<=this.elDef.nodeIndex+this.elDef.childCount;e++){var n=this.elView.def.nodes[e];20224&n.flags&&t.push(n.provider.token),e+=n.childCount}return t},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"references",{get:function(){var t={};if(this.elDef){Da(this.elView,this.elDef,t);for(var e=this.elDef.nodeIndex+1;e<=this.elDef.nodeIndex+this.elDef.childCount;e++){var n=this.elView.def.nodes[e];20224&n.flags&&Da(this.elView,n,t),e+=n.childCount}}return t},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"componentRenderElement",{get:function(){var t=function(t){for(;t&&!$n(t);)t=t.parent;return t.parent?Rn(t.parent,Xn(t).nodeIndex):null}(this.elOrCompView);return t?t.renderElement:void 0},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"renderNode",{get:function(){return 2&this.nodeDef.flags?Jn(this.view,this.nodeDef):Jn(this.elView,this.elDef)},enumerable:!0,configurable:!0}),t.prototype.logError=function(t){for(var e,n,r=[],o=1;o<arguments.length;o++)r[o-1]=arguments[o];2&this.nodeDef.flags?(e=this.view.def,n=this.nodeDef.nodeIndex):(e=this.elView.def,n=this.elDef.nodeIndex);var i=function(t,e){for(var n=-1,r=0;r<=e;r++)3&t.nodes[r].flags&&n++;return n}(e,n),a=-1;e.factory(function(){var e;return++a===i?(e=t.error).bind.apply(e,p([t],r)):Fn}),a<i&&(t.error("Illegal state: the ViewDefinitionFactory did not call the logger!"),t.error.apply(t,p(r)))},t}();function Da(t,e,n){for(var r in e.references)n[r]=Ii(t,e,e.references[r])}function Na(t,e,n,r){var o=_a,i=wa,a=Ea;try{_a=t;var s=e.apply(n,r);return wa=i,Ea=a,_a=o,s}catch(u){if(Kt(u)||!wa)throw u;throw function(t,e){return t instanceof Error||(t=new Error(t.toString())),An(t,e),t}(u,Ma())}}function Ma(){return wa?new Ra(wa,Ea):null}var ja=function(){function t(t){this.delegate=t}return t.prototype.createRenderer=function(t,e){return new Fa(this.delegate.createRenderer(t,e))},t.prototype.begin=function(){this.delegate.begin&&this.delegate.begin()},t.prototype.end=function(){this.delegate.end&&this.delegate.end()},t.prototype.whenRenderingDone=function(){return this.delegate.whenRenderingDone?this.delegate.whenRenderingDone():Promise.resolve(null)},t}(),Fa=function(){function t(t){this.delegate=t,this.debugContextFactory=Ma,this.data=this.delegate.data}return t.prototype.createDebugContext=function(t){return this.debugContextFactory(t)},t.prototype.destroyNode=function(t){!function(t){ui.delete(t.nativeNode)}(ci(t)),this.delegate.destroyNode&&this.delegate.destroyNode(t)},t.prototype.destroy=function(){this.delegate.destroy()},t.prototype.createElement=function(t,e){var n=this.delegate.createElement(t,e),r=this.createDebugContext(n);if(r){var o=new si(n,null,r);o.name=t,li(o)}return n},t.prototype.createComment=function(t){var e=this.delegate.createComment(t),n=this.createDebugContext(e);return n&&li(new ai(e,null,n)),e},t.prototype.createText=function(t){var e=this.delegate.createText(t),n=this.createDebugContext(e);return n&&li(new ai(e,null,n)),e},t.prototype.appendChild=function(t,e){var n=ci(t),r=ci(e);n&&r&&n instanceof si&&n.addChild(r),this.delegate.appendChild(t,e)},t.prototype.insertBefore=function(t,e,n){var r=ci(t),o=ci(e),i=ci(n);r&&o&&r instanceof si&&r.insertBefore(i,o),this.delegate.insertBefore(t,e,n)},t.prototype.removeChild=function(t,e){var n=ci(t),r=ci(e);n&&r&&n instanceof si&&n.removeChild(r),this.delegate.removeChild(t,e)},t.prototype.selectRootElement=function(t,e){var n=this.delegate.selectRootElement(t,e),r=Ma();return r&&li(new si(n,null,r)),n},t.prototype.setAttribute=function(t,e,n,r){var o=ci(t);o&&o instanceof si&&(o.attributes[r?r+":"+e:e]=n),this.delegate.setAttribute(t,e,n,r)},t.prototype.removeAttribute=function(t,e,n){var r=ci(t);r&&r instanceof si&&(r.attributes[n?n+":"+e:e]=null),this.delegate.removeAttribute(t,e,n)},t.prototype.addClass=function(t,e){var n=ci(t);n&&n instanceof si&&(n.classes[e]=!0),this.delegate.addClass(t,e)},t.prototype.removeClass=function(t,e){var n=ci(t);n&&n instanceof si&&(n.classes[e]=!1),this.delegate.removeClass(t,e)},t.prototype.setStyle=function(t,e,n,r){var o=ci(t);o&&o instanceof si&&(o.styles[e]=n),this.delegate.setStyle(t,e,n,r)},t.prototype.removeStyle=function(t,e,n){var r=ci(t);r&&r instanceof si&&(r.styles[e]=null),this.delegate.removeStyle(t,e,n)},t.prototype.setProperty=function(t,e,n){var r=ci(t);r&&r instanceof si&&(r.properties[e]=n),this.delegate.setProperty(t,e,n)},t.prototype.listen=function(t,e,n){if("string"!=typeof t){var r=ci(t);r&&r.listeners.push(new ii(e,n))}return this.delegate.listen(t,e,n)},t.prototype.parentNode=function(t){return this.delegate.parentNode(t)},t.prototype.nextSibling=function(t){return this.delegate.nextSibling(t)},t.prototype.setValue=function(t,e){return this.delegate.setValue(t,e)},t}();function La(t,e,n){return new Ba(t,e,n)}var Ba=function(t){function e(e,n,r){var o=t.call(this)||this;return o.moduleType=e,o._bootstrapComponents=n,o._ngModuleDefFactory=r,o}return o(e,t),e.prototype.create=function(t){!function(){if(!ea){ea=!0;var t=te()?{setCurrentNode:xa,createRootView:ra,createEmbeddedView:ia,createComponentView:aa,createNgModuleRef:sa,overrideProvider:fa,overrideComponentView:pa,clearOverrides:ha,checkAndUpdateView:ga,checkNoChangesView:ma,destroyView:ba,createDebugContext:function(t,e){return new Ra(t,e)},handleEvent:Ta,updateDirectives:ka,updateRenderer:Aa}:{setCurrentNode:function(){},createRootView:na,createEmbeddedView:ji,createComponentView:Li,createNgModuleRef:Hr,overrideProvider:Fn,overrideComponentView:Fn,clearOverrides:Fn,checkAndUpdateView:Ui,checkNoChangesView:zi,destroyView:Ki,createDebugContext:function(t,e){return new Ra(t,e)},handleEvent:function(t,e,n,r){return t.def.handleEvent(t,e,n,r)},updateDirectives:function(t,e){return t.def.updateDirectives(0===e?ya:va,t)},updateRenderer:function(t,e){return t.def.updateRenderer(0===e?ya:va,t)}};jn.setCurrentNode=t.setCurrentNode,jn.createRootView=t.createRootView,jn.createEmbeddedView=t.createEmbeddedView,jn.createComponentView=t.createComponentView,jn.createNgModuleRef=t.createNgModuleRef,jn.overrideProvider=t.overrideProvider,jn.overrideComponentView=t.overrideComponentView,jn.clearOverrides=t.clearOverrides,jn.checkAndUpdateView=t.checkAndUpdateView,jn.checkNoChangesView=t.checkNoChangesView,jn.destroyView=t.destroyView,jn.resolveDep=io,jn.createDebugContext=t.createDebugContext,jn.handleEvent=t.handleEvent,jn.updateDirectives=t.updateDirectives,jn.updateRenderer=t.updateRenderer,jn.dirtyParentQueries=Ti}}();var e=function(t){var e=Array.from(t.providers),n=Array.from(t.modules),r={};for(var o in t.providersByKey)r[o]=t.providersByKey[o];return{factory:t.factory,isRoot:t.isRoot,providers:e,modules:n,providersByKey:r}}(ar(this._ngModuleDefFactory));return jn.createNgModuleRef(this.moduleType,t||Pt.NULL,this._bootstrapComponents,e)},e}(Ge),Va=(n("0TWp"),n("hgfv")),Ha=function(){return function(){}}(),za=function(t){return t[t.NotAccepted=0]="NotAccepted",t[t.Processing=1]="Processing",t[t.DoneWithoutError=2]="DoneWithoutError",t[t.DoneWithError=3]="DoneWithError",t}({}),Ua=function(){function t(){}return t.prototype.getInstaller=function(){return u(this,void 0,void 0,function(){var t;return c(this,function(e){switch(e.label){case 0:return this.installer?[3,2]:(t=this,[4,window.backend.wailsBind.Self()]);case 1:t.installer=e.sent(),this.installer.status=za.NotAccepted,e.label=2;case 2:return[2,this.installer]}})})},t.prototype.setInstallerStatus=function(t){this.installer.status=t},t.ngInjectableDef=dt({factory:function(){return new t},token:t,providedIn:"root"}),t}(),Wa=function(){return function(t){var e=this;this.Status=za,t.getInstaller().then(function(t){return e.installer=t})}}(),Za=function(){return function(){}}(),qa=new kt("appBaseHref"),Ga=void 0,Ka=["en",[["a","p"],["AM","PM"],Ga],[["AM","PM"],Ga,Ga],[["S","M","T","W","T","F","S"],["Sun","Mon","Tue","Wed","Thu","Fri","Sat"],["Sunday","Monday","Tuesday","Wednesday","Thursday","Friday","Saturday"],["Su","Mo","Tu","We","Th","Fr","Sa"]],Ga,[["J","F","M","A","M","J","J","A","S","O","N","D"],["Jan","Feb","Mar","Apr","May","Jun","Jul","Aug","Sep","Oct","Nov","Dec"],["January","February","March","April","May","June","July","August","September","October","November","December"]],Ga,[["B","A"],["BC","AD"],["Before Christ","Anno Domini"]],0,[6,0],["M/d/yy","MMM d, y","MMMM d, y","EEEE, MMMM d, y"],["h:mm a","h:mm:ss a","h:mm:ss a z","h:mm:ss a zzzz"],["{1}, {0}",Ga,"{1} 'at' {0}",Ga],[".",",",";","%","+","-","E","\xd7","\u2030","\u221e","NaN",":"],["#,##0.###","#,##0%","\xa4#,##0.00","#E0"],"$","US Dollar",{},function(t){var e=Math.floor(Math.abs(t)),n=t.toString().replace(/^[^.]*\.?/,"").length;return 1===e&&0===n?1:5}],Qa={},Ya=function(t){return t[t.Zero=0]="Zero",t[t.One=1]="One",t[t.Two=2]="Two",t[t.Few=3]="Few",t[t.Many=4]="Many",t[t.Other=5]="Other",t}({}),Xa=new kt("UseV4Plurals"),Ja=function(){return function(){}}(),$a=function(t){function e(e,n){var r=t.call(this)||this;return r.locale=e,r.deprecatedPluralFn=n,r}return o(e,t),e.prototype.getPluralCategory=function(t,e){switch(this.deprecatedPluralFn?this.deprecatedPluralFn(e||this.locale,t):function(t){return function(t){var e=t.toLowerCase().replace(/_/g,"-"),n=Qa[e];if(n)return n;var r=e.split("-")[0];if(n=Qa[r])return n;if("en"===r)return Ka;throw new Error('Missing locale data for the locale "'+t+'".')}(t)[18]}(e||this.locale)(t)){case Ya.Zero:return"zero";case Ya.One:return"one";case Ya.Two:return"two";case Ya.Few:return"few";case Ya.Many:return"many";default:return"other"}},e}(Ja),ts=function(){function t(t,e,n,r){this.$implicit=t,this.ngForOf=e,this.index=n,this.count=r}return Object.defineProperty(t.prototype,"first",{get:function(){return 0===this.index},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"last",{get:function(){return this.index===this.count-1},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"even",{get:function(){return this.index%2==0},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"odd",{get:function(){return!this.even},enumerable:!0,configurable:!0}),t}(),es=function(){function t(t,e,n){this._viewContainer=t,this._template=e,this._differs=n,this._ngForOfDirty=!0,this._differ=null}return Object.defineProperty(t.prototype,"ngForOf",{set:function(t){this._ngForOf=t,this._ngForOfDirty=!0},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"ngForTrackBy",{get:function(){return this._trackByFn},set:function(t){te()&&null!=t&&"function"!=typeof t&&console&&console.warn&&console.warn("trackBy must be a function, but received "+JSON.stringify(t)+". See https://angular.io/docs/ts/latest/api/common/index/NgFor-directive.html#!#change-propagation for more information."),this._trackByFn=t},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"ngForTemplate",{set:function(t){t&&(this._template=t)},enumerable:!0,configurable:!0}),t.prototype.ngDoCheck=function(){if(this._ngForOfDirty){this._ngForOfDirty=!1;var t=this._ngForOf;if(!this._differ&&t)try{this._differ=this._differs.find(t).create(this.ngForTrackBy)}catch(r){throw new Error("Cannot find a differ supporting object '"+t+"' of type '"+((e=t).name||typeof e)+"'. NgFor only supports binding to Iterables such as Arrays.")}}var e;if(this._differ){var n=this._differ.diff(this._ngForOf);n&&this._applyChanges(n)}},t.prototype._applyChanges=function(t){var e=this,n=[];t.forEachOperation(function(t,r,o){if(null==t.previousIndex){var i=e._viewContainer.createEmbeddedView(e._template,new ts(null,e._ngForOf,-1,-1),o),a=new ns(t,i);n.push(a)}else null==o?e._viewContainer.remove(r):(i=e._viewContainer.get(r),e._viewContainer.move(i,o),a=new ns(t,i),n.push(a))});for(var r=0;r<n.length;r++)this._perViewChange(n[r].view,n[r].record);r=0;for(var o=this._viewContainer.length;r<o;r++){var i=this._viewContainer.get(r);i.context.index=r,i.context.count=o,i.context.ngForOf=this._ngForOf}t.forEachIdentityChange(function(t){e._viewContainer.get(t.currentIndex).context.$implicit=t.item})},t.prototype._perViewChange=function(t,e){t.context.$implicit=e.item},t.ngTemplateContextGuard=function(t,e){return!0},t}(),ns=function(){return function(t,e){this.record=t,this.view=e}}(),rs=function(){function t(t,e){this._viewContainer=t,this._context=new os,this._thenTemplateRef=null,this._elseTemplateRef=null,this._thenViewRef=null,this._elseViewRef=null,this._thenTemplateRef=e}return Object.defineProperty(t.prototype,"ngIf",{set:function(t){this._context.$implicit=this._context.ngIf=t,this._updateView()},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"ngIfThen",{set:function(t){is("ngIfThen",t),this._thenTemplateRef=t,this._thenViewRef=null,this._updateView()},enumerable:!0,configurable:!0}),Object.defineProperty(t.prototype,"ngIfElse",{set:function(t){is("ngIfElse",t),this._elseTemplateRef=t,this._elseViewRef=null,this._updateView()},enumerable:!0,configurable:!0}),t.prototype._updateView=function(){this._context.$implicit?this._thenViewRef||(this._viewContainer.clear(),this._elseViewRef=null,this._thenTemplateRef&&(this._thenViewRef=this._viewContainer.createEmbeddedView(this._thenTemplateRef,this._context))):this._elseViewRef||(this._viewContainer.clear(),this._thenViewRef=null,this._elseTemplateRef&&(this._elseViewRef=this._viewContainer.createEmbeddedView(this._elseTemplateRef,this._context)))},t.ngTemplateGuard_ngIf=function(t,e){return!0},t}(),os=function(){return function(){this.$implicit=null,this.ngIf=null}}();function is(t,e){if(e&&!e.createEmbeddedView)throw new Error(t+" must be a TemplateRef, but received '"+gt(e)+"'.")}var as=function(){return function(){}}(),ss=new kt("DocumentToken"),us="server",cs=function(){function t(t){this.sanitizer=t}return t.prototype.ngAfterViewInit=function(){var t=this;setTimeout(function(){new ls(t.conditions.nativeElement).onReachingBottom(function(){return t.isRead=!0})})},t.prototype.onAccept=function(){this.installer.mustReadAllConditions&&!this.isRead||(this.installer.status=za.Processing)},t}(),ls=function(){function t(t){this.el=t}return t.prototype.onReachingBottom=function(t){var e=this;if(this.isScrolledBottom)return t();var n=function(){if(e.isScrolledBottom)return e.el.removeEventListener("scroll",n),t()};this.el.addEventListener("scroll",n)},Object.defineProperty(t.prototype,"isScrolledBottom",{get:function(){var t=this.el.scrollHeight-this.el.offsetHeight;return t<this.el.offsetHeight||this.el.scrollTop/t>.96},enumerable:!0,configurable:!0}),t}(),fs=null;function ps(){return fs}var hs,ds=function(t){function e(){var e=t.call(this)||this;e._animationPrefix=null,e._transitionEnd=null;try{var n=e.createElement("div",document);if(null!=e.getStyle(n,"animationName"))e._animationPrefix="";else for(var r=["Webkit","Moz","O","ms"],o=0;o<r.length;o++)if(null!=e.getStyle(n,r[o]+"AnimationName")){e._animationPrefix="-"+r[o].toLowerCase()+"-";break}var i={WebkitTransition:"webkitTransitionEnd",MozTransition:"transitionend",OTransition:"oTransitionEnd otransitionend",transition:"transitionend"};Object.keys(i).forEach(function(t){null!=e.getStyle(n,t)&&(e._transitionEnd=i[t])})}catch(a){e._animationPrefix=null,e._transitionEnd=null}return e}return o(e,t),e.prototype.getDistributedNodes=function(t){return t.getDistributedNodes()},e.prototype.resolveAndSetHref=function(t,e,n){t.href=null==n?e:e+"/../"+n},e.prototype.supportsDOMEvents=function(){return!0},e.prototype.supportsNativeShadowDOM=function(){return"function"==typeof document.body.createShadowRoot},e.prototype.getAnimationPrefix=function(){return this._animationPrefix?this._animationPrefix:""},e.prototype.getTransitionEnd=function(){return this._transitionEnd?this._transitionEnd:""},e.prototype.supportsAnimation=function(){return null!=this._animationPrefix&&null!=this._transitionEnd},e}(function(){function t(){this.resourceLoaderType=null}return Object.defineProperty(t.prototype,"attrToPropMap",{get:function(){return this._attrToPropMap},set:function(t){this._attrToPropMap=t},enumerable:!0,configurable:!0}),t}()),ys={class:"className",innerHtml:"innerHTML",readonly:"readOnly",tabindex:"tabIndex"},vs={"\b":"Backspace","\t":"Tab","\x7f":"Delete","\x1b":"Escape",Del:"Delete",Esc:"Escape",Left:"ArrowLeft",Right:"ArrowRight",Up:"ArrowUp",Down:"ArrowDown",Menu:"ContextMenu",Scroll:"ScrollLock",Win:"OS"},gs={A:"1",B:"2",C:"3",D:"4",E:"5",F:"6",G:"7",H:"8",I:"9",J:"*",K:"+",M:"-",N:".",O:"/","`":"0","\x90":"NumLock"},ms=function(){if(St.Node)return St.Node.prototype.contains||function(t){return!!(16&this.compareDocumentPosition(t))}}(),bs=function(t){function e(){return null!==t&&t.apply(this,arguments)||this}return o(e,t),e.prototype.parse=function(t){throw new Error("parse not implemented")},e.makeCurrent=function(){var t;t=new e,fs||(fs=t)},e.prototype.hasProperty=function(t,e){return e in t},e.prototype.setProperty=function(t,e,n){t[e]=n},e.prototype.getProperty=function(t,e){return t[e]},e.prototype.invoke=function(t,e,n){var r;(r=t)[e].apply(r,p(n))},e.prototype.logError=function(t){window.console&&(console.error?console.error(t):console.log(t))},e.prototype.log=function(t){window.console&&window.console.log&&window.console.log(t)},e.prototype.logGroup=function(t){window.console&&window.console.group&&window.console.group(t)},e.prototype.logGroupEnd=function(){window.console&&window.console.groupEnd&&window.console.groupEnd()},Object.defineProperty(e.prototype,"attrToPropMap",{get:function(){return ys},enumerable:!0,configurable:!0}),e.prototype.contains=function(t,e){return ms.call(t,e)},e.prototype.querySelector=function(t,e){return t.querySelector(e)},e.prototype.querySelectorAll=function(t,e){return t.querySelectorAll(e)},e.prototype.on=function(t,e,n){t.addEventListener(e,n,!1)},e.prototype.onAndCancel=function(t,e,n){return t.addEventListener(e,n,!1),function(){t.removeEventListener(e,n,!1)}},e.prototype.dispatchEvent=function(t,e){t.dispatchEvent(e)},e.prototype.createMouseEvent=function(t){var e=this.getDefaultDocument().createEvent("MouseEvent");return e.initEvent(t,!0,!0),e},e.prototype.createEvent=function(t){var e=this.getDefaultDocument().createEvent("Event");return e.initEvent(t,!0,!0),e},e.prototype.preventDefault=function(t){t.preventDefault(),t.returnValue=!1},e.prototype.isPrevented=function(t){return t.defaultPrevented||null!=t.returnValue&&!t.returnValue},e.prototype.getInnerHTML=function(t){return t.innerHTML},e.prototype.getTemplateContent=function(t){return"content"in t&&this.isTemplateElement(t)?t.content:null},e.prototype.getOuterHTML=function(t){return t.outerHTML},e.prototype.nodeName=function(t){return t.nodeName},e.prototype.nodeValue=function(t){return t.nodeValue},e.prototype.type=function(t){return t.type},e.prototype.content=function(t){return this.hasProperty(t,"content")?t.content:t},e.prototype.firstChild=function(t){return t.firstChild},e.prototype.nextSibling=function(t){return t.nextSibling},e.prototype.parentElement=function(t){return t.parentNode},e.prototype.childNodes=function(t){return t.childNodes},e.prototype.childNodesAsList=function(t){for(var e=t.childNodes,n=new Array(e.length),r=0;r<e.length;r++)n[r]=e[r];return n},e.prototype.clearNodes=function(t){for(;t.firstChild;)t.removeChild(t.firstChild)},e.prototype.appendChild=function(t,e){t.appendChild(e)},e.prototype.removeChild=function(t,e){t.removeChild(e)},e.prototype.replaceChild=function(t,e,n){t.replaceChild(e,n)},e.prototype.remove=function(t){return t.parentNode&&t.parentNode.removeChild(t),t},e.prototype.insertBefore=function(t,e,n){t.insertBefore(n,e)},e.prototype.insertAllBefore=function(t,e,n){n.forEach(function(n){return t.insertBefore(n,e)})},e.prototype.insertAfter=function(t,e,n){t.insertBefore(n,e.nextSibling)},e.prototype.setInnerHTML=function(t,e){t.innerHTML=e},e.prototype.getText=function(t){return t.textContent},e.prototype.setText=function(t,e){t.textContent=e},e.prototype.getValue=function(t){return t.value},e.prototype.setValue=function(t,e){t.value=e},e.prototype.getChecked=function(t){return t.checked},e.prototype.setChecked=function(t,e){t.checked=e},e.prototype.createComment=function(t){return this.getDefaultDocument().createComment(t)},e.prototype.createTemplate=function(t){var e=this.getDefaultDocument().createElement("template");return e.innerHTML=t,e},e.prototype.createElement=function(t,e){return(e=e||this.getDefaultDocument()).createElement(t)},e.prototype.createElementNS=function(t,e,n){return(n=n||this.getDefaultDocument()).createElementNS(t,e)},e.prototype.createTextNode=function(t,e){return(e=e||this.getDefaultDocument()).createTextNode(t)},e.prototype.createScriptTag=function(t,e,n){var r=(n=n||this.getDefaultDocument()).createElement("SCRIPT");return r.setAttribute(t,e),r},e.prototype.createStyleElement=function(t,e){var n=(e=e||this.getDefaultDocument()).createElement("style");return this.appendChild(n,this.createTextNode(t,e)),n},e.prototype.createShadowRoot=function(t){return t.createShadowRoot()},e.prototype.getShadowRoot=function(t){return t.shadowRoot},e.prototype.getHost=function(t){return t.host},e.prototype.clone=function(t){return t.cloneNode(!0)},e.prototype.getElementsByClassName=function(t,e){return t.getElementsByClassName(e)},e.prototype.getElementsByTagName=function(t,e){return t.getElementsByTagName(e)},e.prototype.classList=function(t){return Array.prototype.slice.call(t.classList,0)},e.prototype.addClass=function(t,e){t.classList.add(e)},e.prototype.removeClass=function(t,e){t.classList.remove(e)},e.prototype.hasClass=function(t,e){return t.classList.contains(e)},e.prototype.setStyle=function(t,e,n){t.style[e]=n},e.prototype.removeStyle=function(t,e){t.style[e]=""},e.prototype.getStyle=function(t,e){return t.style[e]},e.prototype.hasStyle=function(t,e,n){var r=this.getStyle(t,e)||"";return n?r==n:r.length>0},e.prototype.tagName=function(t){return t.tagName},e.prototype.attributeMap=function(t){for(var e=new Map,n=t.attributes,r=0;r<n.length;r++){var o=n.item(r);e.set(o.name,o.value)}return e},e.prototype.hasAttribute=function(t,e){return t.hasAttribute(e)},e.prototype.hasAttributeNS=function(t,e,n){return t.hasAttributeNS(e,n)},e.prototype.getAttribute=function(t,e){return t.getAttribute(e)},e.prototype.getAttributeNS=function(t,e,n){return t.getAttributeNS(e,n)},e.prototype.setAttribute=function(t,e,n){t.setAttribute(e,n)},e.prototype.setAttributeNS=function(t,e,n,r){t.setAttributeNS(e,n,r)},e.prototype.removeAttribute=function(t,e){t.removeAttribute(e)},e.prototype.removeAttributeNS=function(t,e,n){t.removeAttributeNS(e,n)},e.prototype.templateAwareRoot=function(t){return this.isTemplateElement(t)?this.content(t):t},e.prototype.createHtmlDocument=function(){return document.implementation.createHTMLDocument("fakeTitle")},e.prototype.getDefaultDocument=function(){return document},e.prototype.getBoundingClientRect=function(t){try{return t.getBoundingClientRect()}catch(e){return{top:0,bottom:0,left:0,right:0,width:0,height:0}}},e.prototype.getTitle=function(t){return t.title},e.prototype.setTitle=function(t,e){t.title=e||""},e.prototype.elementMatches=function(t,e){return!!this.isElementNode(t)&&(t.matches&&t.matches(e)||t.msMatchesSelector&&t.msMatchesSelector(e)||t.webkitMatchesSelector&&t.webkitMatchesSelector(e))},e.prototype.isTemplateElement=function(t){return this.isElementNode(t)&&"TEMPLATE"===t.nodeName},e.prototype.isTextNode=function(t){return t.nodeType===Node.TEXT_NODE},e.prototype.isCommentNode=function(t){return t.nodeType===Node.COMMENT_NODE},e.prototype.isElementNode=function(t){return t.nodeType===Node.ELEMENT_NODE},e.prototype.hasShadowRoot=function(t){return null!=t.shadowRoot&&t instanceof HTMLElement},e.prototype.isShadowRoot=function(t){return t instanceof DocumentFragment},e.prototype.importIntoDoc=function(t){return document.importNode(this.templateAwareRoot(t),!0)},e.prototype.adoptNode=function(t){return document.adoptNode(t)},e.prototype.getHref=function(t){return t.getAttribute("href")},e.prototype.getEventKey=function(t){var e=t.key;if(null==e){if(null==(e=t.keyIdentifier))return"Unidentified";e.startsWith("U+")&&(e=String.fromCharCode(parseInt(e.substring(2),16)),3===t.location&&gs.hasOwnProperty(e)&&(e=gs[e]))}return vs[e]||e},e.prototype.getGlobalEventTarget=function(t,e){return"window"===e?window:"document"===e?t:"body"===e?t.body:null},e.prototype.getHistory=function(){return window.history},e.prototype.getLocation=function(){return window.location},e.prototype.getBaseHref=function(t){var e,n=_s||(_s=document.querySelector("base"))?_s.getAttribute("href"):null;return null==n?null:(e=n,hs||(hs=document.createElement("a")),hs.setAttribute("href",e),"/"===hs.pathname.charAt(0)?hs.pathname:"/"+hs.pathname)},e.prototype.resetBaseElement=function(){_s=null},e.prototype.getUserAgent=function(){return window.navigator.userAgent},e.prototype.setData=function(t,e,n){this.setAttribute(t,"data-"+e,n)},e.prototype.getData=function(t,e){return this.getAttribute(t,"data-"+e)},e.prototype.getComputedStyle=function(t){return getComputedStyle(t)},e.prototype.supportsWebAnimation=function(){return"function"==typeof Element.prototype.animate},e.prototype.performanceNow=function(){return window.performance&&window.performance.now?window.performance.now():(new Date).getTime()},e.prototype.supportsCookies=function(){return!0},e.prototype.getCookie=function(t){return function(t,e){var n,r;e=encodeURIComponent(e);try{for(var o=l(t.split(";")),i=o.next();!i.done;i=o.next()){var a=i.value,s=a.indexOf("="),u=f(-1==s?[a,""]:[a.slice(0,s),a.slice(s+1)],2),c=u[1];if(u[0].trim()===e)return decodeURIComponent(c)}}catch(p){n={error:p}}finally{try{i&&!i.done&&(r=o.return)&&r.call(o)}finally{if(n)throw n.error}}return null}(document.cookie,t)},e.prototype.setCookie=function(t,e){document.cookie=encodeURIComponent(t)+"="+encodeURIComponent(e)},e}(ds),_s=null;function ws(){return!!window.history.pushState}var Es=function(t){function e(e){var n=t.call(this)||this;return n._doc=e,n._init(),n}var n;return o(e,t),e.prototype._init=function(){this.location=ps().getLocation(),this._history=ps().getHistory()},e.prototype.getBaseHrefFromDOM=function(){return ps().getBaseHref(this._doc)},e.prototype.onPopState=function(t){ps().getGlobalEventTarget(this._doc,"window").addEventListener("popstate",t,!1)},e.prototype.onHashChange=function(t){ps().getGlobalEventTarget(this._doc,"window").addEventListener("hashchange",t,!1)},Object.defineProperty(e.prototype,"href",{get:function(){return this.location.href},enumerable:!0,configurable:!0}),Object.defineProperty(e.prototype,"protocol",{get:function(){return this.location.protocol},enumerable:!0,configurable:!0}),Object.defineProperty(e.prototype,"hostname",{get:function(){return this.location.hostname},enumerable:!0,configurable:!0}),Object.defineProperty(e.prototype,"port",{get:function(){return this.location.port},enumerable:!0,configurable:!0}),Object.defineProperty(e.prototype,"pathname",{get:function(){return this.location.pathname},set:function(t){this.location.pathname=t},enumerable:!0,configurable:!0}),Object.defineProperty(e.prototype,"search",{get:function(){return this.location.search},enumerable:!0,configurable:!0}),Object.defineProperty(e.prototype,"hash",{get:function(){return this.location.hash},enumerable:!0,configurable:!0}),e.prototype.pushState=function(t,e,n){ws()?this._history.pushState(t,e,n):this.location.hash=n},e.prototype.replaceState=function(t,e,n){ws()?this._history.replaceState(t,e,n):this.location.hash=n},e.prototype.forward=function(){this._history.forward()},e.prototype.back=function(){this._history.back()},e.prototype.getState=function(){return this._history.state},a([(n=ut(ss),function(t,e){n(t,e,0)}),s("design:paramtypes",[Object])],e)}(Za),Ss=new kt("TRANSITION_ID"),xs=[{provide:yo,useFactory:function(t,e,n){return function(){n.get(vo).donePromise.then(function(){var n=ps();Array.prototype.slice.apply(n.querySelectorAll(e,"style[ng-transition]")).filter(function(e){return n.getAttribute(e,"ng-transition")===t}).forEach(function(t){return n.remove(t)})})}},deps:[Ss,ss,Pt],multi:!0}],Ts=function(){function t(){}return t.init=function(){var e;e=new t,Yo=e},t.prototype.addToWindow=function(t){St.getAngularTestability=function(e,n){void 0===n&&(n=!0);var r=t.findTestabilityInTree(e,n);if(null==r)throw new Error("Could not find testability for element.");return r},St.getAllAngularTestabilities=function(){return t.getAllTestabilities()},St.getAllAngularRootElements=function(){return t.getAllRootElements()},St.frameworkStabilizers||(St.frameworkStabilizers=[]),St.frameworkStabilizers.push(function(t){var e=St.getAllAngularTestabilities(),n=e.length,r=!1,o=function(e){r=r||e,0==--n&&t(r)};e.forEach(function(t){t.whenStable(o)})})},t.prototype.findTestabilityInTree=function(t,e,n){if(null==e)return null;var r=t.getTestability(e);return null!=r?r:n?ps().isShadowRoot(e)?this.findTestabilityInTree(t,ps().getHost(e),!0):this.findTestabilityInTree(t,ps().parentElement(e),!0):null},t}();function ks(t,e){"undefined"!=typeof COMPILED&&COMPILED||((St.ng=St.ng||{})[t]=e)}var As=function(){return{ApplicationRef:ri,NgZone:Vo}}();function Is(t){return ci(t)}var Cs=new kt("EventManagerPlugins"),Os=function(){function t(t,e){var n=this;this._zone=e,this._eventNameToPlugin=new Map,t.forEach(function(t){return t.manager=n}),this._plugins=t.slice().reverse()}return t.prototype.addEventListener=function(t,e,n){return this._findPluginFor(e).addEventListener(t,e,n)},t.prototype.addGlobalEventListener=function(t,e,n){return this._findPluginFor(e).addGlobalEventListener(t,e,n)},t.prototype.getZone=function(){return this._zone},t.prototype._findPluginFor=function(t){var e=this._eventNameToPlugin.get(t);if(e)return e;for(var n=this._plugins,r=0;r<n.length;r++){var o=n[r];if(o.supports(t))return this._eventNameToPlugin.set(t,o),o}throw new Error("No event manager plugin found for event "+t)},t}(),Ps=function(){function t(t){this._doc=t}return t.prototype.addGlobalEventListener=function(t,e,n){var r=ps().getGlobalEventTarget(this._doc,t);if(!r)throw new Error("Unsupported event target "+r+" for event "+e);return this.addEventListener(r,e,n)},t}(),Rs=function(){function t(){this._stylesSet=new Set}return t.prototype.addStyles=function(t){var e=this,n=new Set;t.forEach(function(t){e._stylesSet.has(t)||(e._stylesSet.add(t),n.add(t))}),this.onStylesAdded(n)},t.prototype.onStylesAdded=function(t){},t.prototype.getAllStyles=function(){return Array.from(this._stylesSet)},t}(),Ds=function(t){function e(e){var n=t.call(this)||this;return n._doc=e,n._hostNodes=new Set,n._styleNodes=new Set,n._hostNodes.add(e.head),n}return o(e,t),e.prototype._addStylesToHost=function(t,e){var n=this;t.forEach(function(t){var r=n._doc.createElement("style");r.textContent=t,n._styleNodes.add(e.appendChild(r))})},e.prototype.addHost=function(t){this._addStylesToHost(this._stylesSet,t),this._hostNodes.add(t)},e.prototype.removeHost=function(t){this._hostNodes.delete(t)},e.prototype.onStylesAdded=function(t){var e=this;this._hostNodes.forEach(function(n){return e._addStylesToHost(t,n)})},e.prototype.ngOnDestroy=function(){this._styleNodes.forEach(function(t){return ps().remove(t)})},e}(Rs),Ns={svg:"http://www.w3.org/2000/svg",xhtml:"http://www.w3.org/1999/xhtml",xlink:"http://www.w3.org/1999/xlink",xml:"http://www.w3.org/XML/1998/namespace",xmlns:"http://www.w3.org/2000/xmlns/"},Ms=/%COMP%/g,js="_nghost-%COMP%",Fs="_ngcontent-%COMP%";function Ls(t,e,n){for(var r=0;r<e.length;r++){var o=e[r];Array.isArray(o)?Ls(t,o,n):(o=o.replace(Ms,t),n.push(o))}return n}function Bs(t){return function(e){!1===t(e)&&(e.preventDefault(),e.returnValue=!1)}}var Vs=function(){function t(t,e,n){this.eventManager=t,this.sharedStylesHost=e,this.appId=n,this.rendererByCompId=new Map,this.defaultRenderer=new Hs(t)}return t.prototype.createRenderer=function(t,e){if(!t||!e)return this.defaultRenderer;switch(e.encapsulation){case qt.Emulated:var n=this.rendererByCompId.get(e.id);return n||(n=new Ws(this.eventManager,this.sharedStylesHost,e,this.appId),this.rendererByCompId.set(e.id,n)),n.applyToHost(t),n;case qt.Native:case qt.ShadowDom:return new Zs(this.eventManager,this.sharedStylesHost,t,e);default:if(!this.rendererByCompId.has(e.id)){var r=Ls(e.id,e.styles,[]);this.sharedStylesHost.addStyles(r),this.rendererByCompId.set(e.id,this.defaultRenderer)}return this.defaultRenderer}},t.prototype.begin=function(){},t.prototype.end=function(){},t}(),Hs=function(){function t(t){this.eventManager=t,this.data=Object.create(null)}return t.prototype.destroy=function(){},t.prototype.createElement=function(t,e){return e?document.createElementNS(Ns[e]||e,t):document.createElement(t)},t.prototype.createComment=function(t){return document.createComment(t)},t.prototype.createText=function(t){return document.createTextNode(t)},t.prototype.appendChild=function(t,e){t.appendChild(e)},t.prototype.insertBefore=function(t,e,n){t&&t.insertBefore(e,n)},t.prototype.removeChild=function(t,e){t&&t.removeChild(e)},t.prototype.selectRootElement=function(t,e){var n="string"==typeof t?document.querySelector(t):t;if(!n)throw new Error('The selector "'+t+'" did not match any elements');return e||(n.textContent=""),n},t.prototype.parentNode=function(t){return t.parentNode},t.prototype.nextSibling=function(t){return t.nextSibling},t.prototype.setAttribute=function(t,e,n,r){if(r){e=r+":"+e;var o=Ns[r];o?t.setAttributeNS(o,e,n):t.setAttribute(e,n)}else t.setAttribute(e,n)},t.prototype.removeAttribute=function(t,e,n){if(n){var r=Ns[n];r?t.removeAttributeNS(r,e):t.removeAttribute(n+":"+e)}else t.removeAttribute(e)},t.prototype.addClass=function(t,e){t.classList.add(e)},t.prototype.removeClass=function(t,e){t.classList.remove(e)},t.prototype.setStyle=function(t,e,n,r){r&$e.DashCase?t.style.setProperty(e,n,r&$e.Important?"important":""):t.style[e]=n},t.prototype.removeStyle=function(t,e,n){n&$e.DashCase?t.style.removeProperty(e):t.style[e]=""},t.prototype.setProperty=function(t,e,n){Us(e,"property"),t[e]=n},t.prototype.setValue=function(t,e){t.nodeValue=e},t.prototype.listen=function(t,e,n){return Us(e,"listener"),"string"==typeof t?this.eventManager.addGlobalEventListener(t,e,Bs(n)):this.eventManager.addEventListener(t,e,Bs(n))},t}(),zs=function(){return"@".charCodeAt(0)}();function Us(t,e){if(t.charCodeAt(0)===zs)throw new Error("Found the synthetic "+e+" "+t+'. Please include either "BrowserAnimationsModule" or "NoopAnimationsModule" in your application.')}var Ws=function(t){function e(e,n,r,o){var i=t.call(this,e)||this;i.component=r;var a=Ls(o+"-"+r.id,r.styles,[]);return n.addStyles(a),i.contentAttr=Fs.replace(Ms,o+"-"+r.id),i.hostAttr=js.replace(Ms,o+"-"+r.id),i}return o(e,t),e.prototype.applyToHost=function(e){t.prototype.setAttribute.call(this,e,this.hostAttr,"")},e.prototype.createElement=function(e,n){var r=t.prototype.createElement.call(this,e,n);return t.prototype.setAttribute.call(this,r,this.contentAttr,""),r},e}(Hs),Zs=function(t){function e(e,n,r,o){var i=t.call(this,e)||this;i.sharedStylesHost=n,i.hostEl=r,i.component=o,i.shadowRoot=o.encapsulation===qt.ShadowDom?r.attachShadow({mode:"open"}):r.createShadowRoot(),i.sharedStylesHost.addHost(i.shadowRoot);for(var a=Ls(o.id,o.styles,[]),s=0;s<a.length;s++){var u=document.createElement("style");u.textContent=a[s],i.shadowRoot.appendChild(u)}return i}return o(e,t),e.prototype.nodeOrShadowRoot=function(t){return t===this.hostEl?this.shadowRoot:t},e.prototype.destroy=function(){this.sharedStylesHost.removeHost(this.shadowRoot)},e.prototype.appendChild=function(e,n){return t.prototype.appendChild.call(this,this.nodeOrShadowRoot(e),n)},e.prototype.insertBefore=function(e,n,r){return t.prototype.insertBefore.call(this,this.nodeOrShadowRoot(e),n,r)},e.prototype.removeChild=function(e,n){return t.prototype.removeChild.call(this,this.nodeOrShadowRoot(e),n)},e.prototype.parentNode=function(e){return this.nodeOrShadowRoot(t.prototype.parentNode.call(this,this.nodeOrShadowRoot(e)))},e}(Hs),qs=function(){return"undefined"!=typeof Zone&&Zone.__symbol__||function(t){return"__zone_symbol__"+t}}(),Gs=qs("addEventListener"),Ks=qs("removeEventListener"),Qs={},Ys="__zone_symbol__propagationStopped",Xs=function(){var t="undefined"!=typeof Zone&&Zone[qs("BLACK_LISTED_EVENTS")];if(t){var e={};return t.forEach(function(t){e[t]=t}),e}}(),Js=function(t){return!!Xs&&Xs.hasOwnProperty(t)},$s=function(t){var e=Qs[t.type];if(e){var n=this[e];if(n){var r=[t];if(1===n.length)return(a=n[0]).zone!==Zone.current?a.zone.run(a.handler,this,r):a.handler.apply(this,r);for(var o=n.slice(),i=0;i<o.length&&!0!==t[Ys];i++){var a;(a=o[i]).zone!==Zone.current?a.zone.run(a.handler,this,r):a.handler.apply(this,r)}}}},tu=function(t){function e(e,n,r){var o=t.call(this,e)||this;return o.ngZone=n,r&&function(t){return t===us}(r)||o.patchEvent(),o}return o(e,t),e.prototype.patchEvent=function(){if("undefined"!=typeof Event&&Event&&Event.prototype&&!Event.prototype.__zone_symbol__stopImmediatePropagation){var t=Event.prototype.__zone_symbol__stopImmediatePropagation=Event.prototype.stopImmediatePropagation;Event.prototype.stopImmediatePropagation=function(){this&&(this[Ys]=!0),t&&t.apply(this,arguments)}}},e.prototype.supports=function(t){return!0},e.prototype.addEventListener=function(t,e,n){var r=this,o=n;if(!t[Gs]||Vo.isInAngularZone()&&!Js(e))t.addEventListener(e,o,!1);else{var i=Qs[e];i||(i=Qs[e]=qs("ANGULAR"+e+"FALSE"));var a=t[i],s=a&&a.length>0;a||(a=t[i]=[]);var u=Js(e)?Zone.root:Zone.current;if(0===a.length)a.push({zone:u,handler:o});else{for(var c=!1,l=0;l<a.length;l++)if(a[l].handler===o){c=!0;break}c||a.push({zone:u,handler:o})}s||t[Gs](e,$s,!1)}return function(){return r.removeEventListener(t,e,o)}},e.prototype.removeEventListener=function(t,e,n){var r=t[Ks];if(!r)return t.removeEventListener.apply(t,[e,n,!1]);var o=Qs[e],i=o&&t[o];if(!i)return t.removeEventListener.apply(t,[e,n,!1]);for(var a=!1,s=0;s<i.length;s++)if(i[s].handler===n){a=!0,i.splice(s,1);break}a?0===i.length&&r.apply(t,[e,$s,!1]):t.removeEventListener.apply(t,[e,n,!1])},e}(Ps),eu={pan:!0,panstart:!0,panmove:!0,panend:!0,pancancel:!0,panleft:!0,panright:!0,panup:!0,pandown:!0,pinch:!0,pinchstart:!0,pinchmove:!0,pinchend:!0,pinchcancel:!0,pinchin:!0,pinchout:!0,press:!0,pressup:!0,rotate:!0,rotatestart:!0,rotatemove:!0,rotateend:!0,rotatecancel:!0,swipe:!0,swipeleft:!0,swiperight:!0,swipeup:!0,swipedown:!0,tap:!0},nu=new kt("HammerGestureConfig"),ru=new kt("HammerLoader"),ou=function(){function t(){this.events=[],this.overrides={}}return t.prototype.buildHammer=function(t){var e=new Hammer(t,this.options);for(var n in e.get("pinch").set({enable:!0}),e.get("rotate").set({enable:!0}),this.overrides)e.get(n).set(this.overrides[n]);return e},t}(),iu=function(t){function e(e,n,r,o){var i=t.call(this,e)||this;return i._config=n,i.console=r,i.loader=o,i}return o(e,t),e.prototype.supports=function(t){return!(!eu.hasOwnProperty(t.toLowerCase())&&!this.isCustomEvent(t)||!window.Hammer&&!this.loader&&(this.console.warn('The "'+t+'" event cannot be bound because Hammer.JS is not loaded and no custom loader has been specified.'),1))},e.prototype.addEventListener=function(t,e,n){var r=this,o=this.manager.getZone();if(e=e.toLowerCase(),!window.Hammer&&this.loader){var i=!1,a=function(){i=!0};return this.loader().then(function(){if(!window.Hammer)return r.console.warn("The custom HAMMER_LOADER completed, but Hammer.JS is not present."),void(a=function(){});i||(a=r.addEventListener(t,e,n))}).catch(function(){r.console.warn('The "'+e+'" event cannot be bound because the custom Hammer.JS loader failed.'),a=function(){}}),function(){a()}}return o.runOutsideAngular(function(){var i=r._config.buildHammer(t),a=function(t){o.runGuarded(function(){n(t)})};return i.on(e,a),function(){i.off(e,a),"function"==typeof i.destroy&&i.destroy()}})},e.prototype.isCustomEvent=function(t){return this._config.events.indexOf(t)>-1},e}(Ps),au=["alt","control","meta","shift"],su={alt:function(t){return t.altKey},control:function(t){return t.ctrlKey},meta:function(t){return t.metaKey},shift:function(t){return t.shiftKey}},uu=function(t){function e(e){return t.call(this,e)||this}var n;return o(e,t),n=e,e.prototype.supports=function(t){return null!=n.parseEventName(t)},e.prototype.addEventListener=function(t,e,r){var o=n.parseEventName(e),i=n.eventCallback(o.fullKey,r,this.manager.getZone());return this.manager.getZone().runOutsideAngular(function(){return ps().onAndCancel(t,o.domEventName,i)})},e.parseEventName=function(t){var e=t.toLowerCase().split("."),r=e.shift();if(0===e.length||"keydown"!==r&&"keyup"!==r)return null;var o=n._normalizeKey(e.pop()),i="";if(au.forEach(function(t){var n=e.indexOf(t);n>-1&&(e.splice(n,1),i+=t+".")}),i+=o,0!=e.length||0===o.length)return null;var a={};return a.domEventName=r,a.fullKey=i,a},e.getEventFullKey=function(t){var e="",n=ps().getEventKey(t);return" "===(n=n.toLowerCase())?n="space":"."===n&&(n="dot"),au.forEach(function(r){r!=n&&(0,su[r])(t)&&(e+=r+".")}),e+=n},e.eventCallback=function(t,e,r){return function(o){n.getEventFullKey(o)===t&&r.runGuarded(function(){return e(o)})}},e._normalizeKey=function(t){switch(t){case"esc":return"escape";default:return t}},e}(Ps),cu=function(){return function(){}}(),lu=function(t){function e(e){var n=t.call(this)||this;return n._doc=e,n}return o(e,t),e.prototype.sanitize=function(t,e){if(null==e)return null;switch(t){case Ee.NONE:return e;case Ee.HTML:return e instanceof pu?e.changingThisBreaksApplicationSecurity:(this.checkNotSafeValue(e,"HTML"),function(t,e){var n=null;try{se=se||new ee(t);var r=e?String(e):"";n=se.getInertBodyElement(r);var o=5,i=r;do{if(0===o)throw new Error("Failed to sanitize html because the input is unstable");o--,r=i,i=n.innerHTML,n=se.getInertBodyElement(r)}while(r!==i);var a=new ge,s=a.sanitizeChildren(we(n)||n);return te()&&a.sanitizedSomething&&console.warn("WARNING: sanitizing HTML stripped some content, see http://g.co/ng/security#xss"),s}finally{if(n)for(var u=we(n)||n;u.firstChild;)u.removeChild(u.firstChild)}}(this._doc,String(e)));case Ee.STYLE:return e instanceof hu?e.changingThisBreaksApplicationSecurity:(this.checkNotSafeValue(e,"Style"),function(t){if(!(t=String(t).trim()))return"";var e=t.match(Te);return e&&oe(e[1])===e[1]||t.match(xe)&&function(t){for(var e=!0,n=!0,r=0;r<t.length;r++){var o=t.charAt(r);"'"===o&&n?e=!e:'"'===o&&e&&(n=!n)}return e&&n}(t)?t:(te()&&console.warn("WARNING: sanitizing unsafe style value "+t+" (see http://g.co/ng/security#xss)."),"unsafe")}(e));case Ee.SCRIPT:if(e instanceof du)return e.changingThisBreaksApplicationSecurity;throw this.checkNotSafeValue(e,"Script"),new Error("unsafe value used in a script context");case Ee.URL:return e instanceof vu||e instanceof yu?e.changingThisBreaksApplicationSecurity:(this.checkNotSafeValue(e,"URL"),oe(String(e)));case Ee.RESOURCE_URL:if(e instanceof vu)return e.changingThisBreaksApplicationSecurity;throw this.checkNotSafeValue(e,"ResourceURL"),new Error("unsafe value used in a resource URL context (see http://g.co/ng/security#xss)");default:throw new Error("Unexpected SecurityContext "+t+" (see http://g.co/ng/security#xss)")}},e.prototype.checkNotSafeValue=function(t,e){if(t instanceof fu)throw new Error("Required a safe "+e+", got a "+t.getTypeName()+" (see http://g.co/ng/security#xss)")},e.prototype.bypassSecurityTrustHtml=function(t){return new pu(t)},e.prototype.bypassSecurityTrustStyle=function(t){return new hu(t)},e.prototype.bypassSecurityTrustScript=function(t){return new du(t)},e.prototype.bypassSecurityTrustUrl=function(t){return new yu(t)},e.prototype.bypassSecurityTrustResourceUrl=function(t){return new vu(t)},e}(cu),fu=function(){function t(t){this.changingThisBreaksApplicationSecurity=t}return t.prototype.toString=function(){return"SafeValue must use [property]=binding: "+this.changingThisBreaksApplicationSecurity+" (see http://g.co/ng/security#xss)"},t}(),pu=function(t){function e(){return null!==t&&t.apply(this,arguments)||this}return o(e,t),e.prototype.getTypeName=function(){return"HTML"},e}(fu),hu=function(t){function e(){return null!==t&&t.apply(this,arguments)||this}return o(e,t),e.prototype.getTypeName=function(){return"Style"},e}(fu),du=function(t){function e(){return null!==t&&t.apply(this,arguments)||this}return o(e,t),e.prototype.getTypeName=function(){return"Script"},e}(fu),yu=function(t){function e(){return null!==t&&t.apply(this,arguments)||this}return o(e,t),e.prototype.getTypeName=function(){return"URL"},e}(fu),vu=function(t){function e(){return null!==t&&t.apply(this,arguments)||this}return o(e,t),e.prototype.getTypeName=function(){return"ResourceURL"},e}(fu),gu=$o(fi,"browser",[{provide:wo,useValue:"browser"},{provide:_o,useValue:function(){bs.makeCurrent(),Ts.init()},multi:!0},{provide:Za,useClass:Es,deps:[ss]},{provide:ss,useFactory:function(){return document},deps:[]}]);function mu(){return new Xt}var bu=function(){function t(t){if(t)throw new Error("BrowserModule has already been loaded. If you need access to common directives such as NgIf and NgFor from a lazy loaded module, import CommonModule instead.")}var e;return e=t,t.withServerTransition=function(t){return{ngModule:e,providers:[{provide:go,useValue:t.appId},{provide:Ss,useExisting:go},xs]}},t}();"undefined"!=typeof window&&window;var _u=zn({encapsulation:0,styles:[[".wrapper[_ngcontent-%COMP%]{display:-ms-flexbox;display:flex;height:100vh;margin:0 auto;-ms-flex-pack:center;justify-content:center;-ms-flex-direction:column;flex-direction:column;padding:25px}.btn-wrapper[_ngcontent-%COMP%]{position:absolute;width:100%;height:60px;bottom:0;left:0;background:#fff;display:-ms-flexbox;display:flex;-ms-flex-pack:end;justify-content:flex-end;-ms-flex-align:end;align-items:flex-end;text-align:center;padding-right:25px;padding-bottom:10px}.accept-btn-wrapper[_ngcontent-%COMP%]{display:-ms-flexbox;display:flex;-ms-flex-direction:column;flex-direction:column}.accept-btn-wrapper.disabled[_ngcontent-%COMP%]:hover   .accept-btn-tooltip[_ngcontent-%COMP%]{opacity:1;margin-bottom:10px}.accept-btn-wrapper.disabled[_ngcontent-%COMP%]   .accept-btn[_ngcontent-%COMP%]{background-color:#ccc;color:#666;border:1px solid #ccc;cursor:not-allowed}.accept-btn[_ngcontent-%COMP%]{padding:10px 25px;background:#3ac7d3;cursor:pointer;color:#fff;border-radius:4px;border:1px solid #3ac7d3;transition:background .3s,color .3s,border .3s;-ms-flex-item-align:end;align-self:flex-end}.accept-btn-tooltip[_ngcontent-%COMP%]{margin-bottom:0;opacity:0;background-color:#555;color:#fff;border-radius:6px;padding:10px;transition:margin .3s,opacity .3s}.conditions[_ngcontent-%COMP%]{padding:25px 25px 75px;overflow:auto;height:calc(100vh - 60px);position:absolute;top:0;left:0;width:100%;border-bottom:1px solid rgba(58,199,211,.9)}.condition[_ngcontent-%COMP%]{width:100%;min-height:100px;box-shadow:rgba(100,100,111,.2) 0 7px 29px 0;margin-top:25px;margin-bottom:25px;padding:25px;background-color:#fff;border-radius:6px}"]],data:{animation:[{type:7,name:"fadeInList",definitions:[{type:1,expr:"* => *",animation:[{type:11,selector:":leave",animation:[{type:12,timings:100,animation:[{type:4,styles:{type:6,styles:{opacity:0},offset:null},timings:"0.5s"}]}],options:{optional:!0}},{type:11,selector:":enter",animation:[{type:6,styles:{opacity:0},offset:null},{type:12,timings:100,animation:[{type:4,styles:{type:6,styles:{opacity:1},offset:null},timings:"0.5s"}]}],options:{optional:!0}}],options:null}],options:{}}]}});function wu(t){return Di(0,[(t()(),bi(0,0,null,null,2,"div",[["class","condition"]],null,null,null,null,null)),(t()(),bi(1,0,null,null,0,"h3",[],[[8,"innerHTML",1]],null,null,null,null)),(t()(),bi(2,0,null,null,0,"p",[],[[8,"innerHTML",1]],null,null,null,null))],null,function(t,e){var n=e.component;t(e,1,0,n.sanitizer.bypassSecurityTrustHtml(e.context.$implicit.title)),t(e,2,0,n.sanitizer.bypassSecurityTrustHtml(e.context.$implicit.body))})}function Eu(t){return Di(0,[(t()(),bi(0,0,null,null,0,"div",[["class","accept-btn-tooltip"]],[[8,"innerHTML",1]],null,null,null,null))],null,function(t,e){var n=e.component;t(e,0,0,n.sanitizer.bypassSecurityTrustHtml(n.installer.texts.readAllConditionsTooltip))})}function Su(t){return Di(0,[(t()(),bi(0,0,null,null,10,"div",[["class","wrapper"]],null,null,null,null,null)),(t()(),bi(1,0,[[1,0],["conditions",1]],null,4,"div",[["class","conditions"]],null,null,null,null,null)),(t()(),bi(2,0,null,null,0,"h1",[],[[8,"innerHTML",1]],null,null,null,null)),(t()(),bi(3,0,null,null,2,"div",[],[[24,"@fadeInList",0]],null,null,null,null)),(t()(),mi(16777216,null,null,1,null,wu)),Xr(5,278528,null,0,es,[xn,En,yn],{ngForOf:[0,"ngForOf"]},null),(t()(),bi(6,0,null,null,4,"div",[["class","btn-wrapper"]],null,null,null,null,null)),(t()(),bi(7,0,null,null,3,"div",[["class","accept-btn-wrapper"]],[[2,"disabled",null]],null,null,null,null)),(t()(),mi(16777216,null,null,1,null,Eu)),Xr(9,16384,null,0,rs,[xn,En],{ngIf:[0,"ngIf"]},null),(t()(),bi(10,0,null,null,0,"div",[["class","accept-btn"]],[[8,"innerHTML",1]],[[null,"click"]],function(t,e,n){var r=!0;return"click"===e&&(r=!1!==t.component.onAccept()&&r),r},null,null))],function(t,e){var n=e.component;t(e,5,0,n.installer.conditions),t(e,9,0,!n.isRead&&n.installer.mustReadAllConditions)},function(t,e){var n=e.component;t(e,2,0,n.sanitizer.bypassSecurityTrustHtml(n.installer.title)),t(e,3,0,n.installer.conditions.length),t(e,7,0,!n.isRead&&n.installer.mustReadAllConditions),t(e,10,0,n.sanitizer.bypassSecurityTrustHtml(n.installer.texts.acceptButton))})}function xu(t){return Di(0,[xi(671088640,1,{conditions:0}),(t()(),mi(16777216,null,null,1,null,Su)),Xr(2,16384,null,0,rs,[xn,En],{ngIf:[0,"ngIf"]},null)],function(t,e){t(e,2,0,e.component.installer)},null)}var Tu=function(){function t(t,e){var n=this;this.sanitizer=t,this.installerService=e,this.loadAnimation=!1,e.getInstaller().then(function(t){n.installer=t,n.processEachStep()})}return t.prototype.ngOnInit=function(){this.preloadPage()},t.prototype.preloadPage=function(){return u(this,void 0,void 0,function(){return c(this,function(t){switch(t.label){case 0:return[4,this.sleep(100)];case 1:return t.sent(),this.loadAnimation=!0,[2]}})})},t.prototype.processEachStep=function(t){return void 0===t&&(t=0),u(this,void 0,void 0,function(){return c(this,function(e){switch(e.label){case 0:if(this.noStepsRemaining(t))return[2,this.installer.status=za.DoneWithoutError];this.setUpcomingStep(t),e.label=1;case 1:return e.trys.push([1,3,,4]),[4,this.processStepAtIndex(t)];case 2:return e.sent(),[3,4];case 3:return e.sent(),[2,this.installer.status=za.DoneWithError];case 4:return[2,this.processEachStep(t+1)]}})})},t.prototype.setUpcomingStep=function(t){return this.upcomingStep=this.noUpcomingSteps(t)?null:this.installer.steps[t+1]},t.prototype.noStepsRemaining=function(t){return t===this.installer.steps.length},t.prototype.noUpcomingSteps=function(t){return t+1>=this.installer.steps.length},t.prototype.processStepAtIndex=function(t){return u(this,void 0,void 0,function(){var e;return c(this,function(n){switch(n.label){case 0:this.runningStep=e=this.installer.steps[t],n.label=1;case 1:return n.trys.push([1,3,,4]),[4,this.installStep(t)];case 2:return n.sent(),[3,4];case 3:throw n.sent();case 4:return e.done=!0,[4,this.sleep(0)];case 5:return n.sent(),this.lastDoneStep=e,[2]}})})},t.prototype.sleep=function(t){return new Promise(function(e){return setTimeout(e,t)})},t.prototype.installStep=function(t){return u(this,void 0,void 0,function(){var e;return c(this,function(n){switch(n.label){case 0:return n.trys.push([0,2,,3]),[4,window.backend.wailsBind.InstallStep(t)];case 1:return n.sent(),[3,3];case 2:throw e=n.sent(),this.installerService.errMsg=e,e;case 3:return[2]}})})},t}(),ku=zn({encapsulation:0,styles:[[".wrapper[_ngcontent-%COMP%]{height:100vh;width:100%;overflow:hidden}.anchor[_ngcontent-%COMP%]{position:relative;top:50%;left:50%;-webkit-transform:translate(-50%,-50%);-ms-transform:translate(-50%,-50%);transform:translate(-50%,-50%);width:80%}.anchor-layer[_ngcontent-%COMP%]{opacity:0}.anchor-layer[_ngcontent-%COMP%], .process[_ngcontent-%COMP%]{width:100%;background-color:#fff;border-radius:6px;box-shadow:rgba(100,100,111,.2) 0 7px 29px 0;color:#495966;display:-ms-flexbox;display:flex;-ms-flex-align:center;align-items:center}.process[_ngcontent-%COMP%]{position:absolute;opacity:0;transition:opacity .3s,top .5s,left .5s;-webkit-transform:translate3d(0,0,0);transform:translate3d(0,0,0);left:0;top:0}.far-upcoming[_ngcontent-%COMP%]{top:100%;left:-10%}.long-done[_ngcontent-%COMP%]{top:-100%;left:-10%}.process.done[_ngcontent-%COMP%]{opacity:.7;top:-75px;left:-20px;z-index:1}.process.upcoming[_ngcontent-%COMP%]{top:75%;left:-20px;z-index:0;opacity:.4}.process.running[_ngcontent-%COMP%]{opacity:1;z-index:2}.status-icon[_ngcontent-%COMP%]{background-position-x:center;background-position-y:center;background-size:contain;width:40px;height:40px}.status-icon.upcoming[_ngcontent-%COMP%]{background-image:url('data:image/png;base64, iVBORw0KGgoAAAANSUhEUgAAADAAAAAwCAYAAABXAvmHAAAAaUlEQVRoge3WQQqAIABE0TmX9wcP4IFsFUTkQrKGkf/AdfNBQgkAAAAATEpt3b3hlVJbj444A2IjrgGREfeAuIingKURow/8ceIDlkQQ4L5CX4seL234G3XvmRY9XtrkMefeAAAAACDTAcYM2ojNfB3lAAAAAElFTkSuQmCC')}.status-icon.done[_ngcontent-%COMP%]{background-image:url('data:image/png;base64, iVBORw0KGgoAAAANSUhEUgAAADAAAAAwCAYAAABXAvmHAAAAfklEQVRoge3SwQmAMBBE0dSV/iEFWJCe5iIoJBFmR/5rYP/AtgYAAPA/fRxnH8fp7lii+MgR9/ioEU/xEQOIdyHehXgX4l1s8V8cKhG/erBU/Oxh+8/vBNjjd0LKxK8ElYufCSsbL2+B5eMlOl6i4yU6XqLjJTpeouMBAMCGCw3HibHxitv9AAAAAElFTkSuQmCC')}.status-wrapper[_ngcontent-%COMP%]{padding:20px}.loader[_ngcontent-%COMP%]{border:4px solid #f3f3f3;border-top:4px solid #3ac7d3;border-radius:50%;height:40px;width:40px;-webkit-animation:2s linear infinite spin;animation:2s linear infinite spin}@-webkit-keyframes spin{0%{-webkit-transform:rotate(0);transform:rotate(0)}100%{-webkit-transform:rotate(360deg);transform:rotate(360deg)}}@keyframes spin{0%{-webkit-transform:rotate(0);transform:rotate(0)}100%{-webkit-transform:rotate(360deg);transform:rotate(360deg)}}.description[_ngcontent-%COMP%]{position:relative;-ms-flex-preferred-size:100%;flex-basis:100%;padding:20px 20px 20px 0}.index[_ngcontent-%COMP%]{position:absolute;color:#c1c1c1;top:15px;right:15px}.first-appear[_ngcontent-%COMP%]{top:75%!important;left:-20px!important;opacity:0!important}"]],data:{}});function Au(t){return Di(0,[(t()(),bi(0,0,null,null,0,"div",[["class","loader"]],null,null,null,null,null))],null,null)}function Iu(t){return Di(0,[(t()(),bi(0,0,null,null,0,"div",[["class","status-icon"]],[[2,"done",null],[2,"upcoming",null]],null,null,null,null))],null,function(t,e){t(e,0,0,e.parent.context.$implicit.done,e.parent.context.$implicit===e.component.upcomingStep)})}function Cu(t){return Di(0,[(t()(),bi(0,0,null,null,9,"div",[["class","process"]],[[2,"long-done",null],[2,"done",null],[2,"running",null],[2,"upcoming",null],[2,"far-upcoming",null],[2,"first-appear",null]],null,null,null,null)),(t()(),bi(1,0,null,null,4,"div",[["class","status-wrapper"]],null,null,null,null,null)),(t()(),mi(16777216,null,null,1,null,Au)),Xr(3,16384,null,0,rs,[xn,En],{ngIf:[0,"ngIf"]},null),(t()(),mi(16777216,null,null,1,null,Iu)),Xr(5,16384,null,0,rs,[xn,En],{ngIf:[0,"ngIf"]},null),(t()(),bi(6,0,null,null,3,"div",[["class","description"]],null,null,null,null,null)),(t()(),bi(7,0,null,null,1,"div",[["class","index"]],null,null,null,null,null)),(t()(),Oi(8,null,["","/",""])),(t()(),bi(9,0,null,null,0,"p",[],[[8,"innerHTML",1]],null,null,null,null))],function(t,e){var n=e.component;t(e,3,0,e.context.$implicit===n.runningStep&&!e.context.$implicit.done),t(e,5,0,e.context.$implicit!==n.runningStep||e.context.$implicit.done)},function(t,e){var n=e.component;t(e,0,0,e.context.$implicit.done&&e.context.$implicit!==n.lastDoneStep&&e.context.$implicit!==n.runningStep,e.context.$implicit===n.lastDoneStep,e.context.$implicit===n.runningStep,e.context.$implicit===n.upcomingStep,!e.context.$implicit.done&&e.context.$implicit!==n.upcomingStep&&e.context.$implicit!==n.runningStep,!n.loadAnimation),t(e,8,0,e.context.index+1,n.installer.steps.length),t(e,9,0,n.sanitizer.bypassSecurityTrustHtml(e.context.$implicit.description))})}function Ou(t){return Di(0,[(t()(),bi(0,0,null,null,6,"div",[["class","anchor"]],null,null,null,null,null)),(t()(),bi(1,0,null,null,3,"div",[["class","anchor-layer"]],null,null,null,null,null)),(t()(),bi(2,0,null,null,0,"div",[["class","status-icon"]],null,null,null,null,null)),(t()(),bi(3,0,null,null,1,"div",[["class","description"]],null,null,null,null,null)),(t()(),bi(4,0,null,null,0,"p",[],[[8,"innerHTML",1]],null,null,null,null)),(t()(),mi(16777216,null,null,1,null,Cu)),Xr(6,278528,null,0,es,[xn,En,yn],{ngForOf:[0,"ngForOf"]},null)],function(t,e){t(e,6,0,e.component.installer.steps)},function(t,e){var n=e.component;t(e,4,0,n.sanitizer.bypassSecurityTrustHtml(n.runningStep.description))})}function Pu(t){return Di(0,[(t()(),bi(0,0,null,null,2,"div",[["class","wrapper"]],null,null,null,null,null)),(t()(),mi(16777216,null,null,1,null,Ou)),Xr(2,16384,null,0,rs,[xn,En],{ngIf:[0,"ngIf"]},null)],function(t,e){var n=e.component;t(e,2,0,n.installer&&n.runningStep)},null)}var Ru=function(){function t(t,e){var n=this;this.sanitizer=t,this.expanded=!1,this.successMessageLoaded=!1,e.getInstaller().then(function(t){n.installer=t})}return t.prototype.ngAfterViewInit=function(){var t=this;setTimeout(function(){t.successMessageLoaded=!0},100)},t.prototype.toggleExpand=function(){this.expanded=!this.expanded},t}(),Du=zn({encapsulation:0,styles:[[".wrapper[_ngcontent-%COMP%]{height:100vh;padding:50px 50px 100px;overflow:auto}.success-msg[_ngcontent-%COMP%]{margin-bottom:25px;opacity:0;margin-top:75px;transition:opacity .6s,margin-top .6s}.success-msg.loaded[_ngcontent-%COMP%]{margin-top:25px;opacity:1}.step-history[_ngcontent-%COMP%], .success-msg[_ngcontent-%COMP%]{position:relative;width:100%;min-height:100px;box-shadow:rgba(100,100,111,.2) 0 7px 29px 0;padding:25px;background-color:#fff;border-radius:6px}.step-history[_ngcontent-%COMP%]{margin-bottom:25px}.success-icon[_ngcontent-%COMP%]{background-image:url('data:image/png;base64, iVBORw0KGgoAAAANSUhEUgAAADAAAAAwCAYAAABXAvmHAAAAfklEQVRoge3SwQmAMBBE0dSV/iEFWJCe5iIoJBFmR/5rYP/AtgYAAPA/fRxnH8fp7lii+MgR9/ioEU/xEQOIdyHehXgX4l1s8V8cKhG/erBU/Oxh+8/vBNjjd0LKxK8ElYufCSsbL2+B5eMlOl6i4yU6XqLjJTpeouMBAMCGCw3HibHxitv9AAAAAElFTkSuQmCC');background-size:contain;background-repeat:no-repeat;position:absolute;top:-25px;left:50%;-webkit-transform:translateX(-50%);-ms-transform:translateX(-50%);transform:translateX(-50%);width:50px;height:50px;background-color:#fff;border-radius:50%;border:1px solid #3ac7d3}.see-more[_ngcontent-%COMP%]{background-image:url(data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAEgAAABICAYAAABV7bNHAAAAa0lEQVR4nO3QwQ2AMAwDwK7HCCzA/hvABrRIfrjoTsrXcjwGAAAAAAAAAAA/dJzX/Xa75kTMyqyWasuJWC0zK9WWE9P2mIEM1JET0/aYgQzUkRPT9ljdQF9K7ZYTlSrTlgMAAAAAAAAAwE4eiNUjAuDVNJ0AAAAASUVORK5CYII=);height:20px;width:50px;background-size:50%;background-repeat:no-repeat;cursor:pointer}.description[_ngcontent-%COMP%]{font-size:12px;padding-top:20px;padding-bottom:20px;border-bottom:1px solid rgba(0,0,0,.2);font-style:italic}"]],data:{animation:[{type:7,name:"fadeIn",definitions:[{type:0,name:"in",styles:{type:6,styles:{opacity:1},offset:null},options:void 0},{type:1,expr:":enter",animation:[{type:6,styles:{opacity:0},offset:null},{type:4,styles:null,timings:300}],options:null},{type:1,expr:":leave",animation:{type:4,styles:{type:6,styles:{opacity:0},offset:null},timings:300},options:null}],options:{}}]}});function Nu(t){return Di(0,[(t()(),bi(0,0,null,null,0,"div",[["class","description"]],[[8,"innerHTML",1]],null,null,null,null))],null,function(t,e){t(e,0,0,e.component.sanitizer.bypassSecurityTrustHtml(e.context.$implicit.description))})}function Mu(t){return Di(0,[(t()(),bi(0,0,null,null,3,"div",[["class","step-history"]],[[24,"@fadeIn",0]],null,null,null,null)),(t()(),bi(1,0,null,null,0,"h3",[],[[8,"innerHTML",1]],null,null,null,null)),(t()(),mi(16777216,null,null,1,null,Nu)),Xr(3,278528,null,0,es,[xn,En,yn],{ngForOf:[0,"ngForOf"]},null)],function(t,e){t(e,3,0,e.component.installer.steps)},function(t,e){var n=e.component;t(e,0,0,"in"),t(e,1,0,n.sanitizer.bypassSecurityTrustHtml(n.installer.texts.completedSteps))})}function ju(t){return Di(0,[(t()(),bi(0,0,null,null,6,"div",[["class","wrapper"]],null,null,null,null,null)),(t()(),bi(1,0,null,null,3,"div",[["class","success-msg"]],[[2,"loaded",null]],null,null,null,null)),(t()(),bi(2,0,null,null,0,"div",[["class","success-icon"]],null,null,null,null,null)),(t()(),bi(3,0,null,null,0,"p",[],[[8,"innerHTML",1]],null,null,null,null)),(t()(),bi(4,0,null,null,0,"div",[["class","see-more"]],null,[[null,"click"]],function(t,e,n){var r=!0;return"click"===e&&(r=!1!==t.component.toggleExpand()&&r),r},null,null)),(t()(),mi(16777216,null,null,1,null,Mu)),Xr(6,16384,null,0,rs,[xn,En],{ngIf:[0,"ngIf"]},null)],function(t,e){t(e,6,0,e.component.expanded)},function(t,e){var n=e.component;t(e,1,0,n.successMessageLoaded),t(e,3,0,n.sanitizer.bypassSecurityTrustHtml(n.installer.texts.success))})}function Fu(t){return Di(0,[(t()(),mi(16777216,null,null,1,null,ju)),Xr(1,16384,null,0,rs,[xn,En],{ngIf:[0,"ngIf"]},null)],function(t,e){t(e,1,0,e.component.installer)},null)}var Lu=function(){function t(t,e){var n=this;this.sanitizer=t,this.msgLoaded=!1,e.getInstaller().then(function(t){n.installer=t,n.errMsg=e.errMsg})}return t.prototype.ngAfterViewInit=function(){var t=this;setTimeout(function(){t.msgLoaded=!0},100)},t}(),Bu=zn({encapsulation:0,styles:[[".wrapper[_ngcontent-%COMP%]{height:100vh;margin:0 auto;padding:50px 50px 100px}.fail-msg[_ngcontent-%COMP%]{margin-bottom:25px;opacity:0;margin-top:75px;transition:opacity .6s,margin-top .6s;position:relative;width:100%;min-height:100px;box-shadow:rgba(100,100,111,.2) 0 7px 29px 0;padding:25px;background-color:#fff;border-radius:6px}.fail-msg.loaded[_ngcontent-%COMP%]{margin-top:25px;opacity:1}"]],data:{}});function Vu(t){return Di(0,[(t()(),bi(0,0,null,null,4,"div",[["class","fail-msg"]],[[2,"loaded",null]],null,null,null,null)),(t()(),bi(1,0,null,null,0,"div",[["class","fail-icon"]],null,null,null,null,null)),(t()(),bi(2,0,null,null,0,"p",[],[[8,"innerHTML",1]],null,null,null,null)),(t()(),bi(3,0,null,null,1,"i",[],null,null,null,null,null)),(t()(),Oi(4,null,["",""]))],null,function(t,e){var n=e.component;t(e,0,0,n.msgLoaded),t(e,2,0,n.sanitizer.bypassSecurityTrustHtml(n.installer.texts.fail)),t(e,4,0,n.errMsg||"undefined")})}function Hu(t){return Di(0,[(t()(),bi(0,0,null,null,2,"div",[["class","wrapper"]],null,null,null,null,null)),(t()(),mi(16777216,null,null,1,null,Vu)),Xr(2,16384,null,0,rs,[xn,En],{ngIf:[0,"ngIf"]},null)],function(t,e){t(e,2,0,e.component.installer)},null)}var zu=zn({encapsulation:0,styles:[[".wrapper[_ngcontent-%COMP%]{height:100vh;width:100%;background:#ebf1f5}"]],data:{}});function Uu(t){return Di(0,[(t()(),bi(0,0,null,null,1,"app-conditions",[],null,null,null,xu,_u)),Xr(1,4243456,null,0,cs,[cu],{installer:[0,"installer"]},null)],function(t,e){t(e,1,0,e.component.installer)},null)}function Wu(t){return Di(0,[(t()(),bi(0,0,null,null,1,"app-steps",[],null,null,null,Pu,ku)),Xr(1,114688,null,0,Tu,[cu,Ua],null,null)],function(t,e){t(e,1,0)},null)}function Zu(t){return Di(0,[(t()(),bi(0,0,null,null,1,"app-success",[],null,null,null,Fu,Du)),Xr(1,4243456,null,0,Ru,[cu,Ua],null,null)],null,null)}function qu(t){return Di(0,[(t()(),bi(0,0,null,null,1,"app-fail",[],null,null,null,Hu,Bu)),Xr(1,4243456,null,0,Lu,[cu,Ua],null,null)],null,null)}function Gu(t){return Di(0,[(t()(),bi(0,0,null,null,8,"div",[["class","wrapper"]],null,null,null,null,null)),(t()(),mi(16777216,null,null,1,null,Uu)),Xr(2,16384,null,0,rs,[xn,En],{ngIf:[0,"ngIf"]},null),(t()(),mi(16777216,null,null,1,null,Wu)),Xr(4,16384,null,0,rs,[xn,En],{ngIf:[0,"ngIf"]},null),(t()(),mi(16777216,null,null,1,null,Zu)),Xr(6,16384,null,0,rs,[xn,En],{ngIf:[0,"ngIf"]},null),(t()(),mi(16777216,null,null,1,null,qu)),Xr(8,16384,null,0,rs,[xn,En],{ngIf:[0,"ngIf"]},null)],function(t,e){var n=e.component;t(e,2,0,n.installer.status===n.Status.NotAccepted),t(e,4,0,n.installer.status===n.Status.Processing),t(e,6,0,n.installer.status===n.Status.DoneWithoutError),t(e,8,0,n.installer.status===n.Status.DoneWithError)},null)}function Ku(t){return Di(0,[(t()(),mi(16777216,null,null,1,null,Gu)),Xr(1,16384,null,0,rs,[xn,En],{ngIf:[0,"ngIf"]},null)],function(t,e){t(e,1,0,e.component.installer)},null)}function Qu(t){return Di(0,[(t()(),bi(0,0,null,null,1,"div",[["id","app"]],null,null,null,Ku,zu)),Xr(1,49152,null,0,Wa,[Ua],null,null)],null,null)}var Yu=Ir('[id="app"]',Wa,Qu,{},{},[]),Xu=function(){return function(){}}(),Ju=function(){return function(){}}(),$u="*";function tc(t,e){return void 0===e&&(e=null),{type:2,steps:t,options:e}}function ec(t){return{type:6,styles:t,offset:null}}function nc(t){Promise.resolve(null).then(t)}var rc=function(){function t(t,e){void 0===t&&(t=0),void 0===e&&(e=0),this._onDoneFns=[],this._onStartFns=[],this._onDestroyFns=[],this._started=!1,this._destroyed=!1,this._finished=!1,this.parentPlayer=null,this.totalTime=t+e}return t.prototype._onFinish=function(){this._finished||(this._finished=!0,this._onDoneFns.forEach(function(t){return t()}),this._onDoneFns=[])},t.prototype.onStart=function(t){this._onStartFns.push(t)},t.prototype.onDone=function(t){this._onDoneFns.push(t)},t.prototype.onDestroy=function(t){this._onDestroyFns.push(t)},t.prototype.hasStarted=function(){return this._started},t.prototype.init=function(){},t.prototype.play=function(){this.hasStarted()||(this._onStart(),this.triggerMicrotask()),this._started=!0},t.prototype.triggerMicrotask=function(){var t=this;nc(function(){return t._onFinish()})},t.prototype._onStart=function(){this._onStartFns.forEach(function(t){return t()}),this._onStartFns=[]},t.prototype.pause=function(){},t.prototype.restart=function(){},t.prototype.finish=function(){this._onFinish()},t.prototype.destroy=function(){this._destroyed||(this._destroyed=!0,this.hasStarted()||this._onStart(),this.finish(),this._onDestroyFns.forEach(function(t){return t()}),this._onDestroyFns=[])},t.prototype.reset=function(){},t.prototype.setPosition=function(t){},t.prototype.getPosition=function(){return 0},t.prototype.triggerCallback=function(t){var e="start"==t?this._onStartFns:this._onDoneFns;e.forEach(function(t){return t()}),e.length=0},t}(),oc=function(){function t(t){var e=this;this._onDoneFns=[],this._onStartFns=[],this._finished=!1,this._started=!1,this._destroyed=!1,this._onDestroyFns=[],this.parentPlayer=null,this.totalTime=0,this.players=t;var n=0,r=0,o=0,i=this.players.length;0==i?nc(function(){return e._onFinish()}):this.players.forEach(function(t){t.onDone(function(){++n==i&&e._onFinish()}),t.onDestroy(function(){++r==i&&e._onDestroy()}),t.onStart(function(){++o==i&&e._onStart()})}),this.totalTime=this.players.reduce(function(t,e){return Math.max(t,e.totalTime)},0)}return t.prototype._onFinish=function(){this._finished||(this._finished=!0,this._onDoneFns.forEach(function(t){return t()}),this._onDoneFns=[])},t.prototype.init=function(){this.players.forEach(function(t){return t.init()})},t.prototype.onStart=function(t){this._onStartFns.push(t)},t.prototype._onStart=function(){this.hasStarted()||(this._started=!0,this._onStartFns.forEach(function(t){return t()}),this._onStartFns=[])},t.prototype.onDone=function(t){this._onDoneFns.push(t)},t.prototype.onDestroy=function(t){this._onDestroyFns.push(t)},t.prototype.hasStarted=function(){return this._started},t.prototype.play=function(){this.parentPlayer||this.init(),this._onStart(),this.players.forEach(function(t){return t.play()})},t.prototype.pause=function(){this.players.forEach(function(t){return t.pause()})},t.prototype.restart=function(){this.players.forEach(function(t){return t.restart()})},t.prototype.finish=function(){this._onFinish(),this.players.forEach(function(t){return t.finish()})},t.prototype.destroy=function(){this._onDestroy()},t.prototype._onDestroy=function(){this._destroyed||(this._destroyed=!0,this._onFinish(),this.players.forEach(function(t){return t.destroy()}),this._onDestroyFns.forEach(function(t){return t()}),this._onDestroyFns=[])},t.prototype.reset=function(){this.players.forEach(function(t){return t.reset()}),this._destroyed=!1,this._finished=!1,this._started=!1},t.prototype.setPosition=function(t){var e=t*this.totalTime;this.players.forEach(function(t){var n=t.totalTime?Math.min(1,e/t.totalTime):1;t.setPosition(n)})},t.prototype.getPosition=function(){var t=0;return this.players.forEach(function(e){var n=e.getPosition();t=Math.min(n,t)}),t},t.prototype.beforeDestroy=function(){this.players.forEach(function(t){t.beforeDestroy&&t.beforeDestroy()})},t.prototype.triggerCallback=function(t){var e="start"==t?this._onStartFns:this._onDoneFns;e.forEach(function(t){return t()}),e.length=0},t}(),ic="!";function ac(){return"undefined"!=typeof process}function sc(t){switch(t.length){case 0:return new rc;case 1:return t[0];default:return new oc(t)}}function uc(t,e,n,r,o,i){void 0===o&&(o={}),void 0===i&&(i={});var a=[],s=[],u=-1,c=null;if(r.forEach(function(t){var n=t.offset,r=n==u,l=r&&c||{};Object.keys(t).forEach(function(n){var r=n,s=t[n];if("offset"!==n)switch(r=e.normalizePropertyName(r,a),s){case ic:s=o[n];break;case $u:s=i[n];break;default:s=e.normalizeStyleValue(n,r,s,a)}l[r]=s}),r||s.push(l),c=l,u=n}),a.length)throw new Error("Unable to animate due to the following errors:\n - "+a.join("\n - "));return s}function cc(t,e,n,r){switch(e){case"start":t.onStart(function(){return r(n&&lc(n,"start",t))});break;case"done":t.onDone(function(){return r(n&&lc(n,"done",t))});break;case"destroy":t.onDestroy(function(){return r(n&&lc(n,"destroy",t))})}}function lc(t,e,n){var r=n.totalTime,o=fc(t.element,t.triggerName,t.fromState,t.toState,e||t.phaseName,null==r?t.totalTime:r,!!n.disabled),i=t._data;return null!=i&&(o._data=i),o}function fc(t,e,n,r,o,i,a){return void 0===o&&(o=""),void 0===i&&(i=0),{element:t,triggerName:e,fromState:n,toState:r,phaseName:o,totalTime:i,disabled:!!a}}function pc(t,e,n){var r;return t instanceof Map?(r=t.get(e))||t.set(e,r=n):(r=t[e])||(r=t[e]=n),r}function hc(t){var e=t.indexOf(":");return[t.substring(1,e),t.substr(e+1)]}var dc=function(t,e){return!1},yc=function(t,e){return!1},vc=function(t,e,n){return[]},gc=ac();(gc||"undefined"!=typeof Element)&&(dc=function(t,e){return t.contains(e)},yc=function(){if(gc||Element.prototype.matches)return function(t,e){return t.matches(e)};var t=Element.prototype,e=t.matchesSelector||t.mozMatchesSelector||t.msMatchesSelector||t.oMatchesSelector||t.webkitMatchesSelector;return e?function(t,n){return e.apply(t,[n])}:yc}(),vc=function(t,e,n){var r=[];if(n)r.push.apply(r,p(t.querySelectorAll(e)));else{var o=t.querySelector(e);o&&r.push(o)}return r});var mc=null,bc=!1;function _c(t){mc||(mc=("undefined"!=typeof document?document.body:null)||{},bc=!!mc.style&&"WebkitAppearance"in mc.style);var e=!0;return mc.style&&!function(t){return"ebkit"==t.substring(1,6)}(t)&&!(e=t in mc.style)&&bc&&(e="Webkit"+t.charAt(0).toUpperCase()+t.substr(1)in mc.style),e}var wc=yc,Ec=dc,Sc=vc;function xc(t){var e={};return Object.keys(t).forEach(function(n){var r=n.replace(/([a-z])([A-Z])/g,"$1-$2");e[r]=t[n]}),e}var Tc=function(){function t(){}return t.prototype.validateStyleProperty=function(t){return _c(t)},t.prototype.matchesElement=function(t,e){return wc(t,e)},t.prototype.containsElement=function(t,e){return Ec(t,e)},t.prototype.query=function(t,e,n){return Sc(t,e,n)},t.prototype.computeStyle=function(t,e,n){return n||""},t.prototype.animate=function(t,e,n,r,o,i,a){return void 0===i&&(i=[]),new rc(n,r)},t}(),kc=function(){function t(){}return t.NOOP=new Tc,t}(),Ac=1e3;function Ic(t){if("number"==typeof t)return t;var e=t.match(/^(-?[\.\d]+)(m?s)/);return!e||e.length<2?0:Cc(parseFloat(e[1]),e[2])}function Cc(t,e){switch(e){case"s":return t*Ac;default:return t}}function Oc(t,e,n){return t.hasOwnProperty("duration")?t:function(t,e,n){var r,o=0,i="";if("string"==typeof t){var a=t.match(/^(-?[\.\d]+)(m?s)(?:\s+(-?[\.\d]+)(m?s))?(?:\s+([-a-z]+(?:\(.+?\))?))?$/i);if(null===a)return e.push('The provided timing value "'+t+'" is invalid.'),{duration:0,delay:0,easing:""};r=Cc(parseFloat(a[1]),a[2]);var s=a[3];null!=s&&(o=Cc(parseFloat(s),a[4]));var u=a[5];u&&(i=u)}else r=t;if(!n){var c=!1,l=e.length;r<0&&(e.push("Duration values below 0 are not allowed for this animation step."),c=!0),o<0&&(e.push("Delay values below 0 are not allowed for this animation step."),c=!0),c&&e.splice(l,0,'The provided timing value "'+t+'" is invalid.')}return{duration:r,delay:o,easing:i}}(t,e,n)}function Pc(t,e){return void 0===e&&(e={}),Object.keys(t).forEach(function(n){e[n]=t[n]}),e}function Rc(t,e,n){if(void 0===n&&(n={}),e)for(var r in t)n[r]=t[r];else Pc(t,n);return n}function Dc(t,e,n){return n?e+":"+n+";":""}function Nc(t){for(var e="",n=0;n<t.style.length;n++)e+=Dc(0,r=t.style.item(n),t.style.getPropertyValue(r));for(var r in t.style)t.style.hasOwnProperty(r)&&!r.startsWith("_")&&(e+=Dc(0,r.replace(/([a-z])([A-Z])/g,"$1-$2").toLowerCase(),t.style[r]));t.setAttribute("style",e)}function Mc(t,e,n){t.style&&(Object.keys(e).forEach(function(r){var o=Uc(r);n&&!n.hasOwnProperty(r)&&(n[r]=t.style[o]),t.style[o]=e[r]}),ac()&&Nc(t))}function jc(t,e){t.style&&(Object.keys(e).forEach(function(e){var n=Uc(e);t.style[n]=""}),ac()&&Nc(t))}function Fc(t){return Array.isArray(t)?1==t.length?t[0]:tc(t):t}var Lc=new RegExp("{{\\s*(.+?)\\s*}}","g");function Bc(t){var e=[];if("string"==typeof t){for(var n=t.toString(),r=void 0;r=Lc.exec(n);)e.push(r[1]);Lc.lastIndex=0}return e}function Vc(t,e,n){var r=t.toString(),o=r.replace(Lc,function(t,r){var o=e[r];return e.hasOwnProperty(r)||(n.push("Please provide a value for the animation param "+r),o=""),o.toString()});return o==r?t:o}function Hc(t){for(var e=[],n=t.next();!n.done;)e.push(n.value),n=t.next();return e}var zc=/-+([a-z0-9])/g;function Uc(t){return t.replace(zc,function(){for(var t=[],e=0;e<arguments.length;e++)t[e]=arguments[e];return t[1].toUpperCase()})}function Wc(t,e){return 0===t||0===e}function Zc(t,e,n){var r=Object.keys(n);if(r.length&&e.length){var o=e[0],i=[];if(r.forEach(function(t){o.hasOwnProperty(t)||i.push(t),o[t]=n[t]}),i.length)for(var a=function(){var n=e[s];i.forEach(function(e){n[e]=Gc(t,e)})},s=1;s<e.length;s++)a()}return e}function qc(t,e,n){switch(e.type){case 7:return t.visitTrigger(e,n);case 0:return t.visitState(e,n);case 1:return t.visitTransition(e,n);case 2:return t.visitSequence(e,n);case 3:return t.visitGroup(e,n);case 4:return t.visitAnimate(e,n);case 5:return t.visitKeyframes(e,n);case 6:return t.visitStyle(e,n);case 8:return t.visitReference(e,n);case 9:return t.visitAnimateChild(e,n);case 10:return t.visitAnimateRef(e,n);case 11:return t.visitQuery(e,n);case 12:return t.visitStagger(e,n);default:throw new Error("Unable to resolve animation metadata node #"+e.type)}}function Gc(t,e){return window.getComputedStyle(t)[e]}var Kc="*",Qc=new Set(["true","1"]),Yc=new Set(["false","0"]);function Xc(t,e){var n=Qc.has(t)||Yc.has(t),r=Qc.has(e)||Yc.has(e);return function(o,i){var a=t==Kc||t==o,s=e==Kc||e==i;return!a&&n&&"boolean"==typeof o&&(a=o?Qc.has(t):Yc.has(t)),!s&&r&&"boolean"==typeof i&&(s=i?Qc.has(e):Yc.has(e)),a&&s}}var Jc=new RegExp("s*:selfs*,?","g");function $c(t,e,n){return new tl(t).build(e,n)}var tl=function(){function t(t){this._driver=t}return t.prototype.build=function(t,e){var n=new el(e);return this._resetContextStyleTimingState(n),qc(this,Fc(t),n)},t.prototype._resetContextStyleTimingState=function(t){t.currentQuerySelector="",t.collectedStyles={},t.collectedStyles[""]={},t.currentTime=0},t.prototype.visitTrigger=function(t,e){var n=this,r=e.queryCount=0,o=e.depCount=0,i=[],a=[];return"@"==t.name.charAt(0)&&e.errors.push("animation triggers cannot be prefixed with an `@` sign (e.g. trigger('@foo', [...]))"),t.definitions.forEach(function(t){if(n._resetContextStyleTimingState(e),0==t.type){var s=t,u=s.name;u.toString().split(/\s*,\s*/).forEach(function(t){s.name=t,i.push(n.visitState(s,e))}),s.name=u}else if(1==t.type){var c=n.visitTransition(t,e);r+=c.queryCount,o+=c.depCount,a.push(c)}else e.errors.push("only state() and transition() definitions can sit inside of a trigger()")}),{type:7,name:t.name,states:i,transitions:a,queryCount:r,depCount:o,options:null}},t.prototype.visitState=function(t,e){var n=this.visitStyle(t.styles,e),r=t.options&&t.options.params||null;if(n.containsDynamicStyles){var o=new Set,i=r||{};if(n.styles.forEach(function(t){if(nl(t)){var e=t;Object.keys(e).forEach(function(t){Bc(e[t]).forEach(function(t){i.hasOwnProperty(t)||o.add(t)})})}}),o.size){var a=Hc(o.values());e.errors.push('state("'+t.name+'", ...) must define default values for all the following style substitutions: '+a.join(", "))}}return{type:0,name:t.name,style:n,options:r?{params:r}:null}},t.prototype.visitTransition=function(t,e){e.queryCount=0,e.depCount=0;var n,r,o,i=qc(this,Fc(t.animation),e);return{type:1,matchers:(n=t.expr,r=e.errors,o=[],"string"==typeof n?n.split(/\s*,\s*/).forEach(function(t){return function(t,e,n){if(":"==t[0]){var r=function(t,e){switch(t){case":enter":return"void => *";case":leave":return"* => void";case":increment":return function(t,e){return parseFloat(e)>parseFloat(t)};case":decrement":return function(t,e){return parseFloat(e)<parseFloat(t)};default:return e.push('The transition alias value "'+t+'" is not supported'),"* => *"}}(t,n);if("function"==typeof r)return void e.push(r);t=r}var o=t.match(/^(\*|[-\w]+)\s*(<?[=-]>)\s*(\*|[-\w]+)$/);if(null==o||o.length<4)return n.push('The provided transition expression "'+t+'" is not supported'),e;var i=o[1],a=o[2],s=o[3];e.push(Xc(i,s)),"<"!=a[0]||i==Kc&&s==Kc||e.push(Xc(s,i))}(t,o,r)}):o.push(n),o),animation:i,queryCount:e.queryCount,depCount:e.depCount,options:rl(t.options)}},t.prototype.visitSequence=function(t,e){var n=this;return{type:2,steps:t.steps.map(function(t){return qc(n,t,e)}),options:rl(t.options)}},t.prototype.visitGroup=function(t,e){var n=this,r=e.currentTime,o=0,i=t.steps.map(function(t){e.currentTime=r;var i=qc(n,t,e);return o=Math.max(o,e.currentTime),i});return e.currentTime=o,{type:3,steps:i,options:rl(t.options)}},t.prototype.visitAnimate=function(t,e){var n,r=function(t,e){var n=null;if(t.hasOwnProperty("duration"))n=t;else if("number"==typeof t)return ol(Oc(t,e).duration,0,"");var r=t;if(r.split(/\s+/).some(function(t){return"{"==t.charAt(0)&&"{"==t.charAt(1)})){var o=ol(0,0,"");return o.dynamic=!0,o.strValue=r,o}return ol((n=n||Oc(r,e)).duration,n.delay,n.easing)}(t.timings,e.errors);e.currentAnimateTimings=r;var o=t.styles?t.styles:ec({});if(5==o.type)n=this.visitKeyframes(o,e);else{var i=t.styles,a=!1;if(!i){a=!0;var s={};r.easing&&(s.easing=r.easing),i=ec(s)}e.currentTime+=r.duration+r.delay;var u=this.visitStyle(i,e);u.isEmptyStep=a,n=u}return e.currentAnimateTimings=null,{type:4,timings:r,style:n,options:null}},t.prototype.visitStyle=function(t,e){var n=this._makeStyleAst(t,e);return this._validateStyleAst(n,e),n},t.prototype._makeStyleAst=function(t,e){var n=[];Array.isArray(t.styles)?t.styles.forEach(function(t){"string"==typeof t?t==$u?n.push(t):e.errors.push("The provided style string value "+t+" is not allowed."):n.push(t)}):n.push(t.styles);var r=!1,o=null;return n.forEach(function(t){if(nl(t)){var e=t,n=e.easing;if(n&&(o=n,delete e.easing),!r)for(var i in e)if(e[i].toString().indexOf("{{")>=0){r=!0;break}}}),{type:6,styles:n,easing:o,offset:t.offset,containsDynamicStyles:r,options:null}},t.prototype._validateStyleAst=function(t,e){var n=this,r=e.currentAnimateTimings,o=e.currentTime,i=e.currentTime;r&&i>0&&(i-=r.duration+r.delay),t.styles.forEach(function(t){"string"!=typeof t&&Object.keys(t).forEach(function(r){if(n._driver.validateStyleProperty(r)){var a,s,u,c=e.collectedStyles[e.currentQuerySelector],l=c[r],f=!0;l&&(i!=o&&i>=l.startTime&&o<=l.endTime&&(e.errors.push('The CSS property "'+r+'" that exists between the times of "'+l.startTime+'ms" and "'+l.endTime+'ms" is also being animated in a parallel animation between the times of "'+i+'ms" and "'+o+'ms"'),f=!1),i=l.startTime),f&&(c[r]={startTime:i,endTime:o}),e.options&&(a=e.errors,s=e.options.params||{},(u=Bc(t[r])).length&&u.forEach(function(t){s.hasOwnProperty(t)||a.push("Unable to resolve the local animation param "+t+" in the given list of values")}))}else e.errors.push('The provided animation property "'+r+'" is not a supported CSS property for animations')})})},t.prototype.visitKeyframes=function(t,e){var n=this,r={type:5,styles:[],options:null};if(!e.currentAnimateTimings)return e.errors.push("keyframes() must be placed inside of a call to animate()"),r;var o=0,i=[],a=!1,s=!1,u=0,c=t.steps.map(function(t){var r=n._makeStyleAst(t,e),c=null!=r.offset?r.offset:function(t){if("string"==typeof t)return null;var e=null;if(Array.isArray(t))t.forEach(function(t){if(nl(t)&&t.hasOwnProperty("offset")){var n=t;e=parseFloat(n.offset),delete n.offset}});else if(nl(t)&&t.hasOwnProperty("offset")){var n=t;e=parseFloat(n.offset),delete n.offset}return e}(r.styles),l=0;return null!=c&&(o++,l=r.offset=c),s=s||l<0||l>1,a=a||l<u,u=l,i.push(l),r});s&&e.errors.push("Please ensure that all keyframe offsets are between 0 and 1"),a&&e.errors.push("Please ensure that all keyframe offsets are in order");var l=t.steps.length,f=0;o>0&&o<l?e.errors.push("Not all style() steps within the declared keyframes() contain offsets"):0==o&&(f=1/(l-1));var p=l-1,h=e.currentTime,d=e.currentAnimateTimings,y=d.duration;return c.forEach(function(t,o){var a=f>0?o==p?1:f*o:i[o],s=a*y;e.currentTime=h+d.delay+s,d.duration=s,n._validateStyleAst(t,e),t.offset=a,r.styles.push(t)}),r},t.prototype.visitReference=function(t,e){return{type:8,animation:qc(this,Fc(t.animation),e),options:rl(t.options)}},t.prototype.visitAnimateChild=function(t,e){return e.depCount++,{type:9,options:rl(t.options)}},t.prototype.visitAnimateRef=function(t,e){return{type:10,animation:this.visitReference(t.animation,e),options:rl(t.options)}},t.prototype.visitQuery=function(t,e){var n=e.currentQuerySelector,r=t.options||{};e.queryCount++,e.currentQuery=t;var o=f(function(t){var e=!!t.split(/\s*,\s*/).find(function(t){return":self"==t});return e&&(t=t.replace(Jc,"")),[t=t.replace(/@\*/g,".ng-trigger").replace(/@\w+/g,function(t){return".ng-trigger-"+t.substr(1)}).replace(/:animating/g,".ng-animating"),e]}(t.selector),2),i=o[0],a=o[1];e.currentQuerySelector=n.length?n+" "+i:i,pc(e.collectedStyles,e.currentQuerySelector,{});var s=qc(this,Fc(t.animation),e);return e.currentQuery=null,e.currentQuerySelector=n,{type:11,selector:i,limit:r.limit||0,optional:!!r.optional,includeSelf:a,animation:s,originalSelector:t.selector,options:rl(t.options)}},t.prototype.visitStagger=function(t,e){e.currentQuery||e.errors.push("stagger() can only be used inside of query()");var n="full"===t.timings?{duration:0,delay:0,easing:"full"}:Oc(t.timings,e.errors,!0);return{type:12,animation:qc(this,Fc(t.animation),e),timings:n,options:null}},t}(),el=function(){return function(t){this.errors=t,this.queryCount=0,this.depCount=0,this.currentTransition=null,this.currentQuery=null,this.currentQuerySelector=null,this.currentAnimateTimings=null,this.currentTime=0,this.collectedStyles={},this.options=null}}();function nl(t){return!Array.isArray(t)&&"object"==typeof t}function rl(t){var e;return t?(t=Pc(t)).params&&(t.params=(e=t.params)?Pc(e):null):t={},t}function ol(t,e,n){return{duration:t,delay:e,easing:n}}function il(t,e,n,r,o,i,a,s){return void 0===a&&(a=null),void 0===s&&(s=!1),{type:1,element:t,keyframes:e,preStyleProps:n,postStyleProps:r,duration:o,delay:i,totalTime:o+i,easing:a,subTimeline:s}}var al=function(){function t(){this._map=new Map}return t.prototype.consume=function(t){var e=this._map.get(t);return e?this._map.delete(t):e=[],e},t.prototype.append=function(t,e){var n=this._map.get(t);n||this._map.set(t,n=[]),n.push.apply(n,p(e))},t.prototype.has=function(t){return this._map.has(t)},t.prototype.clear=function(){this._map.clear()},t}(),sl=new RegExp(":enter","g"),ul=new RegExp(":leave","g");function cl(t,e,n,r,o,i,a,s,u,c){return void 0===i&&(i={}),void 0===a&&(a={}),void 0===c&&(c=[]),(new ll).buildKeyframes(t,e,n,r,o,i,a,s,u,c)}var ll=function(){function t(){}return t.prototype.buildKeyframes=function(t,e,n,r,o,i,a,s,u,c){void 0===c&&(c=[]),u=u||new al;var l=new pl(t,e,u,r,o,c,[]);l.options=s,l.currentTimeline.setStyles([i],null,l.errors,s),qc(this,n,l);var f=l.timelines.filter(function(t){return t.containsAnimation()});if(f.length&&Object.keys(a).length){var p=f[f.length-1];p.allowOnlyTimelineStyles()||p.setStyles([a],null,l.errors,s)}return f.length?f.map(function(t){return t.buildKeyframes()}):[il(e,[],[],[],0,0,"",!1)]},t.prototype.visitTrigger=function(t,e){},t.prototype.visitState=function(t,e){},t.prototype.visitTransition=function(t,e){},t.prototype.visitAnimateChild=function(t,e){var n=e.subInstructions.consume(e.element);if(n){var r=e.createSubContext(t.options),o=e.currentTimeline.currentTime,i=this._visitSubInstructions(n,r,r.options);o!=i&&e.transformIntoNewTimeline(i)}e.previousNode=t},t.prototype.visitAnimateRef=function(t,e){var n=e.createSubContext(t.options);n.transformIntoNewTimeline(),this.visitReference(t.animation,n),e.transformIntoNewTimeline(n.currentTimeline.currentTime),e.previousNode=t},t.prototype._visitSubInstructions=function(t,e,n){var r=e.currentTimeline.currentTime,o=null!=n.duration?Ic(n.duration):null,i=null!=n.delay?Ic(n.delay):null;return 0!==o&&t.forEach(function(t){var n=e.appendInstructionToTimeline(t,o,i);r=Math.max(r,n.duration+n.delay)}),r},t.prototype.visitReference=function(t,e){e.updateOptions(t.options,!0),qc(this,t.animation,e),e.previousNode=t},t.prototype.visitSequence=function(t,e){var n=this,r=e.subContextCount,o=e,i=t.options;if(i&&(i.params||i.delay)&&((o=e.createSubContext(i)).transformIntoNewTimeline(),null!=i.delay)){6==o.previousNode.type&&(o.currentTimeline.snapshotCurrentStyles(),o.previousNode=fl);var a=Ic(i.delay);o.delayNextStep(a)}t.steps.length&&(t.steps.forEach(function(t){return qc(n,t,o)}),o.currentTimeline.applyStylesToKeyframe(),o.subContextCount>r&&o.transformIntoNewTimeline()),e.previousNode=t},t.prototype.visitGroup=function(t,e){var n=this,r=[],o=e.currentTimeline.currentTime,i=t.options&&t.options.delay?Ic(t.options.delay):0;t.steps.forEach(function(a){var s=e.createSubContext(t.options);i&&s.delayNextStep(i),qc(n,a,s),o=Math.max(o,s.currentTimeline.currentTime),r.push(s.currentTimeline)}),r.forEach(function(t){return e.currentTimeline.mergeTimelineCollectedStyles(t)}),e.transformIntoNewTimeline(o),e.previousNode=t},t.prototype._visitTiming=function(t,e){if(t.dynamic){var n=t.strValue;return Oc(e.params?Vc(n,e.params,e.errors):n,e.errors)}return{duration:t.duration,delay:t.delay,easing:t.easing}},t.prototype.visitAnimate=function(t,e){var n=e.currentAnimateTimings=this._visitTiming(t.timings,e),r=e.currentTimeline;n.delay&&(e.incrementTime(n.delay),r.snapshotCurrentStyles());var o=t.style;5==o.type?this.visitKeyframes(o,e):(e.incrementTime(n.duration),this.visitStyle(o,e),r.applyStylesToKeyframe()),e.currentAnimateTimings=null,e.previousNode=t},t.prototype.visitStyle=function(t,e){var n=e.currentTimeline,r=e.currentAnimateTimings;!r&&n.getCurrentStyleProperties().length&&n.forwardFrame();var o=r&&r.easing||t.easing;t.isEmptyStep?n.applyEmptyStep(o):n.setStyles(t.styles,o,e.errors,e.options),e.previousNode=t},t.prototype.visitKeyframes=function(t,e){var n=e.currentAnimateTimings,r=e.currentTimeline.duration,o=n.duration,i=e.createSubContext().currentTimeline;i.easing=n.easing,t.styles.forEach(function(t){i.forwardTime((t.offset||0)*o),i.setStyles(t.styles,t.easing,e.errors,e.options),i.applyStylesToKeyframe()}),e.currentTimeline.mergeTimelineCollectedStyles(i),e.transformIntoNewTimeline(r+o),e.previousNode=t},t.prototype.visitQuery=function(t,e){var n=this,r=e.currentTimeline.currentTime,o=t.options||{},i=o.delay?Ic(o.delay):0;i&&(6===e.previousNode.type||0==r&&e.currentTimeline.getCurrentStyleProperties().length)&&(e.currentTimeline.snapshotCurrentStyles(),e.previousNode=fl);var a=r,s=e.invokeQuery(t.selector,t.originalSelector,t.limit,t.includeSelf,!!o.optional,e.errors);e.currentQueryTotal=s.length;var u=null;s.forEach(function(r,o){e.currentQueryIndex=o;var s=e.createSubContext(t.options,r);i&&s.delayNextStep(i),r===e.element&&(u=s.currentTimeline),qc(n,t.animation,s),s.currentTimeline.applyStylesToKeyframe(),a=Math.max(a,s.currentTimeline.currentTime)}),e.currentQueryIndex=0,e.currentQueryTotal=0,e.transformIntoNewTimeline(a),u&&(e.currentTimeline.mergeTimelineCollectedStyles(u),e.currentTimeline.snapshotCurrentStyles()),e.previousNode=t},t.prototype.visitStagger=function(t,e){var n=e.parentContext,r=e.currentTimeline,o=t.timings,i=Math.abs(o.duration),a=i*(e.currentQueryTotal-1),s=i*e.currentQueryIndex;switch(o.duration<0?"reverse":o.easing){case"reverse":s=a-s;break;case"full":s=n.currentStaggerTime}var u=e.currentTimeline;s&&u.delayNextStep(s);var c=u.currentTime;qc(this,t.animation,e),e.previousNode=t,n.currentStaggerTime=r.currentTime-c+(r.startTime-n.currentTimeline.startTime)},t}(),fl={},pl=function(){function t(t,e,n,r,o,i,a,s){this._driver=t,this.element=e,this.subInstructions=n,this._enterClassName=r,this._leaveClassName=o,this.errors=i,this.timelines=a,this.parentContext=null,this.currentAnimateTimings=null,this.previousNode=fl,this.subContextCount=0,this.options={},this.currentQueryIndex=0,this.currentQueryTotal=0,this.currentStaggerTime=0,this.currentTimeline=s||new hl(this._driver,e,0),a.push(this.currentTimeline)}return Object.defineProperty(t.prototype,"params",{get:function(){return this.options.params},enumerable:!0,configurable:!0}),t.prototype.updateOptions=function(t,e){var n=this;if(t){var r=t,o=this.options;null!=r.duration&&(o.duration=Ic(r.duration)),null!=r.delay&&(o.delay=Ic(r.delay));var i=r.params;if(i){var a=o.params;a||(a=this.options.params={}),Object.keys(i).forEach(function(t){e&&a.hasOwnProperty(t)||(a[t]=Vc(i[t],a,n.errors))})}}},t.prototype._copyOptions=function(){var t={};if(this.options){var e=this.options.params;if(e){var n=t.params={};Object.keys(e).forEach(function(t){n[t]=e[t]})}}return t},t.prototype.createSubContext=function(e,n,r){void 0===e&&(e=null);var o=n||this.element,i=new t(this._driver,o,this.subInstructions,this._enterClassName,this._leaveClassName,this.errors,this.timelines,this.currentTimeline.fork(o,r||0));return i.previousNode=this.previousNode,i.currentAnimateTimings=this.currentAnimateTimings,i.options=this._copyOptions(),i.updateOptions(e),i.currentQueryIndex=this.currentQueryIndex,i.currentQueryTotal=this.currentQueryTotal,i.parentContext=this,this.subContextCount++,i},t.prototype.transformIntoNewTimeline=function(t){return this.previousNode=fl,this.currentTimeline=this.currentTimeline.fork(this.element,t),this.timelines.push(this.currentTimeline),this.currentTimeline},t.prototype.appendInstructionToTimeline=function(t,e,n){var r={duration:null!=e?e:t.duration,delay:this.currentTimeline.currentTime+(null!=n?n:0)+t.delay,easing:""},o=new dl(this._driver,t.element,t.keyframes,t.preStyleProps,t.postStyleProps,r,t.stretchStartingKeyframe);return this.timelines.push(o),r},t.prototype.incrementTime=function(t){this.currentTimeline.forwardTime(this.currentTimeline.duration+t)},t.prototype.delayNextStep=function(t){t>0&&this.currentTimeline.delayNextStep(t)},t.prototype.invokeQuery=function(t,e,n,r,o,i){var a=[];if(r&&a.push(this.element),t.length>0){t=(t=t.replace(sl,"."+this._enterClassName)).replace(ul,"."+this._leaveClassName);var s=this._driver.query(this.element,t,1!=n);0!==n&&(s=n<0?s.slice(s.length+n,s.length):s.slice(0,n)),a.push.apply(a,p(s))}return o||0!=a.length||i.push('`query("'+e+'")` returned zero elements. (Use `query("'+e+'", { optional: true })` if you wish to allow this.)'),a},t}(),hl=function(){function t(t,e,n,r){this._driver=t,this.element=e,this.startTime=n,this._elementTimelineStylesLookup=r,this.duration=0,this._previousKeyframe={},this._currentKeyframe={},this._keyframes=new Map,this._styleSummary={},this._pendingStyles={},this._backFill={},this._currentEmptyStepKeyframe=null,this._elementTimelineStylesLookup||(this._elementTimelineStylesLookup=new Map),this._localTimelineStyles=Object.create(this._backFill,{}),this._globalTimelineStyles=this._elementTimelineStylesLookup.get(e),this._globalTimelineStyles||(this._globalTimelineStyles=this._localTimelineStyles,this._elementTimelineStylesLookup.set(e,this._localTimelineStyles)),this._loadKeyframe()}return t.prototype.containsAnimation=function(){switch(this._keyframes.size){case 0:return!1;case 1:return this.getCurrentStyleProperties().length>0;default:return!0}},t.prototype.getCurrentStyleProperties=function(){return Object.keys(this._currentKeyframe)},Object.defineProperty(t.prototype,"currentTime",{get:function(){return this.startTime+this.duration},enumerable:!0,configurable:!0}),t.prototype.delayNextStep=function(t){var e=1==this._keyframes.size&&Object.keys(this._pendingStyles).length;this.duration||e?(this.forwardTime(this.currentTime+t),e&&this.snapshotCurrentStyles()):this.startTime+=t},t.prototype.fork=function(e,n){return this.applyStylesToKeyframe(),new t(this._driver,e,n||this.currentTime,this._elementTimelineStylesLookup)},t.prototype._loadKeyframe=function(){this._currentKeyframe&&(this._previousKeyframe=this._currentKeyframe),this._currentKeyframe=this._keyframes.get(this.duration),this._currentKeyframe||(this._currentKeyframe=Object.create(this._backFill,{}),this._keyframes.set(this.duration,this._currentKeyframe))},t.prototype.forwardFrame=function(){this.duration+=1,this._loadKeyframe()},t.prototype.forwardTime=function(t){this.applyStylesToKeyframe(),this.duration=t,this._loadKeyframe()},t.prototype._updateStyle=function(t,e){this._localTimelineStyles[t]=e,this._globalTimelineStyles[t]=e,this._styleSummary[t]={time:this.currentTime,value:e}},t.prototype.allowOnlyTimelineStyles=function(){return this._currentEmptyStepKeyframe!==this._currentKeyframe},t.prototype.applyEmptyStep=function(t){var e=this;t&&(this._previousKeyframe.easing=t),Object.keys(this._globalTimelineStyles).forEach(function(t){e._backFill[t]=e._globalTimelineStyles[t]||$u,e._currentKeyframe[t]=$u}),this._currentEmptyStepKeyframe=this._currentKeyframe},t.prototype.setStyles=function(t,e,n,r){var o=this;e&&(this._previousKeyframe.easing=e);var i=r&&r.params||{},a=function(t,e){var n,r={};return t.forEach(function(t){"*"===t?(n=n||Object.keys(e)).forEach(function(t){r[t]=$u}):Rc(t,!1,r)}),r}(t,this._globalTimelineStyles);Object.keys(a).forEach(function(t){var e=Vc(a[t],i,n);o._pendingStyles[t]=e,o._localTimelineStyles.hasOwnProperty(t)||(o._backFill[t]=o._globalTimelineStyles.hasOwnProperty(t)?o._globalTimelineStyles[t]:$u),o._updateStyle(t,e)})},t.prototype.applyStylesToKeyframe=function(){var t=this,e=this._pendingStyles,n=Object.keys(e);0!=n.length&&(this._pendingStyles={},n.forEach(function(n){t._currentKeyframe[n]=e[n]}),Object.keys(this._localTimelineStyles).forEach(function(e){t._currentKeyframe.hasOwnProperty(e)||(t._currentKeyframe[e]=t._localTimelineStyles[e])}))},t.prototype.snapshotCurrentStyles=function(){var t=this;Object.keys(this._localTimelineStyles).forEach(function(e){var n=t._localTimelineStyles[e];t._pendingStyles[e]=n,t._updateStyle(e,n)})},t.prototype.getFinalKeyframe=function(){return this._keyframes.get(this.duration)},Object.defineProperty(t.prototype,"properties",{get:function(){var t=[];for(var e in this._currentKeyframe)t.push(e);return t},enumerable:!0,configurable:!0}),t.prototype.mergeTimelineCollectedStyles=function(t){var e=this;Object.keys(t._styleSummary).forEach(function(n){var r=e._styleSummary[n],o=t._styleSummary[n];(!r||o.time>r.time)&&e._updateStyle(n,o.value)})},t.prototype.buildKeyframes=function(){var t=this;this.applyStylesToKeyframe();var e=new Set,n=new Set,r=1===this._keyframes.size&&0===this.duration,o=[];this._keyframes.forEach(function(i,a){var s=Rc(i,!0);Object.keys(s).forEach(function(t){var r=s[t];r==ic?e.add(t):r==$u&&n.add(t)}),r||(s.offset=a/t.duration),o.push(s)});var i=e.size?Hc(e.values()):[],a=n.size?Hc(n.values()):[];if(r){var s=o[0],u=Pc(s);s.offset=0,u.offset=1,o=[s,u]}return il(this.element,o,i,a,this.duration,this.startTime,this.easing,!1)},t}(),dl=function(t){function e(e,n,r,o,i,a,s){void 0===s&&(s=!1);var u=t.call(this,e,n,a.delay)||this;return u.element=n,u.keyframes=r,u.preStyleProps=o,u.postStyleProps=i,u._stretchStartingKeyframe=s,u.timings={duration:a.duration,delay:a.delay,easing:a.easing},u}return o(e,t),e.prototype.containsAnimation=function(){return this.keyframes.length>1},e.prototype.buildKeyframes=function(){var t=this.keyframes,e=this.timings,n=e.delay,r=e.duration,o=e.easing;if(this._stretchStartingKeyframe&&n){var i=[],a=r+n,s=n/a,u=Rc(t[0],!1);u.offset=0,i.push(u);var c=Rc(t[0],!1);c.offset=yl(s),i.push(c);for(var l=t.length-1,f=1;f<=l;f++){var p=Rc(t[f],!1);p.offset=yl((n+p.offset*r)/a),i.push(p)}r=a,n=0,o="",t=i}return il(this.element,t,this.preStyleProps,this.postStyleProps,r,n,o,!0)},e}(hl);function yl(t,e){void 0===e&&(e=3);var n=Math.pow(10,e-1);return Math.round(t*n)/n}var vl=function(){return function(){}}(),gl=function(t){function e(){return null!==t&&t.apply(this,arguments)||this}return o(e,t),e.prototype.normalizePropertyName=function(t,e){return Uc(t)},e.prototype.normalizeStyleValue=function(t,e,n,r){var o="",i=n.toString().trim();if(ml[e]&&0!==n&&"0"!==n)if("number"==typeof n)o="px";else{var a=n.match(/^[+-]?[\d\.]+([a-z]*)$/);a&&0==a[1].length&&r.push("Please provide a CSS unit value for "+t+":"+n)}return i+o},e}(vl),ml=function(){return t="width,height,minWidth,minHeight,maxWidth,maxHeight,left,top,bottom,right,fontSize,outlineWidth,outlineOffset,paddingTop,paddingLeft,paddingBottom,paddingRight,marginTop,marginLeft,marginBottom,marginRight,borderRadius,borderWidth,borderTopWidth,borderLeftWidth,borderRightWidth,borderBottomWidth,textIndent,perspective".split(","),e={},t.forEach(function(t){return e[t]=!0}),e;var t,e}();function bl(t,e,n,r,o,i,a,s,u,c,l,f,p){return{type:0,element:t,triggerName:e,isRemovalTransition:o,fromState:n,fromStyles:i,toState:r,toStyles:a,timelines:s,queriedElements:u,preStyleProps:c,postStyleProps:l,totalTime:f,errors:p}}var _l={},wl=function(){function t(t,e,n){this._triggerName=t,this.ast=e,this._stateStyles=n}return t.prototype.match=function(t,e,n,r){return function(t,e,n,r,o){return t.some(function(t){return t(e,n,r,o)})}(this.ast.matchers,t,e,n,r)},t.prototype.buildStyles=function(t,e,n){var r=this._stateStyles["*"],o=this._stateStyles[t],i=r?r.buildStyles(e,n):{};return o?o.buildStyles(e,n):i},t.prototype.build=function(t,e,n,r,o,a,s,u,c,l){var f=[],p=this.ast.options&&this.ast.options.params||_l,h=this.buildStyles(n,s&&s.params||_l,f),d=u&&u.params||_l,y=this.buildStyles(r,d,f),v=new Set,g=new Map,m=new Map,b="void"===r,_={params:i({},p,d)},w=l?[]:cl(t,e,this.ast.animation,o,a,h,y,_,c,f),E=0;if(w.forEach(function(t){E=Math.max(t.duration+t.delay,E)}),f.length)return bl(e,this._triggerName,n,r,b,h,y,[],[],g,m,E,f);w.forEach(function(t){var n=t.element,r=pc(g,n,{});t.preStyleProps.forEach(function(t){return r[t]=!0});var o=pc(m,n,{});t.postStyleProps.forEach(function(t){return o[t]=!0}),n!==e&&v.add(n)});var S=Hc(v.values());return bl(e,this._triggerName,n,r,b,h,y,w,S,g,m,E)},t}(),El=function(){function t(t,e){this.styles=t,this.defaultParams=e}return t.prototype.buildStyles=function(t,e){var n={},r=Pc(this.defaultParams);return Object.keys(t).forEach(function(e){var n=t[e];null!=n&&(r[e]=n)}),this.styles.styles.forEach(function(t){if("string"!=typeof t){var o=t;Object.keys(o).forEach(function(t){var i=o[t];i.length>1&&(i=Vc(i,r,e)),n[t]=i})}}),n},t}(),Sl=function(){function t(t,e){var n=this;this.name=t,this.ast=e,this.transitionFactories=[],this.states={},e.states.forEach(function(t){n.states[t.name]=new El(t.style,t.options&&t.options.params||{})}),xl(this.states,"true","1"),xl(this.states,"false","0"),e.transitions.forEach(function(e){n.transitionFactories.push(new wl(t,e,n.states))}),this.fallbackTransition=new wl(t,{type:1,animation:{type:2,steps:[],options:null},matchers:[function(t,e){return!0}],options:null,queryCount:0,depCount:0},this.states)}return Object.defineProperty(t.prototype,"containsQueries",{get:function(){return this.ast.queryCount>0},enumerable:!0,configurable:!0}),t.prototype.matchTransition=function(t,e,n,r){return this.transitionFactories.find(function(o){return o.match(t,e,n,r)})||null},t.prototype.matchStyles=function(t,e,n){return this.fallbackTransition.buildStyles(t,e,n)},t}();function xl(t,e,n){t.hasOwnProperty(e)?t.hasOwnProperty(n)||(t[n]=t[e]):t.hasOwnProperty(n)&&(t[e]=t[n])}var Tl=new al,kl=function(){function t(t,e,n){this.bodyNode=t,this._driver=e,this._normalizer=n,this._animations={},this._playersById={},this.players=[]}return t.prototype.register=function(t,e){var n=[],r=$c(this._driver,e,n);if(n.length)throw new Error("Unable to build the animation due to the following errors: "+n.join("\n"));this._animations[t]=r},t.prototype._buildPlayer=function(t,e,n){var r=t.element,o=uc(0,this._normalizer,0,t.keyframes,e,n);return this._driver.animate(r,o,t.duration,t.delay,t.easing,[],!0)},t.prototype.create=function(t,e,n){var r=this;void 0===n&&(n={});var o,i=[],a=this._animations[t],s=new Map;if(a?(o=cl(this._driver,e,a,"ng-enter","ng-leave",{},{},n,Tl,i)).forEach(function(t){var e=pc(s,t.element,{});t.postStyleProps.forEach(function(t){return e[t]=null})}):(i.push("The requested animation doesn't exist or has already been destroyed"),o=[]),i.length)throw new Error("Unable to create the animation due to the following errors: "+i.join("\n"));s.forEach(function(t,e){Object.keys(t).forEach(function(n){t[n]=r._driver.computeStyle(e,n,$u)})});var u=sc(o.map(function(t){var e=s.get(t.element);return r._buildPlayer(t,{},e)}));return this._playersById[t]=u,u.onDestroy(function(){return r.destroy(t)}),this.players.push(u),u},t.prototype.destroy=function(t){var e=this._getPlayer(t);e.destroy(),delete this._playersById[t];var n=this.players.indexOf(e);n>=0&&this.players.splice(n,1)},t.prototype._getPlayer=function(t){var e=this._playersById[t];if(!e)throw new Error("Unable to find the timeline player referenced by "+t);return e},t.prototype.listen=function(t,e,n,r){var o=fc(e,"","","");return cc(this._getPlayer(t),n,o,r),function(){}},t.prototype.command=function(t,e,n,r){if("register"!=n)if("create"!=n){var o=this._getPlayer(t);switch(n){case"play":o.play();break;case"pause":o.pause();break;case"reset":o.reset();break;case"restart":o.restart();break;case"finish":o.finish();break;case"init":o.init();break;case"setPosition":o.setPosition(parseFloat(r[0]));break;case"destroy":this.destroy(t)}}else this.create(t,e,r[0]||{});else this.register(t,r[0])},t}(),Al=[],Il={namespaceId:"",setForRemoval:!1,setForMove:!1,hasAnimation:!1,removedBeforeQueried:!1},Cl={namespaceId:"",setForMove:!1,setForRemoval:!1,hasAnimation:!1,removedBeforeQueried:!0},Ol="__ng_removed",Pl=function(){function t(t,e){void 0===e&&(e=""),this.namespaceId=e;var n=t&&t.hasOwnProperty("value");if(this.value=function(t){return null!=t?t:null}(n?t.value:t),n){var r=Pc(t);delete r.value,this.options=r}else this.options={};this.options.params||(this.options.params={})}return Object.defineProperty(t.prototype,"params",{get:function(){return this.options.params},enumerable:!0,configurable:!0}),t.prototype.absorbOptions=function(t){var e=t.params;if(e){var n=this.options.params;Object.keys(e).forEach(function(t){null==n[t]&&(n[t]=e[t])})}},t}(),Rl=new Pl("void"),Dl=function(){function t(t,e,n){this.id=t,this.hostElement=e,this._engine=n,this.players=[],this._triggers={},this._queue=[],this._elementListeners=new Map,this._hostClassName="ng-tns-"+t,Hl(e,this._hostClassName)}return t.prototype.listen=function(t,e,n,r){var o,i=this;if(!this._triggers.hasOwnProperty(e))throw new Error('Unable to listen on the animation trigger event "'+n+'" because the animation trigger "'+e+"\" doesn't exist!");if(null==n||0==n.length)throw new Error('Unable to listen on the animation trigger "'+e+'" because the provided event is undefined!');if("start"!=(o=n)&&"done"!=o)throw new Error('The provided animation trigger event "'+n+'" for the animation trigger "'+e+'" is not supported!');var a=pc(this._elementListeners,t,[]),s={name:e,phase:n,callback:r};a.push(s);var u=pc(this._engine.statesByElement,t,{});return u.hasOwnProperty(e)||(Hl(t,"ng-trigger"),Hl(t,"ng-trigger-"+e),u[e]=Rl),function(){i._engine.afterFlush(function(){var t=a.indexOf(s);t>=0&&a.splice(t,1),i._triggers[e]||delete u[e]})}},t.prototype.register=function(t,e){return!this._triggers[t]&&(this._triggers[t]=e,!0)},t.prototype._getTrigger=function(t){var e=this._triggers[t];if(!e)throw new Error('The provided animation trigger "'+t+'" has not been registered!');return e},t.prototype.trigger=function(t,e,n,r){var o=this;void 0===r&&(r=!0);var i=this._getTrigger(e),a=new Ml(this.id,e,t),s=this._engine.statesByElement.get(t);s||(Hl(t,"ng-trigger"),Hl(t,"ng-trigger-"+e),this._engine.statesByElement.set(t,s={}));var u=s[e],c=new Pl(n,this.id);if(!(n&&n.hasOwnProperty("value"))&&u&&c.absorbOptions(u.options),s[e]=c,u||(u=Rl),"void"===c.value||u.value!==c.value){var l=pc(this._engine.playersByElement,t,[]);l.forEach(function(t){t.namespaceId==o.id&&t.triggerName==e&&t.queued&&t.destroy()});var f=i.matchTransition(u.value,c.value,t,c.params),p=!1;if(!f){if(!r)return;f=i.fallbackTransition,p=!0}return this._engine.totalQueuedPlayers++,this._queue.push({element:t,triggerName:e,transition:f,fromState:u,toState:c,player:a,isFallbackTransition:p}),p||(Hl(t,"ng-animate-queued"),a.onStart(function(){zl(t,"ng-animate-queued")})),a.onDone(function(){var e=o.players.indexOf(a);e>=0&&o.players.splice(e,1);var n=o._engine.playersByElement.get(t);if(n){var r=n.indexOf(a);r>=0&&n.splice(r,1)}}),this.players.push(a),l.push(a),a}if(!function(t,e){var n=Object.keys(t),r=Object.keys(e);if(n.length!=r.length)return!1;for(var o=0;o<n.length;o++){var i=n[o];if(!e.hasOwnProperty(i)||t[i]!==e[i])return!1}return!0}(u.params,c.params)){var h=[],d=i.matchStyles(u.value,u.params,h),y=i.matchStyles(c.value,c.params,h);h.length?this._engine.reportError(h):this._engine.afterFlush(function(){jc(t,d),Mc(t,y)})}},t.prototype.deregister=function(t){var e=this;delete this._triggers[t],this._engine.statesByElement.forEach(function(e,n){delete e[t]}),this._elementListeners.forEach(function(n,r){e._elementListeners.set(r,n.filter(function(e){return e.name!=t}))})},t.prototype.clearElementCache=function(t){this._engine.statesByElement.delete(t),this._elementListeners.delete(t);var e=this._engine.playersByElement.get(t);e&&(e.forEach(function(t){return t.destroy()}),this._engine.playersByElement.delete(t))},t.prototype._signalRemovalForInnerTriggers=function(t,e,n){var r=this;void 0===n&&(n=!1),this._engine.driver.query(t,".ng-trigger",!0).forEach(function(t){if(!t[Ol]){var n=r._engine.fetchNamespacesByElement(t);n.size?n.forEach(function(n){return n.triggerLeaveAnimation(t,e,!1,!0)}):r.clearElementCache(t)}})},t.prototype.triggerLeaveAnimation=function(t,e,n,r){var o=this,i=this._engine.statesByElement.get(t);if(i){var a=[];if(Object.keys(i).forEach(function(e){if(o._triggers[e]){var n=o.trigger(t,e,"void",r);n&&a.push(n)}}),a.length)return this._engine.markElementAsRemoved(this.id,t,!0,e),n&&sc(a).onDone(function(){return o._engine.processLeaveNode(t)}),!0}return!1},t.prototype.prepareLeaveAnimationListeners=function(t){var e=this,n=this._elementListeners.get(t);if(n){var r=new Set;n.forEach(function(n){var o=n.name;if(!r.has(o)){r.add(o);var i=e._triggers[o].fallbackTransition,a=e._engine.statesByElement.get(t)[o]||Rl,s=new Pl("void"),u=new Ml(e.id,o,t);e._engine.totalQueuedPlayers++,e._queue.push({element:t,triggerName:o,transition:i,fromState:a,toState:s,player:u,isFallbackTransition:!0})}})}},t.prototype.removeNode=function(t,e){var n=this,r=this._engine;if(t.childElementCount&&this._signalRemovalForInnerTriggers(t,e,!0),!this.triggerLeaveAnimation(t,e,!0)){var o=!1;if(r.totalAnimations){var i=r.players.length?r.playersByQueriedElement.get(t):[];if(i&&i.length)o=!0;else for(var a=t;a=a.parentNode;)if(r.statesByElement.get(a)){o=!0;break}}this.prepareLeaveAnimationListeners(t),o?r.markElementAsRemoved(this.id,t,!1,e):(r.afterFlush(function(){return n.clearElementCache(t)}),r.destroyInnerAnimations(t),r._onRemovalComplete(t,e))}},t.prototype.insertNode=function(t,e){Hl(t,this._hostClassName)},t.prototype.drainQueuedTransitions=function(t){var e=this,n=[];return this._queue.forEach(function(r){var o=r.player;if(!o.destroyed){var i=r.element,a=e._elementListeners.get(i);a&&a.forEach(function(e){if(e.name==r.triggerName){var n=fc(i,r.triggerName,r.fromState.value,r.toState.value);n._data=t,cc(r.player,e.phase,n,e.callback)}}),o.markedForDestroy?e._engine.afterFlush(function(){o.destroy()}):n.push(r)}}),this._queue=[],n.sort(function(t,n){var r=t.transition.ast.depCount,o=n.transition.ast.depCount;return 0==r||0==o?r-o:e._engine.driver.containsElement(t.element,n.element)?1:-1})},t.prototype.destroy=function(t){this.players.forEach(function(t){return t.destroy()}),this._signalRemovalForInnerTriggers(this.hostElement,t)},t.prototype.elementContainsData=function(t){var e=!1;return this._elementListeners.has(t)&&(e=!0),!!this._queue.find(function(e){return e.element===t})||e},t}(),Nl=function(){function t(t,e,n){this.bodyNode=t,this.driver=e,this._normalizer=n,this.players=[],this.newHostElements=new Map,this.playersByElement=new Map,this.playersByQueriedElement=new Map,this.statesByElement=new Map,this.disabledNodes=new Set,this.totalAnimations=0,this.totalQueuedPlayers=0,this._namespaceLookup={},this._namespaceList=[],this._flushFns=[],this._whenQuietFns=[],this.namespacesByHostElement=new Map,this.collectedEnterElements=[],this.collectedLeaveElements=[],this.onRemovalComplete=function(t,e){}}return t.prototype._onRemovalComplete=function(t,e){this.onRemovalComplete(t,e)},Object.defineProperty(t.prototype,"queuedPlayers",{get:function(){var t=[];return this._namespaceList.forEach(function(e){e.players.forEach(function(e){e.queued&&t.push(e)})}),t},enumerable:!0,configurable:!0}),t.prototype.createNamespace=function(t,e){var n=new Dl(t,e,this);return e.parentNode?this._balanceNamespaceList(n,e):(this.newHostElements.set(e,n),this.collectEnterElement(e)),this._namespaceLookup[t]=n},t.prototype._balanceNamespaceList=function(t,e){var n=this._namespaceList.length-1;if(n>=0){for(var r=!1,o=n;o>=0;o--)if(this.driver.containsElement(this._namespaceList[o].hostElement,e)){this._namespaceList.splice(o+1,0,t),r=!0;break}r||this._namespaceList.splice(0,0,t)}else this._namespaceList.push(t);return this.namespacesByHostElement.set(e,t),t},t.prototype.register=function(t,e){var n=this._namespaceLookup[t];return n||(n=this.createNamespace(t,e)),n},t.prototype.registerTrigger=function(t,e,n){var r=this._namespaceLookup[t];r&&r.register(e,n)&&this.totalAnimations++},t.prototype.destroy=function(t,e){var n=this;if(t){var r=this._fetchNamespace(t);this.afterFlush(function(){n.namespacesByHostElement.delete(r.hostElement),delete n._namespaceLookup[t];var e=n._namespaceList.indexOf(r);e>=0&&n._namespaceList.splice(e,1)}),this.afterFlushAnimationsDone(function(){return r.destroy(e)})}},t.prototype._fetchNamespace=function(t){return this._namespaceLookup[t]},t.prototype.fetchNamespacesByElement=function(t){var e=new Set,n=this.statesByElement.get(t);if(n)for(var r=Object.keys(n),o=0;o<r.length;o++){var i=n[r[o]].namespaceId;if(i){var a=this._fetchNamespace(i);a&&e.add(a)}}return e},t.prototype.trigger=function(t,e,n,r){if(jl(e)){var o=this._fetchNamespace(t);if(o)return o.trigger(e,n,r),!0}return!1},t.prototype.insertNode=function(t,e,n,r){if(jl(e)){var o=e[Ol];if(o&&o.setForRemoval){o.setForRemoval=!1,o.setForMove=!0;var i=this.collectedLeaveElements.indexOf(e);i>=0&&this.collectedLeaveElements.splice(i,1)}if(t){var a=this._fetchNamespace(t);a&&a.insertNode(e,n)}r&&this.collectEnterElement(e)}},t.prototype.collectEnterElement=function(t){this.collectedEnterElements.push(t)},t.prototype.markElementAsDisabled=function(t,e){e?this.disabledNodes.has(t)||(this.disabledNodes.add(t),Hl(t,"ng-animate-disabled")):this.disabledNodes.has(t)&&(this.disabledNodes.delete(t),zl(t,"ng-animate-disabled"))},t.prototype.removeNode=function(t,e,n,r){if(jl(e)){var o=t?this._fetchNamespace(t):null;if(o?o.removeNode(e,r):this.markElementAsRemoved(t,e,!1,r),n){var i=this.namespacesByHostElement.get(e);i&&i.id!==t&&i.removeNode(e,r)}}else this._onRemovalComplete(e,r)},t.prototype.markElementAsRemoved=function(t,e,n,r){this.collectedLeaveElements.push(e),e[Ol]={namespaceId:t,setForRemoval:r,hasAnimation:n,removedBeforeQueried:!1}},t.prototype.listen=function(t,e,n,r,o){return jl(e)?this._fetchNamespace(t).listen(e,n,r,o):function(){}},t.prototype._buildInstruction=function(t,e,n,r,o){return t.transition.build(this.driver,t.element,t.fromState.value,t.toState.value,n,r,t.fromState.options,t.toState.options,e,o)},t.prototype.destroyInnerAnimations=function(t){var e=this,n=this.driver.query(t,".ng-trigger",!0);n.forEach(function(t){return e.destroyActiveAnimationsForElement(t)}),0!=this.playersByQueriedElement.size&&(n=this.driver.query(t,".ng-animating",!0)).forEach(function(t){return e.finishActiveQueriedAnimationOnElement(t)})},t.prototype.destroyActiveAnimationsForElement=function(t){var e=this.playersByElement.get(t);e&&e.forEach(function(t){t.queued?t.markedForDestroy=!0:t.destroy()})},t.prototype.finishActiveQueriedAnimationOnElement=function(t){var e=this.playersByQueriedElement.get(t);e&&e.forEach(function(t){return t.finish()})},t.prototype.whenRenderingDone=function(){var t=this;return new Promise(function(e){if(t.players.length)return sc(t.players).onDone(function(){return e()});e()})},t.prototype.processLeaveNode=function(t){var e=this,n=t[Ol];if(n&&n.setForRemoval){if(t[Ol]=Il,n.namespaceId){this.destroyInnerAnimations(t);var r=this._fetchNamespace(n.namespaceId);r&&r.clearElementCache(t)}this._onRemovalComplete(t,n.setForRemoval)}this.driver.matchesElement(t,".ng-animate-disabled")&&this.markElementAsDisabled(t,!1),this.driver.query(t,".ng-animate-disabled",!0).forEach(function(t){e.markElementAsDisabled(t,!1)})},t.prototype.flush=function(t){var e=this;void 0===t&&(t=-1);var n=[];if(this.newHostElements.size&&(this.newHostElements.forEach(function(t,n){return e._balanceNamespaceList(t,n)}),this.newHostElements.clear()),this.totalAnimations&&this.collectedEnterElements.length)for(var r=0;r<this.collectedEnterElements.length;r++)Hl(this.collectedEnterElements[r],"ng-star-inserted");if(this._namespaceList.length&&(this.totalQueuedPlayers||this.collectedLeaveElements.length)){var o=[];try{n=this._flushAnimations(o,t)}finally{for(r=0;r<o.length;r++)o[r]()}}else for(r=0;r<this.collectedLeaveElements.length;r++)this.processLeaveNode(this.collectedLeaveElements[r]);if(this.totalQueuedPlayers=0,this.collectedEnterElements.length=0,this.collectedLeaveElements.length=0,this._flushFns.forEach(function(t){return t()}),this._flushFns=[],this._whenQuietFns.length){var i=this._whenQuietFns;this._whenQuietFns=[],n.length?sc(n).onDone(function(){i.forEach(function(t){return t()})}):i.forEach(function(t){return t()})}},t.prototype.reportError=function(t){throw new Error("Unable to process animations due to the following failed trigger transitions\n "+t.join("\n"))},t.prototype._flushAnimations=function(t,e){var n=this,r=new al,o=[],a=new Map,s=[],u=new Map,c=new Map,l=new Map,f=new Set;this.disabledNodes.forEach(function(t){f.add(t);for(var e=n.driver.query(t,".ng-animate-queued",!0),r=0;r<e.length;r++)f.add(e[r])});var h=this.bodyNode,d=Array.from(this.statesByElement.keys()),y=Bl(d,this.collectedEnterElements),v=new Map,g=0;y.forEach(function(t,e){var n="ng-enter"+g++;v.set(e,n),t.forEach(function(t){return Hl(t,n)})});for(var m=[],b=new Set,_=new Set,w=0;w<this.collectedLeaveElements.length;w++)(L=(F=this.collectedLeaveElements[w])[Ol])&&L.setForRemoval&&(m.push(F),b.add(F),L.hasAnimation?this.driver.query(F,".ng-star-inserted",!0).forEach(function(t){return b.add(t)}):_.add(F));var E=new Map,S=Bl(d,Array.from(b));S.forEach(function(t,e){var n="ng-leave"+g++;E.set(e,n),t.forEach(function(t){return Hl(t,n)})}),t.push(function(){y.forEach(function(t,e){var n=v.get(e);t.forEach(function(t){return zl(t,n)})}),S.forEach(function(t,e){var n=E.get(e);t.forEach(function(t){return zl(t,n)})}),m.forEach(function(t){n.processLeaveNode(t)})});for(var x=[],T=[],k=this._namespaceList.length-1;k>=0;k--)this._namespaceList[k].drainQueuedTransitions(e).forEach(function(t){var e=t.player,i=t.element;if(x.push(e),n.collectedEnterElements.length){var a=i[Ol];if(a&&a.setForMove)return void e.destroy()}var f=!h||!n.driver.containsElement(h,i),p=E.get(i),d=v.get(i),y=n._buildInstruction(t,r,d,p,f);if(y.errors&&y.errors.length)T.push(y);else{if(f)return e.onStart(function(){return jc(i,y.fromStyles)}),e.onDestroy(function(){return Mc(i,y.toStyles)}),void o.push(e);if(t.isFallbackTransition)return e.onStart(function(){return jc(i,y.fromStyles)}),e.onDestroy(function(){return Mc(i,y.toStyles)}),void o.push(e);y.timelines.forEach(function(t){return t.stretchStartingKeyframe=!0}),r.append(i,y.timelines),s.push({instruction:y,player:e,element:i}),y.queriedElements.forEach(function(t){return pc(u,t,[]).push(e)}),y.preStyleProps.forEach(function(t,e){var n=Object.keys(t);if(n.length){var r=c.get(e);r||c.set(e,r=new Set),n.forEach(function(t){return r.add(t)})}}),y.postStyleProps.forEach(function(t,e){var n=Object.keys(t),r=l.get(e);r||l.set(e,r=new Set),n.forEach(function(t){return r.add(t)})})}});if(T.length){var A=[];T.forEach(function(t){A.push("@"+t.triggerName+" has failed due to:\n"),t.errors.forEach(function(t){return A.push("- "+t+"\n")})}),x.forEach(function(t){return t.destroy()}),this.reportError(A)}var I=new Map,C=new Map;s.forEach(function(t){var e=t.element;r.has(e)&&(C.set(e,e),n._beforeAnimationBuild(t.player.namespaceId,t.instruction,I))}),o.forEach(function(t){var e=t.element;n._getPreviousPlayers(e,!1,t.namespaceId,t.triggerName,null).forEach(function(t){pc(I,e,[]).push(t),t.destroy()})});var O=m.filter(function(t){return Wl(t,c,l)}),P=new Map;Ll(P,this.driver,_,l,$u).forEach(function(t){Wl(t,c,l)&&O.push(t)});var R=new Map;y.forEach(function(t,e){Ll(R,n.driver,new Set(t),c,ic)}),O.forEach(function(t){var e=P.get(t),n=R.get(t);P.set(t,i({},e,n))});var D=[],N=[],M={};s.forEach(function(t){var e=t.element,i=t.player,s=t.instruction;if(r.has(e)){if(f.has(e))return i.onDestroy(function(){return Mc(e,s.toStyles)}),i.disabled=!0,i.overrideTotalTime(s.totalTime),void o.push(i);var u=M;if(C.size>1){for(var c=e,l=[];c=c.parentNode;){var p=C.get(c);if(p){u=p;break}l.push(c)}l.forEach(function(t){return C.set(t,u)})}var h=n._buildAnimation(i.namespaceId,s,I,a,R,P);if(i.setRealPlayer(h),u===M)D.push(i);else{var d=n.playersByElement.get(u);d&&d.length&&(i.parentPlayer=sc(d)),o.push(i)}}else jc(e,s.fromStyles),i.onDestroy(function(){return Mc(e,s.toStyles)}),N.push(i),f.has(e)&&o.push(i)}),N.forEach(function(t){var e=a.get(t.element);if(e&&e.length){var n=sc(e);t.setRealPlayer(n)}}),o.forEach(function(t){t.parentPlayer?t.syncPlayerEvents(t.parentPlayer):t.destroy()});for(var j=0;j<m.length;j++){var F,L=(F=m[j])[Ol];if(zl(F,"ng-leave"),!L||!L.hasAnimation){var B=[];if(u.size){var V=u.get(F);V&&V.length&&B.push.apply(B,p(V));for(var H=this.driver.query(F,".ng-animating",!0),z=0;z<H.length;z++){var U=u.get(H[z]);U&&U.length&&B.push.apply(B,p(U))}}var W=B.filter(function(t){return!t.destroyed});W.length?Ul(this,F,W):this.processLeaveNode(F)}}return m.length=0,D.forEach(function(t){n.players.push(t),t.onDone(function(){t.destroy();var e=n.players.indexOf(t);n.players.splice(e,1)}),t.play()}),D},t.prototype.elementContainsData=function(t,e){var n=!1,r=e[Ol];return r&&r.setForRemoval&&(n=!0),this.playersByElement.has(e)&&(n=!0),this.playersByQueriedElement.has(e)&&(n=!0),this.statesByElement.has(e)&&(n=!0),this._fetchNamespace(t).elementContainsData(e)||n},t.prototype.afterFlush=function(t){this._flushFns.push(t)},t.prototype.afterFlushAnimationsDone=function(t){this._whenQuietFns.push(t)},t.prototype._getPreviousPlayers=function(t,e,n,r,o){var i=[];if(e){var a=this.playersByQueriedElement.get(t);a&&(i=a)}else{var s=this.playersByElement.get(t);if(s){var u=!o||"void"==o;s.forEach(function(t){t.queued||(u||t.triggerName==r)&&i.push(t)})}}return(n||r)&&(i=i.filter(function(t){return!(n&&n!=t.namespaceId||r&&r!=t.triggerName)})),i},t.prototype._beforeAnimationBuild=function(t,e,n){var r,o,i=e.element,a=e.isRemovalTransition?void 0:t,s=e.isRemovalTransition?void 0:e.triggerName,u=function(t){var r=t.element,o=r!==i,u=pc(n,r,[]);c._getPreviousPlayers(r,o,a,s,e.toState).forEach(function(t){var e=t.getRealPlayer();e.beforeDestroy&&e.beforeDestroy(),t.destroy(),u.push(t)})},c=this;try{for(var f=l(e.timelines),p=f.next();!p.done;p=f.next())u(p.value)}catch(h){r={error:h}}finally{try{p&&!p.done&&(o=f.return)&&o.call(f)}finally{if(r)throw r.error}}jc(i,e.fromStyles)},t.prototype._buildAnimation=function(t,e,n,r,o,i){var a=this,s=e.triggerName,u=e.element,c=[],l=new Set,f=new Set,p=e.timelines.map(function(e){var p=e.element;l.add(p);var h=p[Ol];if(h&&h.removedBeforeQueried)return new rc(e.duration,e.delay);var d,y,v=p!==u,g=(d=(n.get(p)||Al).map(function(t){return t.getRealPlayer()}),y=[],function t(e,n){for(var r=0;r<e.length;r++){var o=e[r];o instanceof oc?t(o.players,n):n.push(o)}}(d,y),y).filter(function(t){return!!t.element&&t.element===p}),m=o.get(p),b=i.get(p),_=uc(0,a._normalizer,0,e.keyframes,m,b),w=a._buildPlayer(e,_,g);if(e.subTimeline&&r&&f.add(p),v){var E=new Ml(t,s,p);E.setRealPlayer(w),c.push(E)}return w});c.forEach(function(t){pc(a.playersByQueriedElement,t.element,[]).push(t),t.onDone(function(){return function(t,e,n){var r;if(t instanceof Map){if(r=t.get(e)){if(r.length){var o=r.indexOf(n);r.splice(o,1)}0==r.length&&t.delete(e)}}else(r=t[e])&&(r.length&&(o=r.indexOf(n),r.splice(o,1)),0==r.length&&delete t[e]);return r}(a.playersByQueriedElement,t.element,t)})}),l.forEach(function(t){return Hl(t,"ng-animating")});var h=sc(p);return h.onDestroy(function(){l.forEach(function(t){return zl(t,"ng-animating")}),Mc(u,e.toStyles)}),f.forEach(function(t){pc(r,t,[]).push(h)}),h},t.prototype._buildPlayer=function(t,e,n){return e.length>0?this.driver.animate(t.element,e,t.duration,t.delay,t.easing,n):new rc(t.duration,t.delay)},t}(),Ml=function(){function t(t,e,n){this.namespaceId=t,this.triggerName=e,this.element=n,this._player=new rc,this._containsRealPlayer=!1,this._queuedCallbacks={},this.destroyed=!1,this.markedForDestroy=!1,this.disabled=!1,this.queued=!0,this.totalTime=0}return t.prototype.setRealPlayer=function(t){var e=this;this._containsRealPlayer||(this._player=t,Object.keys(this._queuedCallbacks).forEach(function(n){e._queuedCallbacks[n].forEach(function(e){return cc(t,n,void 0,e)})}),this._queuedCallbacks={},this._containsRealPlayer=!0,this.overrideTotalTime(t.totalTime),this.queued=!1)},t.prototype.getRealPlayer=function(){return this._player},t.prototype.overrideTotalTime=function(t){this.totalTime=t},t.prototype.syncPlayerEvents=function(t){var e=this,n=this._player;n.triggerCallback&&t.onStart(function(){return n.triggerCallback("start")}),t.onDone(function(){return e.finish()}),t.onDestroy(function(){return e.destroy()})},t.prototype._queueEvent=function(t,e){pc(this._queuedCallbacks,t,[]).push(e)},t.prototype.onDone=function(t){this.queued&&this._queueEvent("done",t),this._player.onDone(t)},t.prototype.onStart=function(t){this.queued&&this._queueEvent("start",t),this._player.onStart(t)},t.prototype.onDestroy=function(t){this.queued&&this._queueEvent("destroy",t),this._player.onDestroy(t)},t.prototype.init=function(){this._player.init()},t.prototype.hasStarted=function(){return!this.queued&&this._player.hasStarted()},t.prototype.play=function(){!this.queued&&this._player.play()},t.prototype.pause=function(){!this.queued&&this._player.pause()},t.prototype.restart=function(){!this.queued&&this._player.restart()},t.prototype.finish=function(){this._player.finish()},t.prototype.destroy=function(){this.destroyed=!0,this._player.destroy()},t.prototype.reset=function(){!this.queued&&this._player.reset()},t.prototype.setPosition=function(t){this.queued||this._player.setPosition(t)},t.prototype.getPosition=function(){return this.queued?0:this._player.getPosition()},t.prototype.triggerCallback=function(t){var e=this._player;e.triggerCallback&&e.triggerCallback(t)},t}();function jl(t){return t&&1===t.nodeType}function Fl(t,e){var n=t.style.display;return t.style.display=null!=e?e:"none",n}function Ll(t,e,n,r,o){var i=[];n.forEach(function(t){return i.push(Fl(t))});var a=[];r.forEach(function(n,r){var i={};n.forEach(function(t){var n=i[t]=e.computeStyle(r,t,o);n&&0!=n.length||(r[Ol]=Cl,a.push(r))}),t.set(r,i)});var s=0;return n.forEach(function(t){return Fl(t,i[s++])}),a}function Bl(t,e){var n=new Map;if(t.forEach(function(t){return n.set(t,[])}),0==e.length)return n;var r=new Set(e),o=new Map;return e.forEach(function(t){var e=function t(e){if(!e)return 1;var i=o.get(e);if(i)return i;var a=e.parentNode;return i=n.has(a)?a:r.has(a)?1:t(a),o.set(e,i),i}(t);1!==e&&n.get(e).push(t)}),n}var Vl="$$classes";function Hl(t,e){if(t.classList)t.classList.add(e);else{var n=t[Vl];n||(n=t[Vl]={}),n[e]=!0}}function zl(t,e){if(t.classList)t.classList.remove(e);else{var n=t[Vl];n&&delete n[e]}}function Ul(t,e,n){sc(n).onDone(function(){return t.processLeaveNode(e)})}function Wl(t,e,n){var r=n.get(t);if(!r)return!1;var o=e.get(t);return o?r.forEach(function(t){return o.add(t)}):e.set(t,r),n.delete(t),!0}var Zl=function(){function t(t,e,n){var r=this;this.bodyNode=t,this._driver=e,this._triggerCache={},this.onRemovalComplete=function(t,e){},this._transitionEngine=new Nl(t,e,n),this._timelineEngine=new kl(t,e,n),this._transitionEngine.onRemovalComplete=function(t,e){return r.onRemovalComplete(t,e)}}return t.prototype.registerTrigger=function(t,e,n,r,o){var i=t+"-"+r,a=this._triggerCache[i];if(!a){var s=[],u=$c(this._driver,o,s);if(s.length)throw new Error('The animation trigger "'+r+'" has failed to build due to the following errors:\n - '+s.join("\n - "));a=function(t,e){return new Sl(t,e)}(r,u),this._triggerCache[i]=a}this._transitionEngine.registerTrigger(e,r,a)},t.prototype.register=function(t,e){this._transitionEngine.register(t,e)},t.prototype.destroy=function(t,e){this._transitionEngine.destroy(t,e)},t.prototype.onInsert=function(t,e,n,r){this._transitionEngine.insertNode(t,e,n,r)},t.prototype.onRemove=function(t,e,n,r){this._transitionEngine.removeNode(t,e,r||!1,n)},t.prototype.disableAnimations=function(t,e){this._transitionEngine.markElementAsDisabled(t,e)},t.prototype.process=function(t,e,n,r){if("@"==n.charAt(0)){var o=f(hc(n),2);this._timelineEngine.command(o[0],e,o[1],r)}else this._transitionEngine.trigger(t,e,n,r)},t.prototype.listen=function(t,e,n,r,o){if("@"==n.charAt(0)){var i=f(hc(n),2);return this._timelineEngine.listen(i[0],e,i[1],o)}return this._transitionEngine.listen(t,e,n,r,o)},t.prototype.flush=function(t){void 0===t&&(t=-1),this._transitionEngine.flush(t)},Object.defineProperty(t.prototype,"players",{get:function(){return this._transitionEngine.players.concat(this._timelineEngine.players)},enumerable:!0,configurable:!0}),t.prototype.whenRenderingDone=function(){return this._transitionEngine.whenRenderingDone()},t}();function ql(t,e){var n=null,r=null;return Array.isArray(e)&&e.length?(n=Kl(e[0]),e.length>1&&(r=Kl(e[e.length-1]))):e&&(n=Kl(e)),n||r?new Gl(t,n,r):null}var Gl=function(){function t(e,n,r){this._element=e,this._startStyles=n,this._endStyles=r,this._state=0;var o=t.initialStylesByElement.get(e);o||t.initialStylesByElement.set(e,o={}),this._initialStyles=o}return t.prototype.start=function(){this._state<1&&(this._startStyles&&Mc(this._element,this._startStyles,this._initialStyles),this._state=1)},t.prototype.finish=function(){this.start(),this._state<2&&(Mc(this._element,this._initialStyles),this._endStyles&&(Mc(this._element,this._endStyles),this._endStyles=null),this._state=1)},t.prototype.destroy=function(){this.finish(),this._state<3&&(t.initialStylesByElement.delete(this._element),this._startStyles&&(jc(this._element,this._startStyles),this._endStyles=null),this._endStyles&&(jc(this._element,this._endStyles),this._endStyles=null),Mc(this._element,this._initialStyles),this._state=3)},t.initialStylesByElement=new WeakMap,t}();function Kl(t){for(var e=null,n=Object.keys(t),r=0;r<n.length;r++){var o=n[r];Ql(o)&&((e=e||{})[o]=t[o])}return e}function Ql(t){return"display"===t||"position"===t}var Yl="animation",Xl="animationend",Jl=function(){function t(t,e,n,r,o,i,a){var s=this;this._element=t,this._name=e,this._duration=n,this._delay=r,this._easing=o,this._fillMode=i,this._onDoneFn=a,this._finished=!1,this._destroyed=!1,this._startTime=0,this._position=0,this._eventFn=function(t){return s._handleCallback(t)}}return t.prototype.apply=function(){var t,e,n;e=this._duration+"ms "+this._easing+" "+this._delay+"ms 1 normal "+this._fillMode+" "+this._name,(n=of(t=this._element,"").trim()).length&&(function(t,e){for(var n=0;n<t.length;n++)","===t.charAt(n)&&0}(n),e=n+", "+e),rf(t,"",e),nf(this._element,this._eventFn,!1),this._startTime=Date.now()},t.prototype.pause=function(){$l(this._element,this._name,"paused")},t.prototype.resume=function(){$l(this._element,this._name,"running")},t.prototype.setPosition=function(t){var e=tf(this._element,this._name);this._position=t*this._duration,rf(this._element,"Delay","-"+this._position+"ms",e)},t.prototype.getPosition=function(){return this._position},t.prototype._handleCallback=function(t){var e=t._ngTestManualTimestamp||Date.now(),n=1e3*parseFloat(t.elapsedTime.toFixed(3));t.animationName==this._name&&Math.max(e-this._startTime,0)>=this._delay&&n>=this._duration&&this.finish()},t.prototype.finish=function(){this._finished||(this._finished=!0,this._onDoneFn(),nf(this._element,this._eventFn,!0))},t.prototype.destroy=function(){var t,e,n,r;this._destroyed||(this._destroyed=!0,this.finish(),e=this._name,(r=ef(n=of(t=this._element,"").split(","),e))>=0&&(n.splice(r,1),rf(t,"",n.join(","))))},t}();function $l(t,e,n){rf(t,"PlayState",n,tf(t,e))}function tf(t,e){var n=of(t,"");return n.indexOf(",")>0?ef(n.split(","),e):ef([n],e)}function ef(t,e){for(var n=0;n<t.length;n++)if(t[n].indexOf(e)>=0)return n;return-1}function nf(t,e,n){n?t.removeEventListener(Xl,e):t.addEventListener(Xl,e)}function rf(t,e,n,r){var o=Yl+e;if(null!=r){var i=t.style[o];if(i.length){var a=i.split(",");a[r]=n,n=a.join(",")}}t.style[o]=n}function of(t,e){return t.style[Yl+e]}var af="linear",sf=function(){function t(t,e,n,r,o,i,a,s){this.element=t,this.keyframes=e,this.animationName=n,this._duration=r,this._delay=o,this._finalStyles=a,this._specialStyles=s,this._onDoneFns=[],this._onStartFns=[],this._onDestroyFns=[],this._started=!1,this.currentSnapshot={},this._state=0,this.easing=i||af,this.totalTime=r+o,this._buildStyler()}return t.prototype.onStart=function(t){this._onStartFns.push(t)},t.prototype.onDone=function(t){this._onDoneFns.push(t)},t.prototype.onDestroy=function(t){this._onDestroyFns.push(t)},t.prototype.destroy=function(){this.init(),this._state>=4||(this._state=4,this._styler.destroy(),this._flushStartFns(),this._flushDoneFns(),this._specialStyles&&this._specialStyles.destroy(),this._onDestroyFns.forEach(function(t){return t()}),this._onDestroyFns=[])},t.prototype._flushDoneFns=function(){this._onDoneFns.forEach(function(t){return t()}),this._onDoneFns=[]},t.prototype._flushStartFns=function(){this._onStartFns.forEach(function(t){return t()}),this._onStartFns=[]},t.prototype.finish=function(){this.init(),this._state>=3||(this._state=3,this._styler.finish(),this._flushStartFns(),this._specialStyles&&this._specialStyles.finish(),this._flushDoneFns())},t.prototype.setPosition=function(t){this._styler.setPosition(t)},t.prototype.getPosition=function(){return this._styler.getPosition()},t.prototype.hasStarted=function(){return this._state>=2},t.prototype.init=function(){this._state>=1||(this._state=1,this._styler.apply(),this._delay&&this._styler.pause())},t.prototype.play=function(){this.init(),this.hasStarted()||(this._flushStartFns(),this._state=2,this._specialStyles&&this._specialStyles.start()),this._styler.resume()},t.prototype.pause=function(){this.init(),this._styler.pause()},t.prototype.restart=function(){this.reset(),this.play()},t.prototype.reset=function(){this._styler.destroy(),this._buildStyler(),this._styler.apply()},t.prototype._buildStyler=function(){var t=this;this._styler=new Jl(this.element,this.animationName,this._duration,this._delay,this.easing,"forwards",function(){return t.finish()})},t.prototype.triggerCallback=function(t){var e="start"==t?this._onStartFns:this._onDoneFns;e.forEach(function(t){return t()}),e.length=0},t.prototype.beforeDestroy=function(){var t=this;this.init();var e={};if(this.hasStarted()){var n=this._state>=3;Object.keys(this._finalStyles).forEach(function(r){"offset"!=r&&(e[r]=n?t._finalStyles[r]:Gc(t.element,r))})}this.currentSnapshot=e},t}(),uf=function(t){function e(e,n){var r=t.call(this)||this;return r.element=e,r._startingStyles={},r.__initialized=!1,r._styles=xc(n),r}return o(e,t),e.prototype.init=function(){var e=this;!this.__initialized&&this._startingStyles&&(this.__initialized=!0,Object.keys(this._styles).forEach(function(t){e._startingStyles[t]=e.element.style[t]}),t.prototype.init.call(this))},e.prototype.play=function(){var e=this;this._startingStyles&&(this.init(),Object.keys(this._styles).forEach(function(t){return e.element.style.setProperty(t,e._styles[t])}),t.prototype.play.call(this))},e.prototype.destroy=function(){var e=this;this._startingStyles&&(Object.keys(this._startingStyles).forEach(function(t){var n=e._startingStyles[t];n?e.element.style.setProperty(t,n):e.element.style.removeProperty(t)}),this._startingStyles=null,t.prototype.destroy.call(this))},e}(rc),cf=function(){function t(){this._count=0,this._head=document.querySelector("head"),this._warningIssued=!1}return t.prototype.validateStyleProperty=function(t){return _c(t)},t.prototype.matchesElement=function(t,e){return wc(t,e)},t.prototype.containsElement=function(t,e){return Ec(t,e)},t.prototype.query=function(t,e,n){return Sc(t,e,n)},t.prototype.computeStyle=function(t,e,n){return window.getComputedStyle(t)[e]},t.prototype.buildKeyframeElement=function(t,e,n){n=n.map(function(t){return xc(t)});var r="@keyframes "+e+" {\n",o="";n.forEach(function(t){o=" ";var e=parseFloat(t.offset);r+=""+o+100*e+"% {\n",o+=" ",Object.keys(t).forEach(function(e){var n=t[e];switch(e){case"offset":return;case"easing":return void(n&&(r+=o+"animation-timing-function: "+n+";\n"));default:return void(r+=""+o+e+": "+n+";\n")}}),r+=o+"}\n"}),r+="}\n";var i=document.createElement("style");return i.innerHTML=r,i},t.prototype.animate=function(t,e,n,r,o,i,a){void 0===i&&(i=[]),a&&this._notifyFaultyScrubber();var s=i.filter(function(t){return t instanceof sf}),u={};Wc(n,r)&&s.forEach(function(t){var e=t.currentSnapshot;Object.keys(e).forEach(function(t){return u[t]=e[t]})});var c=function(t){var e={};return t&&(Array.isArray(t)?t:[t]).forEach(function(t){Object.keys(t).forEach(function(n){"offset"!=n&&"easing"!=n&&(e[n]=t[n])})}),e}(e=Zc(t,e,u));if(0==n)return new uf(t,c);var l="gen_css_kf_"+this._count++,f=this.buildKeyframeElement(t,l,e);document.querySelector("head").appendChild(f);var p=ql(t,e),h=new sf(t,e,l,n,r,o,c,p);return h.onDestroy(function(){var t;(t=f).parentNode.removeChild(t)}),h},t.prototype._notifyFaultyScrubber=function(){this._warningIssued||(console.warn("@angular/animations: please load the web-animations.js polyfill to allow programmatic access...\n","  visit http://bit.ly/IWukam to learn more about using the web-animation-js polyfill."),this._warningIssued=!0)},t}(),lf=function(){function t(t,e,n,r){this.element=t,this.keyframes=e,this.options=n,this._specialStyles=r,this._onDoneFns=[],this._onStartFns=[],this._onDestroyFns=[],this._initialized=!1,this._finished=!1,this._started=!1,this._destroyed=!1,this.time=0,this.parentPlayer=null,this.currentSnapshot={},this._duration=n.duration,this._delay=n.delay||0,this.time=this._duration+this._delay}return t.prototype._onFinish=function(){this._finished||(this._finished=!0,this._onDoneFns.forEach(function(t){return t()}),this._onDoneFns=[])},t.prototype.init=function(){this._buildPlayer(),this._preparePlayerBeforeStart()},t.prototype._buildPlayer=function(){var t=this;if(!this._initialized){this._initialized=!0;var e=this.keyframes;this.domPlayer=this._triggerWebAnimation(this.element,e,this.options),this._finalKeyframe=e.length?e[e.length-1]:{},this.domPlayer.addEventListener("finish",function(){return t._onFinish()})}},t.prototype._preparePlayerBeforeStart=function(){this._delay?this._resetDomPlayerState():this.domPlayer.pause()},t.prototype._triggerWebAnimation=function(t,e,n){return t.animate(e,n)},t.prototype.onStart=function(t){this._onStartFns.push(t)},t.prototype.onDone=function(t){this._onDoneFns.push(t)},t.prototype.onDestroy=function(t){this._onDestroyFns.push(t)},t.prototype.play=function(){this._buildPlayer(),this.hasStarted()||(this._onStartFns.forEach(function(t){return t()}),this._onStartFns=[],this._started=!0,this._specialStyles&&this._specialStyles.start()),this.domPlayer.play()},t.prototype.pause=function(){this.init(),this.domPlayer.pause()},t.prototype.finish=function(){this.init(),this._specialStyles&&this._specialStyles.finish(),this._onFinish(),this.domPlayer.finish()},t.prototype.reset=function(){this._resetDomPlayerState(),this._destroyed=!1,this._finished=!1,this._started=!1},t.prototype._resetDomPlayerState=function(){this.domPlayer&&this.domPlayer.cancel()},t.prototype.restart=function(){this.reset(),this.play()},t.prototype.hasStarted=function(){return this._started},t.prototype.destroy=function(){this._destroyed||(this._destroyed=!0,this._resetDomPlayerState(),this._onFinish(),this._specialStyles&&this._specialStyles.destroy(),this._onDestroyFns.forEach(function(t){return t()}),this._onDestroyFns=[])},t.prototype.setPosition=function(t){this.domPlayer.currentTime=t*this.time},t.prototype.getPosition=function(){return this.domPlayer.currentTime/this.time},Object.defineProperty(t.prototype,"totalTime",{get:function(){return this._delay+this._duration},enumerable:!0,configurable:!0}),t.prototype.beforeDestroy=function(){var t=this,e={};this.hasStarted()&&Object.keys(this._finalKeyframe).forEach(function(n){"offset"!=n&&(e[n]=t._finished?t._finalKeyframe[n]:Gc(t.element,n))}),this.currentSnapshot=e},t.prototype.triggerCallback=function(t){var e="start"==t?this._onStartFns:this._onDoneFns;e.forEach(function(t){return t()}),e.length=0},t}(),ff=function(){function t(){this._isNativeImpl=/\{\s*\[native\s+code\]\s*\}/.test(pf().toString()),this._cssKeyframesDriver=new cf}return t.prototype.validateStyleProperty=function(t){return _c(t)},t.prototype.matchesElement=function(t,e){return wc(t,e)},t.prototype.containsElement=function(t,e){return Ec(t,e)},t.prototype.query=function(t,e,n){return Sc(t,e,n)},t.prototype.computeStyle=function(t,e,n){return window.getComputedStyle(t)[e]},t.prototype.overrideWebAnimationsSupport=function(t){this._isNativeImpl=t},t.prototype.animate=function(t,e,n,r,o,i,a){if(void 0===i&&(i=[]),!a&&!this._isNativeImpl)return this._cssKeyframesDriver.animate(t,e,n,r,o,i);var s={duration:n,delay:r,fill:0==r?"both":"forwards"};o&&(s.easing=o);var u={},c=i.filter(function(t){return t instanceof lf});Wc(n,r)&&c.forEach(function(t){var e=t.currentSnapshot;Object.keys(e).forEach(function(t){return u[t]=e[t]})});var l=ql(t,e=Zc(t,e=e.map(function(t){return Rc(t,!1)}),u));return new lf(t,e,s,l)},t}();function pf(){return"undefined"!=typeof window&&void 0!==window.document&&Element.prototype.animate||{}}var hf=function(t){function e(e,n){var r=t.call(this)||this;return r._nextAnimationId=0,r._renderer=e.createRenderer(n.body,{id:"0",encapsulation:qt.None,styles:[],data:{animation:[]}}),r}return o(e,t),e.prototype.build=function(t){var e=this._nextAnimationId.toString();this._nextAnimationId++;var n=Array.isArray(t)?tc(t):t;return vf(this._renderer,null,e,"register",[n]),new df(e,this._renderer)},e}(Xu),df=function(t){function e(e,n){var r=t.call(this)||this;return r._id=e,r._renderer=n,r}return o(e,t),e.prototype.create=function(t,e){return new yf(this._id,t,e||{},this._renderer)},e}(Ju),yf=function(){function t(t,e,n,r){this.id=t,this.element=e,this._renderer=r,this.parentPlayer=null,this._started=!1,this.totalTime=0,this._command("create",n)}return t.prototype._listen=function(t,e){return this._renderer.listen(this.element,"@@"+this.id+":"+t,e)},t.prototype._command=function(t){for(var e=[],n=1;n<arguments.length;n++)e[n-1]=arguments[n];return vf(this._renderer,this.element,this.id,t,e)},t.prototype.onDone=function(t){this._listen("done",t)},t.prototype.onStart=function(t){this._listen("start",t)},t.prototype.onDestroy=function(t){this._listen("destroy",t)},t.prototype.init=function(){this._command("init")},t.prototype.hasStarted=function(){return this._started},t.prototype.play=function(){this._command("play"),this._started=!0},t.prototype.pause=function(){this._command("pause")},t.prototype.restart=function(){this._command("restart")},t.prototype.finish=function(){this._command("finish")},t.prototype.destroy=function(){this._command("destroy")},t.prototype.reset=function(){this._command("reset")},t.prototype.setPosition=function(t){this._command("setPosition",t)},t.prototype.getPosition=function(){return 0},t}();function vf(t,e,n,r,o){return t.setProperty(e,"@@"+n+":"+r,o)}var gf=function(){function t(t,e,n){this.delegate=t,this.engine=e,this._zone=n,this._currentId=0,this._microtaskId=1,this._animationCallbacksBuffer=[],this._rendererCache=new Map,this._cdRecurDepth=0,this.promise=Promise.resolve(0),e.onRemovalComplete=function(t,e){e&&e.parentNode(t)&&e.removeChild(t.parentNode,t)}}return t.prototype.createRenderer=function(t,e){var n=this,r=this.delegate.createRenderer(t,e);if(!(t&&e&&e.data&&e.data.animation)){var o=this._rendererCache.get(r);return o||(o=new mf("",r,this.engine),this._rendererCache.set(r,o)),o}var i=e.id,a=e.id+"-"+this._currentId;return this._currentId++,this.engine.register(a,t),e.data.animation.forEach(function(e){return n.engine.registerTrigger(i,a,t,e.name,e)}),new bf(this,a,r,this.engine)},t.prototype.begin=function(){this._cdRecurDepth++,this.delegate.begin&&this.delegate.begin()},t.prototype._scheduleCountTask=function(){var t=this;this.promise.then(function(){t._microtaskId++})},t.prototype.scheduleListenerCallback=function(t,e,n){var r=this;t>=0&&t<this._microtaskId?this._zone.run(function(){return e(n)}):(0==this._animationCallbacksBuffer.length&&Promise.resolve(null).then(function(){r._zone.run(function(){r._animationCallbacksBuffer.forEach(function(t){var e=f(t,2);(0,e[0])(e[1])}),r._animationCallbacksBuffer=[]})}),this._animationCallbacksBuffer.push([e,n]))},t.prototype.end=function(){var t=this;this._cdRecurDepth--,0==this._cdRecurDepth&&this._zone.runOutsideAngular(function(){t._scheduleCountTask(),t.engine.flush(t._microtaskId)}),this.delegate.end&&this.delegate.end()},t.prototype.whenRenderingDone=function(){return this.engine.whenRenderingDone()},t}(),mf=function(){function t(t,e,n){this.namespaceId=t,this.delegate=e,this.engine=n,this.destroyNode=this.delegate.destroyNode?function(t){return e.destroyNode(t)}:null}return Object.defineProperty(t.prototype,"data",{get:function(){return this.delegate.data},enumerable:!0,configurable:!0}),t.prototype.destroy=function(){this.engine.destroy(this.namespaceId,this.delegate),this.delegate.destroy()},t.prototype.createElement=function(t,e){return this.delegate.createElement(t,e)},t.prototype.createComment=function(t){return this.delegate.createComment(t)},t.prototype.createText=function(t){return this.delegate.createText(t)},t.prototype.appendChild=function(t,e){this.delegate.appendChild(t,e),this.engine.onInsert(this.namespaceId,e,t,!1)},t.prototype.insertBefore=function(t,e,n){this.delegate.insertBefore(t,e,n),this.engine.onInsert(this.namespaceId,e,t,!0)},t.prototype.removeChild=function(t,e,n){this.engine.onRemove(this.namespaceId,e,this.delegate,n)},t.prototype.selectRootElement=function(t,e){return this.delegate.selectRootElement(t,e)},t.prototype.parentNode=function(t){return this.delegate.parentNode(t)},t.prototype.nextSibling=function(t){return this.delegate.nextSibling(t)},t.prototype.setAttribute=function(t,e,n,r){this.delegate.setAttribute(t,e,n,r)},t.prototype.removeAttribute=function(t,e,n){this.delegate.removeAttribute(t,e,n)},t.prototype.addClass=function(t,e){this.delegate.addClass(t,e)},t.prototype.removeClass=function(t,e){this.delegate.removeClass(t,e)},t.prototype.setStyle=function(t,e,n,r){this.delegate.setStyle(t,e,n,r)},t.prototype.removeStyle=function(t,e,n){this.delegate.removeStyle(t,e,n)},t.prototype.setProperty=function(t,e,n){"@"==e.charAt(0)&&"@.disabled"==e?this.disableAnimations(t,!!n):this.delegate.setProperty(t,e,n)},t.prototype.setValue=function(t,e){this.delegate.setValue(t,e)},t.prototype.listen=function(t,e,n){return this.delegate.listen(t,e,n)},t.prototype.disableAnimations=function(t,e){this.engine.disableAnimations(t,e)},t}(),bf=function(t){function e(e,n,r,o){var i=t.call(this,n,r,o)||this;return i.factory=e,i.namespaceId=n,i}return o(e,t),e.prototype.setProperty=function(t,e,n){"@"==e.charAt(0)?"."==e.charAt(1)&&"@.disabled"==e?this.disableAnimations(t,n=void 0===n||!!n):this.engine.process(this.namespaceId,t,e.substr(1),n):this.delegate.setProperty(t,e,n)},e.prototype.listen=function(t,e,n){var r,o,i,a=this;if("@"==e.charAt(0)){var s=function(t){switch(t){case"body":return document.body;case"document":return document;case"window":return window;default:return t}}(t),u=e.substr(1),c="";return"@"!=u.charAt(0)&&(u=(r=f((o=u,i=o.indexOf("."),[o.substring(0,i),o.substr(i+1)]),2))[0],c=r[1]),this.engine.listen(this.namespaceId,s,u,c,function(t){a.factory.scheduleListenerCallback(t._data||-1,n,t)})}return this.delegate.listen(t,e,n)},e}(mf),_f=function(t){function e(e,n,r){return t.call(this,e.body,n,r)||this}return o(e,t),e}(Zl);function wf(){return"function"==typeof pf()?new ff:new cf}function Ef(){return new gl}function Sf(t,e,n){return new gf(t,e,n)}var xf=new kt("AnimationModuleType"),Tf=function(){return function(){}}(),kf=function(){return function(){}}(),Af=new nn("8.2.3");try{"undefined"!=typeof Intl&&Intl}catch(Df){}Error;var If=new nn("8.2.3"),Cf=new kt("mat-sanity-checks",{providedIn:"root",factory:function(){return!0}}),Of=function(){function t(t,e){this._sanityChecksEnabled=t,this._hammerLoader=e,this._hasDoneGlobalChecks=!1,this._hasCheckedHammer=!1,this._document="object"==typeof document&&document?document:null,this._window="object"==typeof window&&window?window:null,this._areChecksEnabled()&&!this._hasDoneGlobalChecks&&(this._checkDoctypeIsDefined(),this._checkThemeIsPresent(),this._checkCdkVersionMatch(),this._hasDoneGlobalChecks=!0)}return t.prototype._areChecksEnabled=function(){return this._sanityChecksEnabled&&te()&&!this._isTestEnv()},t.prototype._isTestEnv=function(){var t=this._window;return t&&(t.__karma__||t.jasmine)},t.prototype._checkDoctypeIsDefined=function(){this._document&&!this._document.doctype&&console.warn("Current document does not have a doctype. This may cause some Angular Material components not to behave as expected.")},t.prototype._checkThemeIsPresent=function(){if(this._document&&this._document.body&&"function"==typeof getComputedStyle){var t=this._document.createElement("div");t.classList.add("mat-theme-loaded-marker"),this._document.body.appendChild(t);var e=getComputedStyle(t);e&&"none"!==e.display&&console.warn("Could not find Angular Material core theme. Most Material components may not work as expected. For more info refer to the theming guide: https://material.angular.io/guide/theming"),this._document.body.removeChild(t)}},t.prototype._checkCdkVersionMatch=function(){If.full!==Af.full&&console.warn("The Angular Material version ("+If.full+") does not match the Angular CDK version ("+Af.full+").\nPlease ensure the versions of these two packages exactly match.")},t.prototype._checkHammerIsAvailable=function(){!this._hasCheckedHammer&&this._window&&(!this._areChecksEnabled()||this._window.Hammer||this._hammerLoader||console.warn("Could not find HammerJS. Certain Angular Material components may not work correctly."),this._hasCheckedHammer=!0)},t}(),Pf=function(){return function(){}}(),Rf=La(Ha,[Wa],function(t){return function(t){for(var e={},n=[],r=!1,o=0;o<t.length;o++){var i=t[o];i.token===Fe&&!0===i.value&&(r=!0),1073741824&i.flags&&n.push(i.token),i.index=o,e[Bn(i.token)]=i}return{factory:null,providersByKey:e,providers:t,modules:n,isRoot:r}}([br(512,Ue,We,[[8,[Yu]],[3,Ue],qe]),br(5120,pi,yi,[[3,pi]]),br(4608,Ja,$a,[pi,[2,Xa]]),br(5120,fo,vi,[Vo]),br(4608,Po,Po,[]),br(5120,go,mo,[]),br(5120,yn,hi,[]),br(5120,vn,di,[]),br(4608,cu,lu,[ss]),br(6144,Se,null,[cu]),br(4608,nu,ou,[]),br(5120,Cs,function(t,e,n,r,o,i,a,s){return[new tu(t,e,n),new uu(r),new iu(o,i,a,s)]},[ss,Vo,wo,ss,ss,nu,So,[2,ru]]),br(4608,Os,Os,[Cs,Vo]),br(135680,Ds,Ds,[ss]),br(4608,Vs,Vs,[Os,Ds,go]),br(5120,kc,wf,[]),br(5120,vl,Ef,[]),br(4608,Zl,_f,[ss,kc,vl]),br(5120,Je,Sf,[Vs,Zl,Vo]),br(6144,Rs,null,[Ds]),br(4608,Ko,Ko,[Vo]),br(4608,Xu,hf,[Je,ss]),br(1073742336,as,as,[]),br(1024,Xt,mu,[]),br(1024,yo,function(t){return[(e=t,ks("probe",Is),ks("coreTokens",i({},As,(e||[]).reduce(function(t,e){return t[e.name]=e.token,t},{}))),function(){return Is})];var e},[[2,Jo]]),br(512,vo,vo,[[2,yo]]),br(131584,ri,ri,[Vo,So,Pt,Xt,Ue,vo]),br(1073742336,gi,gi,[ri]),br(1073742336,bu,bu,[[3,bu]]),br(1073742336,Tf,Tf,[]),br(1073742336,kf,kf,[]),br(1073742336,Of,Of,[[2,Cf],[2,ru]]),br(1073742336,Pf,Pf,[]),br(1073742336,Ha,Ha,[]),br(256,Fe,!0,[]),br(256,xf,"BrowserAnimations",[]),br(256,qa,"/",[])])});(function(){if($t)throw new Error("Cannot enable prod mode after platform setup.");Jt=!1})(),Va.Init(function(){gu().bootstrapModuleFactory(Rf).catch(function(t){return console.error(t)})})},zfnd:function(t,e,n){var r=n("glrk"),o=n("hh1v"),i=n("8GlL");t.exports=function(t,e){if(r(t),o(e)&&e.constructor===t)return e;var n=i.f(t);return(0,n.resolve)(e),n.promise}},zk60:function(t,e,n){var r=n("2oRo");t.exports=function(t,e){try{Object.defineProperty(r,t,{value:e,configurable:!0,writable:!0})}catch(n){r[t]=e}return e}},zn8P:function(t,e){function n(t){return Promise.resolve().then(function(){var e=new Error("Cannot find module '"+t+"'");throw e.code="MODULE_NOT_FOUND",e})}n.keys=function(){return[]},n.resolve=n,t.exports=n,n.id="zn8P"},zuhW:function(t,e,n){var r=n("I+eb"),o=n("hh1v"),i=n("8YOa").onFreeze,a=n("uy83"),s=n("0Dky"),u=Object.preventExtensions;r({target:"Object",stat:!0,forced:s(function(){u(1)}),sham:!a},{preventExtensions:function(t){return u&&o(t)?u(i(t)):t}})}});
\ No newline at end of file
@@ -0,0 +1,182 @@
+package installer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+//EntryKind is the kind of item recorded in an install manifest.
+type EntryKind string
+
+const (
+	KindFile         EntryKind = "file"
+	KindDir          EntryKind = "dir"
+	KindSymlink      EntryKind = "symlink"
+	KindScheme       EntryKind = "scheme"
+	KindUninstallOpt EntryKind = "uninstallOpt"
+	KindShortcut     EntryKind = "shortcut"
+)
+
+//Manifest records every file, directory and registration
+//made by built-in steps during an installation.
+type Manifest struct {
+	InstalledAt time.Time `json:"installedAt"`
+	//Version of the product installed, see SetVersion
+	Version string          `json:"version,omitempty"`
+	Entries []ManifestEntry `json:"entries"`
+
+	mu sync.Mutex
+}
+
+//ManifestEntry is a single item created by an installation.
+type ManifestEntry struct {
+	Kind EntryKind `json:"kind"`
+	//Path of a file, directory or shortcut,
+	//name of a scheme or of an uninstall registry key.
+	Path string `json:"path"`
+	//Checksum is the SHA-256 of a file content once installed.
+	Checksum string `json:"checksum,omitempty"`
+	//Existed states if the item was already there before installation.
+	Existed bool `json:"existed"`
+	//PreviousChecksum is the SHA-256 of a file content
+	//before it was overwritten.
+	PreviousChecksum string `json:"previousChecksum,omitempty"`
+
+	//backup is where a replaced file has been moved
+	//until installation completes.
+	backup string
+}
+
+//LoadManifest reads a manifest saved by a previous installation.
+func LoadManifest(path string) (*Manifest, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	m := &Manifest{}
+	return m, json.Unmarshal(b, m)
+}
+
+//SetManifestPath sets where the install manifest is saved
+//once all steps completed successfully.
+//No manifest is saved if it is not set.
+func (i *installer) SetManifestPath(path string) {
+	i.manifestPath = path
+	i.mode = ""
+}
+
+//Manifest returns what has been recorded so far by built-in steps.
+func (i *installer) Manifest() *Manifest {
+	return i.manifest
+}
+
+func (m *Manifest) add(entries ...ManifestEntry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.Entries = append(m.Entries, entries...)
+}
+
+func (m *Manifest) save(path string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.InstalledAt = time.Now()
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := mkDirAll(filepath.Dir(path)); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, b, 0644)
+}
+
+//saveManifest persists the manifest if a path has been set.
+func (i *installer) saveManifest() error {
+	if i.manifestPath == "" {
+		return nil
+	}
+	i.manifest.Version = i.version
+	if i.previous != nil {
+		i.manifest.merge(i.previous)
+	}
+	return i.manifest.save(i.expand(i.manifestPath))
+}
+
+//merge keeps what the previous installation recorded, so that
+//everything installed by either version is uninstalled. Items
+//the previous installation created are not considered as existing
+//before installation, though they were there when m was recorded.
+func (m *Manifest) merge(previous *Manifest) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	owned := map[string]ManifestEntry{}
+	for _, e := range previous.Entries {
+		key := string(e.Kind) + ":" + e.Path
+		if _, ok := owned[key]; !ok {
+			owned[key] = e
+		}
+	}
+	recorded := map[string]bool{}
+	for index, e := range m.Entries {
+		key := string(e.Kind) + ":" + e.Path
+		recorded[key] = true
+		if p, ok := owned[key]; ok {
+			m.Entries[index].Existed = p.Existed
+			m.Entries[index].PreviousChecksum = p.PreviousChecksum
+		}
+	}
+	var entries []ManifestEntry
+	for _, e := range previous.Entries {
+		key := string(e.Kind) + ":" + e.Path
+		if !recorded[key] {
+			entries = append(entries, e)
+			recorded[key] = true
+		}
+	}
+	m.Entries = append(entries, m.Entries...)
+}
+
+//newFileEntry records the state of file before
+//it is written. Checksum is left to be set once written.
+func newFileEntry(file string) ManifestEntry {
+	previous, err := checksumFile(file)
+	return ManifestEntry{
+		Kind:             KindFile,
+		Path:             file,
+		Existed:          err == nil,
+		PreviousChecksum: previous,
+	}
+}
+
+func newDirEntry(dirPath string) ManifestEntry {
+	return ManifestEntry{
+		Kind:    KindDir,
+		Path:    dirPath,
+		Existed: pathExists(dirPath),
+	}
+}
+
+func pathExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+func checksumFile(file string) (string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,51 @@
+package installer
+
+import "io/fs"
+
+//FSWithModes returns fsys reporting the modes listed by file name
+//instead of its own, so copy steps write files with them.
+//It is meant for file systems unable to hold modes, such as embed.FS
+//which reports every file as read-only.
+func FSWithModes(fsys fs.FS, modes map[string]fs.FileMode) fs.FS {
+	return &modeFS{FS: fsys, modes: modes}
+}
+
+type modeFS struct {
+	fs.FS
+	modes map[string]fs.FileMode
+}
+
+func (m *modeFS) Open(name string) (fs.File, error) {
+	f, err := m.FS.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	mode, ok := m.modes[name]
+	if !ok {
+		return f, nil
+	}
+	return &modeFile{File: f, mode: mode}, nil
+}
+
+//modeFile is a file reporting mode as its permissions.
+type modeFile struct {
+	fs.File
+	mode fs.FileMode
+}
+
+func (f *modeFile) Stat() (fs.FileInfo, error) {
+	info, err := f.File.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return &modeInfo{FileInfo: info, mode: info.Mode()&^fs.ModePerm | f.mode.Perm()}, nil
+}
+
+type modeInfo struct {
+	fs.FileInfo
+	mode fs.FileMode
+}
+
+func (i *modeInfo) Mode() fs.FileMode {
+	return i.mode
+}
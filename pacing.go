@@ -0,0 +1,62 @@
+package installer
+
+import (
+	"context"
+	"time"
+)
+
+//StepPacing defines how long each step lasts at least in
+//the installer window, giving the end-user a sense of progression
+//when steps are instant.
+//
+//Pacing is only applied in the installer window, it is bypassed
+//by the terminal and unattended modes.
+type StepPacing struct {
+	delay time.Duration
+	//minimum states if delay is a minimum duration
+	//rather than a fixed one.
+	minimum bool
+}
+
+//NoPacing processes steps without any delay.
+func NoPacing() StepPacing {
+	return StepPacing{}
+}
+
+//FixedPacing adds a fixed delay before each step.
+func FixedPacing(delay time.Duration) StepPacing {
+	return StepPacing{delay: delay}
+}
+
+//MinDurationPacing makes each step last at least the given
+//duration. It only sleeps for the remainder if the step
+//finished faster.
+func MinDurationPacing(minimum time.Duration) StepPacing {
+	return StepPacing{delay: minimum, minimum: true}
+}
+
+//run processes a step paced by p, delays being
+//cut short once ctx is done.
+func (p StepPacing) run(ctx context.Context, process func() error) error {
+	if !p.minimum {
+		sleep(ctx, p.delay)
+		return process()
+	}
+	start := time.Now()
+	err := process()
+	sleep(ctx, p.delay-time.Since(start))
+	return err
+}
+
+//sleep waits for d unless ctx is done first.
+func sleep(ctx context.Context, d time.Duration) {
+	if d <= 0 {
+		return
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+	case <-ctx.Done():
+	}
+}
@@ -0,0 +1,39 @@
+package installer
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestPacingCancelled(t *testing.T) {
+	tests := []struct {
+		name   string
+		pacing StepPacing
+	}{
+		{name: "fixed", pacing: FixedPacing(time.Hour)},
+		{name: "minimum duration", pacing: MinDurationPacing(time.Hour)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			time.AfterFunc(10*time.Millisecond, cancel)
+			processed := false
+			done := make(chan error, 1)
+			go func() {
+				done <- tt.pacing.run(ctx, func() error {
+					processed = true
+					return nil
+				})
+			}()
+			select {
+			case err := <-done:
+				if err != nil || !processed {
+					t.Fatalf("got %v, processed %v", err, processed)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("pacing ignored cancellation")
+			}
+		})
+	}
+}
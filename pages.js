@@ -0,0 +1,445 @@
+//pages.js completes the bundled frontend, main.js, with pages
+//displayed between the conditions and the installation.
+//It is evaluated before main.js, both sharing what Self returns,
+//and is written in ES5 as the window may run on an old engine.
+(function () {
+	var bind = window.backend.wailsBind;
+	var self = bind.Self;
+	var installStep = bind.InstallStep;
+	//installer resolves to what Self returned, shared with main.js
+	var installer;
+	//running is the index of the step being installed
+	var running = -1;
+	//progress displays the progress of the running step
+	var progress;
+	//page is the page displayed, if any
+	var page;
+	//cancel is the button cancelling the installation
+	var cancel;
+	//cancelling resolves once the installation is cancelled
+	var cancelling;
+	//rollback lists steps reverted, once completed steps are rolled back
+	var rollback;
+
+	bind.Self = function () {
+		if (!installer) {
+			installer = self();
+		}
+		return installer;
+	};
+
+	//InstallStep displays pages before the first step is installed.
+	bind.InstallStep = function (index) {
+		return bind.Self().then(function (data) {
+			if (index !== 0) {
+				return install(data, index);
+			}
+			showCancel(data);
+			return showPages(data).then(function () {
+				return refreshSteps(data);
+			}).then(function () {
+				return install(data, index);
+			});
+		});
+	};
+
+	//install installs step index, once the installation
+	//is cancelled if the user asked to.
+	function install(data, index) {
+		if (cancelling) {
+			return cancelling.then(function () {
+				return attempt(data, index);
+			});
+		}
+		return attempt(data, index);
+	}
+
+	//attempt installs step index. If it fails and the installer
+	//prompts on failure, the user may retry it, skip it or abort
+	//the installation.
+	function attempt(data, index) {
+		running = index;
+		setProgress(0, '');
+		return installStep(index).then(function (result) {
+			showStatus(data, index, result);
+			if (index === data.steps.length - 1) {
+				end();
+			}
+			return result;
+		}, function (err) {
+			if (!data.promptOnFailure || cancelling) {
+				end();
+				throw err;
+			}
+			return showPrompt(data, index, err).then(function (choice) {
+				switch (choice) {
+				case 'retry':
+					return attempt(data, index);
+				case 'skip':
+					return bind.SkipStep(index).then(function () {
+						var result = {status: 'skipped', message: String(err)};
+						showStatus(data, index, result);
+						if (index === data.steps.length - 1) {
+							end();
+						}
+						return result;
+					});
+				}
+				return abort(data).then(function () {
+					end();
+					throw err;
+				});
+			});
+		});
+	}
+
+	//abort cancels the installation, once cancelling
+	//is done if the user already asked to.
+	function abort(data) {
+		if (!cancelling) {
+			data.texts.fail = data.texts.cancelled;
+			cancelling = bind.Cancel();
+		}
+		return cancelling;
+	}
+
+	//showStatus appends to the description of step index
+	//how it ended, unless it was done, so it is displayed
+	//among steps and in the completed steps list.
+	function showStatus(data, index, result) {
+		var label = {skipped: data.texts.skipped, warning: data.texts.warning}[result.status];
+		if (!label) {
+			return;
+		}
+		if (result.message) {
+			label += ' : ' + result.message;
+		}
+		data.steps[index].description += ' <span class="step-status ' + result.status + '">' + escape(label) + '</span>';
+	}
+
+	//end removes controls of the installation once it is over.
+	function end() {
+		running = -1;
+		if (cancel) {
+			document.body.removeChild(cancel);
+			cancel = null;
+		}
+		if (progress) {
+			document.body.removeChild(progress.element);
+			progress = null;
+		}
+	}
+
+	//showCancel displays the button cancelling the installation.
+	//The failure message of the installation becomes the text
+	//telling it was cancelled.
+	function showCancel(data) {
+		cancel = text('button', data.texts.cancel, 'page-btn cancel-btn');
+		cancel.onclick = function () {
+			cancel.disabled = true;
+			abort(data);
+			if (page) {
+				page.close();
+			}
+		};
+		document.body.appendChild(cancel);
+	}
+
+	window.wails.Events.On('stepProgress', function (index, fraction, status) {
+		if (index === running) {
+			setProgress(fraction, status);
+		}
+	});
+
+	window.wails.Events.On('rollbackStep', function (index) {
+		bind.Self().then(function (data) {
+			showRollback(data, index);
+		});
+	});
+
+	//showRollback adds step index to the steps reverted.
+	//They stay displayed once the installation failed.
+	function showRollback(data, index) {
+		var step = element('li');
+		if (!rollback) {
+			rollback = {
+				element: element('div', 'rollback'),
+				list: element('ul')
+			};
+			rollback.element.appendChild(text('p', data.texts.rollback));
+			rollback.element.appendChild(rollback.list);
+			document.body.appendChild(rollback.element);
+		}
+		//descriptions are trusted, main.js renders them as html
+		step.innerHTML = data.steps[index].description;
+		rollback.list.appendChild(step);
+	}
+
+	//setProgress displays the fraction of the running
+	//step done, along with its status.
+	function setProgress(fraction, status) {
+		if (!progress) {
+			progress = {
+				element: element('div', 'step-progress'),
+				bar: element('div', 'progress-bar'),
+				fill: element('div', 'progress-fill'),
+				status: element('p', 'progress-status')
+			};
+			progress.bar.appendChild(progress.fill);
+			progress.element.appendChild(progress.bar);
+			progress.element.appendChild(progress.status);
+			document.body.appendChild(progress.element);
+		}
+		progress.fill.style.width = Math.round(fraction * 100) + '%';
+		progress.status.textContent = status;
+	}
+
+	//showPages displays pages one after the other.
+	function showPages(data) {
+		var pages = [];
+		if (data.components && data.components.length) {
+			pages.push(componentsPage(data));
+		}
+		if (data.installDir) {
+			pages.push(installDirPage(data));
+		}
+		(data.forms || []).forEach(function (form, index) {
+			pages.push(formPage(data, form, index));
+		});
+		return pages.reduce(function (shown, build) {
+			return shown.then(function () {
+				if (!cancelling) {
+					return showPage(data, build);
+				}
+			});
+		}, Promise.resolve());
+	}
+
+	//showPage displays a page until it is submitted or the
+	//installation cancelled. build fills the page and returns
+	//its submit function, which returns a promise rejected with
+	//a message if what was entered is not valid.
+	function showPage(data, build) {
+		return new Promise(function (resolve) {
+			var container = element('div', 'installer-page');
+			var content = element('div', 'page-content');
+			var submit = build(content);
+			var error = element('p', 'page-error');
+			var buttons = element('div', 'page-buttons');
+			var next = text('button', data.texts.next, 'page-btn');
+			next.onclick = function () {
+				next.disabled = true;
+				error.textContent = '';
+				submit().then(function () {
+					page.close();
+				}, function (err) {
+					error.textContent = err;
+					next.disabled = false;
+				});
+			};
+			content.appendChild(error);
+			container.appendChild(content);
+			buttons.appendChild(next);
+			container.appendChild(buttons);
+			document.body.appendChild(container);
+			page = {
+				close: function () {
+					document.body.removeChild(container);
+					page = null;
+					resolve();
+				}
+			};
+		});
+	}
+
+	//showPrompt displays why a step failed until the user chooses
+	//to retry it, skip it or abort the installation. It resolves
+	//to the choice made, aborting if the installation is cancelled.
+	function showPrompt(data, index, err) {
+		return new Promise(function (resolve) {
+			var container = element('div', 'installer-page');
+			var content = element('div', 'page-content');
+			var description = element('p');
+			var buttons = element('div', 'page-buttons');
+			var choose = function (choice) {
+				document.body.removeChild(container);
+				page = null;
+				resolve(choice);
+			};
+			//descriptions are trusted, main.js renders them as html
+			description.innerHTML = data.steps[index].description;
+			content.appendChild(text('h1', data.texts.stepFailed));
+			content.appendChild(description);
+			content.appendChild(text('p', err, 'page-error'));
+			container.appendChild(content);
+			[['abort', data.texts.abort], ['skip', data.texts.skip], ['retry', data.texts.retry]].forEach(function (button) {
+				var b = text('button', button[1], 'page-btn');
+				b.onclick = function () {
+					choose(button[0]);
+				};
+				buttons.appendChild(b);
+			});
+			container.appendChild(buttons);
+			document.body.appendChild(container);
+			page = {
+				close: function () {
+					choose('abort');
+				}
+			};
+		});
+	}
+
+	//componentsPage returns a page where components are selected.
+	function componentsPage(data) {
+		return function (content) {
+			var boxes = [];
+			content.appendChild(text('h1', data.texts.components));
+			data.components.forEach(function (component) {
+				var label = element('label', 'field component');
+				var box = element('input');
+				box.type = 'checkbox';
+				box.checked = component.selected;
+				label.appendChild(box);
+				label.appendChild(text('span', component.title));
+				label.appendChild(text('p', component.description));
+				content.appendChild(label);
+				boxes.push(box);
+			});
+			return function () {
+				var ids = [];
+				data.components.forEach(function (component, i) {
+					component.selected = boxes[i].checked;
+					if (component.selected) {
+						ids.push(component.id);
+					}
+				});
+				return bind.SelectComponents(ids);
+			};
+		};
+	}
+
+	//installDirPage returns a page where the install directory
+	//is typed or browsed for.
+	function installDirPage(data) {
+		return function (content) {
+			var field = element('div', 'field');
+			var row = element('div', 'install-dir');
+			var input = element('input');
+			var browse = text('button', data.texts.browse, 'page-btn');
+			input.type = 'text';
+			input.value = data.installDir.path || data.installDir.default;
+			browse.onclick = function () {
+				bind.BrowseInstallDir().then(function (dir) {
+					if (dir) {
+						input.value = dir;
+					}
+				});
+			};
+			content.appendChild(text('h1', data.texts.installDir));
+			row.appendChild(input);
+			row.appendChild(browse);
+			field.appendChild(row);
+			content.appendChild(field);
+			return function () {
+				return bind.SelectInstallDir(input.value).then(function () {
+					data.installDir.path = input.value;
+				});
+			};
+		};
+	}
+
+	//formPage returns a page where fields of form are filled.
+	function formPage(data, form, index) {
+		return function (content) {
+			var inputs = [];
+			content.appendChild(text('h1', form.title));
+			form.fields.forEach(function (field) {
+				var label = element('label', 'field');
+				var input = fieldInput(field, fieldValue(data, field));
+				label.appendChild(text('span', field.label + (field.required ? ' *' : '')));
+				label.appendChild(input);
+				content.appendChild(label);
+				inputs.push(input);
+			});
+			return function () {
+				var values = {};
+				form.fields.forEach(function (field, i) {
+					values[field.name] = inputValue(field, inputs[i]);
+				});
+				return bind.SubmitForm(index, values).then(function () {
+					form.fields.forEach(function (field) {
+						data.values[field.name] = values[field.name];
+					});
+				});
+			};
+		};
+	}
+
+	function fieldValue(data, field) {
+		if (data.values && data.values.hasOwnProperty(field.name)) {
+			return data.values[field.name];
+		}
+		return field.default;
+	}
+
+	//fieldInput returns an input matching the type of field.
+	function fieldInput(field, value) {
+		var input;
+		switch (field.type) {
+		case 'select':
+			input = element('select');
+			(field.options || []).forEach(function (option) {
+				var o = text('option', option);
+				o.value = option;
+				input.appendChild(o);
+			});
+			input.value = value;
+			return input;
+		case 'checkbox':
+			input = element('input');
+			input.type = 'checkbox';
+			input.checked = value === 'true';
+			return input;
+		}
+		input = element('input');
+		input.type = field.type === 'password' || field.type === 'number' ? field.type : 'text';
+		input.value = value;
+		return input;
+	}
+
+	function inputValue(field, input) {
+		if (field.type === 'checkbox') {
+			return input.checked ? 'true' : 'false';
+		}
+		return input.value;
+	}
+
+	//refreshSteps updates descriptions of steps,
+	//which may refer to what was entered on pages.
+	function refreshSteps(data) {
+		return self().then(function (fresh) {
+			fresh.steps.forEach(function (step, index) {
+				data.steps[index].description = step.description;
+			});
+		});
+	}
+
+	//escape returns s as html text.
+	function escape(s) {
+		return s.replace(/&/g, '&amp;').replace(/</g, '&lt;').replace(/>/g, '&gt;').replace(/"/g, '&quot;');
+	}
+
+	function element(tag, className) {
+		var e = document.createElement(tag);
+		if (className) {
+			e.className = className;
+		}
+		return e;
+	}
+
+	function text(tag, content, className) {
+		var e = element(tag, className);
+		e.textContent = content;
+		return e;
+	}
+})();
@@ -0,0 +1,396 @@
+package installer
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"time"
+)
+
+//A payload is appended to the installer executable as :
+//
+//	file contents | index | signature | trailer
+//
+//The index lists files and directories in JSON, with the offset
+//of their content from the start of the payload and its SHA-256
+//checksum. The signature of the index is optional, see SignPayload.
+//The trailer holds the offset and size of the index and the size
+//of the signature followed by payloadMagic, so a payload is found
+//from the end of the executable.
+const (
+	payloadMagic       = "GOINSTPL"
+	payloadTrailerSize = 8 + 8 + 8 + len(payloadMagic)
+)
+
+//ErrNoPayload is returned when opening a payload
+//from a file that has none appended.
+var ErrNoPayload = errors.New("no payload appended")
+
+type payloadEntry struct {
+	Name    string      `json:"name"`
+	Mode    fs.FileMode `json:"mode"`
+	ModTime time.Time   `json:"modTime"`
+	Offset  int64       `json:"offset"`
+	Size    int64       `json:"size"`
+	//SHA256 is the hex encoded checksum of a file content
+	SHA256 string `json:"sha256,omitempty"`
+}
+
+//Payload is a file tree appended to an executable. It is an fs.FS
+//copy steps can read from, file contents being read lazily.
+//As it holds the SHA-256 checksum of each file, copied files
+//are verified while being written.
+type Payload struct {
+	f       *os.File
+	r       io.ReaderAt
+	entries map[string]*payloadEntry
+	//children of each directory, sorted by name
+	children map[string][]*payloadEntry
+	//index is the raw index signature is made of
+	index     []byte
+	signature []byte
+}
+
+//payloadLayout locates the parts of a payload in a file.
+type payloadLayout struct {
+	start         int64
+	indexOffset   int64
+	indexSize     int64
+	signatureSize int64
+}
+
+//OpenPayload opens the payload appended to the running executable,
+//so one installer binary can be repackaged with new payloads
+//without being compiled again, see AppendPayload.
+func OpenPayload() (*Payload, error) {
+	exe, err := os.Executable()
+	if err != nil {
+		return nil, err
+	}
+	return OpenPayloadFile(exe)
+}
+
+//OpenPayloadFile opens the payload appended to file.
+func OpenPayloadFile(file string) (*Payload, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	p, err := readPayload(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return p, nil
+}
+
+//Close closes the file the payload is read from.
+func (p *Payload) Close() error {
+	return p.f.Close()
+}
+
+//Open opens the named file or directory of the payload.
+func (p *Payload) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+	e, ok := p.entries[name]
+	if !ok {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+	if e.Mode.IsDir() {
+		return &payloadDir{entry: e, children: p.children[name]}, nil
+	}
+	return &payloadFile{entry: e, r: io.NewSectionReader(p.r, e.Offset, e.Size)}, nil
+}
+
+//Checksum returns the hex encoded SHA-256 checksum of file name.
+func (p *Payload) Checksum(name string) (string, bool) {
+	e, ok := p.entries[name]
+	if !ok || e.SHA256 == "" {
+		return "", false
+	}
+	return e.SHA256, true
+}
+
+//Sub returns the payload directory dir, its files being
+//still verified against their checksum, see fs.Sub.
+func (p *Payload) Sub(dir string) (fs.FS, error) {
+	//hide Sub so fs.Sub does not call it back
+	return subChecksumFS(p, struct{ fs.FS }{p}, dir)
+}
+
+//readPayload reads the index of the payload appended to f.
+func readPayload(f *os.File) (*Payload, error) {
+	l, err := findPayload(f)
+	if err != nil {
+		return nil, err
+	}
+	index := make([]byte, l.indexSize+l.signatureSize)
+	if _, err := f.ReadAt(index, l.start+l.indexOffset); err != nil {
+		return nil, err
+	}
+	index, signature := index[:l.indexSize], index[l.indexSize:]
+	var entries []*payloadEntry
+	if err := json.Unmarshal(index, &entries); err != nil {
+		return nil, fmt.Errorf("invalid payload index: %w", err)
+	}
+	p := &Payload{
+		f:         f,
+		r:         io.NewSectionReader(f, l.start, l.indexOffset),
+		entries:   map[string]*payloadEntry{".": {Name: ".", Mode: fs.ModeDir | 0755}},
+		children:  map[string][]*payloadEntry{},
+		index:     index,
+		signature: signature,
+	}
+	for _, e := range entries {
+		if !fs.ValidPath(e.Name) || e.Offset < 0 || e.Size < 0 || e.Offset > l.indexOffset || e.Size > l.indexOffset-e.Offset {
+			return nil, fmt.Errorf("invalid payload entry %s", e.Name)
+		}
+		p.add(e)
+	}
+	for _, children := range p.children {
+		sort.Slice(children, func(a, b int) bool { return children[a].Name < children[b].Name })
+	}
+	return p, nil
+}
+
+//add adds e to the payload tree along with
+//its parent directories if missing.
+func (p *Payload) add(e *payloadEntry) {
+	if _, ok := p.entries[e.Name]; ok {
+		return
+	}
+	p.entries[e.Name] = e
+	dir := path.Dir(e.Name)
+	if _, ok := p.entries[dir]; !ok {
+		p.add(&payloadEntry{Name: dir, Mode: fs.ModeDir | 0755, ModTime: e.ModTime})
+	}
+	p.children[dir] = append(p.children[dir], e)
+}
+
+//findPayload locates the payload appended to f.
+func findPayload(f *os.File) (payloadLayout, error) {
+	info, err := f.Stat()
+	if err != nil {
+		return payloadLayout{}, err
+	}
+	size := info.Size()
+	if size < int64(payloadTrailerSize) {
+		return payloadLayout{}, ErrNoPayload
+	}
+	trailer := make([]byte, payloadTrailerSize)
+	if _, err := f.ReadAt(trailer, size-int64(payloadTrailerSize)); err != nil {
+		return payloadLayout{}, err
+	}
+	if string(trailer[24:]) != payloadMagic {
+		return payloadLayout{}, ErrNoPayload
+	}
+	l := payloadLayout{
+		indexOffset:   int64(binary.LittleEndian.Uint64(trailer[0:8])),
+		indexSize:     int64(binary.LittleEndian.Uint64(trailer[8:16])),
+		signatureSize: int64(binary.LittleEndian.Uint64(trailer[16:24])),
+	}
+	//each field is bounded by what remains of the file
+	//before being subtracted, so none of them overflows
+	remaining := size - int64(payloadTrailerSize)
+	for _, n := range []int64{l.signatureSize, l.indexSize, l.indexOffset} {
+		if n < 0 || n > remaining {
+			return payloadLayout{}, errors.New("invalid payload trailer")
+		}
+		remaining -= n
+	}
+	l.start = remaining
+	return l, nil
+}
+
+//trailer returns the trailer of a payload laid out as l.
+func (l payloadLayout) trailer() []byte {
+	trailer := make([]byte, payloadTrailerSize)
+	binary.LittleEndian.PutUint64(trailer[0:8], uint64(l.indexOffset))
+	binary.LittleEndian.PutUint64(trailer[8:16], uint64(l.indexSize))
+	binary.LittleEndian.PutUint64(trailer[16:24], uint64(l.signatureSize))
+	copy(trailer[24:], payloadMagic)
+	return trailer
+}
+
+//AppendPayload appends the file tree of fsys to the executable
+//exe as a payload, replacing the one already appended if any.
+//Only regular files and directories are supported.
+func AppendPayload(exe string, fsys fs.FS) error {
+	f, err := os.OpenFile(exe, os.O_RDWR, 0)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	end, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	l, err := findPayload(f)
+	if err == nil {
+		end = l.start
+	} else if err != ErrNoPayload {
+		return err
+	}
+	if err := f.Truncate(end); err != nil {
+		return err
+	}
+	if _, err := f.Seek(end, io.SeekStart); err != nil {
+		return err
+	}
+	if err := WritePayload(f, fsys); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+//WritePayload writes the file tree of fsys to w as a payload
+//to be appended to an executable.
+//Only regular files and directories are supported.
+func WritePayload(w io.Writer, fsys fs.FS) error {
+	cw := &countingWriter{w: w}
+	var entries []*payloadEntry
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil || name == "." {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		e := &payloadEntry{Name: name, Mode: info.Mode(), ModTime: info.ModTime(), Offset: cw.n}
+		entries = append(entries, e)
+		if d.IsDir() {
+			return nil
+		}
+		if !info.Mode().IsRegular() {
+			return fmt.Errorf("payload: %s is not a regular file", name)
+		}
+		e.Size, e.SHA256, err = copyFSContent(cw, fsys, name)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	index, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	indexOffset := cw.n
+	if _, err := cw.Write(index); err != nil {
+		return err
+	}
+	l := payloadLayout{indexOffset: indexOffset, indexSize: int64(len(index))}
+	_, err = cw.Write(l.trailer())
+	return err
+}
+
+//copyFSContent copies file name of fsys to w, returning
+//its size and the hex encoded SHA-256 checksum of its content.
+func copyFSContent(w io.Writer, fsys fs.FS, name string) (int64, string, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return 0, "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	n, err := io.Copy(io.MultiWriter(w, h), f)
+	return n, hex.EncodeToString(h.Sum(nil)), err
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(b []byte) (int, error) {
+	n, err := c.w.Write(b)
+	c.n += int64(n)
+	return n, err
+}
+
+//payloadFile is a regular file of a payload.
+type payloadFile struct {
+	entry *payloadEntry
+	r     *io.SectionReader
+}
+
+func (f *payloadFile) Stat() (fs.FileInfo, error) {
+	return &payloadInfo{f.entry}, nil
+}
+
+func (f *payloadFile) Read(b []byte) (int, error) {
+	return f.r.Read(b)
+}
+
+func (f *payloadFile) ReadAt(b []byte, off int64) (int, error) {
+	return f.r.ReadAt(b, off)
+}
+
+func (f *payloadFile) Seek(offset int64, whence int) (int64, error) {
+	return f.r.Seek(offset, whence)
+}
+
+func (f *payloadFile) Close() error {
+	return nil
+}
+
+//payloadDir is a directory of a payload.
+type payloadDir struct {
+	entry    *payloadEntry
+	children []*payloadEntry
+	//read is the number of children already returned by ReadDir
+	read int
+}
+
+func (d *payloadDir) Stat() (fs.FileInfo, error) {
+	return &payloadInfo{d.entry}, nil
+}
+
+func (d *payloadDir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.entry.Name, Err: errors.New("is a directory")}
+}
+
+func (d *payloadDir) Close() error {
+	return nil
+}
+
+func (d *payloadDir) ReadDir(n int) ([]fs.DirEntry, error) {
+	remaining := d.children[d.read:]
+	if n > 0 && len(remaining) == 0 {
+		return nil, io.EOF
+	}
+	if n > 0 && n < len(remaining) {
+		remaining = remaining[:n]
+	}
+	d.read += len(remaining)
+	entries := make([]fs.DirEntry, len(remaining))
+	for index, e := range remaining {
+		entries[index] = &payloadInfo{e}
+	}
+	return entries, nil
+}
+
+//payloadInfo describes a payload entry,
+//both as an fs.FileInfo and an fs.DirEntry.
+type payloadInfo struct {
+	entry *payloadEntry
+}
+
+func (i *payloadInfo) Name() string               { return path.Base(i.entry.Name) }
+func (i *payloadInfo) Size() int64                { return i.entry.Size }
+func (i *payloadInfo) Mode() fs.FileMode          { return i.entry.Mode }
+func (i *payloadInfo) Type() fs.FileMode          { return i.entry.Mode.Type() }
+func (i *payloadInfo) ModTime() time.Time         { return i.entry.ModTime }
+func (i *payloadInfo) IsDir() bool                { return i.entry.Mode.IsDir() }
+func (i *payloadInfo) Sys() interface{}           { return nil }
+func (i *payloadInfo) Info() (fs.FileInfo, error) { return i, nil }
@@ -0,0 +1,104 @@
+package installer
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+)
+
+func TestPayload(t *testing.T) {
+	exe := appendTestPayload(t, fstest.MapFS{
+		"app/bin/app":   {Data: []byte("binary"), Mode: 0755},
+		"app/README.md": {Data: []byte("readme"), Mode: 0644},
+	})
+	p, err := OpenPayloadFile(exe)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+	if err := fstest.TestFS(p, "app/bin/app", "app/README.md"); err != nil {
+		t.Fatal(err)
+	}
+	info, err := p.Open("app/bin/app")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer info.Close()
+	if stat, err := info.Stat(); err != nil || stat.Mode().Perm() != 0755 {
+		t.Fatalf("got %v, %v, want mode 0755", stat.Mode(), err)
+	}
+}
+
+func TestAppendPayloadReplacesPayload(t *testing.T) {
+	exe := appendTestPayload(t, fstest.MapFS{"old": {Data: []byte("old")}})
+	if err := AppendPayload(exe, fstest.MapFS{"new": {Data: []byte("new")}}); err != nil {
+		t.Fatal(err)
+	}
+	p, err := OpenPayloadFile(exe)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+	if _, err := p.Open("old"); err == nil {
+		t.Fatal("previous payload still appended")
+	}
+	if content, err := p.ReadFile("new"); err != nil || string(content) != "new" {
+		t.Fatalf("got %q, %v", content, err)
+	}
+	content, err := ioutil.ReadFile(exe)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content[:len("executable")]) != "executable" {
+		t.Fatal("executable modified")
+	}
+}
+
+func TestOpenPayloadFileWithoutPayload(t *testing.T) {
+	exe := filepath.Join(t.TempDir(), "setup")
+	if err := ioutil.WriteFile(exe, []byte("executable"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := OpenPayloadFile(exe); err != ErrNoPayload {
+		t.Fatalf("got %v, want %v", err, ErrNoPayload)
+	}
+}
+
+func TestOpenPayloadFileInvalidTrailer(t *testing.T) {
+	tests := []struct {
+		name                                  string
+		indexOffset, indexSize, signatureSize uint64
+	}{
+		{name: "index offset too large", indexOffset: math.MaxInt64},
+		{name: "index size too large", indexSize: math.MaxInt64},
+		{name: "signature size too large", signatureSize: math.MaxInt64},
+		{name: "negative index offset", indexOffset: math.MaxUint64},
+		{name: "overflowing sum", indexOffset: math.MaxInt64 / 2, indexSize: math.MaxInt64 / 2, signatureSize: math.MaxInt64 / 2},
+		{name: "larger than the file", indexOffset: 4, indexSize: 4, signatureSize: 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trailer := make([]byte, payloadTrailerSize)
+			binary.LittleEndian.PutUint64(trailer[0:8], tt.indexOffset)
+			binary.LittleEndian.PutUint64(trailer[8:16], tt.indexSize)
+			binary.LittleEndian.PutUint64(trailer[16:24], tt.signatureSize)
+			copy(trailer[24:], payloadMagic)
+			exe := filepath.Join(t.TempDir(), "setup")
+			if err := ioutil.WriteFile(exe, append([]byte("exe"), trailer...), 0755); err != nil {
+				t.Fatal(err)
+			}
+			p, err := OpenPayloadFile(exe)
+			if err == nil {
+				p.Close()
+				t.Fatal("got no error")
+			}
+			if err == ErrNoPayload || os.IsNotExist(err) {
+				t.Fatalf("got %v, want an invalid trailer", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package installer
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+//Progress reports how far a running step is.
+//Fraction goes from 0 to 1, status is a short message
+//such as the name of the file being copied.
+type Progress func(fraction float64, status string)
+
+//AddStepWithProgress adds a new step whose process reports
+//its progress while running. It is displayed as a progress bar
+//in the installer window.
+func (i *installer) AddStepWithProgress(process func(progress Progress) error, desc string, opts ...StepOption) {
+	i.addStep(step{
+		process: func(_ context.Context, progress Progress) error {
+			return process(progress)
+		},
+		Description: desc,
+	}, opts...)
+}
+
+func noProgress(fraction float64, status string) {}
+
+//reportItem reports progress of a step processing
+//total items, index being the one starting.
+func reportItem(progress Progress, index, total int, status string) {
+	if total == 0 {
+		return
+	}
+	progress(float64(index)/float64(total), status)
+}
+
+//throttle returns a Progress calling progress at most once per
+//interval, except for completion which is always reported.
+func throttle(progress Progress, interval time.Duration) Progress {
+	var mu sync.Mutex
+	var last time.Time
+	return func(fraction float64, status string) {
+		mu.Lock()
+		defer mu.Unlock()
+		if fraction < 1 && time.Since(last) < interval {
+			return
+		}
+		last = time.Now()
+		progress(fraction, status)
+	}
+}
+
+//progressReader reports how much of r has been read.
+type progressReader struct {
+	r        io.Reader
+	read     int64
+	size     int64
+	status   string
+	progress Progress
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	p.read += int64(n)
+	if p.size > 0 {
+		p.progress(float64(p.read)/float64(p.size), p.status)
+	}
+	return n, err
+}
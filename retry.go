@@ -0,0 +1,69 @@
+package installer
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+//StepOption customizes how a step is processed.
+type StepOption func(s *step)
+
+//WithTimeout makes a step fail if it did not complete
+//within d. The context given to the step is done once
+//d elapsed, so it only applies to steps stopping when their
+//context is done, such as those added with AddStepWithContext.
+//It has no effect on steps added with AddStep, AddStepWithRollback
+//or AddStepWithProgress, which are waited for as a step still
+//running must not be retried nor rolled back.
+func WithTimeout(d time.Duration) StepOption {
+	return func(s *step) {
+		s.timeout = d
+	}
+}
+
+//WithRetry processes a failing step again up to retries times.
+//The delay between attempts starts at backoff and doubles
+//after each of them.
+func WithRetry(retries int, backoff time.Duration) StepOption {
+	return func(s *step) {
+		s.retries = retries
+		s.backoff = backoff
+	}
+}
+
+//attempt processes s, retrying it as set by WithRetry.
+func (s step) attempt(ctx context.Context, progress Progress) error {
+	backoff := s.backoff
+	for retry := 0; ; retry++ {
+		err := s.processWithTimeout(ctx, progress)
+		if err == nil || retry >= s.retries || ctx.Err() != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+//processWithTimeout processes s with a context done once
+//its timeout elapsed if any, and waits for it to return.
+func (s step) processWithTimeout(ctx context.Context, progress Progress) error {
+	if s.timeout <= 0 {
+		return s.process(ctx, progress)
+	}
+	stepCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	err := s.process(stepCtx, progress)
+	if err != nil && ctx.Err() == nil && stepCtx.Err() == context.DeadlineExceeded {
+		return s.timeoutErr()
+	}
+	return err
+}
+
+func (s step) timeoutErr() error {
+	return fmt.Errorf("step timed out after %s: %w", s.timeout, context.DeadlineExceeded)
+}
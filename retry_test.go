@@ -0,0 +1,49 @@
+package installer
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAttemptTimeout(t *testing.T) {
+	s := step{
+		process: func(ctx context.Context, _ Progress) error {
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	}
+	WithTimeout(10 * time.Millisecond)(&s)
+	if err := s.attempt(context.Background(), func(float64, string) {}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want a timeout", err)
+	}
+}
+
+func TestAttemptWaitsForStepIgnoringContext(t *testing.T) {
+	var running, overlaps, attempts int32
+	s := step{
+		process: func(context.Context, Progress) error {
+			if atomic.AddInt32(&running, 1) > 1 {
+				atomic.AddInt32(&overlaps, 1)
+			}
+			defer atomic.AddInt32(&running, -1)
+			atomic.AddInt32(&attempts, 1)
+			time.Sleep(30 * time.Millisecond)
+			return errors.New("failed")
+		},
+	}
+	WithTimeout(time.Millisecond)(&s)
+	WithRetry(2, time.Millisecond)(&s)
+	s.attempt(context.Background(), func(float64, string) {})
+	if running != 0 {
+		t.Fatal("attempt returned while the step was still running")
+	}
+	if overlaps != 0 {
+		t.Fatalf("got %d overlapping attempts", overlaps)
+	}
+	if attempts != 3 {
+		t.Fatalf("got %d attempts, want 3", attempts)
+	}
+}
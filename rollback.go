@@ -0,0 +1,82 @@
+package installer
+
+import (
+	"fmt"
+	"os"
+)
+
+//rollbackSteps reverts steps from index last down to the first one.
+//
+//notify is called before each undo so the caller can display
+//rollback progress. Every undo is attempted even if one of them
+//fails, the first error encountered is returned.
+func rollbackSteps(steps []step, last int, notify func(index int)) error {
+	var firstErr error
+	for index := last; index >= 0; index-- {
+		if steps[index].undo == nil {
+			continue
+		}
+		notify(index)
+		err := steps[index].undo()
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+//withoutUndo returns a copy of steps where
+//skipped ones have nothing to revert.
+func withoutUndo(steps []step, skipped map[int]bool) []step {
+	s := make([]step, len(steps))
+	copy(s, steps)
+	for index := range skipped {
+		s[index].undo = nil
+	}
+	return s
+}
+
+//withRollbackErr appends a rollback failure to the error
+//that triggered the rollback.
+func withRollbackErr(err, rollbackErr error) error {
+	if rollbackErr == nil {
+		return err
+	}
+	return fmt.Errorf("%w (rollback failed: %v)", err, rollbackErr)
+}
+
+//revertEntries reverts recorded entries in reverse order.
+func revertEntries(entries []ManifestEntry) error {
+	for index := len(entries) - 1; index >= 0; index-- {
+		if err := revertEntry(entries[index]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//revertEntry restores a replaced file, or removes a file
+//or a directory that did not exist before being recorded.
+func revertEntry(e ManifestEntry) error {
+	switch {
+	case e.backup != "":
+		return os.Rename(e.backup, e.Path)
+	case e.Kind == KindFile && !e.Existed:
+		return rmvFile(e.Path)
+	case e.Kind == KindSymlink && !e.Existed:
+		return rmvFile(e.Path)
+	case e.Kind == KindDir && !e.Existed:
+		return rmvEmptyDir(e.Path)
+	}
+	return nil
+}
+
+//commitSteps lets completed steps discard what they kept
+//in case of a rollback. It is best effort, errors are ignored.
+func commitSteps(steps []step) {
+	for _, s := range steps {
+		if s.commit != nil {
+			s.commit()
+		}
+	}
+}
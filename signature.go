@@ -0,0 +1,142 @@
+package installer
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"strings"
+)
+
+var (
+	//ErrPayloadNotSigned is returned when a payload
+	//has no signature.
+	ErrPayloadNotSigned = errors.New("payload is not signed")
+	//ErrInvalidSignature is returned when the signature of a payload
+	//was not made with the expected key or its index was altered.
+	ErrInvalidSignature = errors.New("payload signature is invalid")
+)
+
+//SignPayload signs the index of the payload appended to exe with key,
+//replacing its previous signature if any. As the index holds the
+//checksum of every file, which are verified while being copied,
+//the whole payload is authenticated by that signature.
+func SignPayload(exe string, key ed25519.PrivateKey) error {
+	f, err := os.OpenFile(exe, os.O_RDWR, 0)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	l, err := findPayload(f)
+	if err != nil {
+		return err
+	}
+	index := make([]byte, l.indexSize)
+	end := l.start + l.indexOffset + l.indexSize
+	if _, err := f.ReadAt(index, end-l.indexSize); err != nil {
+		return err
+	}
+	signature := ed25519.Sign(key, index)
+	l.signatureSize = int64(len(signature))
+	if err := f.Truncate(end); err != nil {
+		return err
+	}
+	if _, err := f.Seek(end, io.SeekStart); err != nil {
+		return err
+	}
+	if _, err := f.Write(append(signature, l.trailer()...)); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+//VerifySignature checks the index of the payload was signed
+//with the private key matching publicKey.
+func (p *Payload) VerifySignature(publicKey ed25519.PublicKey) error {
+	if len(p.signature) == 0 {
+		return ErrPayloadNotSigned
+	}
+	if len(publicKey) != ed25519.PublicKeySize || !ed25519.Verify(publicKey, p.index, p.signature) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+//ParsePublicKey parses a base64 encoded ed25519 public key,
+//so it can be compiled into the installer as a string.
+func ParsePublicKey(s string) (ed25519.PublicKey, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(s))
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		return nil, errors.New("invalid ed25519 public key")
+	}
+	return ed25519.PublicKey(key), nil
+}
+
+//RequireSignedPayload makes the installer refuse to proceed unless
+//p is signed with the private key matching publicKey, which should be
+//compiled into the installer. It is checked before any step runs.
+func (i *installer) RequireSignedPayload(p *Payload, publicKey ed25519.PublicKey) {
+	i.checks = append(i.checks, func() error {
+		err := p.VerifySignature(publicKey)
+		switch err {
+		case nil:
+			return nil
+		case ErrPayloadNotSigned:
+			return &checkError{msg: i.getPayloadNotSignedText(), err: err}
+		default:
+			return &checkError{msg: i.getInvalidSignatureText(), err: err}
+		}
+	})
+}
+
+//runChecks runs checks that must pass before any step runs.
+func (i *installer) runChecks() error {
+	if err := i.checkMode(); err != nil {
+		return err
+	}
+	for _, check := range i.checks {
+		if err := check(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//checkError is a failed check along with
+//a message displayed to the user.
+type checkError struct {
+	msg string
+	err error
+}
+
+func (e *checkError) Error() string {
+	return e.msg
+}
+
+func (e *checkError) Unwrap() error {
+	return e.err
+}
+
+//ReadFile reads file name of the payload, failing with a *ChecksumError
+//if its content does not match the checksum held by the index.
+func (p *Payload) ReadFile(name string) ([]byte, error) {
+	f, err := p.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	content, err := io.ReadAll(f)
+	if err != nil {
+		return nil, err
+	}
+	if sum, ok := p.Checksum(name); ok {
+		h := sha256.Sum256(content)
+		if actual := hex.EncodeToString(h[:]); actual != sum {
+			return nil, &ChecksumError{File: name, Expected: sum, Actual: actual}
+		}
+	}
+	return content, nil
+}
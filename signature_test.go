@@ -0,0 +1,166 @@
+package installer
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"errors"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+)
+
+//appendTestPayload appends files to a fake executable
+//and returns its path.
+func appendTestPayload(t *testing.T, files fstest.MapFS) string {
+	t.Helper()
+	exe := filepath.Join(t.TempDir(), "setup")
+	if err := ioutil.WriteFile(exe, []byte("executable"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := AppendPayload(exe, files); err != nil {
+		t.Fatal(err)
+	}
+	return exe
+}
+
+//replaceInFile replaces the first occurrence of old in file.
+func replaceInFile(t *testing.T, file, old, new string) {
+	t.Helper()
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(content, []byte(old)) {
+		t.Fatalf("%s not found in %s", old, file)
+	}
+	content = bytes.Replace(content, []byte(old), []byte(new), 1)
+	if err := ioutil.WriteFile(file, content, 0755); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	publicKey, privateKey, _ := ed25519.GenerateKey(nil)
+	otherKey, _, _ := ed25519.GenerateKey(nil)
+	tests := []struct {
+		name   string
+		sign   bool
+		key    ed25519.PublicKey
+		tamper bool
+		want   error
+	}{
+		{name: "signed", sign: true, key: publicKey},
+		{name: "not signed", key: publicKey, want: ErrPayloadNotSigned},
+		{name: "other key", sign: true, key: otherKey, want: ErrInvalidSignature},
+		{name: "index modified", sign: true, key: publicKey, tamper: true, want: ErrInvalidSignature},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exe := appendTestPayload(t, fstest.MapFS{"a.txt": {Data: []byte("hello")}})
+			if tt.sign {
+				if err := SignPayload(exe, privateKey); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tt.tamper {
+				replaceInFile(t, exe, `"a.txt"`, `"b.txt"`)
+			}
+			p, err := OpenPayloadFile(exe)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer p.Close()
+			if err := p.VerifySignature(tt.key); err != tt.want {
+				t.Errorf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestSignPayloadReplacesSignature(t *testing.T) {
+	publicKey, privateKey, _ := ed25519.GenerateKey(nil)
+	_, otherKey, _ := ed25519.GenerateKey(nil)
+	exe := appendTestPayload(t, fstest.MapFS{"a.txt": {Data: []byte("hello")}})
+	for _, key := range []ed25519.PrivateKey{otherKey, privateKey} {
+		if err := SignPayload(exe, key); err != nil {
+			t.Fatal(err)
+		}
+	}
+	p, err := OpenPayloadFile(exe)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+	if err := p.VerifySignature(publicKey); err != nil {
+		t.Error(err)
+	}
+}
+
+//TestSignedPayloadContentModified checks files copied from a signed
+//payload are verified, even from one of its directories.
+func TestSignedPayloadContentModified(t *testing.T) {
+	publicKey, privateKey, _ := ed25519.GenerateKey(nil)
+	exe := appendTestPayload(t, fstest.MapFS{
+		"definition.yml":  {Data: []byte("title: test")},
+		"payload/app.txt": {Data: []byte("GOODGOOD")},
+	})
+	if err := SignPayload(exe, privateKey); err != nil {
+		t.Fatal(err)
+	}
+	replaceInFile(t, exe, "GOODGOOD", "GOODGOOE")
+	p, err := OpenPayloadFile(exe)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+	sub, err := fs.Sub(p, "payload")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dst := filepath.Join(t.TempDir(), "app")
+	i := New("test")
+	i.RequireSignedPayload(p, publicKey)
+	i.AddStepCopyFS(dst, sub, ".")
+	err = i.Run("test", []string{"--silent"})
+	var checksumErr *ChecksumError
+	if !errors.As(err, &checksumErr) {
+		t.Fatalf("got %v, want a checksum error", err)
+	}
+	if _, err := os.Stat(filepath.Join(dst, "app.txt")); !os.IsNotExist(err) {
+		t.Errorf("modified file installed: %v", err)
+	}
+}
+
+func TestPayloadReadFile(t *testing.T) {
+	exe := appendTestPayload(t, fstest.MapFS{"definition.yml": {Data: []byte("title: test")}})
+	replaceInFile(t, exe, "title: test", "title: evil")
+	p, err := OpenPayloadFile(exe)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+	_, err = p.ReadFile("definition.yml")
+	var checksumErr *ChecksumError
+	if !errors.As(err, &checksumErr) {
+		t.Errorf("got %v, want a checksum error", err)
+	}
+}
+
+func TestParsePublicKey(t *testing.T) {
+	tests := []struct {
+		key     string
+		wantErr bool
+	}{
+		{key: "nQ/65o1zbsKXA0Q4vIv9KNaiq8qjsq+p+DfprOfd2Ok=\n"},
+		{key: "not base64", wantErr: true},
+		{key: "aGVsbG8=", wantErr: true},
+	}
+	for _, tt := range tests {
+		if _, err := ParsePublicKey(tt.key); (err != nil) != tt.wantErr {
+			t.Errorf("ParsePublicKey(%q) error %v, want error %v", tt.key, err, tt.wantErr)
+		}
+	}
+}
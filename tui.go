@@ -0,0 +1,423 @@
+package installer
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultTUIPageSize = 20
+
+var (
+	htmlBreakRegexp = regexp.MustCompile(`(?i)<br\s*/?>|</p>|</li>|</h[1-6]>|</div>`)
+	htmlTagRegexp   = regexp.MustCompile(`<[^>]*>`)
+	blankLineRegexp = regexp.MustCompile(`\n{3,}`)
+	spinnerFrames   = []string{"|", "/", "-", "\\"}
+)
+
+//tui is a text-based frontend used when no display
+//is available to open the installer window.
+type tui struct {
+	//ctx is done once the user interrupts the installer
+	ctx      context.Context
+	in       *bufio.Reader
+	out      io.Writer
+	pageSize int
+}
+
+func newTUI(ctx context.Context, in io.Reader, out io.Writer) *tui {
+	return &tui{
+		ctx:      ctx,
+		in:       bufio.NewReader(in),
+		out:      out,
+		pageSize: tuiPageSize(),
+	}
+}
+
+//tuiPageSize reads terminal height from $LINES,
+//keeping a few lines for the prompt.
+func tuiPageSize() int {
+	lines, err := strconv.Atoi(os.Getenv("LINES"))
+	if err != nil || lines <= 5 {
+		return defaultTUIPageSize
+	}
+	return lines - 3
+}
+
+//runTUI displays conditions page by page, asks the user
+//to accept them and then processes steps while displaying
+//their progress.
+func (i *installer) runTUI(ctx context.Context, in io.Reader, out io.Writer) error {
+	t := newTUI(ctx, in, out)
+	if err := i.runChecks(); err != nil {
+		fmt.Fprintln(out, err)
+		return err
+	}
+	fmt.Fprintf(out, "%s\n\n", stripHTML(i.headline()))
+	if len(i.conditions) > 0 {
+		if err := t.readConditions(i.conditions, i.mustReadAllConditions); err != nil {
+			return err
+		}
+		if !t.confirm(i.texts.AcceptButton) {
+			return errors.New("conditions not accepted")
+		}
+	}
+	if len(i.components) > 0 {
+		if err := t.selectComponents(i.components); err != nil {
+			return err
+		}
+	}
+	if i.installDir != nil {
+		if err := t.selectInstallDir(i); err != nil {
+			return err
+		}
+		//the install record may be in the chosen install dir
+		if err := i.checkMode(); err != nil {
+			fmt.Fprintln(out, err)
+			return err
+		}
+	}
+	for _, form := range i.forms {
+		if err := t.fillForm(i, form); err != nil {
+			return err
+		}
+	}
+	i.describeSteps()
+	err := t.runSteps(ctx, i.steps, i.texts)
+	if err == ErrCancelled {
+		fmt.Fprintf(out, "\n%s\n", stripHTML(i.texts.Cancelled))
+		return err
+	}
+	if err != nil {
+		fmt.Fprintf(out, "\n%s\n", stripHTML(i.texts.Fail))
+		return err
+	}
+	fmt.Fprintf(out, "\n%s\n", stripHTML(i.texts.Success))
+	return nil
+}
+
+//readConditions pages through all conditions.
+//Unless mustReadAll is set, the user may skip remaining
+//pages by typing "a".
+func (t *tui) readConditions(conditions []condition, mustReadAll bool) error {
+	lines := conditionLines(conditions)
+	for start := 0; start < len(lines); start += t.pageSize {
+		end := start + t.pageSize
+		if end >= len(lines) {
+			t.printLines(lines[start:])
+			return nil
+		}
+		t.printLines(lines[start:end])
+		answer, err := t.prompt(t.morePrompt(mustReadAll, start, len(lines)))
+		if err != nil {
+			return err
+		}
+		if !mustReadAll && strings.EqualFold(answer, "a") {
+			return nil
+		}
+	}
+	return nil
+}
+
+//selectComponents lets the user toggle components
+//by typing their number, until an empty line is entered.
+func (t *tui) selectComponents(components []*component) error {
+	for {
+		fmt.Fprintln(t.out)
+		for index, c := range components {
+			fmt.Fprintf(t.out, "  %d. [%s] %s - %s\n", index+1, checkMark(c.Selected), c.Title, stripHTML(c.Description))
+		}
+		answer, err := t.prompt(fmt.Sprintf("1-%d [Enter] :", len(components)))
+		if err != nil {
+			return err
+		}
+		if answer == "" {
+			return nil
+		}
+		n, err := strconv.Atoi(answer)
+		if err == nil && n >= 1 && n <= len(components) {
+			components[n-1].Selected = !components[n-1].Selected
+		}
+	}
+}
+
+//selectInstallDir asks the user for the install directory
+//until a valid one is entered, an empty line keeping the default.
+func (t *tui) selectInstallDir(i *installer) error {
+	for {
+		answer, err := t.prompt(fmt.Sprintf("\n%s [%s] :", i.texts.InstallDir, i.installDir.Default))
+		if err != nil {
+			return err
+		}
+		if answer == "" {
+			answer = i.installDir.Default
+		}
+		err = i.SelectInstallDir(answer)
+		if err == nil {
+			return nil
+		}
+		fmt.Fprintln(t.out, err)
+	}
+}
+
+//fillForm asks the user for each field of form until
+//a valid value is entered, an empty line keeping the current one.
+func (t *tui) fillForm(i *installer, form *form) error {
+	fmt.Fprintf(t.out, "\n%s\n", stripHTML(form.Title))
+	for _, f := range form.Fields {
+		for {
+			answer, err := t.prompt(fieldPrompt(f, i.values[f.Name]))
+			if err != nil {
+				return err
+			}
+			if answer == "" {
+				answer = i.values[f.Name]
+			}
+			if f.Type == FieldCheckbox {
+				answer = checkboxValue(answer)
+			}
+			err = i.SetValue(f.Name, answer)
+			if err == nil {
+				break
+			}
+			fmt.Fprintln(t.out, err)
+		}
+	}
+	return nil
+}
+
+func fieldPrompt(f Field, value string) string {
+	msg := f.Label
+	switch f.Type {
+	case FieldSelect:
+		msg += " (" + strings.Join(f.Options, "/") + ")"
+	case FieldCheckbox:
+		msg += " (y/n)"
+	}
+	return fmt.Sprintf("%s [%s] :", msg, displayValue(f, value))
+}
+
+//checkboxValue converts a yes/no answer to a checkbox value.
+func checkboxValue(answer string) string {
+	switch strings.ToLower(answer) {
+	case "y", "yes", "o", "oui":
+		return "true"
+	case "n", "no", "non":
+		return "false"
+	}
+	return answer
+}
+
+func checkMark(checked bool) string {
+	if checked {
+		return "x"
+	}
+	return " "
+}
+
+func (t *tui) morePrompt(mustReadAll bool, start, total int) string {
+	percent := (start + t.pageSize) * 100 / total
+	if mustReadAll {
+		return fmt.Sprintf("-- %d%% [Enter] --", percent)
+	}
+	return fmt.Sprintf("-- %d%% [Enter, a] --", percent)
+}
+
+func (t *tui) confirm(question string) bool {
+	answer, err := t.prompt(question + " [y/N]")
+	if err != nil {
+		return false
+	}
+	answer = strings.ToLower(answer)
+	return answer == "y" || answer == "yes" || answer == "o" || answer == "oui"
+}
+
+//prompt waits for a line typed by the user,
+//unless the installer is interrupted meanwhile.
+func (t *tui) prompt(msg string) (string, error) {
+	fmt.Fprintf(t.out, "%s ", msg)
+	type line struct {
+		answer string
+		err    error
+	}
+	read := make(chan line, 1)
+	go func() {
+		answer, err := t.in.ReadString('\n')
+		read <- line{answer, err}
+	}()
+	select {
+	case <-t.ctx.Done():
+		fmt.Fprintln(t.out)
+		return "", ErrCancelled
+	case l := <-read:
+		if l.err != nil && l.answer == "" {
+			return "", l.err
+		}
+		return strings.TrimSpace(l.answer), nil
+	}
+}
+
+func (t *tui) printLines(lines []string) {
+	for _, l := range lines {
+		fmt.Fprintln(t.out, l)
+	}
+}
+
+//runSteps processes steps one after the other, a spinner
+//being displayed in front of the running one.
+func (t *tui) runSteps(ctx context.Context, steps []step, txt *texts) error {
+	fmt.Fprintf(t.out, "\n%s\n", txt.CompletedSteps)
+	skipped := map[int]bool{}
+	for index := 0; index < len(steps); index++ {
+		result, err := t.runStep(ctx, steps[index])
+		if err == nil {
+			if result.Status != statusDone {
+				skipped[index] = true
+			}
+			continue
+		}
+		fmt.Fprintf(t.out, "    %v\n", err)
+		switch t.askOnFailure(err, txt) {
+		case retryStep:
+			index--
+		case skipStep:
+			skipped[index] = true
+		default:
+			steps = withoutUndo(steps, skipped)
+			return withRollbackErr(err, t.rollback(steps, index-1, txt))
+		}
+	}
+	commitSteps(steps)
+	return nil
+}
+
+type failureChoice int
+
+const (
+	abortInstall failureChoice = iota
+	retryStep
+	skipStep
+)
+
+//askOnFailure lets the user choose to retry a failed
+//step, skip it or abort the installation.
+func (t *tui) askOnFailure(err error, txt *texts) failureChoice {
+	if err == ErrCancelled {
+		return abortInstall
+	}
+	question := fmt.Sprintf("%s (r) / %s (s) / %s (A) ?", txt.Retry, txt.Skip, txt.Abort)
+	answer, err := t.prompt(question)
+	if err != nil {
+		return abortInstall
+	}
+	switch strings.ToLower(answer) {
+	case "r":
+		return retryStep
+	case "s":
+		return skipStep
+	}
+	return abortInstall
+}
+
+func (t *tui) runStep(ctx context.Context, s step) (stepResult, error) {
+	var progress tuiProgress
+	var result stepResult
+	done := make(chan error)
+	go func() {
+		var err error
+		result, err = runStep(ctx, s, progress.set)
+		done <- err
+	}()
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for frame := 0; ; frame++ {
+		select {
+		case err := <-done:
+			t.printStepStatus(stepStatusMark(result, err), s.Description)
+			fmt.Fprintln(t.out)
+			if result.Status == statusWarning {
+				fmt.Fprintf(t.out, "    %s\n", result.Message)
+			}
+			return result, err
+		case <-ticker.C:
+			mark := spinnerFrames[frame%len(spinnerFrames)]
+			t.printStepStatus(mark, s.Description+progress.String())
+		}
+	}
+}
+
+//tuiProgress holds the last progress reported by a running step.
+type tuiProgress struct {
+	mu       sync.Mutex
+	reported bool
+	fraction float64
+	status   string
+}
+
+func (p *tuiProgress) set(fraction float64, status string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.reported, p.fraction, p.status = true, fraction, status
+}
+
+func (p *tuiProgress) String() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.reported {
+		return ""
+	}
+	return fmt.Sprintf(" %3.0f%% %s", p.fraction*100, p.status)
+}
+
+func (t *tui) rollback(steps []step, last int, txt *texts) error {
+	fmt.Fprintf(t.out, "\n%s\n", txt.Rollback)
+	return rollbackSteps(steps, last, func(index int) {
+		fmt.Fprintf(t.out, "  [<] %s\n", steps[index].Description)
+	})
+}
+
+//printStepStatus rewrites the current line,
+//clearing what was displayed before.
+func (t *tui) printStepStatus(mark, desc string) {
+	fmt.Fprintf(t.out, "\r\x1b[K  [%s] %s", mark, desc)
+}
+
+func stepStatusMark(result stepResult, err error) string {
+	switch {
+	case err != nil:
+		return "✗"
+	case result.Status == statusSkipped:
+		return "-"
+	case result.Status == statusWarning:
+		return "!"
+	}
+	return "✓"
+}
+
+func conditionLines(conditions []condition) []string {
+	var lines []string
+	for _, c := range conditions {
+		lines = append(lines, strings.ToUpper(stripHTML(c.Title)), "")
+		lines = append(lines, strings.Split(stripHTML(c.Body), "\n")...)
+		lines = append(lines, "")
+	}
+	return lines
+}
+
+//stripHTML turns HTML text into plain text fit for a terminal.
+func stripHTML(s string) string {
+	s = htmlBreakRegexp.ReplaceAllString(s, "\n")
+	s = htmlTagRegexp.ReplaceAllString(s, "")
+	s = html.UnescapeString(s)
+	s = blankLineRegexp.ReplaceAllString(s, "\n\n")
+	return strings.TrimSpace(s)
+}
@@ -0,0 +1,106 @@
+package installer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+//longCondition returns a condition body spanning pages
+//of the terminal, and how many times the user is prompted
+//for more while reading it.
+func longCondition() (string, int) {
+	body := strings.Repeat("line\n", 3*tuiPageSize())
+	lines := len(conditionLines([]condition{{Title: "license", Body: body}}))
+	return body, (lines - 1) / tuiPageSize()
+}
+
+func TestTUIConditions(t *testing.T) {
+	body, more := longCondition()
+	tests := []struct {
+		name        string
+		mustReadAll bool
+		input       string
+		wantErr     bool
+	}{
+		{name: "paged", mustReadAll: true, input: strings.Repeat("\n", more) + "y\n"},
+		{name: "all skipped", input: "a\ny\n"},
+		//if a skipped remaining pages, an empty line would decline conditions
+		{name: "all not skipped", mustReadAll: true, input: "a\n" + strings.Repeat("\n", more-1) + "y\n"},
+		{name: "declined", mustReadAll: true, input: strings.Repeat("\n", more) + "n\n", wantErr: true},
+		{name: "no answer", mustReadAll: true, input: strings.Repeat("\n", more), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := New("test")
+			i.SetMustReadAllConditions(tt.mustReadAll)
+			i.AddCondition("license", body)
+			installed := false
+			i.AddStep(func() error {
+				installed = true
+				return nil
+			}, "step")
+			var out bytes.Buffer
+			err := i.runTUI(context.Background(), strings.NewReader(tt.input), &out)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got %v", err)
+			}
+			if installed == tt.wantErr {
+				t.Fatalf("got installed %v", installed)
+			}
+			if skippable := strings.Contains(out.String(), "[Enter, a]"); skippable == tt.mustReadAll {
+				t.Fatalf("got conditions skippable %v", skippable)
+			}
+		})
+	}
+}
+
+func TestTUIFailureChoices(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+		want    []string
+	}{
+		{name: "retry", input: "r\n", want: []string{"install first", "install failing", "install failing", "install last"}},
+		{name: "skip", input: "s\n", want: []string{"install first", "install failing", "install last"}},
+		{name: "abort", input: "a\n", wantErr: true, want: []string{"install first", "install failing", "rollback first"}},
+		{name: "default", input: "\n", wantErr: true, want: []string{"install first", "install failing", "rollback first"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []string
+			i := New("test")
+			add := func(name string, process func() error) {
+				i.AddStepWithRollback(func() error {
+					calls = append(calls, "install "+name)
+					return process()
+				}, func() error {
+					calls = append(calls, "rollback "+name)
+					return nil
+				}, name)
+			}
+			add("first", func() error { return nil })
+			failed := false
+			add("failing", func() error {
+				if failed {
+					return nil
+				}
+				failed = true
+				return errors.New("failed")
+			})
+			add("last", func() error { return nil })
+			var out bytes.Buffer
+			err := i.runTUI(context.Background(), strings.NewReader(tt.input), &out)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got %v", err)
+			}
+			if !reflect.DeepEqual(calls, tt.want) {
+				t.Fatalf("got %v, want %v", calls, tt.want)
+			}
+		})
+	}
+}
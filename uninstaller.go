@@ -0,0 +1,121 @@
+package installer
+
+import (
+	"io"
+	"os"
+)
+
+//NewUninstaller creates an installer reverting what has been
+//recorded in the install manifest saved at manifestPath.
+//
+//Entries are reverted in reverse order :
+//
+//- files are deleted unless their content changed since installation
+//- directories are deleted if they are empty
+//- schemes, shortcuts and uninstall options are deleted
+//
+//Items that already existed before installation are left untouched.
+//The manifest itself is deleted once every entry is reverted.
+//
+//It is displayed like any installer, conditions may be added
+//before opening its window.
+func NewUninstaller(manifestPath string) (*installer, error) {
+	m, err := LoadManifest(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+	i := New("")
+	i.title = i.getUninstallerTitleText()
+	for index := len(m.Entries) - 1; index >= 0; index-- {
+		i.addUninstallEntryStep(m.Entries[index])
+	}
+	process := func() error { return rmvPath(manifestPath) }
+	i.AddStep(process, i.getRemoveManifestText())
+	return i, nil
+}
+
+func (i *installer) addUninstallEntryStep(e ManifestEntry) {
+	switch e.Kind {
+	case KindFile:
+		if !e.Existed {
+			i.addStepRmvUnchangedFile(e.Path, e.Checksum)
+		}
+	case KindDir:
+		if !e.Existed {
+			i.addStepRmvEmptyDir(e.Path)
+		}
+	case KindShortcut, KindSymlink:
+		if !e.Existed {
+			i.addStepRmvFile(e.Path)
+		}
+	case KindScheme:
+		if !e.Existed {
+			i.AddStepDeleteScheme(e.Path)
+		}
+	case KindUninstallOpt:
+		i.addStepDeleteUninstallOpt(e.Path)
+	}
+}
+
+func (i *installer) addStepRmvUnchangedFile(file, sum string) {
+	process := func() error { return rmvUnchangedFile(file, sum) }
+	i.AddStep(process, i.getDeleteFileText(file))
+}
+
+func (i *installer) addStepRmvFile(file string) {
+	process := func() error { return rmvFile(file) }
+	i.AddStep(process, i.getDeleteFileText(file))
+}
+
+func (i *installer) addStepRmvEmptyDir(dirPath string) {
+	process := func() error { return rmvEmptyDir(dirPath) }
+	i.AddStep(process, i.getRmvDirText(dirPath))
+}
+
+//rmvUnchangedFile deletes file only if its content
+//still matches the checksum recorded at installation.
+func rmvUnchangedFile(file, sum string) error {
+	current, err := checksumFile(file)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if current != sum {
+		return nil
+	}
+	return rmvFile(file)
+}
+
+func rmvFile(file string) error {
+	err := os.Remove(file)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func rmvEmptyDir(dirPath string) error {
+	empty, err := isEmptyDir(dirPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil || !empty {
+		return err
+	}
+	return os.Remove(dirPath)
+}
+
+func isEmptyDir(dirPath string) (bool, error) {
+	d, err := os.Open(dirPath)
+	if err != nil {
+		return false, err
+	}
+	defer d.Close()
+	_, err = d.Readdirnames(1)
+	if err == io.EOF {
+		return true, nil
+	}
+	return false, err
+}
@@ -0,0 +1,86 @@
+package installer
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestUninstaller(t *testing.T) {
+	root := t.TempDir()
+	path := func(name string) string { return filepath.Join(root, name) }
+	for _, dir := range []string{"app", "app/empty", "app/kept", "existing"} {
+		if err := os.Mkdir(path(dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	files := map[string]string{
+		"app/installed":  "installed",
+		"app/modified":   "modified",
+		"app/kept/user":  "created by the user",
+		"existing/file":  "existed",
+		"existing/other": "other",
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(path(name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	m := &Manifest{}
+	m.add(
+		ManifestEntry{Kind: KindDir, Path: path("app")},
+		ManifestEntry{Kind: KindDir, Path: path("app/empty")},
+		ManifestEntry{Kind: KindDir, Path: path("app/kept")},
+		ManifestEntry{Kind: KindDir, Path: path("existing"), Existed: true},
+		ManifestEntry{Kind: KindFile, Path: path("app/installed"), Checksum: checksum("installed")},
+		ManifestEntry{Kind: KindFile, Path: path("app/modified"), Checksum: checksum("as installed")},
+		ManifestEntry{Kind: KindFile, Path: path("app/missing"), Checksum: checksum("missing")},
+		ManifestEntry{Kind: KindFile, Path: path("existing/file"), Checksum: checksum("existed"), Existed: true},
+		ManifestEntry{Kind: KindScheme, Path: "existing-scheme", Existed: true},
+	)
+	manifestPath := path("manifest.json")
+	if err := m.save(manifestPath); err != nil {
+		t.Fatal(err)
+	}
+	i, err := NewUninstaller(manifestPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := i.Run("test", []string{"--silent"}); err != nil {
+		t.Fatal(err)
+	}
+	for name, removed := range map[string]bool{
+		"app/installed":  true,
+		"app/empty":      true,
+		"app/modified":   false,
+		"app/kept":       false,
+		"app/kept/user":  false,
+		"app":            false,
+		"existing":       false,
+		"existing/file":  false,
+		"existing/other": false,
+		"manifest.json":  true,
+	} {
+		if _, err := os.Lstat(path(name)); os.IsNotExist(err) != removed {
+			t.Errorf("%s: got removed %v, want %v", name, os.IsNotExist(err), removed)
+		}
+	}
+}
+
+func TestUninstallerSkipsExistingItems(t *testing.T) {
+	entries := []ManifestEntry{
+		{Kind: KindFile, Path: "file", Existed: true},
+		{Kind: KindDir, Path: "dir", Existed: true},
+		{Kind: KindSymlink, Path: "link", Existed: true},
+		{Kind: KindShortcut, Path: "shortcut", Existed: true},
+		{Kind: KindScheme, Path: "scheme", Existed: true},
+	}
+	for _, e := range entries {
+		i := New("test")
+		i.addUninstallEntryStep(e)
+		if len(i.steps) != 0 {
+			t.Errorf("%s %s: got %d steps, want none", e.Kind, e.Path, len(i.steps))
+		}
+	}
+}
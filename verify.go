@@ -0,0 +1,202 @@
+package installer
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"strings"
+)
+
+//ChecksumFS is a file system knowing the SHA-256 checksum
+//of its files, such as a Payload. Files copied from it are
+//hashed while being written and a mismatch fails the step.
+type ChecksumFS interface {
+	fs.FS
+	//Checksum returns the hex encoded SHA-256 checksum of
+	//file name, false if it is unknown.
+	Checksum(name string) (string, bool)
+}
+
+//FSWithChecksums returns fsys along with the SHA-256 checksums
+//of its files by name, see ParseChecksums.
+func FSWithChecksums(fsys fs.FS, sums map[string]string) ChecksumFS {
+	return &checksumFS{FS: fsys, sums: sums}
+}
+
+type checksumFS struct {
+	fs.FS
+	sums map[string]string
+}
+
+func (c *checksumFS) Checksum(name string) (string, bool) {
+	sum, ok := c.sums[name]
+	return sum, ok
+}
+
+//Sub keeps checksums of files found in dir, see fs.Sub.
+func (c *checksumFS) Sub(dir string) (fs.FS, error) {
+	return subChecksumFS(c, c.FS, dir)
+}
+
+//subChecksumFS returns dir of fsys, fsys being parent without
+//its Sub method, along with the checksums parent knows. Without
+//it, fs.Sub would hide them and files would not be verified.
+func subChecksumFS(parent ChecksumFS, fsys fs.FS, dir string) (fs.FS, error) {
+	sub, err := fs.Sub(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+	if dir == "." {
+		return parent, nil
+	}
+	return &subFS{FS: sub, parent: parent, dir: dir}, nil
+}
+
+//subFS is a directory of a ChecksumFS.
+type subFS struct {
+	fs.FS
+	parent ChecksumFS
+	dir    string
+}
+
+func (s *subFS) Checksum(name string) (string, bool) {
+	return s.parent.Checksum(path.Join(s.dir, name))
+}
+
+func (s *subFS) Sub(dir string) (fs.FS, error) {
+	return subChecksumFS(s, s.FS, dir)
+}
+
+//ParseChecksums parses checksums in the format of sha256sum,
+//a hex encoded checksum and a file name per line.
+func ParseChecksums(r io.Reader) (map[string]string, error) {
+	sums := map[string]string{}
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		fields := strings.SplitN(text, " ", 2)
+		if len(fields) != 2 || len(fields[0]) != 64 {
+			return nil, fmt.Errorf("invalid checksum line %d", line)
+		}
+		//a leading * marks files hashed in binary mode
+		name := strings.TrimPrefix(strings.TrimSpace(fields[1]), "*")
+		sums[path.Clean(strings.TrimPrefix(name, "./"))] = strings.ToLower(fields[0])
+	}
+	return sums, scanner.Err()
+}
+
+//checksumLookup returns the checksum of files found in root
+//of fsys, or an empty one if fsys does not know them.
+func checksumLookup(fsys fs.FS, root string) func(name string) string {
+	c, ok := fsys.(ChecksumFS)
+	return func(name string) string {
+		if !ok {
+			return ""
+		}
+		sum, _ := c.Checksum(path.Join(root, name))
+		return sum
+	}
+}
+
+//ChecksumError is returned when the content of a file
+//does not match its expected SHA-256 checksum.
+type ChecksumError struct {
+	File     string
+	Expected string
+	Actual   string
+}
+
+func (e *ChecksumError) Error() string {
+	return fmt.Sprintf("%s is corrupted: expected SHA-256 %s, got %s", e.File, e.Expected, e.Actual)
+}
+
+//VerifyError lists installed files missing or modified
+//since installation.
+type VerifyError struct {
+	Missing  []string
+	Modified []string
+}
+
+func (e *VerifyError) Error() string {
+	var problems []string
+	if len(e.Missing) > 0 {
+		problems = append(problems, "missing: "+strings.Join(e.Missing, ", "))
+	}
+	if len(e.Modified) > 0 {
+		problems = append(problems, "modified: "+strings.Join(e.Modified, ", "))
+	}
+	return "installation verification failed, " + strings.Join(problems, "; ")
+}
+
+//VerifyInstallation re-checks files recorded in the manifest
+//saved at manifestPath, see Manifest.Verify.
+func VerifyInstallation(manifestPath string) error {
+	m, err := LoadManifest(manifestPath)
+	if err != nil {
+		return err
+	}
+	return m.Verify()
+}
+
+//Verify re-checks installed files and directories, and returns
+//a *VerifyError listing those missing or whose content changed
+//since installation.
+func (m *Manifest) Verify() error {
+	m.mu.Lock()
+	entries := lastEntries(m.Entries)
+	m.mu.Unlock()
+	verr := &VerifyError{}
+	for _, e := range entries {
+		switch e.Kind {
+		case KindFile, KindDir, KindSymlink, KindShortcut:
+		default:
+			continue
+		}
+		if !pathExists(e.Path) {
+			verr.Missing = append(verr.Missing, e.Path)
+			continue
+		}
+		if e.Kind != KindFile || e.Checksum == "" {
+			continue
+		}
+		sum, err := checksumFile(e.Path)
+		if err != nil {
+			return err
+		}
+		if sum != e.Checksum {
+			verr.Modified = append(verr.Modified, e.Path)
+		}
+	}
+	if len(verr.Missing) > 0 || len(verr.Modified) > 0 {
+		return verr
+	}
+	return nil
+}
+
+//lastEntries returns entries keeping only the last
+//one recorded for each path, in their original order.
+func lastEntries(entries []ManifestEntry) []ManifestEntry {
+	last := map[string]int{}
+	for index, e := range entries {
+		last[string(e.Kind)+":"+e.Path] = index
+	}
+	var kept []ManifestEntry
+	for index, e := range entries {
+		if last[string(e.Kind)+":"+e.Path] == index {
+			kept = append(kept, e)
+		}
+	}
+	return kept
+}
+
+//AddStepVerifyInstallation adds a step re-checking files
+//installed by previous steps, see Manifest.Verify.
+func (i *installer) AddStepVerifyInstallation() {
+	process := func() error { return i.manifest.Verify() }
+	i.AddStep(process, i.getVerifyInstallationText())
+}
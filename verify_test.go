@@ -0,0 +1,107 @@
+package installer
+
+import (
+	"errors"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestParseChecksums(t *testing.T) {
+	sum := checksum("content")
+	tests := []struct {
+		name    string
+		input   string
+		want    map[string]string
+		wantErr bool
+	}{
+		{name: "text mode", input: sum + "  app/bin\n", want: map[string]string{"app/bin": sum}},
+		{name: "binary mode", input: sum + " *./app/bin\n\n", want: map[string]string{"app/bin": sum}},
+		{name: "upper case", input: strings.ToUpper(sum) + "  bin", want: map[string]string{"bin": sum}},
+		{name: "invalid checksum", input: "1234  bin", wantErr: true},
+		{name: "missing name", input: sum, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseChecksums(strings.NewReader(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got %v", err)
+			}
+			if err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCopyFSChecksums(t *testing.T) {
+	files := fstest.MapFS{"payload/app/bin": {Data: []byte("content")}}
+	tests := []struct {
+		name    string
+		sum     string
+		wantErr bool
+	}{
+		{name: "matching", sum: checksum("content")},
+		{name: "corrupted", sum: checksum("other content"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fsys, err := fs.Sub(FSWithChecksums(files, map[string]string{"payload/app/bin": tt.sum}), "payload")
+			if err != nil {
+				t.Fatal(err)
+			}
+			dst := filepath.Join(t.TempDir(), "dst")
+			i := New("test")
+			i.AddStepCopyFS(dst, fsys, "app")
+			err = i.Run("test", []string{"--silent"})
+			var checksumErr *ChecksumError
+			if errors.As(err, &checksumErr) != tt.wantErr {
+				t.Fatalf("got %v", err)
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatal(err)
+			}
+			if _, err := os.Stat(filepath.Join(dst, "bin")); os.IsNotExist(err) != tt.wantErr {
+				t.Fatalf("got %v", err)
+			}
+		})
+	}
+}
+
+func TestManifestVerify(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{"kept": "kept", "modified": "modified", "missing": "missing"}
+	m := &Manifest{}
+	for name, content := range files {
+		file := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(file, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		m.add(ManifestEntry{Kind: KindFile, Path: file, Checksum: checksum(content)})
+	}
+	m.add(ManifestEntry{Kind: KindScheme, Path: "app"})
+	if err := m.Verify(); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "modified"), []byte("changed"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(filepath.Join(dir, "missing")); err != nil {
+		t.Fatal(err)
+	}
+	var verr *VerifyError
+	if err := m.Verify(); !errors.As(err, &verr) {
+		t.Fatalf("got %v, want a verify error", err)
+	}
+	if want := []string{filepath.Join(dir, "missing")}; !reflect.DeepEqual(verr.Missing, want) {
+		t.Errorf("got %v missing, want %v", verr.Missing, want)
+	}
+	if want := []string{filepath.Join(dir, "modified")}; !reflect.DeepEqual(verr.Modified, want) {
+		t.Errorf("got %v modified, want %v", verr.Modified, want)
+	}
+}
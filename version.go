@@ -0,0 +1,226 @@
+package installer
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+//InstallMode is how the installer runs, depending on the version
+//of the product already installed, see SetVersion.
+type InstallMode string
+
+const (
+	//ModeFresh installs a product not installed yet.
+	ModeFresh InstallMode = "fresh"
+	//ModeUpgrade replaces an older installed version.
+	ModeUpgrade InstallMode = "upgrade"
+	//ModeDowngrade would replace a newer installed version,
+	//the installer refuses to proceed.
+	ModeDowngrade InstallMode = "downgrade"
+	//ModeRepair installs the version already installed again.
+	ModeRepair InstallMode = "repair"
+)
+
+//ErrDowngrade is returned when the version installed
+//is newer than the one being installed.
+var ErrDowngrade = errors.New("a newer version is already installed")
+
+//SetVersion sets the version of the product being installed.
+//It is recorded in the manifest, so the next installer run
+//knows which version is installed and in which mode to run,
+//see Mode.
+func (i *installer) SetVersion(version string) {
+	i.version = version
+	i.mode = ""
+}
+
+//InstalledVersion returns the version recorded by a previous
+//installation in the manifest, see SetManifestPath.
+//It is empty if the product is not installed.
+func (i *installer) InstalledVersion() string {
+	m := i.installedManifest()
+	if m == nil {
+		return ""
+	}
+	return m.Version
+}
+
+//installedManifest returns the manifest saved by
+//a previous installation, nil if there is none.
+func (i *installer) installedManifest() *Manifest {
+	if i.manifestPath == "" {
+		return nil
+	}
+	m, err := LoadManifest(i.expand(i.manifestPath))
+	if err != nil {
+		return nil
+	}
+	return m
+}
+
+//Mode returns how the installer runs, comparing the version being
+//installed to the one already installed. It is detected once more
+//when installation starts, and a downgrade then refuses to proceed.
+func (i *installer) Mode() InstallMode {
+	if i.mode == "" {
+		i.mode = i.detectMode()
+	}
+	return i.mode
+}
+
+func (i *installer) detectMode() InstallMode {
+	installed := i.InstalledVersion()
+	if installed == "" || i.version == "" {
+		return ModeFresh
+	}
+	switch c := compareVersions(i.version, installed); {
+	case c > 0:
+		return ModeUpgrade
+	case c < 0:
+		return ModeDowngrade
+	default:
+		return ModeRepair
+	}
+}
+
+//ForModes makes steps added while calling addSteps run only
+//in listed modes, so each mode has its own list of steps :
+//
+//	i.ForModes([]installer.InstallMode{installer.ModeFresh}, func() {
+//		i.AddStepRmkDir(dir)
+//	})
+//
+//Steps added outside of ForModes run in every mode.
+func (i *installer) ForModes(modes []InstallMode, addSteps ...func()) {
+	previous := i.currentModes
+	i.currentModes = modes
+	for _, add := range addSteps {
+		add()
+	}
+	i.currentModes = previous
+}
+
+//inModes makes s run only in the modes
+//steps are being added for.
+func (i *installer) inModes(s step) step {
+	modes := i.currentModes
+	if modes == nil {
+		return s
+	}
+	runIf := s.runIf
+	s.runIf = func() bool {
+		return containsMode(modes, i.Mode()) && (runIf == nil || runIf())
+	}
+	return s
+}
+
+func containsMode(modes []InstallMode, mode InstallMode) bool {
+	for _, m := range modes {
+		if m == mode {
+			return true
+		}
+	}
+	return false
+}
+
+//checkMode detects the mode once more before steps run,
+//refusing to replace a newer version. When upgrading or repairing,
+//the installed manifest is kept to be merged with the new one.
+func (i *installer) checkMode() error {
+	i.mode = i.detectMode()
+	i.previous = nil
+	if i.mode == ModeUpgrade || i.mode == ModeRepair {
+		i.previous = i.installedManifest()
+	}
+	if i.mode == ModeDowngrade {
+		return &checkError{msg: i.getDowngradeText(i.InstalledVersion(), i.version), err: ErrDowngrade}
+	}
+	return nil
+}
+
+//headline returns the title displayed to the user,
+//telling what the installer is about to do if the
+//product is already installed.
+func (i *installer) headline() string {
+	var text string
+	switch i.Mode() {
+	case ModeUpgrade:
+		text = i.getUpgradeText(i.InstalledVersion(), i.version)
+	case ModeRepair:
+		text = i.getRepairText(i.version)
+	case ModeDowngrade:
+		text = i.getDowngradeText(i.InstalledVersion(), i.version)
+	default:
+		return i.title
+	}
+	return i.title + "<p>" + text + "</p>"
+}
+
+//compareVersions compares versions such as 1.2.10 or v2.0.0-beta.1
+//number by number. A pre-release is lower than its release.
+//It returns a positive number if a is newer than b, a negative one
+//if it is older and 0 if they are the same.
+func compareVersions(a, b string) int {
+	a, aPre := splitPreRelease(a)
+	b, bPre := splitPreRelease(b)
+	if c := compareParts(a, b); c != 0 {
+		return c
+	}
+	switch {
+	case aPre == bPre:
+		return 0
+	case aPre == "":
+		return 1
+	case bPre == "":
+		return -1
+	}
+	return compareParts(aPre, bPre)
+}
+
+//splitPreRelease splits a version from its pre-release
+//and build suffixes, removing its v prefix.
+func splitPreRelease(v string) (string, string) {
+	v = strings.TrimPrefix(strings.TrimSpace(v), "v")
+	if index := strings.IndexByte(v, '+'); index >= 0 {
+		v = v[:index]
+	}
+	if index := strings.IndexByte(v, '-'); index >= 0 {
+		return v[:index], v[index+1:]
+	}
+	return v, ""
+}
+
+//compareParts compares dot separated parts of a and b,
+//numerically when both are numbers, missing parts being 0.
+func compareParts(a, b string) int {
+	aParts, bParts := strings.Split(a, "."), strings.Split(b, ".")
+	for index := 0; index < len(aParts) || index < len(bParts); index++ {
+		aPart, bPart := "0", "0"
+		if index < len(aParts) {
+			aPart = aParts[index]
+		}
+		if index < len(bParts) {
+			bPart = bParts[index]
+		}
+		if c := comparePart(aPart, bPart); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+func comparePart(a, b string) int {
+	aNum, aErr := strconv.Atoi(a)
+	bNum, bErr := strconv.Atoi(b)
+	switch {
+	case aErr == nil && bErr == nil:
+		return aNum - bNum
+	case aErr == nil:
+		//numbers are lower than identifiers
+		return -1
+	case bErr == nil:
+		return 1
+	}
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
@@ -0,0 +1,108 @@
+package installer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "1.0.0", b: "1.0.0", want: 0},
+		{a: "1.10", b: "1.9", want: 1},
+		{a: "v2.0.0", b: "2.0", want: 0},
+		{a: "1.2.3", b: "1.2.4", want: -1},
+		{a: "2.0.0-rc.1", b: "2.0.0", want: -1},
+		{a: "2.0.0-beta.2", b: "2.0.0-beta.10", want: -1},
+		{a: "2.0.0-beta", b: "2.0.0-alpha", want: 1},
+		{a: "2.0.0+build.1", b: "2.0.0", want: 0},
+	}
+	for _, tt := range tests {
+		got := compareVersions(tt.a, tt.b)
+		if got > 0 {
+			got = 1
+		} else if got < 0 {
+			got = -1
+		}
+		if got != tt.want {
+			t.Errorf("compareVersions(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+//installVersion installs files of version into dir,
+//recording them in manifestPath.
+func installVersion(t *testing.T, version, dir, manifestPath string, files map[string][]byte) *installer {
+	t.Helper()
+	i := New("test")
+	i.SetVersion(version)
+	i.SetManifestPath(manifestPath)
+	i.ForModes([]InstallMode{ModeFresh}, func() {
+		i.AddStepRmkDir(dir)
+	})
+	i.AddStepCopyFiles(dir, files)
+	if err := i.Run("test", []string{"--silent"}); err != nil {
+		t.Fatal(err)
+	}
+	return i
+}
+
+func TestUpgradeMode(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "app")
+	manifestPath := filepath.Join(root, "manifest.json")
+	installVersion(t, "1.0.0", dir, manifestPath, map[string][]byte{"a": []byte("1"), "b": []byte("1")})
+	tests := []struct {
+		version string
+		want    InstallMode
+	}{
+		{version: "1.1.0", want: ModeUpgrade},
+		{version: "1.0.0", want: ModeRepair},
+		{version: "0.9.0", want: ModeDowngrade},
+	}
+	for _, tt := range tests {
+		i := New("test")
+		i.SetVersion(tt.version)
+		i.SetManifestPath(manifestPath)
+		if mode := i.Mode(); mode != tt.want {
+			t.Errorf("version %s: mode %s, want %s", tt.version, mode, tt.want)
+		}
+	}
+	i := New("test")
+	i.SetVersion("0.9.0")
+	i.SetManifestPath(manifestPath)
+	if err := i.Run("test", []string{"--silent"}); err == nil {
+		t.Error("downgrade not refused")
+	}
+}
+
+//TestUninstallAfterUpgrade checks files installed by both
+//versions are removed by the uninstaller.
+func TestUninstallAfterUpgrade(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "app")
+	manifestPath := filepath.Join(root, "manifest.json")
+	installVersion(t, "1.0.0", dir, manifestPath, map[string][]byte{"a": []byte("1"), "b": []byte("1")})
+	installVersion(t, "2.0.0", dir, manifestPath, map[string][]byte{"a": []byte("2"), "c": []byte("2")})
+	m, err := LoadManifest(manifestPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Version != "2.0.0" {
+		t.Errorf("version %s recorded, want 2.0.0", m.Version)
+	}
+	u, err := NewUninstaller(manifestPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := u.Run("test", []string{"--silent"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		entries, _ := os.ReadDir(dir)
+		t.Errorf("%s left after uninstall with %d files", dir, len(entries))
+	}
+}